@@ -21,6 +21,7 @@ var services = mapOf(
         "communication" to "Communication",
         "compute" to "Compute",
         "consumption" to "Consumption",
+        "containerapps" to "Container Apps",
         "containers" to "Container Services",
         "cosmos" to "CosmosDB",
         "costmanagement" to "Cost Management",
@@ -33,19 +34,25 @@ var services = mapOf(
         "dataprotection" to "DataProtection",
         "databasemigration" to "Database Migration",
         "databoxedge" to "Databox Edge",
+        "defendereasm" to "Defender EASM",
         "desktopvirtualization" to "Desktop Virtualization",
+        "devcenter" to "Dev Center",
         "devtestlabs" to "Dev Test",
+        "deviceregistry" to "Device Registry",
         "digitaltwins" to "Digital Twins",
         "disks" to "Disks",
         "domainservices" to "DomainServices",
+        "elastic" to "Elastic",
         "eventgrid" to "EventGrid",
         "eventhub" to "EventHub",
         "firewall" to "Firewall",
         "frontdoor" to "FrontDoor",
+        "graphservices" to "Graph Services",
         "hdinsight" to "HDInsight",
         "hpccache" to "HPC Cache",
         "hsm" to "Hardware Security Module",
         "healthcare" to "Health Care",
+        "imagebuilder" to "Image Builder",
         "iotcentral" to "IoT Central",
         "iothub" to "IoT Hub",
         "keyvault" to "KeyVault",
@@ -59,18 +66,23 @@ var services = mapOf(
         "machinelearning" to "Machine Learning",
         "maintenance" to "Maintenance",
         "managedapplications" to "Managed Applications",
+        "devopsinfrastructure" to "Managed DevOps Pools",
         "msi" to "Managed Service Identities",
         "managementgroup" to "Management Group",
         "maps" to "Maps",
         "mariadb" to "MariaDB",
+        "marketplace" to "Marketplace",
         "media" to "Media",
         "mssql" to "Microsoft SQL Server / Azure SQL",
         "mixedreality" to "Mixed Reality",
+        "modsimworkbench" to "Modeling and Simulation Workbench",
         "monitor" to "Monitor",
+        "monitorpipeline" to "Monitor Pipeline",
         "mysql" to "MySQL",
         "netapp" to "NetApp",
         "network" to "Network",
         "notificationhub" to "Notification Hub",
+        "paymenthsm" to "Payment HSM",
         "policy" to "Policy",
         "portal" to "Portal",
         "postgres" to "PostgreSQL",
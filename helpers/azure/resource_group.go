@@ -84,6 +84,23 @@ func SchemaResourceGroupNameSetOptional() *pluginsdk.Schema {
 	}
 }
 
+// NormalizeResourceGroupName returns the name of the Resource Group as it's cased within the Resource ID returned
+// by the API, since Resource Group names are case-insensitive and Data Sources would otherwise expose whichever
+// casing was specified. The configured name is returned when the ID is empty, can't be parsed or refers to
+// another Resource Group.
+func NormalizeResourceGroupName(configured string, resourceId *string) string {
+	if resourceId == nil || *resourceId == "" {
+		return configured
+	}
+
+	id, err := ParseAzureResourceID(*resourceId)
+	if err != nil || !strings.EqualFold(id.ResourceGroup, configured) {
+		return configured
+	}
+
+	return id.ResourceGroup
+}
+
 func ValidateResourceGroupName(v interface{}, k string) (warnings []string, errors []error) {
 	value := v.(string)
 
@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
 func TestValidateResourceGroupName(t *testing.T) {
@@ -77,3 +78,49 @@ func TestValidateResourceGroupName(t *testing.T) {
 		}
 	}
 }
+
+func TestNormalizeResourceGroupName(t *testing.T) {
+	cases := []struct {
+		Configured string
+		ResourceId *string
+		Expected   string
+	}{
+		{
+			Configured: "MyResourceGroup",
+			ResourceId: nil,
+			Expected:   "MyResourceGroup",
+		},
+		{
+			Configured: "MyResourceGroup",
+			ResourceId: utils.String(""),
+			Expected:   "MyResourceGroup",
+		},
+		{
+			Configured: "MyResourceGroup",
+			ResourceId: utils.String("not-a-resource-id"),
+			Expected:   "MyResourceGroup",
+		},
+		{
+			Configured: "myresourcegroup",
+			ResourceId: utils.String("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/MyResourceGroup/providers/Microsoft.Network/virtualNetworks/network1"),
+			Expected:   "MyResourceGroup",
+		},
+		{
+			Configured: "MYRESOURCEGROUP",
+			ResourceId: utils.String("/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/MyResourceGroup/providers/Microsoft.Storage/storageAccounts/account1"),
+			Expected:   "MyResourceGroup",
+		},
+		{
+			Configured: "MyResourceGroup",
+			ResourceId: utils.String("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/OtherResourceGroup/providers/Microsoft.Network/virtualNetworks/network1"),
+			Expected:   "MyResourceGroup",
+		},
+	}
+
+	for _, tc := range cases {
+		actual := azure.NormalizeResourceGroupName(tc.Configured, tc.ResourceId)
+		if actual != tc.Expected {
+			t.Fatalf("Expected %q but got %q for %q", tc.Expected, actual, tc.Configured)
+		}
+	}
+}
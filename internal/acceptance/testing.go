@@ -10,6 +10,7 @@ import (
 	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/hashicorp/go-azure-helpers/authentication"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 )
 
 func PreCheck(t *testing.T) {
@@ -43,7 +44,7 @@ func EnvironmentName() string {
 func Environment() (*azure.Environment, error) {
 	envName := EnvironmentName()
 	metadataURL := os.Getenv("ARM_METADATA_URL")
-	return authentication.AzureEnvironmentByNameFromEndpoint(context.TODO(), metadataURL, envName)
+	return clients.EnvironmentFromMetadataHost(context.TODO(), metadataURL, envName)
 }
 
 func GetAuthConfig(t *testing.T) *authentication.Config {
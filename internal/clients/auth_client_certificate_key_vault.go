@@ -0,0 +1,211 @@
+package clients
+
+import (
+	"context"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"log"
+	"net/http"
+	"reflect"
+
+	keyvaultmgmt "github.com/Azure/azure-sdk-for-go/services/keyvault/v7.1/keyvault"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/adal"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/authentication"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/httplogging"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+	"golang.org/x/crypto/pkcs12"
+)
+
+// ClientCertificateKeyVaultConfig configures authenticating as a Service Principal using a Client Certificate
+// which is retrieved from a Key Vault Secret when the Provider is configured - meaning the Client Certificate
+// doesn't need to be written to disk.
+type ClientCertificateKeyVaultConfig struct {
+	// SecretID is the ID of the Key Vault Secret containing the (base64 encoded) PKCS#12 Client Certificate,
+	// as created by Key Vault for a Certificate - which can be versionless to use the latest version
+	SecretID string
+
+	// Password is the password for the PKCS#12 Client Certificate, if any
+	Password string
+
+	// BootstrapAuthConfig is used to retrieve the Client Certificate from Key Vault, for example using the
+	// Azure CLI or a Managed Identity
+	BootstrapAuthConfig *authentication.Config
+}
+
+// Validate ensures that the Key Vault Secret ID is valid
+func (c ClientCertificateKeyVaultConfig) Validate() error {
+	id, err := parse.ParseOptionallyVersionedNestedItemID(c.SecretID)
+	if err != nil {
+		return fmt.Errorf("parsing the Client Certificate Key Vault Secret ID %q: %+v", c.SecretID, err)
+	}
+
+	if id.NestedItemType != "secrets" {
+		return fmt.Errorf("the Client Certificate Key Vault Secret ID %q must be the ID of a Key Vault Secret (rather than a Key Vault %s)", c.SecretID, id.NestedItemType)
+	}
+
+	return nil
+}
+
+// clientCertificateSender returns the Sender used to retrieve the Client Certificate from Key Vault. Since the response
+// contains the private key, unlike the default Sender the requests and responses aren't dumped to the debug log - and
+// when HTTP Logging is enabled the bodies are omitted from the HTTP Log.
+func clientCertificateSender(sender autorest.Sender, httpLogger *httplogging.Logger) autorest.Sender {
+	if httpLogger != nil {
+		return httpLogger.WrapSenderWithoutBodies(sender)
+	}
+
+	return autorest.SenderFunc(func(req *http.Request) (*http.Response, error) {
+		log.Printf("[DEBUG] AzureRM Request: %s to %s", req.Method, req.URL)
+		resp, err := sender.Do(req)
+		if resp != nil {
+			log.Printf("[DEBUG] AzureRM Response: %s for %s", resp.Status, req.URL)
+		} else if err != nil {
+			log.Printf("[DEBUG] AzureRM Response Error: %s for %s", err, req.URL)
+		}
+		return resp, err
+	})
+}
+
+// load retrieves the Client Certificate from Key Vault using the Bootstrap Auth Config - the sender should be built
+// using clientCertificateSender, so that the Client Certificate isn't logged
+func (c ClientCertificateKeyVaultConfig) load(ctx context.Context, sender autorest.Sender, env azure.Environment) (*clientCertificate, error) {
+	if c.BootstrapAuthConfig == nil {
+		return nil, fmt.Errorf("retrieving the Client Certificate from Key Vault: a Bootstrap Auth Config wasn't configured")
+	}
+
+	id, err := parse.ParseOptionallyVersionedNestedItemID(c.SecretID)
+	if err != nil {
+		return nil, fmt.Errorf("parsing the Client Certificate Key Vault Secret ID %q: %+v", c.SecretID, err)
+	}
+
+	oauthConfig, err := c.BootstrapAuthConfig.BuildOAuthConfig(env.ActiveDirectoryEndpoint)
+	if err != nil {
+		return nil, fmt.Errorf("building the OAuth Config used to retrieve the Client Certificate from Key Vault: %+v", err)
+	}
+
+	client := keyvaultmgmt.New()
+	client.Authorizer = c.BootstrapAuthConfig.BearerAuthorizerCallback(ctx, sender, oauthConfig)
+	client.Sender = sender
+
+	log.Printf("[DEBUG] Retrieving the Client Certificate from Key Vault Secret %q..", c.SecretID)
+	resp, err := client.GetSecret(ctx, id.KeyVaultBaseUrl, id.Name, id.Version)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving the Client Certificate from Key Vault Secret %q: %+v", c.SecretID, err)
+	}
+	if resp.Value == nil {
+		return nil, fmt.Errorf("retrieving the Client Certificate from Key Vault Secret %q: `value` was nil", c.SecretID)
+	}
+
+	data, err := base64.StdEncoding.DecodeString(*resp.Value)
+	if err != nil {
+		return nil, fmt.Errorf("decoding the Client Certificate from Key Vault Secret %q: the value must be a base64 encoded PKCS#12 certificate: %+v", c.SecretID, err)
+	}
+
+	certificate, err := decodeClientCertificate(data, c.Password)
+	if err != nil {
+		return nil, fmt.Errorf("decoding the Client Certificate from Key Vault Secret %q: %+v", c.SecretID, err)
+	}
+
+	return certificate, nil
+}
+
+// decodeClientCertificate decodes the PKCS#12 certificate, which (unlike a certificate read from disk) can
+// contain the certificate chain - as such the certificate matching the private key is used
+func decodeClientCertificate(data []byte, password string) (*clientCertificate, error) {
+	blocks, err := pkcs12.ToPEM(data, password)
+	if err != nil {
+		return nil, err
+	}
+
+	var privateKey *rsa.PrivateKey
+	certificates := make([]*x509.Certificate, 0)
+	for _, block := range blocks {
+		switch block.Type {
+		case "PRIVATE KEY":
+			key, err := parsePrivateKey(block)
+			if err != nil {
+				return nil, err
+			}
+			privateKey = key
+
+		case "CERTIFICATE":
+			certificate, err := x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				return nil, fmt.Errorf("parsing certificate: %+v", err)
+			}
+			certificates = append(certificates, certificate)
+		}
+	}
+
+	if privateKey == nil {
+		return nil, fmt.Errorf("the PKCS#12 certificate must contain a private key")
+	}
+
+	for _, certificate := range certificates {
+		if publicKey, ok := certificate.PublicKey.(*rsa.PublicKey); ok && reflect.DeepEqual(*publicKey, privateKey.PublicKey) {
+			return &clientCertificate{
+				certificate: certificate,
+				privateKey:  privateKey,
+			}, nil
+		}
+	}
+
+	return nil, fmt.Errorf("the PKCS#12 certificate must contain a certificate matching the private key")
+}
+
+func parsePrivateKey(block *pem.Block) (*rsa.PrivateKey, error) {
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("parsing private key: %+v", err)
+	}
+
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("the PKCS#12 certificate must contain an RSA private key")
+	}
+	return rsaKey, nil
+}
+
+type clientCertificate struct {
+	certificate *x509.Certificate
+	privateKey  *rsa.PrivateKey
+}
+
+// authorizer returns an Authorizer for the specified resource using the Client Certificate. When auxiliary tenants
+// are configured a token is also obtained for each of these, which are sent in the `x-ms-authorization-auxiliary`
+// header for cross-tenant requests.
+func (c clientCertificate) authorizer(_ context.Context, sender autorest.Sender, oauthConfig authentication.OAuthConfig, clientId, resource string) (autorest.Authorizer, error) {
+	if oauthConfig.OAuth == nil {
+		return nil, fmt.Errorf("building Service Principal Token using a Client Certificate: an OAuth Config wasn't configured")
+	}
+
+	if oauthConfig.MultiTenantOauth == nil {
+		token, err := adal.NewServicePrincipalTokenFromCertificate(*oauthConfig.OAuth, clientId, c.certificate, c.privateKey, resource)
+		if err != nil {
+			return nil, fmt.Errorf("building Service Principal Token using a Client Certificate: %+v", err)
+		}
+		token.SetSender(sender)
+
+		return autorest.NewBearerAuthorizer(token), nil
+	}
+
+	token, err := adal.NewMultiTenantServicePrincipalTokenFromCertificate(*oauthConfig.MultiTenantOauth, clientId, c.certificate, c.privateKey, resource)
+	if err != nil {
+		return nil, fmt.Errorf("building Multi-Tenant Service Principal Token using a Client Certificate: %+v", err)
+	}
+	token.PrimaryToken.SetSender(sender)
+	for _, auxiliaryToken := range token.AuxiliaryTokens {
+		auxiliaryToken.SetSender(sender)
+	}
+
+	return autorest.NewMultiTenantServicePrincipalTokenAuthorizer(token), nil
+}
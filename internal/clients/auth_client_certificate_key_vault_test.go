@@ -0,0 +1,156 @@
+package clients
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/authentication"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/httplogging"
+)
+
+func TestClientCertificateKeyVaultConfigValidate(t *testing.T) {
+	testData := []struct {
+		name   string
+		config ClientCertificateKeyVaultConfig
+		valid  bool
+	}{
+		{
+			name:   "empty",
+			config: ClientCertificateKeyVaultConfig{},
+			valid:  false,
+		},
+		{
+			name: "versioned secret",
+			config: ClientCertificateKeyVaultConfig{
+				SecretID: "https://example.vault.azure.net/secrets/certificate/fdf067c93bbb4b22bff4d8b7a9a56217",
+			},
+			valid: true,
+		},
+		{
+			name: "versionless secret",
+			config: ClientCertificateKeyVaultConfig{
+				SecretID: "https://example.vault.azure.net/secrets/certificate",
+			},
+			valid: true,
+		},
+		{
+			name: "certificate",
+			config: ClientCertificateKeyVaultConfig{
+				SecretID: "https://example.vault.azure.net/certificates/certificate/fdf067c93bbb4b22bff4d8b7a9a56217",
+			},
+			valid: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Run(v.name, func(t *testing.T) {
+			err := v.config.Validate()
+			if v.valid && err != nil {
+				t.Fatalf("expected the config to be valid but got: %+v", err)
+			}
+			if !v.valid && err == nil {
+				t.Fatalf("expected the config to be invalid but it wasn't")
+			}
+		})
+	}
+}
+
+func TestDecodeClientCertificate(t *testing.T) {
+	data, err := ioutil.ReadFile("testdata/client_certificate.pfx")
+	if err != nil {
+		t.Fatalf("reading certificate: %+v", err)
+	}
+
+	if _, err := decodeClientCertificate(data, "incorrect"); err == nil {
+		t.Fatalf("expected an error when using an incorrect password but didn't get one")
+	}
+
+	certificate, err := decodeClientCertificate(data, "Passw0rd")
+	if err != nil {
+		t.Fatalf("decoding certificate: %+v", err)
+	}
+	if certificate.certificate.Subject.CommonName != "example" {
+		t.Fatalf("expected the Common Name to be %q but got %q", "example", certificate.certificate.Subject.CommonName)
+	}
+	if certificate.privateKey == nil {
+		t.Fatalf("expected the private key to be decoded but it was nil")
+	}
+}
+
+func TestClientCertificateKeyVaultConfigLoadDoesNotLogCertificate(t *testing.T) {
+	data, err := ioutil.ReadFile("testdata/client_certificate.pfx")
+	if err != nil {
+		t.Fatalf("reading certificate: %+v", err)
+	}
+	value := base64.StdEncoding.EncodeToString(data)
+
+	config := ClientCertificateKeyVaultConfig{
+		SecretID: "https://example.vault.azure.net/secrets/certificate/fdf067c93bbb4b22bff4d8b7a9a56217",
+		Password: "Passw0rd",
+		BootstrapAuthConfig: &authentication.Config{
+			TenantID: "00000000-0000-0000-0000-000000000000",
+		},
+	}
+	keyVault := autorest.SenderFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			Status:     "200 OK",
+			StatusCode: http.StatusOK,
+			Header: http.Header{
+				"Content-Type": []string{"application/json"},
+			},
+			Body:    ioutil.NopCloser(strings.NewReader(fmt.Sprintf(`{"id":%q,"value":%q}`, config.SecretID, value))),
+			Request: req,
+		}, nil
+	})
+
+	var debugLog bytes.Buffer
+	log.SetOutput(&debugLog)
+	defer log.SetOutput(os.Stderr)
+
+	httpLogPath := filepath.Join(t.TempDir(), "http.log")
+	httpLogger, err := httplogging.ForPath(httpLogPath)
+	if err != nil {
+		t.Fatalf("building HTTP Logger: %+v", err)
+	}
+
+	for _, logger := range []*httplogging.Logger{nil, httpLogger} {
+		certificate, err := config.load(context.TODO(), clientCertificateSender(keyVault, logger), azure.PublicCloud)
+		if err != nil {
+			t.Fatalf("loading the Client Certificate: %+v", err)
+		}
+		if certificate.privateKey == nil {
+			t.Fatalf("expected the private key to be decoded but it was nil")
+		}
+	}
+
+	// check for a prefix of the value, so that a truncated body would also be caught
+	secret := value[:64]
+	if !strings.Contains(debugLog.String(), "example.vault.azure.net") {
+		t.Fatalf("expected the requests to be included in the debug log but got: %s", debugLog.String())
+	}
+	if strings.Contains(debugLog.String(), secret) {
+		t.Fatalf("expected the Client Certificate not to be included in the debug log but got: %s", debugLog.String())
+	}
+
+	httpLog, err := ioutil.ReadFile(httpLogPath)
+	if err != nil {
+		t.Fatalf("reading HTTP Log: %+v", err)
+	}
+	if !strings.Contains(string(httpLog), "example.vault.azure.net") {
+		t.Fatalf("expected the requests to be included in the HTTP Log but got: %s", string(httpLog))
+	}
+	if strings.Contains(string(httpLog), secret) {
+		t.Fatalf("expected the Client Certificate not to be included in the HTTP Log but got: %s", string(httpLog))
+	}
+}
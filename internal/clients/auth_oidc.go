@@ -0,0 +1,166 @@
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/adal"
+	"github.com/hashicorp/go-azure-helpers/authentication"
+)
+
+// oidcTokenAudience is the audience which Azure Active Directory expects federated ID Tokens to be issued for
+const oidcTokenAudience = "api://AzureADTokenExchange"
+
+// OIDCConfig configures authenticating as a Service Principal using an ID Token issued by an external
+// OIDC provider (such as GitHub Actions), which is exchanged for an access token using the Federated
+// Identity Credential configured on the Application - meaning no secrets need to be stored.
+type OIDCConfig struct {
+	// Token is the ID Token issued by the OIDC provider - when unset, a token is requested from RequestURL
+	Token string
+
+	// RequestURL and RequestToken are used to request an ID Token from the OIDC provider (for example
+	// the ACTIONS_ID_TOKEN_REQUEST_URL and ACTIONS_ID_TOKEN_REQUEST_TOKEN values in GitHub Actions)
+	RequestURL   string
+	RequestToken string
+}
+
+// Validate ensures that either an ID Token, or the information required to request one, has been specified
+func (c OIDCConfig) Validate() error {
+	if c.Token != "" {
+		return nil
+	}
+
+	if c.RequestURL == "" || c.RequestToken == "" {
+		return fmt.Errorf("either an OIDC Token, or both an OIDC Request URL and an OIDC Request Token must be specified when authenticating using OIDC")
+	}
+
+	return nil
+}
+
+// authorizer returns an Authorizer for the specified resource, the ID Token is exchanged each time
+// the access token needs to be refreshed. When auxiliary tenants are configured a token is also obtained
+// for each of these, which are sent in the `x-ms-authorization-auxiliary` header for cross-tenant requests.
+func (c OIDCConfig) authorizer(ctx context.Context, sender autorest.Sender, oauthConfig authentication.OAuthConfig, clientId, resource string) (autorest.Authorizer, error) {
+	if oauthConfig.OAuth == nil {
+		return nil, fmt.Errorf("building Service Principal Token using OIDC: an OAuth Config wasn't configured")
+	}
+
+	if oauthConfig.MultiTenantOauth == nil {
+		token, err := c.servicePrincipalToken(ctx, sender, *oauthConfig.OAuth, clientId, resource)
+		if err != nil {
+			return nil, err
+		}
+
+		return autorest.NewBearerAuthorizer(token), nil
+	}
+
+	multiTenantOAuthConfig := *oauthConfig.MultiTenantOauth
+	primaryToken, err := c.servicePrincipalToken(ctx, sender, *multiTenantOAuthConfig.PrimaryTenant(), clientId, resource)
+	if err != nil {
+		return nil, err
+	}
+
+	auxiliaryTenants := multiTenantOAuthConfig.AuxiliaryTenants()
+	token := &adal.MultiTenantServicePrincipalToken{
+		PrimaryToken:    primaryToken,
+		AuxiliaryTokens: make([]*adal.ServicePrincipalToken, 0, len(auxiliaryTenants)),
+	}
+	for _, tenant := range auxiliaryTenants {
+		auxiliaryToken, err := c.servicePrincipalToken(ctx, sender, *tenant, clientId, resource)
+		if err != nil {
+			return nil, fmt.Errorf("building Service Principal Token for auxiliary tenant: %+v", err)
+		}
+		token.AuxiliaryTokens = append(token.AuxiliaryTokens, auxiliaryToken)
+	}
+
+	return autorest.NewMultiTenantServicePrincipalTokenAuthorizer(token), nil
+}
+
+func (c OIDCConfig) servicePrincipalToken(ctx context.Context, sender autorest.Sender, oauthConfig adal.OAuthConfig, clientId, resource string) (*adal.ServicePrincipalToken, error) {
+	assertion := &oidcClientAssertion{
+		ctx:    ctx,
+		config: c,
+		sender: sender,
+	}
+
+	token, err := adal.NewServicePrincipalTokenWithSecret(oauthConfig, clientId, resource, assertion)
+	if err != nil {
+		return nil, fmt.Errorf("building Service Principal Token using OIDC: %+v", err)
+	}
+	token.SetSender(sender)
+
+	return token, nil
+}
+
+var _ adal.ServicePrincipalSecret = &oidcClientAssertion{}
+
+// oidcClientAssertion authenticates the token request using the OIDC ID Token as a Client Assertion
+type oidcClientAssertion struct {
+	ctx    context.Context
+	config OIDCConfig
+	sender autorest.Sender
+}
+
+func (a *oidcClientAssertion) SetAuthenticationValues(_ *adal.ServicePrincipalToken, values *url.Values) error {
+	token, err := a.idToken()
+	if err != nil {
+		return err
+	}
+
+	values.Set("client_assertion_type", "urn:ietf:params:oauth:client-assertion-type:jwt-bearer")
+	values.Set("client_assertion", token)
+	return nil
+}
+
+func (a *oidcClientAssertion) idToken() (string, error) {
+	if a.config.Token != "" {
+		return a.config.Token, nil
+	}
+
+	requestUrl, err := url.Parse(a.config.RequestURL)
+	if err != nil {
+		return "", fmt.Errorf("parsing OIDC Request URL %q: %+v", a.config.RequestURL, err)
+	}
+	query := requestUrl.Query()
+	query.Set("audience", oidcTokenAudience)
+	requestUrl.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(a.ctx, http.MethodGet, requestUrl.String(), nil)
+	if err != nil {
+		return "", fmt.Errorf("building OIDC Token request: %+v", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", a.config.RequestToken))
+
+	resp, err := a.sender.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("requesting OIDC Token: %+v", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("reading OIDC Token response: %+v", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("requesting OIDC Token: unexpected status %d: %s", resp.StatusCode, string(body))
+	}
+
+	var result struct {
+		Value string `json:"value"`
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return "", fmt.Errorf("parsing OIDC Token response: %+v", err)
+	}
+	if result.Value == "" {
+		return "", fmt.Errorf("the OIDC Token response didn't contain a token")
+	}
+
+	return result.Value, nil
+}
@@ -0,0 +1,135 @@
+package clients
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/adal"
+	"github.com/hashicorp/go-azure-helpers/authentication"
+)
+
+func TestOIDCConfigValidate(t *testing.T) {
+	testData := []struct {
+		name   string
+		config OIDCConfig
+		valid  bool
+	}{
+		{
+			name:   "empty",
+			config: OIDCConfig{},
+			valid:  false,
+		},
+		{
+			name: "token",
+			config: OIDCConfig{
+				Token: "abc123",
+			},
+			valid: true,
+		},
+		{
+			name: "request url only",
+			config: OIDCConfig{
+				RequestURL: "https://example.com",
+			},
+			valid: false,
+		},
+		{
+			name: "request url and token",
+			config: OIDCConfig{
+				RequestURL:   "https://example.com",
+				RequestToken: "abc123",
+			},
+			valid: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Run(v.name, func(t *testing.T) {
+			err := v.config.Validate()
+			if v.valid && err != nil {
+				t.Fatalf("expected the config to be valid but got: %+v", err)
+			}
+			if !v.valid && err == nil {
+				t.Fatalf("expected the config to be invalid but it wasn't")
+			}
+		})
+	}
+}
+
+func TestOIDCClientAssertionRequestsToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer request-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if audience := r.URL.Query().Get("audience"); audience != oidcTokenAudience {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		fmt.Fprint(w, `{"value": "id-token"}`)
+	}))
+	defer server.Close()
+
+	assertion := &oidcClientAssertion{
+		ctx: context.TODO(),
+		config: OIDCConfig{
+			RequestURL:   fmt.Sprintf("%s/token?api-version=2.0", server.URL),
+			RequestToken: "request-token",
+		},
+		sender: server.Client(),
+	}
+
+	values := url.Values{}
+	if err := assertion.SetAuthenticationValues(nil, &values); err != nil {
+		t.Fatalf("setting authentication values: %+v", err)
+	}
+
+	if actual := values.Get("client_assertion"); actual != "id-token" {
+		t.Fatalf("expected the client_assertion to be %q but got %q", "id-token", actual)
+	}
+	if actual := values.Get("client_assertion_type"); actual != "urn:ietf:params:oauth:client-assertion-type:jwt-bearer" {
+		t.Fatalf("unexpected client_assertion_type %q", actual)
+	}
+}
+
+func TestOIDCConfigAuthorizerForAuxiliaryTenants(t *testing.T) {
+	tenantId := "00000000-0000-0000-0000-000000000000"
+	auxiliaryTenantIds := []string{
+		"11111111-1111-1111-1111-111111111111",
+		"22222222-2222-2222-2222-222222222222",
+	}
+	config := OIDCConfig{
+		Token: "id-token",
+	}
+
+	primary, err := adal.NewOAuthConfig("https://login.microsoftonline.com/", tenantId)
+	if err != nil {
+		t.Fatalf("building OAuth Config: %+v", err)
+	}
+	multiTenant, err := adal.NewMultiTenantOAuthConfig("https://login.microsoftonline.com/", tenantId, auxiliaryTenantIds, adal.OAuthOptions{})
+	if err != nil {
+		t.Fatalf("building Multi Tenant OAuth Config: %+v", err)
+	}
+
+	authorizer, err := config.authorizer(context.TODO(), http.DefaultClient, authentication.OAuthConfig{OAuth: primary}, "client-id", "https://management.azure.com/")
+	if err != nil {
+		t.Fatalf("building authorizer: %+v", err)
+	}
+	if _, ok := authorizer.(*autorest.BearerAuthorizer); !ok {
+		t.Fatalf("expected a BearerAuthorizer but got %T", authorizer)
+	}
+
+	authorizer, err = config.authorizer(context.TODO(), http.DefaultClient, authentication.OAuthConfig{OAuth: primary, MultiTenantOauth: &multiTenant}, "client-id", "https://management.azure.com/")
+	if err != nil {
+		t.Fatalf("building multi tenant authorizer: %+v", err)
+	}
+	if _, ok := authorizer.(*autorest.MultiTenantBearerAuthorizer); !ok {
+		t.Fatalf("expected a MultiTenantBearerAuthorizer but got %T", authorizer)
+	}
+}
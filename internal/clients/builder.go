@@ -4,7 +4,8 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
+	"net/http"
+	"time"
 
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/azure"
@@ -13,45 +14,49 @@ import (
 	"github.com/hashicorp/go-azure-helpers/sender"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/httplogging"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/operationmetrics"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 )
 
 type ClientBuilder struct {
 	AuthConfig                  *authentication.Config
 	DisableCorrelationRequestID bool
 	CustomCorrelationRequestID  string
+	CustomRequestHeaders        map[string]string
 	DisableTerraformPartnerID   bool
 	PartnerId                   string
 	SkipProviderRegistration    bool
 	StorageUseAzureAD           bool
 	TerraformVersion            string
 	Features                    features.UserFeatures
+	OperationMetricsPath        string
+	HTTPLogPath                 string
+	DisableWrites               bool
+	DefaultTags                 map[string]string
+	IgnoreTags                  tags.IgnoreConfig
+	DataSourceListCacheEnabled  bool
+	StrictLocationValidation    bool
+	MaxRetries                  int
+	RetryBaseDelay              time.Duration
+
+	// OIDC is nil unless the Provider should authenticate using an ID Token issued by an OIDC provider
+	OIDC *OIDCConfig
+
+	// ClientCertificateKeyVault is nil unless the Provider should authenticate using a Client Certificate
+	// retrieved from a Key Vault Secret
+	ClientCertificateKeyVault *ClientCertificateKeyVaultConfig
 }
 
-const azureStackEnvironmentError = `
-The AzureRM Provider supports the different Azure Public Clouds - including China, Germany,
-Public and US Government - however it does not support Azure Stack due to differences in
-API and feature availability.
-
-Terraform instead offers a separate "azurestack" provider which supports the functionality
-and API's available in Azure Stack via Azure Stack Profiles.
-`
+// servicePrincipalAuthorizer is implemented by the authentication methods which are handled by the Client
+// Builder rather than the Authentication Builder (since these aren't supported there)
+type servicePrincipalAuthorizer interface {
+	authorizer(ctx context.Context, sender autorest.Sender, oauthConfig authentication.OAuthConfig, clientId, resource string) (autorest.Authorizer, error)
+}
 
 func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
-	// point folks towards the separate Azure Stack Provider when using Azure Stack
-	if strings.EqualFold(builder.AuthConfig.Environment, "AZURESTACKCLOUD") {
-		return nil, fmt.Errorf(azureStackEnvironmentError)
-	}
-
-	isAzureStack, err := authentication.IsEnvironmentAzureStack(ctx, builder.AuthConfig.MetadataHost, builder.AuthConfig.Environment)
-	if err != nil {
-		return nil, fmt.Errorf("unable to determine if environment is Azure Stack: %+v", err)
-	}
-	if isAzureStack {
-		return nil, fmt.Errorf(azureStackEnvironmentError)
-	}
-
-	env, err := authentication.AzureEnvironmentByNameFromEndpoint(ctx, builder.AuthConfig.MetadataHost, builder.AuthConfig.Environment)
+	env, err := EnvironmentFromMetadataHost(ctx, builder.AuthConfig.MetadataHost, builder.AuthConfig.Environment)
 	if err != nil {
 		return nil, fmt.Errorf("unable to find environment %q from endpoint %q: %+v", builder.AuthConfig.Environment, builder.AuthConfig.MetadataHost, err)
 	}
@@ -76,48 +81,103 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 		return nil, fmt.Errorf("unable to configure OAuthConfig for tenant %s", builder.AuthConfig.TenantID)
 	}
 
+	// HTTP Logging is opt-in, when enabled the authentication requests are also logged (with secrets redacted)
+	var httpLogger *httplogging.Logger
+	if builder.HTTPLogPath != "" {
+		httpLogger, err = httplogging.ForPath(builder.HTTPLogPath)
+		if err != nil {
+			return nil, fmt.Errorf("configuring HTTP Logging: %+v", err)
+		}
+	}
+
 	sender := sender.BuildSender("AzureRM")
+	if httpLogger != nil {
+		sender = httpLogger.BuildSender()
+	}
+
+	var spAuthorizer servicePrincipalAuthorizer
+	if builder.OIDC != nil {
+		spAuthorizer = builder.OIDC
+	}
+	if builder.ClientCertificateKeyVault != nil {
+		certificateSender := clientCertificateSender(&http.Client{
+			Transport: &http.Transport{
+				Proxy: http.ProxyFromEnvironment,
+			},
+		}, httpLogger)
+		certificate, err := builder.ClientCertificateKeyVault.load(ctx, certificateSender, *env)
+		if err != nil {
+			return nil, err
+		}
+		spAuthorizer = certificate
+	}
+
+	getAuthorizer := func(endpoint string) (autorest.Authorizer, error) {
+		if spAuthorizer != nil {
+			return spAuthorizer.authorizer(ctx, sender, *oauthConfig, builder.AuthConfig.ClientID, endpoint)
+		}
+
+		return builder.AuthConfig.GetADALToken(ctx, sender, oauthConfig, endpoint)
+	}
 
 	// Resource Manager endpoints
 	endpoint := env.ResourceManagerEndpoint
-	auth, err := builder.AuthConfig.GetADALToken(ctx, sender, oauthConfig, env.TokenAudience)
+	auth, err := getAuthorizer(env.TokenAudience)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get authorization token for resource manager: %+v", err)
 	}
 
+	// the authorizers for the other endpoints are only required by a handful of resources, so
+	// rather than obtaining a token for each of them up-front these are obtained on first use
+	lazyAuthorizerForEndpoint := func(endpoint, name string) autorest.Authorizer {
+		return newLazyAuthorizer(func() (autorest.Authorizer, error) {
+			authorizer, err := getAuthorizer(endpoint)
+			if err != nil {
+				return nil, fmt.Errorf("unable to get authorization token for %s: %+v", name, err)
+			}
+			return authorizer, nil
+		})
+	}
+
 	// Graph Endpoints
 	graphEndpoint := env.GraphEndpoint
-	graphAuth, err := builder.AuthConfig.GetADALToken(ctx, sender, oauthConfig, graphEndpoint)
-	if err != nil {
-		return nil, fmt.Errorf("unable to get authorization token for graph endpoints: %+v", err)
-	}
+	graphAuth := lazyAuthorizerForEndpoint(graphEndpoint, "graph endpoints")
 
 	// Storage Endpoints
-	storageAuth, err := builder.AuthConfig.GetADALToken(ctx, sender, oauthConfig, env.ResourceIdentifiers.Storage)
-	if err != nil {
-		return nil, fmt.Errorf("unable to get authorization token for storage endpoints: %+v", err)
-	}
+	storageAuth := lazyAuthorizerForEndpoint(env.ResourceIdentifiers.Storage, "storage endpoints")
 
 	// Synapse Endpoints
 	var synapseAuth autorest.Authorizer = nil
 	if env.ResourceIdentifiers.Synapse != azure.NotAvailable {
-		synapseAuth, err = builder.AuthConfig.GetADALToken(ctx, sender, oauthConfig, env.ResourceIdentifiers.Synapse)
-		if err != nil {
-			return nil, fmt.Errorf("unable to get authorization token for synapse endpoints: %+v", err)
-		}
+		synapseAuth = lazyAuthorizerForEndpoint(env.ResourceIdentifiers.Synapse, "synapse endpoints")
 	} else {
 		log.Printf("[DEBUG] Skipping building the Synapse Authorizer since this is not supported in the current Azure Environment")
 	}
 
 	// Key Vault Endpoints
 	keyVaultAuth := builder.AuthConfig.BearerAuthorizerCallback(ctx, sender, oauthConfig)
+	if spAuthorizer != nil {
+		keyVaultAuth = autorest.NewBearerAuthorizerCallback(sender, func(tenantID, resource string) (*autorest.BearerAuthorizer, error) {
+			// a BearerAuthorizer is only valid for the primary tenant
+			primaryOAuthConfig := authentication.OAuthConfig{
+				OAuth: oauthConfig.OAuth,
+			}
+			authorizer, err := spAuthorizer.authorizer(ctx, sender, primaryOAuthConfig, builder.AuthConfig.ClientID, resource)
+			if err != nil {
+				return nil, err
+			}
 
-	// Batch Management Endpoints
-	batchManagementAuth, err := builder.AuthConfig.GetADALToken(ctx, sender, oauthConfig, env.BatchManagementEndpoint)
-	if err != nil {
-		return nil, fmt.Errorf("unable to get authorization token for batch management endpoint: %+v", err)
+			cast, ok := authorizer.(*autorest.BearerAuthorizer)
+			if !ok {
+				return nil, fmt.Errorf("converting %+v to a BearerAuthorizer", authorizer)
+			}
+			return cast, nil
+		})
 	}
 
+	// Batch Management Endpoints
+	batchManagementAuth := lazyAuthorizerForEndpoint(env.BatchManagementEndpoint, "batch management endpoint")
+
 	o := &common.ClientOptions{
 		SubscriptionId:              builder.AuthConfig.SubscriptionID,
 		TenantID:                    builder.AuthConfig.TenantID,
@@ -134,12 +194,15 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 		SkipProviderReg:             builder.SkipProviderRegistration,
 		DisableCorrelationRequestID: builder.DisableCorrelationRequestID,
 		CustomCorrelationRequestID:  builder.CustomCorrelationRequestID,
+		CustomRequestHeaders:        builder.CustomRequestHeaders,
 		DisableTerraformPartnerID:   builder.DisableTerraformPartnerID,
 		Environment:                 *env,
 		Features:                    builder.Features,
 		StorageUseAzureAD:           builder.StorageUseAzureAD,
+		MaxRetries:                  builder.MaxRetries,
+		RetryBaseDelay:              builder.RetryBaseDelay,
 		TokenFunc: func(endpoint string) (autorest.Authorizer, error) {
-			authorizer, err := builder.AuthConfig.GetADALToken(ctx, sender, oauthConfig, endpoint)
+			authorizer, err := getAuthorizer(endpoint)
 			if err != nil {
 				return nil, fmt.Errorf("getting authorization token for endpoint %s: %+v", endpoint, err)
 			}
@@ -147,9 +210,27 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 		},
 	}
 
+	if builder.OperationMetricsPath != "" {
+		recorder := operationmetrics.ForPath(builder.OperationMetricsPath)
+		o.OperationMetrics = recorder
+
+		// write out the summary when the Provider is stopped (e.g. Terraform is interrupted), since the process
+		// may then exit before the summary would otherwise be written
+		go func() {
+			<-ctx.Done()
+			recorder.Flush()
+		}()
+	}
+	o.HTTPLogger = httpLogger
+
 	if err := client.Build(ctx, o); err != nil {
 		return nil, fmt.Errorf("building Client: %+v", err)
 	}
+	client.DisableWrites = builder.DisableWrites
+	client.DefaultTags = builder.DefaultTags
+	client.IgnoreTags = builder.IgnoreTags
+	client.ListCache = NewListCache(builder.DataSourceListCacheEnabled)
+	client.StrictLocationValidation = builder.StrictLocationValidation
 
 	if features.EnhancedValidationEnabled() {
 		location.CacheSupportedLocations(ctx, env.ResourceManagerEndpoint)
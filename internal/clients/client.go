@@ -7,6 +7,7 @@ import (
 	"github.com/Azure/go-autorest/autorest/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/operationmetrics"
 	aadb2c "github.com/hashicorp/terraform-provider-azurerm/internal/services/aadb2c/client"
 	advisor "github.com/hashicorp/terraform-provider-azurerm/internal/services/advisor/client"
 	analysisServices "github.com/hashicorp/terraform-provider-azurerm/internal/services/analysisservices/client"
@@ -19,6 +20,7 @@ import (
 	automation "github.com/hashicorp/terraform-provider-azurerm/internal/services/automation/client"
 	azureStackHCI "github.com/hashicorp/terraform-provider-azurerm/internal/services/azurestackhci/client"
 	batch "github.com/hashicorp/terraform-provider-azurerm/internal/services/batch/client"
+	billing "github.com/hashicorp/terraform-provider-azurerm/internal/services/billing/client"
 	blueprints "github.com/hashicorp/terraform-provider-azurerm/internal/services/blueprints/client"
 	bot "github.com/hashicorp/terraform-provider-azurerm/internal/services/bot/client"
 	cdn "github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/client"
@@ -26,6 +28,7 @@ import (
 	communication "github.com/hashicorp/terraform-provider-azurerm/internal/services/communication/client"
 	compute "github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/client"
 	consumption "github.com/hashicorp/terraform-provider-azurerm/internal/services/consumption/client"
+	containerapps "github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/client"
 	containerServices "github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/client"
 	cosmosdb "github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/client"
 	costmanagement "github.com/hashicorp/terraform-provider-azurerm/internal/services/costmanagement/client"
@@ -37,21 +40,28 @@ import (
 	datalake "github.com/hashicorp/terraform-provider-azurerm/internal/services/datalake/client"
 	dataprotection "github.com/hashicorp/terraform-provider-azurerm/internal/services/dataprotection/client"
 	datashare "github.com/hashicorp/terraform-provider-azurerm/internal/services/datashare/client"
+	defendereasm "github.com/hashicorp/terraform-provider-azurerm/internal/services/defendereasm/client"
 	desktopvirtualization "github.com/hashicorp/terraform-provider-azurerm/internal/services/desktopvirtualization/client"
+	devcenter "github.com/hashicorp/terraform-provider-azurerm/internal/services/devcenter/client"
+	deviceregistry "github.com/hashicorp/terraform-provider-azurerm/internal/services/deviceregistry/client"
+	devopsinfrastructure "github.com/hashicorp/terraform-provider-azurerm/internal/services/devopsinfrastructure/client"
 	devspace "github.com/hashicorp/terraform-provider-azurerm/internal/services/devspace/client"
 	devtestlabs "github.com/hashicorp/terraform-provider-azurerm/internal/services/devtestlabs/client"
 	digitaltwins "github.com/hashicorp/terraform-provider-azurerm/internal/services/digitaltwins/client"
 	disks "github.com/hashicorp/terraform-provider-azurerm/internal/services/disks/client"
 	dns "github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/client"
 	domainservices "github.com/hashicorp/terraform-provider-azurerm/internal/services/domainservices/client"
+	elastic "github.com/hashicorp/terraform-provider-azurerm/internal/services/elastic/client"
 	eventgrid "github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/client"
 	eventhub "github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub/client"
 	firewall "github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/client"
 	frontdoor "github.com/hashicorp/terraform-provider-azurerm/internal/services/frontdoor/client"
+	graphservices "github.com/hashicorp/terraform-provider-azurerm/internal/services/graphservices/client"
 	hdinsight "github.com/hashicorp/terraform-provider-azurerm/internal/services/hdinsight/client"
 	healthcare "github.com/hashicorp/terraform-provider-azurerm/internal/services/healthcare/client"
 	hpccache "github.com/hashicorp/terraform-provider-azurerm/internal/services/hpccache/client"
 	hsm "github.com/hashicorp/terraform-provider-azurerm/internal/services/hsm/client"
+	imagebuilder "github.com/hashicorp/terraform-provider-azurerm/internal/services/imagebuilder/client"
 	iotcentral "github.com/hashicorp/terraform-provider-azurerm/internal/services/iotcentral/client"
 	iothub "github.com/hashicorp/terraform-provider-azurerm/internal/services/iothub/client"
 	timeseriesinsights "github.com/hashicorp/terraform-provider-azurerm/internal/services/iottimeseriesinsights/client"
@@ -69,15 +79,19 @@ import (
 	managementgroup "github.com/hashicorp/terraform-provider-azurerm/internal/services/managementgroup/client"
 	maps "github.com/hashicorp/terraform-provider-azurerm/internal/services/maps/client"
 	mariadb "github.com/hashicorp/terraform-provider-azurerm/internal/services/mariadb/client"
+	marketplace "github.com/hashicorp/terraform-provider-azurerm/internal/services/marketplace/client"
 	media "github.com/hashicorp/terraform-provider-azurerm/internal/services/media/client"
 	mixedreality "github.com/hashicorp/terraform-provider-azurerm/internal/services/mixedreality/client"
+	modsimworkbench "github.com/hashicorp/terraform-provider-azurerm/internal/services/modsimworkbench/client"
 	monitor "github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/client"
+	monitorpipeline "github.com/hashicorp/terraform-provider-azurerm/internal/services/monitorpipeline/client"
 	msi "github.com/hashicorp/terraform-provider-azurerm/internal/services/msi/client"
 	mssql "github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/client"
 	mysql "github.com/hashicorp/terraform-provider-azurerm/internal/services/mysql/client"
 	netapp "github.com/hashicorp/terraform-provider-azurerm/internal/services/netapp/client"
 	network "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/client"
 	notificationhub "github.com/hashicorp/terraform-provider-azurerm/internal/services/notificationhub/client"
+	paymenthsm "github.com/hashicorp/terraform-provider-azurerm/internal/services/paymenthsm/client"
 	policy "github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/client"
 	portal "github.com/hashicorp/terraform-provider-azurerm/internal/services/portal/client"
 	postgres "github.com/hashicorp/terraform-provider-azurerm/internal/services/postgres/client"
@@ -107,6 +121,7 @@ import (
 	videoAnalyzer "github.com/hashicorp/terraform-provider-azurerm/internal/services/videoanalyzer/client"
 	vmware "github.com/hashicorp/terraform-provider-azurerm/internal/services/vmware/client"
 	web "github.com/hashicorp/terraform-provider-azurerm/internal/services/web/client"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 )
 
 type Client struct {
@@ -116,6 +131,24 @@ type Client struct {
 	Account  *ResourceManagerAccount
 	Features features.UserFeatures
 
+	// OperationMetrics is nil unless the user has opted into recording Operation Metrics
+	OperationMetrics *operationmetrics.Recorder
+
+	// DisableWrites causes any Create, Update or Delete operation to fail before any API calls are made
+	DisableWrites bool
+
+	// DefaultTags are merged into the Tags of each Resource which supports them, Tags set on the Resource take precedence
+	DefaultTags map[string]string
+
+	// IgnoreTags are removed from the Tags of each Resource when these are set into the State
+	IgnoreTags tags.IgnoreConfig
+
+	// StrictLocationValidation validates the `location` of each Resource against the Locations known to the Provider during the plan
+	StrictLocationValidation bool
+
+	// ListCache caches the responses of List API calls made by Data Sources, when the user has opted into this
+	ListCache *ListCache
+
 	AadB2c                *aadb2c.Client
 	Advisor               *advisor.Client
 	AnalysisServices      *analysisServices.Client
@@ -129,6 +162,7 @@ type Client struct {
 	Automation            *automation.Client
 	AzureStackHCI         *azureStackHCI.Client
 	Batch                 *batch.Client
+	Billing               *billing.Client
 	Blueprints            *blueprints.Client
 	Bot                   *bot.Client
 	Cdn                   *cdn.Client
@@ -136,6 +170,7 @@ type Client struct {
 	Communication         *communication.Client
 	Compute               *compute.Client
 	Consumption           *consumption.Client
+	ContainerApps         *containerapps.Client
 	Containers            *containerServices.Client
 	Cosmos                *cosmosdb.Client
 	CostManagement        *costmanagement.Client
@@ -147,21 +182,28 @@ type Client struct {
 	Datalake              *datalake.Client
 	DataProtection        *dataprotection.Client
 	DataShare             *datashare.Client
+	DefenderEASM          *defendereasm.Client
 	DesktopVirtualization *desktopvirtualization.Client
+	DevCenter             *devcenter.Client
+	DeviceRegistry        *deviceregistry.Client
+	DevOpsInfrastructure  *devopsinfrastructure.Client
 	DevSpace              *devspace.Client
 	DevTestLabs           *devtestlabs.Client
 	DigitalTwins          *digitaltwins.Client
 	Disks                 *disks.Client
 	Dns                   *dns.Client
 	DomainServices        *domainservices.Client
+	Elastic               *elastic.Client
 	EventGrid             *eventgrid.Client
 	Eventhub              *eventhub.Client
 	Firewall              *firewall.Client
 	Frontdoor             *frontdoor.Client
+	GraphServices         *graphservices.Client
 	HPCCache              *hpccache.Client
 	HSM                   *hsm.Client
 	HDInsight             *hdinsight.Client
 	HealthCare            *healthcare.Client
+	ImageBuilder          *imagebuilder.Client
 	IoTCentral            *iotcentral.Client
 	IoTHub                *iothub.Client
 	IoTTimeSeriesInsights *timeseriesinsights.Client
@@ -179,15 +221,19 @@ type Client struct {
 	ManagementGroups      *managementgroup.Client
 	Maps                  *maps.Client
 	MariaDB               *mariadb.Client
+	Marketplace           *marketplace.Client
 	Media                 *media.Client
 	MixedReality          *mixedreality.Client
+	ModSimWorkbench       *modsimworkbench.Client
 	Monitor               *monitor.Client
+	MonitorPipeline       *monitorpipeline.Client
 	MSI                   *msi.Client
 	MSSQL                 *mssql.Client
 	MySQL                 *mysql.Client
 	NetApp                *netapp.Client
 	Network               *network.Client
 	NotificationHubs      *notificationhub.Client
+	PaymentHSM            *paymenthsm.Client
 	Policy                *policy.Client
 	Portal                *portal.Client
 	Postgres              *postgres.Client
@@ -220,12 +266,17 @@ type Client struct {
 
 // NOTE: it should be possible for this method to become Private once the top level Client's removed
 
+// Build constructs each of the Service Clients. These are intentionally built up-front rather than on first use:
+// they're accessed as fields (e.g. `meta.(*clients.Client).Compute`) throughout the Provider, and constructing
+// them makes no API calls - the expensive part, obtaining a token for each endpoint, is deferred until the
+// first request by the (lazy) authorizers set up in the Client Builder.
 func (client *Client) Build(ctx context.Context, o *common.ClientOptions) error {
 	autorest.Count429AsRetry = false
 	// Disable the Azure SDK for Go's validation since it's unhelpful for our use-case
 	validation.Disabled = true
 
 	client.Features = o.Features
+	client.OperationMetrics = o.OperationMetrics
 	client.StopContext = ctx
 
 	client.AadB2c = aadb2c.NewClient(o)
@@ -241,6 +292,7 @@ func (client *Client) Build(ctx context.Context, o *common.ClientOptions) error
 	client.Automation = automation.NewClient(o)
 	client.AzureStackHCI = azureStackHCI.NewClient(o)
 	client.Batch = batch.NewClient(o)
+	client.Billing = billing.NewClient(o)
 	client.Blueprints = blueprints.NewClient(o)
 	client.Bot = bot.NewClient(o)
 	client.Cdn = cdn.NewClient(o)
@@ -248,6 +300,7 @@ func (client *Client) Build(ctx context.Context, o *common.ClientOptions) error
 	client.Communication = communication.NewClient(o)
 	client.Compute = compute.NewClient(o)
 	client.Consumption = consumption.NewClient(o)
+	client.ContainerApps = containerapps.NewClient(o)
 	client.Containers = containerServices.NewClient(o)
 	client.Cosmos = cosmosdb.NewClient(o)
 	client.CostManagement = costmanagement.NewClient(o)
@@ -259,21 +312,28 @@ func (client *Client) Build(ctx context.Context, o *common.ClientOptions) error
 	client.Datalake = datalake.NewClient(o)
 	client.DataProtection = dataprotection.NewClient(o)
 	client.DataShare = datashare.NewClient(o)
+	client.DefenderEASM = defendereasm.NewClient(o)
 	client.DesktopVirtualization = desktopvirtualization.NewClient(o)
+	client.DevCenter = devcenter.NewClient(o)
+	client.DeviceRegistry = deviceregistry.NewClient(o)
+	client.DevOpsInfrastructure = devopsinfrastructure.NewClient(o)
 	client.DevSpace = devspace.NewClient(o)
 	client.DevTestLabs = devtestlabs.NewClient(o)
 	client.DigitalTwins = digitaltwins.NewClient(o)
 	client.Disks = disks.NewClient(o)
 	client.Dns = dns.NewClient(o)
 	client.DomainServices = domainservices.NewClient(o)
+	client.Elastic = elastic.NewClient(o)
 	client.EventGrid = eventgrid.NewClient(o)
 	client.Eventhub = eventhub.NewClient(o)
 	client.Firewall = firewall.NewClient(o)
 	client.Frontdoor = frontdoor.NewClient(o)
+	client.GraphServices = graphservices.NewClient(o)
 	client.HPCCache = hpccache.NewClient(o)
 	client.HSM = hsm.NewClient(o)
 	client.HDInsight = hdinsight.NewClient(o)
 	client.HealthCare = healthcare.NewClient(o)
+	client.ImageBuilder = imagebuilder.NewClient(o)
 	client.IoTCentral = iotcentral.NewClient(o)
 	client.IoTHub = iothub.NewClient(o)
 	client.IoTTimeSeriesInsights = timeseriesinsights.NewClient(o)
@@ -291,15 +351,19 @@ func (client *Client) Build(ctx context.Context, o *common.ClientOptions) error
 	client.ManagementGroups = managementgroup.NewClient(o)
 	client.Maps = maps.NewClient(o)
 	client.MariaDB = mariadb.NewClient(o)
+	client.Marketplace = marketplace.NewClient(o)
 	client.Media = media.NewClient(o)
 	client.MixedReality = mixedreality.NewClient(o)
+	client.ModSimWorkbench = modsimworkbench.NewClient(o)
 	client.Monitor = monitor.NewClient(o)
+	client.MonitorPipeline = monitorpipeline.NewClient(o)
 	client.MSI = msi.NewClient(o)
 	client.MSSQL = mssql.NewClient(o)
 	client.MySQL = mysql.NewClient(o)
 	client.NetApp = netapp.NewClient(o)
 	client.Network = network.NewClient(o)
 	client.NotificationHubs = notificationhub.NewClient(o)
+	client.PaymentHSM = paymenthsm.NewClient(o)
 	client.Policy = policy.NewClient(o)
 	client.Portal = portal.NewClient(o)
 	client.Postgres = postgres.NewClient(o)
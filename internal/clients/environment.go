@@ -0,0 +1,193 @@
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/authentication"
+)
+
+// azureStackMetadata is the (single) environment returned from the metadata endpoint of an Azure Stack Hub
+type azureStackMetadata struct {
+	GalleryEndpoint string `json:"galleryEndpoint"`
+	GraphEndpoint   string `json:"graphEndpoint"`
+	PortalEndpoint  string `json:"portalEndpoint"`
+	Authentication  struct {
+		LoginEndpoint string   `json:"loginEndpoint"`
+		Audiences     []string `json:"audiences"`
+	} `json:"authentication"`
+}
+
+// EnvironmentFromMetadataHost returns the Cloud Environment with the specified name - the built-in environments
+// (`public`, `usgovernment` and `china`) are used where possible, otherwise the endpoints for the environment are
+// discovered from the metadata endpoint of the `metadataHost`. This supports both the metadata returned by the
+// public and sovereign clouds (which contains multiple environments) and that returned by Azure Stack Hub.
+func EnvironmentFromMetadataHost(ctx context.Context, metadataHost, environmentName string) (*azure.Environment, error) {
+	if metadataHost == "" || isBuiltInEnvironment(environmentName) {
+		return authentication.AzureEnvironmentByNameFromEndpoint(ctx, metadataHost, environmentName)
+	}
+
+	// older versions of Azure Stack Hub only support the original version of the metadata endpoint
+	var errs []string
+	for _, apiVersion := range []string{"2020-06-01", "2015-01-01"} {
+		body, err := retrieveMetadata(ctx, metadataHost, apiVersion)
+		if err != nil {
+			errs = append(errs, err.Error())
+			continue
+		}
+
+		return parseEnvironmentFromMetadata(metadataHost, environmentName, body)
+	}
+
+	return nil, fmt.Errorf("retrieving environments from metadata host %q: %s", metadataHost, strings.Join(errs, "; "))
+}
+
+func retrieveMetadata(ctx context.Context, metadataHost, apiVersion string) ([]byte, error) {
+	uri := fmt.Sprintf("https://%s/metadata/endpoints?api-version=%s", metadataHost, apiVersion)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return nil, fmt.Errorf("building request for %q: %+v", uri, err)
+	}
+
+	client := http.Client{
+		Transport: &http.Transport{
+			Proxy: http.ProxyFromEnvironment,
+		},
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("sending request to %q: %+v", uri, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %d from %q", resp.StatusCode, uri)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading response from %q: %+v", uri, err)
+	}
+
+	return body, nil
+}
+
+func isBuiltInEnvironment(name string) bool {
+	switch strings.ToLower(name) {
+	case "public", "usgovernment", "china":
+		return true
+	}
+
+	return false
+}
+
+func parseEnvironmentFromMetadata(metadataHost, environmentName string, body []byte) (*azure.Environment, error) {
+	trimmed := strings.TrimSpace(string(body))
+
+	// the public and sovereign clouds return a list of environments
+	if strings.HasPrefix(trimmed, "[") {
+		var environments []authentication.Environment
+		if err := json.Unmarshal(body, &environments); err != nil {
+			return nil, fmt.Errorf("parsing environments from metadata host %q: %+v", metadataHost, err)
+		}
+
+		for _, env := range environments {
+			if strings.EqualFold(env.Name, environmentName) {
+				return buildEnvironmentFromMetadata(env)
+			}
+		}
+
+		return nil, fmt.Errorf("unable to locate metadata for environment %q from metadata host %q", environmentName, metadataHost)
+	}
+
+	// whereas Azure Stack Hub returns a single environment, which is scoped to the metadata host
+	var metadata azureStackMetadata
+	if err := json.Unmarshal(body, &metadata); err != nil {
+		return nil, fmt.Errorf("parsing environment from metadata host %q: %+v", metadataHost, err)
+	}
+
+	return buildEnvironmentFromAzureStackMetadata(metadataHost, environmentName, metadata)
+}
+
+func buildEnvironmentFromMetadata(env authentication.Environment) (*azure.Environment, error) {
+	if len(env.Authentication.Audiences) == 0 {
+		return nil, fmt.Errorf("unable to find token audience for environment %q", env.Name)
+	}
+
+	keyVaultEndpoint := fmt.Sprintf("https://%s/", env.Suffixes.KeyVaultDns)
+	return &azure.Environment{
+		Name:                       env.Name,
+		ResourceManagerEndpoint:    env.ResourceManager,
+		ActiveDirectoryEndpoint:    env.Authentication.LoginEndpoint,
+		GraphEndpoint:              env.Graph,
+		GalleryEndpoint:            env.Gallery,
+		BatchManagementEndpoint:    env.Batch,
+		KeyVaultEndpoint:           keyVaultEndpoint,
+		StorageEndpointSuffix:      env.Suffixes.Storage,
+		SQLDatabaseDNSSuffix:       env.Suffixes.SqlServerHostname,
+		KeyVaultDNSSuffix:          env.Suffixes.KeyVaultDns,
+		ContainerRegistryDNSSuffix: env.Suffixes.AcrLoginServer,
+		TokenAudience:              env.Authentication.Audiences[0],
+		ResourceIdentifiers: azure.ResourceIdentifier{
+			// this isn't returned from the metadata endpoint and is the same across all environments
+			Storage:             "https://storage.azure.com/",
+			Graph:               env.Graph,
+			KeyVault:            keyVaultEndpoint,
+			Datalake:            env.ActiveDirectoryDataLake,
+			Batch:               env.Batch,
+			Synapse:             azure.NotAvailable,
+			ServiceBus:          azure.NotAvailable,
+			OperationalInsights: azure.NotAvailable,
+		},
+	}, nil
+}
+
+func buildEnvironmentFromAzureStackMetadata(metadataHost, environmentName string, metadata azureStackMetadata) (*azure.Environment, error) {
+	if metadata.Authentication.LoginEndpoint == "" {
+		return nil, fmt.Errorf("unable to find login endpoint for environment %q from metadata host %q", environmentName, metadataHost)
+	}
+	if len(metadata.Authentication.Audiences) == 0 {
+		return nil, fmt.Errorf("unable to find token audience for environment %q from metadata host %q", environmentName, metadataHost)
+	}
+
+	// the endpoints for Azure Stack Hub are of the form `management.{region}.{fqdn}` - with the data plane endpoints
+	// (such as Storage and Key Vault) being scoped to the same `{region}.{fqdn}` suffix
+	suffix := strings.TrimPrefix(strings.ToLower(metadataHost), "management.")
+	keyVaultDNSSuffix := fmt.Sprintf("vault.%s", suffix)
+	keyVaultEndpoint := fmt.Sprintf("https://%s/", keyVaultDNSSuffix)
+
+	// when using AD FS the login endpoint contains the `adfs` tenant, which is instead specified as the `tenant_id`
+	loginEndpoint := strings.TrimSuffix(metadata.Authentication.LoginEndpoint, "/")
+	if strings.HasSuffix(strings.ToLower(loginEndpoint), "/adfs") {
+		loginEndpoint = loginEndpoint[:len(loginEndpoint)-len("adfs")]
+	} else {
+		loginEndpoint = loginEndpoint + "/"
+	}
+
+	return &azure.Environment{
+		Name:                    environmentName,
+		ResourceManagerEndpoint: fmt.Sprintf("https://%s/", metadataHost),
+		ActiveDirectoryEndpoint: loginEndpoint,
+		GraphEndpoint:           metadata.GraphEndpoint,
+		GalleryEndpoint:         metadata.GalleryEndpoint,
+		KeyVaultEndpoint:        keyVaultEndpoint,
+		StorageEndpointSuffix:   suffix,
+		KeyVaultDNSSuffix:       keyVaultDNSSuffix,
+		TokenAudience:           metadata.Authentication.Audiences[0],
+		ResourceIdentifiers: azure.ResourceIdentifier{
+			Graph:               metadata.GraphEndpoint,
+			KeyVault:            keyVaultEndpoint,
+			Storage:             azure.NotAvailable,
+			Datalake:            azure.NotAvailable,
+			Batch:               azure.NotAvailable,
+			Synapse:             azure.NotAvailable,
+			ServiceBus:          azure.NotAvailable,
+			OperationalInsights: azure.NotAvailable,
+		},
+	}, nil
+}
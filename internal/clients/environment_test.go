@@ -0,0 +1,161 @@
+package clients
+
+import (
+	"testing"
+)
+
+func TestParseEnvironmentFromMetadata(t *testing.T) {
+	cloudMetadata := `[
+  {
+    "portal": "https://portal.azure.com",
+    "authentication": {
+      "loginEndpoint": "https://login.microsoftonline.com/",
+      "audiences": ["https://management.core.windows.net/", "https://management.azure.com/"],
+      "tenant": "common",
+      "identityProvider": "AAD"
+    },
+    "graph": "https://graph.windows.net/",
+    "name": "AzureCloud",
+    "suffixes": {
+      "keyVaultDns": "vault.azure.net",
+      "storage": "core.windows.net"
+    },
+    "resourceManager": "https://management.azure.com/"
+  },
+  {
+    "authentication": {
+      "loginEndpoint": "https://login.example.com/",
+      "audiences": ["https://management.core.example.com/"],
+      "tenant": "common",
+      "identityProvider": "AAD"
+    },
+    "graph": "https://graph.example.com/",
+    "name": "ExampleCloud",
+    "suffixes": {
+      "keyVaultDns": "vault.example.com",
+      "storage": "core.example.com"
+    },
+    "resourceManager": "https://management.example.com/"
+  }
+]`
+
+	azureStackMetadata := `{
+  "galleryEndpoint": "https://providers.local.azurestack.external:30016/",
+  "graphEndpoint": "https://graph.local.azurestack.external/",
+  "portalEndpoint": "https://portal.local.azurestack.external/",
+  "authentication": {
+    "loginEndpoint": "https://login.microsoftonline.com/",
+    "audiences": ["https://management.example.onmicrosoft.com/00000000-0000-0000-0000-000000000000"]
+  }
+}`
+
+	azureStackAdfsMetadata := `{
+  "galleryEndpoint": "https://providers.local.azurestack.external:30016/",
+  "graphEndpoint": "https://graph.local.azurestack.external/",
+  "portalEndpoint": "https://portal.local.azurestack.external/",
+  "authentication": {
+    "loginEndpoint": "https://adfs.local.azurestack.external/adfs/",
+    "audiences": ["https://management.adfs.azurestack.local/00000000-0000-0000-0000-000000000000"]
+  }
+}`
+
+	testData := []struct {
+		name                    string
+		metadataHost            string
+		environmentName         string
+		body                    string
+		expectError             bool
+		resourceManagerEndpoint string
+		activeDirectoryEndpoint string
+		tokenAudience           string
+		keyVaultDNSSuffix       string
+		storageEndpointSuffix   string
+	}{
+		{
+			name:                    "cloud metadata",
+			metadataHost:            "management.example.com",
+			environmentName:         "examplecloud",
+			body:                    cloudMetadata,
+			resourceManagerEndpoint: "https://management.example.com/",
+			activeDirectoryEndpoint: "https://login.example.com/",
+			tokenAudience:           "https://management.core.example.com/",
+			keyVaultDNSSuffix:       "vault.example.com",
+			storageEndpointSuffix:   "core.example.com",
+		},
+		{
+			name:            "cloud metadata missing the environment",
+			metadataHost:    "management.example.com",
+			environmentName: "othercloud",
+			body:            cloudMetadata,
+			expectError:     true,
+		},
+		{
+			name:                    "azure stack using azure active directory",
+			metadataHost:            "management.local.azurestack.external",
+			environmentName:         "AzureStack",
+			body:                    azureStackMetadata,
+			resourceManagerEndpoint: "https://management.local.azurestack.external/",
+			activeDirectoryEndpoint: "https://login.microsoftonline.com/",
+			tokenAudience:           "https://management.example.onmicrosoft.com/00000000-0000-0000-0000-000000000000",
+			keyVaultDNSSuffix:       "vault.local.azurestack.external",
+			storageEndpointSuffix:   "local.azurestack.external",
+		},
+		{
+			name:                    "azure stack using ad fs",
+			metadataHost:            "management.local.azurestack.external",
+			environmentName:         "AzureStack",
+			body:                    azureStackAdfsMetadata,
+			resourceManagerEndpoint: "https://management.local.azurestack.external/",
+			activeDirectoryEndpoint: "https://adfs.local.azurestack.external/",
+			tokenAudience:           "https://management.adfs.azurestack.local/00000000-0000-0000-0000-000000000000",
+			keyVaultDNSSuffix:       "vault.local.azurestack.external",
+			storageEndpointSuffix:   "local.azurestack.external",
+		},
+		{
+			name:            "azure stack missing the audiences",
+			metadataHost:    "management.local.azurestack.external",
+			environmentName: "AzureStack",
+			body:            `{"authentication": {"loginEndpoint": "https://login.microsoftonline.com/"}}`,
+			expectError:     true,
+		},
+		{
+			name:            "invalid json",
+			metadataHost:    "management.example.com",
+			environmentName: "examplecloud",
+			body:            "not json",
+			expectError:     true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		actual, err := parseEnvironmentFromMetadata(v.metadataHost, v.environmentName, []byte(v.body))
+		if err != nil {
+			if v.expectError {
+				continue
+			}
+
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+		if v.expectError {
+			t.Fatalf("expected an error but didn't get one")
+		}
+
+		if actual.ResourceManagerEndpoint != v.resourceManagerEndpoint {
+			t.Fatalf("expected the Resource Manager Endpoint to be %q but got %q", v.resourceManagerEndpoint, actual.ResourceManagerEndpoint)
+		}
+		if actual.ActiveDirectoryEndpoint != v.activeDirectoryEndpoint {
+			t.Fatalf("expected the Active Directory Endpoint to be %q but got %q", v.activeDirectoryEndpoint, actual.ActiveDirectoryEndpoint)
+		}
+		if actual.TokenAudience != v.tokenAudience {
+			t.Fatalf("expected the Token Audience to be %q but got %q", v.tokenAudience, actual.TokenAudience)
+		}
+		if actual.KeyVaultDNSSuffix != v.keyVaultDNSSuffix {
+			t.Fatalf("expected the Key Vault DNS Suffix to be %q but got %q", v.keyVaultDNSSuffix, actual.KeyVaultDNSSuffix)
+		}
+		if actual.StorageEndpointSuffix != v.storageEndpointSuffix {
+			t.Fatalf("expected the Storage Endpoint Suffix to be %q but got %q", v.storageEndpointSuffix, actual.StorageEndpointSuffix)
+		}
+	}
+}
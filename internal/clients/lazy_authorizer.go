@@ -0,0 +1,51 @@
+package clients
+
+import (
+	"net/http"
+	"sync"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+var _ autorest.Authorizer = &lazyAuthorizer{}
+
+// lazyAuthorizer defers obtaining a token for an endpoint until the first request which needs it.
+//
+// Obtaining a token can be expensive (for example when authenticating using the Azure CLI, since
+// this shells out to `az`) - and most configurations only make use of the Resource Manager endpoint,
+// so the authorizers for the other endpoints (Graph, Storage, Synapse etc) are built on first use.
+type lazyAuthorizer struct {
+	build func() (autorest.Authorizer, error)
+
+	once       sync.Once
+	authorizer autorest.Authorizer
+	err        error
+}
+
+func newLazyAuthorizer(build func() (autorest.Authorizer, error)) *lazyAuthorizer {
+	return &lazyAuthorizer{
+		build: build,
+	}
+}
+
+// get returns the underlying Authorizer, building it if this is the first call - this is safe
+// for concurrent use, the Authorizer is only built once and any error is returned to every caller
+func (a *lazyAuthorizer) get() (autorest.Authorizer, error) {
+	a.once.Do(func() {
+		a.authorizer, a.err = a.build()
+	})
+	return a.authorizer, a.err
+}
+
+func (a *lazyAuthorizer) WithAuthorization() autorest.PrepareDecorator {
+	return func(p autorest.Preparer) autorest.Preparer {
+		return autorest.PreparerFunc(func(r *http.Request) (*http.Request, error) {
+			authorizer, err := a.get()
+			if err != nil {
+				return r, err
+			}
+
+			return authorizer.WithAuthorization()(p).Prepare(r)
+		})
+	}
+}
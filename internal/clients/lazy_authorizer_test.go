@@ -0,0 +1,94 @@
+package clients
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+)
+
+func TestLazyAuthorizer_BuiltOnce(t *testing.T) {
+	var calls int32
+	authorizer := newLazyAuthorizer(func() (autorest.Authorizer, error) {
+		atomic.AddInt32(&calls, 1)
+		return autorest.NewAPIKeyAuthorizerWithHeaders(map[string]interface{}{
+			"Authorization": "Bearer abc123",
+		}), nil
+	})
+
+	if calls != 0 {
+		t.Fatalf("expected the authorizer not to be built until it's used but it was built %d times", calls)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			req, err := autorest.Prepare(&http.Request{Header: http.Header{}, URL: &url.URL{}}, authorizer.WithAuthorization())
+			if err != nil {
+				t.Errorf("preparing request: %+v", err)
+				return
+			}
+			if v := req.Header.Get("Authorization"); v != "Bearer abc123" {
+				t.Errorf("expected the `Authorization` header to be %q but got %q", "Bearer abc123", v)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if calls != 1 {
+		t.Fatalf("expected the authorizer to be built once but it was built %d times", calls)
+	}
+}
+
+func TestLazyAuthorizer_Error(t *testing.T) {
+	authorizer := newLazyAuthorizer(func() (autorest.Authorizer, error) {
+		return nil, fmt.Errorf("unable to obtain a token")
+	})
+
+	for i := 0; i < 2; i++ {
+		if _, err := autorest.Prepare(&http.Request{Header: http.Header{}, URL: &url.URL{}}, authorizer.WithAuthorization()); err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+	}
+}
+
+func TestClientBuild_DoesNotObtainTokens(t *testing.T) {
+	var calls int32
+	newAuthorizer := func() autorest.Authorizer {
+		return newLazyAuthorizer(func() (autorest.Authorizer, error) {
+			atomic.AddInt32(&calls, 1)
+			return autorest.NullAuthorizer{}, nil
+		})
+	}
+
+	o := &common.ClientOptions{
+		SubscriptionId:            "00000000-0000-0000-0000-000000000000",
+		Environment:               azure.PublicCloud,
+		ResourceManagerEndpoint:   azure.PublicCloud.ResourceManagerEndpoint,
+		ResourceManagerAuthorizer: newAuthorizer(),
+		GraphAuthorizer:           newAuthorizer(),
+		KeyVaultAuthorizer:        newAuthorizer(),
+		StorageAuthorizer:         newAuthorizer(),
+		SynapseAuthorizer:         newAuthorizer(),
+		BatchManagementAuthorizer: newAuthorizer(),
+	}
+
+	client := Client{}
+	if err := client.Build(context.Background(), o); err != nil {
+		t.Fatalf("building the Client: %+v", err)
+	}
+
+	if calls != 0 {
+		t.Fatalf("expected no tokens to be obtained whilst building the Service Clients but %d were", calls)
+	}
+}
@@ -0,0 +1,108 @@
+package clients
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+)
+
+// ListCache caches the responses of List API calls made by Data Sources for the duration of the Terraform run, so that
+// Data Sources making identical List calls (for example many `azurerm_role_definition` Data Sources looking up roles
+// at the same scope) only call the API once.
+//
+// Caching is opt-in, when disabled (or nil) the API is always called. Concurrent calls for the same key share a single
+// API call and errors are never cached. Values returned from the cache are shared and so mustn't be modified.
+type ListCache struct {
+	enabled bool
+
+	lock    sync.Mutex
+	entries map[string]*listCacheEntry
+}
+
+type listCacheEntry struct {
+	done  chan struct{}
+	value interface{}
+	err   error
+
+	// cancelled is whether the context of the caller making the API call was done before the call completed, in
+	// which case the error is specific to that caller and so other callers waiting on this entry retry the call
+	cancelled bool
+}
+
+// ListCacheKey identifies a List API call
+type ListCacheKey struct {
+	SubscriptionId string
+
+	// API is the API and Operation being called, e.g. `Microsoft.Resources/resources/List`
+	API string
+
+	// Parameters are the values (such as the Resource Group or a filter) which affect the response
+	Parameters []string
+}
+
+func (k ListCacheKey) String() string {
+	return fmt.Sprintf("%s|%s|%s", strings.ToLower(k.SubscriptionId), k.API, strings.Join(k.Parameters, "|"))
+}
+
+func NewListCache(enabled bool) *ListCache {
+	return &ListCache{
+		enabled: enabled,
+		entries: make(map[string]*listCacheEntry),
+	}
+}
+
+// Get returns the cached response for the List API call identified by the key, calling list to retrieve it when it
+// isn't cached (or caching is disabled). Callers waiting on an in-flight call for the same key return when their own
+// context is done, and retry the call using their own context when the in-flight call was cancelled.
+func (c *ListCache) Get(ctx context.Context, key ListCacheKey, list func(ctx context.Context) (interface{}, error)) (interface{}, error) {
+	if c == nil || !c.enabled {
+		return list(ctx)
+	}
+
+	k := key.String()
+	for {
+		c.lock.Lock()
+		entry, ok := c.entries[k]
+		if !ok {
+			break
+		}
+		c.lock.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("waiting for the in-flight List call for %q: %+v", k, ctx.Err())
+		case <-entry.done:
+		}
+
+		if entry.err != nil && entry.cancelled {
+			log.Printf("[DEBUG] The in-flight List call for %q was cancelled - retrying", k)
+			continue
+		}
+		if entry.err == nil {
+			log.Printf("[DEBUG] Using the cached response for %q", k)
+		}
+		return entry.value, entry.err
+	}
+
+	// the lock is still held from the loop above
+	entry := &listCacheEntry{
+		done: make(chan struct{}),
+	}
+	c.entries[k] = entry
+	c.lock.Unlock()
+
+	entry.value, entry.err = list(ctx)
+	if entry.err != nil {
+		entry.cancelled = ctx.Err() != nil
+
+		// errors aren't cached, so the next call for this key retries the API call
+		c.lock.Lock()
+		delete(c.entries, k)
+		c.lock.Unlock()
+	}
+	close(entry.done)
+
+	return entry.value, entry.err
+}
@@ -0,0 +1,168 @@
+package clients
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestListCache_Disabled(t *testing.T) {
+	var calls int32
+	list := func(ctx context.Context) (interface{}, error) {
+		atomic.AddInt32(&calls, 1)
+		return []string{"a"}, nil
+	}
+	key := ListCacheKey{
+		SubscriptionId: "12345678-1234-9876-4563-123456789012",
+		API:            "Microsoft.Resources/resources/List",
+	}
+
+	for _, cache := range []*ListCache{nil, NewListCache(false)} {
+		atomic.StoreInt32(&calls, 0)
+		for i := 0; i < 3; i++ {
+			if _, err := cache.Get(context.TODO(), key, list); err != nil {
+				t.Fatalf("listing: %+v", err)
+			}
+		}
+
+		if calls != 3 {
+			t.Fatalf("expected the API to be called 3 times when caching is disabled but got %d", calls)
+		}
+	}
+}
+
+func TestListCache_Enabled(t *testing.T) {
+	cache := NewListCache(true)
+
+	var calls int32
+	list := func(value string) func(ctx context.Context) (interface{}, error) {
+		return func(ctx context.Context) (interface{}, error) {
+			atomic.AddInt32(&calls, 1)
+			return value, nil
+		}
+	}
+	first := ListCacheKey{
+		SubscriptionId: "12345678-1234-9876-4563-123456789012",
+		API:            "Microsoft.Resources/resources/List",
+		Parameters:     []string{"resourceGroup eq 'first'"},
+	}
+	second := ListCacheKey{
+		SubscriptionId: "12345678-1234-9876-4563-123456789012",
+		API:            "Microsoft.Resources/resources/List",
+		Parameters:     []string{"resourceGroup eq 'second'"},
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for _, v := range []struct {
+				key      ListCacheKey
+				expected string
+			}{
+				{key: first, expected: "first"},
+				{key: second, expected: "second"},
+			} {
+				actual, err := cache.Get(context.TODO(), v.key, list(v.expected))
+				if err != nil {
+					t.Errorf("listing: %+v", err)
+					return
+				}
+				if actual.(string) != v.expected {
+					t.Errorf("expected %q but got %q", v.expected, actual)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if calls != 2 {
+		t.Fatalf("expected the API to be called once per key but got %d calls", calls)
+	}
+}
+
+func TestListCache_ErrorsAreNotCached(t *testing.T) {
+	cache := NewListCache(true)
+	key := ListCacheKey{
+		SubscriptionId: "12345678-1234-9876-4563-123456789012",
+		API:            "Microsoft.Authorization/roleDefinitions/List",
+	}
+
+	if _, err := cache.Get(context.TODO(), key, func(ctx context.Context) (interface{}, error) {
+		return nil, fmt.Errorf("throttled")
+	}); err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+
+	actual, err := cache.Get(context.TODO(), key, func(ctx context.Context) (interface{}, error) {
+		return "value", nil
+	})
+	if err != nil {
+		t.Fatalf("expected the API to be called again after an error but got: %+v", err)
+	}
+	if actual.(string) != "value" {
+		t.Fatalf("expected %q but got %q", "value", actual)
+	}
+}
+
+func TestListCache_WaitersUseTheirOwnContext(t *testing.T) {
+	cache := NewListCache(true)
+	key := ListCacheKey{
+		SubscriptionId: "12345678-1234-9876-4563-123456789012",
+		API:            "Microsoft.Resources/resources/List",
+	}
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	firstCtx, cancelFirst := context.WithCancel(context.TODO())
+	firstErr := make(chan error, 1)
+	go func() {
+		_, err := cache.Get(firstCtx, key, func(ctx context.Context) (interface{}, error) {
+			close(started)
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-release:
+				return "first", nil
+			}
+		})
+		firstErr <- err
+	}()
+	<-started
+
+	// a waiter whose own context is done returns without waiting on the in-flight call
+	waiterCtx, cancelWaiter := context.WithTimeout(context.TODO(), 10*time.Millisecond)
+	defer cancelWaiter()
+	if _, err := cache.Get(waiterCtx, key, func(ctx context.Context) (interface{}, error) {
+		t.Fatalf("expected the waiter not to call the API")
+		return nil, nil
+	}); err == nil {
+		t.Fatalf("expected an error when the waiter's context was done but didn't get one")
+	}
+
+	// a waiter shouldn't receive the error from the cancelled in-flight call, but instead retry it
+	waiterResult := make(chan interface{}, 1)
+	go func() {
+		actual, err := cache.Get(context.TODO(), key, func(ctx context.Context) (interface{}, error) {
+			return "second", nil
+		})
+		if err != nil {
+			t.Errorf("expected the waiter to retry the cancelled call but got: %+v", err)
+		}
+		waiterResult <- actual
+	}()
+
+	cancelFirst()
+	if err := <-firstErr; err == nil {
+		t.Fatalf("expected the first caller to receive its own cancellation but didn't get an error")
+	}
+	if actual := <-waiterResult; actual != "second" {
+		t.Fatalf("expected %q but got %v", "second", actual)
+	}
+	close(release)
+}
@@ -0,0 +1,170 @@
+package clients
+
+import (
+	"context"
+	"log"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// longRunningOperation is the subset of azure.FutureAPI used to poll a Long Running Operation, which is implemented
+// by the Futures returned from the Azure SDK
+type longRunningOperation interface {
+	DoneWithContext(ctx context.Context, sender autorest.Sender) (bool, error)
+	GetPollingDelay() (time.Duration, bool)
+	PollingURL() string
+	Response() *http.Response
+	Status() string
+}
+
+// sharedLongRunningOperationPoller is used for all Long Running Operations within the Provider, so that polls for the
+// same operation can be de-duplicated across resources
+var sharedLongRunningOperationPoller = newLongRunningOperationPoller()
+
+// WaitForCompletion polls the Long Running Operation until it completes, the context is cancelled or the polling
+// duration of the client is exceeded - and should be used in place of the Future's WaitForCompletionRef.
+//
+// Unlike WaitForCompletionRef, a random jitter is added to the delay between polls, so that large plans containing
+// many Long Running Operations don't poll Resource Manager in lock-step - concurrent waits on the same operation are
+// de-duplicated and progress is logged periodically. The Retry-After header returned by the API is always honoured.
+func WaitForCompletion(ctx context.Context, future longRunningOperation, client autorest.Client) error {
+	return sharedLongRunningOperationPoller.wait(ctx, future, client)
+}
+
+type longRunningOperationPoller struct {
+	// jitter returns the (randomised) delay to use before polling after the specified delay
+	jitter func(delay time.Duration) time.Duration
+
+	// progressInterval is the minimum duration between progress log messages for an operation
+	progressInterval time.Duration
+
+	lock     sync.Mutex
+	inFlight map[string]chan struct{}
+}
+
+func newLongRunningOperationPoller() *longRunningOperationPoller {
+	random := rand.New(rand.NewSource(time.Now().UnixNano()))
+	randomLock := &sync.Mutex{}
+
+	return &longRunningOperationPoller{
+		jitter: func(delay time.Duration) time.Duration {
+			// up to an additional 20% of the delay is added, the delay is never shortened since this may be
+			// the Retry-After value returned by the API
+			if delay <= 0 {
+				return delay
+			}
+			randomLock.Lock()
+			defer randomLock.Unlock()
+			return delay + time.Duration(random.Int63n(int64(delay)/5+1))
+		},
+		progressInterval: time.Minute,
+		inFlight:         make(map[string]chan struct{}),
+	}
+}
+
+func (p *longRunningOperationPoller) wait(ctx context.Context, future longRunningOperation, client autorest.Client) (err error) {
+	// if the provided context already has a deadline don't override it
+	if _, hasDeadline := ctx.Deadline(); !hasDeadline && client.PollingDuration != 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, client.PollingDuration)
+		defer cancel()
+	}
+
+	operation := redactedPollingURL(future.PollingURL())
+	if pollingUrl := future.PollingURL(); pollingUrl != "" {
+		release, err := p.acquire(ctx, pollingUrl, operation)
+		if err != nil {
+			return autorest.NewErrorWithError(err, "LongRunningOperation", "WaitForCompletion", future.Response(), "context has been cancelled")
+		}
+		defer release()
+	}
+
+	started := time.Now()
+	lastProgress := started
+
+	// if the initial response has a Retry-After, wait for the specified amount of time before starting to poll
+	if delay, ok := future.GetPollingDelay(); ok {
+		if !autorest.DelayForBackoff(p.jitter(delay), 0, ctx.Done()) {
+			return ctx.Err()
+		}
+	}
+
+	done, err := future.DoneWithContext(ctx, client)
+	for attempts := 0; !done; done, err = future.DoneWithContext(ctx, client) {
+		if attempts >= client.RetryAttempts {
+			return autorest.NewErrorWithError(err, "LongRunningOperation", "WaitForCompletion", future.Response(), "the number of retries has been exceeded")
+		}
+
+		// the delay attempt is zero when polling succeeded, so that DelayForBackoff doesn't back-off exponentially
+		var delayAttempt int
+		var delay time.Duration
+		if err == nil {
+			var ok bool
+			if delay, ok = future.GetPollingDelay(); !ok {
+				delay = client.PollingDelay
+			}
+
+			if now := time.Now(); now.Sub(lastProgress) >= p.progressInterval {
+				log.Printf("[DEBUG] Long Running Operation %q is still in progress after %s (Status %q)", operation, now.Sub(started).Round(time.Second), future.Status())
+				lastProgress = now
+			}
+		} else {
+			log.Printf("[DEBUG] Polling Long Running Operation %q: %+v", operation, err)
+			delayAttempt = attempts
+			delay = client.RetryDuration
+			attempts++
+		}
+
+		if !autorest.DelayForBackoff(p.jitter(delay), delayAttempt, ctx.Done()) {
+			return autorest.NewErrorWithError(ctx.Err(), "LongRunningOperation", "WaitForCompletion", future.Response(), "context has been cancelled")
+		}
+	}
+
+	log.Printf("[DEBUG] Long Running Operation %q completed after %s (Status %q)", operation, time.Since(started).Round(time.Second), future.Status())
+	return err
+}
+
+// acquire waits until no other poll is in progress for the polling URL and then marks it as in progress - returning a
+// func which must be called once polling has completed. When the operation is already being polled, the result of
+// that poll is reused by polling once it has completed, rather than polling the same operation concurrently.
+func (p *longRunningOperationPoller) acquire(ctx context.Context, pollingUrl, operation string) (func(), error) {
+	for {
+		p.lock.Lock()
+		existing, ok := p.inFlight[pollingUrl]
+		if !ok {
+			done := make(chan struct{})
+			p.inFlight[pollingUrl] = done
+			p.lock.Unlock()
+
+			return func() {
+				p.lock.Lock()
+				delete(p.inFlight, pollingUrl)
+				p.lock.Unlock()
+				close(done)
+			}, nil
+		}
+		p.lock.Unlock()
+
+		log.Printf("[DEBUG] Long Running Operation %q is already being polled - waiting for it to complete", operation)
+		select {
+		case <-existing:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// redactedPollingURL returns the polling URL without the query string, which can contain a signature
+func redactedPollingURL(input string) string {
+	parsed, err := url.Parse(input)
+	if err != nil {
+		return ""
+	}
+	parsed.RawQuery = ""
+	return parsed.String()
+}
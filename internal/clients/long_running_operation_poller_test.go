@@ -0,0 +1,226 @@
+package clients
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+type fakeLongRunningOperation struct {
+	pollingUrl string
+	retryAfter *time.Duration
+
+	// pollsUntilDone is the number of polls after which the operation is completed
+	pollsUntilDone int
+	// errorsUntilDone is the number of polls which return an error
+	errorsUntilDone int
+
+	// polled, when set, is notified for every poll
+	polled chan struct{}
+	// proceed, when set, must be signalled before each poll returns
+	proceed chan struct{}
+
+	lock  sync.Mutex
+	polls int
+}
+
+func (f *fakeLongRunningOperation) DoneWithContext(_ context.Context, _ autorest.Sender) (bool, error) {
+	f.lock.Lock()
+	f.polls++
+	polls := f.polls
+	f.lock.Unlock()
+
+	if f.polled != nil {
+		f.polled <- struct{}{}
+	}
+	if f.proceed != nil {
+		<-f.proceed
+	}
+
+	if polls <= f.errorsUntilDone {
+		return false, fmt.Errorf("polling failed")
+	}
+	return polls >= f.pollsUntilDone, nil
+}
+
+func (f *fakeLongRunningOperation) GetPollingDelay() (time.Duration, bool) {
+	if f.retryAfter == nil {
+		return 0, false
+	}
+	return *f.retryAfter, true
+}
+
+func (f *fakeLongRunningOperation) PollingURL() string {
+	return f.pollingUrl
+}
+
+func (f *fakeLongRunningOperation) Response() *http.Response {
+	return nil
+}
+
+func (f *fakeLongRunningOperation) Status() string {
+	return "InProgress"
+}
+
+func (f *fakeLongRunningOperation) pollCount() int {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return f.polls
+}
+
+func testLongRunningOperationPoller(delays chan<- time.Duration) *longRunningOperationPoller {
+	poller := newLongRunningOperationPoller()
+	poller.jitter = func(delay time.Duration) time.Duration {
+		if delays != nil {
+			delays <- delay
+		}
+		return time.Millisecond
+	}
+	return poller
+}
+
+func testLongRunningOperationClient() autorest.Client {
+	return autorest.Client{
+		PollingDelay:  30 * time.Second,
+		RetryAttempts: 3,
+		RetryDuration: time.Millisecond,
+	}
+}
+
+func TestLongRunningOperationPoller_PollingDelay(t *testing.T) {
+	retryAfter := 5 * time.Second
+	testData := []struct {
+		name       string
+		retryAfter *time.Duration
+		expected   time.Duration
+	}{
+		{
+			name:     "client polling delay",
+			expected: 30 * time.Second,
+		},
+		{
+			name:       "retry after",
+			retryAfter: &retryAfter,
+			expected:   retryAfter,
+		},
+	}
+
+	for _, v := range testData {
+		t.Run(v.name, func(t *testing.T) {
+			delays := make(chan time.Duration, 10)
+			future := &fakeLongRunningOperation{
+				pollingUrl:     "https://management.azure.com/operations/1",
+				retryAfter:     v.retryAfter,
+				pollsUntilDone: 3,
+			}
+
+			if err := testLongRunningOperationPoller(delays).wait(context.TODO(), future, testLongRunningOperationClient()); err != nil {
+				t.Fatalf("waiting for completion: %+v", err)
+			}
+			close(delays)
+
+			if actual := future.pollCount(); actual != 3 {
+				t.Fatalf("expected 3 polls but got %d", actual)
+			}
+			for delay := range delays {
+				if delay != v.expected {
+					t.Fatalf("expected the delay between polls to be %s but got %s", v.expected, delay)
+				}
+			}
+		})
+	}
+}
+
+func TestLongRunningOperationPoller_Jitter(t *testing.T) {
+	poller := newLongRunningOperationPoller()
+
+	delay := 10 * time.Second
+	for i := 0; i < 100; i++ {
+		actual := poller.jitter(delay)
+		if actual < delay || actual > delay+delay/5 {
+			t.Fatalf("expected the jittered delay to be between %s and %s but got %s", delay, delay+delay/5, actual)
+		}
+	}
+
+	if actual := poller.jitter(0); actual != 0 {
+		t.Fatalf("expected no jitter to be added to a zero delay but got %s", actual)
+	}
+}
+
+func TestLongRunningOperationPoller_RetriesExceeded(t *testing.T) {
+	future := &fakeLongRunningOperation{
+		pollingUrl:      "https://management.azure.com/operations/1",
+		pollsUntilDone:  10,
+		errorsUntilDone: 10,
+	}
+
+	if err := testLongRunningOperationPoller(nil).wait(context.TODO(), future, testLongRunningOperationClient()); err == nil {
+		t.Fatalf("expected an error when the number of retries was exceeded but didn't get one")
+	}
+	if actual := future.pollCount(); actual != 4 {
+		t.Fatalf("expected 4 polls but got %d", actual)
+	}
+}
+
+func TestLongRunningOperationPoller_DeduplicatesPolls(t *testing.T) {
+	poller := testLongRunningOperationPoller(nil)
+	client := testLongRunningOperationClient()
+
+	first := &fakeLongRunningOperation{
+		pollingUrl:     "https://management.azure.com/operations/1?sig=abc123",
+		pollsUntilDone: 3,
+		polled:         make(chan struct{}),
+		proceed:        make(chan struct{}),
+	}
+	second := &fakeLongRunningOperation{
+		pollingUrl:     first.pollingUrl,
+		pollsUntilDone: 1,
+	}
+
+	errs := make(chan error, 2)
+	go func() {
+		errs <- poller.wait(context.TODO(), first, client)
+	}()
+
+	// wait until the first operation is being polled before waiting on the same operation
+	<-first.polled
+	go func() {
+		errs <- poller.wait(context.TODO(), second, client)
+	}()
+
+	// the second wait mustn't poll whilst the first is in progress
+	first.proceed <- struct{}{}
+	for i := 1; i < first.pollsUntilDone; i++ {
+		<-first.polled
+		if actual := second.pollCount(); actual != 0 {
+			t.Fatalf("expected the second operation not to be polled whilst the first was in progress but got %d polls", actual)
+		}
+		first.proceed <- struct{}{}
+	}
+
+	for i := 0; i < 2; i++ {
+		if err := <-errs; err != nil {
+			t.Fatalf("waiting for completion: %+v", err)
+		}
+	}
+
+	if actual := second.pollCount(); actual != 1 {
+		t.Fatalf("expected the second operation to be polled once but got %d polls", actual)
+	}
+	if len(poller.inFlight) != 0 {
+		t.Fatalf("expected no operations to be in-flight but got %d", len(poller.inFlight))
+	}
+}
+
+func TestRedactedPollingURL(t *testing.T) {
+	actual := redactedPollingURL("https://management.azure.com/subscriptions/1/providers/Microsoft.Compute/locations/westeurope/operations/2?api-version=2021-11-01&sig=abc123")
+	expected := "https://management.azure.com/subscriptions/1/providers/Microsoft.Compute/locations/westeurope/operations/2"
+	if actual != expected {
+		t.Fatalf("expected %q but got %q", expected, actual)
+	}
+}
@@ -4,12 +4,15 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/hashicorp/go-azure-helpers/sender"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/meta"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/httplogging"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/operationmetrics"
 	"github.com/hashicorp/terraform-provider-azurerm/version"
 )
 
@@ -36,6 +39,19 @@ type ClientOptions struct {
 	Features                    features.UserFeatures
 	StorageUseAzureAD           bool
 
+	// MaxRetries and RetryBaseDelay override the default retry policy of the clients when set
+	MaxRetries     int
+	RetryBaseDelay time.Duration
+
+	// OperationMetrics is nil unless the user has opted into recording Operation Metrics
+	OperationMetrics *operationmetrics.Recorder
+
+	// HTTPLogger is nil unless the user has opted into structured HTTP Logging
+	HTTPLogger *httplogging.Logger
+
+	// CustomRequestHeaders are sent on every request, in addition to the headers set by the Provider
+	CustomRequestHeaders map[string]string
+
 	// Some Dataplane APIs require a token scoped for a specific endpoint
 	TokenFunc func(endpoint string) (autorest.Authorizer, error)
 }
@@ -44,14 +60,43 @@ func (o ClientOptions) ConfigureClient(c *autorest.Client, authorizer autorest.A
 	setUserAgent(c, o.TerraformVersion, o.PartnerId, o.DisableTerraformPartnerID)
 
 	c.Authorizer = authorizer
-	c.Sender = sender.BuildSender("AzureRM")
+	// structured HTTP Logging replaces the request/response dump included in the debug log
+	if o.HTTPLogger != nil {
+		c.Sender = o.HTTPLogger.BuildSender()
+	} else {
+		c.Sender = sender.BuildSender("AzureRM")
+	}
+	c.Sender = withRequestIDsInErrors(c.Sender)
+	if o.OperationMetrics != nil {
+		c.Sender = o.OperationMetrics.WrapSender(c.Sender)
+	}
 	c.SkipResourceProviderRegistration = o.SkipProviderReg
+
+	// the retry policy is used both by the track1 SDKs and by `azure.DoRetryWithRegistration` (which the
+	// embedded SDKs use), which retry throttled (429) and transient errors - honouring any `Retry-After` header
+	// and otherwise backing off exponentially from the base delay
+	if o.MaxRetries > 0 {
+		c.RetryAttempts = o.MaxRetries
+	}
+	if o.RetryBaseDelay > 0 {
+		c.RetryDuration = o.RetryBaseDelay
+	}
+
+	inspectors := make([]autorest.PrepareDecorator, 0)
 	if !o.DisableCorrelationRequestID {
 		id := o.CustomCorrelationRequestID
 		if id == "" {
 			id = correlationRequestID()
 		}
-		c.RequestInspector = withCorrelationRequestID(id)
+		inspectors = append(inspectors, withCorrelationRequestID(id))
+	}
+	if len(o.CustomRequestHeaders) > 0 {
+		inspectors = append(inspectors, withRequestHeaders(o.CustomRequestHeaders))
+	}
+	if len(inspectors) > 0 {
+		c.RequestInspector = func(p autorest.Preparer) autorest.Preparer {
+			return autorest.DecoratePreparer(p, inspectors...)
+		}
 	}
 }
 
@@ -0,0 +1,57 @@
+package common
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// reservedRequestHeaders are the headers set by the Provider (or the SDKs) which can't be overridden using custom
+// request headers
+var reservedRequestHeaders = []string{
+	"Authorization",
+	"Content-Length",
+	"Content-Type",
+	"Host",
+	"User-Agent",
+	HeaderCorrelationRequestID,
+	"x-ms-authorization-auxiliary",
+}
+
+// ValidateRequestHeaders validates that the custom request headers don't override any of the headers set by the
+// Provider - such as the Authorization or Correlation Request ID headers
+func ValidateRequestHeaders(input map[string]string) error {
+	for name := range input {
+		if strings.TrimSpace(name) == "" {
+			return fmt.Errorf("the name of a request header cannot be empty")
+		}
+
+		for _, reserved := range reservedRequestHeaders {
+			if strings.EqualFold(name, reserved) {
+				return fmt.Errorf("the request header %q is set by the Provider and cannot be overridden", name)
+			}
+		}
+	}
+
+	return nil
+}
+
+// withRequestHeaders returns a PrepareDecorator that adds the custom request headers to each request
+func withRequestHeaders(headers map[string]string) autorest.PrepareDecorator {
+	return func(p autorest.Preparer) autorest.Preparer {
+		return autorest.PreparerFunc(func(r *http.Request) (*http.Request, error) {
+			r, err := p.Prepare(r)
+			if err == nil {
+				if r.Header == nil {
+					r.Header = make(http.Header)
+				}
+				for name, value := range headers {
+					r.Header.Set(http.CanonicalHeaderKey(name), value)
+				}
+			}
+			return r, err
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package common
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestValidateRequestHeaders(t *testing.T) {
+	testData := []struct {
+		input map[string]string
+		valid bool
+	}{
+		{
+			input: map[string]string{},
+			valid: true,
+		},
+		{
+			input: map[string]string{"x-example-header": "value"},
+			valid: true,
+		},
+		{
+			input: map[string]string{" ": "value"},
+			valid: false,
+		},
+		{
+			input: map[string]string{"authorization": "Bearer abc123"},
+			valid: false,
+		},
+		{
+			input: map[string]string{"X-Ms-Correlation-Request-Id": "value"},
+			valid: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %+v", v.input)
+
+		err := ValidateRequestHeaders(v.input)
+		if v.valid && err != nil {
+			t.Fatalf("expected %+v to be valid but got: %+v", v.input, err)
+		}
+		if !v.valid && err == nil {
+			t.Fatalf("expected %+v to be invalid but it was valid", v.input)
+		}
+	}
+}
+
+func TestWithRequestHeaders(t *testing.T) {
+	headers := map[string]string{
+		"x-example-header": "value",
+	}
+	req, _ := autorest.Prepare(&http.Request{}, withRequestHeaders(headers))
+
+	if actual := req.Header.Get("X-Example-Header"); actual != "value" {
+		t.Fatalf("expected the header to be %q but got %q", "value", actual)
+	}
+}
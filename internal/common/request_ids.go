@@ -0,0 +1,105 @@
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// HeaderRequestID is the header containing the ID assigned to the request by the API
+const HeaderRequestID = "x-ms-request-id"
+
+// withRequestIDsInErrors returns a Sender which appends the Request ID and Correlation Request ID returned by the API
+// to the message within the body of any error response - so that these are included in the error surfaced to the
+// user, which allows support cases with Microsoft to be correlated to a Terraform run.
+func withRequestIDsInErrors(s autorest.Sender) autorest.Sender {
+	return autorest.SenderFunc(func(req *http.Request) (*http.Response, error) {
+		resp, err := s.Do(req)
+		if resp == nil || resp.Body == nil || resp.StatusCode < http.StatusBadRequest {
+			return resp, err
+		}
+
+		suffix := requestIDsSuffix(req, resp)
+		if suffix == "" {
+			return resp, err
+		}
+
+		body, readErr := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if readErr != nil {
+			resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+			return resp, err
+		}
+
+		if updated, ok := appendToErrorMessage(body, suffix); ok {
+			body = updated
+			resp.ContentLength = int64(len(body))
+			resp.Header.Del("Content-Length")
+		}
+		resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+		return resp, err
+	})
+}
+
+func requestIDsSuffix(req *http.Request, resp *http.Response) string {
+	ids := make([]string, 0)
+	if v := resp.Header.Get(HeaderRequestID); v != "" {
+		ids = append(ids, fmt.Sprintf("Request ID: %s", v))
+	}
+
+	correlationRequestId := resp.Header.Get(HeaderCorrelationRequestID)
+	if correlationRequestId == "" && req != nil {
+		correlationRequestId = req.Header.Get(HeaderCorrelationRequestID)
+	}
+	if correlationRequestId != "" {
+		ids = append(ids, fmt.Sprintf("Correlation Request ID: %s", correlationRequestId))
+	}
+
+	if len(ids) == 0 {
+		return ""
+	}
+	return fmt.Sprintf(" (%s)", strings.Join(ids, ", "))
+}
+
+// appendToErrorMessage appends the suffix to the message within a JSON error response, which is either nested
+// within an `error` object (as returned by Resource Manager) or at the top-level - returning false if the body
+// doesn't contain a message
+func appendToErrorMessage(body []byte, suffix string) ([]byte, bool) {
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	// retain numbers as-is, rather than converting these to a float
+	decoder.UseNumber()
+
+	var parsed map[string]interface{}
+	if err := decoder.Decode(&parsed); err != nil || parsed == nil {
+		return nil, false
+	}
+
+	target := parsed
+	if v, ok := parsed["error"].(map[string]interface{}); ok {
+		target = v
+	}
+
+	key := ""
+	for _, k := range []string{"message", "Message"} {
+		if _, ok := target[k].(string); ok {
+			key = k
+			break
+		}
+	}
+	if key == "" {
+		return nil, false
+	}
+	target[key] = target[key].(string) + suffix
+
+	output, err := json.Marshal(parsed)
+	if err != nil {
+		return nil, false
+	}
+	return output, true
+}
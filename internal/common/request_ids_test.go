@@ -0,0 +1,81 @@
+package common
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestAppendToErrorMessage(t *testing.T) {
+	testData := []struct {
+		input    string
+		expected string
+		ok       bool
+	}{
+		{
+			input:    `{"error":{"code":"Conflict","message":"Something went wrong"}}`,
+			expected: `{"error":{"code":"Conflict","message":"Something went wrong (suffix)"}}`,
+			ok:       true,
+		},
+		{
+			input:    `{"Code":"NotFound","Message":"Not Found","Retries":3}`,
+			expected: `{"Code":"NotFound","Message":"Not Found (suffix)","Retries":3}`,
+			ok:       true,
+		},
+		{
+			input: `{"error":{"code":"Conflict"}}`,
+			ok:    false,
+		},
+		{
+			input: `Internal Server Error`,
+			ok:    false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.input)
+
+		actual, ok := appendToErrorMessage([]byte(v.input), " (suffix)")
+		if ok != v.ok {
+			t.Fatalf("expected ok to be %t but got %t", v.ok, ok)
+		}
+		if ok && string(actual) != v.expected {
+			t.Fatalf("expected %q but got %q", v.expected, string(actual))
+		}
+	}
+}
+
+func TestWithRequestIDsInErrors(t *testing.T) {
+	sender := withRequestIDsInErrors(autorest.SenderFunc(func(req *http.Request) (*http.Response, error) {
+		header := make(http.Header)
+		header.Set(HeaderRequestID, "request-id")
+		return &http.Response{
+			StatusCode: http.StatusConflict,
+			Header:     header,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(`{"error":{"message":"Something went wrong"}}`)),
+		}, nil
+	}))
+
+	req, _ := http.NewRequest(http.MethodGet, "https://management.azure.com", nil)
+	req.Header.Set(HeaderCorrelationRequestID, "correlation-id")
+
+	resp, err := sender.Do(req)
+	if err != nil {
+		t.Fatalf("sending request: %+v", err)
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("reading body: %+v", err)
+	}
+
+	expected := `{"error":{"message":"Something went wrong (Request ID: request-id, Correlation Request ID: correlation-id)"}}`
+	if string(body) != expected {
+		t.Fatalf("expected %q but got %q", expected, string(body))
+	}
+	if resp.ContentLength != int64(len(expected)) {
+		t.Fatalf("expected the Content Length to be %d but got %d", len(expected), resp.ContentLength)
+	}
+}
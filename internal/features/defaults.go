@@ -6,6 +6,10 @@ func Default() UserFeatures {
 		ApiManagement: ApiManagementFeatures{
 			PurgeSoftDeleteOnDestroy: false,
 		},
+		AppConfiguration: AppConfigurationFeatures{
+			PurgeSoftDeleteOnDestroy: true,
+			RecoverSoftDeleted:       true,
+		},
 		CognitiveAccount: CognitiveAccountFeatures{
 			PurgeSoftDeleteOnDestroy: true,
 		},
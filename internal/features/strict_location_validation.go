@@ -0,0 +1,21 @@
+package features
+
+import (
+	"os"
+	"strings"
+)
+
+// StrictLocationValidationEnabled returns whether or not the feature for Strict Location Validation is
+// enabled.
+//
+// When enabled, Location names are validated at plan time against the list of Azure Locations known to
+// the Provider (rather than only those returned from the Azure MetaData Service, which is best-effort) -
+// meaning that a typo in a Location name is surfaced during `terraform plan` rather than at apply time.
+//
+// This is disabled by default, and can be enabled by setting the Environment Variable
+// `ARM_PROVIDER_STRICT_LOCATION_VALIDATION` to `true`. Since the configuration is validated before the
+// Provider is configured, `strict_location_validation` in the Provider block is instead checked when
+// the plan is computed.
+func StrictLocationValidationEnabled() bool {
+	return strings.EqualFold(os.Getenv("ARM_PROVIDER_STRICT_LOCATION_VALIDATION"), "true")
+}
@@ -2,6 +2,7 @@ package features
 
 type UserFeatures struct {
 	ApiManagement          ApiManagementFeatures
+	AppConfiguration       AppConfigurationFeatures
 	CognitiveAccount       CognitiveAccountFeatures
 	VirtualMachine         VirtualMachineFeatures
 	VirtualMachineScaleSet VirtualMachineScaleSetFeatures
@@ -58,3 +59,8 @@ type ResourceGroupFeatures struct {
 type ApiManagementFeatures struct {
 	PurgeSoftDeleteOnDestroy bool
 }
+
+type AppConfigurationFeatures struct {
+	PurgeSoftDeleteOnDestroy bool
+	RecoverSoftDeleted       bool
+}
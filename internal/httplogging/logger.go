@@ -0,0 +1,65 @@
+package httplogging
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"sync"
+)
+
+// Logger writes each API request (and its response) made by the Provider to a file as a line of JSON, with
+// any secrets (such as the Authorization header, keys and connection strings) redacted.
+//
+// This is intended as an alternative to the request/response dump included in the debug log (via `TF_LOG`),
+// which logs everything (including the bodies of every request) as unstructured text.
+type Logger struct {
+	path string
+
+	mu   sync.Mutex
+	file *os.File
+}
+
+var (
+	loggers     = map[string]*Logger{}
+	loggersLock sync.Mutex
+)
+
+// ForPath returns the Logger writing to the specified path - which is shared between all instances of the
+// Provider running within this process, so that the entries written by each aren't interleaved.
+func ForPath(path string) (*Logger, error) {
+	loggersLock.Lock()
+	defer loggersLock.Unlock()
+
+	if existing, ok := loggers[path]; ok {
+		return existing, nil
+	}
+
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("opening %q: %+v", path, err)
+	}
+
+	l := &Logger{
+		path: path,
+		file: file,
+	}
+	loggers[path] = l
+	return l, nil
+}
+
+func (l *Logger) write(entry Entry) {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		log.Printf("[WARN] serializing HTTP Log entry for %s %s: %+v", entry.Method, entry.URL, err)
+		return
+	}
+	line = append(line, '\n')
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if _, err := l.file.Write(line); err != nil {
+		log.Printf("[WARN] writing HTTP Log entry to %q: %+v", l.path, err)
+	}
+}
@@ -0,0 +1,29 @@
+package httplogging
+
+// Entry is a single API request (and its response) written to the log as a line of JSON
+type Entry struct {
+	Timestamp            string `json:"timestamp"`
+	CorrelationRequestID string `json:"correlation_request_id,omitempty"`
+	RequestID            string `json:"request_id,omitempty"`
+
+	Method          string  `json:"method"`
+	URL             string  `json:"url"`
+	StatusCode      int     `json:"status_code,omitempty"`
+	DurationSeconds float64 `json:"duration_seconds"`
+	Error           string  `json:"error,omitempty"`
+
+	// Throttling contains the `Retry-After` and `x-ms-ratelimit-*` headers returned by the API, if any
+	Throttling map[string]string `json:"throttling,omitempty"`
+
+	Request  Message  `json:"request"`
+	Response *Message `json:"response,omitempty"`
+}
+
+// Message is the (redacted) headers and body of a request or response
+type Message struct {
+	Headers map[string]string `json:"headers,omitempty"`
+	Body    string            `json:"body,omitempty"`
+
+	// BodyTruncated specifies whether the Body was larger than the limit and so has been truncated
+	BodyTruncated bool `json:"body_truncated,omitempty"`
+}
@@ -0,0 +1,199 @@
+package httplogging
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+const redacted = "REDACTED"
+
+var (
+	// sensitiveKeySubstrings are the (lower-cased) substrings of JSON keys, header names and query string
+	// parameters whose values should be redacted
+	sensitiveKeySubstrings = []string{
+		"authorization",
+		"connectionstring",
+		"cookie",
+		"credential",
+		"password",
+		"secret",
+		"signature",
+		"token",
+	}
+
+	// sensitiveQueryParameters are query string parameters containing a secret, which don't otherwise match
+	sensitiveQueryParameters = map[string]struct{}{
+		"code": {},
+		"sig":  {},
+	}
+
+	// keyVaultHostSuffixes are the (lower-cased) host suffixes of the Key Vault and Managed HSM data plane APIs, where
+	// the request and response bodies contain the values of Secrets, Keys and Certificates
+	keyVaultHostSuffixes = []string{
+		".vault.azure.net",
+		".vault.azure.cn",
+		".vault.usgovcloudapi.net",
+		".vault.microsoftazure.de",
+		".managedhsm.azure.net",
+		".managedhsm.azure.cn",
+		".managedhsm.usgovcloudapi.net",
+	}
+
+	// connectionStringValueRegex matches the secret within a connection string (or form encoded body), for
+	// example `AccountKey=abc123` or `client_secret=abc123`
+	connectionStringValueRegex = regexp.MustCompile(`(?i)((?:AccountKey|SharedAccessKey|SharedAccessSignature|Password|Pwd|client_secret|client_assertion|refresh_token|access_token|sig)\s*=\s*)[^;&"'\s]+`)
+
+	// jsonValueRegex matches string values of sensitive JSON keys, which is used when the body can't be
+	// parsed as JSON (for example because it's been truncated)
+	jsonValueRegex = regexp.MustCompile(`(?i)("(?:[^"]*(?:connectionstring|credential|password|secret|signature|token)[^"]*|[^"]*keys?)"\s*:\s*)"(?:[^"\\]|\\.)*"`)
+)
+
+// isSensitiveKey returns whether the value of the JSON key, header or query string parameter should be redacted
+func isSensitiveKey(input string) bool {
+	key := strings.ToLower(input)
+	if strings.HasSuffix(key, "key") || strings.HasSuffix(key, "keys") {
+		return true
+	}
+
+	for _, v := range sensitiveKeySubstrings {
+		if strings.Contains(key, v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isSensitiveRequest returns whether the entire request and response bodies should be redacted, since these contain
+// secrets in fields which can't be identified by name - which are requests to the Key Vault data plane (e.g. the
+// `value` of a Secret) and `list*` actions (e.g. `listKeys`, `listCredentials` or `config/appsettings/list`)
+func isSensitiveRequest(req *http.Request) bool {
+	if req == nil || req.URL == nil {
+		return false
+	}
+
+	host := strings.ToLower(req.URL.Hostname())
+	for _, v := range keyVaultHostSuffixes {
+		if strings.HasSuffix(host, v) {
+			return true
+		}
+	}
+
+	if req.Method == http.MethodPost {
+		segments := strings.Split(strings.TrimSuffix(req.URL.Path, "/"), "/")
+		action := strings.ToLower(segments[len(segments)-1])
+		return strings.HasPrefix(action, "list")
+	}
+
+	return false
+}
+
+func redactHeaders(input http.Header) map[string]string {
+	if len(input) == 0 {
+		return nil
+	}
+
+	output := make(map[string]string, len(input))
+	for name, values := range input {
+		if isSensitiveKey(name) {
+			output[name] = redacted
+			continue
+		}
+
+		output[name] = strings.Join(values, ", ")
+	}
+	return output
+}
+
+func redactURL(input *url.URL) string {
+	if input == nil {
+		return ""
+	}
+
+	query := input.Query()
+	if len(query) == 0 {
+		return input.String()
+	}
+
+	for name := range query {
+		_, sensitive := sensitiveQueryParameters[strings.ToLower(name)]
+		if sensitive || isSensitiveKey(name) {
+			query.Set(name, redacted)
+		}
+	}
+
+	output := *input
+	output.RawQuery = query.Encode()
+	return output.String()
+}
+
+// redactBody returns the body with any secrets redacted - bodies which aren't text (for example the contents of
+// a Storage Blob) are omitted
+func redactBody(contentType string, body []byte, truncated bool) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	contentType = strings.ToLower(contentType)
+	isJson := strings.Contains(contentType, "json")
+	isText := isJson || contentType == "" || strings.Contains(contentType, "text") || strings.Contains(contentType, "xml") || strings.Contains(contentType, "form")
+	if !isText {
+		return ""
+	}
+
+	if isJson && !truncated {
+		if v, ok := redactJsonBody(body); ok {
+			return v
+		}
+	}
+
+	output := jsonValueRegex.ReplaceAllString(string(body), `${1}"`+redacted+`"`)
+	return connectionStringValueRegex.ReplaceAllString(output, "${1}"+redacted)
+}
+
+func redactJsonBody(body []byte) (string, bool) {
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	// retain numbers as-is, rather than converting these to a float
+	decoder.UseNumber()
+
+	var parsed interface{}
+	if err := decoder.Decode(&parsed); err != nil {
+		return "", false
+	}
+
+	output, err := json.Marshal(redactJsonValue(parsed))
+	if err != nil {
+		return "", false
+	}
+
+	return string(output), true
+}
+
+func redactJsonValue(input interface{}) interface{} {
+	switch v := input.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if isSensitiveKey(key) && value != nil {
+				v[key] = redacted
+				continue
+			}
+			v[key] = redactJsonValue(value)
+		}
+		return v
+
+	case []interface{}:
+		for i := range v {
+			v[i] = redactJsonValue(v[i])
+		}
+		return v
+
+	case string:
+		return connectionStringValueRegex.ReplaceAllString(v, "${1}"+redacted)
+	}
+
+	return input
+}
@@ -0,0 +1,133 @@
+package httplogging
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// maxBodyLength is the maximum number of bytes of each request/response body which is logged
+const maxBodyLength = 32 * 1024
+
+// BuildSender returns an autorest.Sender which sends requests using an HTTP Client (configured in the same
+// manner as the default sender, but without the request/response dump) and logs each request to the Logger.
+func (l *Logger) BuildSender() autorest.Sender {
+	return l.WrapSender(&http.Client{
+		Transport: &http.Transport{
+			Proxy: http.ProxyFromEnvironment,
+		},
+	})
+}
+
+// WrapSender returns an autorest.Sender which logs each request sent by `sender` (and its response) to the Logger
+func (l *Logger) WrapSender(sender autorest.Sender) autorest.Sender {
+	return l.wrapSender(sender, true)
+}
+
+// WrapSenderWithoutBodies returns an autorest.Sender which logs each request sent by `sender` (and its response) to
+// the Logger, omitting the request and response bodies - which is used for requests where the body is always a secret
+func (l *Logger) WrapSenderWithoutBodies(sender autorest.Sender) autorest.Sender {
+	return l.wrapSender(sender, false)
+}
+
+func (l *Logger) wrapSender(sender autorest.Sender, logBodies bool) autorest.Sender {
+	return autorest.SenderFunc(func(req *http.Request) (*http.Response, error) {
+		start := time.Now()
+		redactEntireBody := isSensitiveRequest(req)
+		bodyForLog := func(contentType string, body []byte, truncated bool) string {
+			if redactEntireBody && len(body) > 0 {
+				return redacted
+			}
+			return redactBody(contentType, body, truncated)
+		}
+
+		entry := Entry{
+			Timestamp:            start.UTC().Format(time.RFC3339Nano),
+			CorrelationRequestID: req.Header.Get("x-ms-correlation-request-id"),
+			Method:               req.Method,
+			URL:                  redactURL(req.URL),
+		}
+
+		var requestBody []byte
+		var requestBodyTruncated bool
+		if req.Body != nil && logBodies {
+			requestBody, requestBodyTruncated, req.Body = peekBody(req.Body)
+		}
+		entry.Request = Message{
+			Headers:       redactHeaders(req.Header),
+			Body:          bodyForLog(req.Header.Get("Content-Type"), requestBody, requestBodyTruncated),
+			BodyTruncated: requestBodyTruncated,
+		}
+
+		resp, err := sender.Do(req)
+		entry.DurationSeconds = time.Since(start).Seconds()
+		if err != nil {
+			entry.Error = err.Error()
+		}
+
+		if resp != nil {
+			entry.StatusCode = resp.StatusCode
+			entry.RequestID = resp.Header.Get("x-ms-request-id")
+			entry.Throttling = throttlingHeaders(resp.Header)
+
+			var responseBody []byte
+			var responseBodyTruncated bool
+			if resp.Body != nil && logBodies {
+				responseBody, responseBodyTruncated, resp.Body = peekBody(resp.Body)
+			}
+			entry.Response = &Message{
+				Headers:       redactHeaders(resp.Header),
+				Body:          bodyForLog(resp.Header.Get("Content-Type"), responseBody, responseBodyTruncated),
+				BodyTruncated: responseBodyTruncated,
+			}
+		}
+
+		l.write(entry)
+		return resp, err
+	})
+}
+
+// peekBody returns up to `maxBodyLength` bytes of the body (and whether it was truncated) along with a
+// replacement for the body, which returns the full contents of the original body when read
+func peekBody(body io.ReadCloser) ([]byte, bool, io.ReadCloser) {
+	prefix, err := ioutil.ReadAll(io.LimitReader(body, maxBodyLength+1))
+	replacement := readCloser{
+		Reader: io.MultiReader(bytes.NewReader(prefix), body),
+		Closer: body,
+	}
+	if err != nil {
+		return nil, false, replacement
+	}
+
+	if len(prefix) > maxBodyLength {
+		return prefix[:maxBodyLength], true, replacement
+	}
+	return prefix, false, replacement
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+// throttlingHeaders returns the headers returned by the API which relate to throttling, which are the
+// `Retry-After` header and the `x-ms-ratelimit-remaining-*` headers
+func throttlingHeaders(input http.Header) map[string]string {
+	output := make(map[string]string)
+	for name, values := range input {
+		key := strings.ToLower(name)
+		if key == "retry-after" || strings.HasPrefix(key, "x-ms-ratelimit-") {
+			output[key] = strings.Join(values, ", ")
+		}
+	}
+
+	if len(output) == 0 {
+		return nil
+	}
+	return output
+}
@@ -0,0 +1,129 @@
+package location
+
+import "sort"
+
+// knownLocations is a map of the canonical name (e.g. `westeurope`) to the Display Name (e.g. `West Europe`)
+// of the Azure Locations across the Public, China and US Government clouds which are known to the Provider.
+var knownLocations = map[string]string{
+	// Public
+	"australiacentral":   "Australia Central",
+	"australiacentral2":  "Australia Central 2",
+	"australiaeast":      "Australia East",
+	"australiasoutheast": "Australia Southeast",
+	"austriaeast":        "Austria East",
+	"belgiumcentral":     "Belgium Central",
+	"brazilsouth":        "Brazil South",
+	"brazilsoutheast":    "Brazil Southeast",
+	"canadacentral":      "Canada Central",
+	"canadaeast":         "Canada East",
+	"centralindia":       "Central India",
+	"centralus":          "Central US",
+	"centraluseuap":      "Central US EUAP",
+	"chilecentral":       "Chile Central",
+	"eastasia":           "East Asia",
+	"eastus":             "East US",
+	"eastus2":            "East US 2",
+	"eastus2euap":        "East US 2 EUAP",
+	"francecentral":      "France Central",
+	"francesouth":        "France South",
+	"germanynorth":       "Germany North",
+	"germanywestcentral": "Germany West Central",
+	"indonesiacentral":   "Indonesia Central",
+	"israelcentral":      "Israel Central",
+	"italynorth":         "Italy North",
+	"japaneast":          "Japan East",
+	"japanwest":          "Japan West",
+	"jioindiacentral":    "Jio India Central",
+	"jioindiawest":       "Jio India West",
+	"koreacentral":       "Korea Central",
+	"koreasouth":         "Korea South",
+	"malaysiawest":       "Malaysia West",
+	"mexicocentral":      "Mexico Central",
+	"newzealandnorth":    "New Zealand North",
+	"northcentralus":     "North Central US",
+	"northeurope":        "North Europe",
+	"norwayeast":         "Norway East",
+	"norwaywest":         "Norway West",
+	"polandcentral":      "Poland Central",
+	"qatarcentral":       "Qatar Central",
+	"southafricanorth":   "South Africa North",
+	"southafricawest":    "South Africa West",
+	"southcentralus":     "South Central US",
+	"southeastasia":      "Southeast Asia",
+	"southindia":         "South India",
+	"spaincentral":       "Spain Central",
+	"swedencentral":      "Sweden Central",
+	"swedensouth":        "Sweden South",
+	"switzerlandnorth":   "Switzerland North",
+	"switzerlandwest":    "Switzerland West",
+	"uaecentral":         "UAE Central",
+	"uaenorth":           "UAE North",
+	"uksouth":            "UK South",
+	"ukwest":             "UK West",
+	"westcentralus":      "West Central US",
+	"westeurope":         "West Europe",
+	"westindia":          "West India",
+	"westus":             "West US",
+	"westus2":            "West US 2",
+	"westus3":            "West US 3",
+
+	// China
+	"chinaeast":   "China East",
+	"chinaeast2":  "China East 2",
+	"chinaeast3":  "China East 3",
+	"chinanorth":  "China North",
+	"chinanorth2": "China North 2",
+	"chinanorth3": "China North 3",
+
+	// US Government
+	"usdodcentral":  "US DoD Central",
+	"usdodeast":     "US DoD East",
+	"usgovarizona":  "US Gov Arizona",
+	"usgoviowa":     "US Gov Iowa",
+	"usgovtexas":    "US Gov Texas",
+	"usgovvirginia": "US Gov Virginia",
+}
+
+// alternateLocationNames contains the alternate (canonical) names which the Azure APIs are known
+// to return for some Locations, mapped to the canonical name used by the Provider
+var alternateLocationNames = map[string]string{
+	"indiacentral": "centralindia",
+	"indiasouth":   "southindia",
+	"indiawest":    "westindia",
+}
+
+// DisplayName returns the human readable Display Name (e.g. `West Europe`) for the specified Location, which
+// can be either a Display Name or a canonical name (e.g. `westeurope`). When the Location isn't known to the
+// Provider, the input is returned as-is.
+func DisplayName(input string) string {
+	if displayName, ok := knownLocations[canonicalName(input)]; ok {
+		return displayName
+	}
+
+	return input
+}
+
+// IsKnown returns whether the specified Location (either the Display Name or the canonical name)
+// is known to the Provider
+func IsKnown(input string) bool {
+	_, ok := knownLocations[canonicalName(input)]
+	return ok
+}
+
+// Known returns the canonical names of the Azure Locations which are known to the Provider
+func Known() []string {
+	out := make([]string, 0, len(knownLocations))
+	for k := range knownLocations {
+		out = append(out, k)
+	}
+	sort.Strings(out)
+	return out
+}
+
+func canonicalName(input string) string {
+	normalized := Normalize(input)
+	if v, ok := alternateLocationNames[normalized]; ok {
+		return v
+	}
+	return normalized
+}
@@ -7,7 +7,9 @@ import (
 )
 
 func Schema() *pluginsdk.Schema {
-	return commonschema.Location()
+	s := commonschema.Location()
+	s.ValidateFunc = EnhancedValidate
+	return s
 }
 
 func SchemaOptional() *pluginsdk.Schema {
@@ -19,7 +21,9 @@ func SchemaComputed() *pluginsdk.Schema {
 }
 
 func SchemaWithoutForceNew() *pluginsdk.Schema {
-	return commonschema.LocationWithoutForceNew()
+	s := commonschema.LocationWithoutForceNew()
+	s.ValidateFunc = EnhancedValidate
+	return s
 }
 
 func DiffSuppressFunc(v, old, new string, d *pluginsdk.ResourceData) bool {
@@ -0,0 +1,43 @@
+package location
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+)
+
+// EnhancedValidate validates the specified Location name - when Strict Location Validation is enabled this
+// validates the Location against the list of Locations known to the Provider, otherwise this falls back to
+// the best-effort validation against the Locations returned from the Azure MetaData Service.
+func EnhancedValidate(i interface{}, k string) ([]string, []error) {
+	if features.StrictLocationValidationEnabled() {
+		return StrictValidate(i, k)
+	}
+
+	return location.EnhancedValidate(i, k)
+}
+
+// StrictValidate validates that the specified Location is either the Display Name (e.g. `West Europe`)
+// or the canonical name (e.g. `westeurope`) of an Azure Location known to the Provider
+func StrictValidate(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %q to be string", k)}
+	}
+
+	normalized := Normalize(v)
+	if normalized == "" {
+		return nil, []error{fmt.Errorf("%q must not be empty", k)}
+	}
+
+	// Some resources use a location named "global".
+	if normalized == "global" || IsKnown(normalized) {
+		return nil, nil
+	}
+
+	return nil, []error{
+		fmt.Errorf("%q was not found in the list of supported Azure Locations: %q", v, strings.Join(Known(), ",")),
+	}
+}
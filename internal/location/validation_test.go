@@ -0,0 +1,103 @@
+package location
+
+import (
+	"os"
+	"testing"
+)
+
+func TestStrictValidate(t *testing.T) {
+	cases := []struct {
+		input string
+		valid bool
+	}{
+		{
+			input: "",
+			valid: false,
+		},
+		{
+			input: "westeurope",
+			valid: true,
+		},
+		{
+			input: "West Europe",
+			valid: true,
+		},
+		{
+			input: "WEST EUROPE",
+			valid: true,
+		},
+		{
+			input: "West Eurpoe",
+			valid: false,
+		},
+		{
+			input: "indiasouth",
+			valid: true,
+		},
+		{
+			input: "global",
+			valid: true,
+		},
+		{
+			input: "usgovvirginia",
+			valid: true,
+		},
+	}
+
+	for _, v := range cases {
+		_, errors := StrictValidate(v.input, "location")
+		actual := len(errors) == 0
+		if v.valid != actual {
+			t.Fatalf("Expected %t for %q but got %t", v.valid, v.input, actual)
+		}
+	}
+}
+
+func TestEnhancedValidateStrictModeEnabled(t *testing.T) {
+	oldValue := os.Getenv("ARM_PROVIDER_STRICT_LOCATION_VALIDATION")
+	os.Setenv("ARM_PROVIDER_STRICT_LOCATION_VALIDATION", "true")
+	defer os.Setenv("ARM_PROVIDER_STRICT_LOCATION_VALIDATION", oldValue)
+
+	if _, errors := EnhancedValidate("West Eurpoe", "location"); len(errors) == 0 {
+		t.Fatalf("Expected an error for an unknown location but didn't get one")
+	}
+
+	if _, errors := EnhancedValidate("West Europe", "location"); len(errors) > 0 {
+		t.Fatalf("Expected no errors for a known location but got %+v", errors)
+	}
+}
+
+func TestDisplayName(t *testing.T) {
+	cases := []struct {
+		input    string
+		expected string
+	}{
+		{
+			input:    "westeurope",
+			expected: "West Europe",
+		},
+		{
+			input:    "West Europe",
+			expected: "West Europe",
+		},
+		{
+			input:    "southeastasia",
+			expected: "Southeast Asia",
+		},
+		{
+			input:    "indiacentral",
+			expected: "Central India",
+		},
+		{
+			input:    "somewhereelse",
+			expected: "somewhereelse",
+		},
+	}
+
+	for _, v := range cases {
+		actual := DisplayName(v.input)
+		if v.expected != actual {
+			t.Fatalf("Expected %q but got %q", v.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package networkrules
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// Expand expands the `network_rules` block into the intermediate NetworkRules type, returning
+// nil when the block isn't specified
+func (o Options) Expand(input []interface{}) *NetworkRules {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	output := NetworkRules{
+		DefaultAction:       raw["default_action"].(string),
+		Bypass:              make([]string, 0),
+		IPRules:             make([]string, 0),
+		VirtualNetworkRules: make([]VirtualNetworkRule, 0),
+	}
+
+	if v, ok := raw["bypass"]; ok && v != nil {
+		for _, item := range v.(*pluginsdk.Set).List() {
+			output.Bypass = append(output.Bypass, item.(string))
+		}
+	}
+
+	for _, item := range raw["ip_rules"].(*pluginsdk.Set).List() {
+		output.IPRules = append(output.IPRules, item.(string))
+	}
+
+	if o.VirtualNetworkRules {
+		for _, item := range raw["virtual_network_rules"].(*pluginsdk.Set).List() {
+			v := item.(map[string]interface{})
+			output.VirtualNetworkRules = append(output.VirtualNetworkRules, VirtualNetworkRule{
+				SubnetId:                         v["subnet_id"].(string),
+				IgnoreMissingVnetServiceEndpoint: v["ignore_missing_vnet_service_endpoint"].(bool),
+			})
+		}
+	} else {
+		for _, item := range raw["virtual_network_subnet_ids"].(*pluginsdk.Set).List() {
+			output.VirtualNetworkRules = append(output.VirtualNetworkRules, VirtualNetworkRule{
+				SubnetId: item.(string),
+			})
+		}
+	}
+
+	return &output
+}
@@ -0,0 +1,67 @@
+package networkrules
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+func TestExpand(t *testing.T) {
+	options := Options{
+		BypassValues: []string{"AzureServices", "None"},
+	}
+	testData := []struct {
+		Name     string
+		Input    map[string]interface{}
+		Expected *NetworkRules
+	}{
+		{
+			Name: "Default Action Only",
+			Input: map[string]interface{}{
+				"default_action":             DefaultActionAllow,
+				"bypass":                     pluginsdk.NewSet(pluginsdk.HashString, []interface{}{}),
+				"ip_rules":                   pluginsdk.NewSet(pluginsdk.HashString, []interface{}{}),
+				"virtual_network_subnet_ids": pluginsdk.NewSet(pluginsdk.HashString, []interface{}{}),
+			},
+			Expected: &NetworkRules{
+				DefaultAction:       DefaultActionAllow,
+				Bypass:              []string{},
+				IPRules:             []string{},
+				VirtualNetworkRules: []VirtualNetworkRule{},
+			},
+		},
+		{
+			Name: "All Fields",
+			Input: map[string]interface{}{
+				"default_action":             DefaultActionDeny,
+				"bypass":                     pluginsdk.NewSet(pluginsdk.HashString, []interface{}{"AzureServices"}),
+				"ip_rules":                   pluginsdk.NewSet(pluginsdk.HashString, []interface{}{"10.0.0.0/24"}),
+				"virtual_network_subnet_ids": pluginsdk.NewSet(pluginsdk.HashString, []interface{}{"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1"}),
+			},
+			Expected: &NetworkRules{
+				DefaultAction: DefaultActionDeny,
+				Bypass:        []string{"AzureServices"},
+				IPRules:       []string{"10.0.0.0/24"},
+				VirtualNetworkRules: []VirtualNetworkRule{
+					{
+						SubnetId: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1",
+					},
+				},
+			},
+		},
+	}
+
+	if actual := options.Expand([]interface{}{}); actual != nil {
+		t.Fatalf("Expected nil when the block isn't specified but got %+v", actual)
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		actual := options.Expand([]interface{}{v.Input})
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
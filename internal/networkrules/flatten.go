@@ -0,0 +1,56 @@
+package networkrules
+
+import (
+	networkParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+)
+
+// Flatten flattens the intermediate NetworkRules type into the `network_rules` block. Any
+// Resource-specific fields defined in `AdditionalFields` need to be set on the returned
+// item by the caller.
+func (o Options) Flatten(input *NetworkRules) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	output := map[string]interface{}{
+		"default_action": input.DefaultAction,
+	}
+
+	if len(o.BypassValues) > 0 {
+		bypass := make([]interface{}, 0)
+		for _, v := range input.Bypass {
+			bypass = append(bypass, v)
+		}
+		output["bypass"] = bypass
+	}
+
+	ipRules := make([]interface{}, 0)
+	for _, v := range input.IPRules {
+		ipRules = append(ipRules, v)
+	}
+	output["ip_rules"] = ipRules
+
+	subnetIds := make([]interface{}, 0)
+	virtualNetworkRules := make([]interface{}, 0)
+	for _, v := range input.VirtualNetworkRules {
+		// the casing of the Subnet ID returned from the API differs by service, so normalize this
+		subnetId := v.SubnetId
+		if id, err := networkParse.SubnetIDInsensitively(v.SubnetId); err == nil {
+			subnetId = id.ID()
+		}
+
+		subnetIds = append(subnetIds, subnetId)
+		virtualNetworkRules = append(virtualNetworkRules, map[string]interface{}{
+			"subnet_id":                            subnetId,
+			"ignore_missing_vnet_service_endpoint": v.IgnoreMissingVnetServiceEndpoint,
+		})
+	}
+
+	if o.VirtualNetworkRules {
+		output["virtual_network_rules"] = virtualNetworkRules
+	} else {
+		output["virtual_network_subnet_ids"] = subnetIds
+	}
+
+	return []interface{}{output}
+}
@@ -0,0 +1,82 @@
+package networkrules
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFlatten(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Options  Options
+		Input    *NetworkRules
+		Expected []interface{}
+	}{
+		{
+			Name:     "Nil",
+			Input:    nil,
+			Expected: []interface{}{},
+		},
+		{
+			Name: "Subnet IDs without Bypass",
+			Input: &NetworkRules{
+				DefaultAction: DefaultActionDeny,
+				Bypass:        []string{"AzureServices"},
+				IPRules:       []string{"1.2.3.4"},
+				VirtualNetworkRules: []VirtualNetworkRule{
+					{
+						SubnetId: "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/group1/providers/Microsoft.Network/virtualnetworks/network1/subnets/subnet1",
+					},
+				},
+			},
+			Expected: []interface{}{
+				map[string]interface{}{
+					"default_action": DefaultActionDeny,
+					"ip_rules":       []interface{}{"1.2.3.4"},
+					"virtual_network_subnet_ids": []interface{}{
+						"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1",
+					},
+				},
+			},
+		},
+		{
+			Name: "Virtual Network Rules with Bypass",
+			Options: Options{
+				BypassValues:        []string{"AzureServices", "None"},
+				VirtualNetworkRules: true,
+			},
+			Input: &NetworkRules{
+				DefaultAction: DefaultActionAllow,
+				Bypass:        []string{"AzureServices"},
+				VirtualNetworkRules: []VirtualNetworkRule{
+					{
+						SubnetId:                         "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1",
+						IgnoreMissingVnetServiceEndpoint: true,
+					},
+				},
+			},
+			Expected: []interface{}{
+				map[string]interface{}{
+					"default_action": DefaultActionAllow,
+					"bypass":         []interface{}{"AzureServices"},
+					"ip_rules":       []interface{}{},
+					"virtual_network_rules": []interface{}{
+						map[string]interface{}{
+							"subnet_id":                            "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1",
+							"ignore_missing_vnet_service_endpoint": true,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		actual := v.Options.Flatten(v.Input)
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
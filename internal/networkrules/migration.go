@@ -0,0 +1,36 @@
+package networkrules
+
+// MigrateState moves the Network Rules block defined at `oldKey` within the raw state to the
+// `network_rules` key used by this package, converting `bypass` from a single value into a set
+// when required.
+func MigrateState(rawState map[string]interface{}, oldKey string) map[string]interface{} {
+	old, ok := rawState[oldKey]
+	if !ok {
+		return rawState
+	}
+	delete(rawState, oldKey)
+
+	items, ok := old.([]interface{})
+	if !ok {
+		rawState["network_rules"] = old
+		return rawState
+	}
+
+	for _, item := range items {
+		v, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if bypass, ok := v["bypass"].(string); ok {
+			if bypass == "" {
+				v["bypass"] = []interface{}{}
+			} else {
+				v["bypass"] = []interface{}{bypass}
+			}
+		}
+	}
+	rawState["network_rules"] = items
+
+	return rawState
+}
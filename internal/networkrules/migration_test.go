@@ -0,0 +1,90 @@
+package networkrules
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMigrateState(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    map[string]interface{}
+		Expected map[string]interface{}
+	}{
+		{
+			Name: "Not Present",
+			Input: map[string]interface{}{
+				"name": "example",
+			},
+			Expected: map[string]interface{}{
+				"name": "example",
+			},
+		},
+		{
+			Name: "Single Bypass Value",
+			Input: map[string]interface{}{
+				"network_acls": []interface{}{
+					map[string]interface{}{
+						"bypass":         "AzureServices",
+						"default_action": "Deny",
+						"ip_rules":       []interface{}{"1.2.3.4"},
+					},
+				},
+			},
+			Expected: map[string]interface{}{
+				"network_rules": []interface{}{
+					map[string]interface{}{
+						"bypass":         []interface{}{"AzureServices"},
+						"default_action": "Deny",
+						"ip_rules":       []interface{}{"1.2.3.4"},
+					},
+				},
+			},
+		},
+		{
+			Name: "Empty Bypass Value",
+			Input: map[string]interface{}{
+				"network_acls": []interface{}{
+					map[string]interface{}{
+						"bypass":         "",
+						"default_action": "Allow",
+					},
+				},
+			},
+			Expected: map[string]interface{}{
+				"network_rules": []interface{}{
+					map[string]interface{}{
+						"bypass":         []interface{}{},
+						"default_action": "Allow",
+					},
+				},
+			},
+		},
+		{
+			Name: "No Bypass",
+			Input: map[string]interface{}{
+				"network_acls": []interface{}{
+					map[string]interface{}{
+						"default_action": "Allow",
+					},
+				},
+			},
+			Expected: map[string]interface{}{
+				"network_rules": []interface{}{
+					map[string]interface{}{
+						"default_action": "Allow",
+					},
+				},
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		actual := MigrateState(v.Input, "network_acls")
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package networkrules
+
+import (
+	networkParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// NetworkRules is the intermediate representation of the Network Rules which is used
+// to map between the Schema and the type used within each Azure SDK Service Package
+type NetworkRules struct {
+	DefaultAction       string
+	Bypass              []string
+	IPRules             []string
+	VirtualNetworkRules []VirtualNetworkRule
+}
+
+type VirtualNetworkRule struct {
+	SubnetId                         string
+	IgnoreMissingVnetServiceEndpoint bool
+}
+
+// Options configures which fields are exposed within the `network_rules` block for a given Resource
+type Options struct {
+	// BypassValues are the Azure Services which can be allowed to bypass these rules,
+	// the `bypass` field is only exposed when these are specified.
+	BypassValues []string
+
+	// SingleBypassValue specifies that only a single value can be specified for `bypass`
+	SingleBypassValue bool
+
+	// IPRuleValidateFunc overrides the validation used for `ip_rules`, which by default
+	// allows either an IPv4 Address or CIDR.
+	IPRuleValidateFunc func(interface{}, string) ([]string, []error)
+
+	// VirtualNetworkRules exposes `virtual_network_rules` blocks, allowing the Service Endpoint
+	// check to be ignored, in place of the `virtual_network_subnet_ids` field.
+	VirtualNetworkRules bool
+
+	// AdditionalFields are any Resource-specific fields which should be exposed within the block
+	AdditionalFields map[string]*pluginsdk.Schema
+}
+
+// VirtualNetworkNames returns the unique names of the Virtual Networks containing the Subnets
+// referenced in these rules, which should be locked on since modifications in the networking
+// stack are exclusive.
+func (r *NetworkRules) VirtualNetworkNames() ([]string, error) {
+	names := make([]string, 0)
+	if r == nil {
+		return names, nil
+	}
+
+	for _, rule := range r.VirtualNetworkRules {
+		id, err := networkParse.SubnetIDInsensitively(rule.SubnetId)
+		if err != nil {
+			return nil, err
+		}
+
+		if !utils.SliceContainsValue(names, id.VirtualNetworkName) {
+			names = append(names, id.VirtualNetworkName)
+		}
+	}
+
+	return names, nil
+}
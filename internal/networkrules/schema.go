@@ -0,0 +1,122 @@
+package networkrules
+
+import (
+	"fmt"
+
+	commonValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/set"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+const (
+	DefaultActionAllow = "Allow"
+	DefaultActionDeny  = "Deny"
+)
+
+// PublicNetworkAccessEnabledSchema returns the Schema for the `public_network_access_enabled` field
+func PublicNetworkAccessEnabledSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeBool,
+		Optional: true,
+		Default:  true,
+	}
+}
+
+// Schema returns the Schema for the `network_rules` block
+func (o Options) Schema() *pluginsdk.Schema {
+	ipRuleValidateFunc := o.IPRuleValidateFunc
+	if ipRuleValidateFunc == nil {
+		ipRuleValidateFunc = validation.Any(
+			commonValidate.IPv4Address,
+			commonValidate.CIDR,
+		)
+	}
+
+	s := map[string]*pluginsdk.Schema{
+		"default_action": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				DefaultActionAllow,
+				DefaultActionDeny,
+			}, false),
+		},
+
+		"ip_rules": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: ipRuleValidateFunc,
+			},
+			Set: set.HashIPv4AddressOrCIDR,
+		},
+	}
+
+	if len(o.BypassValues) > 0 {
+		bypass := &pluginsdk.Schema{
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringInSlice(o.BypassValues, false),
+			},
+		}
+		if o.SingleBypassValue {
+			bypass.MaxItems = 1
+		}
+		s["bypass"] = bypass
+	}
+
+	if o.VirtualNetworkRules {
+		s["virtual_network_rules"] = &pluginsdk.Schema{
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"subnet_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: networkValidate.SubnetID,
+					},
+
+					"ignore_missing_vnet_service_endpoint": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+				},
+			},
+		}
+	} else {
+		s["virtual_network_subnet_ids"] = &pluginsdk.Schema{
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: networkValidate.SubnetID,
+			},
+			Set: set.HashStringIgnoreCase,
+		}
+	}
+
+	for k, v := range o.AdditionalFields {
+		if _, exists := s[k]; exists {
+			panic(fmt.Sprintf("the additional field %q conflicts with a field within the `network_rules` block", k))
+		}
+		s[k] = v
+	}
+
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: s,
+		},
+	}
+}
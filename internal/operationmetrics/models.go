@@ -0,0 +1,39 @@
+package operationmetrics
+
+// Summary is the JSON document written to disk by the Recorder
+type Summary struct {
+	GeneratedAt string `json:"generated_at"`
+
+	// ResourceTypes contains the totals for each Resource Type, ordered slowest-first
+	ResourceTypes []ResourceTypeSummary `json:"resource_types"`
+
+	// Resources contains the operations performed against each resource, ordered slowest-first
+	Resources []ResourceSummary `json:"resources"`
+
+	// UnattributedRetries is the number of retried API requests which couldn't be matched to
+	// the operation of a resource, for example those made whilst configuring the Provider
+	UnattributedRetries int `json:"unattributed_retries"`
+}
+
+type ResourceTypeSummary struct {
+	ResourceType         string  `json:"resource_type"`
+	Operations           int     `json:"operations"`
+	TotalDurationSeconds float64 `json:"total_duration_seconds"`
+	Retries              int     `json:"retries"`
+}
+
+type ResourceSummary struct {
+	ResourceType         string             `json:"resource_type"`
+	ID                   string             `json:"id"`
+	TotalDurationSeconds float64            `json:"total_duration_seconds"`
+	Retries              int                `json:"retries"`
+	Operations           []OperationSummary `json:"operations"`
+}
+
+type OperationSummary struct {
+	Operation       string  `json:"operation"`
+	StartedAt       string  `json:"started_at"`
+	DurationSeconds float64 `json:"duration_seconds"`
+	Retries         int     `json:"retries"`
+	Failed          bool    `json:"failed"`
+}
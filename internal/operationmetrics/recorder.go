@@ -0,0 +1,342 @@
+package operationmetrics
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	OperationCreate = "create"
+	OperationRead   = "read"
+	OperationUpdate = "update"
+	OperationDelete = "delete"
+)
+
+// flushInterval is how long after an operation completes the summary is written to disk, so that
+// the operations completing within this window are written out together
+var flushInterval = 5 * time.Second
+
+// Recorder keeps track of how long each Create/Read/Update/Delete operation takes for each
+// resource, along with the number of API requests which were retried during that operation,
+// and writes a JSON summary of these to disk.
+//
+// Since the Plugin SDK offers no hook for the end of an apply, the summary is kept in memory and
+// written out shortly after operations complete, when the Provider is stopped and when the
+// process exits (see FlushAll) - meaning that once Terraform exits the file contains the full summary.
+type Recorder struct {
+	path string
+
+	// writeMu serializes writing the summary to disk, so that an older summary never replaces a newer one
+	writeMu sync.Mutex
+
+	mu             sync.Mutex
+	resources      map[string]*ResourceSummary
+	knownIds       map[string]struct{}
+	inFlight       map[string]int
+	dirty          bool
+	flushScheduled bool
+
+	// pendingRetries indexes the retries which haven't yet been claimed by a resource by the API
+	// path of each resource (or nested item) which the request could belong to
+	pendingRetries map[string]map[*retryEvent]struct{}
+	pendingCount   int
+}
+
+type retryEvent struct {
+	path string
+	at   time.Time
+}
+
+var (
+	recorders     = map[string]*Recorder{}
+	recordersLock sync.Mutex
+)
+
+// ForPath returns the Recorder writing to the specified path - which is shared between all
+// instances of the Provider running within this process, so that these don't overwrite the
+// summary written by one another.
+func ForPath(path string) *Recorder {
+	recordersLock.Lock()
+	defer recordersLock.Unlock()
+
+	if existing, ok := recorders[path]; ok {
+		return existing
+	}
+
+	r := &Recorder{
+		path:           path,
+		resources:      map[string]*ResourceSummary{},
+		knownIds:       map[string]struct{}{},
+		inFlight:       map[string]int{},
+		pendingRetries: map[string]map[*retryEvent]struct{}{},
+	}
+	recorders[path] = r
+	return r
+}
+
+// Start tracks that the specified operation on the resource with the ID `id` (which is empty when
+// the resource is being created) of the type `resourceType` has started. The returned function
+// must be called with the ID of the resource (if known) once the operation has completed, which
+// records the operation and schedules the summary to be written out.
+func (r *Recorder) Start(resourceType, operation, id string) func(id string, err error) {
+	start := time.Now()
+	startId := strings.ToLower(id)
+
+	r.mu.Lock()
+	r.inFlight[startId]++
+	r.mu.Unlock()
+
+	return func(id string, err error) {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+
+		if r.inFlight[startId]--; r.inFlight[startId] <= 0 {
+			delete(r.inFlight, startId)
+		}
+		r.record(resourceType, operation, id, start, time.Now(), err)
+
+		r.dirty = true
+		if !r.flushScheduled {
+			r.flushScheduled = true
+			time.AfterFunc(flushInterval, r.Flush)
+		}
+	}
+}
+
+// Flush writes the summary of the operations recorded so far to disk, if it's changed since it was last written
+func (r *Recorder) Flush() {
+	r.writeMu.Lock()
+	defer r.writeMu.Unlock()
+
+	r.mu.Lock()
+	if !r.dirty {
+		r.mu.Unlock()
+		return
+	}
+	summary := r.summary()
+	r.dirty = false
+	r.flushScheduled = false
+	r.mu.Unlock()
+
+	if err := r.write(summary); err != nil {
+		log.Printf("[WARN] writing Operation Metrics to %q: %+v", r.path, err)
+	}
+}
+
+// FlushAll writes out the summary for each Recorder in this process, which should be called when
+// the process is about to exit
+func FlushAll() {
+	recordersLock.Lock()
+	all := make([]*Recorder, 0, len(recorders))
+	for _, r := range recorders {
+		all = append(all, r)
+	}
+	recordersLock.Unlock()
+
+	for _, r := range all {
+		r.Flush()
+	}
+}
+
+// record tracks the completed operation, the caller must hold the lock
+func (r *Recorder) record(resourceType, operation, id string, start, end time.Time, err error) {
+	key := fmt.Sprintf("%s|%s", resourceType, strings.ToLower(id))
+	resource, ok := r.resources[key]
+	if !ok {
+		resource = &ResourceSummary{
+			ResourceType: resourceType,
+			ID:           id,
+			Operations:   make([]OperationSummary, 0),
+		}
+		r.resources[key] = resource
+		if id != "" {
+			r.knownIds[strings.TrimSuffix(strings.ToLower(id), "/")] = struct{}{}
+		}
+	}
+
+	duration := end.Sub(start)
+	retries := r.claimRetries(id, start, end)
+	resource.Operations = append(resource.Operations, OperationSummary{
+		Operation:       operation,
+		StartedAt:       start.UTC().Format(time.RFC3339),
+		DurationSeconds: duration.Seconds(),
+		Retries:         retries,
+		Failed:          err != nil,
+	})
+	resource.TotalDurationSeconds += duration.Seconds()
+	resource.Retries += retries
+}
+
+// recordRetry tracks that a request to the API path `path` is going to be retried - which is
+// assigned to the resource whose operation covers this request when that operation completes.
+func (r *Recorder) recordRetry(path string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	event := &retryEvent{
+		path: strings.TrimSuffix(strings.ToLower(path), "/"),
+		at:   time.Now(),
+	}
+	for _, prefix := range pathPrefixes(event.path) {
+		events, ok := r.pendingRetries[prefix]
+		if !ok {
+			events = map[*retryEvent]struct{}{}
+			r.pendingRetries[prefix] = events
+		}
+		events[event] = struct{}{}
+	}
+	r.pendingCount++
+}
+
+// claimRetries returns the number of retried requests made against the resource `id` (or any
+// of its nested items) between `start` and `end`, removing these from the pending retries.
+//
+// Requests made against a nested item which is itself a known resource (for example a Storage
+// Account within a Resource Group) are left for that resource to claim instead.
+func (r *Recorder) claimRetries(id string, start, end time.Time) int {
+	if id == "" {
+		return 0
+	}
+
+	prefix := strings.TrimSuffix(strings.ToLower(id), "/")
+	claimed := make([]*retryEvent, 0)
+	for event := range r.pendingRetries[prefix] {
+		if event.at.Before(start) || event.at.After(end) {
+			continue
+		}
+		if r.isWithinNestedResource(event.path, prefix) {
+			continue
+		}
+		claimed = append(claimed, event)
+	}
+
+	for _, event := range claimed {
+		for _, eventPrefix := range pathPrefixes(event.path) {
+			events := r.pendingRetries[eventPrefix]
+			delete(events, event)
+			if len(events) == 0 {
+				delete(r.pendingRetries, eventPrefix)
+			}
+		}
+	}
+	r.pendingCount -= len(claimed)
+
+	return len(claimed)
+}
+
+// isWithinNestedResource returns whether the (lower-cased) API path `path` is for a known or
+// in-flight resource nested within the resource `id`, or one of its nested items
+func (r *Recorder) isWithinNestedResource(path, id string) bool {
+	for candidate := path; len(candidate) > len(id); candidate = candidate[:strings.LastIndex(candidate, "/")] {
+		if _, ok := r.knownIds[candidate]; ok {
+			return true
+		}
+		if r.inFlight[candidate] > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// pathPrefixes returns the (lower-cased) API path `path` along with the path of each of its parents,
+// for example `/subscriptions/123/resourcegroups/group1` returns `/subscriptions`, `/subscriptions/123`,
+// `/subscriptions/123/resourcegroups` and `/subscriptions/123/resourcegroups/group1`
+func pathPrefixes(path string) []string {
+	prefixes := make([]string, 0)
+	for i := 1; i < len(path); i++ {
+		if path[i] == '/' {
+			prefixes = append(prefixes, path[:i])
+		}
+	}
+	if path != "" {
+		prefixes = append(prefixes, path)
+	}
+	return prefixes
+}
+
+func (r *Recorder) write(summary Summary) error {
+	contents, err := json.MarshalIndent(summary, "", "  ")
+	if err != nil {
+		return fmt.Errorf("serializing summary: %+v", err)
+	}
+
+	// write to a temporary file and then move that into place, so that the summary can be read
+	// whilst Terraform is running without ever seeing a partially written file
+	dir := filepath.Dir(r.path)
+	tmp, err := ioutil.TempFile(dir, filepath.Base(r.path)+".tmp")
+	if err != nil {
+		return fmt.Errorf("creating temporary file in %q: %+v", dir, err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(contents); err != nil {
+		tmp.Close()
+		return fmt.Errorf("writing temporary file %q: %+v", tmp.Name(), err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("closing temporary file %q: %+v", tmp.Name(), err)
+	}
+
+	if err := os.Rename(tmp.Name(), r.path); err != nil {
+		return fmt.Errorf("moving %q to %q: %+v", tmp.Name(), r.path, err)
+	}
+
+	return nil
+}
+
+// summary builds the Summary for the operations recorded so far, the caller must hold the lock
+func (r *Recorder) summary() Summary {
+	resources := make([]ResourceSummary, 0, len(r.resources))
+	types := map[string]*ResourceTypeSummary{}
+	for _, resource := range r.resources {
+		// the operations are copied since further operations can be appended whilst the summary is being written
+		snapshot := *resource
+		snapshot.Operations = append(make([]OperationSummary, 0, len(resource.Operations)), resource.Operations...)
+		resources = append(resources, snapshot)
+
+		typeSummary, ok := types[resource.ResourceType]
+		if !ok {
+			typeSummary = &ResourceTypeSummary{
+				ResourceType: resource.ResourceType,
+			}
+			types[resource.ResourceType] = typeSummary
+		}
+		typeSummary.Operations += len(resource.Operations)
+		typeSummary.TotalDurationSeconds += resource.TotalDurationSeconds
+		typeSummary.Retries += resource.Retries
+	}
+
+	// the slowest resources are the interesting ones, so these go first
+	sort.Slice(resources, func(i, j int) bool {
+		if resources[i].TotalDurationSeconds != resources[j].TotalDurationSeconds {
+			return resources[i].TotalDurationSeconds > resources[j].TotalDurationSeconds
+		}
+		return resources[i].ID < resources[j].ID
+	})
+
+	resourceTypes := make([]ResourceTypeSummary, 0, len(types))
+	for _, v := range types {
+		resourceTypes = append(resourceTypes, *v)
+	}
+	sort.Slice(resourceTypes, func(i, j int) bool {
+		if resourceTypes[i].TotalDurationSeconds != resourceTypes[j].TotalDurationSeconds {
+			return resourceTypes[i].TotalDurationSeconds > resourceTypes[j].TotalDurationSeconds
+		}
+		return resourceTypes[i].ResourceType < resourceTypes[j].ResourceType
+	})
+
+	return Summary{
+		GeneratedAt:         time.Now().UTC().Format(time.RFC3339),
+		ResourceTypes:       resourceTypes,
+		Resources:           resources,
+		UnattributedRetries: r.pendingCount,
+	}
+}
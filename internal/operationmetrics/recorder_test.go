@@ -0,0 +1,135 @@
+package operationmetrics
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestRecorderSummary(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "metrics.json")
+	recorder := ForPath(path)
+	if other := ForPath(path); other != recorder {
+		t.Fatalf("expected the same Recorder to be returned for the same path")
+	}
+
+	groupId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1"
+	accountId := groupId + "/providers/Microsoft.Storage/storageAccounts/account1"
+
+	statusCodes := map[string]int{
+		accountId:                     http.StatusTooManyRequests,
+		accountId + "/blobServices/x": http.StatusServiceUnavailable,
+		accountId + "2":               http.StatusInternalServerError,
+		groupId:                       http.StatusOK,
+
+		"/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Storage/checkNameAvailability": http.StatusTooManyRequests,
+	}
+	sender := recorder.WrapSender(autorest.SenderFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: statusCodes[req.URL.Path], Request: req}, nil
+	}))
+
+	finishGroup := recorder.Start("azurerm_resource_group", OperationRead, groupId)
+	time.Sleep(10 * time.Millisecond)
+	finishAccount := recorder.Start("azurerm_storage_account", OperationCreate, "")
+	finishAccountRead := recorder.Start("azurerm_storage_account", OperationRead, accountId)
+	for path := range statusCodes {
+		req := &http.Request{Method: http.MethodGet, URL: &url.URL{Path: path}}
+		if _, err := sender.Do(req); err != nil {
+			t.Fatalf("sending request: %+v", err)
+		}
+	}
+
+	finishGroup(groupId, nil)
+	finishAccount(accountId, nil)
+	finishAccountRead(accountId, fmt.Errorf("boom"))
+	recorder.Flush()
+
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading summary: %+v", err)
+	}
+
+	var summary Summary
+	if err := json.Unmarshal(contents, &summary); err != nil {
+		t.Fatalf("parsing summary: %+v", err)
+	}
+
+	if len(summary.Resources) != 2 {
+		t.Fatalf("expected 2 resources but got %d", len(summary.Resources))
+	}
+
+	group := summary.Resources[0]
+	if group.ID != groupId {
+		t.Fatalf("expected the slowest resource to be %q but got %q", groupId, group.ID)
+	}
+	// only the request for `account12` should be assigned to the Resource Group, since the others are for the Storage Account
+	if group.Retries != 1 {
+		t.Fatalf("expected the Resource Group to have 1 retry but got %d", group.Retries)
+	}
+
+	account := summary.Resources[1]
+	if len(account.Operations) != 2 {
+		t.Fatalf("expected the Storage Account to have 2 operations but got %d", len(account.Operations))
+	}
+	if account.Retries != 2 || account.Operations[0].Retries != 2 {
+		t.Fatalf("expected the Storage Account Create to have 2 retries but got %d", account.Operations[0].Retries)
+	}
+	if account.Operations[0].Failed || !account.Operations[1].Failed {
+		t.Fatalf("expected only the Storage Account Read to be marked as failed")
+	}
+
+	if summary.UnattributedRetries != 1 {
+		t.Fatalf("expected 1 unattributed retry but got %d", summary.UnattributedRetries)
+	}
+
+	if len(summary.ResourceTypes) != 2 {
+		t.Fatalf("expected 2 resource types but got %d", len(summary.ResourceTypes))
+	}
+	if summary.ResourceTypes[1].ResourceType != "azurerm_storage_account" || summary.ResourceTypes[1].Operations != 2 {
+		t.Fatalf("expected 2 operations for azurerm_storage_account but got %+v", summary.ResourceTypes[1])
+	}
+}
+
+func TestRecorderFlush(t *testing.T) {
+	existing := flushInterval
+	flushInterval = time.Hour
+	defer func() {
+		flushInterval = existing
+	}()
+
+	path := filepath.Join(t.TempDir(), "metrics.json")
+	recorder := ForPath(path)
+
+	groupId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1"
+	for i := 0; i < 3; i++ {
+		finish := recorder.Start("azurerm_resource_group", OperationRead, groupId)
+		finish(groupId, nil)
+	}
+
+	// the summary is only written out once the flush interval has elapsed (or it's explicitly flushed)
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Fatalf("expected the summary not to have been written before being flushed")
+	}
+
+	FlushAll()
+
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading summary: %+v", err)
+	}
+	var summary Summary
+	if err := json.Unmarshal(contents, &summary); err != nil {
+		t.Fatalf("parsing summary: %+v", err)
+	}
+	if len(summary.Resources) != 1 || len(summary.Resources[0].Operations) != 3 {
+		t.Fatalf("expected 1 resource with 3 operations but got %+v", summary.Resources)
+	}
+}
@@ -0,0 +1,24 @@
+package operationmetrics
+
+import (
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// WrapSender returns an autorest.Sender which tracks each request sent by `sender` that'll be
+// retried, either because it failed to send or because the API returned a transient status code.
+func (r *Recorder) WrapSender(sender autorest.Sender) autorest.Sender {
+	return autorest.SenderFunc(func(req *http.Request) (*http.Response, error) {
+		resp, err := sender.Do(req)
+		// requests which fail because the operation was cancelled or timed out won't be retried
+		if req.Context().Err() != nil {
+			return resp, err
+		}
+
+		if err != nil || autorest.ResponseHasStatusCode(resp, autorest.StatusCodesForRetry...) {
+			r.recordRetry(req.URL.Path)
+		}
+		return resp, err
+	})
+}
@@ -0,0 +1,33 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-uuid"
+)
+
+// maxAuxiliaryTenants is the maximum number of auxiliary tokens which Resource Manager accepts in the
+// `x-ms-authorization-auxiliary` header
+const maxAuxiliaryTenants = 3
+
+// validateAuxiliaryTenantIDs validates the `auxiliary_tenant_ids`, which are sourced from either the Provider block
+// or the `ARM_AUXILIARY_TENANT_IDS` Environment Variable, and so can't be validated using the Schema alone
+func validateAuxiliaryTenantIDs(tenantId string, auxiliaryTenantIds []string) error {
+	if len(auxiliaryTenantIds) > maxAuxiliaryTenants {
+		return fmt.Errorf("the provider only supports %d auxiliary tenant IDs", maxAuxiliaryTenants)
+	}
+
+	for _, v := range auxiliaryTenantIds {
+		if _, err := uuid.ParseUUID(v); err != nil {
+			return fmt.Errorf("expected the auxiliary tenant ID %q to be a UUID", v)
+		}
+
+		// a token for the primary tenant is always obtained, so this isn't an auxiliary tenant
+		if tenantId != "" && strings.EqualFold(v, tenantId) {
+			return fmt.Errorf("the auxiliary tenant IDs shouldn't include the tenant ID %q", tenantId)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package provider
+
+import "testing"
+
+func TestValidateAuxiliaryTenantIDs(t *testing.T) {
+	tenantId := "00000000-0000-0000-0000-000000000000"
+
+	testData := []struct {
+		name  string
+		input []string
+		valid bool
+	}{
+		{
+			name:  "none",
+			input: []string{},
+			valid: true,
+		},
+		{
+			name:  "single",
+			input: []string{"11111111-1111-1111-1111-111111111111"},
+			valid: true,
+		},
+		{
+			name: "maximum",
+			input: []string{
+				"11111111-1111-1111-1111-111111111111",
+				"22222222-2222-2222-2222-222222222222",
+				"33333333-3333-3333-3333-333333333333",
+			},
+			valid: true,
+		},
+		{
+			name: "too many",
+			input: []string{
+				"11111111-1111-1111-1111-111111111111",
+				"22222222-2222-2222-2222-222222222222",
+				"33333333-3333-3333-3333-333333333333",
+				"44444444-4444-4444-4444-444444444444",
+			},
+			valid: false,
+		},
+		{
+			name:  "not a uuid",
+			input: []string{"contoso.onmicrosoft.com"},
+			valid: false,
+		},
+		{
+			name:  "primary tenant",
+			input: []string{"11111111-1111-1111-1111-111111111111", tenantId},
+			valid: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		err := validateAuxiliaryTenantIDs(tenantId, v.input)
+		if v.valid && err != nil {
+			t.Fatalf("expected %q to be valid but got: %+v", v.name, err)
+		}
+		if !v.valid && err == nil {
+			t.Fatalf("expected %q to be invalid but it wasn't", v.name)
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+)
+
+// wrapResourceWithDefaultTags merges the `default_tags` configured in the Provider block into the `tags`
+// of Resources which support updating Tags in-place - Tags specified on the Resource take precedence.
+//
+// The `tags` field is marked as Computed so that the merged Tags can be set during the plan, meaning that
+// both the plan shows the Tags which will be assigned and the existing expand/flatten functions within
+// each Resource continue to work as-is.
+func wrapResourceWithDefaultTags(resource *schema.Resource) {
+	s, ok := resource.Schema["tags"]
+	if !ok || !supportsDefaultTags(s) {
+		return
+	}
+
+	s.Computed = true
+
+	existing := resource.CustomizeDiff
+	resource.CustomizeDiff = func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if err := setDefaultTags(d, meta); err != nil {
+			return err
+		}
+
+		if existing != nil {
+			return existing(ctx, d, meta)
+		}
+
+		return nil
+	}
+}
+
+// supportsDefaultTags returns whether the `tags` field matches `tags.Schema()` - that is an Optional
+// map of strings which can be updated in-place
+func supportsDefaultTags(s *schema.Schema) bool {
+	if s.Type != schema.TypeMap || !s.Optional || s.Computed || s.ForceNew {
+		return false
+	}
+
+	elem, ok := s.Elem.(*schema.Schema)
+	return ok && elem.Type == schema.TypeString
+}
+
+func setDefaultTags(d *schema.ResourceDiff, meta interface{}) error {
+	var defaultTags map[string]string
+	if client, ok := meta.(*clients.Client); ok && client != nil {
+		defaultTags = client.DefaultTags
+	}
+
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() {
+		return nil
+	}
+
+	// since `tags` is Computed the raw config is used, so that removing Tags from the Resource is detected
+	raw := config.GetAttr("tags")
+	if !raw.IsWhollyKnown() {
+		return d.SetNewComputed("tags")
+	}
+
+	resourceTags := make(map[string]interface{})
+	if !raw.IsNull() {
+		for k, v := range raw.AsValueMap() {
+			if v.IsNull() {
+				continue
+			}
+			resourceTags[k] = v.AsString()
+		}
+	}
+
+	merged := tags.MergeDefaults(defaultTags, resourceTags)
+	if len(merged) == 0 {
+		if old, _ := d.GetChange("tags"); len(old.(map[string]interface{})) == 0 {
+			return nil
+		}
+	}
+
+	return d.SetNew("tags", merged)
+}
+
+func expandDefaultTags(input []interface{}) map[string]string {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	output := make(map[string]string)
+	for key, value := range v["tags"].(map[string]interface{}) {
+		output[key] = value.(string)
+	}
+
+	return output
+}
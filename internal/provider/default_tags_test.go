@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+)
+
+func TestWrapResourceWithDefaultTags(t *testing.T) {
+	testData := []struct {
+		name     string
+		tags     *schema.Schema
+		expected bool
+	}{
+		{
+			name:     "tags",
+			tags:     tags.Schema(),
+			expected: true,
+		},
+		{
+			name:     "tags with lower case keys",
+			tags:     tags.SchemaEnforceLowerCaseKeys(),
+			expected: true,
+		},
+		{
+			name:     "tags which force a new resource",
+			tags:     tags.ForceNewSchema(),
+			expected: false,
+		},
+		{
+			name:     "computed tags",
+			tags:     tags.SchemaDataSource(),
+			expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Run(v.name, func(t *testing.T) {
+			resource := &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"tags": v.tags,
+				},
+			}
+			wrapResourceWithDefaultTags(resource)
+
+			if actual := resource.CustomizeDiff != nil; actual != v.expected {
+				t.Fatalf("expected the Resource to support Default Tags to be %t but got %t", v.expected, actual)
+			}
+		})
+	}
+}
+
+func TestExpandDefaultTags(t *testing.T) {
+	testData := []struct {
+		name     string
+		input    []interface{}
+		expected map[string]string
+	}{
+		{
+			name:     "not configured",
+			input:    []interface{}{},
+			expected: nil,
+		},
+		{
+			name: "configured",
+			input: []interface{}{
+				map[string]interface{}{
+					"tags": map[string]interface{}{
+						"environment": "Production",
+					},
+				},
+			},
+			expected: map[string]string{
+				"environment": "Production",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Run(v.name, func(t *testing.T) {
+			actual := expandDefaultTags(v.input)
+			if !reflect.DeepEqual(actual, v.expected) {
+				t.Fatalf("expected %+v but got %+v", v.expected, actual)
+			}
+		})
+	}
+}
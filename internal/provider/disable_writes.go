@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+)
+
+const disableWritesErrorFmt = `%s of %q is not permitted since %q is enabled in the Provider block.
+
+The Provider has been configured to only read from Azure (for example, when using read-only
+credentials to check for drift), so no changes have been made. To make this change, set
+"disable_writes" to false in the Provider block (or unset the ARM_DISABLE_WRITES
+Environment Variable).`
+
+// wrapResourceWithDisableWrites wraps the Create, Update and Delete functions of the Resource so that
+// these fail before any API calls are made when `disable_writes` is enabled - Reads are unaffected
+func wrapResourceWithDisableWrites(name string, resource *schema.Resource) {
+	if resource.Create != nil {
+		resource.Create = schema.CreateFunc(withDisableWrites(name, "Creation", operationFunc(resource.Create)))
+	}
+	if resource.Update != nil {
+		resource.Update = schema.UpdateFunc(withDisableWrites(name, "Update", operationFunc(resource.Update)))
+	}
+	if resource.Delete != nil {
+		resource.Delete = schema.DeleteFunc(withDisableWrites(name, "Deletion", operationFunc(resource.Delete)))
+	}
+
+	if resource.CreateContext != nil {
+		resource.CreateContext = schema.CreateContextFunc(withDisableWritesContext(name, "Creation", operationContextFunc(resource.CreateContext)))
+	}
+	if resource.UpdateContext != nil {
+		resource.UpdateContext = schema.UpdateContextFunc(withDisableWritesContext(name, "Update", operationContextFunc(resource.UpdateContext)))
+	}
+	if resource.DeleteContext != nil {
+		resource.DeleteContext = schema.DeleteContextFunc(withDisableWritesContext(name, "Deletion", operationContextFunc(resource.DeleteContext)))
+	}
+}
+
+func withDisableWrites(name, operation string, f operationFunc) operationFunc {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		if writesDisabled(meta) {
+			return disableWritesError(name, operation)
+		}
+
+		return f(d, meta)
+	}
+}
+
+func withDisableWritesContext(name, operation string, f operationContextFunc) operationContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		if writesDisabled(meta) {
+			return diag.FromErr(disableWritesError(name, operation))
+		}
+
+		return f(ctx, d, meta)
+	}
+}
+
+func writesDisabled(meta interface{}) bool {
+	client, ok := meta.(*clients.Client)
+	return ok && client != nil && client.DisableWrites
+}
+
+func disableWritesError(name, operation string) error {
+	return fmt.Errorf(disableWritesErrorFmt, operation, name, "disable_writes")
+}
@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+)
+
+func TestWrapResourceWithDisableWrites(t *testing.T) {
+	testData := []struct {
+		name          string
+		disableWrites bool
+		expectError   bool
+	}{
+		{
+			name:          "writes enabled",
+			disableWrites: false,
+			expectError:   false,
+		},
+		{
+			name:          "writes disabled",
+			disableWrites: true,
+			expectError:   true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Run(v.name, func(t *testing.T) {
+			called := map[string]bool{}
+			operation := func(name string) func(d *schema.ResourceData, meta interface{}) error {
+				return func(d *schema.ResourceData, meta interface{}) error {
+					called[name] = true
+					return nil
+				}
+			}
+
+			resource := &schema.Resource{
+				Create: operation("create"),
+				Read:   operation("read"),
+				Update: operation("update"),
+				Delete: operation("delete"),
+			}
+			wrapResourceWithDisableWrites("azurerm_example", resource)
+
+			meta := &clients.Client{
+				DisableWrites: v.disableWrites,
+			}
+			d := resource.TestResourceData()
+
+			for name, f := range map[string]func(d *schema.ResourceData, meta interface{}) error{
+				"create": resource.Create,
+				"update": resource.Update,
+				"delete": resource.Delete,
+			} {
+				err := f(d, meta)
+				if v.expectError && err == nil {
+					t.Fatalf("expected an error for %s but didn't get one", name)
+				}
+				if !v.expectError && err != nil {
+					t.Fatalf("expected no error for %s but got: %+v", name, err)
+				}
+				if called[name] == v.expectError {
+					t.Fatalf("expected %s to be called to be %t but got %t", name, !v.expectError, called[name])
+				}
+			}
+
+			// reads should always be permitted
+			if err := resource.Read(d, meta); err != nil {
+				t.Fatalf("expected no error for read but got: %+v", err)
+			}
+			if !called["read"] {
+				t.Fatalf("expected read to be called")
+			}
+		})
+	}
+}
@@ -21,6 +21,10 @@ func TestExpandFeatures(t *testing.T) {
 				ApiManagement: features.ApiManagementFeatures{
 					PurgeSoftDeleteOnDestroy: false,
 				},
+				AppConfiguration: features.AppConfigurationFeatures{
+					PurgeSoftDeleteOnDestroy: true,
+					RecoverSoftDeleted:       true,
+				},
 				CognitiveAccount: features.CognitiveAccountFeatures{
 					PurgeSoftDeleteOnDestroy: true,
 				},
@@ -67,6 +71,12 @@ func TestExpandFeatures(t *testing.T) {
 							"purge_soft_delete_on_destroy": true,
 						},
 					},
+					"app_configuration": []interface{}{
+						map[string]interface{}{
+							"purge_soft_delete_on_destroy": true,
+							"recover_soft_deleted":         true,
+						},
+					},
 					"cognitive_account": []interface{}{
 						map[string]interface{}{
 							"purge_soft_delete_on_destroy": true,
@@ -124,6 +134,10 @@ func TestExpandFeatures(t *testing.T) {
 				ApiManagement: features.ApiManagementFeatures{
 					PurgeSoftDeleteOnDestroy: true,
 				},
+				AppConfiguration: features.AppConfigurationFeatures{
+					PurgeSoftDeleteOnDestroy: true,
+					RecoverSoftDeleted:       true,
+				},
 				CognitiveAccount: features.CognitiveAccountFeatures{
 					PurgeSoftDeleteOnDestroy: true,
 				},
@@ -170,6 +184,12 @@ func TestExpandFeatures(t *testing.T) {
 							"purge_soft_delete_on_destroy": false,
 						},
 					},
+					"app_configuration": []interface{}{
+						map[string]interface{}{
+							"purge_soft_delete_on_destroy": false,
+							"recover_soft_deleted":         false,
+						},
+					},
 					"cognitive_account": []interface{}{
 						map[string]interface{}{
 							"purge_soft_delete_on_destroy": false,
@@ -227,6 +247,10 @@ func TestExpandFeatures(t *testing.T) {
 				ApiManagement: features.ApiManagementFeatures{
 					PurgeSoftDeleteOnDestroy: false,
 				},
+				AppConfiguration: features.AppConfigurationFeatures{
+					PurgeSoftDeleteOnDestroy: false,
+					RecoverSoftDeleted:       false,
+				},
 				CognitiveAccount: features.CognitiveAccountFeatures{
 					PurgeSoftDeleteOnDestroy: false,
 				},
@@ -340,6 +364,76 @@ func TestExpandFeaturesApiManagement(t *testing.T) {
 	}
 }
 
+func TestExpandFeaturesAppConfiguration(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		EnvVars  map[string]interface{}
+		Expected features.UserFeatures
+	}{
+		{
+			Name: "Empty Block",
+			Input: []interface{}{
+				map[string]interface{}{
+					"app_configuration": []interface{}{},
+				},
+			},
+			Expected: features.UserFeatures{
+				AppConfiguration: features.AppConfigurationFeatures{
+					PurgeSoftDeleteOnDestroy: true,
+					RecoverSoftDeleted:       true,
+				},
+			},
+		},
+		{
+			Name: "Purge on Destroy and Recover Soft Deleted Enabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"app_configuration": []interface{}{
+						map[string]interface{}{
+							"purge_soft_delete_on_destroy": true,
+							"recover_soft_deleted":         true,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				AppConfiguration: features.AppConfigurationFeatures{
+					PurgeSoftDeleteOnDestroy: true,
+					RecoverSoftDeleted:       true,
+				},
+			},
+		},
+		{
+			Name: "Purge on Destroy and Recover Soft Deleted Disabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"app_configuration": []interface{}{
+						map[string]interface{}{
+							"purge_soft_delete_on_destroy": false,
+							"recover_soft_deleted":         false,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				AppConfiguration: features.AppConfigurationFeatures{
+					PurgeSoftDeleteOnDestroy: false,
+					RecoverSoftDeleted:       false,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandFeatures(testCase.Input)
+		if !reflect.DeepEqual(result.AppConfiguration, testCase.Expected.AppConfiguration) {
+			t.Fatalf("Expected %+v but got %+v", result.AppConfiguration, testCase.Expected.AppConfiguration)
+		}
+	}
+}
+
 func TestExpandFeaturesCognitiveServices(t *testing.T) {
 	testData := []struct {
 		Name     string
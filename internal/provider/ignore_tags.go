@@ -0,0 +1,188 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+)
+
+// wrapResourceWithIgnoreTags wraps the Create, Read and Update functions of Resources which support Tags
+// so that any Tags matching the `ignore_tags` configured in the Provider block are removed from the `tags`
+// once these have been flattened into the State - meaning Tags managed outside of Terraform (for example
+// by Azure Policy) don't show up as a diff.
+//
+// Since most Azure APIs replace all of the Tags on a Resource during an update, prior to an Update any
+// ignored Tags assigned to the Resource in Azure are merged into the `tags` being written, so that these
+// are preserved.
+func wrapResourceWithIgnoreTags(resource *schema.Resource) {
+	s, ok := resource.Schema["tags"]
+	if !ok || s.Type != schema.TypeMap {
+		return
+	}
+
+	if resource.Create != nil {
+		resource.Create = schema.CreateFunc(withIgnoreTags(operationFunc(resource.Create), false))
+	}
+	if resource.Read != nil {
+		resource.Read = schema.ReadFunc(withIgnoreTags(operationFunc(resource.Read), false))
+	}
+	if resource.Update != nil {
+		resource.Update = schema.UpdateFunc(withIgnoreTags(operationFunc(resource.Update), true))
+	}
+
+	if resource.CreateContext != nil {
+		resource.CreateContext = schema.CreateContextFunc(withIgnoreTagsContext(operationContextFunc(resource.CreateContext), false))
+	}
+	if resource.ReadContext != nil {
+		resource.ReadContext = schema.ReadContextFunc(withIgnoreTagsContext(operationContextFunc(resource.ReadContext), false))
+	}
+	if resource.UpdateContext != nil {
+		resource.UpdateContext = schema.UpdateContextFunc(withIgnoreTagsContext(operationContextFunc(resource.UpdateContext), true))
+	}
+}
+
+func withIgnoreTags(f operationFunc, preserve bool) operationFunc {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		if preserve {
+			ctx := context.Background()
+			if client, ok := meta.(*clients.Client); ok && client != nil && client.StopContext != nil {
+				ctx = client.StopContext
+			}
+			if err := preserveIgnoredTags(ctx, d, meta); err != nil {
+				return err
+			}
+		}
+
+		if err := f(d, meta); err != nil {
+			return err
+		}
+
+		return removeIgnoredTags(d, meta)
+	}
+}
+
+func withIgnoreTagsContext(f operationContextFunc, preserve bool) operationContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		if preserve {
+			if err := preserveIgnoredTags(ctx, d, meta); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		diags := f(ctx, d, meta)
+		if diags.HasError() {
+			return diags
+		}
+
+		if err := removeIgnoredTags(d, meta); err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+
+		return diags
+	}
+}
+
+// preserveIgnoredTags merges the ignored Tags which are assigned to the Resource in Azure into the `tags`, so that
+// these are included when the Tags are expanded for the update. The existing Tags are retrieved using the Tags API,
+// which is available for all Resource Manager Resources.
+func preserveIgnoredTags(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client, ok := meta.(*clients.Client)
+	if !ok || client == nil || client.IgnoreTags.IsEmpty() || client.Resource == nil || client.Resource.TagsClient == nil {
+		return nil
+	}
+
+	// the Tags API is only available for Resource Manager IDs, rather than (for example) Key Vault Nested Items
+	if !strings.HasPrefix(strings.ToLower(d.Id()), "/subscriptions/") {
+		return nil
+	}
+
+	resp, err := client.Resource.TagsClient.GetAtScope(ctx, strings.TrimPrefix(d.Id(), "/"))
+	if err != nil {
+		log.Printf("[WARN] retrieving the existing Tags for %q, ignored Tags may be removed during the update: %+v", d.Id(), err)
+		return nil
+	}
+	if resp.Properties == nil || len(resp.Properties.Tags) == 0 {
+		return nil
+	}
+
+	configured, _ := d.Get("tags").(map[string]interface{})
+	merged := make(map[string]interface{}, len(configured))
+	for k, v := range configured {
+		merged[k] = v
+	}
+
+	preserved := false
+	for k, v := range resp.Properties.Tags {
+		if v == nil || !client.IgnoreTags.Ignored(k) {
+			continue
+		}
+		if _, exists := merged[k]; exists {
+			continue
+		}
+
+		log.Printf("[DEBUG] preserving the ignored Tag %q on %q", k, d.Id())
+		merged[k] = *v
+		preserved = true
+	}
+	if !preserved {
+		return nil
+	}
+
+	if err := d.Set("tags", merged); err != nil {
+		return fmt.Errorf("setting `tags`: %+v", err)
+	}
+
+	return nil
+}
+
+func removeIgnoredTags(d *schema.ResourceData, meta interface{}) error {
+	client, ok := meta.(*clients.Client)
+	if !ok || client == nil || client.IgnoreTags.IsEmpty() {
+		return nil
+	}
+
+	// the Resource has been removed from the State (e.g. it no longer exists)
+	if d.Id() == "" {
+		return nil
+	}
+
+	existing, ok := d.Get("tags").(map[string]interface{})
+	if !ok || len(existing) == 0 {
+		return nil
+	}
+
+	if err := d.Set("tags", client.IgnoreTags.RemoveIgnored(existing)); err != nil {
+		return fmt.Errorf("setting `tags`: %+v", err)
+	}
+
+	return nil
+}
+
+func expandIgnoreTags(input []interface{}) tags.IgnoreConfig {
+	if len(input) == 0 || input[0] == nil {
+		return tags.IgnoreConfig{}
+	}
+
+	v := input[0].(map[string]interface{})
+	return tags.IgnoreConfig{
+		Keys:        expandIgnoreTagsList(v["keys"].([]interface{})),
+		KeyPrefixes: expandIgnoreTagsList(v["key_prefixes"].([]interface{})),
+	}
+}
+
+func expandIgnoreTagsList(input []interface{}) []string {
+	output := make([]string, 0)
+	for _, v := range input {
+		if s, ok := v.(string); ok && s != "" {
+			output = append(output, s)
+		}
+	}
+
+	return output
+}
@@ -0,0 +1,182 @@
+package provider
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2020-06-01/resources"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	resourceClient "github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/client"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+)
+
+func TestWrapResourceWithIgnoreTags(t *testing.T) {
+	testData := []struct {
+		name       string
+		ignoreTags tags.IgnoreConfig
+		expected   map[string]interface{}
+	}{
+		{
+			name: "not configured",
+			expected: map[string]interface{}{
+				"environment": "Production",
+				"CreatedBy":   "policy",
+				"hidden-link": "/subscriptions/00000000-0000-0000-0000-000000000000",
+			},
+		},
+		{
+			name: "configured",
+			ignoreTags: tags.IgnoreConfig{
+				Keys:        []string{"createdby"},
+				KeyPrefixes: []string{"hidden-"},
+			},
+			expected: map[string]interface{}{
+				"environment": "Production",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Run(v.name, func(t *testing.T) {
+			resource := &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"tags": tags.Schema(),
+				},
+				Read: func(d *schema.ResourceData, meta interface{}) error {
+					return d.Set("tags", map[string]interface{}{
+						"environment": "Production",
+						"CreatedBy":   "policy",
+						"hidden-link": "/subscriptions/00000000-0000-0000-0000-000000000000",
+					})
+				},
+			}
+			wrapResourceWithIgnoreTags(resource)
+
+			meta := &clients.Client{
+				IgnoreTags: v.ignoreTags,
+			}
+			d := resource.TestResourceData()
+			d.SetId("example")
+
+			if err := resource.Read(d, meta); err != nil {
+				t.Fatalf("reading: %+v", err)
+			}
+
+			if actual := d.Get("tags").(map[string]interface{}); !reflect.DeepEqual(actual, v.expected) {
+				t.Fatalf("expected %+v but got %+v", v.expected, actual)
+			}
+		})
+	}
+}
+
+func TestWrapResourceWithIgnoreTags_UpdatePreservesIgnoredTags(t *testing.T) {
+	id := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example"
+
+	tagsClient := resources.NewTagsClientWithBaseURI("https://management.azure.com", "00000000-0000-0000-0000-000000000000")
+	tagsClient.Authorizer = autorest.NullAuthorizer{}
+	tagsClient.Sender = autorest.SenderFunc(func(req *http.Request) (*http.Response, error) {
+		if expected := id + "/providers/Microsoft.Resources/tags/default"; req.URL.Path != expected {
+			t.Fatalf("expected the Tags to be retrieved from %q but got %q", expected, req.URL.Path)
+		}
+
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header: http.Header{
+				"Content-Type": []string{"application/json"},
+			},
+			Body:    ioutil.NopCloser(strings.NewReader(`{"properties":{"tags":{"environment":"Production","CreatedBy":"policy","hidden-link":"/subscriptions/00000000-0000-0000-0000-000000000000"}}}`)),
+			Request: req,
+		}, nil
+	})
+
+	var written map[string]interface{}
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"tags": tags.Schema(),
+		},
+		Update: func(d *schema.ResourceData, meta interface{}) error {
+			// this is what would be expanded and sent to the API
+			written = d.Get("tags").(map[string]interface{})
+			return nil
+		},
+	}
+	wrapResourceWithIgnoreTags(resource)
+
+	meta := &clients.Client{
+		StopContext: context.TODO(),
+		IgnoreTags: tags.IgnoreConfig{
+			Keys:        []string{"createdby"},
+			KeyPrefixes: []string{"hidden-"},
+		},
+		Resource: &resourceClient.Client{
+			TagsClient: &tagsClient,
+		},
+	}
+	d := resource.TestResourceData()
+	d.SetId(id)
+	if err := d.Set("tags", map[string]interface{}{"environment": "Staging"}); err != nil {
+		t.Fatalf("setting `tags`: %+v", err)
+	}
+
+	if err := resource.Update(d, meta); err != nil {
+		t.Fatalf("updating: %+v", err)
+	}
+
+	expectedWritten := map[string]interface{}{
+		"environment": "Staging",
+		"CreatedBy":   "policy",
+		"hidden-link": "/subscriptions/00000000-0000-0000-0000-000000000000",
+	}
+	if !reflect.DeepEqual(written, expectedWritten) {
+		t.Fatalf("expected the ignored Tags to be preserved in the update %+v but got %+v", expectedWritten, written)
+	}
+
+	expectedState := map[string]interface{}{
+		"environment": "Staging",
+	}
+	if actual := d.Get("tags").(map[string]interface{}); !reflect.DeepEqual(actual, expectedState) {
+		t.Fatalf("expected the ignored Tags to be removed from the State %+v but got %+v", expectedState, actual)
+	}
+}
+
+func TestExpandIgnoreTags(t *testing.T) {
+	testData := []struct {
+		name     string
+		input    []interface{}
+		expected tags.IgnoreConfig
+	}{
+		{
+			name:     "not configured",
+			input:    []interface{}{},
+			expected: tags.IgnoreConfig{},
+		},
+		{
+			name: "configured",
+			input: []interface{}{
+				map[string]interface{}{
+					"keys":         []interface{}{"CreatedBy"},
+					"key_prefixes": []interface{}{"hidden-"},
+				},
+			},
+			expected: tags.IgnoreConfig{
+				Keys:        []string{"CreatedBy"},
+				KeyPrefixes: []string{"hidden-"},
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Run(v.name, func(t *testing.T) {
+			actual := expandIgnoreTags(v.input)
+			if !reflect.DeepEqual(actual, v.expected) {
+				t.Fatalf("expected %+v but got %+v", v.expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/operationmetrics"
+)
+
+// operationFunc is the common signature of the (non-context) Create/Read/Update/Delete functions
+type operationFunc func(d *schema.ResourceData, meta interface{}) error
+
+// operationContextFunc is the common signature of the Create/Read/Update/Delete Context functions
+type operationContextFunc func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics
+
+func wrapDataSourceWithOperationMetrics(name string, dataSource *schema.Resource) {
+	if dataSource.Read != nil {
+		dataSource.Read = schema.ReadFunc(withOperationMetrics(name, operationmetrics.OperationRead, operationFunc(dataSource.Read)))
+	}
+	if dataSource.ReadContext != nil {
+		dataSource.ReadContext = schema.ReadContextFunc(withOperationMetricsContext(name, operationmetrics.OperationRead, operationContextFunc(dataSource.ReadContext)))
+	}
+}
+
+func wrapResourceWithOperationMetrics(name string, resource *schema.Resource) {
+	if resource.Create != nil {
+		resource.Create = schema.CreateFunc(withOperationMetrics(name, operationmetrics.OperationCreate, operationFunc(resource.Create)))
+	}
+	if resource.Read != nil {
+		resource.Read = schema.ReadFunc(withOperationMetrics(name, operationmetrics.OperationRead, operationFunc(resource.Read)))
+	}
+	if resource.Update != nil {
+		resource.Update = schema.UpdateFunc(withOperationMetrics(name, operationmetrics.OperationUpdate, operationFunc(resource.Update)))
+	}
+	if resource.Delete != nil {
+		resource.Delete = schema.DeleteFunc(withOperationMetrics(name, operationmetrics.OperationDelete, operationFunc(resource.Delete)))
+	}
+
+	if resource.CreateContext != nil {
+		resource.CreateContext = schema.CreateContextFunc(withOperationMetricsContext(name, operationmetrics.OperationCreate, operationContextFunc(resource.CreateContext)))
+	}
+	if resource.ReadContext != nil {
+		resource.ReadContext = schema.ReadContextFunc(withOperationMetricsContext(name, operationmetrics.OperationRead, operationContextFunc(resource.ReadContext)))
+	}
+	if resource.UpdateContext != nil {
+		resource.UpdateContext = schema.UpdateContextFunc(withOperationMetricsContext(name, operationmetrics.OperationUpdate, operationContextFunc(resource.UpdateContext)))
+	}
+	if resource.DeleteContext != nil {
+		resource.DeleteContext = schema.DeleteContextFunc(withOperationMetricsContext(name, operationmetrics.OperationDelete, operationContextFunc(resource.DeleteContext)))
+	}
+}
+
+func withOperationMetrics(name, operation string, f operationFunc) operationFunc {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		recorder := operationMetricsRecorder(meta)
+		if recorder == nil {
+			return f(d, meta)
+		}
+
+		id := d.Id()
+		finish := recorder.Start(name, operation, id)
+		err := f(d, meta)
+		finish(operationMetricsResourceId(id, d), err)
+		return err
+	}
+}
+
+func withOperationMetricsContext(name, operation string, f operationContextFunc) operationContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		recorder := operationMetricsRecorder(meta)
+		if recorder == nil {
+			return f(ctx, d, meta)
+		}
+
+		id := d.Id()
+		finish := recorder.Start(name, operation, id)
+		diags := f(ctx, d, meta)
+
+		var err error
+		if diags.HasError() {
+			err = diagnosticsError(diags)
+		}
+		finish(operationMetricsResourceId(id, d), err)
+		return diags
+	}
+}
+
+// operationMetricsResourceId returns the ID the operation applied to - which is the ID once the
+// operation has completed for a Create, and the ID prior to the operation otherwise (since this is
+// removed from the state when the resource is deleted or is no longer found)
+func operationMetricsResourceId(before string, d *schema.ResourceData) string {
+	if before != "" {
+		return before
+	}
+	return d.Id()
+}
+
+func operationMetricsRecorder(meta interface{}) *operationmetrics.Recorder {
+	client, ok := meta.(*clients.Client)
+	if !ok || client == nil {
+		return nil
+	}
+	return client.OperationMetrics
+}
+
+type diagnosticsError diag.Diagnostics
+
+func (e diagnosticsError) Error() string {
+	for _, d := range e {
+		if d.Severity == diag.Error {
+			return d.Summary
+		}
+	}
+	return ""
+}
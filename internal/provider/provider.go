@@ -6,15 +6,18 @@ import (
 	"log"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/go-azure-helpers/authentication"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
@@ -124,10 +127,12 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 			"auxiliary_tenant_ids": {
 				Type:     schema.TypeList,
 				Optional: true,
-				MaxItems: 3,
+				MaxItems: maxAuxiliaryTenants,
 				Elem: &schema.Schema{
-					Type: schema.TypeString,
+					Type:         schema.TypeString,
+					ValidateFunc: validation.IsUUID,
 				},
+				Description: "A list of up to 3 auxiliary Tenant IDs, which are used to obtain tokens for cross-tenant scenarios (such as Virtual Network Peerings and Private Endpoints).",
 			},
 
 			"environment": {
@@ -167,6 +172,13 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 				Description: "The password associated with the Client Certificate. For use when authenticating as a Service Principal using a Client Certificate",
 			},
 
+			"client_certificate_key_vault_secret_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_CLIENT_CERTIFICATE_KEY_VAULT_SECRET_ID", ""),
+				Description: "The ID of the Key Vault Secret containing the Client Certificate associated with the Service Principal, which is retrieved using the Azure CLI or a Managed Identity. For use when authenticating as a Service Principal using a Client Certificate.",
+			},
+
 			// Client Secret specific fields
 			"client_secret": {
 				Type:        schema.TypeString,
@@ -189,6 +201,32 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 				Description: "The path to a custom endpoint for Managed Service Identity - in most circumstances this should be detected automatically. ",
 			},
 
+			// OIDC specific fields
+			"use_oidc": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_USE_OIDC", false),
+				Description: "Allow OpenID Connect to be used for authentication",
+			},
+			"oidc_token": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_OIDC_TOKEN", ""),
+				Description: "The OIDC ID token for use when authenticating as a Service Principal using OpenID Connect.",
+			},
+			"oidc_request_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"ARM_OIDC_REQUEST_URL", "ACTIONS_ID_TOKEN_REQUEST_URL"}, ""),
+				Description: "The URL for the OIDC provider from which to request an ID token. For use when authenticating as a Service Principal using OpenID Connect.",
+			},
+			"oidc_request_token": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"ARM_OIDC_REQUEST_TOKEN", "ACTIONS_ID_TOKEN_REQUEST_TOKEN"}, ""),
+				Description: "The bearer token for the request to the OIDC provider. For use when authenticating as a Service Principal using OpenID Connect.",
+			},
+
 			// Managed Tracking GUID for User-agent
 			"partner_id": {
 				Type:         schema.TypeString,
@@ -205,6 +243,22 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 				Description: "This will disable the x-ms-correlation-request-id header.",
 			},
 
+			"correlation_request_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_CORRELATION_REQUEST_ID", ""),
+				Description: "A value sent as the x-ms-correlation-request-id header on every request, which can be used to correlate the requests made during a Terraform run. A random value is used when not specified.",
+			},
+
+			"request_headers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Additional headers which should be sent on every request made to Azure.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
 			"disable_terraform_partner_id": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -212,8 +266,106 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 				Description: "This will disable the Terraform Partner ID which is used if a custom `partner_id` isn't specified.",
 			},
 
+			"disable_writes": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_DISABLE_WRITES", false),
+				Description: "Should the AzureRM Provider fail any Create, Update or Delete operations? Reads and Data Sources continue to work, allowing plans to be run using read-only credentials.",
+			},
+
+			"data_source_list_cache_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_DATA_SOURCE_LIST_CACHE_ENABLED", false),
+				Description: "Should the responses of List API calls made by Data Sources be cached for the duration of the Terraform run, so that Data Sources making identical calls reuse the first response?",
+			},
+
+			"strict_location_validation": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_PROVIDER_STRICT_LOCATION_VALIDATION", false),
+				Description: "Should the `location` of each Resource be validated against the list of Azure Locations known to the Provider during the plan?",
+			},
+
+			"default_tags": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tags": {
+							Type:         schema.TypeMap,
+							Optional:     true,
+							ValidateFunc: tags.Validate,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+				Description: "Tags which should be assigned to all Resources which support Tags, Tags specified on a Resource take precedence.",
+			},
+
+			"ignore_tags": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"keys": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+
+						"key_prefixes": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+					},
+				},
+				Description: "Tags (by key, or by key prefix) which are managed outside of Terraform and should be ignored on all Resources which support Tags.",
+			},
+
 			"features": schemaFeatures(supportLegacyTestSuite),
 
+			"operation_metrics_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_OPERATION_METRICS_PATH", ""),
+				Description: "The path to a file where a JSON summary of the duration of, and API retries made during, each Create/Read/Update/Delete operation should be written.",
+			},
+
+			"http_log_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_HTTP_LOG_PATH", ""),
+				Description: "The path to a file where each API request made by the Provider (and its response) should be logged as a line of JSON, with secrets such as the Authorization header, keys and connection strings redacted.",
+			},
+
+			"max_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_MAX_RETRIES", defaultMaxRetries),
+				ValidateFunc: validation.IntBetween(1, 20),
+				Description:  "The maximum number of times an API request should be retried when it's throttled or fails with a transient error.",
+			},
+
+			"retry_base_delay": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_RETRY_BASE_DELAY", defaultRetryBaseDelay),
+				ValidateFunc: validateRetryBaseDelay,
+				Description:  "The delay before an API request is first retried (for example `30s`), which doubles on each subsequent retry. A `Retry-After` header returned by the API takes precedence.",
+			},
+
 			// Advanced feature flags
 			"skip_provider_registration": {
 				Type:        schema.TypeBool,
@@ -234,6 +386,19 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 		ResourcesMap:   resources,
 	}
 
+	for k, v := range dataSources {
+		wrapDataSourceWithOperationMetrics(k, v)
+	}
+	for k, v := range resources {
+		wrapResourceWithOperationMetrics(k, v)
+		wrapResourceWithDisableWrites(k, v)
+		wrapResourceWithDefaultTags(v)
+		wrapResourceWithIgnoreTags(v)
+		wrapResourceWithStrictLocationValidation(v)
+	}
+
+	sensitiveAttributesErr := applySensitiveAttributes(os.Getenv(sensitiveAttributesEnvVar), resources, dataSources)
+
 	if !features.ThreePointOh() {
 		p.Schema["skip_credentials_validation"] = &schema.Schema{
 			Type:        schema.TypeBool,
@@ -243,7 +408,14 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 		}
 	}
 
-	p.ConfigureContextFunc = providerConfigure(p)
+	configure := providerConfigure(p)
+	p.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+		if sensitiveAttributesErr != nil {
+			return nil, diag.FromErr(sensitiveAttributesErr)
+		}
+
+		return configure(ctx, d)
+	}
 
 	return p
 }
@@ -257,8 +429,8 @@ func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
 			auxTenants = strings.Split(v, ";")
 		}
 
-		if len(auxTenants) > 3 {
-			return nil, diag.FromErr(fmt.Errorf("The provider only supports 3 auxiliary tenant IDs"))
+		if err := validateAuxiliaryTenantIDs(d.Get("tenant_id").(string), auxTenants); err != nil {
+			return nil, diag.FromErr(fmt.Errorf("validating `auxiliary_tenant_ids`: %+v", err))
 		}
 
 		metadataHost := d.Get("metadata_host").(string)
@@ -293,7 +465,24 @@ func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
 			ClientSecretDocsLink: "https://registry.terraform.io/providers/hashicorp/azurerm/latest/docs/guides/service_principal_client_secret",
 		}
 
-		config, err := builder.Build()
+		var oidc *clients.OIDCConfig
+		if d.Get("use_oidc").(bool) {
+			oidc = &clients.OIDCConfig{
+				Token:        d.Get("oidc_token").(string),
+				RequestURL:   d.Get("oidc_request_url").(string),
+				RequestToken: d.Get("oidc_request_token").(string),
+			}
+		}
+
+		var clientCertificateKeyVault *clients.ClientCertificateKeyVaultConfig
+		if v := d.Get("client_certificate_key_vault_secret_id").(string); v != "" {
+			clientCertificateKeyVault = &clients.ClientCertificateKeyVaultConfig{
+				SecretID: v,
+				Password: d.Get("client_certificate_password").(string),
+			}
+		}
+
+		config, err := buildAuthConfig(builder, oidc, clientCertificateKeyVault)
 		if err != nil {
 			return nil, diag.FromErr(fmt.Errorf("building AzureRM Client: %s", err))
 		}
@@ -305,6 +494,19 @@ func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
 			terraformVersion = "0.11+compatible"
 		}
 
+		retryBaseDelay, err := time.ParseDuration(d.Get("retry_base_delay").(string))
+		if err != nil {
+			return nil, diag.FromErr(fmt.Errorf("parsing `retry_base_delay`: %+v", err))
+		}
+
+		requestHeaders := make(map[string]string)
+		for k, v := range d.Get("request_headers").(map[string]interface{}) {
+			requestHeaders[k] = v.(string)
+		}
+		if err := common.ValidateRequestHeaders(requestHeaders); err != nil {
+			return nil, diag.FromErr(fmt.Errorf("validating `request_headers`: %+v", err))
+		}
+
 		skipProviderRegistration := d.Get("skip_provider_registration").(bool)
 		clientBuilder := clients.ClientBuilder{
 			AuthConfig:                  config,
@@ -312,13 +514,22 @@ func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
 			TerraformVersion:            terraformVersion,
 			PartnerId:                   d.Get("partner_id").(string),
 			DisableCorrelationRequestID: d.Get("disable_correlation_request_id").(bool),
+			CustomCorrelationRequestID:  d.Get("correlation_request_id").(string),
+			CustomRequestHeaders:        requestHeaders,
 			DisableTerraformPartnerID:   d.Get("disable_terraform_partner_id").(bool),
 			Features:                    expandFeatures(d.Get("features").([]interface{})),
 			StorageUseAzureAD:           d.Get("storage_use_azuread").(bool),
-
-			// this field is intentionally not exposed in the provider block, since it's only used for
-			// platform level tracing
-			CustomCorrelationRequestID: os.Getenv("ARM_CORRELATION_REQUEST_ID"),
+			OperationMetricsPath:        d.Get("operation_metrics_path").(string),
+			HTTPLogPath:                 d.Get("http_log_path").(string),
+			DisableWrites:               d.Get("disable_writes").(bool),
+			DefaultTags:                 expandDefaultTags(d.Get("default_tags").([]interface{})),
+			IgnoreTags:                  expandIgnoreTags(d.Get("ignore_tags").([]interface{})),
+			DataSourceListCacheEnabled:  d.Get("data_source_list_cache_enabled").(bool),
+			StrictLocationValidation:    d.Get("strict_location_validation").(bool),
+			MaxRetries:                  d.Get("max_retries").(int),
+			RetryBaseDelay:              retryBaseDelay,
+			OIDC:                        oidc,
+			ClientCertificateKeyVault:   clientCertificateKeyVault,
 		}
 
 		//lint:ignore SA1019 SDKv2 migration - staticcheck's own linter directives are currently being ignored under golanci-lint
@@ -356,6 +567,71 @@ func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
 	}
 }
 
+// buildAuthConfig builds the Authentication Config - when authenticating using OIDC the ID Token is exchanged
+// by the Client Builder, since this isn't an authentication method supported by the Authentication Builder.
+//
+// Similarly when authenticating using a Client Certificate stored in Key Vault, the Client Certificate is retrieved
+// by the Client Builder - using a Bootstrap Auth Config (the Azure CLI or a Managed Identity) built here.
+func buildAuthConfig(builder *authentication.Builder, oidc *clients.OIDCConfig, clientCertificateKeyVault *clients.ClientCertificateKeyVaultConfig) (*authentication.Config, error) {
+	if oidc == nil && clientCertificateKeyVault == nil {
+		return builder.Build()
+	}
+
+	if oidc != nil && clientCertificateKeyVault != nil {
+		return nil, fmt.Errorf("only one of `use_oidc` and `client_certificate_key_vault_secret_id` can be specified")
+	}
+
+	if oidc != nil {
+		if builder.ClientID == "" || builder.TenantID == "" || builder.SubscriptionID == "" {
+			return nil, fmt.Errorf("`client_id`, `subscription_id` and `tenant_id` must be specified when authenticating using OIDC")
+		}
+		if err := oidc.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	if clientCertificateKeyVault != nil {
+		if builder.ClientID == "" || builder.TenantID == "" || builder.SubscriptionID == "" {
+			return nil, fmt.Errorf("`client_id`, `subscription_id` and `tenant_id` must be specified when authenticating using a Client Certificate stored in Key Vault")
+		}
+		if builder.ClientCertPath != "" {
+			return nil, fmt.Errorf("only one of `client_certificate_path` and `client_certificate_key_vault_secret_id` can be specified")
+		}
+		if err := clientCertificateKeyVault.Validate(); err != nil {
+			return nil, err
+		}
+
+		// the Client Certificate is retrieved from Key Vault using the Azure CLI or a Managed Identity, as such
+		// the Service Principal specific fields (and Auxiliary Tenants) are intentionally omitted here
+		bootstrapBuilder := &authentication.Builder{
+			SubscriptionID: builder.SubscriptionID,
+			TenantID:       builder.TenantID,
+			Environment:    builder.Environment,
+			MetadataHost:   builder.MetadataHost,
+			MsiEndpoint:    builder.MsiEndpoint,
+
+			// Feature Toggles
+			SupportsManagedServiceIdentity: builder.SupportsManagedServiceIdentity,
+			SupportsAzureCliToken:          builder.SupportsAzureCliToken,
+		}
+		bootstrapConfig, err := bootstrapBuilder.Build()
+		if err != nil {
+			return nil, fmt.Errorf("building the Auth Config used to retrieve the Client Certificate from Key Vault: %+v", err)
+		}
+		clientCertificateKeyVault.BootstrapAuthConfig = bootstrapConfig
+	}
+
+	return &authentication.Config{
+		ClientID:                         builder.ClientID,
+		SubscriptionID:                   builder.SubscriptionID,
+		TenantID:                         builder.TenantID,
+		AuxiliaryTenantIDs:               builder.AuxiliaryTenantIDs,
+		Environment:                      builder.Environment,
+		MetadataHost:                     builder.MetadataHost,
+		AuthenticatedAsAServicePrincipal: true,
+	}, nil
+}
+
 const resourceProviderRegistrationErrorFmt = `Error ensuring Resource Providers are registered.
 
 Terraform automatically attempts to register the Resource Providers it supports to
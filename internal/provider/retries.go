@@ -0,0 +1,34 @@
+package provider
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// these match the defaults used by the Azure SDK for Go (autorest)
+	defaultMaxRetries     = 3
+	defaultRetryBaseDelay = "30s"
+)
+
+// validateRetryBaseDelay validates that `retry_base_delay` is a duration of at least one second, since the
+// delay between retries is calculated in whole seconds
+func validateRetryBaseDelay(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	duration, err := time.ParseDuration(v)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("expected %q to be a duration (for example `30s` or `1m`) but got %q: %+v", k, v, err))
+		return
+	}
+
+	if duration < time.Second {
+		errors = append(errors, fmt.Errorf("expected %q to be at least `1s` but got %q", k, v))
+	}
+
+	return
+}
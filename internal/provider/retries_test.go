@@ -0,0 +1,45 @@
+package provider
+
+import "testing"
+
+func TestValidateRetryBaseDelay(t *testing.T) {
+	testData := []struct {
+		input string
+		valid bool
+	}{
+		{
+			input: "",
+			valid: false,
+		},
+		{
+			input: "30",
+			valid: false,
+		},
+		{
+			input: "500ms",
+			valid: false,
+		},
+		{
+			input: "1s",
+			valid: true,
+		},
+		{
+			input: "30s",
+			valid: true,
+		},
+		{
+			input: "2m",
+			valid: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.input)
+
+		_, errors := validateRetryBaseDelay(v.input, "retry_base_delay")
+		actual := len(errors) == 0
+		if v.valid != actual {
+			t.Fatalf("Expected %t but got %t for %q", v.valid, actual, v.input)
+		}
+	}
+}
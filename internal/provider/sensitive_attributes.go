@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const sensitiveAttributesEnvVar = "ARM_SENSITIVE_ATTRIBUTES"
+
+// applySensitiveAttributes marks the additional attributes specified in the `ARM_SENSITIVE_ATTRIBUTES`
+// Environment Variable as Sensitive, so that these are redacted from the plan output.
+//
+// This is a comma-separated list of paths in the format `{resource_type}.{attribute}` (or for Data Sources,
+// `data.{data_source_type}.{attribute}`), where nested attributes are separated by a `.` - for example
+// `azurerm_traffic_manager_profile.monitor_config.custom_header.value`. Where the path refers to a block
+// all of the attributes within that block are marked as Sensitive.
+//
+// Since the Schema is returned to Terraform prior to the Provider block being configured, this can only be
+// specified using an Environment Variable - any invalid paths are returned as an error which is surfaced
+// when the Provider is configured.
+func applySensitiveAttributes(input string, resources map[string]*schema.Resource, dataSources map[string]*schema.Resource) error {
+	for _, raw := range strings.Split(input, ",") {
+		path := strings.TrimSpace(raw)
+		if path == "" {
+			continue
+		}
+
+		if err := applySensitiveAttribute(path, resources, dataSources); err != nil {
+			return fmt.Errorf("parsing %q from `%s`: %+v", path, sensitiveAttributesEnvVar, err)
+		}
+	}
+
+	return nil
+}
+
+func applySensitiveAttribute(path string, resources map[string]*schema.Resource, dataSources map[string]*schema.Resource) error {
+	segments := strings.Split(path, ".")
+
+	lookup := resources
+	typeName := "Resource"
+	if segments[0] == "data" {
+		lookup = dataSources
+		typeName = "Data Source"
+		segments = segments[1:]
+	}
+
+	if len(segments) < 2 {
+		return fmt.Errorf("expected a path in the format `{resource_type}.{attribute}` or `data.{data_source_type}.{attribute}`")
+	}
+	for _, segment := range segments {
+		if segment == "" {
+			return fmt.Errorf("path segments cannot be empty")
+		}
+	}
+
+	resource, ok := lookup[segments[0]]
+	if !ok {
+		return fmt.Errorf("the %s %q was not found", typeName, segments[0])
+	}
+
+	return markSchemaAsSensitive(resource.Schema, segments[1:])
+}
+
+// markSchemaAsSensitive marks the attribute at the specified path within the schema map as Sensitive - the
+// schemas are copied prior to being updated since these can be shared between multiple Resources
+func markSchemaAsSensitive(input map[string]*schema.Schema, path []string) error {
+	name := path[0]
+	existing, ok := input[name]
+	if !ok {
+		return fmt.Errorf("the attribute %q was not found", name)
+	}
+
+	updated := *existing
+	input[name] = &updated
+
+	if len(path) == 1 {
+		markAllAsSensitive(&updated)
+		return nil
+	}
+
+	if _, ok := updated.Elem.(*schema.Resource); !ok {
+		return fmt.Errorf("the attribute %q is not a block so cannot contain %q", name, path[1])
+	}
+
+	return markSchemaAsSensitive(copyResourceSchema(&updated), path[1:])
+}
+
+// markAllAsSensitive marks the attribute as Sensitive, or where the attribute is a block all of the attributes
+// within the block - since blocks themselves cannot be marked as Sensitive
+func markAllAsSensitive(input *schema.Schema) {
+	if _, ok := input.Elem.(*schema.Resource); !ok {
+		input.Sensitive = true
+		return
+	}
+
+	nested := copyResourceSchema(input)
+	for name, v := range nested {
+		updated := *v
+		nested[name] = &updated
+		markAllAsSensitive(&updated)
+	}
+}
+
+// copyResourceSchema replaces the nested Resource of the block with a (shallow) copy, returning the schema map
+// of the copy which can then be updated
+func copyResourceSchema(input *schema.Schema) map[string]*schema.Schema {
+	existing := input.Elem.(*schema.Resource)
+
+	updated := *existing
+	updated.Schema = make(map[string]*schema.Schema, len(existing.Schema))
+	for k, v := range existing.Schema {
+		updated.Schema[k] = v
+	}
+
+	input.Elem = &updated
+	return updated.Schema
+}
@@ -0,0 +1,159 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestApplySensitiveAttributes(t *testing.T) {
+	testData := []struct {
+		name        string
+		input       string
+		expectError bool
+		resource    []string
+		dataSource  []string
+	}{
+		{
+			name: "not configured",
+		},
+		{
+			name:     "top-level attribute",
+			input:    "azurerm_example.ip_rules",
+			resource: []string{"ip_rules"},
+		},
+		{
+			name:     "nested attribute",
+			input:    "azurerm_example.monitor_config.custom_header.value",
+			resource: []string{"monitor_config.custom_header.value"},
+		},
+		{
+			name:     "block",
+			input:    "azurerm_example.monitor_config.custom_header",
+			resource: []string{"monitor_config.custom_header.name", "monitor_config.custom_header.value"},
+		},
+		{
+			name:       "data source and whitespace",
+			input:      " azurerm_example.ip_rules , data.azurerm_example.ip_rules",
+			resource:   []string{"ip_rules"},
+			dataSource: []string{"ip_rules"},
+		},
+		{
+			name:        "unknown resource",
+			input:       "azurerm_other.ip_rules",
+			expectError: true,
+		},
+		{
+			name:        "unknown attribute",
+			input:       "azurerm_example.monitor_config.other",
+			expectError: true,
+		},
+		{
+			name:        "attribute isn't a block",
+			input:       "azurerm_example.ip_rules.value",
+			expectError: true,
+		},
+		{
+			name:        "missing attribute",
+			input:       "data.azurerm_example",
+			expectError: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Run(v.name, func(t *testing.T) {
+			shared := testSensitiveAttributesSchema()
+			resources := map[string]*schema.Resource{
+				"azurerm_example": {Schema: shared},
+			}
+			dataSources := map[string]*schema.Resource{
+				"azurerm_example": {Schema: testSensitiveAttributesSchema()},
+			}
+			other := &schema.Resource{Schema: map[string]*schema.Schema{}}
+			for k, v := range shared {
+				other.Schema[k] = v
+			}
+
+			err := applySensitiveAttributes(v.input, resources, dataSources)
+			if v.expectError {
+				if err == nil {
+					t.Fatalf("expected an error but didn't get one")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error but got: %+v", err)
+			}
+
+			assertSensitiveAttributes(t, resources["azurerm_example"], v.resource)
+			assertSensitiveAttributes(t, dataSources["azurerm_example"], v.dataSource)
+
+			// other Resources sharing the same nested schemas should be unaffected
+			assertSensitiveAttributes(t, other, nil)
+		})
+	}
+}
+
+func testSensitiveAttributesSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"ip_rules": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+
+		"monitor_config": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"custom_header": {
+						Type:     schema.TypeList,
+						Optional: true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"name": {
+									Type:     schema.TypeString,
+									Optional: true,
+								},
+
+								"value": {
+									Type:     schema.TypeString,
+									Optional: true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func assertSensitiveAttributes(t *testing.T, resource *schema.Resource, expected []string) {
+	actual := make(map[string]bool)
+	collectSensitiveAttributes("", resource.Schema, actual)
+
+	if len(actual) != len(expected) {
+		t.Fatalf("expected %d sensitive attributes but got %d: %+v", len(expected), len(actual), actual)
+	}
+	for _, path := range expected {
+		if !actual[path] {
+			t.Fatalf("expected %q to be sensitive but it wasn't: %+v", path, actual)
+		}
+	}
+}
+
+func collectSensitiveAttributes(prefix string, input map[string]*schema.Schema, output map[string]bool) {
+	for name, v := range input {
+		path := prefix + name
+		if v.Sensitive {
+			output[path] = true
+		}
+		if nested, ok := v.Elem.(*schema.Resource); ok {
+			collectSensitiveAttributes(path+".", nested.Schema, output)
+		}
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/communication"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/consumption"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/costmanagement"
@@ -33,21 +34,28 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datalake"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dataprotection"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datashare"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/defendereasm"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/desktopvirtualization"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devcenter"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/deviceregistry"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devopsinfrastructure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devspace"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devtestlabs"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/digitaltwins"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/disks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/domainservices"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/elastic"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/frontdoor"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/graphservices"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/hdinsight"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/healthcare"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/hpccache"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/hsm"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/imagebuilder"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/iotcentral"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/iothub"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/iottimeseriesinsights"
@@ -65,15 +73,19 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/managementgroup"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/maps"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mariadb"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/marketplace"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/media"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mixedreality"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/modsimworkbench"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitorpipeline"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/msi"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mysql"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/netapp"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/notificationhub"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/paymenthsm"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/policy"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/portal"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/postgres"
@@ -113,18 +125,37 @@ func SupportedTypedServices() []sdk.TypedServiceRegistration {
 		apimanagement.Registration{},
 		appconfiguration.Registration{},
 		appservice.Registration{},
+		azurestackhci.Registration{},
 		batch.Registration{},
+		billing.Registration{},
 		bot.Registration{},
+		cognitive.Registration{},
+		communication.Registration{},
 		consumption.Registration{},
+		containerapps.Registration{},
 		containers.Registration{},
 		costmanagement.Registration{},
+		defendereasm.Registration{},
+		devcenter.Registration{},
+		deviceregistry.Registration{},
+		devopsinfrastructure.Registration{},
 		disks.Registration{},
+		dns.Registration{},
+		elastic.Registration{},
 		eventhub.Registration{},
+		graphservices.Registration{},
+		imagebuilder.Registration{},
 		loadbalancer.Registration{},
 		loadtest.Registration{},
+		marketplace.Registration{},
+		modsimworkbench.Registration{},
+		monitorpipeline.Registration{},
+		msi.Registration{},
 		mssql.Registration{},
+		paymenthsm.Registration{},
 		policy.Registration{},
 		resource.Registration{},
+		search.Registration{},
 		sentinel.Registration{},
 		servicefabricmanaged.Registration{},
 		streamanalytics.Registration{},
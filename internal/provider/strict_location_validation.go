@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+)
+
+// wrapResourceWithStrictLocationValidation validates the `location` of Resources against the list of Locations
+// known to the Provider during the plan, when `strict_location_validation` is enabled in the Provider block.
+//
+// Terraform validates the configuration before the Provider is configured, as such the ValidateFunc of the
+// `location` field can't access the Provider block (and can only be opted into using the Environment Variable)
+// - instead the Location is validated when the diff is computed, which is still prior to any changes being made.
+func wrapResourceWithStrictLocationValidation(resource *schema.Resource) {
+	s, ok := resource.Schema["location"]
+	if !ok || s.Type != schema.TypeString || (!s.Optional && !s.Required) {
+		return
+	}
+
+	existing := resource.CustomizeDiff
+	resource.CustomizeDiff = func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if err := validateLocationStrictly(d, meta); err != nil {
+			return err
+		}
+
+		if existing != nil {
+			return existing(ctx, d, meta)
+		}
+
+		return nil
+	}
+}
+
+func validateLocationStrictly(d *schema.ResourceDiff, meta interface{}) error {
+	client, ok := meta.(*clients.Client)
+	if !ok || client == nil || !client.StrictLocationValidation {
+		return nil
+	}
+
+	// only Locations which are being set are validated, so that existing Resources are unaffected
+	if !d.NewValueKnown("location") || (d.Id() != "" && !d.HasChange("location")) {
+		return nil
+	}
+
+	v, ok := d.Get("location").(string)
+	if !ok || v == "" {
+		return nil
+	}
+
+	if _, errs := location.StrictValidate(v, "location"); len(errs) > 0 {
+		return errs[0]
+	}
+
+	return nil
+}
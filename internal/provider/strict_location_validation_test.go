@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+)
+
+func TestWrapResourceWithStrictLocationValidation(t *testing.T) {
+	testData := []struct {
+		name     string
+		enabled  bool
+		location string
+		valid    bool
+	}{
+		{
+			name:     "disabled",
+			enabled:  false,
+			location: "West Eurpoe",
+			valid:    true,
+		},
+		{
+			name:     "display name",
+			enabled:  true,
+			location: "West Europe",
+			valid:    true,
+		},
+		{
+			name:     "canonical name",
+			enabled:  true,
+			location: "westeurope",
+			valid:    true,
+		},
+		{
+			name:     "typo",
+			enabled:  true,
+			location: "West Eurpoe",
+			valid:    false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Run(v.name, func(t *testing.T) {
+			resource := &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"location": {
+						Type:     schema.TypeString,
+						Required: true,
+						ForceNew: true,
+					},
+				},
+			}
+			wrapResourceWithStrictLocationValidation(resource)
+
+			meta := &clients.Client{
+				StrictLocationValidation: v.enabled,
+			}
+			config := terraform.NewResourceConfigRaw(map[string]interface{}{
+				"location": v.location,
+			})
+
+			_, err := resource.Diff(context.TODO(), nil, config, meta)
+			if v.valid && err != nil {
+				t.Fatalf("expected %q to be valid but got: %+v", v.location, err)
+			}
+			if !v.valid && err == nil {
+				t.Fatalf("expected %q to be invalid but it wasn't", v.location)
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package resourceid
+
+import (
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+// rootSegmentKeys are the segments common to all Resource ID's, which the Resource ID parser
+// requires to be in this (canonical) casing
+var rootSegmentKeys = []string{
+	"subscriptions",
+	"resourceGroups",
+	"providers",
+}
+
+// ParseAzureResourceIDInsensitively parses the Resource ID in the same manner as ParseAzureResourceID
+// but first normalizes the casing of the `subscriptions`, `resourceGroups` and `providers` segments.
+//
+// Resource ID's copied from the Azure Portal/Azure CLI can differ in casing from the canonical Resource ID,
+// which this allows to be parsed (and then rewritten) rather than rejected - however this should only be
+// used where the ID is being rewritten, the case-sensitive parser should be used to validate Resource ID's.
+func ParseAzureResourceIDInsensitively(input string) (*resourceids.ResourceID, error) {
+	return resourceids.ParseAzureResourceID(normalizeRootSegmentKeys(input))
+}
+
+func normalizeRootSegmentKeys(input string) string {
+	components := strings.Split(input, "/")
+
+	// the first component will be empty since Resource ID's are prefixed with a `/` - so the keys are at odd indexes
+	for i := 1; i < len(components); i += 2 {
+		for _, key := range rootSegmentKeys {
+			if strings.EqualFold(components[i], key) {
+				components[i] = key
+				break
+			}
+		}
+	}
+
+	return strings.Join(components, "/")
+}
@@ -0,0 +1,99 @@
+package resourceid
+
+import "testing"
+
+func TestParseAzureResourceIDInsensitively(t *testing.T) {
+	testData := []struct {
+		Input                 string
+		Error                 bool
+		ExpectedSubscription  string
+		ExpectedResourceGroup string
+		ExpectedProvider      string
+		ExpectedPath          map[string]string
+	}{
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+		{
+			// odd number of segments
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups",
+			Error: true,
+		},
+		{
+			// canonical
+			Input:                 "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Web/sites/site1",
+			ExpectedSubscription:  "12345678-1234-9876-4563-123456789012",
+			ExpectedResourceGroup: "group1",
+			ExpectedProvider:      "Microsoft.Web",
+			ExpectedPath: map[string]string{
+				"sites": "site1",
+			},
+		},
+		{
+			// upper-cased root segments
+			Input:                 "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/group1/PROVIDERS/Microsoft.Web/sites/site1",
+			ExpectedSubscription:  "12345678-1234-9876-4563-123456789012",
+			ExpectedResourceGroup: "group1",
+			ExpectedProvider:      "Microsoft.Web",
+			ExpectedPath: map[string]string{
+				"sites": "site1",
+			},
+		},
+		{
+			// lower-cased root segments, other segments are left as-is
+			Input:                 "/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/group1/providers/microsoft.web/Sites/site1",
+			ExpectedSubscription:  "12345678-1234-9876-4563-123456789012",
+			ExpectedResourceGroup: "group1",
+			ExpectedProvider:      "microsoft.web",
+			ExpectedPath: map[string]string{
+				"Sites": "site1",
+			},
+		},
+		{
+			// values matching a root segment name are left as-is
+			Input:                 "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/PROVIDERS/providers/Microsoft.Web/sites/site1",
+			ExpectedSubscription:  "12345678-1234-9876-4563-123456789012",
+			ExpectedResourceGroup: "PROVIDERS",
+			ExpectedProvider:      "Microsoft.Web",
+			ExpectedPath: map[string]string{
+				"sites": "site1",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ParseAzureResourceIDInsensitively(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.SubscriptionID != v.ExpectedSubscription {
+			t.Fatalf("Expected %q but got %q for SubscriptionID", v.ExpectedSubscription, actual.SubscriptionID)
+		}
+		if actual.ResourceGroup != v.ExpectedResourceGroup {
+			t.Fatalf("Expected %q but got %q for ResourceGroup", v.ExpectedResourceGroup, actual.ResourceGroup)
+		}
+		if actual.Provider != v.ExpectedProvider {
+			t.Fatalf("Expected %q but got %q for Provider", v.ExpectedProvider, actual.Provider)
+		}
+		if len(actual.Path) != len(v.ExpectedPath) {
+			t.Fatalf("Expected %d but got %d segments in Path", len(v.ExpectedPath), len(actual.Path))
+		}
+		for key, value := range v.ExpectedPath {
+			if actual.Path[key] != value {
+				t.Fatalf("Expected %q but got %q for the %q segment", value, actual.Path[key], key)
+			}
+		}
+	}
+}
@@ -82,6 +82,18 @@ type ResourceWithCustomImporter interface {
 	CustomImporter() ResourceRunFunc
 }
 
+// ResourceWithNormalizedImport is an optional interface
+//
+// Resources implementing this interface parse the Resource ID specified during `terraform import`
+// case-insensitively (rather than validating it using IDValidationFunc) and rewrite it into its canonical
+// casing, allowing Resource ID's copied from the Azure Portal/Azure CLI to be imported.
+type ResourceWithNormalizedImport interface {
+	Resource
+
+	// IDInsensitiveParseFunc returns a function which parses the Resource ID case-insensitively
+	IDInsensitiveParseFunc() pluginsdk.IDInsensitiveParseFunc
+}
+
 // ResourceWithUpdate is an optional interface
 //
 // Notably the Arguments for Resources implementing this interface
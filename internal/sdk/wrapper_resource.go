@@ -74,7 +74,28 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 			Read:   d(rw.resource.Read().Timeout),
 			Delete: d(rw.resource.Delete().Timeout),
 		},
-		Importer: pluginsdk.ImporterValidatingResourceIdThen(func(id string) error {
+	}
+
+	importThenFunc := func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) ([]*pluginsdk.ResourceData, error) {
+		if v, ok := rw.resource.(ResourceWithCustomImporter); ok {
+			metaData := runArgs(d, meta, rw.logger)
+
+			err := v.CustomImporter()(ctx, metaData)
+			if err != nil {
+				return nil, err
+			}
+
+			return []*pluginsdk.ResourceData{metaData.ResourceData}, nil
+		}
+
+		return schema.ImportStatePassthroughContext(ctx, d, meta)
+	}
+
+	// Resources can opt into parsing the Resource ID specified during import case-insensitively
+	if v, ok := rw.resource.(ResourceWithNormalizedImport); ok {
+		resource.Importer = pluginsdk.ImporterNormalizingResourceIdThen(v.IDInsensitiveParseFunc(), importThenFunc)
+	} else {
+		resource.Importer = pluginsdk.ImporterValidatingResourceIdThen(func(id string) error {
 			fn := rw.resource.IDValidationFunc()
 			warnings, errors := fn(id, "id")
 			if len(warnings) > 0 {
@@ -91,20 +112,7 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 			}
 
 			return nil
-		}, func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) ([]*pluginsdk.ResourceData, error) {
-			if v, ok := rw.resource.(ResourceWithCustomImporter); ok {
-				metaData := runArgs(d, meta, rw.logger)
-
-				err := v.CustomImporter()(ctx, metaData)
-				if err != nil {
-					return nil, err
-				}
-
-				return []*pluginsdk.ResourceData{metaData.ResourceData}, nil
-			}
-
-			return schema.ImportStatePassthroughContext(ctx, d, meta)
-		}),
+		}, importThenFunc)
 	}
 
 	// Not all resources support update - so this is an separate interface
@@ -6,12 +6,14 @@ import (
 	"strings"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/apimanagement/mgmt/2021-08-01/apimanagement"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	azValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/schemaz"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2023-09-01-preview/backend"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -49,6 +51,94 @@ func resourceApiManagementBackend() *pluginsdk.Resource {
 
 			"resource_group_name": azure.SchemaResourceGroupName(),
 
+			"circuit_breaker_rule": {
+				Type:          pluginsdk.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"pool"},
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"failure_condition": {
+							Type:     pluginsdk.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"interval_duration": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: azValidate.ISO8601Duration,
+									},
+
+									"count": {
+										Type:         pluginsdk.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+										ExactlyOneOf: []string{"circuit_breaker_rule.0.failure_condition.0.count", "circuit_breaker_rule.0.failure_condition.0.percentage"},
+									},
+
+									"percentage": {
+										Type:         pluginsdk.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(1, 100),
+										ExactlyOneOf: []string{"circuit_breaker_rule.0.failure_condition.0.count", "circuit_breaker_rule.0.failure_condition.0.percentage"},
+									},
+
+									"error_reasons": {
+										Type:     pluginsdk.TypeList,
+										Optional: true,
+										Elem: &pluginsdk.Schema{
+											Type:         pluginsdk.TypeString,
+											ValidateFunc: validation.StringIsNotEmpty,
+										},
+										AtLeastOneOf: []string{"circuit_breaker_rule.0.failure_condition.0.error_reasons", "circuit_breaker_rule.0.failure_condition.0.status_code_range"},
+									},
+
+									"status_code_range": {
+										Type:     pluginsdk.TypeList,
+										Optional: true,
+										Elem: &pluginsdk.Resource{
+											Schema: map[string]*pluginsdk.Schema{
+												"min": {
+													Type:         pluginsdk.TypeInt,
+													Required:     true,
+													ValidateFunc: validation.IntBetween(200, 599),
+												},
+
+												"max": {
+													Type:         pluginsdk.TypeInt,
+													Required:     true,
+													ValidateFunc: validation.IntBetween(200, 599),
+												},
+											},
+										},
+										AtLeastOneOf: []string{"circuit_breaker_rule.0.failure_condition.0.error_reasons", "circuit_breaker_rule.0.failure_condition.0.status_code_range"},
+									},
+								},
+							},
+						},
+
+						"trip_duration": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: azValidate.ISO8601Duration,
+						},
+
+						"accept_retry_after_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+
 			"credentials": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
@@ -114,13 +204,49 @@ func resourceApiManagementBackend() *pluginsdk.Resource {
 				ValidateFunc: validation.StringLenBetween(1, 2000),
 			},
 
+			"pool": {
+				Type:          pluginsdk.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ExactlyOneOf:  []string{"pool", "url"},
+				ConflictsWith: []string{"credentials", "proxy", "resource_id", "service_fabric_cluster", "tls"},
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"service": {
+							Type:     pluginsdk.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"id": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validate.BackendID,
+									},
+
+									"priority": {
+										Type:         pluginsdk.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(0, 100),
+									},
+
+									"weight": {
+										Type:         pluginsdk.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(0, 100),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
 			"protocol": {
-				Type:     pluginsdk.TypeString,
-				Required: true,
-				ValidateFunc: validation.StringInSlice([]string{
-					string(apimanagement.BackendProtocolHTTP),
-					string(apimanagement.BackendProtocolSoap),
-				}, false),
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(backend.PossibleValuesForBackendProtocol(), false),
+				RequiredWith: []string{"url"},
 			},
 
 			"proxy": {
@@ -246,8 +372,10 @@ func resourceApiManagementBackend() *pluginsdk.Resource {
 
 			"url": {
 				Type:         pluginsdk.TypeString,
-				Required:     true,
+				Optional:     true,
 				ValidateFunc: validation.StringIsNotEmpty,
+				ExactlyOneOf: []string{"pool", "url"},
+				RequiredWith: []string{"protocol"},
 			},
 		},
 	}
@@ -260,46 +388,48 @@ func resourceApiManagementBackendCreateUpdate(d *pluginsdk.ResourceData, meta in
 	defer cancel()
 
 	id := parse.NewBackendID(subscriptionId, d.Get("resource_group_name").(string), d.Get("api_management_name").(string), d.Get("name").(string))
+	backendId := backend.NewBackendID(id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.Name)
 
 	if d.IsNewResource() {
-		existing, err := client.Get(ctx, id.ResourceGroup, id.ServiceName, id.Name)
+		existing, err := client.Get(ctx, backendId)
 		if err != nil {
-			if !utils.ResponseWasNotFound(existing.Response) {
+			if !response.WasNotFound(existing.HttpResponse) {
 				return fmt.Errorf("checking for presence of existing %s: %s", id, err)
 			}
 		}
 
-		if !utils.ResponseWasNotFound(existing.Response) {
+		if !response.WasNotFound(existing.HttpResponse) {
 			return tf.ImportAsExistsError("azurerm_api_management_backend", id.ID())
 		}
 	}
 
-	credentialsRaw := d.Get("credentials").([]interface{})
-	credentials := expandApiManagementBackendCredentials(credentialsRaw)
-	protocol := d.Get("protocol").(string)
-	proxyRaw := d.Get("proxy").([]interface{})
-	proxy := expandApiManagementBackendProxy(proxyRaw)
-	tlsRaw := d.Get("tls").([]interface{})
-	tls := expandApiManagementBackendTls(tlsRaw)
-	url := d.Get("url").(string)
-
-	backendContract := apimanagement.BackendContract{
-		BackendContractProperties: &apimanagement.BackendContractProperties{
-			Credentials: credentials,
-			Protocol:    apimanagement.BackendProtocol(protocol),
-			Proxy:       proxy,
-			TLS:         tls,
-			URL:         utils.String(url),
-		},
+	backendType := backend.BackendTypeSingle
+	props := backend.BackendContractProperties{
+		CircuitBreaker: expandApiManagementBackendCircuitBreaker(d.Get("circuit_breaker_rule").([]interface{})),
+		Credentials:    expandApiManagementBackendCredentials(d.Get("credentials").([]interface{})),
+		Proxy:          expandApiManagementBackendProxy(d.Get("proxy").([]interface{})),
+		Tls:            expandApiManagementBackendTls(d.Get("tls").([]interface{})),
 	}
+
+	if poolRaw, ok := d.GetOk("pool"); ok {
+		// a pool load-balances across other (single) backends within the API Management Service, so has no url/protocol
+		backendType = backend.BackendTypePool
+		props.Pool = expandApiManagementBackendPool(poolRaw.([]interface{}))
+	} else {
+		protocol := backend.BackendProtocol(d.Get("protocol").(string))
+		props.Protocol = &protocol
+		props.Url = utils.String(d.Get("url").(string))
+	}
+	props.Type = &backendType
+
 	if description, ok := d.GetOk("description"); ok {
-		backendContract.BackendContractProperties.Description = utils.String(description.(string))
+		props.Description = utils.String(description.(string))
 	}
 	if resourceID, ok := d.GetOk("resource_id"); ok {
-		backendContract.BackendContractProperties.ResourceID = utils.String(resourceID.(string))
+		props.ResourceId = utils.String(resourceID.(string))
 	}
 	if title, ok := d.GetOk("title"); ok {
-		backendContract.BackendContractProperties.Title = utils.String(title.(string))
+		props.Title = utils.String(title.(string))
 	}
 
 	if serviceFabricClusterRaw, ok := d.GetOk("service_fabric_cluster"); ok {
@@ -307,12 +437,16 @@ func resourceApiManagementBackendCreateUpdate(d *pluginsdk.ResourceData, meta in
 		if err != nil {
 			return err
 		}
-		backendContract.BackendContractProperties.Properties = &apimanagement.BackendProperties{
+		props.Properties = &backend.BackendProperties{
 			ServiceFabricCluster: serviceFabricCluster,
 		}
 	}
 
-	if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.ServiceName, id.Name, backendContract, ""); err != nil {
+	backendContract := backend.BackendContract{
+		Properties: &props,
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, backendId, backendContract, backend.DefaultCreateOrUpdateOperationOptions()); err != nil {
 		return fmt.Errorf("creating/updating %s: %+v", id, err)
 	}
 
@@ -329,9 +463,9 @@ func resourceApiManagementBackendRead(d *pluginsdk.ResourceData, meta interface{
 		return err
 	}
 
-	resp, err := client.Get(ctx, id.ResourceGroup, id.ServiceName, id.Name)
+	resp, err := client.Get(ctx, backend.NewBackendID(id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.Name))
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if response.WasNotFound(resp.HttpResponse) {
 			log.Printf("[DEBUG] %s does not exist - removing from state!", *id)
 			d.SetId("")
 			return nil
@@ -344,25 +478,37 @@ func resourceApiManagementBackendRead(d *pluginsdk.ResourceData, meta interface{
 	d.Set("api_management_name", id.ServiceName)
 	d.Set("resource_group_name", id.ResourceGroup)
 
-	if props := resp.BackendContractProperties; props != nil {
-		d.Set("description", props.Description)
-		d.Set("protocol", string(props.Protocol))
-		d.Set("resource_id", props.ResourceID)
-		d.Set("title", props.Title)
-		d.Set("url", props.URL)
-		if err := d.Set("credentials", flattenApiManagementBackendCredentials(props.Credentials)); err != nil {
-			return fmt.Errorf("setting `credentials`: %s", err)
-		}
-		if err := d.Set("proxy", flattenApiManagementBackendProxy(props.Proxy)); err != nil {
-			return fmt.Errorf("setting `proxy`: %s", err)
-		}
-		if properties := props.Properties; properties != nil {
-			if err := d.Set("service_fabric_cluster", flattenApiManagementBackendServiceFabricCluster(properties.ServiceFabricCluster)); err != nil {
-				return fmt.Errorf("setting `service_fabric_cluster`: %s", err)
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			d.Set("description", props.Description)
+			protocol := ""
+			if props.Protocol != nil {
+				protocol = string(*props.Protocol)
+			}
+			d.Set("protocol", protocol)
+			d.Set("resource_id", props.ResourceId)
+			d.Set("title", props.Title)
+			d.Set("url", props.Url)
+			if err := d.Set("circuit_breaker_rule", flattenApiManagementBackendCircuitBreaker(props.CircuitBreaker)); err != nil {
+				return fmt.Errorf("setting `circuit_breaker_rule`: %s", err)
+			}
+			if err := d.Set("credentials", flattenApiManagementBackendCredentials(props.Credentials)); err != nil {
+				return fmt.Errorf("setting `credentials`: %s", err)
+			}
+			if err := d.Set("pool", flattenApiManagementBackendPool(props.Pool)); err != nil {
+				return fmt.Errorf("setting `pool`: %s", err)
+			}
+			if err := d.Set("proxy", flattenApiManagementBackendProxy(props.Proxy)); err != nil {
+				return fmt.Errorf("setting `proxy`: %s", err)
+			}
+			if properties := props.Properties; properties != nil {
+				if err := d.Set("service_fabric_cluster", flattenApiManagementBackendServiceFabricCluster(properties.ServiceFabricCluster)); err != nil {
+					return fmt.Errorf("setting `service_fabric_cluster`: %s", err)
+				}
+			}
+			if err := d.Set("tls", flattenApiManagementBackendTls(props.Tls)); err != nil {
+				return fmt.Errorf("setting `tls`: %s", err)
 			}
-		}
-		if err := d.Set("tls", flattenApiManagementBackendTls(props.TLS)); err != nil {
-			return fmt.Errorf("setting `tls`: %s", err)
 		}
 	}
 
@@ -379,8 +525,11 @@ func resourceApiManagementBackendDelete(d *pluginsdk.ResourceData, meta interfac
 		return err
 	}
 
-	if resp, err := client.Delete(ctx, id.ResourceGroup, id.ServiceName, id.Name, ""); err != nil {
-		if !utils.ResponseWasNotFound(resp) {
+	options := backend.DeleteOperationOptions{
+		IfMatch: utils.String("*"),
+	}
+	if resp, err := client.Delete(ctx, backend.NewBackendID(id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.Name), options); err != nil {
+		if !response.WasNotFound(resp.HttpResponse) {
 			return fmt.Errorf("deleting %s: %s", *id, err)
 		}
 	}
@@ -388,12 +537,67 @@ func resourceApiManagementBackendDelete(d *pluginsdk.ResourceData, meta interfac
 	return nil
 }
 
-func expandApiManagementBackendCredentials(input []interface{}) *apimanagement.BackendCredentialsContract {
+func expandApiManagementBackendCircuitBreaker(input []interface{}) *backend.BackendCircuitBreaker {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	rules := make([]backend.CircuitBreakerRule, 0)
+	for _, item := range input {
+		v := item.(map[string]interface{})
+		rules = append(rules, backend.CircuitBreakerRule{
+			AcceptRetryAfter: utils.Bool(v["accept_retry_after_enabled"].(bool)),
+			FailureCondition: expandApiManagementBackendCircuitBreakerFailureCondition(v["failure_condition"].([]interface{})),
+			Name:             utils.String(v["name"].(string)),
+			TripDuration:     utils.String(v["trip_duration"].(string)),
+		})
+	}
+
+	return &backend.BackendCircuitBreaker{
+		Rules: &rules,
+	}
+}
+
+func expandApiManagementBackendCircuitBreakerFailureCondition(input []interface{}) *backend.CircuitBreakerFailureCondition {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+	v := input[0].(map[string]interface{})
+
+	condition := backend.CircuitBreakerFailureCondition{
+		Interval: utils.String(v["interval_duration"].(string)),
+	}
+	if count := v["count"].(int); count > 0 {
+		condition.Count = utils.Int64(int64(count))
+	}
+	if percentage := v["percentage"].(int); percentage > 0 {
+		condition.Percentage = utils.Int64(int64(percentage))
+	}
+	if errorReasons := v["error_reasons"].([]interface{}); len(errorReasons) > 0 {
+		condition.ErrorReasons = utils.ExpandStringSlice(errorReasons)
+	}
+
+	statusCodeRanges := make([]backend.FailureStatusCodeRange, 0)
+	for _, item := range v["status_code_range"].([]interface{}) {
+		r := item.(map[string]interface{})
+		statusCodeRanges = append(statusCodeRanges, backend.FailureStatusCodeRange{
+			Max: utils.Int64(int64(r["max"].(int))),
+			Min: utils.Int64(int64(r["min"].(int))),
+		})
+	}
+	if len(statusCodeRanges) > 0 {
+		condition.StatusCodeRanges = &statusCodeRanges
+	}
+
+	return &condition
+}
+
+func expandApiManagementBackendCredentials(input []interface{}) *backend.BackendCredentialsContract {
 	if len(input) == 0 {
 		return nil
 	}
 	v := input[0].(map[string]interface{})
-	contract := apimanagement.BackendCredentialsContract{}
+	contract := backend.BackendCredentialsContract{}
 	if authorizationRaw := v["authorization"]; authorizationRaw != nil {
 		authorization := expandApiManagementBackendCredentialsAuthorization(authorizationRaw.([]interface{}))
 		contract.Authorization = authorization
@@ -405,22 +609,20 @@ func expandApiManagementBackendCredentials(input []interface{}) *apimanagement.B
 		}
 	}
 	if headerRaw := v["header"]; headerRaw != nil {
-		header := expandApiManagementBackendCredentialsObject(headerRaw.(map[string]interface{}))
-		contract.Header = *header
+		contract.Header = expandApiManagementBackendCredentialsObject(headerRaw.(map[string]interface{}))
 	}
 	if queryRaw := v["query"]; queryRaw != nil {
-		query := expandApiManagementBackendCredentialsObject(queryRaw.(map[string]interface{}))
-		contract.Query = *query
+		contract.Query = expandApiManagementBackendCredentialsObject(queryRaw.(map[string]interface{}))
 	}
 	return &contract
 }
 
-func expandApiManagementBackendCredentialsAuthorization(input []interface{}) *apimanagement.BackendAuthorizationHeaderCredentials {
+func expandApiManagementBackendCredentialsAuthorization(input []interface{}) *backend.BackendAuthorizationHeaderCredentials {
 	if len(input) == 0 {
 		return nil
 	}
 	v := input[0].(map[string]interface{})
-	credentials := apimanagement.BackendAuthorizationHeaderCredentials{}
+	credentials := backend.BackendAuthorizationHeaderCredentials{}
 	if parameter := v["parameter"]; parameter != nil {
 		credentials.Parameter = utils.String(parameter.(string))
 	}
@@ -438,17 +640,43 @@ func expandApiManagementBackendCredentialsObject(input map[string]interface{}) *
 	return &output
 }
 
-func expandApiManagementBackendProxy(input []interface{}) *apimanagement.BackendProxyContract {
+func expandApiManagementBackendPool(input []interface{}) *backend.BackendBaseParametersPool {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+	v := input[0].(map[string]interface{})
+
+	services := make([]backend.BackendPoolItem, 0)
+	for _, item := range v["service"].([]interface{}) {
+		service := item.(map[string]interface{})
+		poolItem := backend.BackendPoolItem{
+			Id: service["id"].(string),
+		}
+		if priority := service["priority"].(int); priority > 0 {
+			poolItem.Priority = utils.Int64(int64(priority))
+		}
+		if weight := service["weight"].(int); weight > 0 {
+			poolItem.Weight = utils.Int64(int64(weight))
+		}
+		services = append(services, poolItem)
+	}
+
+	return &backend.BackendBaseParametersPool{
+		Services: &services,
+	}
+}
+
+func expandApiManagementBackendProxy(input []interface{}) *backend.BackendProxyContract {
 	if len(input) == 0 {
 		return nil
 	}
 	v := input[0].(map[string]interface{})
-	contract := apimanagement.BackendProxyContract{}
+	contract := backend.BackendProxyContract{}
 	if password := v["password"]; password != nil {
 		contract.Password = utils.String(password.(string))
 	}
 	if url := v["url"]; url != nil {
-		contract.URL = utils.String(url.(string))
+		contract.Url = url.(string)
 	}
 	if username := v["username"]; username != nil {
 		contract.Username = utils.String(username.(string))
@@ -456,16 +684,16 @@ func expandApiManagementBackendProxy(input []interface{}) *apimanagement.Backend
 	return &contract
 }
 
-func expandApiManagementBackendServiceFabricCluster(input []interface{}) (error, *apimanagement.BackendServiceFabricClusterProperties) {
+func expandApiManagementBackendServiceFabricCluster(input []interface{}) (error, *backend.BackendServiceFabricClusterProperties) {
 	if len(input) == 0 {
 		return nil, nil
 	}
 	v := input[0].(map[string]interface{})
 	managementEndpoints := v["management_endpoints"].(*pluginsdk.Set).List()
-	maxPartitionResolutionRetries := int32(v["max_partition_resolution_retries"].(int))
-	properties := apimanagement.BackendServiceFabricClusterProperties{
-		ManagementEndpoints:           utils.ExpandStringSlice(managementEndpoints),
-		MaxPartitionResolutionRetries: utils.Int32(maxPartitionResolutionRetries),
+	maxPartitionResolutionRetries := int64(v["max_partition_resolution_retries"].(int))
+	properties := backend.BackendServiceFabricClusterProperties{
+		ManagementEndpoints:           *utils.ExpandStringSlice(managementEndpoints),
+		MaxPartitionResolutionRetries: utils.Int64(maxPartitionResolutionRetries),
 	}
 
 	if v2, ok := v["client_certificate_thumbprint"].(string); ok && v2 != "" {
@@ -473,10 +701,10 @@ func expandApiManagementBackendServiceFabricCluster(input []interface{}) (error,
 	}
 
 	if v2, ok := v["client_certificate_id"].(string); ok && v2 != "" {
-		properties.ClientCertificateID = utils.String(v2)
+		properties.ClientCertificateId = utils.String(v2)
 	}
 
-	if properties.ClientCertificateID == nil && properties.ClientCertificatethumbprint == nil {
+	if properties.ClientCertificateId == nil && properties.ClientCertificatethumbprint == nil {
 		return fmt.Errorf("at least one of `client_certificate_thumbprint` and `client_certificate_id` must be set"), nil
 	}
 
@@ -496,11 +724,11 @@ func expandApiManagementBackendServiceFabricCluster(input []interface{}) (error,
 	return nil, &properties
 }
 
-func expandApiManagementBackendServiceFabricClusterServerX509Names(input []interface{}) *[]apimanagement.X509CertificateName {
-	results := make([]apimanagement.X509CertificateName, 0)
+func expandApiManagementBackendServiceFabricClusterServerX509Names(input []interface{}) *[]backend.X509CertificateName {
+	results := make([]backend.X509CertificateName, 0)
 	for _, certificateName := range input {
 		v := certificateName.(map[string]interface{})
-		result := apimanagement.X509CertificateName{
+		result := backend.X509CertificateName{
 			IssuerCertificateThumbprint: utils.String(v["issuer_certificate_thumbprint"].(string)),
 			Name:                        utils.String(v["name"].(string)),
 		}
@@ -509,12 +737,12 @@ func expandApiManagementBackendServiceFabricClusterServerX509Names(input []inter
 	return &results
 }
 
-func expandApiManagementBackendTls(input []interface{}) *apimanagement.BackendTLSProperties {
+func expandApiManagementBackendTls(input []interface{}) *backend.BackendTlsProperties {
 	if len(input) == 0 {
 		return nil
 	}
 	v := input[0].(map[string]interface{})
-	properties := apimanagement.BackendTLSProperties{}
+	properties := backend.BackendTlsProperties{}
 	if validateCertificateChain := v["validate_certificate_chain"]; validateCertificateChain != nil {
 		properties.ValidateCertificateChain = utils.Bool(validateCertificateChain.(bool))
 	}
@@ -524,7 +752,83 @@ func expandApiManagementBackendTls(input []interface{}) *apimanagement.BackendTL
 	return &properties
 }
 
-func flattenApiManagementBackendCredentials(input *apimanagement.BackendCredentialsContract) []interface{} {
+func flattenApiManagementBackendCircuitBreaker(input *backend.BackendCircuitBreaker) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil || input.Rules == nil {
+		return results
+	}
+
+	for _, rule := range *input.Rules {
+		acceptRetryAfter := false
+		if rule.AcceptRetryAfter != nil {
+			acceptRetryAfter = *rule.AcceptRetryAfter
+		}
+		name := ""
+		if rule.Name != nil {
+			name = *rule.Name
+		}
+		tripDuration := ""
+		if rule.TripDuration != nil {
+			tripDuration = *rule.TripDuration
+		}
+
+		results = append(results, map[string]interface{}{
+			"accept_retry_after_enabled": acceptRetryAfter,
+			"failure_condition":          flattenApiManagementBackendCircuitBreakerFailureCondition(rule.FailureCondition),
+			"name":                       name,
+			"trip_duration":              tripDuration,
+		})
+	}
+	return results
+}
+
+func flattenApiManagementBackendCircuitBreakerFailureCondition(input *backend.CircuitBreakerFailureCondition) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	count := 0
+	if input.Count != nil {
+		count = int(*input.Count)
+	}
+	interval := ""
+	if input.Interval != nil {
+		interval = *input.Interval
+	}
+	percentage := 0
+	if input.Percentage != nil {
+		percentage = int(*input.Percentage)
+	}
+
+	statusCodeRanges := make([]interface{}, 0)
+	if input.StatusCodeRanges != nil {
+		for _, r := range *input.StatusCodeRanges {
+			min := 0
+			if r.Min != nil {
+				min = int(*r.Min)
+			}
+			max := 0
+			if r.Max != nil {
+				max = int(*r.Max)
+			}
+			statusCodeRanges = append(statusCodeRanges, map[string]interface{}{
+				"max": max,
+				"min": min,
+			})
+		}
+	}
+
+	return append(results, map[string]interface{}{
+		"count":             count,
+		"error_reasons":     utils.FlattenStringSlice(input.ErrorReasons),
+		"interval_duration": interval,
+		"percentage":        percentage,
+		"status_code_range": statusCodeRanges,
+	})
+}
+
+func flattenApiManagementBackendCredentials(input *backend.BackendCredentialsContract) []interface{} {
 	results := make([]interface{}, 0)
 	if input == nil {
 		return results
@@ -539,18 +843,18 @@ func flattenApiManagementBackendCredentials(input *apimanagement.BackendCredenti
 	return append(results, result)
 }
 
-func flattenApiManagementBackendCredentialsObject(input map[string][]string) map[string]interface{} {
+func flattenApiManagementBackendCredentialsObject(input *map[string][]string) map[string]interface{} {
 	results := make(map[string]interface{})
 	if input == nil {
 		return results
 	}
-	for k, v := range input {
+	for k, v := range *input {
 		results[k] = strings.Join(v, ",")
 	}
 	return results
 }
 
-func flattenApiManagementBackendCredentialsAuthorization(input *apimanagement.BackendAuthorizationHeaderCredentials) []interface{} {
+func flattenApiManagementBackendCredentialsAuthorization(input *backend.BackendAuthorizationHeaderCredentials) []interface{} {
 	results := make([]interface{}, 0)
 	if input == nil {
 		return results
@@ -565,7 +869,35 @@ func flattenApiManagementBackendCredentialsAuthorization(input *apimanagement.Ba
 	return append(results, result)
 }
 
-func flattenApiManagementBackendProxy(input *apimanagement.BackendProxyContract) []interface{} {
+func flattenApiManagementBackendPool(input *backend.BackendBaseParametersPool) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil || input.Services == nil {
+		return results
+	}
+
+	services := make([]interface{}, 0)
+	for _, item := range *input.Services {
+		priority := 0
+		if item.Priority != nil {
+			priority = int(*item.Priority)
+		}
+		weight := 0
+		if item.Weight != nil {
+			weight = int(*item.Weight)
+		}
+		services = append(services, map[string]interface{}{
+			"id":       item.Id,
+			"priority": priority,
+			"weight":   weight,
+		})
+	}
+
+	return append(results, map[string]interface{}{
+		"service": services,
+	})
+}
+
+func flattenApiManagementBackendProxy(input *backend.BackendProxyContract) []interface{} {
 	results := make([]interface{}, 0)
 	if input == nil {
 		return results
@@ -574,16 +906,14 @@ func flattenApiManagementBackendProxy(input *apimanagement.BackendProxyContract)
 	if password := input.Password; password != nil {
 		result["password"] = *password
 	}
-	if url := input.URL; url != nil {
-		result["url"] = *url
-	}
+	result["url"] = input.Url
 	if username := input.Username; username != nil {
 		result["username"] = *username
 	}
 	return append(results, result)
 }
 
-func flattenApiManagementBackendServiceFabricCluster(input *apimanagement.BackendServiceFabricClusterProperties) []interface{} {
+func flattenApiManagementBackendServiceFabricCluster(input *backend.BackendServiceFabricClusterProperties) []interface{} {
 	results := make([]interface{}, 0)
 	if input == nil {
 		return results
@@ -593,13 +923,11 @@ func flattenApiManagementBackendServiceFabricCluster(input *apimanagement.Backen
 		result["client_certificate_thumbprint"] = *clientCertificatethumbprint
 	}
 
-	if input.ClientCertificateID != nil {
-		result["client_certificate_id"] = *input.ClientCertificateID
+	if input.ClientCertificateId != nil {
+		result["client_certificate_id"] = *input.ClientCertificateId
 	}
 
-	if managementEndpoints := input.ManagementEndpoints; managementEndpoints != nil {
-		result["management_endpoints"] = *managementEndpoints
-	}
+	result["management_endpoints"] = input.ManagementEndpoints
 	if maxPartitionResolutionRetries := input.MaxPartitionResolutionRetries; maxPartitionResolutionRetries != nil {
 		result["max_partition_resolution_retries"] = int(*maxPartitionResolutionRetries)
 	}
@@ -610,7 +938,7 @@ func flattenApiManagementBackendServiceFabricCluster(input *apimanagement.Backen
 	return append(results, result)
 }
 
-func flattenApiManagementBackendServiceFabricClusterServerX509Names(input *[]apimanagement.X509CertificateName) []interface{} {
+func flattenApiManagementBackendServiceFabricClusterServerX509Names(input *[]backend.X509CertificateName) []interface{} {
 	results := make([]interface{}, 0)
 	if input == nil {
 		return results
@@ -628,7 +956,7 @@ func flattenApiManagementBackendServiceFabricClusterServerX509Names(input *[]api
 	return results
 }
 
-func flattenApiManagementBackendTls(input *apimanagement.BackendTLSProperties) []interface{} {
+func flattenApiManagementBackendTls(input *backend.BackendTlsProperties) []interface{} {
 	results := make([]interface{}, 0)
 	if input == nil {
 		return results
@@ -5,10 +5,12 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2023-09-01-preview/backend"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
@@ -159,6 +161,59 @@ func TestAccApiManagementBackend_serviceFabricClientCertificateId(t *testing.T)
 	})
 }
 
+func TestAccApiManagementBackend_circuitBreaker(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_backend", "test")
+	r := ApiManagementAuthorizationBackendResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.circuitBreaker(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.circuitBreakerUpdated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data, "circuitbreaker"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApiManagementBackend_pool(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_backend", "test")
+	r := ApiManagementAuthorizationBackendResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.pool(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("pool.0.service.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.poolUpdated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("pool.0.service.#").HasValue("3"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccApiManagementBackend_disappears(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_api_management_backend", "test")
 	r := ApiManagementAuthorizationBackendResource{}
@@ -194,12 +249,12 @@ func (ApiManagementAuthorizationBackendResource) Exists(ctx context.Context, cli
 		return nil, err
 	}
 
-	resp, err := clients.ApiManagement.BackendClient.Get(ctx, id.ResourceGroup, id.ServiceName, id.Name)
+	resp, err := clients.ApiManagement.BackendClient.Get(ctx, backend.NewBackendID(id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.Name))
 	if err != nil {
 		return nil, fmt.Errorf("reading %s: %+v", *id, err)
 	}
 
-	return utils.Bool(resp.ID != nil), nil
+	return utils.Bool(resp.Model != nil && resp.Model.Id != nil), nil
 }
 
 func (r ApiManagementAuthorizationBackendResource) Destroy(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
@@ -208,9 +263,12 @@ func (r ApiManagementAuthorizationBackendResource) Destroy(ctx context.Context,
 		return nil, err
 	}
 
-	resp, err := client.ApiManagement.BackendClient.Delete(ctx, id.ResourceGroup, id.ServiceName, id.Name, "")
+	options := backend.DeleteOperationOptions{
+		IfMatch: utils.String("*"),
+	}
+	resp, err := client.ApiManagement.BackendClient.Delete(ctx, backend.NewBackendID(id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.Name), options)
 	if err != nil {
-		if utils.ResponseWasNotFound(resp) {
+		if response.WasNotFound(resp.HttpResponse) {
 			return utils.Bool(true), nil
 		}
 		return nil, fmt.Errorf("deleting Backend: %+v", err)
@@ -375,6 +433,156 @@ resource "azurerm_api_management_backend" "test" {
 `, r.template(data, "sf"), data.RandomInteger)
 }
 
+func (r ApiManagementAuthorizationBackendResource) circuitBreaker(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_backend" "test" {
+  name                = "acctestapi-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  api_management_name = azurerm_api_management.test.name
+  protocol            = "http"
+  url                 = "https://acctest"
+
+  circuit_breaker_rule {
+    name          = "acctest-rule"
+    trip_duration = "PT1M"
+
+    failure_condition {
+      count             = 3
+      interval_duration = "PT1H"
+
+      status_code_range {
+        min = 500
+        max = 599
+      }
+    }
+  }
+}
+`, r.template(data, "circuitbreaker"), data.RandomInteger)
+}
+
+func (r ApiManagementAuthorizationBackendResource) circuitBreakerUpdated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_backend" "test" {
+  name                = "acctestapi-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  api_management_name = azurerm_api_management.test.name
+  protocol            = "http"
+  url                 = "https://acctest"
+
+  circuit_breaker_rule {
+    name                       = "acctest-rule-updated"
+    trip_duration              = "PT5M"
+    accept_retry_after_enabled = true
+
+    failure_condition {
+      percentage        = 50
+      interval_duration = "PT30M"
+      error_reasons     = ["timeout", "BackendConnectionFailure"]
+
+      status_code_range {
+        min = 429
+        max = 429
+      }
+
+      status_code_range {
+        min = 500
+        max = 503
+      }
+    }
+  }
+}
+`, r.template(data, "circuitbreaker"), data.RandomInteger)
+}
+
+func (r ApiManagementAuthorizationBackendResource) poolTemplate(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_backend" "first" {
+  name                = "acctestapi-first-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  api_management_name = azurerm_api_management.test.name
+  protocol            = "http"
+  url                 = "https://first.acctest"
+}
+
+resource "azurerm_api_management_backend" "second" {
+  name                = "acctestapi-second-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  api_management_name = azurerm_api_management.test.name
+  protocol            = "http"
+  url                 = "https://second.acctest"
+}
+
+resource "azurerm_api_management_backend" "third" {
+  name                = "acctestapi-third-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  api_management_name = azurerm_api_management.test.name
+  protocol            = "http"
+  url                 = "https://third.acctest"
+}
+`, r.template(data, "pool"), data.RandomInteger, data.RandomInteger, data.RandomInteger)
+}
+
+func (r ApiManagementAuthorizationBackendResource) pool(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_backend" "test" {
+  name                = "acctestapi-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  api_management_name = azurerm_api_management.test.name
+  description         = "load-balanced pool"
+
+  pool {
+    service {
+      id = azurerm_api_management_backend.first.id
+    }
+
+    service {
+      id = azurerm_api_management_backend.second.id
+    }
+  }
+}
+`, r.poolTemplate(data), data.RandomInteger)
+}
+
+func (r ApiManagementAuthorizationBackendResource) poolUpdated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_backend" "test" {
+  name                = "acctestapi-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  api_management_name = azurerm_api_management.test.name
+  description         = "load-balanced pool"
+
+  pool {
+    service {
+      id       = azurerm_api_management_backend.first.id
+      priority = 1
+      weight   = 3
+    }
+
+    service {
+      id       = azurerm_api_management_backend.second.id
+      priority = 1
+      weight   = 1
+    }
+
+    service {
+      id       = azurerm_api_management_backend.third.id
+      priority = 2
+    }
+  }
+}
+`, r.poolTemplate(data), data.RandomInteger)
+}
+
 func (r ApiManagementAuthorizationBackendResource) requiresImport(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
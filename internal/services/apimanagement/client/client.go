@@ -3,6 +3,7 @@ package client
 import (
 	"github.com/Azure/azure-sdk-for-go/services/apimanagement/mgmt/2021-08-01/apimanagement"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2023-09-01-preview/backend"
 )
 
 type Client struct {
@@ -15,7 +16,7 @@ type Client struct {
 	ApiSchemasClient                 *apimanagement.APISchemaClient
 	ApiVersionSetClient              *apimanagement.APIVersionSetClient
 	AuthorizationServersClient       *apimanagement.AuthorizationServerClient
-	BackendClient                    *apimanagement.BackendClient
+	BackendClient                    *backend.BackendClient
 	CacheClient                      *apimanagement.CacheClient
 	CertificatesClient               *apimanagement.CertificateClient
 	DiagnosticClient                 *apimanagement.DiagnosticClient
@@ -73,7 +74,7 @@ func NewClient(o *common.ClientOptions) *Client {
 	authorizationServersClient := apimanagement.NewAuthorizationServerClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&authorizationServersClient.Client, o.ResourceManagerAuthorizer)
 
-	backendClient := apimanagement.NewBackendClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	backendClient := backend.NewBackendClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&backendClient.Client, o.ResourceManagerAuthorizer)
 
 	cacheClient := apimanagement.NewCacheClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
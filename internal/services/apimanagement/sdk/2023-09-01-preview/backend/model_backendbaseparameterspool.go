@@ -0,0 +1,5 @@
+package backend
+
+type BackendBaseParametersPool struct {
+	Services *[]BackendPoolItem `json:"services,omitempty"`
+}
@@ -0,0 +1,9 @@
+package backend
+
+type CircuitBreakerFailureCondition struct {
+	Count            *int64                    `json:"count,omitempty"`
+	ErrorReasons     *[]string                 `json:"errorReasons,omitempty"`
+	Interval         *string                   `json:"interval,omitempty"`
+	Percentage       *int64                    `json:"percentage,omitempty"`
+	StatusCodeRanges *[]FailureStatusCodeRange `json:"statusCodeRanges,omitempty"`
+}
@@ -0,0 +1,8 @@
+package backend
+
+type CircuitBreakerRule struct {
+	AcceptRetryAfter *bool                           `json:"acceptRetryAfter,omitempty"`
+	FailureCondition *CircuitBreakerFailureCondition `json:"failureCondition,omitempty"`
+	Name             *string                         `json:"name,omitempty"`
+	TripDuration     *string                         `json:"tripDuration,omitempty"`
+}
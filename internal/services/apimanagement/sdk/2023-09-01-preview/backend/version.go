@@ -0,0 +1,9 @@
+package backend
+
+import "fmt"
+
+const defaultApiVersion = "2023-09-01-preview"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/backend/%s", defaultApiVersion)
+}
@@ -1,8 +1,10 @@
 package appconfiguration
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"net/http"
 	"strings"
 	"time"
 
@@ -13,12 +15,13 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/identity"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
-	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/sdk/2020-06-01/configurationstores"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/sdk/2023-03-01/configurationstores"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
 type appConfigurationIdentityType = identity.SystemAssignedUserAssigned
@@ -68,6 +71,20 @@ func resourceAppConfiguration() *pluginsdk.Resource {
 				}, false),
 			},
 
+			"purge_protection_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"soft_delete_retention_days": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      7,
+				ValidateFunc: validation.IntBetween(1, 7),
+			},
+
 			"endpoint": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
@@ -171,7 +188,30 @@ func resourceAppConfiguration() *pluginsdk.Resource {
 
 			"tags": tags.Schema(),
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceAppConfigurationCustomizeDiff),
+	}
+}
+
+// resourceAppConfigurationCustomizeDiff rejects the soft-delete arguments for the `free` sku, which doesn't support
+// soft-delete - since `soft_delete_retention_days` has a default the raw config is used to determine if it's been set
+func resourceAppConfigurationCustomizeDiff(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
+	if !strings.EqualFold(diff.Get("sku").(string), "free") {
+		return nil
+	}
+
+	if diff.Get("purge_protection_enabled").(bool) {
+		return fmt.Errorf("`purge_protection_enabled` is not supported when `sku` is set to `free`")
+	}
+
+	config := diff.GetRawConfig()
+	if !config.IsNull() && config.IsKnown() {
+		if raw := config.GetAttr("soft_delete_retention_days"); !raw.IsNull() {
+			return fmt.Errorf("`soft_delete_retention_days` is not supported when `sku` is set to `free`")
+		}
 	}
+
+	return nil
 }
 
 func resourceAppConfigurationCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -195,12 +235,47 @@ func resourceAppConfigurationCreate(d *pluginsdk.ResourceData, meta interface{})
 		return tf.ImportAsExistsError("azurerm_app_configuration", resourceId.ID())
 	}
 
+	location := azure.NormalizeLocation(d.Get("location").(string))
+
+	// before creating check to see if the App Configuration exists in the soft delete state
+	deletedId := configurationstores.NewDeletedConfigurationStoreID(subscriptionId, location, name)
+	deleted, err := client.GetDeleted(ctx, deletedId)
+	if err != nil {
+		// If Terraform lacks permission to read at the Subscription we'll get 403, not 404
+		if !response.WasNotFound(deleted.HttpResponse) && !wasForbidden(deleted.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", deletedId, err)
+		}
+	}
+
+	// if so, does the user want us to recover it?
+	recoverSoftDeleted := false
+	if !response.WasNotFound(deleted.HttpResponse) && !wasForbidden(deleted.HttpResponse) {
+		if !meta.(*clients.Client).Features.AppConfiguration.RecoverSoftDeleted {
+			// this exists but the users opted out so they must import this it out-of-band
+			return fmt.Errorf(optedOutOfRecoveringSoftDeletedAppConfigurationErrorFmt(name, location))
+		}
+
+		recoverSoftDeleted = true
+	}
+
 	parameters := configurationstores.ConfigurationStore{
-		Location: azure.NormalizeLocation(d.Get("location").(string)),
+		Location: location,
 		Sku: configurationstores.Sku{
 			Name: d.Get("sku").(string),
 		},
-		Tags: tagsHelper.Expand(d.Get("tags").(map[string]interface{})),
+		Properties: &configurationstores.ConfigurationStoreProperties{},
+		Tags:       tagsHelper.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	// soft-delete (and therefore purge protection) is only supported by the `standard` sku
+	if parameters.Sku.Name == "standard" {
+		parameters.Properties.EnablePurgeProtection = utils.Bool(d.Get("purge_protection_enabled").(bool))
+		parameters.Properties.SoftDeleteRetentionInDays = utils.Int64(int64(d.Get("soft_delete_retention_days").(int)))
+	}
+
+	if recoverSoftDeleted {
+		createMode := configurationstores.CreateModeRecover
+		parameters.Properties.CreateMode = &createMode
 	}
 
 	identity, err := expandAppConfigurationIdentity(d.Get("identity").([]interface{}))
@@ -214,6 +289,7 @@ func resourceAppConfigurationCreate(d *pluginsdk.ResourceData, meta interface{})
 	}
 
 	d.SetId(resourceId.ID())
+
 	return resourceAppConfigurationRead(d, meta)
 }
 
@@ -243,6 +319,17 @@ func resourceAppConfigurationUpdate(d *pluginsdk.ResourceData, meta interface{})
 		parameters.Identity = identity
 	}
 
+	if d.HasChange("purge_protection_enabled") {
+		oldValue, newValue := d.GetChange("purge_protection_enabled")
+		if oldValue.(bool) && !newValue.(bool) {
+			return fmt.Errorf("updating %s: once Purge Protection has been Enabled it's not possible to disable it", *id)
+		}
+
+		parameters.Properties = &configurationstores.ConfigurationStorePropertiesUpdateParameters{
+			EnablePurgeProtection: utils.Bool(newValue.(bool)),
+		}
+	}
+
 	if err := client.UpdateThenPoll(ctx, *id, parameters); err != nil {
 		return fmt.Errorf("updating %s: %+v", *id, err)
 	}
@@ -284,6 +371,18 @@ func resourceAppConfigurationRead(d *pluginsdk.ResourceData, meta interface{}) e
 
 		if props := model.Properties; props != nil {
 			d.Set("endpoint", props.Endpoint)
+
+			purgeProtectionEnabled := false
+			if props.EnablePurgeProtection != nil {
+				purgeProtectionEnabled = *props.EnablePurgeProtection
+			}
+			d.Set("purge_protection_enabled", purgeProtectionEnabled)
+
+			softDeleteRetentionDays := 7
+			if props.SoftDeleteRetentionInDays != nil {
+				softDeleteRetentionDays = int(*props.SoftDeleteRetentionInDays)
+			}
+			d.Set("soft_delete_retention_days", softDeleteRetentionDays)
 		}
 
 		accessKeys := flattenAppConfigurationAccessKeys(resultPage.Items)
@@ -312,13 +411,58 @@ func resourceAppConfigurationDelete(d *pluginsdk.ResourceData, meta interface{})
 		return err
 	}
 
+	existing, err := client.Get(ctx, *id)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	if existing.Model == nil {
+		return fmt.Errorf("retrieving %s: `model` was nil", *id)
+	}
+
 	if err := client.DeleteThenPoll(ctx, *id); err != nil {
 		return fmt.Errorf("deleting %s: %+v", *id, err)
 	}
 
+	// only the `standard` sku supports soft-delete, so there's nothing to purge for a `free` App Configuration
+	if meta.(*clients.Client).Features.AppConfiguration.PurgeSoftDeleteOnDestroy && strings.EqualFold(existing.Model.Sku.Name, "standard") {
+		// App Configurations with Purge Protection Enabled cannot be purged unless done by Azure
+		if props := existing.Model.Properties; props != nil && props.EnablePurgeProtection != nil && *props.EnablePurgeProtection {
+			log.Printf("[DEBUG] %s has Purge Protection Enabled and will be purged automatically by Azure", *id)
+			return nil
+		}
+
+		deletedId := configurationstores.NewDeletedConfigurationStoreID(id.SubscriptionId, location.Normalize(existing.Model.Location), id.ConfigStoreName)
+		log.Printf("[DEBUG] Purging %s..", deletedId)
+		if err := client.PurgeDeletedThenPoll(ctx, deletedId); err != nil {
+			return fmt.Errorf("purging %s: %+v", deletedId, err)
+		}
+		log.Printf("[DEBUG] Purged %s.", deletedId)
+	}
+
 	return nil
 }
 
+// wasForbidden returns whether the request was rejected since Terraform lacks permission at the Subscription scope
+func wasForbidden(resp *http.Response) bool {
+	return resp != nil && resp.StatusCode == http.StatusForbidden
+}
+
+func optedOutOfRecoveringSoftDeletedAppConfigurationErrorFmt(name, location string) string {
+	return fmt.Sprintf(`
+An existing soft-deleted App Configuration exists with the Name %q in the location %q, however
+automatically recovering this App Configuration has been disabled via the "features" block.
+
+Terraform can automatically recover the soft-deleted App Configuration when this behaviour is
+enabled within the "features" block (located within the "provider" block) - more
+information can be found here:
+
+https://registry.terraform.io/providers/hashicorp/azurerm/latest/docs#features
+
+Alternatively you can manually recover this (e.g. using the Azure CLI) and then import
+this into Terraform via "terraform import", or pick a different name/location.
+`, name, location)
+}
+
 type flattenedAccessKeys struct {
 	primaryReadKey    []interface{}
 	primaryWriteKey   []interface{}
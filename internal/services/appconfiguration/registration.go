@@ -20,6 +20,7 @@ func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
 		KeyResource{},
 		FeatureResource{},
+		ReplicaResource{},
 	}
 }
 
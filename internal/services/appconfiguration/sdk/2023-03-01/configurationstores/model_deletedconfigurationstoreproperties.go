@@ -0,0 +1,10 @@
+package configurationstores
+
+type DeletedConfigurationStoreProperties struct {
+	ConfigurationStoreId   *string            `json:"configurationStoreId,omitempty"`
+	DeletionDate           *string            `json:"deletionDate,omitempty"`
+	Location               *string            `json:"location,omitempty"`
+	PurgeProtectionEnabled *bool              `json:"purgeProtectionEnabled,omitempty"`
+	ScheduledPurgeDate     *string            `json:"scheduledPurgeDate,omitempty"`
+	Tags                   *map[string]string `json:"tags,omitempty"`
+}
@@ -0,0 +1,54 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestConfigurationStoreReplicaName(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "",
+			ErrCount: 1,
+		},
+		{
+			Value:    "a",
+			ErrCount: 0,
+		},
+		{
+			Value:    "replica1",
+			ErrCount: 0,
+		},
+		{
+			Value:    "replicaWestUS",
+			ErrCount: 0,
+		},
+		{
+			Value:    "replica-1",
+			ErrCount: 1,
+		},
+		{
+			Value:    "replica_1",
+			ErrCount: 1,
+		},
+		{
+			Value:    strings.Repeat("a", 50),
+			ErrCount: 0,
+		},
+		{
+			Value:    strings.Repeat("a", 51),
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := ConfigurationStoreReplicaName(tc.Value, "name")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Configuration Store Replica Name %q to trigger %d validation errors but got %d", tc.Value, tc.ErrCount, len(errors))
+		}
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/appinsights/mgmt/2020-02-02/insights"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceid"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/applicationinsights/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/applicationinsights/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -24,9 +25,8 @@ func resourceApplicationInsightsSmartDetectionRule() *pluginsdk.Resource {
 		Update: resourceApplicationInsightsSmartDetectionRuleUpdate,
 		Delete: resourceApplicationInsightsSmartDetectionRuleDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.SmartDetectionRuleID(id)
-			return err
+		Importer: pluginsdk.ImporterNormalizingResourceId(func(id string) (resourceid.Formatter, error) {
+			return parse.SmartDetectionRuleIDInsensitively(id)
 		}),
 
 		SchemaVersion: 1,
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceid"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/applicationinsights/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/applicationinsights/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
@@ -28,9 +29,8 @@ func resourceApplicationInsightsWebTests() *pluginsdk.Resource {
 		Read:   resourceApplicationInsightsWebTestsRead,
 		Update: resourceApplicationInsightsWebTestsCreateUpdate,
 		Delete: resourceApplicationInsightsWebTestsDelete,
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.WebTestID(id)
-			return err
+		Importer: pluginsdk.ImporterNormalizingResourceId(func(id string) (resourceid.Formatter, error) {
+			return parse.WebTestIDInsensitively(id)
 		}),
 
 		SchemaVersion: 1,
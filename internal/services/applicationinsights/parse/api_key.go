@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceid"
 )
 
 type ApiKeyId struct {
@@ -81,7 +82,7 @@ func ApiKeyID(input string) (*ApiKeyId, error) {
 // Whilst this may seem strange, this enables Terraform have consistent casing
 // which works around issues in Core, whilst handling broken API responses.
 func ApiKeyIDInsensitively(input string) (*ApiKeyId, error) {
-	id, err := resourceids.ParseAzureResourceID(input)
+	id, err := resourceid.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -231,6 +231,17 @@ func TestApiKeyIDInsensitively(t *testing.T) {
 				Name:           "apikey1",
 			},
 		},
+
+		{
+			// upper-cased root segment names
+			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/group1/PROVIDERS/Microsoft.Insights/components/component1/apiKeys/apikey1",
+			Expected: &ApiKeyId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "group1",
+				ComponentName:  "component1",
+				Name:           "apikey1",
+			},
+		},
 	}
 
 	for _, v := range testData {
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceid"
 )
 
 type ComponentId struct {
@@ -75,7 +76,7 @@ func ComponentID(input string) (*ComponentId, error) {
 // Whilst this may seem strange, this enables Terraform have consistent casing
 // which works around issues in Core, whilst handling broken API responses.
 func ComponentIDInsensitively(input string) (*ComponentId, error) {
-	id, err := resourceids.ParseAzureResourceID(input)
+	id, err := resourceid.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
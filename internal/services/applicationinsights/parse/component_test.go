@@ -199,6 +199,16 @@ func TestComponentIDInsensitively(t *testing.T) {
 				Name:           "component1",
 			},
 		},
+
+		{
+			// upper-cased root segment names
+			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/group1/PROVIDERS/Microsoft.Insights/components/component1",
+			Expected: &ComponentId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "group1",
+				Name:           "component1",
+			},
+		},
 	}
 
 	for _, v := range testData {
@@ -231,6 +231,17 @@ func TestSmartDetectionRuleIDInsensitively(t *testing.T) {
 				SmartDetectionRuleName: "rule1",
 			},
 		},
+
+		{
+			// upper-cased root segment names
+			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/group1/PROVIDERS/Microsoft.Insights/components/component1/smartDetectionRule/rule1",
+			Expected: &SmartDetectionRuleId{
+				SubscriptionId:         "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:          "group1",
+				ComponentName:          "component1",
+				SmartDetectionRuleName: "rule1",
+			},
+		},
 	}
 
 	for _, v := range testData {
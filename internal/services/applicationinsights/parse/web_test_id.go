@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceid"
 )
 
 type WebTestId struct {
@@ -75,7 +76,7 @@ func WebTestID(input string) (*WebTestId, error) {
 // Whilst this may seem strange, this enables Terraform have consistent casing
 // which works around issues in Core, whilst handling broken API responses.
 func WebTestIDInsensitively(input string) (*WebTestId, error) {
-	id, err := resourceids.ParseAzureResourceID(input)
+	id, err := resourceid.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -199,6 +199,16 @@ func TestWebTestIDInsensitively(t *testing.T) {
 				Name:           "test1",
 			},
 		},
+
+		{
+			// upper-cased root segment names
+			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/group1/PROVIDERS/Microsoft.Insights/webTests/test1",
+			Expected: &WebTestId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "group1",
+				Name:           "test1",
+			},
+		},
 	}
 
 	for _, v := range testData {
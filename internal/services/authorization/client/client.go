@@ -4,19 +4,49 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/graphrbac/1.6/graphrbac"
 	"github.com/Azure/azure-sdk-for-go/services/preview/authorization/mgmt/2020-04-01-preview/authorization"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/authorization/sdk/2020-10-01/roleassignmentschedulerequests"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/authorization/sdk/2020-10-01/roleassignmentschedules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/authorization/sdk/2020-10-01/roleeligibilityschedulerequests"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/authorization/sdk/2020-10-01/roleeligibilityschedules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/authorization/sdk/2020-10-01/rolemanagementpolicies"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/authorization/sdk/2020-10-01/rolemanagementpolicyassignments"
 )
 
 type Client struct {
+	ActiveRequestsClient    *roleassignmentschedulerequests.RoleAssignmentScheduleRequestsClient
+	ActiveSchedulesClient   *roleassignmentschedules.RoleAssignmentSchedulesClient
+	EligibleRequestsClient  *roleeligibilityschedulerequests.RoleEligibilityScheduleRequestsClient
+	EligibleSchedulesClient *roleeligibilityschedules.RoleEligibilitySchedulesClient
 	GroupsClient            *graphrbac.GroupsClient
+	PimPoliciesClient       *rolemanagementpolicies.RoleManagementPoliciesClient
+	PolicyAssignmentsClient *rolemanagementpolicyassignments.RoleManagementPolicyAssignmentsClient
 	RoleAssignmentsClient   *authorization.RoleAssignmentsClient
 	RoleDefinitionsClient   *authorization.RoleDefinitionsClient
 	ServicePrincipalsClient *graphrbac.ServicePrincipalsClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
+	activeRequestsClient := roleassignmentschedulerequests.NewRoleAssignmentScheduleRequestsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&activeRequestsClient.Client, o.ResourceManagerAuthorizer)
+
+	activeSchedulesClient := roleassignmentschedules.NewRoleAssignmentSchedulesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&activeSchedulesClient.Client, o.ResourceManagerAuthorizer)
+
+	eligibleRequestsClient := roleeligibilityschedulerequests.NewRoleEligibilityScheduleRequestsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&eligibleRequestsClient.Client, o.ResourceManagerAuthorizer)
+
+	eligibleSchedulesClient := roleeligibilityschedules.NewRoleEligibilitySchedulesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&eligibleSchedulesClient.Client, o.ResourceManagerAuthorizer)
+
 	groupsClient := graphrbac.NewGroupsClientWithBaseURI(o.GraphEndpoint, o.TenantID)
 	o.ConfigureClient(&groupsClient.Client, o.GraphAuthorizer)
 
+	pimPoliciesClient := rolemanagementpolicies.NewRoleManagementPoliciesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&pimPoliciesClient.Client, o.ResourceManagerAuthorizer)
+
+	policyAssignmentsClient := rolemanagementpolicyassignments.NewRoleManagementPolicyAssignmentsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&policyAssignmentsClient.Client, o.ResourceManagerAuthorizer)
+
 	roleAssignmentsClient := authorization.NewRoleAssignmentsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&roleAssignmentsClient.Client, o.ResourceManagerAuthorizer)
 
@@ -27,7 +57,13 @@ func NewClient(o *common.ClientOptions) *Client {
 	o.ConfigureClient(&servicePrincipalsClient.Client, o.GraphAuthorizer)
 
 	return &Client{
+		ActiveRequestsClient:    &activeRequestsClient,
+		ActiveSchedulesClient:   &activeSchedulesClient,
+		EligibleRequestsClient:  &eligibleRequestsClient,
+		EligibleSchedulesClient: &eligibleSchedulesClient,
 		GroupsClient:            &groupsClient,
+		PimPoliciesClient:       &pimPoliciesClient,
+		PolicyAssignmentsClient: &policyAssignmentsClient,
 		RoleAssignmentsClient:   &roleAssignmentsClient,
 		RoleDefinitionsClient:   &roleDefinitionsClient,
 		ServicePrincipalsClient: &servicePrincipalsClient,
@@ -0,0 +1,122 @@
+package authorization_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/authorization/sdk/2020-10-01/roleassignmentschedulerequests"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type PimActiveRoleAssignmentResource struct{}
+
+func TestAccPimActiveRoleAssignment_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_pim_active_role_assignment", "test")
+	r := PimActiveRoleAssignmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("principal_type").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccPimActiveRoleAssignment_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_pim_active_role_assignment", "test")
+	r := PimActiveRoleAssignmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("schedule.0.expiration.0.duration_hours").HasValue("8"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (PimActiveRoleAssignmentResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := roleassignmentschedulerequests.ParseScopedRoleAssignmentScheduleRequestID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Authorization.ActiveRequestsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (PimActiveRoleAssignmentResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-pim-%d"
+  location = "%s"
+}
+
+data "azurerm_role_definition" "test" {
+  name  = "Reader"
+  scope = azurerm_resource_group.test.id
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r PimActiveRoleAssignmentResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_pim_active_role_assignment" "test" {
+  scope              = azurerm_resource_group.test.id
+  role_definition_id = data.azurerm_role_definition.test.id
+  principal_id       = data.azurerm_client_config.current.object_id
+}
+`, r.template(data))
+}
+
+func (r PimActiveRoleAssignmentResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_pim_active_role_assignment" "test" {
+  scope              = azurerm_resource_group.test.id
+  role_definition_id = data.azurerm_role_definition.test.id
+  principal_id       = data.azurerm_client_config.current.object_id
+  justification      = "Expiring assignment for acceptance tests"
+
+  schedule {
+    expiration {
+      duration_hours = 8
+    }
+  }
+
+  ticket {
+    number = "1"
+    system = "acctest"
+  }
+}
+`, r.template(data))
+}
@@ -0,0 +1,276 @@
+package authorization
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/authorization/sdk/2020-10-01/roleeligibilityschedulerequests"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/authorization/sdk/2020-10-01/roleeligibilityschedules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourcePimEligibleRoleAssignment() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourcePimEligibleRoleAssignmentCreate,
+		Read:   resourcePimEligibleRoleAssignmentRead,
+		Delete: resourcePimEligibleRoleAssignmentDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := roleeligibilityschedulerequests.ParseScopedRoleEligibilityScheduleRequestID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: pimRoleAssignmentSchema(),
+	}
+}
+
+func resourcePimEligibleRoleAssignmentCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Authorization.EligibleRequestsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	name, err := uuid.GenerateUUID()
+	if err != nil {
+		return fmt.Errorf("generating a name for the Eligible Role Assignment Request: %+v", err)
+	}
+	id := roleeligibilityschedulerequests.NewScopedRoleEligibilityScheduleRequestID(d.Get("scope").(string), name)
+
+	schedule := expandPimSchedule(d.Get("schedule").([]interface{}))
+	expirationType := roleeligibilityschedulerequests.Type(schedule.ExpirationType)
+	ticketNumber, ticketSystem := expandPimTicket(d.Get("ticket").([]interface{}))
+
+	payload := roleeligibilityschedulerequests.RoleEligibilityScheduleRequest{
+		Properties: &roleeligibilityschedulerequests.RoleEligibilityScheduleRequestProperties{
+			PrincipalId:      d.Get("principal_id").(string),
+			RequestType:      roleeligibilityschedulerequests.RequestTypeAdminAssign,
+			RoleDefinitionId: d.Get("role_definition_id").(string),
+			ScheduleInfo: &roleeligibilityschedulerequests.RoleEligibilityScheduleRequestPropertiesScheduleInfo{
+				StartDateTime: schedule.StartDateTime,
+				Expiration: &roleeligibilityschedulerequests.RoleEligibilityScheduleRequestPropertiesScheduleInfoExpiration{
+					Duration:    schedule.Duration,
+					EndDateTime: schedule.EndDateTime,
+					Type:        &expirationType,
+				},
+			},
+		},
+	}
+	if v := d.Get("justification").(string); v != "" {
+		payload.Properties.Justification = utils.String(v)
+	}
+	if ticketNumber != nil || ticketSystem != nil {
+		payload.Properties.TicketInfo = &roleeligibilityschedulerequests.RoleEligibilityScheduleRequestPropertiesTicketInfo{
+			TicketNumber: ticketNumber,
+			TicketSystem: ticketSystem,
+		}
+	}
+
+	if _, err := client.Create(ctx, id, payload); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	if err := waitForPimEligibleRoleAssignmentRequest(ctx, client, id, d.Timeout(pluginsdk.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	d.SetId(id.ID())
+
+	return resourcePimEligibleRoleAssignmentRead(d, meta)
+}
+
+func resourcePimEligibleRoleAssignmentRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Authorization.EligibleRequestsClient
+	schedulesClient := meta.(*clients.Client).Authorization.EligibleSchedulesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := roleeligibilityschedulerequests.ParseScopedRoleEligibilityScheduleRequestID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state!", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	if resp.Model == nil || resp.Model.Properties == nil {
+		return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+	}
+	props := resp.Model.Properties
+
+	status := ""
+	if props.Status != nil {
+		status = string(*props.Status)
+	}
+	if pimRequestStatusIn(status, pimRequestRemovedStatuses) {
+		log.Printf("[DEBUG] %s has the status %q - removing from state!", *id, status)
+		d.SetId("")
+		return nil
+	}
+
+	// once provisioned the Request remains even when the Eligible Role Assignment has expired or been removed
+	if pimRequestStatusIn(status, pimRequestCompletedStatuses) && props.TargetRoleEligibilityScheduleId != nil {
+		scheduleId, err := roleeligibilityschedules.ParseScopedRoleEligibilityScheduleIDInsensitively(*props.TargetRoleEligibilityScheduleId)
+		if err != nil {
+			return err
+		}
+
+		schedule, err := schedulesClient.Get(ctx, *scheduleId)
+		if err != nil {
+			if response.WasNotFound(schedule.HttpResponse) {
+				log.Printf("[DEBUG] %s for %s was not found - removing from state!", *scheduleId, *id)
+				d.SetId("")
+				return nil
+			}
+
+			return fmt.Errorf("retrieving %s: %+v", *scheduleId, err)
+		}
+	}
+
+	d.Set("scope", id.Scope)
+	d.Set("role_definition_id", props.RoleDefinitionId)
+	d.Set("principal_id", props.PrincipalId)
+	d.Set("justification", props.Justification)
+
+	principalType := ""
+	if props.PrincipalType != nil {
+		principalType = string(*props.PrincipalType)
+	}
+	d.Set("principal_type", principalType)
+
+	schedule := pimSchedule{}
+	if info := props.ScheduleInfo; info != nil {
+		schedule.StartDateTime = info.StartDateTime
+		if expiration := info.Expiration; expiration != nil {
+			schedule.Duration = expiration.Duration
+			schedule.EndDateTime = expiration.EndDateTime
+			if expiration.Type != nil {
+				schedule.ExpirationType = string(*expiration.Type)
+			}
+		}
+	}
+	if err := d.Set("schedule", flattenPimSchedule(schedule)); err != nil {
+		return fmt.Errorf("setting `schedule`: %+v", err)
+	}
+
+	var ticketNumber, ticketSystem *string
+	if ticket := props.TicketInfo; ticket != nil {
+		ticketNumber = ticket.TicketNumber
+		ticketSystem = ticket.TicketSystem
+	}
+	if err := d.Set("ticket", flattenPimTicket(ticketNumber, ticketSystem)); err != nil {
+		return fmt.Errorf("setting `ticket`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourcePimEligibleRoleAssignmentDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Authorization.EligibleRequestsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := roleeligibilityschedulerequests.ParseScopedRoleEligibilityScheduleRequestID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	existing, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(existing.HttpResponse) {
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	if existing.Model == nil || existing.Model.Properties == nil {
+		return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+	}
+	props := existing.Model.Properties
+
+	status := ""
+	if props.Status != nil {
+		status = string(*props.Status)
+	}
+
+	// a Request which hasn't been provisioned yet can be cancelled, otherwise a separate Request has to be
+	// submitted to remove the Eligible Role Assignment
+	if pimRequestStatusIn(status, pimRequestApprovalStatuses) || pimRequestStatusIn(status, pimRequestPendingStatuses) {
+		if _, err := client.Cancel(ctx, *id); err != nil {
+			return fmt.Errorf("cancelling %s: %+v", *id, err)
+		}
+		return nil
+	}
+	if !pimRequestStatusIn(status, pimRequestCompletedStatuses) {
+		return nil
+	}
+
+	name, err := uuid.GenerateUUID()
+	if err != nil {
+		return fmt.Errorf("generating a name for the removal Request: %+v", err)
+	}
+	removalId := roleeligibilityschedulerequests.NewScopedRoleEligibilityScheduleRequestID(id.Scope, name)
+
+	payload := roleeligibilityschedulerequests.RoleEligibilityScheduleRequest{
+		Properties: &roleeligibilityschedulerequests.RoleEligibilityScheduleRequestProperties{
+			Justification:    utils.String("Removed by Terraform"),
+			PrincipalId:      props.PrincipalId,
+			RequestType:      roleeligibilityschedulerequests.RequestTypeAdminRemove,
+			RoleDefinitionId: props.RoleDefinitionId,
+		},
+	}
+	if resp, err := client.Create(ctx, removalId, payload); err != nil {
+		// the Eligible Role Assignment may have already expired
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil
+		}
+		return fmt.Errorf("removing %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func waitForPimEligibleRoleAssignmentRequest(ctx context.Context, client *roleeligibilityschedulerequests.RoleEligibilityScheduleRequestsClient, id roleeligibilityschedulerequests.ScopedRoleEligibilityScheduleRequestId, timeout time.Duration) error {
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending: pimRequestPendingStatuses,
+		Target:  append(append([]string{}, pimRequestCompletedStatuses...), pimRequestApprovalStatuses...),
+		Refresh: func() (interface{}, string, error) {
+			resp, err := client.Get(ctx, id)
+			if err != nil {
+				return nil, "", fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			status := ""
+			if model := resp.Model; model != nil && model.Properties != nil && model.Properties.Status != nil {
+				status = string(*model.Properties.Status)
+			}
+			return resp, status, nil
+		},
+		MinTimeout: 5 * time.Second,
+		Timeout:    timeout,
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for %s to be provisioned: %+v", id, err)
+	}
+
+	return nil
+}
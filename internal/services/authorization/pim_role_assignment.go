@@ -0,0 +1,297 @@
+package authorization
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// the statuses of a PIM Schedule Request are shared between Eligible and Active Role Assignments, as such
+// these are compared as strings rather than using the constants from either SDK package
+var (
+	pimRequestPendingStatuses = []string{
+		"Accepted",
+		"AdminApproved",
+		"Granted",
+		"PendingEvaluation",
+		"PendingExternalProvisioning",
+		"PendingProvisioning",
+		"PendingScheduleCreation",
+		"ProvisioningStarted",
+	}
+
+	pimRequestApprovalStatuses = []string{
+		"PendingAdminDecision",
+		"PendingApproval",
+		"PendingApprovalProvisioning",
+	}
+
+	pimRequestCompletedStatuses = []string{
+		"Provisioned",
+		"ScheduleCreated",
+	}
+
+	pimRequestRemovedStatuses = []string{
+		"AdminDenied",
+		"Canceled",
+		"Denied",
+		"Revoked",
+		"TimedOut",
+	}
+)
+
+var pimDurationDaysRegex = regexp.MustCompile(`^P(\d+)D$`)
+var pimDurationHoursRegex = regexp.MustCompile(`^PT(\d+)H$`)
+
+func pimRoleAssignmentSchema() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"scope": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.Any(
+				commonids.ValidateSubscriptionID,
+				commonids.ValidateResourceGroupID,
+			),
+		},
+
+		"role_definition_id": {
+			Type:             pluginsdk.TypeString,
+			Required:         true,
+			ForceNew:         true,
+			DiffSuppressFunc: suppress.CaseDifference,
+			ValidateFunc:     validation.StringIsNotEmpty,
+		},
+
+		"principal_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsUUID,
+		},
+
+		"justification": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"ticket": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Computed: true,
+			ForceNew: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"number": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"system": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"schedule": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Computed: true,
+			ForceNew: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"start_date_time": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Computed:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.IsRFC3339Time,
+					},
+
+					"expiration": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Computed: true,
+						ForceNew: true,
+						MaxItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"duration_days": {
+									Type:          pluginsdk.TypeInt,
+									Optional:      true,
+									Computed:      true,
+									ForceNew:      true,
+									ValidateFunc:  validation.IntAtLeast(1),
+									ConflictsWith: []string{"schedule.0.expiration.0.duration_hours", "schedule.0.expiration.0.end_date_time"},
+								},
+
+								"duration_hours": {
+									Type:          pluginsdk.TypeInt,
+									Optional:      true,
+									Computed:      true,
+									ForceNew:      true,
+									ValidateFunc:  validation.IntAtLeast(1),
+									ConflictsWith: []string{"schedule.0.expiration.0.duration_days", "schedule.0.expiration.0.end_date_time"},
+								},
+
+								"end_date_time": {
+									Type:          pluginsdk.TypeString,
+									Optional:      true,
+									Computed:      true,
+									ForceNew:      true,
+									ValidateFunc:  validation.IsRFC3339Time,
+									ConflictsWith: []string{"schedule.0.expiration.0.duration_days", "schedule.0.expiration.0.duration_hours"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+
+		"principal_type": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+// pimSchedule is an SDK-agnostic representation of the schedule for an Eligible/Active Role Assignment
+type pimSchedule struct {
+	StartDateTime  *string
+	ExpirationType string
+	Duration       *string
+	EndDateTime    *string
+}
+
+func expandPimSchedule(input []interface{}) pimSchedule {
+	output := pimSchedule{
+		ExpirationType: "NoExpiration",
+	}
+	if len(input) == 0 || input[0] == nil {
+		return output
+	}
+	raw := input[0].(map[string]interface{})
+
+	if v := raw["start_date_time"].(string); v != "" {
+		output.StartDateTime = &v
+	}
+
+	if expirations := raw["expiration"].([]interface{}); len(expirations) > 0 && expirations[0] != nil {
+		expiration := expirations[0].(map[string]interface{})
+		if v := expiration["duration_days"].(int); v > 0 {
+			output.ExpirationType = "AfterDuration"
+			output.Duration = utils.String(fmt.Sprintf("P%dD", v))
+		}
+		if v := expiration["duration_hours"].(int); v > 0 {
+			output.ExpirationType = "AfterDuration"
+			output.Duration = utils.String(fmt.Sprintf("PT%dH", v))
+		}
+		if v := expiration["end_date_time"].(string); v != "" {
+			output.ExpirationType = "AfterDateTime"
+			output.EndDateTime = &v
+		}
+	}
+
+	return output
+}
+
+func flattenPimSchedule(input pimSchedule) []interface{} {
+	startDateTime := ""
+	if input.StartDateTime != nil {
+		startDateTime = *input.StartDateTime
+	}
+
+	durationDays := 0
+	durationHours := 0
+	if input.Duration != nil {
+		if match := pimDurationDaysRegex.FindStringSubmatch(*input.Duration); len(match) == 2 {
+			durationDays, _ = strconv.Atoi(match[1])
+		}
+		if match := pimDurationHoursRegex.FindStringSubmatch(*input.Duration); len(match) == 2 {
+			durationHours, _ = strconv.Atoi(match[1])
+		}
+	}
+
+	endDateTime := ""
+	if input.EndDateTime != nil {
+		endDateTime = *input.EndDateTime
+	}
+
+	expiration := make([]interface{}, 0)
+	if input.ExpirationType != "" && input.ExpirationType != "NoExpiration" {
+		expiration = append(expiration, map[string]interface{}{
+			"duration_days":  durationDays,
+			"duration_hours": durationHours,
+			"end_date_time":  endDateTime,
+		})
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"start_date_time": startDateTime,
+			"expiration":      expiration,
+		},
+	}
+}
+
+func expandPimTicket(input []interface{}) (number *string, system *string) {
+	if len(input) == 0 || input[0] == nil {
+		return nil, nil
+	}
+	raw := input[0].(map[string]interface{})
+	if v := raw["number"].(string); v != "" {
+		number = &v
+	}
+	if v := raw["system"].(string); v != "" {
+		system = &v
+	}
+	return number, system
+}
+
+func flattenPimTicket(number *string, system *string) []interface{} {
+	if number == nil && system == nil {
+		return []interface{}{}
+	}
+
+	ticketNumber := ""
+	if number != nil {
+		ticketNumber = *number
+	}
+	ticketSystem := ""
+	if system != nil {
+		ticketSystem = *system
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"number": ticketNumber,
+			"system": ticketSystem,
+		},
+	}
+}
+
+func pimRequestStatusIn(status string, statuses []string) bool {
+	for _, v := range statuses {
+		if v == status {
+			return true
+		}
+	}
+	return false
+}
@@ -1,6 +1,7 @@
 package authorization
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -122,18 +123,31 @@ func dataSourceArmRoleDefinitionRead(d *pluginsdk.ResourceData, meta interface{}
 	// search by name
 	var role authorization.RoleDefinition
 	if name != "" {
-		roleDefinitions, err := client.List(ctx, scope, fmt.Sprintf("roleName eq '%s'", name))
+		filter := fmt.Sprintf("roleName eq '%s'", name)
+		key := clients.ListCacheKey{
+			SubscriptionId: meta.(*clients.Client).Account.SubscriptionId,
+			API:            "Microsoft.Authorization/roleDefinitions/List",
+			Parameters:     []string{scope, filter},
+		}
+		result, err := meta.(*clients.Client).ListCache.Get(ctx, key, func(ctx context.Context) (interface{}, error) {
+			roleDefinitions, err := client.List(ctx, scope, filter)
+			if err != nil {
+				return nil, err
+			}
+			return roleDefinitions.Values(), nil
+		})
 		if err != nil {
 			return fmt.Errorf("loading Role Definition List: %+v", err)
 		}
-		if len(roleDefinitions.Values()) != 1 {
+		roleDefinitions := result.([]authorization.RoleDefinition)
+		if len(roleDefinitions) != 1 {
 			return fmt.Errorf("loading Role Definition List: could not find role '%s'", name)
 		}
-		if roleDefinitions.Values()[0].ID == nil {
+		if roleDefinitions[0].ID == nil {
 			return fmt.Errorf("loading Role Definition List: values[0].ID is nil '%s'", name)
 		}
 
-		defId = *roleDefinitions.Values()[0].ID
+		defId = *roleDefinitions[0].ID
 		role, err = client.GetByID(ctx, defId)
 		if err != nil {
 			return fmt.Errorf("Getting Role Definition by ID %s: %+v", defId, err)
@@ -0,0 +1,827 @@
+package authorization
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/authorization/sdk/2020-10-01/rolemanagementpolicies"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/authorization/sdk/2020-10-01/rolemanagementpolicyassignments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceArmRoleManagementPolicy() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceArmRoleManagementPolicyCreateUpdate,
+		Read:   resourceArmRoleManagementPolicyRead,
+		Update: resourceArmRoleManagementPolicyCreateUpdate,
+		Delete: resourceArmRoleManagementPolicyDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := rolemanagementpolicies.ParseScopedRoleManagementPolicyID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"scope": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.Any(
+					commonids.ValidateSubscriptionID,
+					commonids.ValidateResourceGroupID,
+				),
+			},
+
+			"role_definition_id": {
+				Type:             pluginsdk.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppress.CaseDifference,
+				ValidateFunc:     validation.StringIsNotEmpty,
+			},
+
+			"activation_rules": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"maximum_duration": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validate.ISO8601Duration,
+						},
+
+						"require_approval": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+
+						"approval_stage": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"primary_approver": {
+										Type:     pluginsdk.TypeSet,
+										Required: true,
+										MinItems: 1,
+										Elem: &pluginsdk.Resource{
+											Schema: map[string]*pluginsdk.Schema{
+												"object_id": {
+													Type:         pluginsdk.TypeString,
+													Required:     true,
+													ValidateFunc: validation.IsUUID,
+												},
+
+												"type": {
+													Type:     pluginsdk.TypeString,
+													Required: true,
+													ValidateFunc: validation.StringInSlice([]string{
+														string(rolemanagementpolicies.UserTypeGroup),
+														string(rolemanagementpolicies.UserTypeUser),
+													}, false),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+
+						"require_justification": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+
+						"require_multifactor_authentication": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+
+						"require_ticket_info": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"eligible_assignment_rules": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"expiration_required": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+
+						"expire_after": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validate.ISO8601Duration,
+						},
+					},
+				},
+			},
+
+			"active_assignment_rules": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"expiration_required": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+
+						"expire_after": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validate.ISO8601Duration,
+						},
+
+						"require_justification": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+
+						"require_multifactor_authentication": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+
+						"require_ticket_info": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"notification_rules": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"eligible_assignments": roleManagementPolicyNotificationTargetSchema(),
+
+						"active_assignments": roleManagementPolicyNotificationTargetSchema(),
+
+						"eligible_activations": roleManagementPolicyNotificationTargetSchema(),
+					},
+				},
+			},
+
+			"name": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"description": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func roleManagementPolicyNotificationTargetSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"admin_notifications": roleManagementPolicyNotificationSettingsSchema(),
+
+				"approver_notifications": roleManagementPolicyNotificationSettingsSchema(),
+
+				"assignee_notifications": roleManagementPolicyNotificationSettingsSchema(),
+			},
+		},
+	}
+}
+
+func roleManagementPolicyNotificationSettingsSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"notification_level": {
+					Type:     pluginsdk.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(rolemanagementpolicies.NotificationLevelAll),
+						string(rolemanagementpolicies.NotificationLevelCritical),
+					}, false),
+				},
+
+				"default_recipients": {
+					Type:     pluginsdk.TypeBool,
+					Required: true,
+				},
+
+				"additional_recipients": {
+					Type:     pluginsdk.TypeSet,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceArmRoleManagementPolicyCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Authorization.PimPoliciesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	scope := d.Get("scope").(string)
+	roleDefinitionId := d.Get("role_definition_id").(string)
+
+	var id rolemanagementpolicies.ScopedRoleManagementPolicyId
+	if d.IsNewResource() {
+		// every Role Definition has a Role Management Policy at each scope, so rather than being created
+		// the existing Policy is looked up and then adopted
+		policyId, err := findRoleManagementPolicyIdForRoleDefinition(ctx, meta.(*clients.Client).Authorization.PolicyAssignmentsClient, scope, roleDefinitionId)
+		if err != nil {
+			return err
+		}
+		id = *policyId
+	} else {
+		policyId, err := rolemanagementpolicies.ParseScopedRoleManagementPolicyID(d.Id())
+		if err != nil {
+			return err
+		}
+		id = *policyId
+	}
+
+	locks.ByID(id.ID())
+	defer locks.UnlockByID(id.ID())
+
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	if existing.Model == nil || existing.Model.Properties == nil || existing.Model.Properties.Rules == nil {
+		return fmt.Errorf("retrieving %s: `properties.rules` was nil", id)
+	}
+
+	rules, err := expandRoleManagementPolicyRules(d, *existing.Model.Properties.Rules)
+	if err != nil {
+		return fmt.Errorf("expanding the rules for %s: %+v", id, err)
+	}
+
+	// only the rules which have changed are sent, since the API rejects changes to rules which are enforced by a parent scope
+	if len(rules) > 0 {
+		payload := rolemanagementpolicies.RoleManagementPolicy{
+			Properties: &rolemanagementpolicies.RoleManagementPolicyProperties{
+				Rules: &rules,
+			},
+		}
+		if _, err := client.Update(ctx, id, payload); err != nil {
+			return fmt.Errorf("updating %s: %+v", id, err)
+		}
+	}
+
+	d.SetId(id.ID())
+
+	return resourceArmRoleManagementPolicyRead(d, meta)
+}
+
+func resourceArmRoleManagementPolicyRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Authorization.PimPoliciesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := rolemanagementpolicies.ParseScopedRoleManagementPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state!", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	roleDefinitionId := d.Get("role_definition_id").(string)
+	if roleDefinitionId == "" {
+		// when importing the Role Definition has to be looked up from the Policy Assignment
+		roleDefinitionId, err = findRoleDefinitionIdForRoleManagementPolicy(ctx, meta.(*clients.Client).Authorization.PolicyAssignmentsClient, *id)
+		if err != nil {
+			return err
+		}
+	}
+
+	d.Set("scope", id.Scope)
+	d.Set("role_definition_id", roleDefinitionId)
+	d.Set("name", id.RoleManagementPolicyName)
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			d.Set("description", props.Description)
+
+			rules := make(roleManagementPolicyRules, 0)
+			if props.Rules != nil {
+				rules = *props.Rules
+			}
+
+			if err := d.Set("activation_rules", flattenRoleManagementPolicyActivationRules(rules)); err != nil {
+				return fmt.Errorf("setting `activation_rules`: %+v", err)
+			}
+			if err := d.Set("eligible_assignment_rules", flattenRoleManagementPolicyEligibleAssignmentRules(rules)); err != nil {
+				return fmt.Errorf("setting `eligible_assignment_rules`: %+v", err)
+			}
+			if err := d.Set("active_assignment_rules", flattenRoleManagementPolicyActiveAssignmentRules(rules)); err != nil {
+				return fmt.Errorf("setting `active_assignment_rules`: %+v", err)
+			}
+			if err := d.Set("notification_rules", flattenRoleManagementPolicyNotificationRules(rules)); err != nil {
+				return fmt.Errorf("setting `notification_rules`: %+v", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceArmRoleManagementPolicyDelete(d *pluginsdk.ResourceData, _ interface{}) error {
+	id, err := rolemanagementpolicies.ParseScopedRoleManagementPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// Role Management Policies exist for as long as the Role Definition and scope do, so can't be deleted
+	log.Printf("[DEBUG] %s can't be deleted - removing from state only", *id)
+	return nil
+}
+
+func findRoleManagementPolicyIdForRoleDefinition(ctx context.Context, client *rolemanagementpolicyassignments.RoleManagementPolicyAssignmentsClient, scope, roleDefinitionId string) (*rolemanagementpolicies.ScopedRoleManagementPolicyId, error) {
+	scopeId := rolemanagementpolicyassignments.NewScopeID(scope)
+	resp, err := client.ListForScopeComplete(ctx, scopeId)
+	if err != nil {
+		return nil, fmt.Errorf("listing Role Management Policy Assignments for %s: %+v", scopeId, err)
+	}
+
+	for _, item := range resp.Items {
+		props := item.Properties
+		if props == nil || props.RoleDefinitionId == nil || props.PolicyId == nil {
+			continue
+		}
+
+		if roleDefinitionIdsMatch(*props.RoleDefinitionId, roleDefinitionId) {
+			return rolemanagementpolicies.ParseScopedRoleManagementPolicyIDInsensitively(*props.PolicyId)
+		}
+	}
+
+	return nil, fmt.Errorf("no Role Management Policy was found for the Role Definition %q at the scope %q", roleDefinitionId, scope)
+}
+
+func findRoleDefinitionIdForRoleManagementPolicy(ctx context.Context, client *rolemanagementpolicyassignments.RoleManagementPolicyAssignmentsClient, id rolemanagementpolicies.ScopedRoleManagementPolicyId) (string, error) {
+	scopeId := rolemanagementpolicyassignments.NewScopeID(id.Scope)
+	resp, err := client.ListForScopeComplete(ctx, scopeId)
+	if err != nil {
+		return "", fmt.Errorf("listing Role Management Policy Assignments for %s: %+v", scopeId, err)
+	}
+
+	for _, item := range resp.Items {
+		props := item.Properties
+		if props == nil || props.RoleDefinitionId == nil || props.PolicyId == nil {
+			continue
+		}
+
+		if strings.EqualFold(*props.PolicyId, id.ID()) {
+			return *props.RoleDefinitionId, nil
+		}
+	}
+
+	return "", fmt.Errorf("no Role Definition was found which is assigned to %s", id)
+}
+
+// roleDefinitionIdsMatch compares two Role Definition IDs, which are returned by the API both with and
+// without the Subscription prefix - the name of a Role Definition is a unique GUID so this is compared
+func roleDefinitionIdsMatch(first, second string) bool {
+	firstSegments := strings.Split(strings.TrimSuffix(first, "/"), "/")
+	secondSegments := strings.Split(strings.TrimSuffix(second, "/"), "/")
+	return strings.EqualFold(firstSegments[len(firstSegments)-1], secondSegments[len(secondSegments)-1])
+}
+
+// the IDs of the rules which make up a Role Management Policy - these are fixed by the API
+const (
+	roleManagementPolicyRuleActivationApproval       = "Approval_EndUser_Assignment"
+	roleManagementPolicyRuleActivationEnablement     = "Enablement_EndUser_Assignment"
+	roleManagementPolicyRuleActivationExpiration     = "Expiration_EndUser_Assignment"
+	roleManagementPolicyRuleActiveAssignEnablement   = "Enablement_Admin_Assignment"
+	roleManagementPolicyRuleActiveAssignExpiration   = "Expiration_Admin_Assignment"
+	roleManagementPolicyRuleEligibleAssignExpiration = "Expiration_Admin_Eligibility"
+)
+
+// notificationRuleId returns the ID of the rule for the notifications sent to `recipient` when `caller` performs an
+// action at `level` - where `caller` is either `Admin` (for assignments) or `EndUser` (for activations)
+func notificationRuleId(recipient rolemanagementpolicies.RecipientType, caller, level string) string {
+	return fmt.Sprintf("Notification_%s_%s_%s", recipient, caller, level)
+}
+
+var roleManagementPolicyNotificationTargets = map[string][2]string{
+	"eligible_assignments": {"Admin", "Eligibility"},
+	"active_assignments":   {"Admin", "Assignment"},
+	"eligible_activations": {"EndUser", "Assignment"},
+}
+
+var roleManagementPolicyNotificationRecipients = map[string]rolemanagementpolicies.RecipientType{
+	"admin_notifications":    rolemanagementpolicies.RecipientTypeAdmin,
+	"approver_notifications": rolemanagementpolicies.RecipientTypeApprover,
+	"assignee_notifications": rolemanagementpolicies.RecipientTypeRequestor,
+}
+
+type roleManagementPolicyRules []rolemanagementpolicies.RoleManagementPolicyRule
+
+func (r roleManagementPolicyRules) find(id string) (int, bool) {
+	for i, rule := range r {
+		if rule == nil {
+			continue
+		}
+		if ruleId := rule.RoleManagementPolicyRuleId(); ruleId != nil && strings.EqualFold(*ruleId, id) {
+			return i, true
+		}
+	}
+	return -1, false
+}
+
+func (r roleManagementPolicyRules) approvalRule(id string) *rolemanagementpolicies.RoleManagementPolicyApprovalRule {
+	if i, ok := r.find(id); ok {
+		if rule, ok := r[i].(rolemanagementpolicies.RoleManagementPolicyApprovalRule); ok {
+			return &rule
+		}
+	}
+	return nil
+}
+
+func (r roleManagementPolicyRules) enablementRule(id string) *rolemanagementpolicies.RoleManagementPolicyEnablementRule {
+	if i, ok := r.find(id); ok {
+		if rule, ok := r[i].(rolemanagementpolicies.RoleManagementPolicyEnablementRule); ok {
+			return &rule
+		}
+	}
+	return nil
+}
+
+func (r roleManagementPolicyRules) expirationRule(id string) *rolemanagementpolicies.RoleManagementPolicyExpirationRule {
+	if i, ok := r.find(id); ok {
+		if rule, ok := r[i].(rolemanagementpolicies.RoleManagementPolicyExpirationRule); ok {
+			return &rule
+		}
+	}
+	return nil
+}
+
+func (r roleManagementPolicyRules) notificationRule(id string) *rolemanagementpolicies.RoleManagementPolicyNotificationRule {
+	if i, ok := r.find(id); ok {
+		if rule, ok := r[i].(rolemanagementpolicies.RoleManagementPolicyNotificationRule); ok {
+			return &rule
+		}
+	}
+	return nil
+}
+
+// expandRoleManagementPolicyRules returns the rules within `existing` which have been changed in the configuration
+func expandRoleManagementPolicyRules(d *pluginsdk.ResourceData, existing roleManagementPolicyRules) ([]rolemanagementpolicies.RoleManagementPolicyRule, error) {
+	changed := make([]rolemanagementpolicies.RoleManagementPolicyRule, 0)
+
+	expiration := func(id, requiredKey, durationKey string) error {
+		if (requiredKey == "" || !d.HasChange(requiredKey)) && !d.HasChange(durationKey) {
+			return nil
+		}
+		rule := existing.expirationRule(id)
+		if rule == nil {
+			return fmt.Errorf("the Expiration rule %q was not found", id)
+		}
+		if requiredKey != "" && d.HasChange(requiredKey) {
+			rule.IsExpirationRequired = utils.Bool(d.Get(requiredKey).(bool))
+		}
+		if d.HasChange(durationKey) {
+			rule.MaximumDuration = utils.String(d.Get(durationKey).(string))
+		}
+		changed = append(changed, *rule)
+		return nil
+	}
+
+	enablement := func(id, prefix string) error {
+		keys := map[string]rolemanagementpolicies.EnablementRules{
+			prefix + ".require_justification":              rolemanagementpolicies.EnablementRulesJustification,
+			prefix + ".require_multifactor_authentication": rolemanagementpolicies.EnablementRulesMultiFactorAuthentication,
+			prefix + ".require_ticket_info":                rolemanagementpolicies.EnablementRulesTicketing,
+		}
+		hasChange := false
+		for key := range keys {
+			hasChange = hasChange || d.HasChange(key)
+		}
+		if !hasChange {
+			return nil
+		}
+
+		rule := existing.enablementRule(id)
+		if rule == nil {
+			return fmt.Errorf("the Enablement rule %q was not found", id)
+		}
+
+		enabled := make(map[rolemanagementpolicies.EnablementRules]bool)
+		if rule.EnabledRules != nil {
+			for _, v := range *rule.EnabledRules {
+				enabled[v] = true
+			}
+		}
+		for key, value := range keys {
+			if d.HasChange(key) {
+				enabled[value] = d.Get(key).(bool)
+			}
+		}
+
+		enabledRules := make([]rolemanagementpolicies.EnablementRules, 0)
+		for _, value := range rolemanagementpolicies.PossibleValuesForEnablementRules() {
+			if enabled[rolemanagementpolicies.EnablementRules(value)] {
+				enabledRules = append(enabledRules, rolemanagementpolicies.EnablementRules(value))
+			}
+		}
+		rule.EnabledRules = &enabledRules
+		changed = append(changed, *rule)
+		return nil
+	}
+
+	if err := expiration(roleManagementPolicyRuleActivationExpiration, "", "activation_rules.0.maximum_duration"); err != nil {
+		return nil, err
+	}
+	if err := enablement(roleManagementPolicyRuleActivationEnablement, "activation_rules.0"); err != nil {
+		return nil, err
+	}
+	if d.HasChange("activation_rules.0.require_approval") || d.HasChange("activation_rules.0.approval_stage") {
+		rule := existing.approvalRule(roleManagementPolicyRuleActivationApproval)
+		if rule == nil {
+			return nil, fmt.Errorf("the Approval rule %q was not found", roleManagementPolicyRuleActivationApproval)
+		}
+		if rule.Setting == nil {
+			rule.Setting = &rolemanagementpolicies.ApprovalSettings{}
+		}
+		rule.Setting.IsApprovalRequired = utils.Bool(d.Get("activation_rules.0.require_approval").(bool))
+
+		stages := make([]rolemanagementpolicies.ApprovalStage, 0)
+		if v := d.Get("activation_rules.0.approval_stage").([]interface{}); len(v) > 0 && v[0] != nil {
+			stage := v[0].(map[string]interface{})
+			approvers := make([]rolemanagementpolicies.UserSet, 0)
+			for _, raw := range stage["primary_approver"].(*pluginsdk.Set).List() {
+				approver := raw.(map[string]interface{})
+				userType := rolemanagementpolicies.UserType(approver["type"].(string))
+				approvers = append(approvers, rolemanagementpolicies.UserSet{
+					Id:       utils.String(approver["object_id"].(string)),
+					IsBackup: utils.Bool(false),
+					UserType: &userType,
+				})
+			}
+
+			// the remaining settings for the stage are retained from the existing stage, when present
+			approvalStage := rolemanagementpolicies.ApprovalStage{}
+			if rule.Setting.ApprovalStages != nil && len(*rule.Setting.ApprovalStages) > 0 {
+				approvalStage = (*rule.Setting.ApprovalStages)[0]
+			}
+			approvalStage.PrimaryApprovers = &approvers
+			stages = append(stages, approvalStage)
+
+			mode := rolemanagementpolicies.ApprovalModeSingleStage
+			rule.Setting.ApprovalMode = &mode
+		}
+		rule.Setting.ApprovalStages = &stages
+		changed = append(changed, *rule)
+	}
+
+	if err := expiration(roleManagementPolicyRuleEligibleAssignExpiration, "eligible_assignment_rules.0.expiration_required", "eligible_assignment_rules.0.expire_after"); err != nil {
+		return nil, err
+	}
+	if err := expiration(roleManagementPolicyRuleActiveAssignExpiration, "active_assignment_rules.0.expiration_required", "active_assignment_rules.0.expire_after"); err != nil {
+		return nil, err
+	}
+	if err := enablement(roleManagementPolicyRuleActiveAssignEnablement, "active_assignment_rules.0"); err != nil {
+		return nil, err
+	}
+
+	for targetKey, target := range roleManagementPolicyNotificationTargets {
+		for recipientKey, recipient := range roleManagementPolicyNotificationRecipients {
+			key := fmt.Sprintf("notification_rules.0.%s.0.%s", targetKey, recipientKey)
+			if !d.HasChange(key) {
+				continue
+			}
+
+			v := d.Get(key).([]interface{})
+			if len(v) == 0 || v[0] == nil {
+				continue
+			}
+			settings := v[0].(map[string]interface{})
+
+			id := notificationRuleId(recipient, target[0], target[1])
+			rule := existing.notificationRule(id)
+			if rule == nil {
+				return nil, fmt.Errorf("the Notification rule %q was not found", id)
+			}
+
+			level := rolemanagementpolicies.NotificationLevel(settings["notification_level"].(string))
+			rule.NotificationLevel = &level
+			rule.IsDefaultRecipientsEnabled = utils.Bool(settings["default_recipients"].(bool))
+			rule.NotificationRecipients = utils.ExpandStringSlice(settings["additional_recipients"].(*pluginsdk.Set).List())
+			changed = append(changed, *rule)
+		}
+	}
+
+	return changed, nil
+}
+
+func flattenRoleManagementPolicyActivationRules(rules roleManagementPolicyRules) []interface{} {
+	maximumDuration := ""
+	if rule := rules.expirationRule(roleManagementPolicyRuleActivationExpiration); rule != nil && rule.MaximumDuration != nil {
+		maximumDuration = *rule.MaximumDuration
+	}
+
+	requireApproval := false
+	approvalStages := make([]interface{}, 0)
+	if rule := rules.approvalRule(roleManagementPolicyRuleActivationApproval); rule != nil && rule.Setting != nil {
+		if rule.Setting.IsApprovalRequired != nil {
+			requireApproval = *rule.Setting.IsApprovalRequired
+		}
+		if rule.Setting.ApprovalStages != nil {
+			for _, stage := range *rule.Setting.ApprovalStages {
+				approvers := make([]interface{}, 0)
+				if stage.PrimaryApprovers != nil {
+					for _, approver := range *stage.PrimaryApprovers {
+						objectId := ""
+						if approver.Id != nil {
+							objectId = *approver.Id
+						}
+						userType := ""
+						if approver.UserType != nil {
+							userType = string(*approver.UserType)
+						}
+						approvers = append(approvers, map[string]interface{}{
+							"object_id": objectId,
+							"type":      userType,
+						})
+					}
+				}
+
+				// an approval stage without any approvers is returned when approval isn't configured
+				if len(approvers) > 0 {
+					approvalStages = append(approvalStages, map[string]interface{}{
+						"primary_approver": approvers,
+					})
+				}
+			}
+		}
+	}
+
+	enabled := flattenRoleManagementPolicyEnablementRule(rules.enablementRule(roleManagementPolicyRuleActivationEnablement))
+
+	return []interface{}{
+		map[string]interface{}{
+			"maximum_duration":                   maximumDuration,
+			"require_approval":                   requireApproval,
+			"approval_stage":                     approvalStages,
+			"require_justification":              enabled[rolemanagementpolicies.EnablementRulesJustification],
+			"require_multifactor_authentication": enabled[rolemanagementpolicies.EnablementRulesMultiFactorAuthentication],
+			"require_ticket_info":                enabled[rolemanagementpolicies.EnablementRulesTicketing],
+		},
+	}
+}
+
+func flattenRoleManagementPolicyEligibleAssignmentRules(rules roleManagementPolicyRules) []interface{} {
+	expirationRequired, expireAfter := flattenRoleManagementPolicyExpirationRule(rules.expirationRule(roleManagementPolicyRuleEligibleAssignExpiration))
+	return []interface{}{
+		map[string]interface{}{
+			"expiration_required": expirationRequired,
+			"expire_after":        expireAfter,
+		},
+	}
+}
+
+func flattenRoleManagementPolicyActiveAssignmentRules(rules roleManagementPolicyRules) []interface{} {
+	expirationRequired, expireAfter := flattenRoleManagementPolicyExpirationRule(rules.expirationRule(roleManagementPolicyRuleActiveAssignExpiration))
+	enabled := flattenRoleManagementPolicyEnablementRule(rules.enablementRule(roleManagementPolicyRuleActiveAssignEnablement))
+	return []interface{}{
+		map[string]interface{}{
+			"expiration_required":                expirationRequired,
+			"expire_after":                       expireAfter,
+			"require_justification":              enabled[rolemanagementpolicies.EnablementRulesJustification],
+			"require_multifactor_authentication": enabled[rolemanagementpolicies.EnablementRulesMultiFactorAuthentication],
+			"require_ticket_info":                enabled[rolemanagementpolicies.EnablementRulesTicketing],
+		},
+	}
+}
+
+func flattenRoleManagementPolicyNotificationRules(rules roleManagementPolicyRules) []interface{} {
+	output := make(map[string]interface{})
+	for targetKey, target := range roleManagementPolicyNotificationTargets {
+		recipients := make(map[string]interface{})
+		for recipientKey, recipient := range roleManagementPolicyNotificationRecipients {
+			rule := rules.notificationRule(notificationRuleId(recipient, target[0], target[1]))
+			if rule == nil {
+				recipients[recipientKey] = []interface{}{}
+				continue
+			}
+
+			notificationLevel := ""
+			if rule.NotificationLevel != nil {
+				notificationLevel = string(*rule.NotificationLevel)
+			}
+			defaultRecipients := false
+			if rule.IsDefaultRecipientsEnabled != nil {
+				defaultRecipients = *rule.IsDefaultRecipientsEnabled
+			}
+			recipients[recipientKey] = []interface{}{
+				map[string]interface{}{
+					"notification_level":    notificationLevel,
+					"default_recipients":    defaultRecipients,
+					"additional_recipients": utils.FlattenStringSlice(rule.NotificationRecipients),
+				},
+			}
+		}
+		output[targetKey] = []interface{}{recipients}
+	}
+
+	return []interface{}{output}
+}
+
+func flattenRoleManagementPolicyExpirationRule(rule *rolemanagementpolicies.RoleManagementPolicyExpirationRule) (bool, string) {
+	if rule == nil {
+		return false, ""
+	}
+
+	expirationRequired := false
+	if rule.IsExpirationRequired != nil {
+		expirationRequired = *rule.IsExpirationRequired
+	}
+	expireAfter := ""
+	if rule.MaximumDuration != nil {
+		expireAfter = *rule.MaximumDuration
+	}
+	return expirationRequired, expireAfter
+}
+
+func flattenRoleManagementPolicyEnablementRule(rule *rolemanagementpolicies.RoleManagementPolicyEnablementRule) map[rolemanagementpolicies.EnablementRules]bool {
+	output := make(map[rolemanagementpolicies.EnablementRules]bool)
+	if rule != nil && rule.EnabledRules != nil {
+		for _, v := range *rule.EnabledRules {
+			output[v] = true
+		}
+	}
+	return output
+}
@@ -0,0 +1,161 @@
+package authorization_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/authorization/sdk/2020-10-01/rolemanagementpolicies"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type RoleManagementPolicyResource struct{}
+
+func TestAccRoleManagementPolicy_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_role_management_policy", "test")
+	r := RoleManagementPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("activation_rules.0.maximum_duration").HasValue("PT1H"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccRoleManagementPolicy_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_role_management_policy", "test")
+	r := RoleManagementPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("activation_rules.0.require_approval").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (RoleManagementPolicyResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := rolemanagementpolicies.ParseScopedRoleManagementPolicyID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Authorization.PimPoliciesClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (RoleManagementPolicyResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-pim-%d"
+  location = "%s"
+}
+
+data "azurerm_role_definition" "test" {
+  name  = "Reader"
+  scope = azurerm_resource_group.test.id
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r RoleManagementPolicyResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_role_management_policy" "test" {
+  scope              = azurerm_resource_group.test.id
+  role_definition_id = data.azurerm_role_definition.test.id
+
+  activation_rules {
+    maximum_duration = "PT1H"
+    require_approval = false
+  }
+}
+`, r.template(data))
+}
+
+func (r RoleManagementPolicyResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_role_management_policy" "test" {
+  scope              = azurerm_resource_group.test.id
+  role_definition_id = data.azurerm_role_definition.test.id
+
+  activation_rules {
+    maximum_duration      = "PT2H"
+    require_approval      = true
+    require_justification = true
+
+    approval_stage {
+      primary_approver {
+        object_id = data.azurerm_client_config.current.object_id
+        type      = "User"
+      }
+    }
+  }
+
+  eligible_assignment_rules {
+    expiration_required = true
+    expire_after        = "P180D"
+  }
+
+  active_assignment_rules {
+    expiration_required                = true
+    expire_after                       = "P90D"
+    require_multifactor_authentication = true
+  }
+
+  notification_rules {
+    eligible_activations {
+      approver_notifications {
+        notification_level    = "All"
+        default_recipients    = true
+        additional_recipients = ["someone@example.com"]
+      }
+    }
+  }
+}
+`, r.template(data))
+}
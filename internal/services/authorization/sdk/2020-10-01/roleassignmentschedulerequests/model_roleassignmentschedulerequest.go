@@ -0,0 +1,8 @@
+package roleassignmentschedulerequests
+
+type RoleAssignmentScheduleRequest struct {
+	Id         *string                                  `json:"id,omitempty"`
+	Name       *string                                  `json:"name,omitempty"`
+	Properties *RoleAssignmentScheduleRequestProperties `json:"properties,omitempty"`
+	Type       *string                                  `json:"type,omitempty"`
+}
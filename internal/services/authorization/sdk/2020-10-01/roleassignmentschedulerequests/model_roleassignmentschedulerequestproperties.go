@@ -0,0 +1,21 @@
+package roleassignmentschedulerequests
+
+type RoleAssignmentScheduleRequestProperties struct {
+	ApprovalId                             *string                                              `json:"approvalId,omitempty"`
+	Condition                              *string                                              `json:"condition,omitempty"`
+	ConditionVersion                       *string                                              `json:"conditionVersion,omitempty"`
+	CreatedOn                              *string                                              `json:"createdOn,omitempty"`
+	Justification                          *string                                              `json:"justification,omitempty"`
+	PrincipalId                            string                                               `json:"principalId"`
+	PrincipalType                          *PrincipalType                                       `json:"principalType,omitempty"`
+	RequestType                            RequestType                                          `json:"requestType"`
+	RequestorId                            *string                                              `json:"requestorId,omitempty"`
+	RoleDefinitionId                       string                                               `json:"roleDefinitionId"`
+	ScheduleInfo                           *RoleAssignmentScheduleRequestPropertiesScheduleInfo `json:"scheduleInfo,omitempty"`
+	Scope                                  *string                                              `json:"scope,omitempty"`
+	Status                                 *Status                                              `json:"status,omitempty"`
+	LinkedRoleEligibilityScheduleId        *string                                              `json:"linkedRoleEligibilityScheduleId,omitempty"`
+	TargetRoleAssignmentScheduleId         *string                                              `json:"targetRoleAssignmentScheduleId,omitempty"`
+	TargetRoleAssignmentScheduleInstanceId *string                                              `json:"targetRoleAssignmentScheduleInstanceId,omitempty"`
+	TicketInfo                             *RoleAssignmentScheduleRequestPropertiesTicketInfo   `json:"ticketInfo,omitempty"`
+}
@@ -0,0 +1,6 @@
+package roleassignmentschedulerequests
+
+type RoleAssignmentScheduleRequestPropertiesScheduleInfo struct {
+	Expiration    *RoleAssignmentScheduleRequestPropertiesScheduleInfoExpiration `json:"expiration,omitempty"`
+	StartDateTime *string                                                        `json:"startDateTime,omitempty"`
+}
@@ -0,0 +1,9 @@
+package roleassignmentschedulerequests
+
+import "fmt"
+
+const defaultApiVersion = "2020-10-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/roleassignmentschedulerequests/%s", defaultApiVersion)
+}
@@ -0,0 +1,8 @@
+package roleassignmentschedules
+
+type RoleAssignmentSchedule struct {
+	Id         *string                           `json:"id,omitempty"`
+	Name       *string                           `json:"name,omitempty"`
+	Properties *RoleAssignmentScheduleProperties `json:"properties,omitempty"`
+	Type       *string                           `json:"type,omitempty"`
+}
@@ -0,0 +1,11 @@
+package roleassignmentschedules
+
+type RoleAssignmentScheduleProperties struct {
+	EndDateTime      *string     `json:"endDateTime,omitempty"`
+	MemberType       *MemberType `json:"memberType,omitempty"`
+	PrincipalId      *string     `json:"principalId,omitempty"`
+	RoleDefinitionId *string     `json:"roleDefinitionId,omitempty"`
+	Scope            *string     `json:"scope,omitempty"`
+	StartDateTime    *string     `json:"startDateTime,omitempty"`
+	Status           *Status     `json:"status,omitempty"`
+}
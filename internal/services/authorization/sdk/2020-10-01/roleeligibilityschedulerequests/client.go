@@ -0,0 +1,15 @@
+package roleeligibilityschedulerequests
+
+import "github.com/Azure/go-autorest/autorest"
+
+type RoleEligibilityScheduleRequestsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewRoleEligibilityScheduleRequestsClientWithBaseURI(endpoint string) RoleEligibilityScheduleRequestsClient {
+	return RoleEligibilityScheduleRequestsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
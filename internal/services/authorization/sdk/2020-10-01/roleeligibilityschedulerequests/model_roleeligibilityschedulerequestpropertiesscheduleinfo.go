@@ -0,0 +1,6 @@
+package roleeligibilityschedulerequests
+
+type RoleEligibilityScheduleRequestPropertiesScheduleInfo struct {
+	Expiration    *RoleEligibilityScheduleRequestPropertiesScheduleInfoExpiration `json:"expiration,omitempty"`
+	StartDateTime *string                                                         `json:"startDateTime,omitempty"`
+}
@@ -0,0 +1,7 @@
+package roleeligibilityschedulerequests
+
+type RoleEligibilityScheduleRequestPropertiesScheduleInfoExpiration struct {
+	Duration    *string `json:"duration,omitempty"`
+	EndDateTime *string `json:"endDateTime,omitempty"`
+	Type        *Type   `json:"type,omitempty"`
+}
@@ -0,0 +1,6 @@
+package roleeligibilityschedulerequests
+
+type RoleEligibilityScheduleRequestPropertiesTicketInfo struct {
+	TicketNumber *string `json:"ticketNumber,omitempty"`
+	TicketSystem *string `json:"ticketSystem,omitempty"`
+}
@@ -0,0 +1,15 @@
+package roleeligibilityschedules
+
+import "github.com/Azure/go-autorest/autorest"
+
+type RoleEligibilitySchedulesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewRoleEligibilitySchedulesClientWithBaseURI(endpoint string) RoleEligibilitySchedulesClient {
+	return RoleEligibilitySchedulesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,9 @@
+package rolemanagementpolicies
+
+type ApprovalSettings struct {
+	ApprovalMode                     *ApprovalMode    `json:"approvalMode,omitempty"`
+	ApprovalStages                   *[]ApprovalStage `json:"approvalStages,omitempty"`
+	IsApprovalRequired               *bool            `json:"isApprovalRequired,omitempty"`
+	IsApprovalRequiredForExtension   *bool            `json:"isApprovalRequiredForExtension,omitempty"`
+	IsRequestorJustificationRequired *bool            `json:"isRequestorJustificationRequired,omitempty"`
+}
@@ -0,0 +1,10 @@
+package rolemanagementpolicies
+
+type ApprovalStage struct {
+	ApprovalStageTimeOutInDays      *int64     `json:"approvalStageTimeOutInDays,omitempty"`
+	EscalationApprovers             *[]UserSet `json:"escalationApprovers,omitempty"`
+	EscalationTimeInMinutes         *int64     `json:"escalationTimeInMinutes,omitempty"`
+	IsApproverJustificationRequired *bool      `json:"isApproverJustificationRequired,omitempty"`
+	IsEscalationEnabled             *bool      `json:"isEscalationEnabled,omitempty"`
+	PrimaryApprovers                *[]UserSet `json:"primaryApprovers,omitempty"`
+}
@@ -0,0 +1,43 @@
+package rolemanagementpolicies
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+var _ RoleManagementPolicyRule = RoleManagementPolicyAuthenticationContextRule{}
+
+type RoleManagementPolicyAuthenticationContextRule struct {
+	ClaimValue *string                         `json:"claimValue,omitempty"`
+	Id         *string                         `json:"id,omitempty"`
+	IsEnabled  *bool                           `json:"isEnabled,omitempty"`
+	Target     *RoleManagementPolicyRuleTarget `json:"target,omitempty"`
+}
+
+func (s RoleManagementPolicyAuthenticationContextRule) RoleManagementPolicyRuleId() *string {
+	return s.Id
+}
+
+var _ json.Marshaler = RoleManagementPolicyAuthenticationContextRule{}
+
+func (s RoleManagementPolicyAuthenticationContextRule) MarshalJSON() ([]byte, error) {
+	type wrapper RoleManagementPolicyAuthenticationContextRule
+	wrapped := wrapper(s)
+	encoded, err := json.Marshal(wrapped)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling RoleManagementPolicyAuthenticationContextRule: %+v", err)
+	}
+
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		return nil, fmt.Errorf("unmarshaling RoleManagementPolicyAuthenticationContextRule: %+v", err)
+	}
+	decoded["ruleType"] = "RoleManagementPolicyAuthenticationContextRule"
+
+	encoded, err = json.Marshal(decoded)
+	if err != nil {
+		return nil, fmt.Errorf("re-marshaling RoleManagementPolicyAuthenticationContextRule: %+v", err)
+	}
+
+	return encoded, nil
+}
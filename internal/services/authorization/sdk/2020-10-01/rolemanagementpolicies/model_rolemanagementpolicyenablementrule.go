@@ -0,0 +1,42 @@
+package rolemanagementpolicies
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+var _ RoleManagementPolicyRule = RoleManagementPolicyEnablementRule{}
+
+type RoleManagementPolicyEnablementRule struct {
+	EnabledRules *[]EnablementRules              `json:"enabledRules,omitempty"`
+	Id           *string                         `json:"id,omitempty"`
+	Target       *RoleManagementPolicyRuleTarget `json:"target,omitempty"`
+}
+
+func (s RoleManagementPolicyEnablementRule) RoleManagementPolicyRuleId() *string {
+	return s.Id
+}
+
+var _ json.Marshaler = RoleManagementPolicyEnablementRule{}
+
+func (s RoleManagementPolicyEnablementRule) MarshalJSON() ([]byte, error) {
+	type wrapper RoleManagementPolicyEnablementRule
+	wrapped := wrapper(s)
+	encoded, err := json.Marshal(wrapped)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling RoleManagementPolicyEnablementRule: %+v", err)
+	}
+
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		return nil, fmt.Errorf("unmarshaling RoleManagementPolicyEnablementRule: %+v", err)
+	}
+	decoded["ruleType"] = "RoleManagementPolicyEnablementRule"
+
+	encoded, err = json.Marshal(decoded)
+	if err != nil {
+		return nil, fmt.Errorf("re-marshaling RoleManagementPolicyEnablementRule: %+v", err)
+	}
+
+	return encoded, nil
+}
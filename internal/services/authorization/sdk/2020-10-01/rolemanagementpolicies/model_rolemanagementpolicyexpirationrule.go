@@ -0,0 +1,43 @@
+package rolemanagementpolicies
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+var _ RoleManagementPolicyRule = RoleManagementPolicyExpirationRule{}
+
+type RoleManagementPolicyExpirationRule struct {
+	Id                   *string                         `json:"id,omitempty"`
+	IsExpirationRequired *bool                           `json:"isExpirationRequired,omitempty"`
+	MaximumDuration      *string                         `json:"maximumDuration,omitempty"`
+	Target               *RoleManagementPolicyRuleTarget `json:"target,omitempty"`
+}
+
+func (s RoleManagementPolicyExpirationRule) RoleManagementPolicyRuleId() *string {
+	return s.Id
+}
+
+var _ json.Marshaler = RoleManagementPolicyExpirationRule{}
+
+func (s RoleManagementPolicyExpirationRule) MarshalJSON() ([]byte, error) {
+	type wrapper RoleManagementPolicyExpirationRule
+	wrapped := wrapper(s)
+	encoded, err := json.Marshal(wrapped)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling RoleManagementPolicyExpirationRule: %+v", err)
+	}
+
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		return nil, fmt.Errorf("unmarshaling RoleManagementPolicyExpirationRule: %+v", err)
+	}
+	decoded["ruleType"] = "RoleManagementPolicyExpirationRule"
+
+	encoded, err = json.Marshal(decoded)
+	if err != nil {
+		return nil, fmt.Errorf("re-marshaling RoleManagementPolicyExpirationRule: %+v", err)
+	}
+
+	return encoded, nil
+}
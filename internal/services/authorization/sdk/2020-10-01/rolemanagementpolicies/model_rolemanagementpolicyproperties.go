@@ -0,0 +1,62 @@
+package rolemanagementpolicies
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+type RoleManagementPolicyProperties struct {
+	Description           *string                     `json:"description,omitempty"`
+	DisplayName           *string                     `json:"displayName,omitempty"`
+	EffectiveRules        *[]RoleManagementPolicyRule `json:"effectiveRules,omitempty"`
+	IsOrganizationDefault *bool                       `json:"isOrganizationDefault,omitempty"`
+	LastModifiedDateTime  *string                     `json:"lastModifiedDateTime,omitempty"`
+	Rules                 *[]RoleManagementPolicyRule `json:"rules,omitempty"`
+	Scope                 *string                     `json:"scope,omitempty"`
+}
+
+var _ json.Unmarshaler = &RoleManagementPolicyProperties{}
+
+func (s *RoleManagementPolicyProperties) UnmarshalJSON(bytes []byte) error {
+	type alias RoleManagementPolicyProperties
+	var decoded struct {
+		alias
+		EffectiveRules *[]json.RawMessage `json:"effectiveRules,omitempty"`
+		Rules          *[]json.RawMessage `json:"rules,omitempty"`
+	}
+	if err := json.Unmarshal(bytes, &decoded); err != nil {
+		return fmt.Errorf("unmarshaling into RoleManagementPolicyProperties: %+v", err)
+	}
+
+	*s = RoleManagementPolicyProperties(decoded.alias)
+
+	effectiveRules, err := unmarshalRoleManagementPolicyRuleSlice(decoded.EffectiveRules)
+	if err != nil {
+		return fmt.Errorf("unmarshaling 'EffectiveRules' for 'RoleManagementPolicyProperties': %+v", err)
+	}
+	s.EffectiveRules = effectiveRules
+
+	rules, err := unmarshalRoleManagementPolicyRuleSlice(decoded.Rules)
+	if err != nil {
+		return fmt.Errorf("unmarshaling 'Rules' for 'RoleManagementPolicyProperties': %+v", err)
+	}
+	s.Rules = rules
+
+	return nil
+}
+
+func unmarshalRoleManagementPolicyRuleSlice(input *[]json.RawMessage) (*[]RoleManagementPolicyRule, error) {
+	if input == nil {
+		return nil, nil
+	}
+
+	output := make([]RoleManagementPolicyRule, 0)
+	for i, val := range *input {
+		impl, err := unmarshalRoleManagementPolicyRuleImplementation(val)
+		if err != nil {
+			return nil, fmt.Errorf("unmarshaling index %d: %+v", i, err)
+		}
+		output = append(output, impl)
+	}
+	return &output, nil
+}
@@ -0,0 +1,91 @@
+package rolemanagementpolicies
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+type RoleManagementPolicyRule interface {
+	RoleManagementPolicyRuleId() *string
+}
+
+// RawRoleManagementPolicyRuleImpl is returned for Rule Types which aren't known to this SDK, the
+// values are retained so that the Rule can be round-tripped without losing any information
+type RawRoleManagementPolicyRuleImpl struct {
+	Type   string
+	Values map[string]interface{}
+}
+
+func (s RawRoleManagementPolicyRuleImpl) RoleManagementPolicyRuleId() *string {
+	if v, ok := s.Values["id"].(string); ok {
+		return &v
+	}
+	return nil
+}
+
+func (s RawRoleManagementPolicyRuleImpl) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.Values)
+}
+
+func unmarshalRoleManagementPolicyRuleImplementation(input []byte) (RoleManagementPolicyRule, error) {
+	if input == nil {
+		return nil, nil
+	}
+
+	var temp map[string]interface{}
+	if err := json.Unmarshal(input, &temp); err != nil {
+		return nil, fmt.Errorf("unmarshaling RoleManagementPolicyRule into map[string]interface: %+v", err)
+	}
+
+	value, ok := temp["ruleType"].(string)
+	if !ok {
+		return nil, nil
+	}
+
+	if strings.EqualFold(value, string(RoleManagementPolicyRuleTypeRoleManagementPolicyApprovalRule)) {
+		var out RoleManagementPolicyApprovalRule
+		if err := json.Unmarshal(input, &out); err != nil {
+			return nil, fmt.Errorf("unmarshaling into RoleManagementPolicyApprovalRule: %+v", err)
+		}
+		return out, nil
+	}
+
+	if strings.EqualFold(value, string(RoleManagementPolicyRuleTypeRoleManagementPolicyAuthenticationContextRule)) {
+		var out RoleManagementPolicyAuthenticationContextRule
+		if err := json.Unmarshal(input, &out); err != nil {
+			return nil, fmt.Errorf("unmarshaling into RoleManagementPolicyAuthenticationContextRule: %+v", err)
+		}
+		return out, nil
+	}
+
+	if strings.EqualFold(value, string(RoleManagementPolicyRuleTypeRoleManagementPolicyEnablementRule)) {
+		var out RoleManagementPolicyEnablementRule
+		if err := json.Unmarshal(input, &out); err != nil {
+			return nil, fmt.Errorf("unmarshaling into RoleManagementPolicyEnablementRule: %+v", err)
+		}
+		return out, nil
+	}
+
+	if strings.EqualFold(value, string(RoleManagementPolicyRuleTypeRoleManagementPolicyExpirationRule)) {
+		var out RoleManagementPolicyExpirationRule
+		if err := json.Unmarshal(input, &out); err != nil {
+			return nil, fmt.Errorf("unmarshaling into RoleManagementPolicyExpirationRule: %+v", err)
+		}
+		return out, nil
+	}
+
+	if strings.EqualFold(value, string(RoleManagementPolicyRuleTypeRoleManagementPolicyNotificationRule)) {
+		var out RoleManagementPolicyNotificationRule
+		if err := json.Unmarshal(input, &out); err != nil {
+			return nil, fmt.Errorf("unmarshaling into RoleManagementPolicyNotificationRule: %+v", err)
+		}
+		return out, nil
+	}
+
+	out := RawRoleManagementPolicyRuleImpl{
+		Type:   value,
+		Values: temp,
+	}
+	return out, nil
+}
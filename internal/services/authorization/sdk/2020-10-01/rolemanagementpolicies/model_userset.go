@@ -0,0 +1,8 @@
+package rolemanagementpolicies
+
+type UserSet struct {
+	Description *string   `json:"description,omitempty"`
+	Id          *string   `json:"id,omitempty"`
+	IsBackup    *bool     `json:"isBackup,omitempty"`
+	UserType    *UserType `json:"userType,omitempty"`
+}
@@ -0,0 +1,7 @@
+package rolemanagementpolicyassignments
+
+type RoleManagementPolicyAssignmentProperties struct {
+	PolicyId         *string `json:"policyId,omitempty"`
+	RoleDefinitionId *string `json:"roleDefinitionId,omitempty"`
+	Scope            *string `json:"scope,omitempty"`
+}
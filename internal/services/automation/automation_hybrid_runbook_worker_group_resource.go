@@ -0,0 +1,181 @@
+package automation
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/automation/sdk/2021-06-22/hybridrunbookworkergroup"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/automation/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceAutomationHybridRunbookWorkerGroup() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceAutomationHybridRunbookWorkerGroupCreate,
+		Read:   resourceAutomationHybridRunbookWorkerGroupRead,
+		Update: resourceAutomationHybridRunbookWorkerGroupUpdate,
+		Delete: resourceAutomationHybridRunbookWorkerGroupDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := hybridrunbookworkergroup.ParseHybridRunbookWorkerGroupID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"automation_account_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.AutomationAccount(),
+			},
+
+			// when omitted runbooks are run as the Local System account on the Hybrid Workers
+			"credential_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+	}
+}
+
+func resourceAutomationHybridRunbookWorkerGroupCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Automation.HybridWorkerGroupClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := hybridrunbookworkergroup.NewHybridRunbookWorkerGroupID(subscriptionId, d.Get("resource_group_name").(string), d.Get("automation_account_name").(string), d.Get("name").(string))
+
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_automation_hybrid_runbook_worker_group", id.ID())
+	}
+
+	payload := hybridrunbookworkergroup.HybridRunbookWorkerGroupCreateOrUpdateParameters{
+		Name:       utils.String(id.HybridRunbookWorkerGroupName),
+		Properties: expandAutomationHybridRunbookWorkerGroupProperties(d.Get("credential_name").(string)),
+	}
+	if _, err := client.Create(ctx, id, payload); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceAutomationHybridRunbookWorkerGroupRead(d, meta)
+}
+
+func resourceAutomationHybridRunbookWorkerGroupRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Automation.HybridWorkerGroupClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := hybridrunbookworkergroup.ParseHybridRunbookWorkerGroupID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state!", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.HybridRunbookWorkerGroupName)
+	d.Set("resource_group_name", id.ResourceGroupName)
+	d.Set("automation_account_name", id.AutomationAccountName)
+
+	credentialName := ""
+	if model := resp.Model; model != nil && model.Properties != nil {
+		if credential := model.Properties.Credential; credential != nil && credential.Name != nil {
+			credentialName = *credential.Name
+		}
+	}
+	d.Set("credential_name", credentialName)
+
+	return nil
+}
+
+func resourceAutomationHybridRunbookWorkerGroupUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Automation.HybridWorkerGroupClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := hybridrunbookworkergroup.ParseHybridRunbookWorkerGroupID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	payload := hybridrunbookworkergroup.HybridRunbookWorkerGroupCreateOrUpdateParameters{
+		Properties: expandAutomationHybridRunbookWorkerGroupProperties(d.Get("credential_name").(string)),
+	}
+	if _, err := client.Update(ctx, *id, payload); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return resourceAutomationHybridRunbookWorkerGroupRead(d, meta)
+}
+
+func resourceAutomationHybridRunbookWorkerGroupDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Automation.HybridWorkerGroupClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := hybridrunbookworkergroup.ParseHybridRunbookWorkerGroupID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if resp, err := client.Delete(ctx, *id); err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil
+		}
+
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandAutomationHybridRunbookWorkerGroupProperties(credentialName string) *hybridrunbookworkergroup.HybridRunbookWorkerGroupCreateOrUpdateProperties {
+	// an empty credential (rather than omitting it) is required to revert to the Local System account
+	return &hybridrunbookworkergroup.HybridRunbookWorkerGroupCreateOrUpdateProperties{
+		Credential: &hybridrunbookworkergroup.RunAsCredentialAssociationProperty{
+			Name: utils.String(credentialName),
+		},
+	}
+}
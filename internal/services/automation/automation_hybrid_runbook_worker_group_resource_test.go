@@ -0,0 +1,157 @@
+package automation_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/automation/sdk/2021-06-22/hybridrunbookworkergroup"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type AutomationHybridRunbookWorkerGroupResource struct {
+}
+
+func TestAccAutomationHybridRunbookWorkerGroup_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_automation_hybrid_runbook_worker_group", "test")
+	r := AutomationHybridRunbookWorkerGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAutomationHybridRunbookWorkerGroup_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_automation_hybrid_runbook_worker_group", "test")
+	r := AutomationHybridRunbookWorkerGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccAutomationHybridRunbookWorkerGroup_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_automation_hybrid_runbook_worker_group", "test")
+	r := AutomationHybridRunbookWorkerGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("credential_name").HasValue(fmt.Sprintf("acctest-%d", data.RandomInteger)),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("credential_name").HasValue(""),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (t AutomationHybridRunbookWorkerGroupResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := hybridrunbookworkergroup.ParseHybridRunbookWorkerGroupID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Automation.HybridWorkerGroupClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (AutomationHybridRunbookWorkerGroupResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-auto-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_automation_account" "test" {
+  name                = "acctest-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku_name            = "Basic"
+}
+
+resource "azurerm_automation_credential" "test" {
+  name                    = "acctest-%[1]d"
+  resource_group_name     = azurerm_resource_group.test.name
+  automation_account_name = azurerm_automation_account.test.name
+  username                = "test_user"
+  password                = "test_pwd"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r AutomationHybridRunbookWorkerGroupResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_automation_hybrid_runbook_worker_group" "test" {
+  name                    = "acctest-%d"
+  resource_group_name     = azurerm_resource_group.test.name
+  automation_account_name = azurerm_automation_account.test.name
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r AutomationHybridRunbookWorkerGroupResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_automation_hybrid_runbook_worker_group" "import" {
+  name                    = azurerm_automation_hybrid_runbook_worker_group.test.name
+  resource_group_name     = azurerm_automation_hybrid_runbook_worker_group.test.resource_group_name
+  automation_account_name = azurerm_automation_hybrid_runbook_worker_group.test.automation_account_name
+}
+`, r.basic(data))
+}
+
+func (r AutomationHybridRunbookWorkerGroupResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_automation_hybrid_runbook_worker_group" "test" {
+  name                    = "acctest-%d"
+  resource_group_name     = azurerm_resource_group.test.name
+  automation_account_name = azurerm_automation_account.test.name
+  credential_name         = azurerm_automation_credential.test.name
+}
+`, r.template(data), data.RandomInteger)
+}
@@ -0,0 +1,193 @@
+package automation
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/automation/sdk/2021-06-22/hybridrunbookworker"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/automation/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceAutomationHybridRunbookWorker() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceAutomationHybridRunbookWorkerCreate,
+		Read:   resourceAutomationHybridRunbookWorkerRead,
+		Delete: resourceAutomationHybridRunbookWorkerDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := hybridrunbookworker.ParseHybridRunbookWorkerID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"automation_account_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.AutomationAccount(),
+			},
+
+			"worker_group_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"worker_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			// either an Azure Virtual Machine or an Azure Arc-enabled Server
+			"vm_resource_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"ip": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"last_seen_date_time": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"registration_date_time": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"worker_name": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"worker_type": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAutomationHybridRunbookWorkerCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Automation.HybridRunbookWorkerClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := hybridrunbookworker.NewHybridRunbookWorkerID(subscriptionId, d.Get("resource_group_name").(string), d.Get("automation_account_name").(string), d.Get("worker_group_name").(string), d.Get("worker_id").(string))
+
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_automation_hybrid_runbook_worker", id.ID())
+	}
+
+	payload := hybridrunbookworker.HybridRunbookWorkerCreateParameters{
+		Name: utils.String(id.HybridRunbookWorkerId),
+		Properties: hybridrunbookworker.HybridRunbookWorkerCreateOrUpdateParameters{
+			VMResourceId: utils.String(d.Get("vm_resource_id").(string)),
+		},
+	}
+	if _, err := client.Create(ctx, id, payload); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceAutomationHybridRunbookWorkerRead(d, meta)
+}
+
+func resourceAutomationHybridRunbookWorkerRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Automation.HybridRunbookWorkerClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := hybridrunbookworker.ParseHybridRunbookWorkerID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state!", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("resource_group_name", id.ResourceGroupName)
+	d.Set("automation_account_name", id.AutomationAccountName)
+	d.Set("worker_group_name", id.HybridRunbookWorkerGroupName)
+	d.Set("worker_id", id.HybridRunbookWorkerId)
+
+	if model := resp.Model; model != nil && model.Properties != nil {
+		props := model.Properties
+		d.Set("vm_resource_id", props.VMResourceId)
+		d.Set("ip", props.IP)
+		d.Set("last_seen_date_time", props.LastSeenDateTime)
+		d.Set("registration_date_time", props.RegisteredDateTime)
+		d.Set("worker_name", props.WorkerName)
+
+		workerType := ""
+		if props.WorkerType != nil {
+			workerType = string(*props.WorkerType)
+		}
+		d.Set("worker_type", workerType)
+	}
+
+	return nil
+}
+
+func resourceAutomationHybridRunbookWorkerDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Automation.HybridRunbookWorkerClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := hybridrunbookworker.ParseHybridRunbookWorkerID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if resp, err := client.Delete(ctx, *id); err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil
+		}
+
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
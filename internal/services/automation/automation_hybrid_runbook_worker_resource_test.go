@@ -0,0 +1,180 @@
+package automation_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/automation/sdk/2021-06-22/hybridrunbookworker"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type AutomationHybridRunbookWorkerResource struct {
+	workerId string
+}
+
+func TestAccAutomationHybridRunbookWorker_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_automation_hybrid_runbook_worker", "test")
+	r := newAutomationHybridRunbookWorkerResource(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("worker_type").HasValue("HybridV2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAutomationHybridRunbookWorker_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_automation_hybrid_runbook_worker", "test")
+	r := newAutomationHybridRunbookWorkerResource(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func newAutomationHybridRunbookWorkerResource(t *testing.T) AutomationHybridRunbookWorkerResource {
+	workerId, err := uuid.GenerateUUID()
+	if err != nil {
+		t.Fatalf("generating a Worker ID: %+v", err)
+	}
+
+	return AutomationHybridRunbookWorkerResource{
+		workerId: workerId,
+	}
+}
+
+func (t AutomationHybridRunbookWorkerResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := hybridrunbookworker.ParseHybridRunbookWorkerID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Automation.HybridRunbookWorkerClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (AutomationHybridRunbookWorkerResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-auto-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_automation_account" "test" {
+  name                = "acctest-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku_name            = "Basic"
+}
+
+resource "azurerm_automation_hybrid_runbook_worker_group" "test" {
+  name                    = "acctest-%[1]d"
+  resource_group_name     = azurerm_resource_group.test.name
+  automation_account_name = azurerm_automation_account.test.name
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestVNet-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  address_space       = ["192.168.1.0/24"]
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "acctestSubnet-%[1]d"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["192.168.1.0/24"]
+}
+
+resource "azurerm_network_interface" "test" {
+  name                = "acctestNIC-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  ip_configuration {
+    name                          = "vm-%[1]d"
+    subnet_id                     = azurerm_subnet.test.id
+    private_ip_address_allocation = "Dynamic"
+  }
+}
+
+resource "azurerm_linux_virtual_machine" "test" {
+  name                = "acctestVM-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  size                            = "Standard_B1s"
+  admin_username                  = "testadmin"
+  admin_password                  = "Password1234!"
+  disable_password_authentication = false
+
+  source_image_reference {
+    publisher = "Canonical"
+    offer     = "UbuntuServer"
+    sku       = "18.04-LTS"
+    version   = "latest"
+  }
+
+  os_disk {
+    caching              = "ReadWrite"
+    storage_account_type = "Standard_LRS"
+  }
+
+  network_interface_ids = [azurerm_network_interface.test.id]
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r AutomationHybridRunbookWorkerResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_automation_hybrid_runbook_worker" "test" {
+  resource_group_name     = azurerm_resource_group.test.name
+  automation_account_name = azurerm_automation_account.test.name
+  worker_group_name       = azurerm_automation_hybrid_runbook_worker_group.test.name
+  worker_id               = "%s"
+  vm_resource_id          = azurerm_linux_virtual_machine.test.id
+}
+`, r.template(data), r.workerId)
+}
+
+func (r AutomationHybridRunbookWorkerResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_automation_hybrid_runbook_worker" "import" {
+  resource_group_name     = azurerm_automation_hybrid_runbook_worker.test.resource_group_name
+  automation_account_name = azurerm_automation_hybrid_runbook_worker.test.automation_account_name
+  worker_group_name       = azurerm_automation_hybrid_runbook_worker.test.worker_group_name
+  worker_id               = azurerm_automation_hybrid_runbook_worker.test.worker_id
+  vm_resource_id          = azurerm_automation_hybrid_runbook_worker.test.vm_resource_id
+}
+`, r.basic(data))
+}
@@ -0,0 +1,295 @@
+package automation
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/automation/mgmt/2018-06-30-preview/automation"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/automation/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/automation/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceAutomationSourceControl() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceAutomationSourceControlCreate,
+		Read:   resourceAutomationSourceControlRead,
+		Update: resourceAutomationSourceControlUpdate,
+		Delete: resourceAutomationSourceControlDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.SourceControlID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"automation_account_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.AutomationAccount(),
+			},
+
+			"repository_url": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsURLWithHTTPS,
+			},
+
+			"source_control_type": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(automation.GitHub),
+					string(automation.VsoGit),
+					string(automation.VsoTfvc),
+				}, false),
+			},
+
+			// the security token isn't returned by the API, so it's taken from the config/state
+			"security": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"token": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"token_type": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(automation.Oauth),
+								string(automation.PersonalAccessToken),
+							}, false),
+						},
+
+						"refresh_token": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
+			// a branch isn't applicable to TFVC repositories
+			"branch": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"folder_path": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"automatic_sync": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"publish_runbook_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"description": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 512),
+			},
+		},
+	}
+}
+
+func resourceAutomationSourceControlCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Automation.SourceControlClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewSourceControlID(client.SubscriptionID, d.Get("resource_group_name").(string), d.Get("automation_account_name").(string), d.Get("name").(string))
+
+	existing, err := client.Get(ctx, id.ResourceGroup, id.AutomationAccountName, id.Name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !utils.ResponseWasNotFound(existing.Response) {
+		return tf.ImportAsExistsError("azurerm_automation_source_control", id.ID())
+	}
+
+	parameters := automation.SourceControlCreateOrUpdateParameters{
+		SourceControlCreateOrUpdateProperties: &automation.SourceControlCreateOrUpdateProperties{
+			RepoURL:        utils.String(d.Get("repository_url").(string)),
+			Branch:         utils.String(d.Get("branch").(string)),
+			FolderPath:     utils.String(d.Get("folder_path").(string)),
+			AutoSync:       utils.Bool(d.Get("automatic_sync").(bool)),
+			PublishRunbook: utils.Bool(d.Get("publish_runbook_enabled").(bool)),
+			SourceType:     automation.SourceType(d.Get("source_control_type").(string)),
+			SecurityToken:  expandAutomationSourceControlSecurity(d.Get("security").([]interface{})),
+			Description:    utils.String(d.Get("description").(string)),
+		},
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.AutomationAccountName, id.Name, parameters); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceAutomationSourceControlRead(d, meta)
+}
+
+func resourceAutomationSourceControlRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Automation.SourceControlClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.SourceControlID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.AutomationAccountName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state!", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("automation_account_name", id.AutomationAccountName)
+
+	if props := resp.SourceControlProperties; props != nil {
+		d.Set("repository_url", props.RepoURL)
+		d.Set("source_control_type", string(props.SourceType))
+		d.Set("branch", props.Branch)
+		d.Set("folder_path", props.FolderPath)
+		d.Set("automatic_sync", props.AutoSync)
+		d.Set("publish_runbook_enabled", props.PublishRunbook)
+		d.Set("description", props.Description)
+	}
+
+	return nil
+}
+
+func resourceAutomationSourceControlUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Automation.SourceControlClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.SourceControlID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	props := automation.SourceControlUpdateProperties{}
+	if d.HasChange("branch") {
+		props.Branch = utils.String(d.Get("branch").(string))
+	}
+	if d.HasChange("folder_path") {
+		props.FolderPath = utils.String(d.Get("folder_path").(string))
+	}
+	if d.HasChange("automatic_sync") {
+		props.AutoSync = utils.Bool(d.Get("automatic_sync").(bool))
+	}
+	if d.HasChange("publish_runbook_enabled") {
+		props.PublishRunbook = utils.Bool(d.Get("publish_runbook_enabled").(bool))
+	}
+	if d.HasChange("security") {
+		props.SecurityToken = expandAutomationSourceControlSecurity(d.Get("security").([]interface{}))
+	}
+	if d.HasChange("description") {
+		props.Description = utils.String(d.Get("description").(string))
+	}
+
+	parameters := automation.SourceControlUpdateParameters{
+		SourceControlUpdateProperties: &props,
+	}
+	if _, err := client.Update(ctx, id.ResourceGroup, id.AutomationAccountName, id.Name, parameters); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return resourceAutomationSourceControlRead(d, meta)
+}
+
+func resourceAutomationSourceControlDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Automation.SourceControlClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.SourceControlID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Delete(ctx, id.ResourceGroup, id.AutomationAccountName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return nil
+		}
+
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandAutomationSourceControlSecurity(input []interface{}) *automation.SourceControlSecurityTokenProperties {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+	raw := input[0].(map[string]interface{})
+
+	output := automation.SourceControlSecurityTokenProperties{
+		AccessToken: utils.String(raw["token"].(string)),
+		TokenType:   automation.TokenType(raw["token_type"].(string)),
+	}
+	if v := raw["refresh_token"].(string); v != "" {
+		output.RefreshToken = utils.String(v)
+	}
+
+	return &output
+}
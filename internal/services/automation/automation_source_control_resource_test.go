@@ -0,0 +1,191 @@
+package automation_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/automation/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type AutomationSourceControlResource struct {
+	token string
+}
+
+func TestAccAutomationSourceControl_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_automation_source_control", "test")
+	r := newAutomationSourceControlResource(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("security"),
+	})
+}
+
+func TestAccAutomationSourceControl_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_automation_source_control", "test")
+	r := newAutomationSourceControlResource(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccAutomationSourceControl_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_automation_source_control", "test")
+	r := newAutomationSourceControlResource(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("security"),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("automatic_sync").HasValue("true"),
+				check.That(data.ResourceName).Key("publish_runbook_enabled").HasValue("false"),
+			),
+		},
+		data.ImportStep("security"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("security"),
+	})
+}
+
+func newAutomationSourceControlResource(t *testing.T) AutomationSourceControlResource {
+	token := os.Getenv("ARM_TEST_GITHUB_TOKEN")
+	if token == "" {
+		t.Skip("Skipping as `ARM_TEST_GITHUB_TOKEN` is not specified")
+	}
+
+	return AutomationSourceControlResource{
+		token: token,
+	}
+}
+
+func (t AutomationSourceControlResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.SourceControlID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Automation.SourceControlClient.Get(ctx, id.ResourceGroup, id.AutomationAccountName, id.Name)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.SourceControlProperties != nil), nil
+}
+
+func (AutomationSourceControlResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-auto-%d"
+  location = "%s"
+}
+
+resource "azurerm_automation_account" "test" {
+  name                = "acctest-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku_name            = "Basic"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
+func (r AutomationSourceControlResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_automation_source_control" "test" {
+  name                    = "acctest-%d"
+  resource_group_name     = azurerm_resource_group.test.name
+  automation_account_name = azurerm_automation_account.test.name
+  repository_url          = "https://github.com/Azure-Samples/azure-automation-runbooks.git"
+  source_control_type     = "GitHub"
+  branch                  = "master"
+  folder_path             = "/"
+
+  security {
+    token      = "%s"
+    token_type = "PersonalAccessToken"
+  }
+}
+`, r.template(data), data.RandomInteger, r.token)
+}
+
+func (r AutomationSourceControlResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_automation_source_control" "import" {
+  name                    = azurerm_automation_source_control.test.name
+  resource_group_name     = azurerm_automation_source_control.test.resource_group_name
+  automation_account_name = azurerm_automation_source_control.test.automation_account_name
+  repository_url          = azurerm_automation_source_control.test.repository_url
+  source_control_type     = azurerm_automation_source_control.test.source_control_type
+  branch                  = azurerm_automation_source_control.test.branch
+  folder_path             = azurerm_automation_source_control.test.folder_path
+
+  security {
+    token      = "%s"
+    token_type = "PersonalAccessToken"
+  }
+}
+`, r.basic(data), r.token)
+}
+
+func (r AutomationSourceControlResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_automation_source_control" "test" {
+  name                    = "acctest-%d"
+  resource_group_name     = azurerm_resource_group.test.name
+  automation_account_name = azurerm_automation_account.test.name
+  repository_url          = "https://github.com/Azure-Samples/azure-automation-runbooks.git"
+  source_control_type     = "GitHub"
+  branch                  = "master"
+  folder_path             = "/runbooks"
+  automatic_sync          = true
+  publish_runbook_enabled = false
+  description             = "Runbooks synchronized from GitHub"
+
+  security {
+    token      = "%s"
+    token_type = "PersonalAccessToken"
+  }
+}
+`, r.template(data), data.RandomInteger, r.token)
+}
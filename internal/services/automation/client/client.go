@@ -3,6 +3,8 @@ package client
 import (
 	"github.com/Azure/azure-sdk-for-go/services/preview/automation/mgmt/2018-06-30-preview/automation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/automation/sdk/2021-06-22/hybridrunbookworker"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/automation/sdk/2021-06-22/hybridrunbookworkergroup"
 )
 
 type Client struct {
@@ -14,11 +16,14 @@ type Client struct {
 	CredentialClient            *automation.CredentialClient
 	DscConfigurationClient      *automation.DscConfigurationClient
 	DscNodeConfigurationClient  *automation.DscNodeConfigurationClient
+	HybridRunbookWorkerClient   *hybridrunbookworker.HybridRunbookWorkerClient
+	HybridWorkerGroupClient     *hybridrunbookworkergroup.HybridRunbookWorkerGroupClient
 	JobScheduleClient           *automation.JobScheduleClient
 	ModuleClient                *automation.ModuleClient
 	RunbookClient               *automation.RunbookClient
 	RunbookDraftClient          *automation.RunbookDraftClient
 	ScheduleClient              *automation.ScheduleClient
+	SourceControlClient         *automation.SourceControlClient
 	VariableClient              *automation.VariableClient
 	WebhookClient               *automation.WebhookClient
 }
@@ -48,6 +53,12 @@ func NewClient(o *common.ClientOptions) *Client {
 	dscNodeConfigurationClient := automation.NewDscNodeConfigurationClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&dscNodeConfigurationClient.Client, o.ResourceManagerAuthorizer)
 
+	hybridRunbookWorkerClient := hybridrunbookworker.NewHybridRunbookWorkerClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&hybridRunbookWorkerClient.Client, o.ResourceManagerAuthorizer)
+
+	hybridWorkerGroupClient := hybridrunbookworkergroup.NewHybridRunbookWorkerGroupClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&hybridWorkerGroupClient.Client, o.ResourceManagerAuthorizer)
+
 	jobScheduleClient := automation.NewJobScheduleClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&jobScheduleClient.Client, o.ResourceManagerAuthorizer)
 
@@ -63,6 +74,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	scheduleClient := automation.NewScheduleClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&scheduleClient.Client, o.ResourceManagerAuthorizer)
 
+	sourceControlClient := automation.NewSourceControlClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&sourceControlClient.Client, o.ResourceManagerAuthorizer)
+
 	variableClient := automation.NewVariableClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&variableClient.Client, o.ResourceManagerAuthorizer)
 
@@ -78,11 +92,14 @@ func NewClient(o *common.ClientOptions) *Client {
 		CredentialClient:            &credentialClient,
 		DscConfigurationClient:      &dscConfigurationClient,
 		DscNodeConfigurationClient:  &dscNodeConfigurationClient,
+		HybridRunbookWorkerClient:   &hybridRunbookWorkerClient,
+		HybridWorkerGroupClient:     &hybridWorkerGroupClient,
 		JobScheduleClient:           &jobScheduleClient,
 		ModuleClient:                &moduleClient,
 		RunbookClient:               &runbookClient,
 		RunbookDraftClient:          &runbookDraftClient,
 		ScheduleClient:              &scheduleClient,
+		SourceControlClient:         &sourceControlClient,
 		VariableClient:              &variableClient,
 		WebhookClient:               &webhookClient,
 	}
@@ -41,10 +41,13 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_automation_credential":                     resourceAutomationCredential(),
 		"azurerm_automation_dsc_configuration":              resourceAutomationDscConfiguration(),
 		"azurerm_automation_dsc_nodeconfiguration":          resourceAutomationDscNodeConfiguration(),
+		"azurerm_automation_hybrid_runbook_worker":          resourceAutomationHybridRunbookWorker(),
+		"azurerm_automation_hybrid_runbook_worker_group":    resourceAutomationHybridRunbookWorkerGroup(),
 		"azurerm_automation_job_schedule":                   resourceAutomationJobSchedule(),
 		"azurerm_automation_module":                         resourceAutomationModule(),
 		"azurerm_automation_runbook":                        resourceAutomationRunbook(),
 		"azurerm_automation_schedule":                       resourceAutomationSchedule(),
+		"azurerm_automation_source_control":                 resourceAutomationSourceControl(),
 		"azurerm_automation_variable_bool":                  resourceAutomationVariableBool(),
 		"azurerm_automation_variable_datetime":              resourceAutomationVariableDateTime(),
 		"azurerm_automation_variable_int":                   resourceAutomationVariableInt(),
@@ -0,0 +1,15 @@
+package hybridrunbookworker
+
+import "github.com/Azure/go-autorest/autorest"
+
+type HybridRunbookWorkerClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewHybridRunbookWorkerClientWithBaseURI(endpoint string) HybridRunbookWorkerClient {
+	return HybridRunbookWorkerClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
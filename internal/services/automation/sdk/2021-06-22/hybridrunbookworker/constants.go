@@ -0,0 +1,31 @@
+package hybridrunbookworker
+
+import "strings"
+
+type WorkerType string
+
+const (
+	WorkerTypeHybridV1 WorkerType = "HybridV1"
+	WorkerTypeHybridV2 WorkerType = "HybridV2"
+)
+
+func PossibleValuesForWorkerType() []string {
+	return []string{
+		string(WorkerTypeHybridV1),
+		string(WorkerTypeHybridV2),
+	}
+}
+
+func parseWorkerType(input string) (*WorkerType, error) {
+	vals := map[string]WorkerType{
+		"hybridv1": WorkerTypeHybridV1,
+		"hybridv2": WorkerTypeHybridV2,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := WorkerType(input)
+	return &out, nil
+}
@@ -0,0 +1,8 @@
+package hybridrunbookworker
+
+type HybridRunbookWorker struct {
+	Id         *string                        `json:"id,omitempty"`
+	Name       *string                        `json:"name,omitempty"`
+	Properties *HybridRunbookWorkerProperties `json:"properties,omitempty"`
+	Type       *string                        `json:"type,omitempty"`
+}
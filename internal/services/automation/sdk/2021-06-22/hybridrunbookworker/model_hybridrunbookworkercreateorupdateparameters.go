@@ -0,0 +1,5 @@
+package hybridrunbookworker
+
+type HybridRunbookWorkerCreateOrUpdateParameters struct {
+	VMResourceId *string `json:"vmResourceId,omitempty"`
+}
@@ -0,0 +1,6 @@
+package hybridrunbookworker
+
+type HybridRunbookWorkerCreateParameters struct {
+	Name       *string                                     `json:"name,omitempty"`
+	Properties HybridRunbookWorkerCreateOrUpdateParameters `json:"properties"`
+}
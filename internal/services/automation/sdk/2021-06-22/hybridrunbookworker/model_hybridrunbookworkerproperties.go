@@ -0,0 +1,10 @@
+package hybridrunbookworker
+
+type HybridRunbookWorkerProperties struct {
+	IP                 *string     `json:"ip,omitempty"`
+	LastSeenDateTime   *string     `json:"lastSeenDateTime,omitempty"`
+	RegisteredDateTime *string     `json:"registeredDateTime,omitempty"`
+	VMResourceId       *string     `json:"vmResourceId,omitempty"`
+	WorkerName         *string     `json:"workerName,omitempty"`
+	WorkerType         *WorkerType `json:"workerType,omitempty"`
+}
@@ -0,0 +1,9 @@
+package hybridrunbookworker
+
+import "fmt"
+
+const defaultApiVersion = "2021-06-22"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/hybridrunbookworker/%s", defaultApiVersion)
+}
@@ -0,0 +1,15 @@
+package hybridrunbookworkergroup
+
+import "github.com/Azure/go-autorest/autorest"
+
+type HybridRunbookWorkerGroupClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewHybridRunbookWorkerGroupClientWithBaseURI(endpoint string) HybridRunbookWorkerGroupClient {
+	return HybridRunbookWorkerGroupClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
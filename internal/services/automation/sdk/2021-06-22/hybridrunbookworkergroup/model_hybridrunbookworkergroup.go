@@ -0,0 +1,8 @@
+package hybridrunbookworkergroup
+
+type HybridRunbookWorkerGroup struct {
+	Id         *string                             `json:"id,omitempty"`
+	Name       *string                             `json:"name,omitempty"`
+	Properties *HybridRunbookWorkerGroupProperties `json:"properties,omitempty"`
+	Type       *string                             `json:"type,omitempty"`
+}
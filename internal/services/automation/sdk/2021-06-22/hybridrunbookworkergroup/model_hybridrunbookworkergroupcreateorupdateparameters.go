@@ -0,0 +1,6 @@
+package hybridrunbookworkergroup
+
+type HybridRunbookWorkerGroupCreateOrUpdateParameters struct {
+	Name       *string                                           `json:"name,omitempty"`
+	Properties *HybridRunbookWorkerGroupCreateOrUpdateProperties `json:"properties,omitempty"`
+}
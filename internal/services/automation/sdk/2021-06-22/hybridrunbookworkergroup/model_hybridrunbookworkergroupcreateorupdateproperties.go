@@ -0,0 +1,5 @@
+package hybridrunbookworkergroup
+
+type HybridRunbookWorkerGroupCreateOrUpdateProperties struct {
+	Credential *RunAsCredentialAssociationProperty `json:"credential,omitempty"`
+}
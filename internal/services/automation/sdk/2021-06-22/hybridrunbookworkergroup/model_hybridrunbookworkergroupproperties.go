@@ -0,0 +1,6 @@
+package hybridrunbookworkergroup
+
+type HybridRunbookWorkerGroupProperties struct {
+	Credential *RunAsCredentialAssociationProperty `json:"credential,omitempty"`
+	GroupType  *GroupTypeEnum                      `json:"groupType,omitempty"`
+}
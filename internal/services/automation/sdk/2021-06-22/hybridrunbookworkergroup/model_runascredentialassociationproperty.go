@@ -0,0 +1,5 @@
+package hybridrunbookworkergroup
+
+type RunAsCredentialAssociationProperty struct {
+	Name *string `json:"name,omitempty"`
+}
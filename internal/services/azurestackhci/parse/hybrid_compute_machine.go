@@ -0,0 +1,114 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceid"
+)
+
+type HybridComputeMachineId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	MachineName    string
+}
+
+func NewHybridComputeMachineID(subscriptionId, resourceGroup, machineName string) HybridComputeMachineId {
+	return HybridComputeMachineId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		MachineName:    machineName,
+	}
+}
+
+func (id HybridComputeMachineId) String() string {
+	segments := []string{
+		fmt.Sprintf("Machine Name %q", id.MachineName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Hybrid Compute Machine", segmentsStr)
+}
+
+func (id HybridComputeMachineId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.HybridCompute/machines/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.MachineName)
+}
+
+// HybridComputeMachineID parses a HybridComputeMachine ID into an HybridComputeMachineId struct
+func HybridComputeMachineID(input string) (*HybridComputeMachineId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := HybridComputeMachineId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.MachineName, err = id.PopSegment("machines"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// HybridComputeMachineIDInsensitively parses an HybridComputeMachine ID into an HybridComputeMachineId struct, insensitively
+// This should only be used to parse an ID for rewriting, the HybridComputeMachineID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func HybridComputeMachineIDInsensitively(input string) (*HybridComputeMachineId, error) {
+	id, err := resourceid.ParseAzureResourceIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := HybridComputeMachineId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	// find the correct casing for the 'machines' segment
+	machinesKey := "machines"
+	for key := range id.Path {
+		if strings.EqualFold(key, machinesKey) {
+			machinesKey = key
+			break
+		}
+	}
+	if resourceId.MachineName, err = id.PopSegment(machinesKey); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -1,9 +1,17 @@
 package azurestackhci
 
-import "github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
 
 type Registration struct{}
 
+var (
+	_ sdk.TypedServiceRegistration   = Registration{}
+	_ sdk.UntypedServiceRegistration = Registration{}
+)
+
 // Name is the name of this Service
 func (r Registration) Name() string {
 	return "Azure Stack HCI"
@@ -27,3 +35,18 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_stack_hci_cluster": resourceArmStackHCICluster(),
 	}
 }
+
+// DataSources returns a list of Data Sources supported by this Service
+func (r Registration) DataSources() []sdk.DataSource {
+	return []sdk.DataSource{}
+}
+
+// Resources returns a list of Resources supported by this Service
+func (r Registration) Resources() []sdk.Resource {
+	return []sdk.Resource{
+		StackHCILogicalNetworkResource{},
+		StackHCIMarketplaceGalleryImageResource{},
+		StackHCINetworkInterfaceResource{},
+		StackHCIVirtualMachineInstanceResource{},
+	}
+}
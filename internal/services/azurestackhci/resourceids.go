@@ -1,3 +1,6 @@
 package azurestackhci
 
 //go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=Cluster -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.AzureStackHCI/clusters/cluster1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=CustomLocation -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ExtendedLocation/customLocations/location1 -rewrite=true
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=HybridComputeMachine -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.HybridCompute/machines/machine1 -rewrite=true
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=StorageContainer -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.AzureStackHCI/storageContainers/container1 -rewrite=true
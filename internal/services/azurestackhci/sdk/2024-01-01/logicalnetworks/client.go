@@ -0,0 +1,15 @@
+package logicalnetworks
+
+import "github.com/Azure/go-autorest/autorest"
+
+type LogicalNetworksClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewLogicalNetworksClientWithBaseURI(endpoint string) LogicalNetworksClient {
+	return LogicalNetworksClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,161 @@
+package logicalnetworks
+
+import "strings"
+
+type CreatedByType string
+
+const (
+	CreatedByTypeApplication     CreatedByType = "Application"
+	CreatedByTypeKey             CreatedByType = "Key"
+	CreatedByTypeManagedIdentity CreatedByType = "ManagedIdentity"
+	CreatedByTypeUser            CreatedByType = "User"
+)
+
+func PossibleValuesForCreatedByType() []string {
+	return []string{
+		string(CreatedByTypeApplication),
+		string(CreatedByTypeKey),
+		string(CreatedByTypeManagedIdentity),
+		string(CreatedByTypeUser),
+	}
+}
+
+func parseCreatedByType(input string) (*CreatedByType, error) {
+	vals := map[string]CreatedByType{
+		"application":     CreatedByTypeApplication,
+		"key":             CreatedByTypeKey,
+		"managedidentity": CreatedByTypeManagedIdentity,
+		"user":            CreatedByTypeUser,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := CreatedByType(input)
+	return &out, nil
+}
+
+type ExtendedLocationTypes string
+
+const (
+	ExtendedLocationTypesCustomLocation ExtendedLocationTypes = "CustomLocation"
+)
+
+func PossibleValuesForExtendedLocationTypes() []string {
+	return []string{
+		string(ExtendedLocationTypesCustomLocation),
+	}
+}
+
+func parseExtendedLocationTypes(input string) (*ExtendedLocationTypes, error) {
+	vals := map[string]ExtendedLocationTypes{
+		"customlocation": ExtendedLocationTypesCustomLocation,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ExtendedLocationTypes(input)
+	return &out, nil
+}
+
+type IPAllocationMethodEnum string
+
+const (
+	IPAllocationMethodEnumDynamic IPAllocationMethodEnum = "Dynamic"
+	IPAllocationMethodEnumStatic  IPAllocationMethodEnum = "Static"
+)
+
+func PossibleValuesForIPAllocationMethodEnum() []string {
+	return []string{
+		string(IPAllocationMethodEnumDynamic),
+		string(IPAllocationMethodEnumStatic),
+	}
+}
+
+func parseIPAllocationMethodEnum(input string) (*IPAllocationMethodEnum, error) {
+	vals := map[string]IPAllocationMethodEnum{
+		"dynamic": IPAllocationMethodEnumDynamic,
+		"static":  IPAllocationMethodEnumStatic,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := IPAllocationMethodEnum(input)
+	return &out, nil
+}
+
+type IPPoolTypeEnum string
+
+const (
+	IPPoolTypeEnumVippool IPPoolTypeEnum = "vippool"
+	IPPoolTypeEnumVm      IPPoolTypeEnum = "vm"
+)
+
+func PossibleValuesForIPPoolTypeEnum() []string {
+	return []string{
+		string(IPPoolTypeEnumVippool),
+		string(IPPoolTypeEnumVm),
+	}
+}
+
+func parseIPPoolTypeEnum(input string) (*IPPoolTypeEnum, error) {
+	vals := map[string]IPPoolTypeEnum{
+		"vippool": IPPoolTypeEnumVippool,
+		"vm":      IPPoolTypeEnumVm,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := IPPoolTypeEnum(input)
+	return &out, nil
+}
+
+type ProvisioningStateEnum string
+
+const (
+	ProvisioningStateEnumAccepted     ProvisioningStateEnum = "Accepted"
+	ProvisioningStateEnumCanceled     ProvisioningStateEnum = "Canceled"
+	ProvisioningStateEnumDeleting     ProvisioningStateEnum = "Deleting"
+	ProvisioningStateEnumFailed       ProvisioningStateEnum = "Failed"
+	ProvisioningStateEnumInProgress   ProvisioningStateEnum = "InProgress"
+	ProvisioningStateEnumProvisioning ProvisioningStateEnum = "Provisioning"
+	ProvisioningStateEnumSucceeded    ProvisioningStateEnum = "Succeeded"
+)
+
+func PossibleValuesForProvisioningStateEnum() []string {
+	return []string{
+		string(ProvisioningStateEnumAccepted),
+		string(ProvisioningStateEnumCanceled),
+		string(ProvisioningStateEnumDeleting),
+		string(ProvisioningStateEnumFailed),
+		string(ProvisioningStateEnumInProgress),
+		string(ProvisioningStateEnumProvisioning),
+		string(ProvisioningStateEnumSucceeded),
+	}
+}
+
+func parseProvisioningStateEnum(input string) (*ProvisioningStateEnum, error) {
+	vals := map[string]ProvisioningStateEnum{
+		"accepted":     ProvisioningStateEnumAccepted,
+		"canceled":     ProvisioningStateEnumCanceled,
+		"deleting":     ProvisioningStateEnumDeleting,
+		"failed":       ProvisioningStateEnumFailed,
+		"inprogress":   ProvisioningStateEnumInProgress,
+		"provisioning": ProvisioningStateEnumProvisioning,
+		"succeeded":    ProvisioningStateEnumSucceeded,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ProvisioningStateEnum(input)
+	return &out, nil
+}
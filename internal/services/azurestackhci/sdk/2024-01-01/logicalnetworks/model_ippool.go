@@ -0,0 +1,8 @@
+package logicalnetworks
+
+type IPPool struct {
+	End        *string         `json:"end,omitempty"`
+	IPPoolType *IPPoolTypeEnum `json:"ipPoolType,omitempty"`
+	Name       *string         `json:"name,omitempty"`
+	Start      *string         `json:"start,omitempty"`
+}
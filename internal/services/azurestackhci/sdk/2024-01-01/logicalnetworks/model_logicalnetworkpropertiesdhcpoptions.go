@@ -0,0 +1,5 @@
+package logicalnetworks
+
+type LogicalNetworkPropertiesDhcpOptions struct {
+	DnsServers *[]string `json:"dnsServers,omitempty"`
+}
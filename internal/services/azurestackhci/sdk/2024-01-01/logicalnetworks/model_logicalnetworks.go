@@ -0,0 +1,12 @@
+package logicalnetworks
+
+type LogicalNetworks struct {
+	ExtendedLocation *ExtendedLocation         `json:"extendedLocation,omitempty"`
+	Id               *string                   `json:"id,omitempty"`
+	Location         string                    `json:"location"`
+	Name             *string                   `json:"name,omitempty"`
+	Properties       *LogicalNetworkProperties `json:"properties,omitempty"`
+	SystemData       *SystemData               `json:"systemData,omitempty"`
+	Tags             *map[string]string        `json:"tags,omitempty"`
+	Type             *string                   `json:"type,omitempty"`
+}
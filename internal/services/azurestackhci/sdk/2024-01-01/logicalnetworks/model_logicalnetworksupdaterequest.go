@@ -0,0 +1,5 @@
+package logicalnetworks
+
+type LogicalNetworksUpdateRequest struct {
+	Tags *map[string]string `json:"tags,omitempty"`
+}
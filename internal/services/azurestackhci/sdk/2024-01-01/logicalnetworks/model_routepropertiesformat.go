@@ -0,0 +1,6 @@
+package logicalnetworks
+
+type RoutePropertiesFormat struct {
+	AddressPrefix    *string `json:"addressPrefix,omitempty"`
+	NextHopIPAddress *string `json:"nextHopIpAddress,omitempty"`
+}
@@ -0,0 +1,8 @@
+package logicalnetworks
+
+type RouteTable struct {
+	Etag       *string                     `json:"etag,omitempty"`
+	Name       *string                     `json:"name,omitempty"`
+	Properties *RouteTablePropertiesFormat `json:"properties,omitempty"`
+	Type       *string                     `json:"type,omitempty"`
+}
@@ -0,0 +1,5 @@
+package marketplacegalleryimages
+
+type GalleryImageVersion struct {
+	Name *string `json:"name,omitempty"`
+}
@@ -0,0 +1,10 @@
+package marketplacegalleryimages
+
+type MarketplaceGalleryImageProperties struct {
+	ContainerId       *string                 `json:"containerId,omitempty"`
+	HyperVGeneration  *HyperVGeneration       `json:"hyperVGeneration,omitempty"`
+	Identifier        *GalleryImageIdentifier `json:"identifier,omitempty"`
+	OsType            OperatingSystemTypes    `json:"osType"`
+	ProvisioningState *ProvisioningStateEnum  `json:"provisioningState,omitempty"`
+	Version           *GalleryImageVersion    `json:"version,omitempty"`
+}
@@ -0,0 +1,5 @@
+package networkinterfaces
+
+type IPConfigurationPropertiesSubnet struct {
+	Id *string `json:"id,omitempty"`
+}
@@ -0,0 +1,8 @@
+package networkinterfaces
+
+type NetworkInterfaceProperties struct {
+	DnsSettings       *InterfaceDNSSettings  `json:"dnsSettings,omitempty"`
+	IPConfigurations  *[]IPConfiguration     `json:"ipConfigurations,omitempty"`
+	MacAddress        *string                `json:"macAddress,omitempty"`
+	ProvisioningState *ProvisioningStateEnum `json:"provisioningState,omitempty"`
+}
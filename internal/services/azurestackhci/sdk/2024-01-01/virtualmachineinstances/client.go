@@ -0,0 +1,15 @@
+package virtualmachineinstances
+
+import "github.com/Azure/go-autorest/autorest"
+
+type VirtualMachineInstancesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewVirtualMachineInstancesClientWithBaseURI(endpoint string) VirtualMachineInstancesClient {
+	return VirtualMachineInstancesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,99 @@
+package virtualmachineinstances
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = VirtualMachineInstanceId{}
+
+// VirtualMachineInstanceId is a struct representing the Resource ID for a Virtual Machine Instance
+type VirtualMachineInstanceId struct {
+	Scope string
+}
+
+// NewVirtualMachineInstanceID returns a new VirtualMachineInstanceId struct
+func NewVirtualMachineInstanceID(scope string) VirtualMachineInstanceId {
+	return VirtualMachineInstanceId{
+		Scope: scope,
+	}
+}
+
+// ParseVirtualMachineInstanceID parses 'input' into a VirtualMachineInstanceId
+func ParseVirtualMachineInstanceID(input string) (*VirtualMachineInstanceId, error) {
+	parser := resourceids.NewParserFromResourceIdType(VirtualMachineInstanceId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := VirtualMachineInstanceId{}
+
+	if id.Scope, ok = parsed.Parsed["scope"]; !ok {
+		return nil, fmt.Errorf("the segment 'scope' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseVirtualMachineInstanceIDInsensitively parses 'input' case-insensitively into a VirtualMachineInstanceId
+// note: this method should only be used for API response data and not user input
+func ParseVirtualMachineInstanceIDInsensitively(input string) (*VirtualMachineInstanceId, error) {
+	parser := resourceids.NewParserFromResourceIdType(VirtualMachineInstanceId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := VirtualMachineInstanceId{}
+
+	if id.Scope, ok = parsed.Parsed["scope"]; !ok {
+		return nil, fmt.Errorf("the segment 'scope' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateVirtualMachineInstanceID checks that 'input' can be parsed as a Virtual Machine Instance ID
+func ValidateVirtualMachineInstanceID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseVirtualMachineInstanceID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Virtual Machine Instance ID
+func (id VirtualMachineInstanceId) ID() string {
+	fmtString := "/%s/providers/Microsoft.AzureStackHCI/virtualMachineInstances/default"
+	return fmt.Sprintf(fmtString, strings.TrimPrefix(id.Scope, "/"))
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Virtual Machine Instance ID
+func (id VirtualMachineInstanceId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.ScopeSegment("scope", "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/some-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftAzureStackHCI", "Microsoft.AzureStackHCI", "Microsoft.AzureStackHCI"),
+		resourceids.StaticSegment("staticVirtualMachineInstances", "virtualMachineInstances", "virtualMachineInstances"),
+		resourceids.StaticSegment("staticDefault", "default", "default"),
+	}
+}
+
+// String returns a human-readable description of this Virtual Machine Instance ID
+func (id VirtualMachineInstanceId) String() string {
+	components := []string{
+		fmt.Sprintf("Scope: %q", id.Scope),
+	}
+	return fmt.Sprintf("Virtual Machine Instance (%s)", strings.Join(components, "\n"))
+}
@@ -0,0 +1,7 @@
+package virtualmachineinstances
+
+type HardwareProfileUpdate struct {
+	MemoryMB   *int64  `json:"memoryMB,omitempty"`
+	Processors *int64  `json:"processors,omitempty"`
+	VMSize     *string `json:"vmSize,omitempty"`
+}
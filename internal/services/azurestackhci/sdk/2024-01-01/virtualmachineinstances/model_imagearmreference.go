@@ -0,0 +1,5 @@
+package virtualmachineinstances
+
+type ImageArmReference struct {
+	Id *string `json:"id,omitempty"`
+}
@@ -0,0 +1,5 @@
+package virtualmachineinstances
+
+type NetworkProfileUpdate struct {
+	NetworkInterfaces *[]NetworkProfileUpdateNetworkInterfacesItem `json:"networkInterfaces,omitempty"`
+}
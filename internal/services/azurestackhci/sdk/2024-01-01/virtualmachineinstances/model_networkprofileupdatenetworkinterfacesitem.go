@@ -0,0 +1,5 @@
+package virtualmachineinstances
+
+type NetworkProfileUpdateNetworkInterfacesItem struct {
+	Id *string `json:"id,omitempty"`
+}
@@ -0,0 +1,5 @@
+package virtualmachineinstances
+
+type StorageProfileUpdate struct {
+	DataDisks *[]StorageProfileUpdateDataDisksItem `json:"dataDisks,omitempty"`
+}
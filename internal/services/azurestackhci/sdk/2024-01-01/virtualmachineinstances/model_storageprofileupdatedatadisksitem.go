@@ -0,0 +1,5 @@
+package virtualmachineinstances
+
+type StorageProfileUpdateDataDisksItem struct {
+	Id *string `json:"id,omitempty"`
+}
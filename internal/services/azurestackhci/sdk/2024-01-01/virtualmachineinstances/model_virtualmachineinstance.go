@@ -0,0 +1,10 @@
+package virtualmachineinstances
+
+type VirtualMachineInstance struct {
+	ExtendedLocation *ExtendedLocation                 `json:"extendedLocation,omitempty"`
+	Id               *string                           `json:"id,omitempty"`
+	Name             *string                           `json:"name,omitempty"`
+	Properties       *VirtualMachineInstanceProperties `json:"properties,omitempty"`
+	SystemData       *SystemData                       `json:"systemData,omitempty"`
+	Type             *string                           `json:"type,omitempty"`
+}
@@ -0,0 +1,11 @@
+package virtualmachineinstances
+
+type VirtualMachineInstanceProperties struct {
+	HardwareProfile   *VirtualMachineInstancePropertiesHardwareProfile `json:"hardwareProfile,omitempty"`
+	NetworkProfile    *VirtualMachineInstancePropertiesNetworkProfile  `json:"networkProfile,omitempty"`
+	OsProfile         *VirtualMachineInstancePropertiesOsProfile       `json:"osProfile,omitempty"`
+	ProvisioningState *ProvisioningStateEnum                           `json:"provisioningState,omitempty"`
+	SecurityProfile   *VirtualMachineInstancePropertiesSecurityProfile `json:"securityProfile,omitempty"`
+	StorageProfile    *VirtualMachineInstancePropertiesStorageProfile  `json:"storageProfile,omitempty"`
+	VMId              *string                                          `json:"vmId,omitempty"`
+}
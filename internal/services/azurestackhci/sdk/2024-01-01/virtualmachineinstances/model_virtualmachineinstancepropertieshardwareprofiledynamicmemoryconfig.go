@@ -0,0 +1,7 @@
+package virtualmachineinstances
+
+type VirtualMachineInstancePropertiesHardwareProfileDynamicMemoryConfig struct {
+	MaximumMemoryMB    *int64 `json:"maximumMemoryMB,omitempty"`
+	MinimumMemoryMB    *int64 `json:"minimumMemoryMB,omitempty"`
+	TargetMemoryBuffer *int64 `json:"targetMemoryBuffer,omitempty"`
+}
@@ -0,0 +1,5 @@
+package virtualmachineinstances
+
+type VirtualMachineInstancePropertiesNetworkProfile struct {
+	NetworkInterfaces *[]VirtualMachineInstancePropertiesNetworkProfileNetworkInterfacesItem `json:"networkInterfaces,omitempty"`
+}
@@ -0,0 +1,5 @@
+package virtualmachineinstances
+
+type VirtualMachineInstancePropertiesNetworkProfileNetworkInterfacesItem struct {
+	Id *string `json:"id,omitempty"`
+}
@@ -0,0 +1,9 @@
+package virtualmachineinstances
+
+type VirtualMachineInstancePropertiesOsProfile struct {
+	AdminPassword        *string                                                        `json:"adminPassword,omitempty"`
+	AdminUsername        *string                                                        `json:"adminUsername,omitempty"`
+	ComputerName         *string                                                        `json:"computerName,omitempty"`
+	LinuxConfiguration   *VirtualMachineInstancePropertiesOsProfileLinuxConfiguration   `json:"linuxConfiguration,omitempty"`
+	WindowsConfiguration *VirtualMachineInstancePropertiesOsProfileWindowsConfiguration `json:"windowsConfiguration,omitempty"`
+}
@@ -0,0 +1,7 @@
+package virtualmachineinstances
+
+type VirtualMachineInstancePropertiesOsProfileLinuxConfiguration struct {
+	DisablePasswordAuthentication *bool `json:"disablePasswordAuthentication,omitempty"`
+	ProvisionVMAgent              *bool `json:"provisionVMAgent,omitempty"`
+	ProvisionVMConfigAgent        *bool `json:"provisionVMConfigAgent,omitempty"`
+}
@@ -0,0 +1,8 @@
+package virtualmachineinstances
+
+type VirtualMachineInstancePropertiesOsProfileWindowsConfiguration struct {
+	EnableAutomaticUpdates *bool   `json:"enableAutomaticUpdates,omitempty"`
+	ProvisionVMAgent       *bool   `json:"provisionVMAgent,omitempty"`
+	ProvisionVMConfigAgent *bool   `json:"provisionVMConfigAgent,omitempty"`
+	TimeZone               *string `json:"timeZone,omitempty"`
+}
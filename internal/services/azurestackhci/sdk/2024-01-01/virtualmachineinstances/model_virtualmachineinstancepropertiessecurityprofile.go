@@ -0,0 +1,7 @@
+package virtualmachineinstances
+
+type VirtualMachineInstancePropertiesSecurityProfile struct {
+	EnableTPM    *bool                                                        `json:"enableTPM,omitempty"`
+	SecurityType *SecurityTypes                                               `json:"securityType,omitempty"`
+	UefiSettings *VirtualMachineInstancePropertiesSecurityProfileUefiSettings `json:"uefiSettings,omitempty"`
+}
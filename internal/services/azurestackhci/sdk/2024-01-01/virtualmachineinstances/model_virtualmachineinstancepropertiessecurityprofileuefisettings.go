@@ -0,0 +1,5 @@
+package virtualmachineinstances
+
+type VirtualMachineInstancePropertiesSecurityProfileUefiSettings struct {
+	SecureBootEnabled *bool `json:"secureBootEnabled,omitempty"`
+}
@@ -0,0 +1,8 @@
+package virtualmachineinstances
+
+type VirtualMachineInstancePropertiesStorageProfile struct {
+	DataDisks             *[]VirtualMachineInstancePropertiesStorageProfileDataDisksItem `json:"dataDisks,omitempty"`
+	ImageReference        *ImageArmReference                                             `json:"imageReference,omitempty"`
+	OsDisk                *VirtualMachineInstancePropertiesStorageProfileOsDisk          `json:"osDisk,omitempty"`
+	VMConfigStoragePathId *string                                                        `json:"vmConfigStoragePathId,omitempty"`
+}
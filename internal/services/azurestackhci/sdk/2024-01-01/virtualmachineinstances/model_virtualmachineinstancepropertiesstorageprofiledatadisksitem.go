@@ -0,0 +1,5 @@
+package virtualmachineinstances
+
+type VirtualMachineInstancePropertiesStorageProfileDataDisksItem struct {
+	Id *string `json:"id,omitempty"`
+}
@@ -0,0 +1,7 @@
+package virtualmachineinstances
+
+type VirtualMachineInstanceUpdateProperties struct {
+	HardwareProfile *HardwareProfileUpdate `json:"hardwareProfile,omitempty"`
+	NetworkProfile  *NetworkProfileUpdate  `json:"networkProfile,omitempty"`
+	StorageProfile  *StorageProfileUpdate  `json:"storageProfile,omitempty"`
+}
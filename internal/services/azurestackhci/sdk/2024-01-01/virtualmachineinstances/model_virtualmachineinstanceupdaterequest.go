@@ -0,0 +1,5 @@
+package virtualmachineinstances
+
+type VirtualMachineInstanceUpdateRequest struct {
+	Properties *VirtualMachineInstanceUpdateProperties `json:"properties,omitempty"`
+}
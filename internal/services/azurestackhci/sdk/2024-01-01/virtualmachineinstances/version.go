@@ -0,0 +1,9 @@
+package virtualmachineinstances
+
+import "fmt"
+
+const defaultApiVersion = "2024-01-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/virtualmachineinstances/%s", defaultApiVersion)
+}
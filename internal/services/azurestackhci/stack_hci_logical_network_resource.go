@@ -0,0 +1,485 @@
+package azurestackhci
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/azurestackhci/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/azurestackhci/sdk/2024-01-01/logicalnetworks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/azurestackhci/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.ResourceWithUpdate = StackHCILogicalNetworkResource{}
+
+type StackHCILogicalNetworkResource struct{}
+
+type StackHCILogicalNetworkResourceModel struct {
+	Name              string                         `tfschema:"name"`
+	ResourceGroupName string                         `tfschema:"resource_group_name"`
+	Location          string                         `tfschema:"location"`
+	CustomLocationId  string                         `tfschema:"custom_location_id"`
+	VirtualSwitchName string                         `tfschema:"virtual_switch_name"`
+	DNSServers        []string                       `tfschema:"dns_servers"`
+	Subnet            []StackHCILogicalNetworkSubnet `tfschema:"subnet"`
+	Tags              map[string]string              `tfschema:"tags"`
+}
+
+type StackHCILogicalNetworkSubnet struct {
+	IPAllocationMethod string                         `tfschema:"ip_allocation_method"`
+	AddressPrefix      string                         `tfschema:"address_prefix"`
+	IPPool             []StackHCILogicalNetworkIPPool `tfschema:"ip_pool"`
+	Route              []StackHCILogicalNetworkRoute  `tfschema:"route"`
+	VlanId             int                            `tfschema:"vlan_id"`
+}
+
+type StackHCILogicalNetworkIPPool struct {
+	Start string `tfschema:"start"`
+	End   string `tfschema:"end"`
+}
+
+type StackHCILogicalNetworkRoute struct {
+	Name             string `tfschema:"name"`
+	AddressPrefix    string `tfschema:"address_prefix"`
+	NextHopIPAddress string `tfschema:"next_hop_ip_address"`
+}
+
+func (r StackHCILogicalNetworkResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[a-zA-Z0-9][\-\.\_a-zA-Z0-9]{0,62}[a-zA-Z0-9]$`),
+				"`name` must be between 2 and 64 characters, can only contain alphanumeric characters, hyphens, periods and underscores, and must start and end with an alphanumeric character",
+			),
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": location.Schema(),
+
+		"custom_location_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.CustomLocationID,
+		},
+
+		"virtual_switch_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"dns_servers": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.IsIPv4Address,
+			},
+		},
+
+		"subnet": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			ForceNew: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"ip_allocation_method": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringInSlice(logicalnetworks.PossibleValuesForIPAllocationMethodEnum(), false),
+					},
+
+					"address_prefix": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.IsCIDR,
+					},
+
+					"ip_pool": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						ForceNew: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"start": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ForceNew:     true,
+									ValidateFunc: validation.IsIPv4Address,
+								},
+
+								"end": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ForceNew:     true,
+									ValidateFunc: validation.IsIPv4Address,
+								},
+							},
+						},
+					},
+
+					"route": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						ForceNew: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"name": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ForceNew:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"address_prefix": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ForceNew:     true,
+									ValidateFunc: validation.IsCIDR,
+								},
+
+								"next_hop_ip_address": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ForceNew:     true,
+									ValidateFunc: validation.IsIPv4Address,
+								},
+							},
+						},
+					},
+
+					"vlan_id": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.IntBetween(0, 4094),
+					},
+				},
+			},
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r StackHCILogicalNetworkResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r StackHCILogicalNetworkResource) ModelObject() interface{} {
+	return &StackHCILogicalNetworkResourceModel{}
+}
+
+func (r StackHCILogicalNetworkResource) ResourceType() string {
+	return "azurerm_stack_hci_logical_network"
+}
+
+func (r StackHCILogicalNetworkResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return logicalnetworks.ValidateLogicalNetworkID
+}
+
+func (r StackHCILogicalNetworkResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AzureStackHCI.LogicalNetworksClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model StackHCILogicalNetworkResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := logicalnetworks.NewLogicalNetworkID(subscriptionId, model.ResourceGroupName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			extendedLocationType := logicalnetworks.ExtendedLocationTypesCustomLocation
+			payload := logicalnetworks.LogicalNetworks{
+				ExtendedLocation: &logicalnetworks.ExtendedLocation{
+					Name: utils.String(model.CustomLocationId),
+					Type: &extendedLocationType,
+				},
+				Location: location.Normalize(model.Location),
+				Properties: &logicalnetworks.LogicalNetworkProperties{
+					Subnets:      expandStackHCILogicalNetworkSubnet(model.Subnet),
+					VMSwitchName: utils.String(model.VirtualSwitchName),
+				},
+				Tags: &model.Tags,
+			}
+
+			if len(model.DNSServers) > 0 {
+				dnsServers := model.DNSServers
+				payload.Properties.DhcpOptions = &logicalnetworks.LogicalNetworkPropertiesDhcpOptions{
+					DnsServers: &dnsServers,
+				}
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r StackHCILogicalNetworkResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AzureStackHCI.LogicalNetworksClient
+
+			id, err := logicalnetworks.ParseLogicalNetworkID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := StackHCILogicalNetworkResourceModel{
+				Name:              id.LogicalNetworkName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+
+				if model.ExtendedLocation != nil && model.ExtendedLocation.Name != nil {
+					customLocationId, err := parse.CustomLocationIDInsensitively(*model.ExtendedLocation.Name)
+					if err != nil {
+						return err
+					}
+					state.CustomLocationId = customLocationId.ID()
+				}
+
+				if props := model.Properties; props != nil {
+					state.VirtualSwitchName = utils.NormalizeNilableString(props.VMSwitchName)
+					state.Subnet = flattenStackHCILogicalNetworkSubnet(props.Subnets)
+
+					if props.DhcpOptions != nil && props.DhcpOptions.DnsServers != nil {
+						state.DNSServers = *props.DhcpOptions.DnsServers
+					}
+				}
+
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r StackHCILogicalNetworkResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AzureStackHCI.LogicalNetworksClient
+
+			id, err := logicalnetworks.ParseLogicalNetworkID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model StackHCILogicalNetworkResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			payload := logicalnetworks.LogicalNetworksUpdateRequest{}
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = &model.Tags
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r StackHCILogicalNetworkResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AzureStackHCI.LogicalNetworksClient
+
+			id, err := logicalnetworks.ParseLogicalNetworkID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandStackHCILogicalNetworkSubnet(input []StackHCILogicalNetworkSubnet) *[]logicalnetworks.Subnet {
+	output := make([]logicalnetworks.Subnet, 0)
+	for _, v := range input {
+		ipAllocationMethod := logicalnetworks.IPAllocationMethodEnum(v.IPAllocationMethod)
+		props := logicalnetworks.SubnetPropertiesFormat{
+			IPAllocationMethod: &ipAllocationMethod,
+			IPPools:            expandStackHCILogicalNetworkIPPool(v.IPPool),
+		}
+
+		if v.AddressPrefix != "" {
+			props.AddressPrefix = utils.String(v.AddressPrefix)
+		}
+
+		if v.VlanId != 0 {
+			props.Vlan = utils.Int64(int64(v.VlanId))
+		}
+
+		if len(v.Route) > 0 {
+			props.RouteTable = &logicalnetworks.RouteTable{
+				Properties: &logicalnetworks.RouteTablePropertiesFormat{
+					Routes: expandStackHCILogicalNetworkRoute(v.Route),
+				},
+			}
+		}
+
+		output = append(output, logicalnetworks.Subnet{
+			Properties: &props,
+		})
+	}
+
+	return &output
+}
+
+func flattenStackHCILogicalNetworkSubnet(input *[]logicalnetworks.Subnet) []StackHCILogicalNetworkSubnet {
+	output := make([]StackHCILogicalNetworkSubnet, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		props := v.Properties
+		if props == nil {
+			continue
+		}
+
+		subnet := StackHCILogicalNetworkSubnet{
+			AddressPrefix: utils.NormalizeNilableString(props.AddressPrefix),
+			IPPool:        flattenStackHCILogicalNetworkIPPool(props.IPPools),
+			Route:         []StackHCILogicalNetworkRoute{},
+		}
+
+		if props.IPAllocationMethod != nil {
+			subnet.IPAllocationMethod = string(*props.IPAllocationMethod)
+		}
+
+		if props.Vlan != nil {
+			subnet.VlanId = int(*props.Vlan)
+		}
+
+		if props.RouteTable != nil && props.RouteTable.Properties != nil {
+			subnet.Route = flattenStackHCILogicalNetworkRoute(props.RouteTable.Properties.Routes)
+		}
+
+		output = append(output, subnet)
+	}
+
+	return output
+}
+
+func expandStackHCILogicalNetworkIPPool(input []StackHCILogicalNetworkIPPool) *[]logicalnetworks.IPPool {
+	output := make([]logicalnetworks.IPPool, 0)
+	for _, v := range input {
+		output = append(output, logicalnetworks.IPPool{
+			Start: utils.String(v.Start),
+			End:   utils.String(v.End),
+		})
+	}
+
+	return &output
+}
+
+func flattenStackHCILogicalNetworkIPPool(input *[]logicalnetworks.IPPool) []StackHCILogicalNetworkIPPool {
+	output := make([]StackHCILogicalNetworkIPPool, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		output = append(output, StackHCILogicalNetworkIPPool{
+			Start: utils.NormalizeNilableString(v.Start),
+			End:   utils.NormalizeNilableString(v.End),
+		})
+	}
+
+	return output
+}
+
+func expandStackHCILogicalNetworkRoute(input []StackHCILogicalNetworkRoute) *[]logicalnetworks.Route {
+	output := make([]logicalnetworks.Route, 0)
+	for _, v := range input {
+		output = append(output, logicalnetworks.Route{
+			Name: utils.String(v.Name),
+			Properties: &logicalnetworks.RoutePropertiesFormat{
+				AddressPrefix:    utils.String(v.AddressPrefix),
+				NextHopIPAddress: utils.String(v.NextHopIPAddress),
+			},
+		})
+	}
+
+	return &output
+}
+
+func flattenStackHCILogicalNetworkRoute(input *[]logicalnetworks.Route) []StackHCILogicalNetworkRoute {
+	output := make([]StackHCILogicalNetworkRoute, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		route := StackHCILogicalNetworkRoute{
+			Name: utils.NormalizeNilableString(v.Name),
+		}
+		if props := v.Properties; props != nil {
+			route.AddressPrefix = utils.NormalizeNilableString(props.AddressPrefix)
+			route.NextHopIPAddress = utils.NormalizeNilableString(props.NextHopIPAddress)
+		}
+		output = append(output, route)
+	}
+
+	return output
+}
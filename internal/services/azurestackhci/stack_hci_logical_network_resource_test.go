@@ -0,0 +1,183 @@
+package azurestackhci_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/azurestackhci/sdk/2024-01-01/logicalnetworks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type StackHCILogicalNetworkResource struct{}
+
+// the Arc VM Management resources are deployed onto an existing (physical) Azure Stack HCI Cluster via the Custom
+// Location of its Arc Resource Bridge - as such these tests require an existing Custom Location to be specified
+func preCheckStackHCICustomLocation(t *testing.T) {
+	if os.Getenv("ARM_TEST_STACK_HCI_CUSTOM_LOCATION_ID") == "" {
+		t.Skip("Skipping as ARM_TEST_STACK_HCI_CUSTOM_LOCATION_ID is not specified")
+	}
+}
+
+func TestAccStackHCILogicalNetwork_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_stack_hci_logical_network", "test")
+	r := StackHCILogicalNetworkResource{}
+	preCheckStackHCICustomLocation(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStackHCILogicalNetwork_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_stack_hci_logical_network", "test")
+	r := StackHCILogicalNetworkResource{}
+	preCheckStackHCICustomLocation(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccStackHCILogicalNetwork_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_stack_hci_logical_network", "test")
+	r := StackHCILogicalNetworkResource{}
+	preCheckStackHCICustomLocation(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data, "test"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data, "updated"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r StackHCILogicalNetworkResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := logicalnetworks.ParseLogicalNetworkID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.AzureStackHCI.LogicalNetworksClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r StackHCILogicalNetworkResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_stack_hci_logical_network" "test" {
+  name                = "acctest-ln-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  custom_location_id  = %q
+  virtual_switch_name = "ConvergedSwitch(managementcompute)"
+
+  subnet {
+    ip_allocation_method = "Dynamic"
+  }
+}
+`, r.template(data), data.RandomInteger, os.Getenv("ARM_TEST_STACK_HCI_CUSTOM_LOCATION_ID"))
+}
+
+func (r StackHCILogicalNetworkResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_stack_hci_logical_network" "import" {
+  name                = azurerm_stack_hci_logical_network.test.name
+  resource_group_name = azurerm_stack_hci_logical_network.test.resource_group_name
+  location            = azurerm_stack_hci_logical_network.test.location
+  custom_location_id  = azurerm_stack_hci_logical_network.test.custom_location_id
+  virtual_switch_name = azurerm_stack_hci_logical_network.test.virtual_switch_name
+
+  subnet {
+    ip_allocation_method = "Dynamic"
+  }
+}
+`, r.basic(data))
+}
+
+func (r StackHCILogicalNetworkResource) complete(data acceptance.TestData, tag string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_stack_hci_logical_network" "test" {
+  name                = "acctest-ln-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  custom_location_id  = %q
+  virtual_switch_name = "ConvergedSwitch(managementcompute)"
+  dns_servers         = ["10.0.0.7", "10.0.0.8"]
+
+  subnet {
+    ip_allocation_method = "Static"
+    address_prefix       = "10.0.0.0/24"
+    vlan_id              = 123
+
+    ip_pool {
+      start = "10.0.0.218"
+      end   = "10.0.0.230"
+    }
+
+    route {
+      name                = "test-route"
+      address_prefix      = "0.0.0.0/0"
+      next_hop_ip_address = "10.0.0.1"
+    }
+  }
+
+  tags = {
+    environment = %q
+  }
+}
+`, r.template(data), data.RandomInteger, os.Getenv("ARM_TEST_STACK_HCI_CUSTOM_LOCATION_ID"), tag)
+}
+
+func (r StackHCILogicalNetworkResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-hci-ln-%d"
+  location = %q
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
@@ -0,0 +1,342 @@
+package azurestackhci
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/azurestackhci/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/azurestackhci/sdk/2024-01-01/marketplacegalleryimages"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/azurestackhci/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.ResourceWithUpdate = StackHCIMarketplaceGalleryImageResource{}
+
+type StackHCIMarketplaceGalleryImageResource struct{}
+
+type StackHCIMarketplaceGalleryImageResourceModel struct {
+	Name               string                                      `tfschema:"name"`
+	ResourceGroupName  string                                      `tfschema:"resource_group_name"`
+	Location           string                                      `tfschema:"location"`
+	CustomLocationId   string                                      `tfschema:"custom_location_id"`
+	HyperVGeneration   string                                      `tfschema:"hyperv_generation"`
+	OsType             string                                      `tfschema:"os_type"`
+	Identifier         []StackHCIMarketplaceGalleryImageIdentifier `tfschema:"identifier"`
+	Version            string                                      `tfschema:"version"`
+	StorageContainerId string                                      `tfschema:"storage_container_id"`
+	Tags               map[string]string                           `tfschema:"tags"`
+}
+
+type StackHCIMarketplaceGalleryImageIdentifier struct {
+	Publisher string `tfschema:"publisher"`
+	Offer     string `tfschema:"offer"`
+	Sku       string `tfschema:"sku"`
+}
+
+func (r StackHCIMarketplaceGalleryImageResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[a-zA-Z0-9][\-\.\_a-zA-Z0-9]{0,78}[a-zA-Z0-9]$`),
+				"`name` must be between 2 and 80 characters, can only contain alphanumeric characters, hyphens, periods and underscores, and must start and end with an alphanumeric character",
+			),
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": location.Schema(),
+
+		"custom_location_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.CustomLocationID,
+		},
+
+		"hyperv_generation": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(marketplacegalleryimages.PossibleValuesForHyperVGeneration(), false),
+		},
+
+		"os_type": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(marketplacegalleryimages.PossibleValuesForOperatingSystemTypes(), false),
+		},
+
+		"identifier": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			ForceNew: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"publisher": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"offer": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"sku": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"version": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"storage_container_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.StorageContainerID,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r StackHCIMarketplaceGalleryImageResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r StackHCIMarketplaceGalleryImageResource) ModelObject() interface{} {
+	return &StackHCIMarketplaceGalleryImageResourceModel{}
+}
+
+func (r StackHCIMarketplaceGalleryImageResource) ResourceType() string {
+	return "azurerm_stack_hci_marketplace_gallery_image"
+}
+
+func (r StackHCIMarketplaceGalleryImageResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return marketplacegalleryimages.ValidateMarketplaceGalleryImageID
+}
+
+func (r StackHCIMarketplaceGalleryImageResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		// downloading the image onto the cluster can take a considerable amount of time
+		Timeout: 2 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AzureStackHCI.MarketplaceGalleryImagesClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model StackHCIMarketplaceGalleryImageResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := marketplacegalleryimages.NewMarketplaceGalleryImageID(subscriptionId, model.ResourceGroupName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			extendedLocationType := marketplacegalleryimages.ExtendedLocationTypesCustomLocation
+			hyperVGeneration := marketplacegalleryimages.HyperVGeneration(model.HyperVGeneration)
+			payload := marketplacegalleryimages.MarketplaceGalleryImages{
+				ExtendedLocation: &marketplacegalleryimages.ExtendedLocation{
+					Name: utils.String(model.CustomLocationId),
+					Type: &extendedLocationType,
+				},
+				Location: location.Normalize(model.Location),
+				Properties: &marketplacegalleryimages.MarketplaceGalleryImageProperties{
+					HyperVGeneration: &hyperVGeneration,
+					Identifier:       expandStackHCIMarketplaceGalleryImageIdentifier(model.Identifier),
+					OsType:           marketplacegalleryimages.OperatingSystemTypes(model.OsType),
+					Version: &marketplacegalleryimages.GalleryImageVersion{
+						Name: utils.String(model.Version),
+					},
+				},
+				Tags: &model.Tags,
+			}
+
+			if model.StorageContainerId != "" {
+				payload.Properties.ContainerId = utils.String(model.StorageContainerId)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r StackHCIMarketplaceGalleryImageResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AzureStackHCI.MarketplaceGalleryImagesClient
+
+			id, err := marketplacegalleryimages.ParseMarketplaceGalleryImageID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := StackHCIMarketplaceGalleryImageResourceModel{
+				Name:              id.MarketplaceGalleryImageName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+
+				if model.ExtendedLocation != nil && model.ExtendedLocation.Name != nil {
+					customLocationId, err := parse.CustomLocationIDInsensitively(*model.ExtendedLocation.Name)
+					if err != nil {
+						return err
+					}
+					state.CustomLocationId = customLocationId.ID()
+				}
+
+				if props := model.Properties; props != nil {
+					state.OsType = string(props.OsType)
+					state.Identifier = flattenStackHCIMarketplaceGalleryImageIdentifier(props.Identifier)
+
+					if props.HyperVGeneration != nil {
+						state.HyperVGeneration = string(*props.HyperVGeneration)
+					}
+
+					if props.Version != nil {
+						state.Version = utils.NormalizeNilableString(props.Version.Name)
+					}
+
+					if props.ContainerId != nil {
+						storageContainerId, err := parse.StorageContainerIDInsensitively(*props.ContainerId)
+						if err != nil {
+							return err
+						}
+						state.StorageContainerId = storageContainerId.ID()
+					}
+				}
+
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r StackHCIMarketplaceGalleryImageResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AzureStackHCI.MarketplaceGalleryImagesClient
+
+			id, err := marketplacegalleryimages.ParseMarketplaceGalleryImageID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model StackHCIMarketplaceGalleryImageResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			payload := marketplacegalleryimages.MarketplaceGalleryImagesUpdateRequest{}
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = &model.Tags
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r StackHCIMarketplaceGalleryImageResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AzureStackHCI.MarketplaceGalleryImagesClient
+
+			id, err := marketplacegalleryimages.ParseMarketplaceGalleryImageID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandStackHCIMarketplaceGalleryImageIdentifier(input []StackHCIMarketplaceGalleryImageIdentifier) *marketplacegalleryimages.GalleryImageIdentifier {
+	if len(input) == 0 {
+		return nil
+	}
+
+	v := input[0]
+	return &marketplacegalleryimages.GalleryImageIdentifier{
+		Publisher: v.Publisher,
+		Offer:     v.Offer,
+		Sku:       v.Sku,
+	}
+}
+
+func flattenStackHCIMarketplaceGalleryImageIdentifier(input *marketplacegalleryimages.GalleryImageIdentifier) []StackHCIMarketplaceGalleryImageIdentifier {
+	if input == nil {
+		return []StackHCIMarketplaceGalleryImageIdentifier{}
+	}
+
+	return []StackHCIMarketplaceGalleryImageIdentifier{
+		{
+			Publisher: input.Publisher,
+			Offer:     input.Offer,
+			Sku:       input.Sku,
+		},
+	}
+}
@@ -0,0 +1,834 @@
+package azurestackhci
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/azurestackhci/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/azurestackhci/sdk/2024-01-01/networkinterfaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/azurestackhci/sdk/2024-01-01/virtualmachineinstances"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/azurestackhci/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.ResourceWithUpdate = StackHCIVirtualMachineInstanceResource{}
+
+type StackHCIVirtualMachineInstanceResource struct{}
+
+type StackHCIVirtualMachineInstanceResourceModel struct {
+	ArcMachineId        string                                          `tfschema:"arc_machine_id"`
+	CustomLocationId    string                                          `tfschema:"custom_location_id"`
+	HardwareProfile     []StackHCIVirtualMachineInstanceHardwareProfile `tfschema:"hardware_profile"`
+	NetworkInterfaceIds []string                                        `tfschema:"network_interface_ids"`
+	OsProfile           []StackHCIVirtualMachineInstanceOsProfile       `tfschema:"os_profile"`
+	StorageProfile      []StackHCIVirtualMachineInstanceStorageProfile  `tfschema:"storage_profile"`
+	SecurityProfile     []StackHCIVirtualMachineInstanceSecurityProfile `tfschema:"security_profile"`
+	VirtualMachineId    string                                          `tfschema:"virtual_machine_id"`
+}
+
+type StackHCIVirtualMachineInstanceHardwareProfile struct {
+	VmSize         string                                        `tfschema:"vm_size"`
+	ProcessorCount int                                           `tfschema:"processor_count"`
+	MemoryInMb     int                                           `tfschema:"memory_in_mb"`
+	DynamicMemory  []StackHCIVirtualMachineInstanceDynamicMemory `tfschema:"dynamic_memory"`
+}
+
+type StackHCIVirtualMachineInstanceDynamicMemory struct {
+	MaximumMemoryInMb            int `tfschema:"maximum_memory_in_mb"`
+	MinimumMemoryInMb            int `tfschema:"minimum_memory_in_mb"`
+	TargetMemoryBufferPercentage int `tfschema:"target_memory_buffer_percentage"`
+}
+
+type StackHCIVirtualMachineInstanceOsProfile struct {
+	AdminUsername        string                                               `tfschema:"admin_username"`
+	AdminPassword        string                                               `tfschema:"admin_password"`
+	ComputerName         string                                               `tfschema:"computer_name"`
+	LinuxConfiguration   []StackHCIVirtualMachineInstanceLinuxConfiguration   `tfschema:"linux_configuration"`
+	WindowsConfiguration []StackHCIVirtualMachineInstanceWindowsConfiguration `tfschema:"windows_configuration"`
+}
+
+type StackHCIVirtualMachineInstanceLinuxConfiguration struct {
+	PasswordAuthenticationEnabled bool `tfschema:"password_authentication_enabled"`
+	VmAgentEnabled                bool `tfschema:"vm_agent_enabled"`
+	VmConfigAgentEnabled          bool `tfschema:"vm_config_agent_enabled"`
+}
+
+type StackHCIVirtualMachineInstanceWindowsConfiguration struct {
+	AutomaticUpdateEnabled bool   `tfschema:"automatic_update_enabled"`
+	TimeZone               string `tfschema:"time_zone"`
+	VmAgentEnabled         bool   `tfschema:"vm_agent_enabled"`
+	VmConfigAgentEnabled   bool   `tfschema:"vm_config_agent_enabled"`
+}
+
+type StackHCIVirtualMachineInstanceStorageProfile struct {
+	ImageId               string   `tfschema:"image_id"`
+	DataDiskIds           []string `tfschema:"data_disk_ids"`
+	VmConfigStoragePathId string   `tfschema:"vm_config_storage_path_id"`
+}
+
+type StackHCIVirtualMachineInstanceSecurityProfile struct {
+	SecureBootEnabled bool   `tfschema:"secure_boot_enabled"`
+	TpmEnabled        bool   `tfschema:"tpm_enabled"`
+	SecurityType      string `tfschema:"security_type"`
+}
+
+func (r StackHCIVirtualMachineInstanceResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"arc_machine_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.HybridComputeMachineID,
+		},
+
+		"custom_location_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.CustomLocationID,
+		},
+
+		"hardware_profile": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"vm_size": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"processor_count": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+
+					"memory_in_mb": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+
+					"dynamic_memory": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						ForceNew: true,
+						MaxItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"maximum_memory_in_mb": {
+									Type:         pluginsdk.TypeInt,
+									Required:     true,
+									ForceNew:     true,
+									ValidateFunc: validation.IntAtLeast(1),
+								},
+
+								"minimum_memory_in_mb": {
+									Type:         pluginsdk.TypeInt,
+									Required:     true,
+									ForceNew:     true,
+									ValidateFunc: validation.IntAtLeast(1),
+								},
+
+								"target_memory_buffer_percentage": {
+									Type:         pluginsdk.TypeInt,
+									Required:     true,
+									ForceNew:     true,
+									ValidateFunc: validation.IntBetween(5, 2000),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+
+		"network_interface_ids": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: networkinterfaces.ValidateNetworkInterfaceID,
+			},
+		},
+
+		"os_profile": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			ForceNew: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"admin_username": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"admin_password": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						Sensitive:    true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"computer_name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringLenBetween(1, 15),
+					},
+
+					"linux_configuration": {
+						Type:         pluginsdk.TypeList,
+						Optional:     true,
+						ForceNew:     true,
+						MaxItems:     1,
+						ExactlyOneOf: []string{"os_profile.0.linux_configuration", "os_profile.0.windows_configuration"},
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"password_authentication_enabled": {
+									Type:     pluginsdk.TypeBool,
+									Optional: true,
+									ForceNew: true,
+									Default:  true,
+								},
+
+								"vm_agent_enabled": {
+									Type:     pluginsdk.TypeBool,
+									Optional: true,
+									ForceNew: true,
+									Default:  true,
+								},
+
+								"vm_config_agent_enabled": {
+									Type:     pluginsdk.TypeBool,
+									Optional: true,
+									ForceNew: true,
+									Default:  true,
+								},
+							},
+						},
+					},
+
+					"windows_configuration": {
+						Type:         pluginsdk.TypeList,
+						Optional:     true,
+						ForceNew:     true,
+						MaxItems:     1,
+						ExactlyOneOf: []string{"os_profile.0.linux_configuration", "os_profile.0.windows_configuration"},
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"automatic_update_enabled": {
+									Type:     pluginsdk.TypeBool,
+									Optional: true,
+									ForceNew: true,
+									Default:  false,
+								},
+
+								"time_zone": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									ForceNew:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"vm_agent_enabled": {
+									Type:     pluginsdk.TypeBool,
+									Optional: true,
+									ForceNew: true,
+									Default:  true,
+								},
+
+								"vm_config_agent_enabled": {
+									Type:     pluginsdk.TypeBool,
+									Optional: true,
+									ForceNew: true,
+									Default:  true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+
+		"storage_profile": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					// this can be either a Marketplace Gallery Image or a Gallery Image
+					"image_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: azure.ValidateResourceID,
+					},
+
+					"data_disk_ids": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: azure.ValidateResourceID,
+						},
+					},
+
+					"vm_config_storage_path_id": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Computed:     true,
+						ForceNew:     true,
+						ValidateFunc: validate.StorageContainerID,
+					},
+				},
+			},
+		},
+
+		"security_profile": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			ForceNew: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"secure_boot_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						ForceNew: true,
+						Default:  true,
+					},
+
+					"tpm_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						ForceNew: true,
+						Default:  false,
+					},
+
+					"security_type": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringInSlice(virtualmachineinstances.PossibleValuesForSecurityTypes(), false),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r StackHCIVirtualMachineInstanceResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"virtual_machine_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r StackHCIVirtualMachineInstanceResource) ModelObject() interface{} {
+	return &StackHCIVirtualMachineInstanceResourceModel{}
+}
+
+func (r StackHCIVirtualMachineInstanceResource) ResourceType() string {
+	return "azurerm_stack_hci_virtual_machine_instance"
+}
+
+func (r StackHCIVirtualMachineInstanceResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return virtualmachineinstances.ValidateVirtualMachineInstanceID
+}
+
+func (r StackHCIVirtualMachineInstanceResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 2 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AzureStackHCI.VirtualMachineInstancesClient
+
+			var model StackHCIVirtualMachineInstanceResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			arcMachineId, err := parse.HybridComputeMachineID(model.ArcMachineId)
+			if err != nil {
+				return err
+			}
+
+			id := virtualmachineinstances.NewVirtualMachineInstanceID(arcMachineId.ID())
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			extendedLocationType := virtualmachineinstances.ExtendedLocationTypesCustomLocation
+			payload := virtualmachineinstances.VirtualMachineInstance{
+				ExtendedLocation: &virtualmachineinstances.ExtendedLocation{
+					Name: utils.String(model.CustomLocationId),
+					Type: &extendedLocationType,
+				},
+				Properties: &virtualmachineinstances.VirtualMachineInstanceProperties{
+					HardwareProfile: expandStackHCIVirtualMachineInstanceHardwareProfile(model.HardwareProfile),
+					NetworkProfile: &virtualmachineinstances.VirtualMachineInstancePropertiesNetworkProfile{
+						NetworkInterfaces: expandStackHCIVirtualMachineInstanceNetworkInterfaces(model.NetworkInterfaceIds),
+					},
+					OsProfile:       expandStackHCIVirtualMachineInstanceOsProfile(model.OsProfile),
+					SecurityProfile: expandStackHCIVirtualMachineInstanceSecurityProfile(model.SecurityProfile),
+					StorageProfile:  expandStackHCIVirtualMachineInstanceStorageProfile(model.StorageProfile),
+				},
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r StackHCIVirtualMachineInstanceResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AzureStackHCI.VirtualMachineInstancesClient
+
+			id, err := virtualmachineinstances.ParseVirtualMachineInstanceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			arcMachineId, err := parse.HybridComputeMachineIDInsensitively(id.Scope)
+			if err != nil {
+				return err
+			}
+
+			state := StackHCIVirtualMachineInstanceResourceModel{
+				ArcMachineId: arcMachineId.ID(),
+			}
+
+			// the API doesn't return the `admin_password`, so this is pulled from the existing state
+			var existing StackHCIVirtualMachineInstanceResourceModel
+			if err := metadata.Decode(&existing); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+			adminPassword := ""
+			if len(existing.OsProfile) > 0 {
+				adminPassword = existing.OsProfile[0].AdminPassword
+			}
+
+			if model := resp.Model; model != nil {
+				if model.ExtendedLocation != nil && model.ExtendedLocation.Name != nil {
+					customLocationId, err := parse.CustomLocationIDInsensitively(*model.ExtendedLocation.Name)
+					if err != nil {
+						return err
+					}
+					state.CustomLocationId = customLocationId.ID()
+				}
+
+				if props := model.Properties; props != nil {
+					state.HardwareProfile = flattenStackHCIVirtualMachineInstanceHardwareProfile(props.HardwareProfile)
+					state.OsProfile = flattenStackHCIVirtualMachineInstanceOsProfile(props.OsProfile, adminPassword)
+					state.SecurityProfile = flattenStackHCIVirtualMachineInstanceSecurityProfile(props.SecurityProfile)
+					state.VirtualMachineId = utils.NormalizeNilableString(props.VMId)
+
+					storageProfile, err := flattenStackHCIVirtualMachineInstanceStorageProfile(props.StorageProfile)
+					if err != nil {
+						return err
+					}
+					state.StorageProfile = storageProfile
+
+					networkInterfaceIds, err := flattenStackHCIVirtualMachineInstanceNetworkInterfaces(props.NetworkProfile)
+					if err != nil {
+						return err
+					}
+					state.NetworkInterfaceIds = networkInterfaceIds
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r StackHCIVirtualMachineInstanceResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 2 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AzureStackHCI.VirtualMachineInstancesClient
+
+			id, err := virtualmachineinstances.ParseVirtualMachineInstanceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model StackHCIVirtualMachineInstanceResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			payload := virtualmachineinstances.VirtualMachineInstanceUpdateRequest{
+				Properties: &virtualmachineinstances.VirtualMachineInstanceUpdateProperties{},
+			}
+
+			if metadata.ResourceData.HasChange("hardware_profile") && len(model.HardwareProfile) > 0 {
+				hardwareProfile := model.HardwareProfile[0]
+				payload.Properties.HardwareProfile = &virtualmachineinstances.HardwareProfileUpdate{
+					VMSize: utils.String(hardwareProfile.VmSize),
+				}
+				if hardwareProfile.ProcessorCount != 0 {
+					payload.Properties.HardwareProfile.Processors = utils.Int64(int64(hardwareProfile.ProcessorCount))
+				}
+				if hardwareProfile.MemoryInMb != 0 {
+					payload.Properties.HardwareProfile.MemoryMB = utils.Int64(int64(hardwareProfile.MemoryInMb))
+				}
+			}
+
+			if metadata.ResourceData.HasChange("network_interface_ids") {
+				networkInterfaces := make([]virtualmachineinstances.NetworkProfileUpdateNetworkInterfacesItem, 0)
+				for _, v := range model.NetworkInterfaceIds {
+					networkInterfaces = append(networkInterfaces, virtualmachineinstances.NetworkProfileUpdateNetworkInterfacesItem{
+						Id: utils.String(v),
+					})
+				}
+				payload.Properties.NetworkProfile = &virtualmachineinstances.NetworkProfileUpdate{
+					NetworkInterfaces: &networkInterfaces,
+				}
+			}
+
+			if metadata.ResourceData.HasChange("storage_profile.0.data_disk_ids") {
+				dataDisks := make([]virtualmachineinstances.StorageProfileUpdateDataDisksItem, 0)
+				if len(model.StorageProfile) > 0 {
+					for _, v := range model.StorageProfile[0].DataDiskIds {
+						dataDisks = append(dataDisks, virtualmachineinstances.StorageProfileUpdateDataDisksItem{
+							Id: utils.String(v),
+						})
+					}
+				}
+				payload.Properties.StorageProfile = &virtualmachineinstances.StorageProfileUpdate{
+					DataDisks: &dataDisks,
+				}
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r StackHCIVirtualMachineInstanceResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 1 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AzureStackHCI.VirtualMachineInstancesClient
+
+			id, err := virtualmachineinstances.ParseVirtualMachineInstanceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandStackHCIVirtualMachineInstanceHardwareProfile(input []StackHCIVirtualMachineInstanceHardwareProfile) *virtualmachineinstances.VirtualMachineInstancePropertiesHardwareProfile {
+	if len(input) == 0 {
+		return nil
+	}
+
+	v := input[0]
+	output := virtualmachineinstances.VirtualMachineInstancePropertiesHardwareProfile{
+		VMSize: utils.String(v.VmSize),
+	}
+
+	if v.ProcessorCount != 0 {
+		output.Processors = utils.Int64(int64(v.ProcessorCount))
+	}
+
+	if v.MemoryInMb != 0 {
+		output.MemoryMB = utils.Int64(int64(v.MemoryInMb))
+	}
+
+	if len(v.DynamicMemory) > 0 {
+		dynamicMemory := v.DynamicMemory[0]
+		output.DynamicMemoryConfig = &virtualmachineinstances.VirtualMachineInstancePropertiesHardwareProfileDynamicMemoryConfig{
+			MaximumMemoryMB:    utils.Int64(int64(dynamicMemory.MaximumMemoryInMb)),
+			MinimumMemoryMB:    utils.Int64(int64(dynamicMemory.MinimumMemoryInMb)),
+			TargetMemoryBuffer: utils.Int64(int64(dynamicMemory.TargetMemoryBufferPercentage)),
+		}
+	}
+
+	return &output
+}
+
+func flattenStackHCIVirtualMachineInstanceHardwareProfile(input *virtualmachineinstances.VirtualMachineInstancePropertiesHardwareProfile) []StackHCIVirtualMachineInstanceHardwareProfile {
+	if input == nil {
+		return []StackHCIVirtualMachineInstanceHardwareProfile{}
+	}
+
+	output := StackHCIVirtualMachineInstanceHardwareProfile{
+		VmSize:        utils.NormalizeNilableString(input.VMSize),
+		DynamicMemory: []StackHCIVirtualMachineInstanceDynamicMemory{},
+	}
+
+	if input.Processors != nil {
+		output.ProcessorCount = int(*input.Processors)
+	}
+
+	if input.MemoryMB != nil {
+		output.MemoryInMb = int(*input.MemoryMB)
+	}
+
+	if v := input.DynamicMemoryConfig; v != nil && (v.MaximumMemoryMB != nil || v.MinimumMemoryMB != nil || v.TargetMemoryBuffer != nil) {
+		dynamicMemory := StackHCIVirtualMachineInstanceDynamicMemory{}
+		if v.MaximumMemoryMB != nil {
+			dynamicMemory.MaximumMemoryInMb = int(*v.MaximumMemoryMB)
+		}
+		if v.MinimumMemoryMB != nil {
+			dynamicMemory.MinimumMemoryInMb = int(*v.MinimumMemoryMB)
+		}
+		if v.TargetMemoryBuffer != nil {
+			dynamicMemory.TargetMemoryBufferPercentage = int(*v.TargetMemoryBuffer)
+		}
+		output.DynamicMemory = append(output.DynamicMemory, dynamicMemory)
+	}
+
+	return []StackHCIVirtualMachineInstanceHardwareProfile{output}
+}
+
+func expandStackHCIVirtualMachineInstanceNetworkInterfaces(input []string) *[]virtualmachineinstances.VirtualMachineInstancePropertiesNetworkProfileNetworkInterfacesItem {
+	output := make([]virtualmachineinstances.VirtualMachineInstancePropertiesNetworkProfileNetworkInterfacesItem, 0)
+	for _, v := range input {
+		output = append(output, virtualmachineinstances.VirtualMachineInstancePropertiesNetworkProfileNetworkInterfacesItem{
+			Id: utils.String(v),
+		})
+	}
+
+	return &output
+}
+
+func flattenStackHCIVirtualMachineInstanceNetworkInterfaces(input *virtualmachineinstances.VirtualMachineInstancePropertiesNetworkProfile) ([]string, error) {
+	output := make([]string, 0)
+	if input == nil || input.NetworkInterfaces == nil {
+		return output, nil
+	}
+
+	for _, v := range *input.NetworkInterfaces {
+		if v.Id == nil {
+			continue
+		}
+
+		networkInterfaceId, err := networkinterfaces.ParseNetworkInterfaceIDInsensitively(*v.Id)
+		if err != nil {
+			return nil, err
+		}
+		output = append(output, networkInterfaceId.ID())
+	}
+
+	return output, nil
+}
+
+func expandStackHCIVirtualMachineInstanceOsProfile(input []StackHCIVirtualMachineInstanceOsProfile) *virtualmachineinstances.VirtualMachineInstancePropertiesOsProfile {
+	if len(input) == 0 {
+		return nil
+	}
+
+	v := input[0]
+	output := virtualmachineinstances.VirtualMachineInstancePropertiesOsProfile{
+		AdminPassword: utils.String(v.AdminPassword),
+		AdminUsername: utils.String(v.AdminUsername),
+		ComputerName:  utils.String(v.ComputerName),
+	}
+
+	if len(v.LinuxConfiguration) > 0 {
+		linux := v.LinuxConfiguration[0]
+		output.LinuxConfiguration = &virtualmachineinstances.VirtualMachineInstancePropertiesOsProfileLinuxConfiguration{
+			DisablePasswordAuthentication: utils.Bool(!linux.PasswordAuthenticationEnabled),
+			ProvisionVMAgent:              utils.Bool(linux.VmAgentEnabled),
+			ProvisionVMConfigAgent:        utils.Bool(linux.VmConfigAgentEnabled),
+		}
+	}
+
+	if len(v.WindowsConfiguration) > 0 {
+		windows := v.WindowsConfiguration[0]
+		output.WindowsConfiguration = &virtualmachineinstances.VirtualMachineInstancePropertiesOsProfileWindowsConfiguration{
+			EnableAutomaticUpdates: utils.Bool(windows.AutomaticUpdateEnabled),
+			ProvisionVMAgent:       utils.Bool(windows.VmAgentEnabled),
+			ProvisionVMConfigAgent: utils.Bool(windows.VmConfigAgentEnabled),
+		}
+		if windows.TimeZone != "" {
+			output.WindowsConfiguration.TimeZone = utils.String(windows.TimeZone)
+		}
+	}
+
+	return &output
+}
+
+func flattenStackHCIVirtualMachineInstanceOsProfile(input *virtualmachineinstances.VirtualMachineInstancePropertiesOsProfile, adminPassword string) []StackHCIVirtualMachineInstanceOsProfile {
+	if input == nil {
+		return []StackHCIVirtualMachineInstanceOsProfile{}
+	}
+
+	output := StackHCIVirtualMachineInstanceOsProfile{
+		AdminUsername:        utils.NormalizeNilableString(input.AdminUsername),
+		AdminPassword:        adminPassword,
+		ComputerName:         utils.NormalizeNilableString(input.ComputerName),
+		LinuxConfiguration:   []StackHCIVirtualMachineInstanceLinuxConfiguration{},
+		WindowsConfiguration: []StackHCIVirtualMachineInstanceWindowsConfiguration{},
+	}
+
+	if linux := input.LinuxConfiguration; linux != nil {
+		output.LinuxConfiguration = append(output.LinuxConfiguration, StackHCIVirtualMachineInstanceLinuxConfiguration{
+			PasswordAuthenticationEnabled: linux.DisablePasswordAuthentication == nil || !*linux.DisablePasswordAuthentication,
+			VmAgentEnabled:                linux.ProvisionVMAgent != nil && *linux.ProvisionVMAgent,
+			VmConfigAgentEnabled:          linux.ProvisionVMConfigAgent != nil && *linux.ProvisionVMConfigAgent,
+		})
+	}
+
+	if windows := input.WindowsConfiguration; windows != nil {
+		output.WindowsConfiguration = append(output.WindowsConfiguration, StackHCIVirtualMachineInstanceWindowsConfiguration{
+			AutomaticUpdateEnabled: windows.EnableAutomaticUpdates != nil && *windows.EnableAutomaticUpdates,
+			TimeZone:               utils.NormalizeNilableString(windows.TimeZone),
+			VmAgentEnabled:         windows.ProvisionVMAgent != nil && *windows.ProvisionVMAgent,
+			VmConfigAgentEnabled:   windows.ProvisionVMConfigAgent != nil && *windows.ProvisionVMConfigAgent,
+		})
+	}
+
+	return []StackHCIVirtualMachineInstanceOsProfile{output}
+}
+
+func expandStackHCIVirtualMachineInstanceStorageProfile(input []StackHCIVirtualMachineInstanceStorageProfile) *virtualmachineinstances.VirtualMachineInstancePropertiesStorageProfile {
+	if len(input) == 0 {
+		return nil
+	}
+
+	v := input[0]
+	dataDisks := make([]virtualmachineinstances.VirtualMachineInstancePropertiesStorageProfileDataDisksItem, 0)
+	for _, dataDiskId := range v.DataDiskIds {
+		dataDisks = append(dataDisks, virtualmachineinstances.VirtualMachineInstancePropertiesStorageProfileDataDisksItem{
+			Id: utils.String(dataDiskId),
+		})
+	}
+
+	output := virtualmachineinstances.VirtualMachineInstancePropertiesStorageProfile{
+		DataDisks: &dataDisks,
+		ImageReference: &virtualmachineinstances.ImageArmReference{
+			Id: utils.String(v.ImageId),
+		},
+	}
+
+	if v.VmConfigStoragePathId != "" {
+		output.VMConfigStoragePathId = utils.String(v.VmConfigStoragePathId)
+	}
+
+	return &output
+}
+
+func flattenStackHCIVirtualMachineInstanceStorageProfile(input *virtualmachineinstances.VirtualMachineInstancePropertiesStorageProfile) ([]StackHCIVirtualMachineInstanceStorageProfile, error) {
+	if input == nil {
+		return []StackHCIVirtualMachineInstanceStorageProfile{}, nil
+	}
+
+	output := StackHCIVirtualMachineInstanceStorageProfile{
+		DataDiskIds: []string{},
+	}
+
+	if input.ImageReference != nil {
+		output.ImageId = utils.NormalizeNilableString(input.ImageReference.Id)
+	}
+
+	if input.DataDisks != nil {
+		for _, v := range *input.DataDisks {
+			if v.Id != nil {
+				output.DataDiskIds = append(output.DataDiskIds, *v.Id)
+			}
+		}
+	}
+
+	if input.VMConfigStoragePathId != nil {
+		storageContainerId, err := parse.StorageContainerIDInsensitively(*input.VMConfigStoragePathId)
+		if err != nil {
+			return nil, err
+		}
+		output.VmConfigStoragePathId = storageContainerId.ID()
+	}
+
+	return []StackHCIVirtualMachineInstanceStorageProfile{output}, nil
+}
+
+func expandStackHCIVirtualMachineInstanceSecurityProfile(input []StackHCIVirtualMachineInstanceSecurityProfile) *virtualmachineinstances.VirtualMachineInstancePropertiesSecurityProfile {
+	if len(input) == 0 {
+		return nil
+	}
+
+	v := input[0]
+	output := virtualmachineinstances.VirtualMachineInstancePropertiesSecurityProfile{
+		EnableTPM: utils.Bool(v.TpmEnabled),
+		UefiSettings: &virtualmachineinstances.VirtualMachineInstancePropertiesSecurityProfileUefiSettings{
+			SecureBootEnabled: utils.Bool(v.SecureBootEnabled),
+		},
+	}
+
+	if v.SecurityType != "" {
+		securityType := virtualmachineinstances.SecurityTypes(v.SecurityType)
+		output.SecurityType = &securityType
+	}
+
+	return &output
+}
+
+func flattenStackHCIVirtualMachineInstanceSecurityProfile(input *virtualmachineinstances.VirtualMachineInstancePropertiesSecurityProfile) []StackHCIVirtualMachineInstanceSecurityProfile {
+	if input == nil {
+		return []StackHCIVirtualMachineInstanceSecurityProfile{}
+	}
+
+	output := StackHCIVirtualMachineInstanceSecurityProfile{
+		TpmEnabled: input.EnableTPM != nil && *input.EnableTPM,
+	}
+
+	if input.UefiSettings != nil && input.UefiSettings.SecureBootEnabled != nil {
+		output.SecureBootEnabled = *input.UefiSettings.SecureBootEnabled
+	}
+
+	if input.SecurityType != nil {
+		output.SecurityType = string(*input.SecurityType)
+	}
+
+	return []StackHCIVirtualMachineInstanceSecurityProfile{output}
+}
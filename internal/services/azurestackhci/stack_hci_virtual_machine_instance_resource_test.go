@@ -0,0 +1,183 @@
+package azurestackhci_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/azurestackhci/sdk/2024-01-01/virtualmachineinstances"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type StackHCIVirtualMachineInstanceResource struct{}
+
+// the Virtual Machine Instance is an extension of an Arc Machine, which is registered outside of this provider
+func preCheckStackHCIVirtualMachineInstance(t *testing.T) {
+	preCheckStackHCICustomLocation(t)
+
+	if os.Getenv("ARM_TEST_STACK_HCI_ARC_MACHINE_ID") == "" {
+		t.Skip("Skipping as ARM_TEST_STACK_HCI_ARC_MACHINE_ID is not specified")
+	}
+}
+
+func TestAccStackHCIVirtualMachineInstance_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_stack_hci_virtual_machine_instance", "test")
+	r := StackHCIVirtualMachineInstanceResource{}
+	preCheckStackHCIVirtualMachineInstance(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, 2, 8192),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("os_profile.0.admin_password"),
+	})
+}
+
+func TestAccStackHCIVirtualMachineInstance_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_stack_hci_virtual_machine_instance", "test")
+	r := StackHCIVirtualMachineInstanceResource{}
+	preCheckStackHCIVirtualMachineInstance(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, 2, 8192),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccStackHCIVirtualMachineInstance_updateHardwareProfile(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_stack_hci_virtual_machine_instance", "test")
+	r := StackHCIVirtualMachineInstanceResource{}
+	preCheckStackHCIVirtualMachineInstance(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, 2, 8192),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("os_profile.0.admin_password"),
+		{
+			Config: r.basic(data, 4, 16384),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("hardware_profile.0.processor_count").HasValue("4"),
+				check.That(data.ResourceName).Key("hardware_profile.0.memory_in_mb").HasValue("16384"),
+			),
+		},
+		data.ImportStep("os_profile.0.admin_password"),
+	})
+}
+
+func (r StackHCIVirtualMachineInstanceResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := virtualmachineinstances.ParseVirtualMachineInstanceID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.AzureStackHCI.VirtualMachineInstancesClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r StackHCIVirtualMachineInstanceResource) basic(data acceptance.TestData, processorCount, memoryInMb int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_stack_hci_virtual_machine_instance" "test" {
+  arc_machine_id        = %q
+  custom_location_id    = %q
+  network_interface_ids = [azurerm_stack_hci_network_interface.test.id]
+
+  hardware_profile {
+    vm_size         = "Custom"
+    processor_count = %d
+    memory_in_mb    = %d
+  }
+
+  os_profile {
+    admin_username = "adminuser"
+    admin_password = "P@ssw0rd1234!"
+    computer_name  = "acctestvm"
+
+    windows_configuration {}
+  }
+
+  storage_profile {
+    image_id = azurerm_stack_hci_marketplace_gallery_image.test.id
+  }
+}
+`, r.template(data), os.Getenv("ARM_TEST_STACK_HCI_ARC_MACHINE_ID"), os.Getenv("ARM_TEST_STACK_HCI_CUSTOM_LOCATION_ID"), processorCount, memoryInMb)
+}
+
+func (r StackHCIVirtualMachineInstanceResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_stack_hci_virtual_machine_instance" "import" {
+  arc_machine_id        = azurerm_stack_hci_virtual_machine_instance.test.arc_machine_id
+  custom_location_id    = azurerm_stack_hci_virtual_machine_instance.test.custom_location_id
+  network_interface_ids = azurerm_stack_hci_virtual_machine_instance.test.network_interface_ids
+
+  hardware_profile {
+    vm_size         = "Custom"
+    processor_count = 2
+    memory_in_mb    = 8192
+  }
+
+  os_profile {
+    admin_username = "adminuser"
+    admin_password = "P@ssw0rd1234!"
+    computer_name  = "acctestvm"
+
+    windows_configuration {}
+  }
+
+  storage_profile {
+    image_id = azurerm_stack_hci_marketplace_gallery_image.test.id
+  }
+}
+`, r.basic(data, 2, 8192))
+}
+
+func (r StackHCIVirtualMachineInstanceResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_stack_hci_marketplace_gallery_image" "test" {
+  name                = "acctest-mgi-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  custom_location_id  = %q
+  hyperv_generation   = "V2"
+  os_type             = "Windows"
+  version             = "20348.2655.240905"
+
+  identifier {
+    publisher = "MicrosoftWindowsServer"
+    offer     = "WindowsServer"
+    sku       = "2022-datacenter-azure-edition-core"
+  }
+}
+`, StackHCINetworkInterfaceResource{}.basic(data), data.RandomInteger, os.Getenv("ARM_TEST_STACK_HCI_CUSTOM_LOCATION_ID"))
+}
@@ -0,0 +1,25 @@
+package client
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/billing/sdk/2022-11-01/reservations"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/billing/sdk/2022-11-01/savingsplans"
+)
+
+type Client struct {
+	ReservationsClient *reservations.ReservationsClient
+	SavingsPlansClient *savingsplans.SavingsPlansClient
+}
+
+func NewClient(o *common.ClientOptions) *Client {
+	reservationsClient := reservations.NewReservationsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&reservationsClient.Client, o.ResourceManagerAuthorizer)
+
+	savingsPlansClient := savingsplans.NewSavingsPlansClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&savingsPlansClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		ReservationsClient: &reservationsClient,
+		SavingsPlansClient: &savingsPlansClient,
+	}
+}
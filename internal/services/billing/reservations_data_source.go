@@ -0,0 +1,243 @@
+package billing
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/billing/sdk/2022-11-01/reservations"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.DataSource = ReservationsDataSource{}
+
+type ReservationsDataSource struct{}
+
+type ReservationsDataSourceModel struct {
+	ReservedResourceType string                 `tfschema:"reserved_resource_type"`
+	Reservations         []ReservationDataModel `tfschema:"reservations"`
+}
+
+type ReservationDataModel struct {
+	Id                          string   `tfschema:"id"`
+	DisplayName                 string   `tfschema:"display_name"`
+	SkuName                     string   `tfschema:"sku_name"`
+	ReservedResourceType        string   `tfschema:"reserved_resource_type"`
+	Quantity                    int      `tfschema:"quantity"`
+	Term                        string   `tfschema:"term"`
+	BillingPlan                 string   `tfschema:"billing_plan"`
+	AppliedScopeType            string   `tfschema:"applied_scope_type"`
+	AppliedScopes               []string `tfschema:"applied_scopes"`
+	Renew                       bool     `tfschema:"renew"`
+	ExpiryDateTime              string   `tfschema:"expiry_date_time"`
+	UtilizationTrend            string   `tfschema:"utilization_trend"`
+	Utilization1DayPercentage   float64  `tfschema:"utilization_1_day_percentage"`
+	Utilization7DaysPercentage  float64  `tfschema:"utilization_7_days_percentage"`
+	Utilization30DaysPercentage float64  `tfschema:"utilization_30_days_percentage"`
+}
+
+func (d ReservationsDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"reserved_resource_type": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (d ReservationsDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"reservations": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"display_name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"sku_name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"reserved_resource_type": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"quantity": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"term": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"billing_plan": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"applied_scope_type": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"applied_scopes": {
+						Type:     pluginsdk.TypeList,
+						Computed: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+
+					"renew": {
+						Type:     pluginsdk.TypeBool,
+						Computed: true,
+					},
+
+					"expiry_date_time": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"utilization_trend": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"utilization_1_day_percentage": {
+						Type:     pluginsdk.TypeFloat,
+						Computed: true,
+					},
+
+					"utilization_7_days_percentage": {
+						Type:     pluginsdk.TypeFloat,
+						Computed: true,
+					},
+
+					"utilization_30_days_percentage": {
+						Type:     pluginsdk.TypeFloat,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d ReservationsDataSource) ModelObject() interface{} {
+	return &ReservationsDataSourceModel{}
+}
+
+func (d ReservationsDataSource) ResourceType() string {
+	return "azurerm_reservations"
+}
+
+func (d ReservationsDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Billing.ReservationsClient
+
+			var state ReservationsDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			resp, err := client.ListAllComplete(ctx)
+			if err != nil {
+				return fmt.Errorf("listing Reservations: %+v", err)
+			}
+
+			state.Reservations = flattenReservations(resp.Items, state.ReservedResourceType)
+
+			metadata.ResourceData.SetId("/providers/Microsoft.Capacity/reservations")
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func flattenReservations(input []reservations.ReservationResponse, reservedResourceType string) []ReservationDataModel {
+	output := make([]ReservationDataModel, 0)
+
+	for _, item := range input {
+		props := item.Properties
+		if props == nil {
+			continue
+		}
+
+		// only the active reservations are returned, those which have expired/been cancelled, merged or split are skipped
+		if !strings.EqualFold(utils.NormalizeNilableString(props.ProvisioningState), "Succeeded") {
+			continue
+		}
+		if reservedResourceType != "" && !strings.EqualFold(utils.NormalizeNilableString(props.ReservedResourceType), reservedResourceType) {
+			continue
+		}
+
+		reservation := ReservationDataModel{
+			Id:                   utils.NormalizeNilableString(item.Id),
+			DisplayName:          utils.NormalizeNilableString(props.DisplayName),
+			ReservedResourceType: utils.NormalizeNilableString(props.ReservedResourceType),
+			Term:                 utils.NormalizeNilableString(props.Term),
+			BillingPlan:          utils.NormalizeNilableString(props.BillingPlan),
+			AppliedScopeType:     utils.NormalizeNilableString(props.AppliedScopeType),
+			AppliedScopes:        []string{},
+			ExpiryDateTime:       utils.NormalizeNilableString(props.ExpiryDateTime),
+		}
+		if item.Sku != nil {
+			reservation.SkuName = utils.NormalizeNilableString(item.Sku.Name)
+		}
+		if props.Quantity != nil {
+			reservation.Quantity = int(*props.Quantity)
+		}
+		if props.AppliedScopes != nil {
+			reservation.AppliedScopes = *props.AppliedScopes
+		}
+		if props.Renew != nil {
+			reservation.Renew = *props.Renew
+		}
+		if utilization := props.Utilization; utilization != nil {
+			reservation.UtilizationTrend = utils.NormalizeNilableString(utilization.Trend)
+			if utilization.Aggregates != nil {
+				for _, aggregate := range *utilization.Aggregates {
+					setUtilizationPercentage(aggregate.Grain, aggregate.GrainUnit, aggregate.Value, &reservation.Utilization1DayPercentage, &reservation.Utilization7DaysPercentage, &reservation.Utilization30DaysPercentage)
+				}
+			}
+		}
+
+		output = append(output, reservation)
+	}
+
+	return output
+}
+
+// setUtilizationPercentage assigns the utilization percentage for a 1, 7 or 30 day aggregate to the matching field
+func setUtilizationPercentage(grain *float64, grainUnit *string, value *float64, oneDay, sevenDays, thirtyDays *float64) {
+	if grain == nil || value == nil || !strings.EqualFold(utils.NormalizeNilableString(grainUnit), "days") {
+		return
+	}
+
+	switch *grain {
+	case 1:
+		*oneDay = *value
+	case 7:
+		*sevenDays = *value
+	case 30:
+		*thirtyDays = *value
+	}
+}
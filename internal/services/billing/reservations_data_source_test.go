@@ -0,0 +1,61 @@
+package billing_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type ReservationsDataSource struct{}
+
+func TestAccReservationsDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_reservations", "test")
+	r := ReservationsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("id").HasValue("/providers/Microsoft.Capacity/reservations"),
+				check.That(data.ResourceName).Key("reservations.#").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccReservationsDataSource_reservedResourceType(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_reservations", "test")
+	r := ReservationsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.reservedResourceType(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("reservations.#").Exists(),
+			),
+		},
+	})
+}
+
+func (ReservationsDataSource) basic() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_reservations" "test" {}
+`
+}
+
+func (ReservationsDataSource) reservedResourceType() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_reservations" "test" {
+  reserved_resource_type = "VirtualMachines"
+}
+`
+}
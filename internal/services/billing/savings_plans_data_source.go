@@ -0,0 +1,180 @@
+package billing
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/billing/sdk/2022-11-01/savingsplans"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.DataSource = SavingsPlansDataSource{}
+
+type SavingsPlansDataSource struct{}
+
+type SavingsPlansDataSourceModel struct {
+	SavingsPlans []SavingsPlanDataModel `tfschema:"savings_plans"`
+}
+
+type SavingsPlanDataModel struct {
+	Id                          string  `tfschema:"id"`
+	DisplayName                 string  `tfschema:"display_name"`
+	SkuName                     string  `tfschema:"sku_name"`
+	Term                        string  `tfschema:"term"`
+	BillingPlan                 string  `tfschema:"billing_plan"`
+	BillingScopeId              string  `tfschema:"billing_scope_id"`
+	AppliedScopeType            string  `tfschema:"applied_scope_type"`
+	AppliedScopeId              string  `tfschema:"applied_scope_id"`
+	CommitmentAmount            float64 `tfschema:"commitment_amount"`
+	CommitmentCurrencyCode      string  `tfschema:"commitment_currency_code"`
+	CommitmentGrain             string  `tfschema:"commitment_grain"`
+	Renew                       bool    `tfschema:"renew"`
+	ExpiryDateTime              string  `tfschema:"expiry_date_time"`
+	UtilizationTrend            string  `tfschema:"utilization_trend"`
+	Utilization1DayPercentage   float64 `tfschema:"utilization_1_day_percentage"`
+	Utilization7DaysPercentage  float64 `tfschema:"utilization_7_days_percentage"`
+	Utilization30DaysPercentage float64 `tfschema:"utilization_30_days_percentage"`
+}
+
+func (d SavingsPlansDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (d SavingsPlansDataSource) Attributes() map[string]*pluginsdk.Schema {
+	computedString := func() *pluginsdk.Schema {
+		return &pluginsdk.Schema{
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		}
+	}
+	computedFloat := func() *pluginsdk.Schema {
+		return &pluginsdk.Schema{
+			Type:     pluginsdk.TypeFloat,
+			Computed: true,
+		}
+	}
+
+	return map[string]*pluginsdk.Schema{
+		"savings_plans": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"id":                       computedString(),
+					"display_name":             computedString(),
+					"sku_name":                 computedString(),
+					"term":                     computedString(),
+					"billing_plan":             computedString(),
+					"billing_scope_id":         computedString(),
+					"applied_scope_type":       computedString(),
+					"applied_scope_id":         computedString(),
+					"commitment_amount":        computedFloat(),
+					"commitment_currency_code": computedString(),
+					"commitment_grain":         computedString(),
+					"renew": {
+						Type:     pluginsdk.TypeBool,
+						Computed: true,
+					},
+					"expiry_date_time":               computedString(),
+					"utilization_trend":              computedString(),
+					"utilization_1_day_percentage":   computedFloat(),
+					"utilization_7_days_percentage":  computedFloat(),
+					"utilization_30_days_percentage": computedFloat(),
+				},
+			},
+		},
+	}
+}
+
+func (d SavingsPlansDataSource) ModelObject() interface{} {
+	return &SavingsPlansDataSourceModel{}
+}
+
+func (d SavingsPlansDataSource) ResourceType() string {
+	return "azurerm_savings_plans"
+}
+
+func (d SavingsPlansDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Billing.SavingsPlansClient
+
+			resp, err := client.ListAllComplete(ctx)
+			if err != nil {
+				return fmt.Errorf("listing Savings Plans: %+v", err)
+			}
+
+			state := SavingsPlansDataSourceModel{
+				SavingsPlans: flattenSavingsPlans(resp.Items),
+			}
+
+			metadata.ResourceData.SetId("/providers/Microsoft.BillingBenefits/savingsPlans")
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func flattenSavingsPlans(input []savingsplans.SavingsPlanModel) []SavingsPlanDataModel {
+	output := make([]SavingsPlanDataModel, 0)
+
+	for _, item := range input {
+		props := item.Properties
+		if props == nil {
+			continue
+		}
+
+		// only the active savings plans are returned
+		if !strings.EqualFold(utils.NormalizeNilableString(props.ProvisioningState), "Succeeded") {
+			continue
+		}
+
+		plan := SavingsPlanDataModel{
+			Id:               utils.NormalizeNilableString(item.Id),
+			DisplayName:      utils.NormalizeNilableString(props.DisplayName),
+			Term:             utils.NormalizeNilableString(props.Term),
+			BillingPlan:      utils.NormalizeNilableString(props.BillingPlan),
+			BillingScopeId:   utils.NormalizeNilableString(props.BillingScopeId),
+			AppliedScopeType: utils.NormalizeNilableString(props.AppliedScopeType),
+			ExpiryDateTime:   utils.NormalizeNilableString(props.ExpiryDateTime),
+		}
+		if item.Sku != nil {
+			plan.SkuName = utils.NormalizeNilableString(item.Sku.Name)
+		}
+		if scope := props.AppliedScopeProperties; scope != nil {
+			// only one of these is set, depending on the `applied_scope_type`
+			for _, v := range []*string{scope.ResourceGroupId, scope.SubscriptionId, scope.ManagementGroupId} {
+				if v != nil && *v != "" {
+					plan.AppliedScopeId = *v
+					break
+				}
+			}
+		}
+		if commitment := props.Commitment; commitment != nil {
+			if commitment.Amount != nil {
+				plan.CommitmentAmount = *commitment.Amount
+			}
+			plan.CommitmentCurrencyCode = utils.NormalizeNilableString(commitment.CurrencyCode)
+			plan.CommitmentGrain = utils.NormalizeNilableString(commitment.Grain)
+		}
+		if props.Renew != nil {
+			plan.Renew = *props.Renew
+		}
+		if utilization := props.Utilization; utilization != nil {
+			plan.UtilizationTrend = utils.NormalizeNilableString(utilization.Trend)
+			if utilization.Aggregates != nil {
+				for _, aggregate := range *utilization.Aggregates {
+					setUtilizationPercentage(aggregate.Grain, aggregate.GrainUnit, aggregate.Value, &plan.Utilization1DayPercentage, &plan.Utilization7DaysPercentage, &plan.Utilization30DaysPercentage)
+				}
+			}
+		}
+
+		output = append(output, plan)
+	}
+
+	return output
+}
@@ -0,0 +1,35 @@
+package billing_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type SavingsPlansDataSource struct{}
+
+func TestAccSavingsPlansDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_savings_plans", "test")
+	r := SavingsPlansDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("id").HasValue("/providers/Microsoft.BillingBenefits/savingsPlans"),
+				check.That(data.ResourceName).Key("savings_plans.#").Exists(),
+			),
+		},
+	})
+}
+
+func (SavingsPlansDataSource) basic() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_savings_plans" "test" {}
+`
+}
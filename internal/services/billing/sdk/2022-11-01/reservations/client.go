@@ -0,0 +1,15 @@
+package reservations
+
+import "github.com/Azure/go-autorest/autorest"
+
+type ReservationsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewReservationsClientWithBaseURI(endpoint string) ReservationsClient {
+	return ReservationsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
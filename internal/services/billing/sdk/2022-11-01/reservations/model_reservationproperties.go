@@ -0,0 +1,20 @@
+package reservations
+
+type ReservationProperties struct {
+	AppliedScopeProperties       *AppliedScopeProperties `json:"appliedScopeProperties,omitempty"`
+	AppliedScopeType             *string                 `json:"appliedScopeType,omitempty"`
+	AppliedScopes                *[]string               `json:"appliedScopes,omitempty"`
+	BillingPlan                  *string                 `json:"billingPlan,omitempty"`
+	BillingScopeId               *string                 `json:"billingScopeId,omitempty"`
+	DisplayName                  *string                 `json:"displayName,omitempty"`
+	DisplayProvisioningState     *string                 `json:"displayProvisioningState,omitempty"`
+	EffectiveDateTime            *string                 `json:"effectiveDateTime,omitempty"`
+	ExpiryDateTime               *string                 `json:"expiryDateTime,omitempty"`
+	ProvisioningState            *string                 `json:"provisioningState,omitempty"`
+	Quantity                     *int64                  `json:"quantity,omitempty"`
+	Renew                        *bool                   `json:"renew,omitempty"`
+	ReservedResourceType         *string                 `json:"reservedResourceType,omitempty"`
+	Term                         *string                 `json:"term,omitempty"`
+	UserFriendlyAppliedScopeType *string                 `json:"userFriendlyAppliedScopeType,omitempty"`
+	Utilization                  *Utilization            `json:"utilization,omitempty"`
+}
@@ -0,0 +1,12 @@
+package reservations
+
+type ReservationResponse struct {
+	Id         *string                `json:"id,omitempty"`
+	Kind       *string                `json:"kind,omitempty"`
+	Location   *string                `json:"location,omitempty"`
+	Name       *string                `json:"name,omitempty"`
+	Properties *ReservationProperties `json:"properties,omitempty"`
+	Sku        *Sku                   `json:"sku,omitempty"`
+	SystemData *SystemData            `json:"systemData,omitempty"`
+	Type       *string                `json:"type,omitempty"`
+}
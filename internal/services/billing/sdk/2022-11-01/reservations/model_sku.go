@@ -0,0 +1,5 @@
+package reservations
+
+type Sku struct {
+	Name *string `json:"name,omitempty"`
+}
@@ -0,0 +1,6 @@
+package reservations
+
+type Utilization struct {
+	Aggregates *[]UtilizationAggregates `json:"aggregates,omitempty"`
+	Trend      *string                  `json:"trend,omitempty"`
+}
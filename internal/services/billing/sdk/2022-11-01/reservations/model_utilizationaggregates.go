@@ -0,0 +1,8 @@
+package reservations
+
+type UtilizationAggregates struct {
+	Grain     *float64 `json:"grain,omitempty"`
+	GrainUnit *string  `json:"grainUnit,omitempty"`
+	Value     *float64 `json:"value,omitempty"`
+	ValueUnit *string  `json:"valueUnit,omitempty"`
+}
@@ -0,0 +1,15 @@
+package savingsplans
+
+import "github.com/Azure/go-autorest/autorest"
+
+type SavingsPlansClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewSavingsPlansClientWithBaseURI(endpoint string) SavingsPlansClient {
+	return SavingsPlansClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
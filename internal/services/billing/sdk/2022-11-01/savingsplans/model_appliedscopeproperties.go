@@ -0,0 +1,9 @@
+package savingsplans
+
+type AppliedScopeProperties struct {
+	DisplayName       *string `json:"displayName,omitempty"`
+	ManagementGroupId *string `json:"managementGroupId,omitempty"`
+	ResourceGroupId   *string `json:"resourceGroupId,omitempty"`
+	SubscriptionId    *string `json:"subscriptionId,omitempty"`
+	TenantId          *string `json:"tenantId,omitempty"`
+}
@@ -0,0 +1,10 @@
+package savingsplans
+
+type SavingsPlanModel struct {
+	Id         *string                `json:"id,omitempty"`
+	Name       *string                `json:"name,omitempty"`
+	Properties *SavingsPlanProperties `json:"properties,omitempty"`
+	Sku        *Sku                   `json:"sku,omitempty"`
+	SystemData *SystemData            `json:"systemData,omitempty"`
+	Type       *string                `json:"type,omitempty"`
+}
@@ -0,0 +1,18 @@
+package savingsplans
+
+type SavingsPlanProperties struct {
+	AppliedScopeProperties       *AppliedScopeProperties `json:"appliedScopeProperties,omitempty"`
+	AppliedScopeType             *string                 `json:"appliedScopeType,omitempty"`
+	BillingPlan                  *string                 `json:"billingPlan,omitempty"`
+	BillingScopeId               *string                 `json:"billingScopeId,omitempty"`
+	Commitment                   *Commitment             `json:"commitment,omitempty"`
+	DisplayName                  *string                 `json:"displayName,omitempty"`
+	DisplayProvisioningState     *string                 `json:"displayProvisioningState,omitempty"`
+	EffectiveDateTime            *string                 `json:"effectiveDateTime,omitempty"`
+	ExpiryDateTime               *string                 `json:"expiryDateTime,omitempty"`
+	ProvisioningState            *string                 `json:"provisioningState,omitempty"`
+	Renew                        *bool                   `json:"renew,omitempty"`
+	Term                         *string                 `json:"term,omitempty"`
+	UserFriendlyAppliedScopeType *string                 `json:"userFriendlyAppliedScopeType,omitempty"`
+	Utilization                  *Utilization            `json:"utilization,omitempty"`
+}
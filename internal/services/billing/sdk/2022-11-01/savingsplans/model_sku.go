@@ -0,0 +1,5 @@
+package savingsplans
+
+type Sku struct {
+	Name *string `json:"name,omitempty"`
+}
@@ -0,0 +1,8 @@
+package savingsplans
+
+type UtilizationAggregates struct {
+	Grain     *float64 `json:"grain,omitempty"`
+	GrainUnit *string  `json:"grainUnit,omitempty"`
+	Value     *float64 `json:"value,omitempty"`
+	ValueUnit *string  `json:"valueUnit,omitempty"`
+}
@@ -0,0 +1,531 @@
+package cdn
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/cdn/mgmt/2020-09-01/cdn"
+	"github.com/Azure/azure-sdk-for-go/services/dns/mgmt/2018-05-01/dns"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/validate"
+	dnsParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/parse"
+	dnsValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// cdnFrontDoorCustomDomainValidationRecordTTL is the TTL (in seconds) of the `_dnsauth` TXT Record
+const cdnFrontDoorCustomDomainValidationRecordTTL = 3600
+
+func resourceCdnFrontDoorCustomDomain() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceCdnFrontDoorCustomDomainCreate,
+		Read:   resourceCdnFrontDoorCustomDomainRead,
+		Update: resourceCdnFrontDoorCustomDomainUpdate,
+		Delete: resourceCdnFrontDoorCustomDomainDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.FrontDoorCustomDomainID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(12 * time.Hour),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(12 * time.Hour),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.CdnEndpointCustomDomainName(),
+			},
+
+			"cdn_frontdoor_profile_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ProfileID,
+			},
+
+			"host_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"dns_zone_id": {
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				ValidateFunc:     dnsValidate.DnsZoneID,
+				DiffSuppressFunc: suppress.CaseDifference,
+			},
+
+			"manage_validation_record": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"tls": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"certificate_type": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							Default:  string(cdn.AfdCertificateTypeManagedCertificate),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(cdn.AfdCertificateTypeManagedCertificate),
+							}, false),
+						},
+
+						"minimum_tls_version": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							Default:  string(cdn.AfdMinimumTLSVersionTLS12),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(cdn.AfdMinimumTLSVersionTLS10),
+								string(cdn.AfdMinimumTLSVersionTLS12),
+							}, false),
+						},
+					},
+				},
+			},
+
+			"domain_validation_state": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"expiration_date": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"validation_token": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
+			if !diff.Get("manage_validation_record").(bool) {
+				return nil
+			}
+
+			if diff.Get("dns_zone_id").(string) == "" && diff.NewValueKnown("dns_zone_id") {
+				return fmt.Errorf("`dns_zone_id` must be specified when `manage_validation_record` is enabled")
+			}
+
+			// where the Validation Token has expired (or the Custom Domain otherwise hasn't been validated) an update
+			// is required so that the Validation Token can be refreshed and the `_dnsauth` TXT Record updated
+			if diff.Id() != "" {
+				if state := diff.Get("domain_validation_state").(string); state != "" && state != string(cdn.DomainValidationStateApproved) {
+					if err := diff.SetNewComputed("domain_validation_state"); err != nil {
+						return err
+					}
+				}
+			}
+
+			return nil
+		}),
+	}
+}
+
+func resourceCdnFrontDoorCustomDomainCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorCustomDomainsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	profileId, err := parse.ProfileID(d.Get("cdn_frontdoor_profile_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewFrontDoorCustomDomainID(profileId.SubscriptionId, profileId.ResourceGroup, profileId.Name, d.Get("name").(string))
+
+	existing, err := client.Get(ctx, id.ResourceGroup, id.ProfileName, id.CustomDomainName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+
+	if !utils.ResponseWasNotFound(existing.Response) {
+		return tf.ImportAsExistsError("azurerm_cdn_frontdoor_custom_domain", id.ID())
+	}
+
+	props := cdn.AFDDomain{
+		AFDDomainProperties: &cdn.AFDDomainProperties{
+			AzureDNSZone: expandCdnFrontDoorCustomDomainDnsZone(d.Get("dns_zone_id").(string)),
+			HostName:     utils.String(d.Get("host_name").(string)),
+			TLSSettings:  expandCdnFrontDoorCustomDomainTlsSettings(d.Get("tls").([]interface{})),
+		},
+	}
+
+	future, err := client.Create(ctx, id.ResourceGroup, id.ProfileName, id.CustomDomainName, props)
+	if err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for creation of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	if d.Get("manage_validation_record").(bool) {
+		if err := validateCdnFrontDoorCustomDomainUsingDnsZone(ctx, meta.(*clients.Client), id, d.Get("dns_zone_id").(string)); err != nil {
+			return err
+		}
+	}
+
+	return resourceCdnFrontDoorCustomDomainRead(d, meta)
+}
+
+func resourceCdnFrontDoorCustomDomainUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorCustomDomainsClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FrontDoorCustomDomainID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.HasChanges("dns_zone_id", "tls") {
+		props := cdn.AFDDomainUpdateParameters{
+			AFDDomainUpdatePropertiesParameters: &cdn.AFDDomainUpdatePropertiesParameters{
+				AzureDNSZone: expandCdnFrontDoorCustomDomainDnsZone(d.Get("dns_zone_id").(string)),
+				TLSSettings:  expandCdnFrontDoorCustomDomainTlsSettings(d.Get("tls").([]interface{})),
+			},
+		}
+
+		future, err := client.Update(ctx, id.ResourceGroup, id.ProfileName, id.CustomDomainName, props)
+		if err != nil {
+			return fmt.Errorf("updating %s: %+v", *id, err)
+		}
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for update of %s: %+v", *id, err)
+		}
+	}
+
+	// the `_dnsauth` TXT Record is no longer required in the previous DNS Zone when either the DNS Zone has changed
+	// or the Validation Record is no longer managed by Terraform
+	oldManaged, newManaged := d.GetChange("manage_validation_record")
+	oldDnsZoneId, newDnsZoneId := d.GetChange("dns_zone_id")
+	if oldManaged.(bool) && oldDnsZoneId.(string) != "" && (!newManaged.(bool) || !strings.EqualFold(oldDnsZoneId.(string), newDnsZoneId.(string))) {
+		if err := deleteCdnFrontDoorCustomDomainValidationRecord(ctx, meta.(*clients.Client), d.Get("host_name").(string), oldDnsZoneId.(string)); err != nil {
+			return err
+		}
+	}
+
+	if d.Get("manage_validation_record").(bool) {
+		if err := validateCdnFrontDoorCustomDomainUsingDnsZone(ctx, meta.(*clients.Client), *id, d.Get("dns_zone_id").(string)); err != nil {
+			return err
+		}
+	}
+
+	return resourceCdnFrontDoorCustomDomainRead(d, meta)
+}
+
+func resourceCdnFrontDoorCustomDomainRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorCustomDomainsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FrontDoorCustomDomainID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.ProfileName, id.CustomDomainName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state!", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.CustomDomainName)
+	d.Set("cdn_frontdoor_profile_id", parse.NewProfileID(id.SubscriptionId, id.ResourceGroup, id.ProfileName).ID())
+
+	if props := resp.AFDDomainProperties; props != nil {
+		d.Set("host_name", props.HostName)
+		d.Set("domain_validation_state", string(props.DomainValidationState))
+
+		dnsZoneId := ""
+		if props.AzureDNSZone != nil && props.AzureDNSZone.ID != nil {
+			dnsZoneId = *props.AzureDNSZone.ID
+		}
+		d.Set("dns_zone_id", dnsZoneId)
+
+		if err := d.Set("tls", flattenCdnFrontDoorCustomDomainTlsSettings(props.TLSSettings)); err != nil {
+			return fmt.Errorf("setting `tls`: %+v", err)
+		}
+
+		expirationDate := ""
+		validationToken := ""
+		if validation := props.ValidationProperties; validation != nil {
+			expirationDate = utils.NormalizeNilableString(validation.ExpirationDate)
+			validationToken = utils.NormalizeNilableString(validation.ValidationToken)
+		}
+		d.Set("expiration_date", expirationDate)
+		d.Set("validation_token", validationToken)
+	}
+
+	return nil
+}
+
+func resourceCdnFrontDoorCustomDomainDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorCustomDomainsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FrontDoorCustomDomainID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.ProfileName, id.CustomDomainName)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+	}
+
+	if d.Get("manage_validation_record").(bool) && d.Get("dns_zone_id").(string) != "" {
+		if err := deleteCdnFrontDoorCustomDomainValidationRecord(ctx, meta.(*clients.Client), d.Get("host_name").(string), d.Get("dns_zone_id").(string)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deleteCdnFrontDoorCustomDomainValidationRecord removes the `_dnsauth` TXT Record for the Host Name from the
+// specified DNS Zone, ignoring the TXT Record if it's already been removed
+func deleteCdnFrontDoorCustomDomainValidationRecord(ctx context.Context, client *clients.Client, hostName, dnsZoneIdRaw string) error {
+	recordSetsClient := client.Dns.RecordSetsClient
+
+	dnsZoneId, err := dnsParse.DnsZoneID(dnsZoneIdRaw)
+	if err != nil {
+		return err
+	}
+
+	recordName, err := cdnFrontDoorCustomDomainValidationRecordName(hostName, dnsZoneId.Name)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting the validation TXT Record %q within %s..", recordName, *dnsZoneId)
+	if resp, err := recordSetsClient.Delete(ctx, dnsZoneId.ResourceGroup, dnsZoneId.Name, recordName, dns.TXT, ""); err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("deleting the validation TXT Record %q within %s: %+v", recordName, *dnsZoneId, err)
+		}
+	}
+
+	return nil
+}
+
+// validateCdnFrontDoorCustomDomainUsingDnsZone creates (or updates) the `_dnsauth` TXT Record within the DNS Zone
+// using the current Validation Token for the Custom Domain - refreshing the Validation Token first where it has
+// expired - and then waits for the Custom Domain to be validated
+func validateCdnFrontDoorCustomDomainUsingDnsZone(ctx context.Context, client *clients.Client, id parse.FrontDoorCustomDomainId, dnsZoneIdRaw string) error {
+	domainsClient := client.Cdn.FrontDoorCustomDomainsClient
+	recordSetsClient := client.Dns.RecordSetsClient
+
+	dnsZoneId, err := dnsParse.DnsZoneID(dnsZoneIdRaw)
+	if err != nil {
+		return err
+	}
+
+	resp, err := domainsClient.Get(ctx, id.ResourceGroup, id.ProfileName, id.CustomDomainName)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	if resp.AFDDomainProperties == nil {
+		return fmt.Errorf("retrieving %s: `properties` was nil", id)
+	}
+	if resp.AFDDomainProperties.DomainValidationState == cdn.DomainValidationStateApproved {
+		return nil
+	}
+
+	recordName, err := cdnFrontDoorCustomDomainValidationRecordName(utils.NormalizeNilableString(resp.AFDDomainProperties.HostName), dnsZoneId.Name)
+	if err != nil {
+		return err
+	}
+
+	if cdnFrontDoorCustomDomainValidationTokenExpired(resp.AFDDomainProperties) {
+		log.Printf("[DEBUG] Refreshing the Validation Token for %s..", id)
+		future, err := domainsClient.RefreshValidationToken(ctx, id.ResourceGroup, id.ProfileName, id.CustomDomainName)
+		if err != nil {
+			return fmt.Errorf("refreshing the Validation Token for %s: %+v", id, err)
+		}
+		if err := future.WaitForCompletionRef(ctx, domainsClient.Client); err != nil {
+			return fmt.Errorf("waiting for the Validation Token for %s to be refreshed: %+v", id, err)
+		}
+
+		resp, err = domainsClient.Get(ctx, id.ResourceGroup, id.ProfileName, id.CustomDomainName)
+		if err != nil {
+			return fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+		if resp.AFDDomainProperties == nil {
+			return fmt.Errorf("retrieving %s: `properties` was nil", id)
+		}
+	}
+
+	validationToken := ""
+	if props := resp.AFDDomainProperties.ValidationProperties; props != nil {
+		validationToken = utils.NormalizeNilableString(props.ValidationToken)
+	}
+	if validationToken == "" {
+		return fmt.Errorf("retrieving %s: `validationProperties.validationToken` was nil", id)
+	}
+
+	log.Printf("[DEBUG] Creating/updating the validation TXT Record %q within %s..", recordName, *dnsZoneId)
+	recordSet := dns.RecordSet{
+		Name: utils.String(recordName),
+		RecordSetProperties: &dns.RecordSetProperties{
+			TTL: utils.Int64(cdnFrontDoorCustomDomainValidationRecordTTL),
+			TxtRecords: &[]dns.TxtRecord{
+				{
+					Value: &[]string{validationToken},
+				},
+			},
+		},
+	}
+	if _, err := recordSetsClient.CreateOrUpdate(ctx, dnsZoneId.ResourceGroup, dnsZoneId.Name, recordName, dns.TXT, recordSet, "", ""); err != nil {
+		return fmt.Errorf("creating/updating the validation TXT Record %q within %s: %+v", recordName, *dnsZoneId, err)
+	}
+
+	log.Printf("[DEBUG] Waiting for %s to be validated..", id)
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("context had no deadline")
+	}
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending: []string{
+			"",
+			string(cdn.DomainValidationStateUnknown),
+			string(cdn.DomainValidationStateSubmitting),
+			string(cdn.DomainValidationStatePending),
+			string(cdn.DomainValidationStatePendingRevalidation),
+		},
+		Target:     []string{string(cdn.DomainValidationStateApproved)},
+		MinTimeout: 30 * time.Second,
+		Timeout:    time.Until(deadline),
+		Refresh: func() (interface{}, string, error) {
+			resp, err := domainsClient.Get(ctx, id.ResourceGroup, id.ProfileName, id.CustomDomainName)
+			if err != nil {
+				return nil, "", fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+			if resp.AFDDomainProperties == nil {
+				return nil, "", fmt.Errorf("retrieving %s: `properties` was nil", id)
+			}
+
+			return resp, string(resp.AFDDomainProperties.DomainValidationState), nil
+		},
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for %s to be validated: %+v", id, err)
+	}
+
+	return nil
+}
+
+// cdnFrontDoorCustomDomainValidationRecordName returns the name of the `_dnsauth` TXT Record (relative to the
+// DNS Zone) used to validate the Host Name of the Custom Domain
+func cdnFrontDoorCustomDomainValidationRecordName(hostName, zoneName string) (string, error) {
+	hostName = strings.TrimSuffix(strings.ToLower(hostName), ".")
+	zoneName = strings.TrimSuffix(strings.ToLower(zoneName), ".")
+
+	if hostName == zoneName {
+		return "_dnsauth", nil
+	}
+
+	if suffix := "." + zoneName; strings.HasSuffix(hostName, suffix) {
+		return fmt.Sprintf("_dnsauth.%s", strings.TrimSuffix(hostName, suffix)), nil
+	}
+
+	return "", fmt.Errorf("the Host Name %q is not within the DNS Zone %q", hostName, zoneName)
+}
+
+// cdnFrontDoorCustomDomainValidationTokenExpired returns whether a new Validation Token needs to be generated
+// before the Custom Domain can be validated
+func cdnFrontDoorCustomDomainValidationTokenExpired(input *cdn.AFDDomainProperties) bool {
+	if input.DomainValidationState == cdn.DomainValidationStateTimedOut {
+		return true
+	}
+
+	if input.ValidationProperties == nil || input.ValidationProperties.ValidationToken == nil || *input.ValidationProperties.ValidationToken == "" {
+		return true
+	}
+
+	if v := input.ValidationProperties.ExpirationDate; v != nil {
+		expirationDate, err := time.Parse(time.RFC3339, *v)
+		if err == nil && expirationDate.Before(time.Now()) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func expandCdnFrontDoorCustomDomainDnsZone(input string) *cdn.ResourceReference {
+	if input == "" {
+		return nil
+	}
+
+	return &cdn.ResourceReference{
+		ID: utils.String(input),
+	}
+}
+
+func expandCdnFrontDoorCustomDomainTlsSettings(input []interface{}) *cdn.AFDDomainHTTPSParameters {
+	if len(input) == 0 || input[0] == nil {
+		return &cdn.AFDDomainHTTPSParameters{
+			CertificateType:   cdn.AfdCertificateTypeManagedCertificate,
+			MinimumTLSVersion: cdn.AfdMinimumTLSVersionTLS12,
+		}
+	}
+
+	raw := input[0].(map[string]interface{})
+	return &cdn.AFDDomainHTTPSParameters{
+		CertificateType:   cdn.AfdCertificateType(raw["certificate_type"].(string)),
+		MinimumTLSVersion: cdn.AfdMinimumTLSVersion(raw["minimum_tls_version"].(string)),
+	}
+}
+
+func flattenCdnFrontDoorCustomDomainTlsSettings(input *cdn.AFDDomainHTTPSParameters) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"certificate_type":    string(input.CertificateType),
+			"minimum_tls_version": string(input.MinimumTLSVersion),
+		},
+	}
+}
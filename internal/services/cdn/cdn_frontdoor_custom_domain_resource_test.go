@@ -0,0 +1,244 @@
+package cdn_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/dns/mgmt/2018-05-01/dns"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type CdnFrontDoorCustomDomainResource struct {
+	DNSZoneRG     string
+	DNSZoneName   string
+	SubDomainName string
+}
+
+func NewCdnFrontDoorCustomDomainResource(dnsZoneRg, dnsZoneName string) *CdnFrontDoorCustomDomainResource {
+	return &CdnFrontDoorCustomDomainResource{
+		DNSZoneRG:     dnsZoneRg,
+		DNSZoneName:   dnsZoneName,
+		SubDomainName: acceptance.RandString(3),
+	}
+}
+
+func TestAccCdnFrontDoorCustomDomain_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cdn_frontdoor_custom_domain", "test")
+
+	r := NewCdnFrontDoorCustomDomainResource(os.Getenv("ARM_TEST_DNS_ZONE_RESOURCE_GROUP_NAME"), os.Getenv("ARM_TEST_DNS_ZONE_NAME"))
+	r.preCheck(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("validation_token").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccCdnFrontDoorCustomDomain_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cdn_frontdoor_custom_domain", "test")
+
+	r := NewCdnFrontDoorCustomDomainResource(os.Getenv("ARM_TEST_DNS_ZONE_RESOURCE_GROUP_NAME"), os.Getenv("ARM_TEST_DNS_ZONE_NAME"))
+	r.preCheck(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccCdnFrontDoorCustomDomain_manageValidationRecord(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cdn_frontdoor_custom_domain", "test")
+
+	r := NewCdnFrontDoorCustomDomainResource(os.Getenv("ARM_TEST_DNS_ZONE_RESOURCE_GROUP_NAME"), os.Getenv("ARM_TEST_DNS_ZONE_NAME"))
+	r.preCheck(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.manageValidationRecord(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("domain_validation_state").HasValue("Approved"),
+			),
+		},
+		data.ImportStep("manage_validation_record"),
+	})
+}
+
+func TestAccCdnFrontDoorCustomDomain_manageValidationRecordToggled(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cdn_frontdoor_custom_domain", "test")
+
+	r := NewCdnFrontDoorCustomDomainResource(os.Getenv("ARM_TEST_DNS_ZONE_RESOURCE_GROUP_NAME"), os.Getenv("ARM_TEST_DNS_ZONE_NAME"))
+	r.preCheck(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.manageValidationRecord(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				data.CheckWithClient(r.validationRecordExists(true)),
+			),
+		},
+		data.ImportStep("manage_validation_record"),
+		{
+			Config: r.unmanagedValidationRecord(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				data.CheckWithClient(r.validationRecordExists(false)),
+			),
+		},
+		data.ImportStep("manage_validation_record"),
+	})
+}
+
+func (r CdnFrontDoorCustomDomainResource) preCheck(t *testing.T) {
+	if r.DNSZoneRG == "" {
+		t.Skipf("`ARM_TEST_DNS_ZONE_RESOURCE_GROUP_NAME` must be set for acceptance tests!")
+	}
+	if r.DNSZoneName == "" {
+		t.Skipf("`ARM_TEST_DNS_ZONE_NAME` must be set for acceptance tests!")
+	}
+}
+
+func (r CdnFrontDoorCustomDomainResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.FrontDoorCustomDomainID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Cdn.FrontDoorCustomDomainsClient.Get(ctx, id.ResourceGroup, id.ProfileName, id.CustomDomainName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.AFDDomainProperties != nil), nil
+}
+
+func (r CdnFrontDoorCustomDomainResource) validationRecordExists(shouldExist bool) acceptance.ClientCheckFunc {
+	return func(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) error {
+		recordName := fmt.Sprintf("_dnsauth.%s", r.SubDomainName)
+		resp, err := client.Dns.RecordSetsClient.Get(ctx, r.DNSZoneRG, r.DNSZoneName, recordName, dns.TXT)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				if shouldExist {
+					return fmt.Errorf("the validation TXT Record %q was not found within the DNS Zone %q", recordName, r.DNSZoneName)
+				}
+				return nil
+			}
+			return fmt.Errorf("retrieving the validation TXT Record %q: %+v", recordName, err)
+		}
+
+		if !shouldExist {
+			return fmt.Errorf("the validation TXT Record %q still exists within the DNS Zone %q", recordName, r.DNSZoneName)
+		}
+
+		return nil
+	}
+}
+
+func (r CdnFrontDoorCustomDomainResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cdn_frontdoor_custom_domain" "test" {
+  name                     = "acctest-customdomain-%d"
+  cdn_frontdoor_profile_id = azurerm_cdn_profile.test.id
+  host_name                = "%s.${data.azurerm_dns_zone.test.name}"
+}
+`, r.template(data), data.RandomInteger, r.SubDomainName)
+}
+
+func (r CdnFrontDoorCustomDomainResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cdn_frontdoor_custom_domain" "import" {
+  name                     = azurerm_cdn_frontdoor_custom_domain.test.name
+  cdn_frontdoor_profile_id = azurerm_cdn_frontdoor_custom_domain.test.cdn_frontdoor_profile_id
+  host_name                = azurerm_cdn_frontdoor_custom_domain.test.host_name
+}
+`, r.basic(data))
+}
+
+func (r CdnFrontDoorCustomDomainResource) manageValidationRecord(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cdn_frontdoor_custom_domain" "test" {
+  name                     = "acctest-customdomain-%d"
+  cdn_frontdoor_profile_id = azurerm_cdn_profile.test.id
+  host_name                = "%s.${data.azurerm_dns_zone.test.name}"
+  dns_zone_id              = data.azurerm_dns_zone.test.id
+  manage_validation_record = true
+
+  tls {
+    certificate_type    = "ManagedCertificate"
+    minimum_tls_version = "TLS12"
+  }
+}
+`, r.template(data), data.RandomInteger, r.SubDomainName)
+}
+
+func (r CdnFrontDoorCustomDomainResource) unmanagedValidationRecord(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cdn_frontdoor_custom_domain" "test" {
+  name                     = "acctest-customdomain-%d"
+  cdn_frontdoor_profile_id = azurerm_cdn_profile.test.id
+  host_name                = "%s.${data.azurerm_dns_zone.test.name}"
+  dns_zone_id              = data.azurerm_dns_zone.test.id
+  manage_validation_record = false
+
+  tls {
+    certificate_type    = "ManagedCertificate"
+    minimum_tls_version = "TLS12"
+  }
+}
+`, r.template(data), data.RandomInteger, r.SubDomainName)
+}
+
+func (r CdnFrontDoorCustomDomainResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-cdn-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_cdn_profile" "test" {
+  name                = "acctestcdnprof%[1]d"
+  location            = "global"
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "Standard_AzureFrontDoor"
+}
+
+data "azurerm_dns_zone" "test" {
+  name                = "%[3]s"
+  resource_group_name = "%[4]s"
+}
+`, data.RandomInteger, data.Locations.Primary, r.DNSZoneName, r.DNSZoneRG)
+}
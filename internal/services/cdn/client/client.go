@@ -6,9 +6,10 @@ import (
 )
 
 type Client struct {
-	CustomDomainsClient *cdn.CustomDomainsClient
-	EndpointsClient     *cdn.EndpointsClient
-	ProfilesClient      *cdn.ProfilesClient
+	CustomDomainsClient          *cdn.CustomDomainsClient
+	EndpointsClient              *cdn.EndpointsClient
+	FrontDoorCustomDomainsClient *cdn.AFDCustomDomainsClient
+	ProfilesClient               *cdn.ProfilesClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -18,12 +19,16 @@ func NewClient(o *common.ClientOptions) *Client {
 	endpointsClient := cdn.NewEndpointsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&endpointsClient.Client, o.ResourceManagerAuthorizer)
 
+	frontDoorCustomDomainsClient := cdn.NewAFDCustomDomainsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&frontDoorCustomDomainsClient.Client, o.ResourceManagerAuthorizer)
+
 	profilesClient := cdn.NewProfilesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&profilesClient.Client, o.ResourceManagerAuthorizer)
 
 	return &Client{
-		CustomDomainsClient: &customDomainsClient,
-		EndpointsClient:     &endpointsClient,
-		ProfilesClient:      &profilesClient,
+		CustomDomainsClient:          &customDomainsClient,
+		EndpointsClient:              &endpointsClient,
+		FrontDoorCustomDomainsClient: &frontDoorCustomDomainsClient,
+		ProfilesClient:               &profilesClient,
 	}
 }
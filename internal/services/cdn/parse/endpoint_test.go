@@ -231,6 +231,17 @@ func TestEndpointIDInsensitively(t *testing.T) {
 				Name:           "endpoint1",
 			},
 		},
+
+		{
+			// upper-cased root segment names
+			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/Microsoft.Cdn/profiles/profile1/endpoints/endpoint1",
+			Expected: &EndpointId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "resGroup1",
+				ProfileName:    "profile1",
+				Name:           "endpoint1",
+			},
+		},
 	}
 
 	for _, v := range testData {
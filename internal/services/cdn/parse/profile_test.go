@@ -199,6 +199,16 @@ func TestProfileIDInsensitively(t *testing.T) {
 				Name:           "profile1",
 			},
 		},
+
+		{
+			// upper-cased root segment names
+			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/Microsoft.Cdn/profiles/profile1",
+			Expected: &ProfileId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "resGroup1",
+				Name:           "profile1",
+			},
+		},
 	}
 
 	for _, v := range testData {
@@ -28,8 +28,9 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 // SupportedResources returns the supported Resources supported by this Service
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurerm_cdn_endpoint":               resourceCdnEndpoint(),
-		"azurerm_cdn_endpoint_custom_domain": resourceArmCdnEndpointCustomDomain(),
-		"azurerm_cdn_profile":                resourceCdnProfile(),
+		"azurerm_cdn_endpoint":                resourceCdnEndpoint(),
+		"azurerm_cdn_endpoint_custom_domain":  resourceArmCdnEndpointCustomDomain(),
+		"azurerm_cdn_frontdoor_custom_domain": resourceCdnFrontDoorCustomDomain(),
+		"azurerm_cdn_profile":                 resourceCdnProfile(),
 	}
 }
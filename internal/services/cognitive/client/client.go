@@ -3,17 +3,29 @@ package client
 import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cognitive/sdk/2021-04-30/cognitiveservicesaccounts"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cognitive/sdk/2024-10-01/deployments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cognitive/sdk/2024-10-01/models"
 )
 
 type Client struct {
-	AccountsClient *cognitiveservicesaccounts.CognitiveServicesAccountsClient
+	AccountsClient    *cognitiveservicesaccounts.CognitiveServicesAccountsClient
+	DeploymentsClient *deployments.DeploymentsClient
+	ModelsClient      *models.ModelsClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
 	accountsClient := cognitiveservicesaccounts.NewCognitiveServicesAccountsClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&accountsClient.Client, o.ResourceManagerAuthorizer)
 
+	deploymentsClient := deployments.NewDeploymentsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&deploymentsClient.Client, o.ResourceManagerAuthorizer)
+
+	modelsClient := models.NewModelsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&modelsClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
-		AccountsClient: &accountsClient,
+		AccountsClient:    &accountsClient,
+		DeploymentsClient: &deploymentsClient,
+		ModelsClient:      &modelsClient,
 	}
 }
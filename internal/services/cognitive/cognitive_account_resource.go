@@ -12,9 +12,12 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	commonValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/identity"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/networkrules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cognitive/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cognitive/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cognitive/sdk/2021-04-30/cognitiveservicesaccounts"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cognitive/validate"
@@ -32,7 +35,7 @@ import (
 )
 
 func resourceCognitiveAccount() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceCognitiveAccountCreate,
 		Read:   resourceCognitiveAccountRead,
 		Update: resourceCognitiveAccountUpdate,
@@ -90,6 +93,7 @@ func resourceCognitiveAccount() *pluginsdk.Resource {
 					"LUIS",
 					"LUIS.Authoring",
 					"MetricsAdvisor",
+					"OpenAI",
 					"Personalizer",
 					"QnAMaker",
 					"Recommendations",
@@ -270,11 +274,7 @@ func resourceCognitiveAccount() *pluginsdk.Resource {
 				Default:  false,
 			},
 
-			"public_network_access_enabled": {
-				Type:     pluginsdk.TypeBool,
-				Optional: true,
-				Default:  true,
-			},
+			"public_network_access_enabled": networkrules.PublicNetworkAccessEnabledSchema(),
 
 			"qna_runtime_endpoint": {
 				Type:         pluginsdk.TypeString,
@@ -322,6 +322,23 @@ func resourceCognitiveAccount() *pluginsdk.Resource {
 			},
 		},
 	}
+
+	// TODO: (v3.0) Add the following to the migration guide:
+	// *Breaking Change* In this version the `network_acls` block is renamed to `network_rules` and the deprecated
+	// `virtual_network_subnet_ids` field within it is removed in favour of `virtual_network_rules`.
+	if features.ThreePointOh() {
+		networkRules := cognitiveAccountNetworkRules.Schema()
+		networkRules.RequiredWith = []string{"custom_subdomain_name"}
+
+		delete(resource.Schema, "network_acls")
+		resource.Schema["network_rules"] = networkRules
+		resource.SchemaVersion = 1
+		resource.StateUpgraders = pluginsdk.StateUpgrades(map[int]pluginsdk.StateUpgrade{
+			0: migration.AccountV0ToV1{},
+		})
+	}
+
+	return resource
 }
 
 func resourceCognitiveAccountCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -351,7 +368,7 @@ func resourceCognitiveAccountCreate(d *pluginsdk.ResourceData, meta interface{})
 		return fmt.Errorf("expanding sku_name for %s: %v", id, err)
 	}
 
-	networkAcls, subnetIds := expandCognitiveAccountNetworkAcls(d)
+	networkAcls, subnetIds := expandCognitiveAccountNetworkRules(d)
 
 	// also lock on the Virtual Network ID's since modifications in the networking stack are exclusive
 	virtualNetworkNames := make([]string, 0)
@@ -436,7 +453,7 @@ func resourceCognitiveAccountUpdate(d *pluginsdk.ResourceData, meta interface{})
 		return fmt.Errorf("expanding sku_name for %s: %+v", *id, err)
 	}
 
-	networkAcls, subnetIds := expandCognitiveAccountNetworkAcls(d)
+	networkAcls, subnetIds := expandCognitiveAccountNetworkRules(d)
 
 	// also lock on the Virtual Network ID's since modifications in the networking stack are exclusive
 	virtualNetworkNames := make([]string, 0)
@@ -561,8 +578,14 @@ func resourceCognitiveAccountRead(d *pluginsdk.ResourceData, meta interface{}) e
 			}
 			d.Set("endpoint", props.Endpoint)
 			d.Set("custom_subdomain_name", props.CustomSubDomainName)
-			if err := d.Set("network_acls", flattenCognitiveAccountNetworkAcls(props.NetworkAcls)); err != nil {
-				return fmt.Errorf("setting `network_acls` for Cognitive Account %q: %+v", id, err)
+			if features.ThreePointOh() {
+				if err := d.Set("network_rules", flattenCognitiveAccountNetworkRules(props.NetworkAcls)); err != nil {
+					return fmt.Errorf("setting `network_rules` for Cognitive Account %q: %+v", id, err)
+				}
+			} else {
+				if err := d.Set("network_acls", flattenCognitiveAccountNetworkAcls(props.NetworkAcls)); err != nil {
+					return fmt.Errorf("setting `network_acls` for Cognitive Account %q: %+v", id, err)
+				}
 			}
 			d.Set("fqdns", utils.FlattenStringSlice(props.AllowedFqdnList))
 
@@ -668,6 +691,47 @@ func cognitiveAccountStateRefreshFunc(ctx context.Context, client *cognitiveserv
 	}
 }
 
+var cognitiveAccountNetworkRules = networkrules.Options{
+	VirtualNetworkRules: true,
+}
+
+func expandCognitiveAccountNetworkRules(d *pluginsdk.ResourceData) (*cognitiveservicesaccounts.NetworkRuleSet, []string) {
+	if !features.ThreePointOh() {
+		return expandCognitiveAccountNetworkAcls(d)
+	}
+
+	subnetIds := make([]string, 0)
+	rules := cognitiveAccountNetworkRules.Expand(d.Get("network_rules").([]interface{}))
+	if rules == nil {
+		return nil, subnetIds
+	}
+
+	defaultAction := cognitiveservicesaccounts.NetworkRuleAction(rules.DefaultAction)
+
+	ipRules := make([]cognitiveservicesaccounts.IpRule, 0)
+	for _, v := range rules.IPRules {
+		ipRules = append(ipRules, cognitiveservicesaccounts.IpRule{
+			Value: v,
+		})
+	}
+
+	networkRules := make([]cognitiveservicesaccounts.VirtualNetworkRule, 0)
+	for _, v := range rules.VirtualNetworkRules {
+		subnetIds = append(subnetIds, v.SubnetId)
+		networkRules = append(networkRules, cognitiveservicesaccounts.VirtualNetworkRule{
+			Id:                               v.SubnetId,
+			IgnoreMissingVnetServiceEndpoint: utils.Bool(v.IgnoreMissingVnetServiceEndpoint),
+		})
+	}
+
+	ruleSet := cognitiveservicesaccounts.NetworkRuleSet{
+		DefaultAction:       &defaultAction,
+		IpRules:             &ipRules,
+		VirtualNetworkRules: &networkRules,
+	}
+	return &ruleSet, subnetIds
+}
+
 func expandCognitiveAccountNetworkAcls(d *pluginsdk.ResourceData) (*cognitiveservicesaccounts.NetworkRuleSet, []string) {
 	input := d.Get("network_acls").([]interface{})
 	subnetIds := make([]string, 0)
@@ -816,6 +880,40 @@ func expandCognitiveAccountAPIProperties(d *pluginsdk.ResourceData) (*cognitives
 	return &props, nil
 }
 
+func flattenCognitiveAccountNetworkRules(input *cognitiveservicesaccounts.NetworkRuleSet) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	rules := networkrules.NetworkRules{
+		IPRules:             make([]string, 0),
+		VirtualNetworkRules: make([]networkrules.VirtualNetworkRule, 0),
+	}
+	if input.DefaultAction != nil {
+		rules.DefaultAction = string(*input.DefaultAction)
+	}
+	if input.IpRules != nil {
+		for _, v := range *input.IpRules {
+			rules.IPRules = append(rules.IPRules, v.Value)
+		}
+	}
+	if input.VirtualNetworkRules != nil {
+		for _, v := range *input.VirtualNetworkRules {
+			ignoreMissingVnetServiceEndpoint := false
+			if v.IgnoreMissingVnetServiceEndpoint != nil {
+				ignoreMissingVnetServiceEndpoint = *v.IgnoreMissingVnetServiceEndpoint
+			}
+
+			rules.VirtualNetworkRules = append(rules.VirtualNetworkRules, networkrules.VirtualNetworkRule{
+				SubnetId:                         v.Id,
+				IgnoreMissingVnetServiceEndpoint: ignoreMissingVnetServiceEndpoint,
+			})
+		}
+	}
+
+	return cognitiveAccountNetworkRules.Flatten(&rules)
+}
+
 func flattenCognitiveAccountNetworkAcls(input *cognitiveservicesaccounts.NetworkRuleSet) []interface{} {
 	if input == nil {
 		return []interface{}{}
@@ -0,0 +1,436 @@
+package cognitive
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cognitive/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cognitive/sdk/2024-10-01/deployments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cognitive/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.ResourceWithUpdate = CognitiveDeploymentResource{}
+
+type CognitiveDeploymentResource struct{}
+
+type CognitiveDeploymentResourceModel struct {
+	Name                     string                     `tfschema:"name"`
+	CognitiveAccountId       string                     `tfschema:"cognitive_account_id"`
+	Model                    []CognitiveDeploymentModel `tfschema:"model"`
+	Sku                      []CognitiveDeploymentSku   `tfschema:"sku"`
+	RaiPolicyName            string                     `tfschema:"rai_policy_name"`
+	VersionUpgradeOption     string                     `tfschema:"version_upgrade_option"`
+	DynamicThrottlingEnabled bool                       `tfschema:"dynamic_throttling_enabled"`
+	CurrentCapacity          int                        `tfschema:"current_capacity"`
+}
+
+type CognitiveDeploymentModel struct {
+	Format  string `tfschema:"format"`
+	Name    string `tfschema:"name"`
+	Version string `tfschema:"version"`
+}
+
+type CognitiveDeploymentSku struct {
+	Name     string `tfschema:"name"`
+	Tier     string `tfschema:"tier"`
+	Size     string `tfschema:"size"`
+	Family   string `tfschema:"family"`
+	Capacity int    `tfschema:"capacity"`
+}
+
+func (r CognitiveDeploymentResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]{1,63}$`),
+				"`name` must be between 2 and 64 characters, can only contain alphanumeric characters, underscores, periods and hyphens, and must start with an alphanumeric character",
+			),
+		},
+
+		"cognitive_account_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.AccountID,
+		},
+
+		"model": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"format": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"version": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"sku": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+						ForceNew: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							"Standard",
+							"DataZoneStandard",
+							"GlobalStandard",
+							"GlobalBatch",
+							"ProvisionedManaged",
+							"DataZoneProvisionedManaged",
+							"GlobalProvisionedManaged",
+						}, false),
+					},
+
+					"tier": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringInSlice([]string{"Free", "Basic", "Standard", "Premium", "Enterprise"}, false),
+					},
+
+					"size": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"family": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					// the capacity is the Tokens-Per-Minute (in thousands) for the Standard SKUs and the number of
+					// Provisioned Throughput Units for the Provisioned SKUs - both of which can be scaled in place
+					"capacity": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      1,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+				},
+			},
+		},
+
+		"rai_policy_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"version_upgrade_option": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      string(deployments.DeploymentModelVersionUpgradeOptionOnceNewDefaultVersionAvailable),
+			ValidateFunc: validation.StringInSlice(deployments.PossibleValuesForDeploymentModelVersionUpgradeOption(), false),
+		},
+
+		"dynamic_throttling_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+	}
+}
+
+func (r CognitiveDeploymentResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"current_capacity": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+	}
+}
+
+func (r CognitiveDeploymentResource) ModelObject() interface{} {
+	return &CognitiveDeploymentResourceModel{}
+}
+
+func (r CognitiveDeploymentResource) ResourceType() string {
+	return "azurerm_cognitive_deployment"
+}
+
+func (r CognitiveDeploymentResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return deployments.ValidateDeploymentID
+}
+
+func (r CognitiveDeploymentResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cognitive.DeploymentsClient
+
+			var model CognitiveDeploymentResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			accountId, err := parse.AccountID(model.CognitiveAccountId)
+			if err != nil {
+				return err
+			}
+
+			id := deployments.NewDeploymentID(accountId.SubscriptionId, accountId.ResourceGroup, accountId.Name, model.Name)
+
+			// the API only allows a single Deployment within an Account to be modified at once
+			locks.ByID(accountId.ID())
+			defer locks.UnlockByID(accountId.ID())
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, expandCognitiveDeployment(model)); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r CognitiveDeploymentResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cognitive.DeploymentsClient
+
+			id, err := deployments.ParseDeploymentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := CognitiveDeploymentResourceModel{
+				Name:               id.DeploymentName,
+				CognitiveAccountId: parse.NewAccountID(id.SubscriptionId, id.ResourceGroupName, id.AccountName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				state.Sku = flattenCognitiveDeploymentSku(model.Sku)
+
+				if props := model.Properties; props != nil {
+					state.Model = flattenCognitiveDeploymentModel(props.Model)
+					state.RaiPolicyName = utils.NormalizeNilableString(props.RaiPolicyName)
+
+					if props.VersionUpgradeOption != nil {
+						state.VersionUpgradeOption = string(*props.VersionUpgradeOption)
+					}
+					if props.DynamicThrottlingEnabled != nil {
+						state.DynamicThrottlingEnabled = *props.DynamicThrottlingEnabled
+					}
+					if props.CurrentCapacity != nil {
+						state.CurrentCapacity = int(*props.CurrentCapacity)
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r CognitiveDeploymentResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cognitive.DeploymentsClient
+
+			id, err := deployments.ParseDeploymentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model CognitiveDeploymentResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			accountId := parse.NewAccountID(id.SubscriptionId, id.ResourceGroupName, id.AccountName)
+			locks.ByID(accountId.ID())
+			defer locks.UnlockByID(accountId.ID())
+
+			// scaling the capacity on its own is done via a PATCH so that the model & policy aren't re-sent
+			if !metadata.ResourceData.HasChanges("model", "rai_policy_name", "version_upgrade_option", "dynamic_throttling_enabled") {
+				payload := deployments.PatchResourceTagsAndSku{
+					Sku: expandCognitiveDeploymentSku(model.Sku),
+				}
+				if err := client.UpdateThenPoll(ctx, *id, payload); err != nil {
+					return fmt.Errorf("updating the capacity for %s: %+v", *id, err)
+				}
+
+				return nil
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, expandCognitiveDeployment(model)); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r CognitiveDeploymentResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cognitive.DeploymentsClient
+
+			id, err := deployments.ParseDeploymentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			accountId := parse.NewAccountID(id.SubscriptionId, id.ResourceGroupName, id.AccountName)
+			locks.ByID(accountId.ID())
+			defer locks.UnlockByID(accountId.ID())
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandCognitiveDeployment(input CognitiveDeploymentResourceModel) deployments.Deployment {
+	versionUpgradeOption := deployments.DeploymentModelVersionUpgradeOption(input.VersionUpgradeOption)
+	output := deployments.Deployment{
+		Properties: &deployments.DeploymentProperties{
+			DynamicThrottlingEnabled: utils.Bool(input.DynamicThrottlingEnabled),
+			Model:                    expandCognitiveDeploymentModel(input.Model),
+			VersionUpgradeOption:     &versionUpgradeOption,
+		},
+		Sku: expandCognitiveDeploymentSku(input.Sku),
+	}
+
+	if input.RaiPolicyName != "" {
+		output.Properties.RaiPolicyName = utils.String(input.RaiPolicyName)
+	}
+
+	return output
+}
+
+func expandCognitiveDeploymentModel(input []CognitiveDeploymentModel) *deployments.DeploymentModel {
+	if len(input) == 0 {
+		return nil
+	}
+
+	v := input[0]
+	output := deployments.DeploymentModel{
+		Format: utils.String(v.Format),
+		Name:   utils.String(v.Name),
+	}
+	if v.Version != "" {
+		output.Version = utils.String(v.Version)
+	}
+
+	return &output
+}
+
+func flattenCognitiveDeploymentModel(input *deployments.DeploymentModel) []CognitiveDeploymentModel {
+	if input == nil {
+		return []CognitiveDeploymentModel{}
+	}
+
+	return []CognitiveDeploymentModel{
+		{
+			Format:  utils.NormalizeNilableString(input.Format),
+			Name:    utils.NormalizeNilableString(input.Name),
+			Version: utils.NormalizeNilableString(input.Version),
+		},
+	}
+}
+
+func expandCognitiveDeploymentSku(input []CognitiveDeploymentSku) *deployments.Sku {
+	if len(input) == 0 {
+		return nil
+	}
+
+	v := input[0]
+	output := deployments.Sku{
+		Name:     v.Name,
+		Capacity: utils.Int64(int64(v.Capacity)),
+	}
+	if v.Tier != "" {
+		output.Tier = utils.String(v.Tier)
+	}
+	if v.Size != "" {
+		output.Size = utils.String(v.Size)
+	}
+	if v.Family != "" {
+		output.Family = utils.String(v.Family)
+	}
+
+	return &output
+}
+
+func flattenCognitiveDeploymentSku(input *deployments.Sku) []CognitiveDeploymentSku {
+	if input == nil {
+		return []CognitiveDeploymentSku{}
+	}
+
+	output := CognitiveDeploymentSku{
+		Name:   input.Name,
+		Tier:   utils.NormalizeNilableString(input.Tier),
+		Size:   utils.NormalizeNilableString(input.Size),
+		Family: utils.NormalizeNilableString(input.Family),
+	}
+	if input.Capacity != nil {
+		output.Capacity = int(*input.Capacity)
+	}
+
+	return []CognitiveDeploymentSku{output}
+}
@@ -0,0 +1,277 @@
+package cognitive
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cognitive/sdk/2024-10-01/models"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.DataSource = CognitiveModelsDataSource{}
+
+type CognitiveModelsDataSource struct{}
+
+type CognitiveModelsDataSourceModel struct {
+	Location string                     `tfschema:"location"`
+	Kind     string                     `tfschema:"kind"`
+	Models   []CognitiveModelsDataModel `tfschema:"models"`
+}
+
+type CognitiveModelsDataModel struct {
+	Kind                 string                   `tfschema:"kind"`
+	AccountSkuName       string                   `tfschema:"account_sku_name"`
+	Format               string                   `tfschema:"format"`
+	Name                 string                   `tfschema:"name"`
+	Version              string                   `tfschema:"version"`
+	LifecycleStatus      string                   `tfschema:"lifecycle_status"`
+	DefaultVersion       bool                     `tfschema:"default_version"`
+	MaxCapacity          int                      `tfschema:"max_capacity"`
+	FineTuneDeprecation  string                   `tfschema:"fine_tune_deprecation_date"`
+	InferenceDeprecation string                   `tfschema:"inference_deprecation_date"`
+	Capabilities         map[string]string        `tfschema:"capabilities"`
+	Sku                  []CognitiveModelsDataSku `tfschema:"sku"`
+}
+
+type CognitiveModelsDataSku struct {
+	Name            string `tfschema:"name"`
+	UsageName       string `tfschema:"usage_name"`
+	DeprecationDate string `tfschema:"deprecation_date"`
+	DefaultCapacity int    `tfschema:"default_capacity"`
+	MinimumCapacity int    `tfschema:"minimum_capacity"`
+	MaximumCapacity int    `tfschema:"maximum_capacity"`
+	CapacityStep    int    `tfschema:"capacity_step"`
+}
+
+func (d CognitiveModelsDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"location": location.SchemaWithoutForceNew(),
+
+		"kind": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (d CognitiveModelsDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"models": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"kind": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"account_sku_name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"format": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"version": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"lifecycle_status": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"default_version": {
+						Type:     pluginsdk.TypeBool,
+						Computed: true,
+					},
+
+					"max_capacity": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"fine_tune_deprecation_date": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"inference_deprecation_date": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"capabilities": {
+						Type:     pluginsdk.TypeMap,
+						Computed: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+
+					"sku": {
+						Type:     pluginsdk.TypeList,
+						Computed: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"name": {
+									Type:     pluginsdk.TypeString,
+									Computed: true,
+								},
+
+								"usage_name": {
+									Type:     pluginsdk.TypeString,
+									Computed: true,
+								},
+
+								"deprecation_date": {
+									Type:     pluginsdk.TypeString,
+									Computed: true,
+								},
+
+								"default_capacity": {
+									Type:     pluginsdk.TypeInt,
+									Computed: true,
+								},
+
+								"minimum_capacity": {
+									Type:     pluginsdk.TypeInt,
+									Computed: true,
+								},
+
+								"maximum_capacity": {
+									Type:     pluginsdk.TypeInt,
+									Computed: true,
+								},
+
+								"capacity_step": {
+									Type:     pluginsdk.TypeInt,
+									Computed: true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d CognitiveModelsDataSource) ModelObject() interface{} {
+	return &CognitiveModelsDataSourceModel{}
+}
+
+func (d CognitiveModelsDataSource) ResourceType() string {
+	return "azurerm_cognitive_models"
+}
+
+func (d CognitiveModelsDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cognitive.ModelsClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var state CognitiveModelsDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := models.NewLocationID(subscriptionId, location.Normalize(state.Location))
+
+			predicate := models.ModelPredicate{}
+			if state.Kind != "" {
+				predicate.Kind = utils.String(state.Kind)
+			}
+
+			resp, err := client.ListCompleteMatchingPredicate(ctx, id, predicate)
+			if err != nil {
+				return fmt.Errorf("listing models for %s: %+v", id, err)
+			}
+
+			state.Location = location.Normalize(state.Location)
+			state.Models = flattenCognitiveModels(resp.Items)
+
+			metadata.SetID(id)
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func flattenCognitiveModels(input []models.Model) []CognitiveModelsDataModel {
+	output := make([]CognitiveModelsDataModel, 0)
+	for _, item := range input {
+		v := CognitiveModelsDataModel{
+			Kind:           utils.NormalizeNilableString(item.Kind),
+			AccountSkuName: utils.NormalizeNilableString(item.SkuName),
+			Capabilities:   map[string]string{},
+			Sku:            []CognitiveModelsDataSku{},
+		}
+
+		if model := item.Model; model != nil {
+			v.Format = utils.NormalizeNilableString(model.Format)
+			v.Name = utils.NormalizeNilableString(model.Name)
+			v.Version = utils.NormalizeNilableString(model.Version)
+			v.LifecycleStatus = utils.NormalizeNilableString(model.LifecycleStatus)
+
+			if model.IsDefaultVersion != nil {
+				v.DefaultVersion = *model.IsDefaultVersion
+			}
+			if model.MaxCapacity != nil {
+				v.MaxCapacity = int(*model.MaxCapacity)
+			}
+			if model.Capabilities != nil {
+				v.Capabilities = *model.Capabilities
+			}
+			if deprecation := model.Deprecation; deprecation != nil {
+				v.FineTuneDeprecation = utils.NormalizeNilableString(deprecation.FineTune)
+				v.InferenceDeprecation = utils.NormalizeNilableString(deprecation.Inference)
+			}
+
+			if model.Skus != nil {
+				for _, sku := range *model.Skus {
+					s := CognitiveModelsDataSku{
+						Name:            utils.NormalizeNilableString(sku.Name),
+						UsageName:       utils.NormalizeNilableString(sku.UsageName),
+						DeprecationDate: utils.NormalizeNilableString(sku.DeprecationDate),
+					}
+					if capacity := sku.Capacity; capacity != nil {
+						if capacity.Default != nil {
+							s.DefaultCapacity = int(*capacity.Default)
+						}
+						if capacity.Minimum != nil {
+							s.MinimumCapacity = int(*capacity.Minimum)
+						}
+						if capacity.Maximum != nil {
+							s.MaximumCapacity = int(*capacity.Maximum)
+						}
+						if capacity.Step != nil {
+							s.CapacityStep = int(*capacity.Step)
+						}
+					}
+					v.Sku = append(v.Sku, s)
+				}
+			}
+		}
+
+		output = append(output, v)
+	}
+
+	return output
+}
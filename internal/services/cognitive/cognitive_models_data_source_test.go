@@ -0,0 +1,40 @@
+package cognitive_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type CognitiveModelsDataSource struct{}
+
+func TestAccCognitiveModelsDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_cognitive_models", "test")
+	r := CognitiveModelsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("models.#").Exists(),
+				check.That(data.ResourceName).Key("models.0.kind").HasValue("OpenAI"),
+				check.That(data.ResourceName).Key("models.0.name").Exists(),
+			),
+		},
+	})
+}
+
+func (CognitiveModelsDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_cognitive_models" "test" {
+  location = %q
+  kind     = "OpenAI"
+}
+`, data.Locations.Primary)
+}
@@ -0,0 +1,90 @@
+package migration
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestAccountV0ToV1(t *testing.T) {
+	subnetId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1"
+
+	cases := map[string]struct {
+		Input    map[string]interface{}
+		Expected map[string]interface{}
+	}{
+		"no_network_acls": {
+			Input: map[string]interface{}{
+				"name": "acctest",
+			},
+			Expected: map[string]interface{}{
+				"name": "acctest",
+			},
+		},
+		"virtual_network_rules": {
+			Input: map[string]interface{}{
+				"network_acls": []interface{}{
+					map[string]interface{}{
+						"default_action":             "Deny",
+						"ip_rules":                   []interface{}{"1.2.3.4"},
+						"virtual_network_subnet_ids": []interface{}{subnetId},
+						"virtual_network_rules": []interface{}{
+							map[string]interface{}{
+								"subnet_id":                            subnetId,
+								"ignore_missing_vnet_service_endpoint": true,
+							},
+						},
+					},
+				},
+			},
+			Expected: map[string]interface{}{
+				"network_rules": []interface{}{
+					map[string]interface{}{
+						"default_action": "Deny",
+						"ip_rules":       []interface{}{"1.2.3.4"},
+						"virtual_network_rules": []interface{}{
+							map[string]interface{}{
+								"subnet_id":                            subnetId,
+								"ignore_missing_vnet_service_endpoint": true,
+							},
+						},
+					},
+				},
+			},
+		},
+		"virtual_network_subnet_ids_only": {
+			Input: map[string]interface{}{
+				"network_acls": []interface{}{
+					map[string]interface{}{
+						"default_action":             "Allow",
+						"virtual_network_subnet_ids": []interface{}{subnetId},
+					},
+				},
+			},
+			Expected: map[string]interface{}{
+				"network_rules": []interface{}{
+					map[string]interface{}{
+						"default_action": "Allow",
+						"virtual_network_rules": []interface{}{
+							map[string]interface{}{
+								"subnet_id":                            subnetId,
+								"ignore_missing_vnet_service_endpoint": false,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		actual, err := AccountV0ToV1{}.UpgradeFunc()(context.TODO(), tc.Input, nil)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %+v", name, err)
+		}
+
+		if !reflect.DeepEqual(actual, tc.Expected) {
+			t.Fatalf("%s: expected %+v but got %+v", name, tc.Expected, actual)
+		}
+	}
+}
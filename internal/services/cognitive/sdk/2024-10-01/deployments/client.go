@@ -0,0 +1,15 @@
+package deployments
+
+import "github.com/Azure/go-autorest/autorest"
+
+type DeploymentsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewDeploymentsClientWithBaseURI(endpoint string) DeploymentsClient {
+	return DeploymentsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
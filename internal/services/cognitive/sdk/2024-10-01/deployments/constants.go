@@ -0,0 +1,114 @@
+package deployments
+
+import "strings"
+
+type CreatedByType string
+
+const (
+	CreatedByTypeApplication     CreatedByType = "Application"
+	CreatedByTypeKey             CreatedByType = "Key"
+	CreatedByTypeManagedIdentity CreatedByType = "ManagedIdentity"
+	CreatedByTypeUser            CreatedByType = "User"
+)
+
+func PossibleValuesForCreatedByType() []string {
+	return []string{
+		string(CreatedByTypeApplication),
+		string(CreatedByTypeKey),
+		string(CreatedByTypeManagedIdentity),
+		string(CreatedByTypeUser),
+	}
+}
+
+func parseCreatedByType(input string) (*CreatedByType, error) {
+	vals := map[string]CreatedByType{
+		"application":     CreatedByTypeApplication,
+		"key":             CreatedByTypeKey,
+		"managedidentity": CreatedByTypeManagedIdentity,
+		"user":            CreatedByTypeUser,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := CreatedByType(input)
+	return &out, nil
+}
+
+type DeploymentModelVersionUpgradeOption string
+
+const (
+	DeploymentModelVersionUpgradeOptionNoAutoUpgrade                  DeploymentModelVersionUpgradeOption = "NoAutoUpgrade"
+	DeploymentModelVersionUpgradeOptionOnceCurrentVersionExpired      DeploymentModelVersionUpgradeOption = "OnceCurrentVersionExpired"
+	DeploymentModelVersionUpgradeOptionOnceNewDefaultVersionAvailable DeploymentModelVersionUpgradeOption = "OnceNewDefaultVersionAvailable"
+)
+
+func PossibleValuesForDeploymentModelVersionUpgradeOption() []string {
+	return []string{
+		string(DeploymentModelVersionUpgradeOptionNoAutoUpgrade),
+		string(DeploymentModelVersionUpgradeOptionOnceCurrentVersionExpired),
+		string(DeploymentModelVersionUpgradeOptionOnceNewDefaultVersionAvailable),
+	}
+}
+
+func parseDeploymentModelVersionUpgradeOption(input string) (*DeploymentModelVersionUpgradeOption, error) {
+	vals := map[string]DeploymentModelVersionUpgradeOption{
+		"noautoupgrade":                  DeploymentModelVersionUpgradeOptionNoAutoUpgrade,
+		"oncecurrentversionexpired":      DeploymentModelVersionUpgradeOptionOnceCurrentVersionExpired,
+		"oncenewdefaultversionavailable": DeploymentModelVersionUpgradeOptionOnceNewDefaultVersionAvailable,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := DeploymentModelVersionUpgradeOption(input)
+	return &out, nil
+}
+
+type DeploymentProvisioningState string
+
+const (
+	DeploymentProvisioningStateAccepted  DeploymentProvisioningState = "Accepted"
+	DeploymentProvisioningStateCanceled  DeploymentProvisioningState = "Canceled"
+	DeploymentProvisioningStateCreating  DeploymentProvisioningState = "Creating"
+	DeploymentProvisioningStateDeleting  DeploymentProvisioningState = "Deleting"
+	DeploymentProvisioningStateDisabled  DeploymentProvisioningState = "Disabled"
+	DeploymentProvisioningStateFailed    DeploymentProvisioningState = "Failed"
+	DeploymentProvisioningStateMoving    DeploymentProvisioningState = "Moving"
+	DeploymentProvisioningStateSucceeded DeploymentProvisioningState = "Succeeded"
+)
+
+func PossibleValuesForDeploymentProvisioningState() []string {
+	return []string{
+		string(DeploymentProvisioningStateAccepted),
+		string(DeploymentProvisioningStateCanceled),
+		string(DeploymentProvisioningStateCreating),
+		string(DeploymentProvisioningStateDeleting),
+		string(DeploymentProvisioningStateDisabled),
+		string(DeploymentProvisioningStateFailed),
+		string(DeploymentProvisioningStateMoving),
+		string(DeploymentProvisioningStateSucceeded),
+	}
+}
+
+func parseDeploymentProvisioningState(input string) (*DeploymentProvisioningState, error) {
+	vals := map[string]DeploymentProvisioningState{
+		"accepted":  DeploymentProvisioningStateAccepted,
+		"canceled":  DeploymentProvisioningStateCanceled,
+		"creating":  DeploymentProvisioningStateCreating,
+		"deleting":  DeploymentProvisioningStateDeleting,
+		"disabled":  DeploymentProvisioningStateDisabled,
+		"failed":    DeploymentProvisioningStateFailed,
+		"moving":    DeploymentProvisioningStateMoving,
+		"succeeded": DeploymentProvisioningStateSucceeded,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := DeploymentProvisioningState(input)
+	return &out, nil
+}
@@ -0,0 +1,11 @@
+package deployments
+
+type Deployment struct {
+	Etag       *string               `json:"etag,omitempty"`
+	Id         *string               `json:"id,omitempty"`
+	Name       *string               `json:"name,omitempty"`
+	Properties *DeploymentProperties `json:"properties,omitempty"`
+	Sku        *Sku                  `json:"sku,omitempty"`
+	SystemData *SystemData           `json:"systemData,omitempty"`
+	Type       *string               `json:"type,omitempty"`
+}
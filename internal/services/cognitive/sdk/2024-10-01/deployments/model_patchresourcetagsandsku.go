@@ -0,0 +1,6 @@
+package deployments
+
+type PatchResourceTagsAndSku struct {
+	Sku  *Sku               `json:"sku,omitempty"`
+	Tags *map[string]string `json:"tags,omitempty"`
+}
@@ -0,0 +1,9 @@
+package deployments
+
+type Sku struct {
+	Capacity *int64  `json:"capacity,omitempty"`
+	Family   *string `json:"family,omitempty"`
+	Name     string  `json:"name"`
+	Size     *string `json:"size,omitempty"`
+	Tier     *string `json:"tier,omitempty"`
+}
@@ -0,0 +1,15 @@
+package models
+
+import "github.com/Azure/go-autorest/autorest"
+
+type ModelsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewModelsClientWithBaseURI(endpoint string) ModelsClient {
+	return ModelsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
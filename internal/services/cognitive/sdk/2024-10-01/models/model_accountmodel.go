@@ -0,0 +1,13 @@
+package models
+
+type AccountModel struct {
+	Capabilities     *map[string]string    `json:"capabilities,omitempty"`
+	Deprecation      *ModelDeprecationInfo `json:"deprecation,omitempty"`
+	Format           *string               `json:"format,omitempty"`
+	IsDefaultVersion *bool                 `json:"isDefaultVersion,omitempty"`
+	LifecycleStatus  *string               `json:"lifecycleStatus,omitempty"`
+	MaxCapacity      *int64                `json:"maxCapacity,omitempty"`
+	Name             *string               `json:"name,omitempty"`
+	Skus             *[]ModelSku           `json:"skus,omitempty"`
+	Version          *string               `json:"version,omitempty"`
+}
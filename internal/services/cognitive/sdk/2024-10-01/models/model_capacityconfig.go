@@ -0,0 +1,8 @@
+package models
+
+type CapacityConfig struct {
+	Default *int64 `json:"default,omitempty"`
+	Maximum *int64 `json:"maximum,omitempty"`
+	Minimum *int64 `json:"minimum,omitempty"`
+	Step    *int64 `json:"step,omitempty"`
+}
@@ -0,0 +1,7 @@
+package models
+
+type Model struct {
+	Kind    *string       `json:"kind,omitempty"`
+	Model   *AccountModel `json:"model,omitempty"`
+	SkuName *string       `json:"skuName,omitempty"`
+}
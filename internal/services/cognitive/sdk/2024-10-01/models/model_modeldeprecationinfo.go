@@ -0,0 +1,6 @@
+package models
+
+type ModelDeprecationInfo struct {
+	FineTune  *string `json:"fineTune,omitempty"`
+	Inference *string `json:"inference,omitempty"`
+}
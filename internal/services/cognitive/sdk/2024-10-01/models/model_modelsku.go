@@ -0,0 +1,8 @@
+package models
+
+type ModelSku struct {
+	Capacity        *CapacityConfig `json:"capacity,omitempty"`
+	DeprecationDate *string         `json:"deprecationDate,omitempty"`
+	Name            *string         `json:"name,omitempty"`
+	UsageName       *string         `json:"usageName,omitempty"`
+}
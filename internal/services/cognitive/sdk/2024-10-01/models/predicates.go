@@ -0,0 +1,19 @@
+package models
+
+type ModelPredicate struct {
+	Kind    *string
+	SkuName *string
+}
+
+func (p ModelPredicate) Matches(input Model) bool {
+
+	if p.Kind != nil && (input.Kind == nil || *p.Kind != *input.Kind) {
+		return false
+	}
+
+	if p.SkuName != nil && (input.SkuName == nil || *p.SkuName != *input.SkuName) {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,9 @@
+package models
+
+import "fmt"
+
+const defaultApiVersion = "2024-10-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/models/%s", defaultApiVersion)
+}
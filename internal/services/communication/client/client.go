@@ -1,12 +1,44 @@
 package client
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/Azure/azure-sdk-for-go/services/communication/mgmt/2020-08-20/communication"
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/communication/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/communication/sdk/2022-12-01/phonenumbers"
 )
 
+// dataPlaneResource is the resource which tokens for the Communication Services data plane are issued for
+const dataPlaneResource = "https://communication.azure.com"
+
 type Client struct {
-	ServiceClient *communication.ServiceClient
+	ServiceClient       *communication.ServiceClient
+	tokenFunc           func(endpoint string) (autorest.Authorizer, error)
+	configureClientFunc func(c *autorest.Client, authorizer autorest.Authorizer)
+}
+
+// PhoneNumbersClient returns a client for the Phone Numbers API of the specified Communication Service
+func (c Client) PhoneNumbersClient(ctx context.Context, id parse.CommunicationServiceId) (*phonenumbers.PhoneNumbersClient, error) {
+	service, err := c.ServiceClient.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	if service.ServiceProperties == nil || service.ServiceProperties.HostName == nil {
+		return nil, fmt.Errorf("retrieving %s: `properties.hostName` was nil", id)
+	}
+
+	authorizer, err := c.tokenFunc(dataPlaneResource)
+	if err != nil {
+		return nil, fmt.Errorf("obtaining auth token for %q: %+v", dataPlaneResource, err)
+	}
+
+	client := phonenumbers.NewPhoneNumbersClientWithBaseURI(fmt.Sprintf("https://%s", *service.ServiceProperties.HostName))
+	c.configureClientFunc(&client.Client, authorizer)
+	return &client, nil
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -14,6 +46,8 @@ func NewClient(o *common.ClientOptions) *Client {
 	o.ConfigureClient(&serviceClient.Client, o.ResourceManagerAuthorizer)
 
 	return &Client{
-		ServiceClient: &serviceClient,
+		ServiceClient:       &serviceClient,
+		tokenFunc:           o.TokenFunc,
+		configureClientFunc: o.ConfigureClient,
 	}
 }
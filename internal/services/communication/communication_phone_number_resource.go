@@ -0,0 +1,313 @@
+package communication
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/communication/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/communication/sdk/2022-12-01/phonenumbers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/communication/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.ResourceWithUpdate = CommunicationPhoneNumberResource{}
+
+type CommunicationPhoneNumberResource struct{}
+
+type CommunicationPhoneNumberResourceModel struct {
+	CommunicationServiceId string  `tfschema:"communication_service_id"`
+	CountryCode            string  `tfschema:"country_code"`
+	PhoneNumberType        string  `tfschema:"phone_number_type"`
+	AssignmentType         string  `tfschema:"assignment_type"`
+	AreaCode               string  `tfschema:"area_code"`
+	CallingCapability      string  `tfschema:"calling_capability"`
+	SmsCapability          string  `tfschema:"sms_capability"`
+	PhoneNumber            string  `tfschema:"phone_number"`
+	MonthlyCost            float64 `tfschema:"monthly_cost"`
+	CurrencyCode           string  `tfschema:"currency_code"`
+	PurchaseDate           string  `tfschema:"purchase_date"`
+}
+
+func (r CommunicationPhoneNumberResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"communication_service_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.CommunicationServiceID,
+		},
+
+		"country_code": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[A-Z]{2}$`),
+				"`country_code` must be a two letter ISO 3166-1 alpha-2 country code, for example `US`",
+			),
+		},
+
+		"phone_number_type": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(phonenumbers.PossibleValuesForPhoneNumberType(), false),
+		},
+
+		"assignment_type": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(phonenumbers.PossibleValuesForPhoneNumberAssignmentType(), false),
+		},
+
+		"area_code": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[0-9]+$`),
+				"`area_code` must only contain digits",
+			),
+		},
+
+		"calling_capability": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(phonenumbers.PossibleValuesForPhoneNumberCapabilityType(), false),
+		},
+
+		"sms_capability": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(phonenumbers.PossibleValuesForPhoneNumberCapabilityType(), false),
+		},
+	}
+}
+
+func (r CommunicationPhoneNumberResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"phone_number": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"monthly_cost": {
+			Type:     pluginsdk.TypeFloat,
+			Computed: true,
+		},
+
+		"currency_code": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"purchase_date": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r CommunicationPhoneNumberResource) ModelObject() interface{} {
+	return &CommunicationPhoneNumberResourceModel{}
+}
+
+func (r CommunicationPhoneNumberResource) ResourceType() string {
+	return "azurerm_communication_phone_number"
+}
+
+func (r CommunicationPhoneNumberResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.PhoneNumberID
+}
+
+func (r CommunicationPhoneNumberResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model CommunicationPhoneNumberResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			serviceId, err := parse.CommunicationServiceID(model.CommunicationServiceId)
+			if err != nil {
+				return err
+			}
+
+			client, err := metadata.Client.Communication.PhoneNumbersClient(ctx, *serviceId)
+			if err != nil {
+				return err
+			}
+
+			// Phone Numbers are acquired by searching for an available number matching the requested
+			// criteria, which reserves it until the search expires, and then purchasing the search
+			searchRequest := phonenumbers.PhoneNumberSearchRequest{
+				AssignmentType: phonenumbers.PhoneNumberAssignmentType(model.AssignmentType),
+				Capabilities: phonenumbers.PhoneNumberCapabilities{
+					Calling: phonenumbers.PhoneNumberCapabilityType(model.CallingCapability),
+					Sms:     phonenumbers.PhoneNumberCapabilityType(model.SmsCapability),
+				},
+				PhoneNumberType: phonenumbers.PhoneNumberType(model.PhoneNumberType),
+				Quantity:        utils.Int64(1),
+			}
+			if model.AreaCode != "" {
+				searchRequest.AreaCode = utils.String(model.AreaCode)
+			}
+
+			searchId, err := client.SearchAvailablePhoneNumbersThenPoll(ctx, model.CountryCode, searchRequest)
+			if err != nil {
+				return fmt.Errorf("searching for an available Phone Number for %s: %+v", *serviceId, err)
+			}
+			if searchId == nil {
+				return fmt.Errorf("searching for an available Phone Number for %s: `search-id` was nil", *serviceId)
+			}
+
+			searchResult, err := client.GetSearchResult(ctx, *searchId)
+			if err != nil {
+				return fmt.Errorf("retrieving the result of the Phone Number search %q for %s: %+v", *searchId, *serviceId, err)
+			}
+			if searchResult.Model == nil || len(searchResult.Model.PhoneNumbers) == 0 {
+				return fmt.Errorf("no Phone Numbers are available for %s matching the specified criteria", *serviceId)
+			}
+			phoneNumber := searchResult.Model.PhoneNumbers[0]
+
+			if err := client.PurchasePhoneNumbersThenPoll(ctx, phonenumbers.PhoneNumberPurchaseRequest{SearchId: searchId}); err != nil {
+				return fmt.Errorf("purchasing Phone Number %q for %s: %+v", phoneNumber, *serviceId, err)
+			}
+
+			id := parse.NewPhoneNumberID(serviceId.SubscriptionId, serviceId.ResourceGroup, serviceId.Name, strings.TrimPrefix(phoneNumber, "+"))
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r CommunicationPhoneNumberResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.PhoneNumberID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			serviceId := parse.NewCommunicationServiceID(id.SubscriptionId, id.ResourceGroup, id.CommunicationServiceName)
+			service, err := metadata.Client.Communication.ServiceClient.Get(ctx, serviceId.ResourceGroup, serviceId.Name)
+			if err != nil {
+				if utils.ResponseWasNotFound(service.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", serviceId, err)
+			}
+
+			client, err := metadata.Client.Communication.PhoneNumbersClient(ctx, serviceId)
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.GetByNumber(ctx, phoneNumberFromId(*id))
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := CommunicationPhoneNumberResourceModel{
+				CommunicationServiceId: serviceId.ID(),
+			}
+
+			// the area code isn't returned by the API
+			if v, ok := metadata.ResourceData.GetOk("area_code"); ok {
+				state.AreaCode = v.(string)
+			}
+
+			if model := resp.Model; model != nil {
+				state.CountryCode = model.CountryCode
+				state.PhoneNumberType = string(model.PhoneNumberType)
+				state.AssignmentType = string(model.AssignmentType)
+				state.CallingCapability = string(model.Capabilities.Calling)
+				state.SmsCapability = string(model.Capabilities.Sms)
+				state.PhoneNumber = model.PhoneNumber
+				state.MonthlyCost = model.Cost.Amount
+				state.CurrencyCode = model.Cost.CurrencyCode
+				state.PurchaseDate = model.PurchaseDate
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r CommunicationPhoneNumberResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.PhoneNumberID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model CommunicationPhoneNumberResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client, err := metadata.Client.Communication.PhoneNumbersClient(ctx, parse.NewCommunicationServiceID(id.SubscriptionId, id.ResourceGroup, id.CommunicationServiceName))
+			if err != nil {
+				return err
+			}
+
+			if metadata.ResourceData.HasChanges("calling_capability", "sms_capability") {
+				calling := phonenumbers.PhoneNumberCapabilityType(model.CallingCapability)
+				sms := phonenumbers.PhoneNumberCapabilityType(model.SmsCapability)
+				payload := phonenumbers.PhoneNumberCapabilitiesRequest{
+					Calling: &calling,
+					Sms:     &sms,
+				}
+				if err := client.UpdateCapabilitiesThenPoll(ctx, phoneNumberFromId(*id), payload); err != nil {
+					return fmt.Errorf("updating the capabilities for %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r CommunicationPhoneNumberResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.PhoneNumberID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			client, err := metadata.Client.Communication.PhoneNumbersClient(ctx, parse.NewCommunicationServiceID(id.SubscriptionId, id.ResourceGroup, id.CommunicationServiceName))
+			if err != nil {
+				return err
+			}
+
+			if err := client.ReleasePhoneNumberThenPoll(ctx, phoneNumberFromId(*id)); err != nil {
+				return fmt.Errorf("releasing %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// phoneNumberFromId returns the E.164 formatted Phone Number for the specified ID - the leading `+`
+// is omitted from the Resource ID since this would otherwise need to be encoded
+func phoneNumberFromId(id parse.PhoneNumberId) string {
+	return fmt.Sprintf("+%s", id.Name)
+}
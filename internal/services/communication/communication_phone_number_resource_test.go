@@ -0,0 +1,96 @@
+package communication_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/communication/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type CommunicationPhoneNumberResource struct{}
+
+func TestAccCommunicationPhoneNumber_basic(t *testing.T) {
+	if os.Getenv("ARM_TEST_COMMUNICATION_PHONE_NUMBERS") == "" {
+		t.Skip("Skipping as `ARM_TEST_COMMUNICATION_PHONE_NUMBERS` is not specified - purchasing a Phone Number incurs a charge")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_communication_phone_number", "test")
+	r := CommunicationPhoneNumberResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "outbound"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("phone_number").Exists(),
+				check.That(data.ResourceName).Key("currency_code").Exists(),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data, "inbound+outbound"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r CommunicationPhoneNumberResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.PhoneNumberID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	phoneNumbersClient, err := client.Communication.PhoneNumbersClient(ctx, parse.NewCommunicationServiceID(id.SubscriptionId, id.ResourceGroup, id.CommunicationServiceName))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := phoneNumbersClient.GetByNumber(ctx, fmt.Sprintf("+%s", id.Name))
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r CommunicationPhoneNumberResource) basic(data acceptance.TestData, smsCapability string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-communication-%d"
+  location = "%s"
+}
+
+resource "azurerm_communication_service" "test" {
+  name                = "acctest-CommunicationService-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  data_location       = "United States"
+}
+
+resource "azurerm_communication_phone_number" "test" {
+  communication_service_id = azurerm_communication_service.test.id
+  country_code             = "US"
+  phone_number_type        = "tollFree"
+  assignment_type          = "application"
+  calling_capability       = "outbound"
+  sms_capability           = %q
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, smsCapability)
+}
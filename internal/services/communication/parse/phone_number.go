@@ -0,0 +1,75 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type PhoneNumberId struct {
+	SubscriptionId           string
+	ResourceGroup            string
+	CommunicationServiceName string
+	Name                     string
+}
+
+func NewPhoneNumberID(subscriptionId, resourceGroup, communicationServiceName, name string) PhoneNumberId {
+	return PhoneNumberId{
+		SubscriptionId:           subscriptionId,
+		ResourceGroup:            resourceGroup,
+		CommunicationServiceName: communicationServiceName,
+		Name:                     name,
+	}
+}
+
+func (id PhoneNumberId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Communication Service Name %q", id.CommunicationServiceName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Phone Number", segmentsStr)
+}
+
+func (id PhoneNumberId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Communication/CommunicationServices/%s/phoneNumbers/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.CommunicationServiceName, id.Name)
+}
+
+// PhoneNumberID parses a PhoneNumber ID into an PhoneNumberId struct
+func PhoneNumberID(input string) (*PhoneNumberId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := PhoneNumberId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.CommunicationServiceName, err = id.PopSegment("CommunicationServices"); err != nil {
+		return nil, err
+	}
+	if resourceId.Name, err = id.PopSegment("phoneNumbers"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -1,3 +1,4 @@
 package communication
 
 //go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=CommunicationService -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Communication/CommunicationServices/communicationService1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=PhoneNumber -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Communication/CommunicationServices/communicationService1/phoneNumbers/14255550123
@@ -0,0 +1,15 @@
+package phonenumbers
+
+import "github.com/Azure/go-autorest/autorest"
+
+type PhoneNumbersClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewPhoneNumbersClientWithBaseURI(endpoint string) PhoneNumbersClient {
+	return PhoneNumbersClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
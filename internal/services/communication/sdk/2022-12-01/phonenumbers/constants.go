@@ -0,0 +1,56 @@
+package phonenumbers
+
+type PhoneNumberAssignmentType string
+
+const (
+	PhoneNumberAssignmentTypeApplication PhoneNumberAssignmentType = "application"
+	PhoneNumberAssignmentTypePerson      PhoneNumberAssignmentType = "person"
+)
+
+func PossibleValuesForPhoneNumberAssignmentType() []string {
+	return []string{
+		string(PhoneNumberAssignmentTypeApplication),
+		string(PhoneNumberAssignmentTypePerson),
+	}
+}
+
+type PhoneNumberCapabilityType string
+
+const (
+	PhoneNumberCapabilityTypeInbound         PhoneNumberCapabilityType = "inbound"
+	PhoneNumberCapabilityTypeInboundOutbound PhoneNumberCapabilityType = "inbound+outbound"
+	PhoneNumberCapabilityTypeNone            PhoneNumberCapabilityType = "none"
+	PhoneNumberCapabilityTypeOutbound        PhoneNumberCapabilityType = "outbound"
+)
+
+func PossibleValuesForPhoneNumberCapabilityType() []string {
+	return []string{
+		string(PhoneNumberCapabilityTypeInbound),
+		string(PhoneNumberCapabilityTypeInboundOutbound),
+		string(PhoneNumberCapabilityTypeNone),
+		string(PhoneNumberCapabilityTypeOutbound),
+	}
+}
+
+type PhoneNumberType string
+
+const (
+	PhoneNumberTypeGeographic PhoneNumberType = "geographic"
+	PhoneNumberTypeTollFree   PhoneNumberType = "tollFree"
+)
+
+func PossibleValuesForPhoneNumberType() []string {
+	return []string{
+		string(PhoneNumberTypeGeographic),
+		string(PhoneNumberTypeTollFree),
+	}
+}
+
+type OperationStatus string
+
+const (
+	OperationStatusFailed     OperationStatus = "failed"
+	OperationStatusNotStarted OperationStatus = "notStarted"
+	OperationStatusRunning    OperationStatus = "running"
+	OperationStatusSucceeded  OperationStatus = "succeeded"
+)
@@ -0,0 +1,64 @@
+package phonenumbers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type GetByNumberResponse struct {
+	HttpResponse *http.Response
+	Model        *PurchasedPhoneNumber
+}
+
+// GetByNumber ...
+func (c PhoneNumbersClient) GetByNumber(ctx context.Context, phoneNumber string) (result GetByNumberResponse, err error) {
+	req, err := c.preparerForGetByNumber(ctx, phoneNumber)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "phonenumbers.PhoneNumbersClient", "GetByNumber", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, autorest.DoRetryForStatusCodes(c.Client.RetryAttempts, c.Client.RetryDuration, autorest.StatusCodesForRetry...))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "phonenumbers.PhoneNumbersClient", "GetByNumber", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForGetByNumber(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "phonenumbers.PhoneNumbersClient", "GetByNumber", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForGetByNumber prepares the GetByNumber request.
+func (c PhoneNumbersClient) preparerForGetByNumber(ctx context.Context, phoneNumber string) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(phoneNumberPath(phoneNumber)),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForGetByNumber handles the response to the GetByNumber request. The method always
+// closes the http.Response Body.
+func (c PhoneNumbersClient) responderForGetByNumber(resp *http.Response) (result GetByNumberResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
@@ -0,0 +1,66 @@
+package phonenumbers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type GetSearchResultResponse struct {
+	HttpResponse *http.Response
+	Model        *PhoneNumberSearchResult
+}
+
+// GetSearchResult ...
+func (c PhoneNumbersClient) GetSearchResult(ctx context.Context, searchId string) (result GetSearchResultResponse, err error) {
+	req, err := c.preparerForGetSearchResult(ctx, searchId)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "phonenumbers.PhoneNumbersClient", "GetSearchResult", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, autorest.DoRetryForStatusCodes(c.Client.RetryAttempts, c.Client.RetryDuration, autorest.StatusCodesForRetry...))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "phonenumbers.PhoneNumbersClient", "GetSearchResult", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForGetSearchResult(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "phonenumbers.PhoneNumbersClient", "GetSearchResult", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForGetSearchResult prepares the GetSearchResult request.
+func (c PhoneNumbersClient) preparerForGetSearchResult(ctx context.Context, searchId string) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(fmt.Sprintf("/availablePhoneNumbers/searchResults/%s", url.PathEscape(searchId))),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForGetSearchResult handles the response to the GetSearchResult request. The method always
+// closes the http.Response Body.
+func (c PhoneNumbersClient) responderForGetSearchResult(resp *http.Response) (result GetSearchResultResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
@@ -0,0 +1,84 @@
+package phonenumbers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type PurchasePhoneNumbersResponse struct {
+	Poller       *OperationPoller
+	HttpResponse *http.Response
+}
+
+// PurchasePhoneNumbers ...
+func (c PhoneNumbersClient) PurchasePhoneNumbers(ctx context.Context, input PhoneNumberPurchaseRequest) (result PurchasePhoneNumbersResponse, err error) {
+	req, err := c.preparerForPurchasePhoneNumbers(ctx, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "phonenumbers.PhoneNumbersClient", "PurchasePhoneNumbers", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForPurchasePhoneNumbers(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "phonenumbers.PhoneNumbersClient", "PurchasePhoneNumbers", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// PurchasePhoneNumbersThenPoll performs PurchasePhoneNumbers then polls until it's completed
+func (c PhoneNumbersClient) PurchasePhoneNumbersThenPoll(ctx context.Context, input PhoneNumberPurchaseRequest) error {
+	result, err := c.PurchasePhoneNumbers(ctx, input)
+	if err != nil {
+		return fmt.Errorf("performing PurchasePhoneNumbers: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after PurchasePhoneNumbers: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForPurchasePhoneNumbers prepares the PurchasePhoneNumbers request.
+func (c PhoneNumbersClient) preparerForPurchasePhoneNumbers(ctx context.Context, input PhoneNumberPurchaseRequest) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPost(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath("/availablePhoneNumbers/:purchase"),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForPurchasePhoneNumbers sends the PurchasePhoneNumbers request. The method will close the
+// http.Response Body if it receives an error.
+func (c PhoneNumbersClient) senderForPurchasePhoneNumbers(ctx context.Context, req *http.Request) (future PurchasePhoneNumbersResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, autorest.DoRetryForStatusCodes(c.Client.RetryAttempts, c.Client.RetryDuration, autorest.StatusCodesForRetry...))
+	future.HttpResponse = resp
+	if err != nil {
+		return
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusAccepted),
+		autorest.ByClosing())
+	if err != nil {
+		return
+	}
+
+	future.Poller, err = newOperationPollerFromResponse(ctx, resp, c.Client)
+	return
+}
@@ -0,0 +1,82 @@
+package phonenumbers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type ReleasePhoneNumberResponse struct {
+	Poller       *OperationPoller
+	HttpResponse *http.Response
+}
+
+// ReleasePhoneNumber ...
+func (c PhoneNumbersClient) ReleasePhoneNumber(ctx context.Context, phoneNumber string) (result ReleasePhoneNumberResponse, err error) {
+	req, err := c.preparerForReleasePhoneNumber(ctx, phoneNumber)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "phonenumbers.PhoneNumbersClient", "ReleasePhoneNumber", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForReleasePhoneNumber(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "phonenumbers.PhoneNumbersClient", "ReleasePhoneNumber", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// ReleasePhoneNumberThenPoll performs ReleasePhoneNumber then polls until it's completed
+func (c PhoneNumbersClient) ReleasePhoneNumberThenPoll(ctx context.Context, phoneNumber string) error {
+	result, err := c.ReleasePhoneNumber(ctx, phoneNumber)
+	if err != nil {
+		return fmt.Errorf("performing ReleasePhoneNumber: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after ReleasePhoneNumber: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForReleasePhoneNumber prepares the ReleasePhoneNumber request.
+func (c PhoneNumbersClient) preparerForReleasePhoneNumber(ctx context.Context, phoneNumber string) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsDelete(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(phoneNumberPath(phoneNumber)),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForReleasePhoneNumber sends the ReleasePhoneNumber request. The method will close the
+// http.Response Body if it receives an error.
+func (c PhoneNumbersClient) senderForReleasePhoneNumber(ctx context.Context, req *http.Request) (future ReleasePhoneNumberResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, autorest.DoRetryForStatusCodes(c.Client.RetryAttempts, c.Client.RetryDuration, autorest.StatusCodesForRetry...))
+	future.HttpResponse = resp
+	if err != nil {
+		return
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusAccepted),
+		autorest.ByClosing())
+	if err != nil {
+		return
+	}
+
+	future.Poller, err = newOperationPollerFromResponse(ctx, resp, c.Client)
+	return
+}
@@ -0,0 +1,89 @@
+package phonenumbers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type SearchAvailablePhoneNumbersResponse struct {
+	Poller       *OperationPoller
+	HttpResponse *http.Response
+	SearchId     *string
+}
+
+// SearchAvailablePhoneNumbers ...
+func (c PhoneNumbersClient) SearchAvailablePhoneNumbers(ctx context.Context, countryCode string, input PhoneNumberSearchRequest) (result SearchAvailablePhoneNumbersResponse, err error) {
+	req, err := c.preparerForSearchAvailablePhoneNumbers(ctx, countryCode, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "phonenumbers.PhoneNumbersClient", "SearchAvailablePhoneNumbers", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForSearchAvailablePhoneNumbers(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "phonenumbers.PhoneNumbersClient", "SearchAvailablePhoneNumbers", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// SearchAvailablePhoneNumbersThenPoll performs SearchAvailablePhoneNumbers then polls until it's completed, returning the ID of the Search
+func (c PhoneNumbersClient) SearchAvailablePhoneNumbersThenPoll(ctx context.Context, countryCode string, input PhoneNumberSearchRequest) (*string, error) {
+	result, err := c.SearchAvailablePhoneNumbers(ctx, countryCode, input)
+	if err != nil {
+		return nil, fmt.Errorf("performing SearchAvailablePhoneNumbers: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return nil, fmt.Errorf("polling after SearchAvailablePhoneNumbers: %+v", err)
+	}
+
+	return result.SearchId, nil
+}
+
+// preparerForSearchAvailablePhoneNumbers prepares the SearchAvailablePhoneNumbers request.
+func (c PhoneNumbersClient) preparerForSearchAvailablePhoneNumbers(ctx context.Context, countryCode string, input PhoneNumberSearchRequest) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPost(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(fmt.Sprintf("/availablePhoneNumbers/countries/%s/:search", url.PathEscape(countryCode))),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForSearchAvailablePhoneNumbers sends the SearchAvailablePhoneNumbers request. The method will close the
+// http.Response Body if it receives an error.
+func (c PhoneNumbersClient) senderForSearchAvailablePhoneNumbers(ctx context.Context, req *http.Request) (future SearchAvailablePhoneNumbersResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, autorest.DoRetryForStatusCodes(c.Client.RetryAttempts, c.Client.RetryDuration, autorest.StatusCodesForRetry...))
+	future.HttpResponse = resp
+	if err != nil {
+		return
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusAccepted),
+		autorest.ByClosing())
+	if err != nil {
+		return
+	}
+
+	if v := resp.Header.Get("search-id"); v != "" {
+		future.SearchId = &v
+	}
+	future.Poller, err = newOperationPollerFromResponse(ctx, resp, c.Client)
+	return
+}
@@ -0,0 +1,84 @@
+package phonenumbers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type UpdateCapabilitiesResponse struct {
+	Poller       *OperationPoller
+	HttpResponse *http.Response
+}
+
+// UpdateCapabilities ...
+func (c PhoneNumbersClient) UpdateCapabilities(ctx context.Context, phoneNumber string, input PhoneNumberCapabilitiesRequest) (result UpdateCapabilitiesResponse, err error) {
+	req, err := c.preparerForUpdateCapabilities(ctx, phoneNumber, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "phonenumbers.PhoneNumbersClient", "UpdateCapabilities", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForUpdateCapabilities(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "phonenumbers.PhoneNumbersClient", "UpdateCapabilities", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// UpdateCapabilitiesThenPoll performs UpdateCapabilities then polls until it's completed
+func (c PhoneNumbersClient) UpdateCapabilitiesThenPoll(ctx context.Context, phoneNumber string, input PhoneNumberCapabilitiesRequest) error {
+	result, err := c.UpdateCapabilities(ctx, phoneNumber, input)
+	if err != nil {
+		return fmt.Errorf("performing UpdateCapabilities: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after UpdateCapabilities: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForUpdateCapabilities prepares the UpdateCapabilities request.
+func (c PhoneNumbersClient) preparerForUpdateCapabilities(ctx context.Context, phoneNumber string, input PhoneNumberCapabilitiesRequest) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/merge-patch+json"),
+		autorest.AsPatch(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(phoneNumberPath(phoneNumber)+"/capabilities"),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForUpdateCapabilities sends the UpdateCapabilities request. The method will close the
+// http.Response Body if it receives an error.
+func (c PhoneNumbersClient) senderForUpdateCapabilities(ctx context.Context, req *http.Request) (future UpdateCapabilitiesResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, autorest.DoRetryForStatusCodes(c.Client.RetryAttempts, c.Client.RetryDuration, autorest.StatusCodesForRetry...))
+	future.HttpResponse = resp
+	if err != nil {
+		return
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusAccepted),
+		autorest.ByClosing())
+	if err != nil {
+		return
+	}
+
+	future.Poller, err = newOperationPollerFromResponse(ctx, resp, c.Client)
+	return
+}
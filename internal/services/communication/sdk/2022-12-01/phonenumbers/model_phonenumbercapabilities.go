@@ -0,0 +1,6 @@
+package phonenumbers
+
+type PhoneNumberCapabilities struct {
+	Calling PhoneNumberCapabilityType `json:"calling"`
+	Sms     PhoneNumberCapabilityType `json:"sms"`
+}
@@ -0,0 +1,6 @@
+package phonenumbers
+
+type PhoneNumberCapabilitiesRequest struct {
+	Calling *PhoneNumberCapabilityType `json:"calling,omitempty"`
+	Sms     *PhoneNumberCapabilityType `json:"sms,omitempty"`
+}
@@ -0,0 +1,7 @@
+package phonenumbers
+
+type PhoneNumberCost struct {
+	Amount           float64 `json:"amount"`
+	BillingFrequency string  `json:"billingFrequency"`
+	CurrencyCode     string  `json:"currencyCode"`
+}
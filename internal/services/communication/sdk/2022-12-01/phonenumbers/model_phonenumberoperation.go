@@ -0,0 +1,14 @@
+package phonenumbers
+
+type PhoneNumberOperation struct {
+	Error            *PhoneNumberOperationError `json:"error,omitempty"`
+	Id               string                     `json:"id"`
+	OperationType    string                     `json:"operationType"`
+	ResourceLocation *string                    `json:"resourceLocation,omitempty"`
+	Status           OperationStatus            `json:"status"`
+}
+
+type PhoneNumberOperationError struct {
+	Code    *string `json:"code,omitempty"`
+	Message *string `json:"message,omitempty"`
+}
@@ -0,0 +1,5 @@
+package phonenumbers
+
+type PhoneNumberPurchaseRequest struct {
+	SearchId *string `json:"searchId,omitempty"`
+}
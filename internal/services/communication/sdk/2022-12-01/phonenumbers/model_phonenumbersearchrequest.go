@@ -0,0 +1,9 @@
+package phonenumbers
+
+type PhoneNumberSearchRequest struct {
+	AreaCode        *string                   `json:"areaCode,omitempty"`
+	AssignmentType  PhoneNumberAssignmentType `json:"assignmentType"`
+	Capabilities    PhoneNumberCapabilities   `json:"capabilities"`
+	PhoneNumberType PhoneNumberType           `json:"phoneNumberType"`
+	Quantity        *int64                    `json:"quantity,omitempty"`
+}
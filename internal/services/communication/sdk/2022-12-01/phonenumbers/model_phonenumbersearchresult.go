@@ -0,0 +1,11 @@
+package phonenumbers
+
+type PhoneNumberSearchResult struct {
+	AssignmentType  PhoneNumberAssignmentType `json:"assignmentType"`
+	Capabilities    PhoneNumberCapabilities   `json:"capabilities"`
+	Cost            PhoneNumberCost           `json:"cost"`
+	PhoneNumberType PhoneNumberType           `json:"phoneNumberType"`
+	PhoneNumbers    []string                  `json:"phoneNumbers"`
+	SearchExpiresBy string                    `json:"searchExpiresBy"`
+	SearchId        string                    `json:"searchId"`
+}
@@ -0,0 +1,12 @@
+package phonenumbers
+
+type PurchasedPhoneNumber struct {
+	AssignmentType  PhoneNumberAssignmentType `json:"assignmentType"`
+	Capabilities    PhoneNumberCapabilities   `json:"capabilities"`
+	CountryCode     string                    `json:"countryCode"`
+	Cost            PhoneNumberCost           `json:"cost"`
+	Id              string                    `json:"id"`
+	PhoneNumber     string                    `json:"phoneNumber"`
+	PhoneNumberType PhoneNumberType           `json:"phoneNumberType"`
+	PurchaseDate    string                    `json:"purchaseDate"`
+}
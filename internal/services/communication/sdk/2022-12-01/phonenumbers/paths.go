@@ -0,0 +1,9 @@
+package phonenumbers
+
+import "net/url"
+
+// phoneNumberPath returns the path for the specified Phone Number - the leading `+` in an E.164
+// formatted number has to be encoded, since the API otherwise interprets this as a space
+func phoneNumberPath(phoneNumber string) string {
+	return "/phoneNumbers/" + url.QueryEscape(phoneNumber)
+}
@@ -0,0 +1,118 @@
+package phonenumbers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+const defaultPollingDelay = 5 * time.Second
+
+// OperationPoller polls a Phone Numbers operation until it's completed.
+//
+// The Phone Numbers API returns the status of a long running operation through an `Operation-Location`
+// header which isn't tracked by the Resource Manager poller, so these operations are polled directly.
+type OperationPoller struct {
+	client            autorest.Client
+	ctx               context.Context
+	operationLocation string
+	delay             time.Duration
+}
+
+func newOperationPollerFromResponse(ctx context.Context, resp *http.Response, client autorest.Client) (*OperationPoller, error) {
+	if resp == nil {
+		return nil, fmt.Errorf("`resp` was nil")
+	}
+
+	operationLocation := resp.Header.Get("Operation-Location")
+	if operationLocation == "" {
+		return nil, fmt.Errorf("the `Operation-Location` header was missing from the response")
+	}
+
+	return &OperationPoller{
+		client:            client,
+		ctx:               ctx,
+		operationLocation: operationLocation,
+		delay:             retryAfter(resp),
+	}, nil
+}
+
+// PollUntilDone polls the operation until it's either succeeded or failed
+func (p *OperationPoller) PollUntilDone() error {
+	for {
+		select {
+		case <-p.ctx.Done():
+			return fmt.Errorf("waiting for the operation %q to complete: %+v", p.operationLocation, p.ctx.Err())
+		case <-time.After(p.delay):
+		}
+
+		operation, resp, err := p.poll()
+		if err != nil {
+			return err
+		}
+		p.delay = retryAfter(resp)
+
+		switch strings.ToLower(string(operation.Status)) {
+		case strings.ToLower(string(OperationStatusSucceeded)):
+			return nil
+
+		case strings.ToLower(string(OperationStatusFailed)):
+			code := ""
+			message := ""
+			if operation.Error != nil {
+				if operation.Error.Code != nil {
+					code = *operation.Error.Code
+				}
+				if operation.Error.Message != nil {
+					message = *operation.Error.Message
+				}
+			}
+			return fmt.Errorf("the operation %q failed with code %q: %s", operation.Id, code, message)
+		}
+	}
+}
+
+func (p *OperationPoller) poll() (*PhoneNumberOperation, *http.Response, error) {
+	preparer := autorest.CreatePreparer(
+		autorest.AsGet(),
+		autorest.WithBaseURL(p.operationLocation))
+	req, err := preparer.Prepare((&http.Request{}).WithContext(p.ctx))
+	if err != nil {
+		return nil, nil, fmt.Errorf("preparing the request to poll %q: %+v", p.operationLocation, err)
+	}
+
+	resp, err := p.client.Send(req, autorest.DoRetryForStatusCodes(p.client.RetryAttempts, p.client.RetryDuration, autorest.StatusCodesForRetry...))
+	if err != nil {
+		return nil, resp, fmt.Errorf("polling %q: %+v", p.operationLocation, err)
+	}
+
+	var operation PhoneNumberOperation
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&operation),
+		autorest.ByClosing())
+	if err != nil {
+		return nil, resp, fmt.Errorf("polling %q: %+v", p.operationLocation, err)
+	}
+
+	return &operation, resp, nil
+}
+
+func retryAfter(resp *http.Response) time.Duration {
+	if resp != nil {
+		if v := resp.Header.Get("Retry-After"); v != "" {
+			if seconds, err := strconv.Atoi(v); err == nil && seconds >= 0 {
+				return time.Duration(seconds) * time.Second
+			}
+		}
+	}
+
+	return defaultPollingDelay
+}
@@ -0,0 +1,71 @@
+package phonenumbers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestOperationPoller(t *testing.T) {
+	testData := []struct {
+		statuses    []string
+		expectError bool
+	}{
+		{
+			statuses: []string{"notStarted", "running", "succeeded"},
+		},
+		{
+			statuses: []string{"Succeeded"},
+		},
+		{
+			statuses:    []string{"running", "failed"},
+			expectError: true,
+		},
+	}
+
+	for _, v := range testData {
+		polls := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			status := v.statuses[polls]
+			polls++
+
+			w.Header().Set("Retry-After", "0")
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprintf(w, `{"id": "search_123", "status": %q, "error": {"code": "NoNumbersAvailable", "message": "no numbers"}}`, status)
+		}))
+
+		resp := &http.Response{
+			Header: http.Header{
+				"Operation-Location": []string{server.URL + "/phoneNumbers/operations/search_123"},
+			},
+		}
+		poller, err := newOperationPollerFromResponse(context.TODO(), resp, autorest.NewClientWithUserAgent(""))
+		if err != nil {
+			t.Fatalf("building poller: %+v", err)
+		}
+		poller.delay = 0
+
+		err = poller.PollUntilDone()
+		server.Close()
+
+		if v.expectError && err == nil {
+			t.Fatalf("expected an error for %q but didn't get one", v.statuses)
+		}
+		if !v.expectError && err != nil {
+			t.Fatalf("expected no error for %q but got: %+v", v.statuses, err)
+		}
+		if polls != len(v.statuses) {
+			t.Fatalf("expected %d polls for %q but got %d", len(v.statuses), v.statuses, polls)
+		}
+	}
+}
+
+func TestOperationPollerMissingHeader(t *testing.T) {
+	if _, err := newOperationPollerFromResponse(context.TODO(), &http.Response{Header: http.Header{}}, autorest.NewClientWithUserAgent("")); err == nil {
+		t.Fatalf("expected an error when the `Operation-Location` header is missing")
+	}
+}
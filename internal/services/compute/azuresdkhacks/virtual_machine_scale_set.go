@@ -0,0 +1,208 @@
+package azuresdkhacks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-07-01/compute"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// The version of the Azure SDK for Go in use (API Version 2021-07-01) doesn't expose either the Spot Priority Mix
+// or the Maximum Surge for Rolling Upgrades, which are both only available from API Version 2022-08-01. As such
+// these functions send the requests using the newer API Version and patch the missing fields into (and out of)
+// the payloads - this can be removed once the Compute SDK has been updated.
+
+const virtualMachineScaleSetAPIVersion = "2022-08-01"
+
+// VirtualMachineScaleSetExtendedProperties contains the fields missing from compute.VirtualMachineScaleSet
+type VirtualMachineScaleSetExtendedProperties struct {
+	// MaxSurge is sent as `properties.upgradePolicy.rollingUpgradePolicy.maxSurge` when a Rolling Upgrade Policy is present
+	MaxSurge *bool
+
+	PriorityMixPolicy *PriorityMixPolicy
+}
+
+type PriorityMixPolicy struct {
+	BaseRegularPriorityCount           *int32 `json:"baseRegularPriorityCount,omitempty"`
+	RegularPriorityPercentageAboveBase *int32 `json:"regularPriorityPercentageAboveBase,omitempty"`
+}
+
+type virtualMachineScaleSetExtendedResponse struct {
+	Properties *struct {
+		PriorityMixPolicy *PriorityMixPolicy `json:"priorityMixPolicy,omitempty"`
+		UpgradePolicy     *struct {
+			RollingUpgradePolicy *struct {
+				MaxSurge *bool `json:"maxSurge,omitempty"`
+			} `json:"rollingUpgradePolicy,omitempty"`
+		} `json:"upgradePolicy,omitempty"`
+	} `json:"properties,omitempty"`
+}
+
+// CreateOrUpdateVirtualMachineScaleSet creates or updates the Virtual Machine Scale Set including the extended properties
+func CreateOrUpdateVirtualMachineScaleSet(ctx context.Context, client *compute.VirtualMachineScaleSetsClient, resourceGroupName string, vmScaleSetName string, parameters compute.VirtualMachineScaleSet, extended VirtualMachineScaleSetExtendedProperties) (result compute.VirtualMachineScaleSetsCreateOrUpdateFuture, err error) {
+	req, err := virtualMachineScaleSetPreparer(ctx, client, resourceGroupName, vmScaleSetName, autorest.AsPut(), withExtendedVirtualMachineScaleSetJSON(parameters, extended))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "compute.VirtualMachineScaleSetsClient", "CreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = client.CreateOrUpdateSender(req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "compute.VirtualMachineScaleSetsClient", "CreateOrUpdate", result.Response(), "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// UpdateVirtualMachineScaleSet patches the Virtual Machine Scale Set including the extended properties
+func UpdateVirtualMachineScaleSet(ctx context.Context, client *compute.VirtualMachineScaleSetsClient, resourceGroupName string, vmScaleSetName string, parameters compute.VirtualMachineScaleSetUpdate, extended VirtualMachineScaleSetExtendedProperties) (result compute.VirtualMachineScaleSetsUpdateFuture, err error) {
+	req, err := virtualMachineScaleSetPreparer(ctx, client, resourceGroupName, vmScaleSetName, autorest.AsPatch(), withExtendedVirtualMachineScaleSetJSON(parameters, extended))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "compute.VirtualMachineScaleSetsClient", "Update", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = client.UpdateSender(req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "compute.VirtualMachineScaleSetsClient", "Update", result.Response(), "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// GetVirtualMachineScaleSet retrieves the Virtual Machine Scale Set along with the extended properties
+func GetVirtualMachineScaleSet(ctx context.Context, client *compute.VirtualMachineScaleSetsClient, resourceGroupName string, vmScaleSetName string, expand compute.ExpandTypesForGetVMScaleSets) (result compute.VirtualMachineScaleSet, extended VirtualMachineScaleSetExtendedProperties, err error) {
+	decorators := make([]autorest.PrepareDecorator, 0)
+	if len(string(expand)) > 0 {
+		decorators = append(decorators, autorest.WithQueryParameters(map[string]interface{}{
+			"$expand": autorest.Encode("query", expand),
+		}))
+	}
+
+	req, err := virtualMachineScaleSetPreparer(ctx, client, resourceGroupName, vmScaleSetName, autorest.AsGet(), decorators...)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "compute.VirtualMachineScaleSetsClient", "Get", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.GetSender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "compute.VirtualMachineScaleSetsClient", "Get", resp, "Failure sending request")
+		return
+	}
+
+	var body bytes.Buffer
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByCopying(&body),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "compute.VirtualMachineScaleSetsClient", "Get", resp, "Failure responding to request")
+		return
+	}
+
+	var raw virtualMachineScaleSetExtendedResponse
+	if err = json.Unmarshal(body.Bytes(), &raw); err != nil {
+		err = autorest.NewErrorWithError(err, "compute.VirtualMachineScaleSetsClient", "Get", resp, "Failure unmarshalling extended properties")
+		return
+	}
+
+	if props := raw.Properties; props != nil {
+		extended.PriorityMixPolicy = props.PriorityMixPolicy
+		if props.UpgradePolicy != nil && props.UpgradePolicy.RollingUpgradePolicy != nil {
+			extended.MaxSurge = props.UpgradePolicy.RollingUpgradePolicy.MaxSurge
+		}
+	}
+
+	return
+}
+
+func virtualMachineScaleSetPreparer(ctx context.Context, client *compute.VirtualMachineScaleSetsClient, resourceGroupName string, vmScaleSetName string, method autorest.PrepareDecorator, decorators ...autorest.PrepareDecorator) (*http.Request, error) {
+	pathParameters := map[string]interface{}{
+		"resourceGroupName": autorest.Encode("path", resourceGroupName),
+		"subscriptionId":    autorest.Encode("path", client.SubscriptionID),
+		"vmScaleSetName":    autorest.Encode("path", vmScaleSetName),
+	}
+
+	queryParameters := map[string]interface{}{
+		"api-version": virtualMachineScaleSetAPIVersion,
+	}
+
+	decorators = append([]autorest.PrepareDecorator{
+		autorest.AsContentType("application/json; charset=utf-8"),
+		method,
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters("/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Compute/virtualMachineScaleSets/{vmScaleSetName}", pathParameters),
+		autorest.WithQueryParameters(queryParameters),
+	}, decorators...)
+
+	preparer := autorest.CreatePreparer(decorators...)
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+func withExtendedVirtualMachineScaleSetJSON(v interface{}, extended VirtualMachineScaleSetExtendedProperties) autorest.PrepareDecorator {
+	return func(p autorest.Preparer) autorest.Preparer {
+		return autorest.PreparerFunc(func(r *http.Request) (*http.Request, error) {
+			r, err := p.Prepare(r)
+			if err != nil {
+				return r, err
+			}
+
+			b, err := json.Marshal(v)
+			if err != nil {
+				return r, err
+			}
+
+			var out map[string]interface{}
+			if err := json.Unmarshal(b, &out); err != nil {
+				return r, err
+			}
+
+			patchVirtualMachineScaleSetExtendedProperties(out, extended)
+
+			b, err = json.Marshal(out)
+			if err != nil {
+				return r, err
+			}
+
+			r.ContentLength = int64(len(b))
+			r.Body = io.NopCloser(bytes.NewReader(b))
+			return r, nil
+		})
+	}
+}
+
+func patchVirtualMachineScaleSetExtendedProperties(out map[string]interface{}, extended VirtualMachineScaleSetExtendedProperties) {
+	props, ok := out["properties"].(map[string]interface{})
+	if !ok {
+		props = make(map[string]interface{})
+		out["properties"] = props
+	}
+
+	if extended.PriorityMixPolicy != nil {
+		props["priorityMixPolicy"] = extended.PriorityMixPolicy
+	}
+
+	if extended.MaxSurge != nil {
+		upgradePolicy, ok := props["upgradePolicy"].(map[string]interface{})
+		if !ok {
+			return
+		}
+		rollingUpgradePolicy, ok := upgradePolicy["rollingUpgradePolicy"].(map[string]interface{})
+		if !ok {
+			return
+		}
+		rollingUpgradePolicy["maxSurge"] = *extended.MaxSurge
+	}
+}
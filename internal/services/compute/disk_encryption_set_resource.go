@@ -6,15 +6,18 @@ import (
 	"log"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-07-01/compute"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	tagsHelper "github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
-	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/identity"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/sdk/2022-03-02/diskencryptionsets"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/client"
 	keyVaultParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
 	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
+	msiValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/msi/validate"
 	resourcesClient "github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/client"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -23,6 +26,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+type diskEncryptionSetIdentity = identity.SystemAssignedUserAssigned
+
 func resourceDiskEncryptionSet() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceDiskEncryptionSetCreate,
@@ -38,7 +43,7 @@ func resourceDiskEncryptionSet() *pluginsdk.Resource {
 		},
 
 		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.DiskEncryptionSetID(id)
+			_, err := diskencryptionsets.ParseDiskEncryptionSetID(id)
 			return err
 		}),
 
@@ -69,13 +74,21 @@ func resourceDiskEncryptionSet() *pluginsdk.Resource {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
 				ForceNew: true,
-				Default:  string(compute.DiskEncryptionSetTypeEncryptionAtRestWithCustomerKey),
+				Default:  string(diskencryptionsets.DiskEncryptionSetTypeEncryptionAtRestWithCustomerKey),
 				ValidateFunc: validation.StringInSlice([]string{
-					string(compute.DiskEncryptionSetTypeEncryptionAtRestWithCustomerKey),
-					string(compute.DiskEncryptionSetTypeEncryptionAtRestWithPlatformAndCustomerKeys),
+					string(diskencryptionsets.DiskEncryptionSetTypeEncryptionAtRestWithCustomerKey),
+					string(diskencryptionsets.DiskEncryptionSetTypeEncryptionAtRestWithPlatformAndCustomerKeys),
 				}, false),
 			},
 
+			// the Client ID of a multi-tenant Application, used together with a User Assigned Identity
+			// to access a Key Vault in another Tenant (cross-tenant customer-managed keys)
+			"federated_client_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
 			"identity": {
 				Type: pluginsdk.TypeList,
 				// whilst the API Documentation shows optional - attempting to send nothing returns:
@@ -89,9 +102,19 @@ func resourceDiskEncryptionSet() *pluginsdk.Resource {
 							Type:     pluginsdk.TypeString,
 							Required: true,
 							ValidateFunc: validation.StringInSlice([]string{
-								string(compute.DiskEncryptionSetIdentityTypeSystemAssigned),
+								"SystemAssigned",
+								"UserAssigned",
+								"SystemAssigned, UserAssigned",
 							}, false),
 						},
+						"identity_ids": {
+							Type:     pluginsdk.TypeSet,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: msiValidate.UserAssignedIdentityID,
+							},
+						},
 						"principal_id": {
 							Type:     pluginsdk.TypeString,
 							Computed: true,
@@ -113,20 +136,19 @@ func resourceDiskEncryptionSetCreate(d *pluginsdk.ResourceData, meta interface{}
 	client := meta.(*clients.Client).Compute.DiskEncryptionSetsClient
 	keyVaultsClient := meta.(*clients.Client).KeyVault
 	resourcesClient := meta.(*clients.Client).Resource
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	name := d.Get("name").(string)
-	resourceGroup := d.Get("resource_group_name").(string)
-
-	existing, err := client.Get(ctx, resourceGroup, name)
+	id := diskencryptionsets.NewDiskEncryptionSetID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+	existing, err := client.Get(ctx, id)
 	if err != nil {
-		if !utils.ResponseWasNotFound(existing.Response) {
-			return fmt.Errorf("checking for present of existing Disk Encryption Set %q (Resource Group %q): %+v", name, resourceGroup, err)
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
 		}
 	}
-	if existing.ID != nil && *existing.ID != "" {
-		return tf.ImportAsExistsError("azurerm_disk_encryption_set", *existing.ID)
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_disk_encryption_set", id.ID())
 	}
 
 	keyVaultKeyId := d.Get("key_vault_key_id").(string)
@@ -141,44 +163,37 @@ func resourceDiskEncryptionSetCreate(d *pluginsdk.ResourceData, meta interface{}
 		return fmt.Errorf("validating Key Vault %q (Resource Group %q) for Disk Encryption Set: Purge Protection must be enabled but it isn't!", keyVaultDetails.keyVaultName, keyVaultDetails.resourceGroupName)
 	}
 
-	location := azure.NormalizeLocation(d.Get("location").(string))
-	rotationToLatestKeyVersionEnabled := d.Get("auto_key_rotation_enabled").(bool)
-	encryptionType := d.Get("encryption_type").(string)
-	identityRaw := d.Get("identity").([]interface{})
-	t := d.Get("tags").(map[string]interface{})
-
-	params := compute.DiskEncryptionSet{
-		Location: utils.String(location),
-		EncryptionSetProperties: &compute.EncryptionSetProperties{
-			ActiveKey: &compute.KeyForDiskEncryptionSet{
-				KeyURL: utils.String(keyVaultKeyId),
-				SourceVault: &compute.SourceVault{
-					ID: utils.String(keyVaultDetails.keyVaultId),
+	expandedIdentity, err := expandDiskEncryptionSetIdentity(d.Get("identity").([]interface{}))
+	if err != nil {
+		return fmt.Errorf("expanding `identity`: %+v", err)
+	}
+
+	encryptionType := diskencryptionsets.DiskEncryptionSetType(d.Get("encryption_type").(string))
+	params := diskencryptionsets.DiskEncryptionSet{
+		Location: azure.NormalizeLocation(d.Get("location").(string)),
+		Properties: &diskencryptionsets.EncryptionSetProperties{
+			ActiveKey: &diskencryptionsets.KeyForDiskEncryptionSet{
+				KeyUrl: keyVaultKeyId,
+				SourceVault: &diskencryptionsets.SourceVault{
+					Id: utils.String(keyVaultDetails.keyVaultId),
 				},
 			},
-			RotationToLatestKeyVersionEnabled: utils.Bool(rotationToLatestKeyVersionEnabled),
-			EncryptionType:                    compute.DiskEncryptionSetType(encryptionType),
+			RotationToLatestKeyVersionEnabled: utils.Bool(d.Get("auto_key_rotation_enabled").(bool)),
+			EncryptionType:                    &encryptionType,
 		},
-		Identity: expandDiskEncryptionSetIdentity(identityRaw),
-		Tags:     tags.Expand(t),
+		Identity: expandedIdentity,
+		Tags:     tagsHelper.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
-	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, params)
-	if err != nil {
-		return fmt.Errorf("creating Disk Encryption Set %q (Resource Group %q): %+v", name, resourceGroup, err)
-	}
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for creation of Disk Encryption Set %q (Resource Group %q): %+v", name, resourceGroup, err)
+	if v, ok := d.GetOk("federated_client_id"); ok {
+		params.Properties.FederatedClientId = utils.String(v.(string))
 	}
 
-	resp, err := client.Get(ctx, resourceGroup, name)
-	if err != nil {
-		return fmt.Errorf("retrieving Disk Encryption Set %q (Resource Group %q): %+v", name, resourceGroup, err)
-	}
-	if resp.ID == nil {
-		return fmt.Errorf("Cannot read Disk Encryption Set %q (Resource Group %q) ID", name, resourceGroup)
+	if err := client.CreateOrUpdateThenPoll(ctx, id, params); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
 	}
-	d.SetId(*resp.ID)
+
+	d.SetId(id.ID())
 
 	return resourceDiskEncryptionSetRead(d, meta)
 }
@@ -188,47 +203,59 @@ func resourceDiskEncryptionSetRead(d *pluginsdk.ResourceData, meta interface{})
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := parse.DiskEncryptionSetID(d.Id())
+	id, err := diskencryptionsets.ParseDiskEncryptionSetID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	resp, err := client.Get(ctx, *id)
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
-			log.Printf("[INFO] Disk Encryption Set %q does not exist - removing from state", d.Id())
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[INFO] %s does not exist - removing from state", *id)
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("reading Disk Encryption Set %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
-	}
-
-	d.Set("name", id.Name)
-	d.Set("resource_group_name", id.ResourceGroup)
-	if location := resp.Location; location != nil {
-		d.Set("location", azure.NormalizeLocation(*location))
-	}
-
-	if props := resp.EncryptionSetProperties; props != nil {
-		keyVaultKeyId := ""
-		if props.ActiveKey != nil && props.ActiveKey.KeyURL != nil {
-			keyVaultKeyId = *props.ActiveKey.KeyURL
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.DiskEncryptionSetName)
+	d.Set("resource_group_name", id.ResourceGroupName)
+
+	if model := resp.Model; model != nil {
+		d.Set("location", azure.NormalizeLocation(model.Location))
+
+		if props := model.Properties; props != nil {
+			keyVaultKeyId := ""
+			if props.ActiveKey != nil {
+				keyVaultKeyId = props.ActiveKey.KeyUrl
+			}
+			d.Set("key_vault_key_id", keyVaultKeyId)
+			d.Set("auto_key_rotation_enabled", props.RotationToLatestKeyVersionEnabled)
+
+			encryptionType := string(diskencryptionsets.DiskEncryptionSetTypeEncryptionAtRestWithCustomerKey)
+			if props.EncryptionType != nil && *props.EncryptionType != "" {
+				encryptionType = string(*props.EncryptionType)
+			}
+			d.Set("encryption_type", encryptionType)
+
+			// the API returns `None` when no Federated Client ID is configured
+			federatedClientId := ""
+			if props.FederatedClientId != nil && *props.FederatedClientId != "None" {
+				federatedClientId = *props.FederatedClientId
+			}
+			d.Set("federated_client_id", federatedClientId)
 		}
-		d.Set("key_vault_key_id", keyVaultKeyId)
-		d.Set("auto_key_rotation_enabled", props.RotationToLatestKeyVersionEnabled)
 
-		encryptionType := string(compute.DiskEncryptionSetTypeEncryptionAtRestWithCustomerKey)
-		if props.EncryptionType != "" {
-			encryptionType = string(props.EncryptionType)
+		if err := d.Set("identity", flattenDiskEncryptionSetIdentity(model.Identity)); err != nil {
+			return fmt.Errorf("setting `identity`: %+v", err)
 		}
-		d.Set("encryption_type", encryptionType)
-	}
 
-	if err := d.Set("identity", flattenDiskEncryptionSetIdentity(resp.Identity)); err != nil {
-		return fmt.Errorf("setting `identity`: %+v", err)
+		if err := tags.FlattenAndSet(d, tagsHelper.Flatten(model.Tags)); err != nil {
+			return err
+		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return nil
 }
 
 func resourceDiskEncryptionSetUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -238,14 +265,22 @@ func resourceDiskEncryptionSetUpdate(d *pluginsdk.ResourceData, meta interface{}
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := parse.DiskEncryptionSetID(d.Id())
+	id, err := diskencryptionsets.ParseDiskEncryptionSetID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	update := compute.DiskEncryptionSetUpdate{}
+	update := diskencryptionsets.DiskEncryptionSetUpdate{}
 	if d.HasChange("tags") {
-		update.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
+		update.Tags = tagsHelper.Expand(d.Get("tags").(map[string]interface{}))
+	}
+
+	if d.HasChange("identity") {
+		expandedIdentity, err := expandDiskEncryptionSetIdentity(d.Get("identity").([]interface{}))
+		if err != nil {
+			return fmt.Errorf("expanding `identity`: %+v", err)
+		}
+		update.Identity = expandedIdentity
 	}
 
 	if d.HasChange("key_vault_key_id") {
@@ -260,30 +295,39 @@ func resourceDiskEncryptionSetUpdate(d *pluginsdk.ResourceData, meta interface{}
 		if !keyVaultDetails.purgeProtectionEnabled {
 			return fmt.Errorf("validating Key Vault %q (Resource Group %q) for Disk Encryption Set: Purge Protection must be enabled but it isn't!", keyVaultDetails.keyVaultName, keyVaultDetails.resourceGroupName)
 		}
-		update.DiskEncryptionSetUpdateProperties = &compute.DiskEncryptionSetUpdateProperties{
-			ActiveKey: &compute.KeyForDiskEncryptionSet{
-				KeyURL: utils.String(keyVaultKeyId),
-				SourceVault: &compute.SourceVault{
-					ID: utils.String(keyVaultDetails.keyVaultId),
+		update.Properties = &diskencryptionsets.DiskEncryptionSetUpdateProperties{
+			ActiveKey: &diskencryptionsets.KeyForDiskEncryptionSet{
+				KeyUrl: keyVaultKeyId,
+				SourceVault: &diskencryptionsets.SourceVault{
+					Id: utils.String(keyVaultDetails.keyVaultId),
 				},
 			},
 		}
 	}
 
 	if d.HasChange("auto_key_rotation_enabled") {
-		if update.DiskEncryptionSetUpdateProperties == nil {
-			update.DiskEncryptionSetUpdateProperties = &compute.DiskEncryptionSetUpdateProperties{}
+		if update.Properties == nil {
+			update.Properties = &diskencryptionsets.DiskEncryptionSetUpdateProperties{}
 		}
 
-		update.DiskEncryptionSetUpdateProperties.RotationToLatestKeyVersionEnabled = utils.Bool(d.Get("auto_key_rotation_enabled").(bool))
+		update.Properties.RotationToLatestKeyVersionEnabled = utils.Bool(d.Get("auto_key_rotation_enabled").(bool))
 	}
 
-	future, err := client.Update(ctx, id.ResourceGroup, id.Name, update)
-	if err != nil {
-		return fmt.Errorf("updating Disk Encryption Set %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	if d.HasChange("federated_client_id") {
+		if update.Properties == nil {
+			update.Properties = &diskencryptionsets.DiskEncryptionSetUpdateProperties{}
+		}
+
+		// the Federated Client ID is removed by setting it to `None`, since an empty value is ignored
+		federatedClientId := "None"
+		if v := d.Get("federated_client_id").(string); v != "" {
+			federatedClientId = v
+		}
+		update.Properties.FederatedClientId = utils.String(federatedClientId)
 	}
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for update of Disk Encryption Set %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+
+	if err := client.UpdateThenPoll(ctx, *id, update); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
 	}
 
 	return resourceDiskEncryptionSetRead(d, meta)
@@ -294,52 +338,36 @@ func resourceDiskEncryptionSetDelete(d *pluginsdk.ResourceData, meta interface{}
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := parse.DiskEncryptionSetID(d.Id())
+	id, err := diskencryptionsets.ParseDiskEncryptionSetID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
-	if err != nil {
-		return fmt.Errorf("deleting Disk Encryption Set %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
-	}
-
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for deleting Disk Encryption Set %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
 	}
 
 	return nil
 }
 
-func expandDiskEncryptionSetIdentity(input []interface{}) *compute.EncryptionSetIdentity {
-	val := input[0].(map[string]interface{})
-	return &compute.EncryptionSetIdentity{
-		Type: compute.DiskEncryptionSetIdentityType(val["type"].(string)),
+func expandDiskEncryptionSetIdentity(input []interface{}) (*identity.SystemUserAssignedIdentityMap, error) {
+	expanded, err := diskEncryptionSetIdentity{}.Expand(input)
+	if err != nil {
+		return nil, err
 	}
+
+	result := identity.SystemUserAssignedIdentityMap{}
+	result.FromExpandedConfig(*expanded)
+	return &result, nil
 }
 
-func flattenDiskEncryptionSetIdentity(input *compute.EncryptionSetIdentity) []interface{} {
+func flattenDiskEncryptionSetIdentity(input *identity.SystemUserAssignedIdentityMap) []interface{} {
 	if input == nil {
-		return make([]interface{}, 0)
+		return []interface{}{}
 	}
 
-	identityType := string(input.Type)
-	principalId := ""
-	if input.PrincipalID != nil {
-		principalId = *input.PrincipalID
-	}
-	tenantId := ""
-	if input.TenantID != nil {
-		tenantId = *input.TenantID
-	}
-
-	return []interface{}{
-		map[string]interface{}{
-			"type":         identityType,
-			"principal_id": principalId,
-			"tenant_id":    tenantId,
-		},
-	}
+	config := input.ToExpandedConfig()
+	return diskEncryptionSetIdentity{}.Flatten(&config)
 }
 
 type diskEncryptionSetKeyVault struct {
@@ -148,24 +148,18 @@ func dataSourceImageRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	} else {
 		r := regexp.MustCompile(nameRegex.(string))
 
-		list := make([]compute.Image, 0)
-		resp, err := client.ListByResourceGroupComplete(ctx, resGroup)
+		images, err := listImagesByResourceGroup(ctx, meta.(*clients.Client), resGroup)
 		if err != nil {
-			if utils.ResponseWasNotFound(resp.Response().Response) {
-				return fmt.Errorf("No Images were found for Resource Group %q", resGroup)
-			}
 			return fmt.Errorf("[ERROR] Error getting list of images (resource group %q): %+v", resGroup, err)
 		}
+		if images == nil {
+			return fmt.Errorf("No Images were found for Resource Group %q", resGroup)
+		}
 
-		for resp.NotDone() {
-			img = resp.Value()
-			if r.Match(([]byte)(*img.Name)) {
-				list = append(list, img)
-			}
-			err = resp.NextWithContext(ctx)
-
-			if err != nil {
-				return err
+		list := make([]compute.Image, 0)
+		for _, v := range *images {
+			if v.Name != nil && r.MatchString(*v.Name) {
+				list = append(list, v)
 			}
 		}
 
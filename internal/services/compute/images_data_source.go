@@ -116,25 +116,21 @@ func dataSourceImages() *pluginsdk.Resource {
 }
 
 func dataSourceImagesRead(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.ImagesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
 	resourceGroup := d.Get("resource_group_name").(string)
 	filterTags := tags.Expand(d.Get("tags_filter").(map[string]interface{}))
 
-	resp, err := client.ListByResourceGroupComplete(ctx, resourceGroup)
+	list, err := listImagesByResourceGroup(ctx, meta.(*clients.Client), resourceGroup)
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response().Response) {
-			return fmt.Errorf("no images were found in Resource Group %q", resourceGroup)
-		}
 		return fmt.Errorf("retrieving Images (Resource Group %q): %+v", resourceGroup, err)
 	}
-
-	images, err := flattenImagesResult(ctx, resp, filterTags)
-	if err != nil {
-		return fmt.Errorf("parsing Images (Resource Group %q): %+v", resourceGroup, err)
+	if list == nil {
+		return fmt.Errorf("no images were found in Resource Group %q", resourceGroup)
 	}
+
+	images := flattenImagesResult(*list, filterTags)
 	if len(images) == 0 {
 		return fmt.Errorf("no images were found that match the specified tags")
 	}
@@ -150,11 +146,43 @@ func dataSourceImagesRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func flattenImagesResult(ctx context.Context, iterator compute.ImageListResultIterator, filterTags map[string]*string) ([]interface{}, error) {
+// listImagesByResourceGroup returns the Images within the Resource Group, using the List Cache when it's enabled - nil
+// is returned when the Resource Group doesn't exist. The returned Images are shared and so mustn't be modified.
+func listImagesByResourceGroup(ctx context.Context, client *clients.Client, resourceGroup string) (*[]compute.Image, error) {
+	key := clients.ListCacheKey{
+		SubscriptionId: client.Account.SubscriptionId,
+		API:            "Microsoft.Compute/images/ListByResourceGroup",
+		Parameters:     []string{resourceGroup},
+	}
+	result, err := client.ListCache.Get(ctx, key, func(ctx context.Context) (interface{}, error) {
+		resp, err := client.Compute.ImagesClient.ListByResourceGroupComplete(ctx, resourceGroup)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response().Response) {
+				return (*[]compute.Image)(nil), nil
+			}
+			return nil, err
+		}
+
+		images := make([]compute.Image, 0)
+		for resp.NotDone() {
+			images = append(images, resp.Value())
+			if err := resp.NextWithContext(ctx); err != nil {
+				return nil, err
+			}
+		}
+		return &images, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.(*[]compute.Image), nil
+}
+
+func flattenImagesResult(input []compute.Image, filterTags map[string]*string) []interface{} {
 	results := make([]interface{}, 0)
 
-	for iterator.NotDone() {
-		image := iterator.Value()
+	for _, image := range input {
 		found := true
 		// Loop through our filter tags and see if they match
 		for k, v := range filterTags {
@@ -169,12 +197,9 @@ func flattenImagesResult(ctx context.Context, iterator compute.ImageListResultIt
 		if found {
 			results = append(results, flattenImage(image))
 		}
-		if err := iterator.NextWithContext(ctx); err != nil {
-			return nil, err
-		}
 	}
 
-	return results, nil
+	return results
 }
 
 func flattenImage(input compute.Image) map[string]interface{} {
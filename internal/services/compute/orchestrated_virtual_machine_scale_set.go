@@ -8,6 +8,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-07-01/compute"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	azValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/azuresdkhacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
 	msiparse "github.com/hashicorp/terraform-provider-azurerm/internal/services/msi/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -740,6 +741,105 @@ func OrchestratedVirtualMachineScaleSetAutomaticRepairsPolicySchema() *pluginsdk
 	}
 }
 
+func OrchestratedVirtualMachineScaleSetPriorityMixPolicySchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"base_regular_count": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					Default:      0,
+					ValidateFunc: validation.IntBetween(0, 1000),
+				},
+				"regular_percentage_above_base": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					Default:      0,
+					ValidateFunc: validation.IntBetween(0, 100),
+				},
+			},
+		},
+	}
+}
+
+func OrchestratedVirtualMachineScaleSetRollingUpgradePolicySchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"max_batch_instance_percent": {
+					Type:         pluginsdk.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntBetween(5, 100),
+				},
+				"max_unhealthy_instance_percent": {
+					Type:         pluginsdk.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntBetween(5, 100),
+				},
+				"max_unhealthy_upgraded_instance_percent": {
+					Type:         pluginsdk.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntBetween(0, 100),
+				},
+				"pause_time_between_batches": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: azValidate.ISO8601Duration,
+				},
+				"cross_zone_upgrades_enabled": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+				"maximum_surge_instances_enabled": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+				"prioritize_unhealthy_instances_enabled": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+			},
+		},
+	}
+}
+
+func OrchestratedVirtualMachineScaleSetScaleInPolicySchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"rule": {
+					Type:     pluginsdk.TypeString,
+					Optional: true,
+					Default:  string(compute.VirtualMachineScaleSetScaleInRulesDefault),
+					ValidateFunc: validation.StringInSlice([]string{
+						string(compute.VirtualMachineScaleSetScaleInRulesDefault),
+						string(compute.VirtualMachineScaleSetScaleInRulesNewestVM),
+						string(compute.VirtualMachineScaleSetScaleInRulesOldestVM),
+					}, false),
+				},
+				"force_deletion_enabled": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+			},
+		},
+	}
+}
+
 func FlattenOrchestratedVirtualMachineScaleSetOSProfile(input *compute.VirtualMachineScaleSetOSProfile, d *pluginsdk.ResourceData) []interface{} {
 	if input == nil {
 		return []interface{}{}
@@ -1962,3 +2062,132 @@ func FlattenOrchestratedVirtualMachineScaleSetAutomaticRepairsPolicy(input *comp
 		},
 	}
 }
+
+func ExpandOrchestratedVirtualMachineScaleSetPriorityMixPolicy(input []interface{}) *azuresdkhacks.PriorityMixPolicy {
+	// when the block is removed all instances above the base count are Spot instances, which matches the defaults
+	baseRegularCount := 0
+	regularPercentageAboveBase := 0
+	if len(input) > 0 && input[0] != nil {
+		raw := input[0].(map[string]interface{})
+		baseRegularCount = raw["base_regular_count"].(int)
+		regularPercentageAboveBase = raw["regular_percentage_above_base"].(int)
+	}
+
+	return &azuresdkhacks.PriorityMixPolicy{
+		BaseRegularPriorityCount:           utils.Int32(int32(baseRegularCount)),
+		RegularPriorityPercentageAboveBase: utils.Int32(int32(regularPercentageAboveBase)),
+	}
+}
+
+func FlattenOrchestratedVirtualMachineScaleSetPriorityMixPolicy(input *azuresdkhacks.PriorityMixPolicy) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	baseRegularCount := 0
+	if input.BaseRegularPriorityCount != nil {
+		baseRegularCount = int(*input.BaseRegularPriorityCount)
+	}
+
+	regularPercentageAboveBase := 0
+	if input.RegularPriorityPercentageAboveBase != nil {
+		regularPercentageAboveBase = int(*input.RegularPriorityPercentageAboveBase)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"base_regular_count":            baseRegularCount,
+			"regular_percentage_above_base": regularPercentageAboveBase,
+		},
+	}
+}
+
+func ExpandOrchestratedVirtualMachineScaleSetRollingUpgradePolicy(input []interface{}) (*compute.RollingUpgradePolicy, *bool) {
+	if len(input) == 0 || input[0] == nil {
+		return nil, nil
+	}
+
+	raw := input[0].(map[string]interface{})
+
+	policy := &compute.RollingUpgradePolicy{
+		MaxBatchInstancePercent:             utils.Int32(int32(raw["max_batch_instance_percent"].(int))),
+		MaxUnhealthyInstancePercent:         utils.Int32(int32(raw["max_unhealthy_instance_percent"].(int))),
+		MaxUnhealthyUpgradedInstancePercent: utils.Int32(int32(raw["max_unhealthy_upgraded_instance_percent"].(int))),
+		PauseTimeBetweenBatches:             utils.String(raw["pause_time_between_batches"].(string)),
+		EnableCrossZoneUpgrade:              utils.Bool(raw["cross_zone_upgrades_enabled"].(bool)),
+		PrioritizeUnhealthyInstances:        utils.Bool(raw["prioritize_unhealthy_instances_enabled"].(bool)),
+	}
+
+	return policy, utils.Bool(raw["maximum_surge_instances_enabled"].(bool))
+}
+
+func FlattenOrchestratedVirtualMachineScaleSetRollingUpgradePolicy(input *compute.RollingUpgradePolicy, maxSurge *bool) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	maxBatchInstancePercent := 0
+	if input.MaxBatchInstancePercent != nil {
+		maxBatchInstancePercent = int(*input.MaxBatchInstancePercent)
+	}
+
+	maxUnhealthyInstancePercent := 0
+	if input.MaxUnhealthyInstancePercent != nil {
+		maxUnhealthyInstancePercent = int(*input.MaxUnhealthyInstancePercent)
+	}
+
+	maxUnhealthyUpgradedInstancePercent := 0
+	if input.MaxUnhealthyUpgradedInstancePercent != nil {
+		maxUnhealthyUpgradedInstancePercent = int(*input.MaxUnhealthyUpgradedInstancePercent)
+	}
+
+	pauseTimeBetweenBatches := ""
+	if input.PauseTimeBetweenBatches != nil {
+		pauseTimeBetweenBatches = *input.PauseTimeBetweenBatches
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"max_batch_instance_percent":              maxBatchInstancePercent,
+			"max_unhealthy_instance_percent":          maxUnhealthyInstancePercent,
+			"max_unhealthy_upgraded_instance_percent": maxUnhealthyUpgradedInstancePercent,
+			"pause_time_between_batches":              pauseTimeBetweenBatches,
+			"cross_zone_upgrades_enabled":             utils.NormaliseNilableBool(input.EnableCrossZoneUpgrade),
+			"maximum_surge_instances_enabled":         utils.NormaliseNilableBool(maxSurge),
+			"prioritize_unhealthy_instances_enabled":  utils.NormaliseNilableBool(input.PrioritizeUnhealthyInstances),
+		},
+	}
+}
+
+func ExpandOrchestratedVirtualMachineScaleSetScaleInPolicy(input []interface{}) *compute.ScaleInPolicy {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+
+	return &compute.ScaleInPolicy{
+		Rules: &[]compute.VirtualMachineScaleSetScaleInRules{
+			compute.VirtualMachineScaleSetScaleInRules(raw["rule"].(string)),
+		},
+		ForceDeletion: utils.Bool(raw["force_deletion_enabled"].(bool)),
+	}
+}
+
+func FlattenOrchestratedVirtualMachineScaleSetScaleInPolicy(input *compute.ScaleInPolicy) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	rule := string(compute.VirtualMachineScaleSetScaleInRulesDefault)
+	if input.Rules != nil && len(*input.Rules) > 0 {
+		rule = string((*input.Rules)[0])
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"rule":                   rule,
+			"force_deletion_enabled": utils.NormaliseNilableBool(input.ForceDeletion),
+		},
+	}
+}
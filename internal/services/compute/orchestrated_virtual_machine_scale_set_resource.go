@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/azuresdkhacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
 	computeValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
@@ -157,6 +158,8 @@ func resourceOrchestratedVirtualMachineScaleSet() *pluginsdk.Resource {
 				}, false),
 			},
 
+			"priority_mix": OrchestratedVirtualMachineScaleSetPriorityMixPolicySchema(),
+
 			"proximity_placement_group_id": {
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
@@ -166,6 +169,10 @@ func resourceOrchestratedVirtualMachineScaleSet() *pluginsdk.Resource {
 				DiffSuppressFunc: suppress.CaseDifference,
 			},
 
+			"rolling_upgrade_policy": OrchestratedVirtualMachineScaleSetRollingUpgradePolicySchema(),
+
+			"scale_in": OrchestratedVirtualMachineScaleSetScaleInPolicySchema(),
+
 			// removing single_placement_group since it has been retired as of version 2019-12-01 for Flex VMSS
 			"source_image_id": {
 				Type:         pluginsdk.TypeString,
@@ -184,6 +191,17 @@ func resourceOrchestratedVirtualMachineScaleSet() *pluginsdk.Resource {
 
 			"termination_notification": OrchestratedVirtualMachineScaleSetTerminateNotificationSchema(),
 
+			"upgrade_mode": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  string(compute.UpgradeModeManual),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(compute.UpgradeModeManual),
+					string(compute.UpgradeModeRolling),
+				}, false),
+			},
+
 			"zones": azure.SchemaZones(),
 
 			"tags": tags.Schema(),
@@ -253,9 +271,9 @@ func resourceOrchestratedVirtualMachineScaleSetCreate(d *pluginsdk.ResourceData,
 		}
 	}
 
-	// Not currently supported in OVMSS
+	// health_probe_id is not currently supported in OVMSS
 	// healthProbeId := d.Get("health_probe_id").(string)
-	// upgradeMode := compute.UpgradeMode(d.Get("upgrade_mode").(string))
+	upgradeMode := compute.UpgradeMode(d.Get("upgrade_mode").(string))
 
 	instances := d.Get("instances").(int)
 	if v, ok := d.GetOk("sku_name"); ok {
@@ -361,8 +379,7 @@ func resourceOrchestratedVirtualMachineScaleSetCreate(d *pluginsdk.ResourceData,
 		virtualMachineProfile.NetworkProfile = networkProfile
 	}
 
-	// hasHealthExtension is currently not needed but I added the plumming because we will need it
-	// once upgrade policy is added to OVMSS
+	// a health extension is required when the upgrade mode is Rolling
 	hasHealthExtension := false
 	if v, ok := d.GetOk("extension"); ok {
 		var err error
@@ -383,6 +400,24 @@ func resourceOrchestratedVirtualMachineScaleSetCreate(d *pluginsdk.ResourceData,
 		log.Printf("[DEBUG] Orchestrated Virtual Machine Scale Set %q (Resource Group %q) has a Health Extension defined", name, resourceGroup)
 	}
 
+	rollingUpgradePolicy, maxSurge := ExpandOrchestratedVirtualMachineScaleSetRollingUpgradePolicy(d.Get("rolling_upgrade_policy").([]interface{}))
+	if upgradeMode != compute.UpgradeModeRolling && rollingUpgradePolicy != nil {
+		return fmt.Errorf("a `rolling_upgrade_policy` block cannot be specified when `upgrade_mode` is set to %q", string(upgradeMode))
+	}
+	if upgradeMode == compute.UpgradeModeRolling {
+		if rollingUpgradePolicy == nil {
+			return fmt.Errorf("a `rolling_upgrade_policy` block must be specified when `upgrade_mode` is set to %q", string(upgradeMode))
+		}
+		if !hasHealthExtension {
+			return fmt.Errorf("an `ApplicationHealthLinux` or `ApplicationHealthWindows` extension must be specified when `upgrade_mode` is set to %q", string(upgradeMode))
+		}
+	}
+
+	// the Spot Priority Mix and Maximum Surge aren't exposed by the Azure SDK, so these are patched into the request
+	extendedProps := azuresdkhacks.VirtualMachineScaleSetExtendedProperties{
+		MaxSurge: maxSurge,
+	}
+
 	if v, ok := d.Get("max_bid_price").(float64); ok && v > 0 {
 		if virtualMachineProfile.Priority != compute.VirtualMachinePriorityTypesSpot {
 			return fmt.Errorf("`max_bid_price` can only be configured when `priority` is set to `Spot`")
@@ -442,11 +477,29 @@ func resourceOrchestratedVirtualMachineScaleSetCreate(d *pluginsdk.ResourceData,
 			props.VirtualMachineScaleSetProperties.ZoneBalance = utils.Bool(v.(bool))
 		}
 
+		if upgradeMode == compute.UpgradeModeRolling {
+			props.VirtualMachineScaleSetProperties.UpgradePolicy = &compute.UpgradePolicy{
+				Mode:                 upgradeMode,
+				RollingUpgradePolicy: rollingUpgradePolicy,
+			}
+		}
+
+		if v, ok := d.GetOk("scale_in"); ok {
+			props.VirtualMachineScaleSetProperties.ScaleInPolicy = ExpandOrchestratedVirtualMachineScaleSetScaleInPolicy(v.([]interface{}))
+		}
+
+		if v, ok := d.GetOk("priority_mix"); ok {
+			if virtualMachineProfile.Priority != compute.VirtualMachinePriorityTypesSpot {
+				return fmt.Errorf("a `priority_mix` block can only be specified when `priority` is set to `Spot`")
+			}
+			extendedProps.PriorityMixPolicy = ExpandOrchestratedVirtualMachineScaleSetPriorityMixPolicy(v.([]interface{}))
+		}
+
 		props.VirtualMachineScaleSetProperties.VirtualMachineProfile = &virtualMachineProfile
 	}
 
 	log.Printf("[DEBUG] Creating Orchestrated Virtual Machine Scale Set %q (Resource Group %q)..", name, resourceGroup)
-	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, props)
+	future, err := azuresdkhacks.CreateOrUpdateVirtualMachineScaleSet(ctx, client, resourceGroup, name, props, extendedProps)
 	if err != nil {
 		return fmt.Errorf("creating Orchestrated Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resourceGroup, err)
 	}
@@ -460,7 +513,7 @@ func resourceOrchestratedVirtualMachineScaleSetCreate(d *pluginsdk.ResourceData,
 
 			for {
 				log.Printf("[DEBUG] Retrying PUT %d for Orchestrated Virtual Machine Scale Set %q (Resource Group %q)..", errCount, name, resourceGroup)
-				future, err := client.CreateOrUpdate(ctx, resourceGroup, name, props)
+				future, err := azuresdkhacks.CreateOrUpdateVirtualMachineScaleSet(ctx, client, resourceGroup, name, props, extendedProps)
 				if err != nil {
 					return fmt.Errorf("creating Orchestrated Virtual Machine Scale Set %q (Resource Group %q) after %d retries: %+v", name, resourceGroup, errCount, err)
 				}
@@ -541,6 +594,7 @@ func resourceOrchestratedVirtualMachineScaleSetUpdate(d *pluginsdk.ResourceData,
 
 	updateProps := compute.VirtualMachineScaleSetUpdateProperties{}
 	update := compute.VirtualMachineScaleSetUpdate{}
+	extendedProps := azuresdkhacks.VirtualMachineScaleSetExtendedProperties{}
 	osType := compute.OperatingSystemTypesWindows
 
 	if !isLegacy {
@@ -553,10 +607,34 @@ func resourceOrchestratedVirtualMachineScaleSetUpdate(d *pluginsdk.ResourceData,
 					ImageReference: existing.VirtualMachineScaleSetProperties.VirtualMachineProfile.StorageProfile.ImageReference,
 				},
 			},
-			// Currently not suppored in orchestrated VMSS
-			// if an upgrade policy's been configured previously (which it will have) it must be threaded through
-			// this doesn't matter for Manual - but breaks when updating anything on a Automatic and Rolling Mode Scale Set
-			// UpgradePolicy: existing.VirtualMachineScaleSetProperties.UpgradePolicy,
+			// if an upgrade policy's been configured previously it must be threaded through
+			// this doesn't matter for Manual - but breaks when updating anything on a Rolling Mode Scale Set
+			UpgradePolicy: existing.VirtualMachineScaleSetProperties.UpgradePolicy,
+		}
+
+		upgradeMode := compute.UpgradeMode(d.Get("upgrade_mode").(string))
+		rollingUpgradePolicy, maxSurge := ExpandOrchestratedVirtualMachineScaleSetRollingUpgradePolicy(d.Get("rolling_upgrade_policy").([]interface{}))
+		if upgradeMode != compute.UpgradeModeRolling && rollingUpgradePolicy != nil {
+			return fmt.Errorf("a `rolling_upgrade_policy` block cannot be specified when `upgrade_mode` is set to %q", string(upgradeMode))
+		}
+		if upgradeMode == compute.UpgradeModeRolling {
+			if rollingUpgradePolicy == nil {
+				return fmt.Errorf("a `rolling_upgrade_policy` block must be specified when `upgrade_mode` is set to %q", string(upgradeMode))
+			}
+
+			// the Maximum Surge isn't returned by the version of the Azure SDK in use, so always send the configured value
+			extendedProps.MaxSurge = maxSurge
+
+			if d.HasChange("rolling_upgrade_policy") {
+				updateProps.UpgradePolicy = &compute.UpgradePolicy{
+					Mode:                 upgradeMode,
+					RollingUpgradePolicy: rollingUpgradePolicy,
+				}
+			}
+		}
+
+		if d.HasChange("scale_in") {
+			updateProps.ScaleInPolicy = ExpandOrchestratedVirtualMachineScaleSetScaleInPolicy(d.Get("scale_in").([]interface{}))
 		}
 
 		priority := compute.VirtualMachinePriorityTypes(d.Get("priority").(string))
@@ -570,6 +648,17 @@ func resourceOrchestratedVirtualMachineScaleSetUpdate(d *pluginsdk.ResourceData,
 			}
 		}
 
+		if d.HasChange("priority_mix") {
+			priorityMixRaw := d.Get("priority_mix").([]interface{})
+			if priority != compute.VirtualMachinePriorityTypesSpot && len(priorityMixRaw) > 0 {
+				return fmt.Errorf("a `priority_mix` block can only be specified when `priority` is set to `Spot`")
+			}
+
+			if priority == compute.VirtualMachinePriorityTypesSpot {
+				extendedProps.PriorityMixPolicy = ExpandOrchestratedVirtualMachineScaleSetPriorityMixPolicy(priorityMixRaw)
+			}
+		}
+
 		osProfileRaw := d.Get("os_profile").([]interface{})
 		vmssOsProfile := compute.VirtualMachineScaleSetUpdateOSProfile{}
 		windowsConfig := compute.WindowsConfiguration{}
@@ -825,6 +914,7 @@ func resourceOrchestratedVirtualMachineScaleSetUpdate(d *pluginsdk.ResourceData,
 		UpdateInstances:              false,
 		Client:                       meta.(*clients.Client).Compute,
 		Existing:                     existing,
+		ExtendedProperties:           &extendedProps,
 		ID:                           id,
 		OSType:                       osType,
 	}
@@ -847,7 +937,7 @@ func resourceOrchestratedVirtualMachineScaleSetRead(d *pluginsdk.ResourceData, m
 	}
 
 	// Upgrading to the 2021-07-01 exposed a new expand parameter in the GET method
-	resp, err := client.Get(ctx, id.ResourceGroup, id.Name, compute.ExpandTypesForGetVMScaleSetsUserData)
+	resp, extendedProps, err := azuresdkhacks.GetVirtualMachineScaleSet(ctx, client, id.ResourceGroup, id.Name, compute.ExpandTypesForGetVMScaleSetsUserData)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			log.Printf("[DEBUG] Orchestrated Virtual Machine Scale Set %q was not found in Resource Group %q - removing from state!", id.Name, id.ResourceGroup)
@@ -908,6 +998,30 @@ func resourceOrchestratedVirtualMachineScaleSetRead(d *pluginsdk.ResourceData, m
 	d.Set("unique_id", props.UniqueID)
 	d.Set("zone_balance", props.ZoneBalance)
 
+	upgradeMode := compute.UpgradeModeManual
+	var rollingUpgradePolicy *compute.RollingUpgradePolicy
+	if policy := props.UpgradePolicy; policy != nil {
+		if policy.Mode != "" {
+			upgradeMode = policy.Mode
+		}
+		if upgradeMode == compute.UpgradeModeRolling {
+			rollingUpgradePolicy = policy.RollingUpgradePolicy
+		}
+	}
+	d.Set("upgrade_mode", string(upgradeMode))
+
+	if err := d.Set("rolling_upgrade_policy", FlattenOrchestratedVirtualMachineScaleSetRollingUpgradePolicy(rollingUpgradePolicy, extendedProps.MaxSurge)); err != nil {
+		return fmt.Errorf("setting `rolling_upgrade_policy`: %+v", err)
+	}
+
+	if err := d.Set("scale_in", FlattenOrchestratedVirtualMachineScaleSetScaleInPolicy(props.ScaleInPolicy)); err != nil {
+		return fmt.Errorf("setting `scale_in`: %+v", err)
+	}
+
+	if err := d.Set("priority_mix", FlattenOrchestratedVirtualMachineScaleSetPriorityMixPolicy(extendedProps.PriorityMixPolicy)); err != nil {
+		return fmt.Errorf("setting `priority_mix`: %+v", err)
+	}
+
 	if profile := props.VirtualMachineProfile; profile != nil {
 		if err := d.Set("boot_diagnostics", flattenBootDiagnostics(profile.DiagnosticsProfile)); err != nil {
 			return fmt.Errorf("setting `boot_diagnostics`: %+v", err)
@@ -39,6 +39,7 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 		"azurerm_virtual_machine":           dataSourceVirtualMachine(),
 		"azurerm_virtual_machine_scale_set": dataSourceVirtualMachineScaleSet(),
 		"azurerm_ssh_public_key":            dataSourceSshPublicKey(),
+		"azurerm_vm_images_latest":          dataSourceVMImagesLatest(),
 	}
 }
 
@@ -60,6 +61,7 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_snapshot":                               resourceSnapshot(),
 		"azurerm_virtual_machine_data_disk_attachment":   resourceVirtualMachineDataDiskAttachment(),
 		"azurerm_virtual_machine_extension":              resourceVirtualMachineExtension(),
+		"azurerm_virtual_machine_run_command":            resourceVirtualMachineRunCommand(),
 		"azurerm_virtual_machine_scale_set":              resourceVirtualMachineScaleSet(),
 		"azurerm_orchestrated_virtual_machine_scale_set": resourceOrchestratedVirtualMachineScaleSet(),
 		"azurerm_virtual_machine":                        resourceVirtualMachine(),
@@ -0,0 +1,15 @@
+package diskencryptionsets
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/identity"
+)
+
+type DiskEncryptionSet struct {
+	Id         *string                                 `json:"id,omitempty"`
+	Identity   *identity.SystemUserAssignedIdentityMap `json:"identity,omitempty"`
+	Location   string                                  `json:"location"`
+	Name       *string                                 `json:"name,omitempty"`
+	Properties *EncryptionSetProperties                `json:"properties,omitempty"`
+	Tags       *map[string]string                      `json:"tags,omitempty"`
+	Type       *string                                 `json:"type,omitempty"`
+}
@@ -0,0 +1,11 @@
+package diskencryptionsets
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/identity"
+)
+
+type DiskEncryptionSetUpdate struct {
+	Identity   *identity.SystemUserAssignedIdentityMap `json:"identity,omitempty"`
+	Properties *DiskEncryptionSetUpdateProperties      `json:"properties,omitempty"`
+	Tags       *map[string]string                      `json:"tags,omitempty"`
+}
@@ -0,0 +1,8 @@
+package diskencryptionsets
+
+type DiskEncryptionSetUpdateProperties struct {
+	ActiveKey                         *KeyForDiskEncryptionSet `json:"activeKey,omitempty"`
+	EncryptionType                    *DiskEncryptionSetType   `json:"encryptionType,omitempty"`
+	FederatedClientId                 *string                  `json:"federatedClientId,omitempty"`
+	RotationToLatestKeyVersionEnabled *bool                    `json:"rotationToLatestKeyVersionEnabled,omitempty"`
+}
@@ -0,0 +1,10 @@
+package diskencryptionsets
+
+type EncryptionSetProperties struct {
+	ActiveKey                         *KeyForDiskEncryptionSet   `json:"activeKey,omitempty"`
+	EncryptionType                    *DiskEncryptionSetType     `json:"encryptionType,omitempty"`
+	FederatedClientId                 *string                    `json:"federatedClientId,omitempty"`
+	PreviousKeys                      *[]KeyForDiskEncryptionSet `json:"previousKeys,omitempty"`
+	ProvisioningState                 *string                    `json:"provisioningState,omitempty"`
+	RotationToLatestKeyVersionEnabled *bool                      `json:"rotationToLatestKeyVersionEnabled,omitempty"`
+}
@@ -0,0 +1,6 @@
+package diskencryptionsets
+
+type KeyForDiskEncryptionSet struct {
+	KeyUrl      string       `json:"keyUrl"`
+	SourceVault *SourceVault `json:"sourceVault,omitempty"`
+}
@@ -0,0 +1,5 @@
+package diskencryptionsets
+
+type SourceVault struct {
+	Id *string `json:"id,omitempty"`
+}
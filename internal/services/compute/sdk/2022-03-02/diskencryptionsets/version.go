@@ -0,0 +1,9 @@
+package diskencryptionsets
+
+import "fmt"
+
+const defaultApiVersion = "2022-03-02"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/diskencryptionsets/%s", defaultApiVersion)
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-07-01/compute"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/azuresdkhacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/client"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
@@ -26,6 +27,9 @@ type virtualMachineScaleSetUpdateMetaData struct {
 	Existing compute.VirtualMachineScaleSet
 	ID       *parse.VirtualMachineScaleSetId
 	OSType   compute.OperatingSystemTypes
+
+	// the properties which aren't exposed by the Azure SDK - when set the update is sent using the newer API version
+	ExtendedProperties *azuresdkhacks.VirtualMachineScaleSetExtendedProperties
 }
 
 func (metadata virtualMachineScaleSetUpdateMetaData) performUpdate(ctx context.Context, update compute.VirtualMachineScaleSetUpdate) error {
@@ -87,7 +91,13 @@ func (metadata virtualMachineScaleSetUpdateMetaData) updateVmss(ctx context.Cont
 	id := metadata.ID
 
 	log.Printf("[DEBUG] Updating %s Virtual Machine Scale Set %q (Resource Group %q)..", metadata.OSType, id.Name, id.ResourceGroup)
-	future, err := client.Update(ctx, id.ResourceGroup, id.Name, update)
+	var future compute.VirtualMachineScaleSetsUpdateFuture
+	var err error
+	if metadata.ExtendedProperties != nil {
+		future, err = azuresdkhacks.UpdateVirtualMachineScaleSet(ctx, client, id.ResourceGroup, id.Name, update, *metadata.ExtendedProperties)
+	} else {
+		future, err = client.Update(ctx, id.ResourceGroup, id.Name, update)
+	}
 	if err != nil {
 		return fmt.Errorf("updating %s Virtual Machine Scale Set %q (Resource Group %q): %+v", metadata.OSType, id.Name, id.ResourceGroup, err)
 	}
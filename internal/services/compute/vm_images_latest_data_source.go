@@ -0,0 +1,163 @@
+package compute
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func dataSourceVMImagesLatest() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceVMImagesLatestRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"location": azure.SchemaLocation(),
+
+			"publisher": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"offer": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"sku_regex": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+
+			"images": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"sku": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"version": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"urn": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceVMImagesLatestRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.VMImageClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	location := azure.NormalizeLocation(d.Get("location").(string))
+	publisher := d.Get("publisher").(string)
+	offer := d.Get("offer").(string)
+
+	skuRegex, err := regexp.Compile(d.Get("sku_regex").(string))
+	if err != nil {
+		return fmt.Errorf("compiling `sku_regex`: %+v", err)
+	}
+
+	skus, err := client.ListSkus(ctx, location, publisher, offer)
+	if err != nil {
+		return fmt.Errorf("listing Platform Image SKUs (location %q / publisher %q / offer %q): %+v", location, publisher, offer, err)
+	}
+
+	skuNames := make([]string, 0)
+	if skus.Value != nil {
+		for _, item := range *skus.Value {
+			if item.Name != nil && skuRegex.MatchString(*item.Name) {
+				skuNames = append(skuNames, *item.Name)
+			}
+		}
+	}
+	sort.Strings(skuNames)
+
+	images := make([]interface{}, 0)
+	for _, sku := range skuNames {
+		versions, err := client.List(ctx, location, publisher, offer, sku, "", nil, "")
+		if err != nil {
+			return fmt.Errorf("listing Platform Images (location %q / publisher %q / offer %q / sku %q): %+v", location, publisher, offer, sku, err)
+		}
+		if versions.Value == nil {
+			continue
+		}
+
+		// the API doesn't order versions semantically, so find the newest one ourselves
+		var latest *version.Version
+		var latestId, latestName string
+		for _, item := range *versions.Value {
+			if item.Name == nil {
+				continue
+			}
+
+			v, err := version.NewVersion(*item.Name)
+			if err != nil {
+				log.Printf("[DEBUG] skipping Platform Image version %q for SKU %q since it couldn't be parsed: %+v", *item.Name, sku, err)
+				continue
+			}
+
+			if latest == nil || v.GreaterThan(latest) {
+				latest = v
+				latestName = *item.Name
+				latestId = ""
+				if item.ID != nil {
+					latestId = *item.ID
+				}
+			}
+		}
+		if latest == nil {
+			continue
+		}
+
+		images = append(images, map[string]interface{}{
+			"id":      latestId,
+			"sku":     sku,
+			"version": latestName,
+			"urn":     fmt.Sprintf("%s:%s:%s:%s", publisher, offer, sku, latestName),
+		})
+	}
+
+	d.SetId(fmt.Sprintf("/subscriptions/%s/providers/Microsoft.Compute/locations/%s/publishers/%s/artifacttypes/vmimage/offers/%s", subscriptionId, location, publisher, offer))
+	d.Set("location", location)
+	d.Set("publisher", publisher)
+	d.Set("offer", offer)
+
+	if err := d.Set("images", images); err != nil {
+		return fmt.Errorf("setting `images`: %+v", err)
+	}
+
+	return nil
+}
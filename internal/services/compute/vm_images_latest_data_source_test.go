@@ -0,0 +1,60 @@
+package compute_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type VMImagesLatestDataSource struct {
+}
+
+func TestAccDataSourceVMImagesLatest_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_vm_images_latest", "test")
+	r := VMImagesLatestDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "^(16|18)\\\\.04-LTS$"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("images.#").HasValue("2"),
+				check.That(data.ResourceName).Key("images.0.sku").HasValue("16.04-LTS"),
+				check.That(data.ResourceName).Key("images.0.version").Exists(),
+				check.That(data.ResourceName).Key("images.0.urn").Exists(),
+				check.That(data.ResourceName).Key("images.1.sku").HasValue("18.04-LTS"),
+				check.That(data.ResourceName).Key("images.1.version").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceVMImagesLatest_noMatches(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_vm_images_latest", "test")
+	r := VMImagesLatestDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "^does-not-exist$"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("images.#").HasValue("0"),
+			),
+		},
+	})
+}
+
+func (VMImagesLatestDataSource) basic(data acceptance.TestData, skuRegex string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_vm_images_latest" "test" {
+  location  = "%s"
+  publisher = "Canonical"
+  offer     = "UbuntuServer"
+  sku_regex = "%s"
+}
+`, data.Locations.Primary, skuRegex)
+}
@@ -0,0 +1,25 @@
+package client
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/sdk/2022-06-01-preview/connectedenvironments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/sdk/2022-06-01-preview/connectedenvironmentscertificates"
+)
+
+type Client struct {
+	ConnectedEnvironmentsClient             *connectedenvironments.ConnectedEnvironmentsClient
+	ConnectedEnvironmentsCertificatesClient *connectedenvironmentscertificates.ConnectedEnvironmentsCertificatesClient
+}
+
+func NewClient(o *common.ClientOptions) *Client {
+	connectedEnvironmentsClient := connectedenvironments.NewConnectedEnvironmentsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&connectedEnvironmentsClient.Client, o.ResourceManagerAuthorizer)
+
+	connectedEnvironmentsCertificatesClient := connectedenvironmentscertificates.NewConnectedEnvironmentsCertificatesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&connectedEnvironmentsCertificatesClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		ConnectedEnvironmentsClient:             &connectedEnvironmentsClient,
+		ConnectedEnvironmentsCertificatesClient: &connectedEnvironmentsCertificatesClient,
+	}
+}
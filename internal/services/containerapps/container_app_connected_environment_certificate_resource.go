@@ -0,0 +1,272 @@
+package containerapps
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/sdk/2022-06-01-preview/connectedenvironments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/sdk/2022-06-01-preview/connectedenvironmentscertificates"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.ResourceWithUpdate = ConnectedEnvironmentCertificateResource{}
+
+type ConnectedEnvironmentCertificateResource struct{}
+
+type ConnectedEnvironmentCertificateResourceModel struct {
+	Name                   string            `tfschema:"name"`
+	ConnectedEnvironmentId string            `tfschema:"connected_environment_id"`
+	CertificateBlobBase64  string            `tfschema:"certificate_blob_base64"`
+	CertificatePassword    string            `tfschema:"certificate_password"`
+	ExpirationDate         string            `tfschema:"expiration_date"`
+	IssueDate              string            `tfschema:"issue_date"`
+	Issuer                 string            `tfschema:"issuer"`
+	SubjectName            string            `tfschema:"subject_name"`
+	Thumbprint             string            `tfschema:"thumbprint"`
+	Tags                   map[string]string `tfschema:"tags"`
+}
+
+func (r ConnectedEnvironmentCertificateResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,258}[a-zA-Z0-9]$`),
+				"`name` must be between 2 and 260 characters long, start and end with a letter or number and can only contain letters, numbers, hyphens, underscores and periods",
+			),
+		},
+
+		"connected_environment_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: connectedenvironments.ValidateConnectedEnvironmentID,
+		},
+
+		// the certificate and password aren't returned by the API
+		"certificate_blob_base64": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.StringIsBase64,
+		},
+
+		"certificate_password": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r ConnectedEnvironmentCertificateResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"expiration_date": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"issue_date": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"issuer": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"subject_name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"thumbprint": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r ConnectedEnvironmentCertificateResource) ModelObject() interface{} {
+	return &ConnectedEnvironmentCertificateResourceModel{}
+}
+
+func (r ConnectedEnvironmentCertificateResource) ResourceType() string {
+	return "azurerm_container_app_connected_environment_certificate"
+}
+
+func (r ConnectedEnvironmentCertificateResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return connectedenvironmentscertificates.ValidateCertificateID
+}
+
+func (r ConnectedEnvironmentCertificateResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.ConnectedEnvironmentsCertificatesClient
+			environmentsClient := metadata.Client.ContainerApps.ConnectedEnvironmentsClient
+
+			var model ConnectedEnvironmentCertificateResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			environmentId, err := connectedenvironments.ParseConnectedEnvironmentID(model.ConnectedEnvironmentId)
+			if err != nil {
+				return err
+			}
+
+			id := connectedenvironmentscertificates.NewCertificateID(environmentId.SubscriptionId, environmentId.ResourceGroupName, environmentId.ConnectedEnvironmentName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			// the Certificate must be in the same location as the Connected Environment
+			environment, err := environmentsClient.Get(ctx, *environmentId)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *environmentId, err)
+			}
+			if environment.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *environmentId)
+			}
+
+			payload := connectedenvironmentscertificates.Certificate{
+				Location: environment.Model.Location,
+				Properties: &connectedenvironmentscertificates.CertificateProperties{
+					Value: utils.String(model.CertificateBlobBase64),
+				},
+				Tags: &model.Tags,
+			}
+
+			if model.CertificatePassword != "" {
+				payload.Properties.Password = utils.String(model.CertificatePassword)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ConnectedEnvironmentCertificateResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.ConnectedEnvironmentsCertificatesClient
+
+			id, err := connectedenvironmentscertificates.ParseCertificateID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			var config ConnectedEnvironmentCertificateResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			state := ConnectedEnvironmentCertificateResourceModel{
+				Name:                   id.CertificateName,
+				ConnectedEnvironmentId: connectedenvironments.NewConnectedEnvironmentID(id.SubscriptionId, id.ResourceGroupName, id.ConnectedEnvironmentName).ID(),
+				CertificateBlobBase64:  config.CertificateBlobBase64,
+				CertificatePassword:    config.CertificatePassword,
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.ExpirationDate = utils.NormalizeNilableString(props.ExpirationDate)
+					state.IssueDate = utils.NormalizeNilableString(props.IssueDate)
+					state.Issuer = utils.NormalizeNilableString(props.Issuer)
+					state.SubjectName = utils.NormalizeNilableString(props.SubjectName)
+					state.Thumbprint = utils.NormalizeNilableString(props.Thumbprint)
+				}
+
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ConnectedEnvironmentCertificateResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.ConnectedEnvironmentsCertificatesClient
+
+			id, err := connectedenvironmentscertificates.ParseCertificateID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ConnectedEnvironmentCertificateResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			payload := connectedenvironmentscertificates.CertificatePatch{}
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = &model.Tags
+			}
+
+			if _, err := client.Update(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ConnectedEnvironmentCertificateResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.ConnectedEnvironmentsCertificatesClient
+
+			id, err := connectedenvironmentscertificates.ParseCertificateID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,135 @@
+package containerapps_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/sdk/2022-06-01-preview/connectedenvironmentscertificates"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ConnectedEnvironmentCertificateResource struct{}
+
+func TestAccContainerAppConnectedEnvironmentCertificate_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_connected_environment_certificate", "test")
+	r := ConnectedEnvironmentCertificateResource{}
+	preCheckConnectedEnvironment(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("thumbprint").Exists(),
+				check.That(data.ResourceName).Key("expiration_date").Exists(),
+			),
+		},
+		data.ImportStep("certificate_blob_base64", "certificate_password"),
+	})
+}
+
+func TestAccContainerAppConnectedEnvironmentCertificate_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_connected_environment_certificate", "test")
+	r := ConnectedEnvironmentCertificateResource{}
+	preCheckConnectedEnvironment(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccContainerAppConnectedEnvironmentCertificate_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_connected_environment_certificate", "test")
+	r := ConnectedEnvironmentCertificateResource{}
+	preCheckConnectedEnvironment(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("certificate_blob_base64", "certificate_password"),
+		{
+			Config: r.tags(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("tags.environment").HasValue("test"),
+			),
+		},
+		data.ImportStep("certificate_blob_base64", "certificate_password"),
+	})
+}
+
+func (r ConnectedEnvironmentCertificateResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := connectedenvironmentscertificates.ParseCertificateID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.ContainerApps.ConnectedEnvironmentsCertificatesClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r ConnectedEnvironmentCertificateResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_app_connected_environment_certificate" "test" {
+  name                     = "acctest-cert-%d"
+  connected_environment_id = azurerm_container_app_connected_environment.test.id
+  certificate_blob_base64  = filebase64("testdata/certificate.pfx")
+  certificate_password     = "terraform"
+}
+`, ConnectedEnvironmentResource{}.basic(data), data.RandomInteger)
+}
+
+func (r ConnectedEnvironmentCertificateResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_app_connected_environment_certificate" "import" {
+  name                     = azurerm_container_app_connected_environment_certificate.test.name
+  connected_environment_id = azurerm_container_app_connected_environment_certificate.test.connected_environment_id
+  certificate_blob_base64  = azurerm_container_app_connected_environment_certificate.test.certificate_blob_base64
+  certificate_password     = azurerm_container_app_connected_environment_certificate.test.certificate_password
+}
+`, r.basic(data))
+}
+
+func (r ConnectedEnvironmentCertificateResource) tags(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_app_connected_environment_certificate" "test" {
+  name                     = "acctest-cert-%d"
+  connected_environment_id = azurerm_container_app_connected_environment.test.id
+  certificate_blob_base64  = filebase64("testdata/certificate.pfx")
+  certificate_password     = "terraform"
+
+  tags = {
+    environment = "test"
+  }
+}
+`, ConnectedEnvironmentResource{}.basic(data), data.RandomInteger)
+}
@@ -0,0 +1,366 @@
+package containerapps
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/sdk/2022-06-01-preview/connectedenvironments"
+	customLocationParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/deviceregistry/parse"
+	customLocationValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/deviceregistry/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.ResourceWithUpdate = ConnectedEnvironmentResource{}
+
+type ConnectedEnvironmentResource struct{}
+
+type ConnectedEnvironmentResourceModel struct {
+	Name                                    string                             `tfschema:"name"`
+	ResourceGroupName                       string                             `tfschema:"resource_group_name"`
+	Location                                string                             `tfschema:"location"`
+	CustomLocationId                        string                             `tfschema:"custom_location_id"`
+	StaticIpAddress                         string                             `tfschema:"static_ip_address"`
+	DaprApplicationInsightsConnectionString string                             `tfschema:"dapr_application_insights_connection_string"`
+	CustomDomain                            []ConnectedEnvironmentCustomDomain `tfschema:"custom_domain"`
+	DefaultDomain                           string                             `tfschema:"default_domain"`
+	Tags                                    map[string]string                  `tfschema:"tags"`
+}
+
+type ConnectedEnvironmentCustomDomain struct {
+	DnsSuffix                  string `tfschema:"dns_suffix"`
+	CertificateBlobBase64      string `tfschema:"certificate_blob_base64"`
+	CertificatePassword        string `tfschema:"certificate_password"`
+	CustomDomainVerificationId string `tfschema:"custom_domain_verification_id"`
+	Thumbprint                 string `tfschema:"thumbprint"`
+}
+
+func (r ConnectedEnvironmentResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validateContainerAppEnvironmentName,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": location.Schema(),
+
+		"custom_location_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: customLocationValidate.CustomLocationID,
+		},
+
+		"static_ip_address": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.IPv4Address,
+		},
+
+		"dapr_application_insights_connection_string": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"custom_domain": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"dns_suffix": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					// the certificate and password aren't returned by the API
+					"certificate_blob_base64": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						Sensitive:    true,
+						ValidateFunc: validation.StringIsBase64,
+					},
+
+					"certificate_password": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Sensitive:    true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"custom_domain_verification_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"thumbprint": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r ConnectedEnvironmentResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"default_domain": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r ConnectedEnvironmentResource) ModelObject() interface{} {
+	return &ConnectedEnvironmentResourceModel{}
+}
+
+func (r ConnectedEnvironmentResource) ResourceType() string {
+	return "azurerm_container_app_connected_environment"
+}
+
+func (r ConnectedEnvironmentResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return connectedenvironments.ValidateConnectedEnvironmentID
+}
+
+func (r ConnectedEnvironmentResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.ConnectedEnvironmentsClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model ConnectedEnvironmentResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := connectedenvironments.NewConnectedEnvironmentID(subscriptionId, model.ResourceGroupName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			extendedLocationType := connectedenvironments.ExtendedLocationTypesCustomLocation
+			payload := connectedenvironments.ConnectedEnvironment{
+				ExtendedLocation: &connectedenvironments.ExtendedLocation{
+					Name: utils.String(model.CustomLocationId),
+					Type: &extendedLocationType,
+				},
+				Location: location.Normalize(model.Location),
+				Properties: &connectedenvironments.ConnectedEnvironmentProperties{
+					CustomDomainConfiguration: expandConnectedEnvironmentCustomDomain(model.CustomDomain),
+				},
+				Tags: &model.Tags,
+			}
+
+			if model.StaticIpAddress != "" {
+				payload.Properties.StaticIP = utils.String(model.StaticIpAddress)
+			}
+
+			if model.DaprApplicationInsightsConnectionString != "" {
+				payload.Properties.DaprAIConnectionString = utils.String(model.DaprApplicationInsightsConnectionString)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ConnectedEnvironmentResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.ConnectedEnvironmentsClient
+
+			id, err := connectedenvironments.ParseConnectedEnvironmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			// the Dapr connection string and the custom domain certificate aren't returned by the API
+			var config ConnectedEnvironmentResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			state := ConnectedEnvironmentResourceModel{
+				Name:                                    id.ConnectedEnvironmentName,
+				ResourceGroupName:                       id.ResourceGroupName,
+				DaprApplicationInsightsConnectionString: config.DaprApplicationInsightsConnectionString,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+
+				if model.ExtendedLocation != nil && model.ExtendedLocation.Name != nil {
+					customLocationId, err := customLocationParse.CustomLocationIDInsensitively(*model.ExtendedLocation.Name)
+					if err != nil {
+						return err
+					}
+					state.CustomLocationId = customLocationId.ID()
+				}
+
+				if props := model.Properties; props != nil {
+					state.StaticIpAddress = utils.NormalizeNilableString(props.StaticIP)
+					state.DefaultDomain = utils.NormalizeNilableString(props.DefaultDomain)
+					state.CustomDomain = flattenConnectedEnvironmentCustomDomain(props.CustomDomainConfiguration, config.CustomDomain)
+				}
+
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ConnectedEnvironmentResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.ConnectedEnvironmentsClient
+
+			id, err := connectedenvironments.ParseConnectedEnvironmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ConnectedEnvironmentResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+			if existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+			}
+
+			payload := *existing.Model
+
+			if metadata.ResourceData.HasChange("dapr_application_insights_connection_string") {
+				payload.Properties.DaprAIConnectionString = utils.String(model.DaprApplicationInsightsConnectionString)
+			}
+
+			// the certificate isn't returned by the API, so this needs to be sent each time
+			payload.Properties.CustomDomainConfiguration = expandConnectedEnvironmentCustomDomain(model.CustomDomain)
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = &model.Tags
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ConnectedEnvironmentResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.ConnectedEnvironmentsClient
+
+			id, err := connectedenvironments.ParseConnectedEnvironmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandConnectedEnvironmentCustomDomain(input []ConnectedEnvironmentCustomDomain) *connectedenvironments.CustomDomainConfiguration {
+	if len(input) == 0 {
+		return nil
+	}
+
+	v := input[0]
+	result := connectedenvironments.CustomDomainConfiguration{
+		DnsSuffix:        utils.String(v.DnsSuffix),
+		CertificateValue: utils.String(v.CertificateBlobBase64),
+	}
+
+	if v.CertificatePassword != "" {
+		result.CertificatePassword = utils.String(v.CertificatePassword)
+	}
+
+	return &result
+}
+
+func flattenConnectedEnvironmentCustomDomain(input *connectedenvironments.CustomDomainConfiguration, config []ConnectedEnvironmentCustomDomain) []ConnectedEnvironmentCustomDomain {
+	if input == nil || input.DnsSuffix == nil {
+		return []ConnectedEnvironmentCustomDomain{}
+	}
+
+	result := ConnectedEnvironmentCustomDomain{
+		DnsSuffix:                  *input.DnsSuffix,
+		CustomDomainVerificationId: utils.NormalizeNilableString(input.CustomDomainVerificationId),
+		Thumbprint:                 utils.NormalizeNilableString(input.Thumbprint),
+	}
+
+	if len(config) > 0 {
+		result.CertificateBlobBase64 = config[0].CertificateBlobBase64
+		result.CertificatePassword = config[0].CertificatePassword
+	}
+
+	return []ConnectedEnvironmentCustomDomain{result}
+}
+
+func validateContainerAppEnvironmentName(i interface{}, k string) ([]string, []error) {
+	return validation.StringMatch(
+		regexp.MustCompile(`^[a-z][a-z0-9-]{0,58}[a-z0-9]$`),
+		fmt.Sprintf("%q must be between 2 and 60 characters long, start with a lowercase letter, end with a lowercase letter or number and can only contain lowercase letters, numbers and hyphens", k),
+	)(i, k)
+}
@@ -0,0 +1,179 @@
+package containerapps_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/sdk/2022-06-01-preview/connectedenvironments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ConnectedEnvironmentResource struct{}
+
+// Connected Environments are deployed to a Custom Location on an Arc-enabled Kubernetes Cluster with the
+// Container Apps extension installed, which must exist in the Primary test location - as such these tests
+// require an existing Custom Location.
+func preCheckConnectedEnvironment(t *testing.T) {
+	if os.Getenv("ARM_TEST_CUSTOM_LOCATION_ID") == "" {
+		t.Skip("Skipping as ARM_TEST_CUSTOM_LOCATION_ID is not specified")
+	}
+}
+
+func TestAccContainerAppConnectedEnvironment_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_connected_environment", "test")
+	r := ConnectedEnvironmentResource{}
+	preCheckConnectedEnvironment(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("default_domain").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccContainerAppConnectedEnvironment_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_connected_environment", "test")
+	r := ConnectedEnvironmentResource{}
+	preCheckConnectedEnvironment(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccContainerAppConnectedEnvironment_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_connected_environment", "test")
+	r := ConnectedEnvironmentResource{}
+	preCheckConnectedEnvironment(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("custom_domain.0.thumbprint").Exists(),
+			),
+		},
+		data.ImportStep("dapr_application_insights_connection_string", "custom_domain.0.certificate_blob_base64", "custom_domain.0.certificate_password"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ConnectedEnvironmentResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := connectedenvironments.ParseConnectedEnvironmentID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.ContainerApps.ConnectedEnvironmentsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r ConnectedEnvironmentResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_app_connected_environment" "test" {
+  name                = "acctest-cae-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  custom_location_id  = %q
+}
+`, r.template(data), data.RandomInteger, os.Getenv("ARM_TEST_CUSTOM_LOCATION_ID"))
+}
+
+func (r ConnectedEnvironmentResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_app_connected_environment" "import" {
+  name                = azurerm_container_app_connected_environment.test.name
+  resource_group_name = azurerm_container_app_connected_environment.test.resource_group_name
+  location            = azurerm_container_app_connected_environment.test.location
+  custom_location_id  = azurerm_container_app_connected_environment.test.custom_location_id
+}
+`, r.basic(data))
+}
+
+func (r ConnectedEnvironmentResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_insights" "test" {
+  name                = "acctestappinsights-%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  application_type    = "web"
+}
+
+resource "azurerm_container_app_connected_environment" "test" {
+  name                = "acctest-cae-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  custom_location_id  = %[3]q
+
+  dapr_application_insights_connection_string = azurerm_application_insights.test.connection_string
+
+  custom_domain {
+    dns_suffix              = "acctest-%[2]d.example.com"
+    certificate_blob_base64 = filebase64("testdata/certificate.pfx")
+    certificate_password    = "terraform"
+  }
+
+  tags = {
+    environment = "test"
+  }
+}
+`, r.template(data), data.RandomInteger, os.Getenv("ARM_TEST_CUSTOM_LOCATION_ID"))
+}
+
+func (r ConnectedEnvironmentResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-containerapps-%d"
+  location = %q
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
@@ -0,0 +1,15 @@
+package connectedenvironments
+
+import "github.com/Azure/go-autorest/autorest"
+
+type ConnectedEnvironmentsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewConnectedEnvironmentsClientWithBaseURI(endpoint string) ConnectedEnvironmentsClient {
+	return ConnectedEnvironmentsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,108 @@
+package connectedenvironments
+
+import "strings"
+
+type ConnectedEnvironmentProvisioningState string
+
+const (
+	ConnectedEnvironmentProvisioningStateCanceled                      ConnectedEnvironmentProvisioningState = "Canceled"
+	ConnectedEnvironmentProvisioningStateFailed                        ConnectedEnvironmentProvisioningState = "Failed"
+	ConnectedEnvironmentProvisioningStateInfrastructureSetupComplete   ConnectedEnvironmentProvisioningState = "InfrastructureSetupComplete"
+	ConnectedEnvironmentProvisioningStateInfrastructureSetupInProgress ConnectedEnvironmentProvisioningState = "InfrastructureSetupInProgress"
+	ConnectedEnvironmentProvisioningStateInitializationInProgress      ConnectedEnvironmentProvisioningState = "InitializationInProgress"
+	ConnectedEnvironmentProvisioningStateScheduledForDelete            ConnectedEnvironmentProvisioningState = "ScheduledForDelete"
+	ConnectedEnvironmentProvisioningStateSucceeded                     ConnectedEnvironmentProvisioningState = "Succeeded"
+	ConnectedEnvironmentProvisioningStateWaiting                       ConnectedEnvironmentProvisioningState = "Waiting"
+)
+
+func PossibleValuesForConnectedEnvironmentProvisioningState() []string {
+	return []string{
+		string(ConnectedEnvironmentProvisioningStateCanceled),
+		string(ConnectedEnvironmentProvisioningStateFailed),
+		string(ConnectedEnvironmentProvisioningStateInfrastructureSetupComplete),
+		string(ConnectedEnvironmentProvisioningStateInfrastructureSetupInProgress),
+		string(ConnectedEnvironmentProvisioningStateInitializationInProgress),
+		string(ConnectedEnvironmentProvisioningStateScheduledForDelete),
+		string(ConnectedEnvironmentProvisioningStateSucceeded),
+		string(ConnectedEnvironmentProvisioningStateWaiting),
+	}
+}
+
+func parseConnectedEnvironmentProvisioningState(input string) (*ConnectedEnvironmentProvisioningState, error) {
+	vals := map[string]ConnectedEnvironmentProvisioningState{
+		"canceled":                      ConnectedEnvironmentProvisioningStateCanceled,
+		"failed":                        ConnectedEnvironmentProvisioningStateFailed,
+		"infrastructuresetupcomplete":   ConnectedEnvironmentProvisioningStateInfrastructureSetupComplete,
+		"infrastructuresetupinprogress": ConnectedEnvironmentProvisioningStateInfrastructureSetupInProgress,
+		"initializationinprogress":      ConnectedEnvironmentProvisioningStateInitializationInProgress,
+		"scheduledfordelete":            ConnectedEnvironmentProvisioningStateScheduledForDelete,
+		"succeeded":                     ConnectedEnvironmentProvisioningStateSucceeded,
+		"waiting":                       ConnectedEnvironmentProvisioningStateWaiting,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ConnectedEnvironmentProvisioningState(input)
+	return &out, nil
+}
+
+type CreatedByType string
+
+const (
+	CreatedByTypeApplication     CreatedByType = "Application"
+	CreatedByTypeKey             CreatedByType = "Key"
+	CreatedByTypeManagedIdentity CreatedByType = "ManagedIdentity"
+	CreatedByTypeUser            CreatedByType = "User"
+)
+
+func PossibleValuesForCreatedByType() []string {
+	return []string{
+		string(CreatedByTypeApplication),
+		string(CreatedByTypeKey),
+		string(CreatedByTypeManagedIdentity),
+		string(CreatedByTypeUser),
+	}
+}
+
+func parseCreatedByType(input string) (*CreatedByType, error) {
+	vals := map[string]CreatedByType{
+		"application":     CreatedByTypeApplication,
+		"key":             CreatedByTypeKey,
+		"managedidentity": CreatedByTypeManagedIdentity,
+		"user":            CreatedByTypeUser,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := CreatedByType(input)
+	return &out, nil
+}
+
+type ExtendedLocationTypes string
+
+const (
+	ExtendedLocationTypesCustomLocation ExtendedLocationTypes = "CustomLocation"
+)
+
+func PossibleValuesForExtendedLocationTypes() []string {
+	return []string{
+		string(ExtendedLocationTypesCustomLocation),
+	}
+}
+
+func parseExtendedLocationTypes(input string) (*ExtendedLocationTypes, error) {
+	vals := map[string]ExtendedLocationTypes{
+		"customlocation": ExtendedLocationTypesCustomLocation,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ExtendedLocationTypes(input)
+	return &out, nil
+}
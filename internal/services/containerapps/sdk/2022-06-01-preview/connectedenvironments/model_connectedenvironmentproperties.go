@@ -0,0 +1,10 @@
+package connectedenvironments
+
+type ConnectedEnvironmentProperties struct {
+	CustomDomainConfiguration *CustomDomainConfiguration             `json:"customDomainConfiguration,omitempty"`
+	DaprAIConnectionString    *string                                `json:"daprAIConnectionString,omitempty"`
+	DefaultDomain             *string                                `json:"defaultDomain,omitempty"`
+	DeploymentErrors          *string                                `json:"deploymentErrors,omitempty"`
+	ProvisioningState         *ConnectedEnvironmentProvisioningState `json:"provisioningState,omitempty"`
+	StaticIP                  *string                                `json:"staticIp,omitempty"`
+}
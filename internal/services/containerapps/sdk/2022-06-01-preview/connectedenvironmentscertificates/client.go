@@ -0,0 +1,15 @@
+package connectedenvironmentscertificates
+
+import "github.com/Azure/go-autorest/autorest"
+
+type ConnectedEnvironmentsCertificatesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewConnectedEnvironmentsCertificatesClientWithBaseURI(endpoint string) ConnectedEnvironmentsCertificatesClient {
+	return ConnectedEnvironmentsCertificatesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
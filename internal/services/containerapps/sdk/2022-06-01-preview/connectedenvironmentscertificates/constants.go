@@ -0,0 +1,74 @@
+package connectedenvironmentscertificates
+
+import "strings"
+
+type CertificateProvisioningState string
+
+const (
+	CertificateProvisioningStateCanceled     CertificateProvisioningState = "Canceled"
+	CertificateProvisioningStateDeleteFailed CertificateProvisioningState = "DeleteFailed"
+	CertificateProvisioningStateFailed       CertificateProvisioningState = "Failed"
+	CertificateProvisioningStatePending      CertificateProvisioningState = "Pending"
+	CertificateProvisioningStateSucceeded    CertificateProvisioningState = "Succeeded"
+)
+
+func PossibleValuesForCertificateProvisioningState() []string {
+	return []string{
+		string(CertificateProvisioningStateCanceled),
+		string(CertificateProvisioningStateDeleteFailed),
+		string(CertificateProvisioningStateFailed),
+		string(CertificateProvisioningStatePending),
+		string(CertificateProvisioningStateSucceeded),
+	}
+}
+
+func parseCertificateProvisioningState(input string) (*CertificateProvisioningState, error) {
+	vals := map[string]CertificateProvisioningState{
+		"canceled":     CertificateProvisioningStateCanceled,
+		"deletefailed": CertificateProvisioningStateDeleteFailed,
+		"failed":       CertificateProvisioningStateFailed,
+		"pending":      CertificateProvisioningStatePending,
+		"succeeded":    CertificateProvisioningStateSucceeded,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := CertificateProvisioningState(input)
+	return &out, nil
+}
+
+type CreatedByType string
+
+const (
+	CreatedByTypeApplication     CreatedByType = "Application"
+	CreatedByTypeKey             CreatedByType = "Key"
+	CreatedByTypeManagedIdentity CreatedByType = "ManagedIdentity"
+	CreatedByTypeUser            CreatedByType = "User"
+)
+
+func PossibleValuesForCreatedByType() []string {
+	return []string{
+		string(CreatedByTypeApplication),
+		string(CreatedByTypeKey),
+		string(CreatedByTypeManagedIdentity),
+		string(CreatedByTypeUser),
+	}
+}
+
+func parseCreatedByType(input string) (*CreatedByType, error) {
+	vals := map[string]CreatedByType{
+		"application":     CreatedByTypeApplication,
+		"key":             CreatedByTypeKey,
+		"managedidentity": CreatedByTypeManagedIdentity,
+		"user":            CreatedByTypeUser,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := CreatedByType(input)
+	return &out, nil
+}
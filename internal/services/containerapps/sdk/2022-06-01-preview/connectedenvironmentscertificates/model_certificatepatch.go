@@ -0,0 +1,5 @@
+package connectedenvironmentscertificates
+
+type CertificatePatch struct {
+	Tags *map[string]string `json:"tags,omitempty"`
+}
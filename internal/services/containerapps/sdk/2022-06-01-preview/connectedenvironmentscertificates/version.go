@@ -0,0 +1,9 @@
+package connectedenvironmentscertificates
+
+import "fmt"
+
+const defaultApiVersion = "2022-06-01-preview"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/connectedenvironmentscertificates/%s", defaultApiVersion)
+}
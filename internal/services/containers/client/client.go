@@ -8,10 +8,19 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/preview/containerregistry/mgmt/2020-11-01-preview/containerregistry"
 	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2023-10-15/fleetmembers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2023-10-15/fleets"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2023-10-15/fleetupdatestrategies"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2023-10-15/updateruns"
 )
 
 type Client struct {
 	AgentPoolsClient                *containerservice.AgentPoolsClient
+	ConnectedRegistriesClient       *containerregistry.ConnectedRegistriesClient
+	FleetMembersClient              *fleetmembers.FleetMembersClient
+	FleetsClient                    *fleets.FleetsClient
+	FleetUpdateRunsClient           *updateruns.UpdateRunsClient
+	FleetUpdateStrategiesClient     *fleetupdatestrategies.FleetUpdateStrategiesClient
 	GroupsClient                    *containerinstance.ContainerGroupsClient
 	KubernetesClustersClient        *containerservice.ManagedClustersClient
 	MaintenanceConfigurationsClient *containerservice.MaintenanceConfigurationsClient
@@ -42,6 +51,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	scopeMapsClient := containerregistry.NewScopeMapsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&scopeMapsClient.Client, o.ResourceManagerAuthorizer)
 
+	connectedRegistriesClient := containerregistry.NewConnectedRegistriesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&connectedRegistriesClient.Client, o.ResourceManagerAuthorizer)
+
 	tasksClient := legacyacr.NewTasksClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&tasksClient.Client, o.ResourceManagerAuthorizer)
 
@@ -61,8 +73,26 @@ func NewClient(o *common.ClientOptions) *Client {
 	servicesClient := legacy.NewContainerServicesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&servicesClient.Client, o.ResourceManagerAuthorizer)
 
+	// Fleet Manager
+	fleetsClient := fleets.NewFleetsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&fleetsClient.Client, o.ResourceManagerAuthorizer)
+
+	fleetMembersClient := fleetmembers.NewFleetMembersClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&fleetMembersClient.Client, o.ResourceManagerAuthorizer)
+
+	fleetUpdateRunsClient := updateruns.NewUpdateRunsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&fleetUpdateRunsClient.Client, o.ResourceManagerAuthorizer)
+
+	fleetUpdateStrategiesClient := fleetupdatestrategies.NewFleetUpdateStrategiesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&fleetUpdateStrategiesClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
 		AgentPoolsClient:                &agentPoolsClient,
+		ConnectedRegistriesClient:       &connectedRegistriesClient,
+		FleetMembersClient:              &fleetMembersClient,
+		FleetsClient:                    &fleetsClient,
+		FleetUpdateRunsClient:           &fleetUpdateRunsClient,
+		FleetUpdateStrategiesClient:     &fleetUpdateStrategiesClient,
 		KubernetesClustersClient:        &kubernetesClustersClient,
 		GroupsClient:                    &groupsClient,
 		MaintenanceConfigurationsClient: &maintenanceConfigurationsClient,
@@ -0,0 +1,422 @@
+package containers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/containerregistry/mgmt/2020-11-01-preview/containerregistry"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	validateHelper "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ContainerRegistryConnectedRegistryResource struct{}
+
+var _ sdk.ResourceWithUpdate = ContainerRegistryConnectedRegistryResource{}
+
+type ContainerRegistryConnectedRegistryModel struct {
+	Name                string   `tfschema:"name"`
+	ContainerRegistryId string   `tfschema:"container_registry_id"`
+	ParentRegistryId    string   `tfschema:"parent_registry_id"`
+	SyncTokenId         string   `tfschema:"sync_token_id"`
+	SyncSchedule        string   `tfschema:"sync_schedule"`
+	SyncWindow          string   `tfschema:"sync_window"`
+	SyncMessageTTL      string   `tfschema:"sync_message_ttl"`
+	Mode                string   `tfschema:"mode"`
+	ClientTokenIds      []string `tfschema:"client_token_ids"`
+	LogLevel            string   `tfschema:"log_level"`
+	AuditLogEnabled     bool     `tfschema:"audit_log_enabled"`
+	ConnectionState     string   `tfschema:"connection_state"`
+	Version             string   `tfschema:"version"`
+	GatewayEndpoint     string   `tfschema:"gateway_endpoint"`
+	LastSyncTime        string   `tfschema:"last_sync_time"`
+}
+
+func (r ContainerRegistryConnectedRegistryResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ContainerRegistryConnectedRegistryName,
+		},
+
+		"container_registry_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.RegistryID,
+		},
+
+		// connected registries can be nested, in which case the parent is another connected registry
+		"parent_registry_id": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			ForceNew: true,
+			ValidateFunc: validation.Any(
+				validate.RegistryID,
+				validate.ContainerRegistryConnectedRegistryID,
+			),
+		},
+
+		// the sync token can be rotated in-place, however doing so requires the
+		// connected registry on the edge device to be reconfigured
+		"sync_token_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validate.ContainerRegistryTokenID,
+		},
+
+		"sync_schedule": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      "* * * * *",
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"sync_window": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validateHelper.ISO8601Duration,
+		},
+
+		"sync_message_ttl": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      "P1D",
+			ValidateFunc: validateHelper.ISO8601Duration,
+		},
+
+		"mode": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			ForceNew: true,
+			Default:  string(containerregistry.ConnectedRegistryModeRegistry),
+			ValidateFunc: validation.StringInSlice([]string{
+				string(containerregistry.ConnectedRegistryModeMirror),
+				string(containerregistry.ConnectedRegistryModeRegistry),
+			}, false),
+		},
+
+		"client_token_ids": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validate.ContainerRegistryTokenID,
+			},
+		},
+
+		"log_level": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Default:  string(containerregistry.LogLevelNone),
+			ValidateFunc: validation.StringInSlice([]string{
+				string(containerregistry.LogLevelDebug),
+				string(containerregistry.LogLevelError),
+				string(containerregistry.LogLevelInformation),
+				string(containerregistry.LogLevelNone),
+				string(containerregistry.LogLevelWarning),
+			}, false),
+		},
+
+		"audit_log_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+	}
+}
+
+func (r ContainerRegistryConnectedRegistryResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"connection_state": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"version": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"gateway_endpoint": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"last_sync_time": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r ContainerRegistryConnectedRegistryResource) ResourceType() string {
+	return "azurerm_container_registry_connected_registry"
+}
+
+func (r ContainerRegistryConnectedRegistryResource) ModelObject() interface{} {
+	return &ContainerRegistryConnectedRegistryModel{}
+}
+
+func (r ContainerRegistryConnectedRegistryResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.ContainerRegistryConnectedRegistryID
+}
+
+func (r ContainerRegistryConnectedRegistryResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ConnectedRegistriesClient
+
+			var model ContainerRegistryConnectedRegistryModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			registryId, err := parse.RegistryID(model.ContainerRegistryId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewContainerRegistryConnectedRegistryID(registryId.SubscriptionId, registryId.ResourceGroup, registryId.Name, model.Name)
+			existing, err := client.Get(ctx, id.ResourceGroup, id.RegistryName, id.ConnectedRegistryName)
+			if err != nil {
+				if !utils.ResponseWasNotFound(existing.Response) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			params := expandContainerRegistryConnectedRegistry(model)
+			future, err := client.Create(ctx, id.ResourceGroup, id.RegistryName, id.ConnectedRegistryName, params)
+			if err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for creation of %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ContainerRegistryConnectedRegistryResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ConnectedRegistriesClient
+
+			id, err := parse.ContainerRegistryConnectedRegistryID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+			registryId := parse.NewRegistryID(id.SubscriptionId, id.ResourceGroup, id.RegistryName)
+
+			resp, err := client.Get(ctx, id.ResourceGroup, id.RegistryName, id.ConnectedRegistryName)
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			model := ContainerRegistryConnectedRegistryModel{
+				Name:                id.ConnectedRegistryName,
+				ContainerRegistryId: registryId.ID(),
+			}
+
+			if props := resp.ConnectedRegistryProperties; props != nil {
+				model.Mode = string(props.Mode)
+				model.ConnectionState = string(props.ConnectionState)
+				if props.Version != nil {
+					model.Version = *props.Version
+				}
+
+				if parent := props.Parent; parent != nil {
+					if parent.ID != nil {
+						model.ParentRegistryId = *parent.ID
+					}
+
+					if sync := parent.SyncProperties; sync != nil {
+						if sync.TokenID != nil {
+							model.SyncTokenId = *sync.TokenID
+						}
+						if sync.Schedule != nil {
+							model.SyncSchedule = *sync.Schedule
+						}
+						if sync.SyncWindow != nil {
+							model.SyncWindow = *sync.SyncWindow
+						}
+						if sync.MessageTTL != nil {
+							model.SyncMessageTTL = *sync.MessageTTL
+						}
+						if sync.GatewayEndpoint != nil {
+							model.GatewayEndpoint = *sync.GatewayEndpoint
+						}
+						if sync.LastSyncTime != nil {
+							model.LastSyncTime = sync.LastSyncTime.Format(time.RFC3339)
+						}
+					}
+				}
+
+				if props.ClientTokenIds != nil {
+					model.ClientTokenIds = *props.ClientTokenIds
+				}
+
+				if logging := props.Logging; logging != nil {
+					model.LogLevel = string(logging.LogLevel)
+					model.AuditLogEnabled = logging.AuditLogStatus == containerregistry.Enabled
+				}
+			}
+
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r ContainerRegistryConnectedRegistryResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ConnectedRegistriesClient
+
+			id, err := parse.ContainerRegistryConnectedRegistryID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ContainerRegistryConnectedRegistryModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			// the sync token isn't exposed by the PATCH endpoint, so rotating it requires the whole resource to be re-submitted
+			if metadata.ResourceData.HasChange("sync_token_id") {
+				params := expandContainerRegistryConnectedRegistry(model)
+				future, err := client.Create(ctx, id.ResourceGroup, id.RegistryName, id.ConnectedRegistryName, params)
+				if err != nil {
+					return fmt.Errorf("updating %s: %+v", id, err)
+				}
+				if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+					return fmt.Errorf("waiting for update of %s: %+v", id, err)
+				}
+
+				return nil
+			}
+
+			params := containerregistry.ConnectedRegistryUpdateParameters{
+				ConnectedRegistryUpdateProperties: &containerregistry.ConnectedRegistryUpdateProperties{},
+			}
+			if metadata.ResourceData.HasChanges("sync_schedule", "sync_window", "sync_message_ttl") {
+				params.ConnectedRegistryUpdateProperties.SyncProperties = &containerregistry.SyncUpdateProperties{
+					Schedule:   utils.String(model.SyncSchedule),
+					MessageTTL: utils.String(model.SyncMessageTTL),
+				}
+				if model.SyncWindow != "" {
+					params.ConnectedRegistryUpdateProperties.SyncProperties.SyncWindow = utils.String(model.SyncWindow)
+				}
+			}
+			if metadata.ResourceData.HasChange("client_token_ids") {
+				// an empty list (rather than null) is required to remove all of the client tokens
+				clientTokenIds := make([]string, 0)
+				clientTokenIds = append(clientTokenIds, model.ClientTokenIds...)
+				params.ConnectedRegistryUpdateProperties.ClientTokenIds = &clientTokenIds
+			}
+			if metadata.ResourceData.HasChanges("log_level", "audit_log_enabled") {
+				params.ConnectedRegistryUpdateProperties.Logging = expandContainerRegistryConnectedRegistryLogging(model)
+			}
+
+			future, err := client.Update(ctx, id.ResourceGroup, id.RegistryName, id.ConnectedRegistryName, params)
+			if err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for update of %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ContainerRegistryConnectedRegistryResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ConnectedRegistriesClient
+
+			id, err := parse.ContainerRegistryConnectedRegistryID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			future, err := client.Delete(ctx, id.ResourceGroup, id.RegistryName, id.ConnectedRegistryName)
+			if err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				if !response.WasNotFound(future.Response()) {
+					return fmt.Errorf("waiting for removal of %s: %+v", id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandContainerRegistryConnectedRegistry(model ContainerRegistryConnectedRegistryModel) containerregistry.ConnectedRegistry {
+	sync := &containerregistry.SyncProperties{
+		TokenID:    utils.String(model.SyncTokenId),
+		Schedule:   utils.String(model.SyncSchedule),
+		MessageTTL: utils.String(model.SyncMessageTTL),
+	}
+	if model.SyncWindow != "" {
+		sync.SyncWindow = utils.String(model.SyncWindow)
+	}
+
+	parent := &containerregistry.ParentProperties{
+		SyncProperties: sync,
+	}
+	if model.ParentRegistryId != "" {
+		parent.ID = utils.String(model.ParentRegistryId)
+	}
+
+	props := &containerregistry.ConnectedRegistryProperties{
+		Mode:    containerregistry.ConnectedRegistryMode(model.Mode),
+		Parent:  parent,
+		Logging: expandContainerRegistryConnectedRegistryLogging(model),
+	}
+	if len(model.ClientTokenIds) > 0 {
+		props.ClientTokenIds = &model.ClientTokenIds
+	}
+
+	return containerregistry.ConnectedRegistry{
+		ConnectedRegistryProperties: props,
+	}
+}
+
+func expandContainerRegistryConnectedRegistryLogging(model ContainerRegistryConnectedRegistryModel) *containerregistry.LoggingProperties {
+	auditLogStatus := containerregistry.Disabled
+	if model.AuditLogEnabled {
+		auditLogStatus = containerregistry.Enabled
+	}
+
+	return &containerregistry.LoggingProperties{
+		LogLevel:       containerregistry.LogLevel(model.LogLevel),
+		AuditLogStatus: auditLogStatus,
+	}
+}
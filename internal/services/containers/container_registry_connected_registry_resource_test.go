@@ -0,0 +1,220 @@
+package containers_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ContainerRegistryConnectedRegistryResource struct{}
+
+func TestAccContainerRegistryConnectedRegistry_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry_connected_registry", "test")
+	r := ContainerRegistryConnectedRegistryResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccContainerRegistryConnectedRegistry_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry_connected_registry", "test")
+	r := ContainerRegistryConnectedRegistryResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccContainerRegistryConnectedRegistry_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry_connected_registry", "test")
+	r := ContainerRegistryConnectedRegistryResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data, "sync"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccContainerRegistryConnectedRegistry_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry_connected_registry", "test")
+	r := ContainerRegistryConnectedRegistryResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data, "sync"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			// rotates the sync token
+			Config: r.complete(data, "rotated"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ContainerRegistryConnectedRegistryResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ContainerRegistryConnectedRegistryID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Containers.ConnectedRegistriesClient.Get(ctx, id.ResourceGroup, id.RegistryName, id.ConnectedRegistryName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return utils.Bool(resp.ID != nil), nil
+}
+
+func (r ContainerRegistryConnectedRegistryResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-acr-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_container_registry" "test" {
+  name                  = "testacccr%[1]d"
+  resource_group_name   = azurerm_resource_group.test.name
+  location              = azurerm_resource_group.test.location
+  sku                   = "Premium"
+  data_endpoint_enabled = true
+}
+
+resource "azurerm_container_registry_scope_map" "sync" {
+  name                    = "testsyncscopemap%[1]d"
+  container_registry_name = azurerm_container_registry.test.name
+  resource_group_name     = azurerm_container_registry.test.resource_group_name
+  actions = [
+    "repositories/hello-world/content/read",
+    "repositories/hello-world/metadata/read",
+    "gateway/testacccr%[1]d/config/read",
+    "gateway/testacccr%[1]d/config/write",
+    "gateway/testacccr%[1]d/message/read",
+    "gateway/testacccr%[1]d/message/write",
+  ]
+}
+
+resource "azurerm_container_registry_token" "sync" {
+  name                    = "testsynctoken%[1]d"
+  container_registry_name = azurerm_container_registry.test.name
+  resource_group_name     = azurerm_container_registry.test.resource_group_name
+  scope_map_id            = azurerm_container_registry_scope_map.sync.id
+}
+
+resource "azurerm_container_registry_token" "rotated" {
+  name                    = "testrotatedtoken%[1]d"
+  container_registry_name = azurerm_container_registry.test.name
+  resource_group_name     = azurerm_container_registry.test.resource_group_name
+  scope_map_id            = azurerm_container_registry_scope_map.sync.id
+}
+
+# use system wide scope map for the client token
+data "azurerm_container_registry_scope_map" "pull_repos" {
+  name                    = "_repositories_pull"
+  container_registry_name = azurerm_container_registry.test.name
+  resource_group_name     = azurerm_container_registry.test.resource_group_name
+}
+
+resource "azurerm_container_registry_token" "client" {
+  name                    = "testclienttoken%[1]d"
+  container_registry_name = azurerm_container_registry.test.name
+  resource_group_name     = azurerm_container_registry.test.resource_group_name
+  scope_map_id            = data.azurerm_container_registry_scope_map.pull_repos.id
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r ContainerRegistryConnectedRegistryResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_registry_connected_registry" "test" {
+  name                  = "testacccr%d"
+  container_registry_id = azurerm_container_registry.test.id
+  sync_token_id         = azurerm_container_registry_token.sync.id
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ContainerRegistryConnectedRegistryResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_registry_connected_registry" "import" {
+  name                  = azurerm_container_registry_connected_registry.test.name
+  container_registry_id = azurerm_container_registry_connected_registry.test.container_registry_id
+  sync_token_id         = azurerm_container_registry_connected_registry.test.sync_token_id
+}
+`, r.basic(data))
+}
+
+func (r ContainerRegistryConnectedRegistryResource) complete(data acceptance.TestData, syncToken string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_registry_connected_registry" "test" {
+  name                  = "testacccr%d"
+  container_registry_id = azurerm_container_registry.test.id
+  sync_token_id         = azurerm_container_registry_token.%s.id
+  sync_schedule         = "0 9 * * *"
+  sync_window           = "PT3H"
+  sync_message_ttl      = "P2D"
+  client_token_ids      = [azurerm_container_registry_token.client.id]
+  log_level             = "Information"
+  audit_log_enabled     = true
+}
+`, r.template(data), data.RandomInteger, syncToken)
+}
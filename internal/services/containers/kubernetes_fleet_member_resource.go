@@ -0,0 +1,206 @@
+package containers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2023-10-15/fleetmembers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2023-10-15/fleets"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.ResourceWithUpdate = KubernetesFleetMemberResource{}
+
+type KubernetesFleetMemberResource struct{}
+
+type KubernetesFleetMemberResourceModel struct {
+	Name                string `tfschema:"name"`
+	KubernetesFleetId   string `tfschema:"kubernetes_fleet_id"`
+	KubernetesClusterId string `tfschema:"kubernetes_cluster_id"`
+	Group               string `tfschema:"group"`
+}
+
+func (r KubernetesFleetMemberResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.KubernetesFleetName,
+		},
+
+		"kubernetes_fleet_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: fleets.ValidateFleetID,
+		},
+
+		"kubernetes_cluster_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ClusterID,
+		},
+
+		"group": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validate.KubernetesFleetUpdateName,
+		},
+	}
+}
+
+func (r KubernetesFleetMemberResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r KubernetesFleetMemberResource) ModelObject() interface{} {
+	return &KubernetesFleetMemberResourceModel{}
+}
+
+func (r KubernetesFleetMemberResource) ResourceType() string {
+	return "azurerm_kubernetes_fleet_member"
+}
+
+func (r KubernetesFleetMemberResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return fleetmembers.ValidateMemberID
+}
+
+func (r KubernetesFleetMemberResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.FleetMembersClient
+
+			var model KubernetesFleetMemberResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			fleetId, err := fleets.ParseFleetID(model.KubernetesFleetId)
+			if err != nil {
+				return err
+			}
+
+			id := fleetmembers.NewMemberID(fleetId.SubscriptionId, fleetId.ResourceGroupName, fleetId.FleetName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := fleetmembers.FleetMember{
+				Properties: &fleetmembers.FleetMemberProperties{
+					ClusterResourceId: model.KubernetesClusterId,
+				},
+			}
+			if model.Group != "" {
+				payload.Properties.Group = utils.String(model.Group)
+			}
+
+			if err := client.CreateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r KubernetesFleetMemberResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.FleetMembersClient
+
+			id, err := fleetmembers.ParseMemberID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := KubernetesFleetMemberResourceModel{
+				Name:              id.MemberName,
+				KubernetesFleetId: fleets.NewFleetID(id.SubscriptionId, id.ResourceGroupName, id.FleetName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.KubernetesClusterId = props.ClusterResourceId
+					state.Group = utils.NormalizeNilableString(props.Group)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r KubernetesFleetMemberResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.FleetMembersClient
+
+			id, err := fleetmembers.ParseMemberID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model KubernetesFleetMemberResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if metadata.ResourceData.HasChange("group") {
+				payload := fleetmembers.FleetMemberUpdate{
+					Properties: &fleetmembers.FleetMemberUpdateProperties{
+						Group: utils.String(model.Group),
+					},
+				}
+				if err := client.UpdateThenPoll(ctx, *id, payload); err != nil {
+					return fmt.Errorf("updating %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r KubernetesFleetMemberResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.FleetMembersClient
+
+			id, err := fleetmembers.ParseMemberID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,148 @@
+package containers_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2023-10-15/fleetmembers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type KubernetesFleetMemberResource struct{}
+
+func TestAccKubernetesFleetMember_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_fleet_member", "test")
+	r := KubernetesFleetMemberResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccKubernetesFleetMember_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_fleet_member", "test")
+	r := KubernetesFleetMemberResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccKubernetesFleetMember_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_fleet_member", "test")
+	r := KubernetesFleetMemberResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.group(data, "canary"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("group").HasValue("canary"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.group(data, "production"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("group").HasValue("production"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r KubernetesFleetMemberResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := fleetmembers.ParseMemberID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Containers.FleetMembersClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r KubernetesFleetMemberResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kubernetes_cluster" "test" {
+  name                = "acctestaks%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  dns_prefix          = "acctestaks%d"
+
+  default_node_pool {
+    name       = "default"
+    node_count = 1
+    vm_size    = "Standard_DS2_v2"
+  }
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+`, KubernetesFleetManagerResource{}.basic(data), data.RandomInteger, data.RandomInteger)
+}
+
+func (r KubernetesFleetMemberResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kubernetes_fleet_member" "test" {
+  name                  = "acctestmember%d"
+  kubernetes_fleet_id   = azurerm_kubernetes_fleet_manager.test.id
+  kubernetes_cluster_id = azurerm_kubernetes_cluster.test.id
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r KubernetesFleetMemberResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kubernetes_fleet_member" "import" {
+  name                  = azurerm_kubernetes_fleet_member.test.name
+  kubernetes_fleet_id   = azurerm_kubernetes_fleet_member.test.kubernetes_fleet_id
+  kubernetes_cluster_id = azurerm_kubernetes_fleet_member.test.kubernetes_cluster_id
+}
+`, r.basic(data))
+}
+
+func (r KubernetesFleetMemberResource) group(data acceptance.TestData, group string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kubernetes_fleet_member" "test" {
+  name                  = "acctestmember%d"
+  kubernetes_fleet_id   = azurerm_kubernetes_fleet_manager.test.id
+  kubernetes_cluster_id = azurerm_kubernetes_cluster.test.id
+  group                 = %q
+}
+`, r.template(data), data.RandomInteger, group)
+}
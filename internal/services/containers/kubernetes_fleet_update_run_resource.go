@@ -0,0 +1,344 @@
+package containers
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2023-10-15/fleets"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2023-10-15/fleetupdatestrategies"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2023-10-15/updateruns"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// An Update Run is a one-off trigger which rolls an upgrade out across the members of a Fleet, as such it can't
+// be updated once created - changing any argument recreates (and re-runs) it.
+var _ sdk.Resource = KubernetesFleetUpdateRunResource{}
+
+type KubernetesFleetUpdateRunResource struct{}
+
+type KubernetesFleetUpdateRunResourceModel struct {
+	Name                     string                                         `tfschema:"name"`
+	KubernetesFleetManagerId string                                         `tfschema:"kubernetes_fleet_manager_id"`
+	FleetUpdateStrategyId    string                                         `tfschema:"fleet_update_strategy_id"`
+	ManagedClusterUpdate     []KubernetesFleetUpdateRunManagedClusterUpdate `tfschema:"managed_cluster_update"`
+	Stage                    []KubernetesFleetUpdateStageModel              `tfschema:"stage"`
+	Status                   string                                         `tfschema:"status"`
+}
+
+type KubernetesFleetUpdateRunManagedClusterUpdate struct {
+	Upgrade            []KubernetesFleetUpdateRunUpgrade            `tfschema:"upgrade"`
+	NodeImageSelection []KubernetesFleetUpdateRunNodeImageSelection `tfschema:"node_image_selection"`
+}
+
+type KubernetesFleetUpdateRunUpgrade struct {
+	Type              string `tfschema:"type"`
+	KubernetesVersion string `tfschema:"kubernetes_version"`
+}
+
+type KubernetesFleetUpdateRunNodeImageSelection struct {
+	Type string `tfschema:"type"`
+}
+
+func (r KubernetesFleetUpdateRunResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.KubernetesFleetUpdateName,
+		},
+
+		"kubernetes_fleet_manager_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: fleets.ValidateFleetID,
+		},
+
+		"managed_cluster_update": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			ForceNew: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"upgrade": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						ForceNew: true,
+						MaxItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"type": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ForceNew:     true,
+									ValidateFunc: validation.StringInSlice(updateruns.PossibleValuesForManagedClusterUpgradeType(), false),
+								},
+
+								"kubernetes_version": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									ForceNew:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+							},
+						},
+					},
+
+					"node_image_selection": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						ForceNew: true,
+						MaxItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"type": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ForceNew:     true,
+									ValidateFunc: validation.StringInSlice(updateruns.PossibleValuesForNodeImageSelectionType(), false),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+
+		"fleet_update_strategy_id": {
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			ForceNew:      true,
+			ValidateFunc:  fleetupdatestrategies.ValidateUpdateStrategyID,
+			ConflictsWith: []string{"stage"},
+		},
+
+		"stage": func() *pluginsdk.Schema {
+			s := kubernetesFleetUpdateStageSchema(false, true)
+			s.ConflictsWith = []string{"fleet_update_strategy_id"}
+			return s
+		}(),
+	}
+}
+
+func (r KubernetesFleetUpdateRunResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"status": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r KubernetesFleetUpdateRunResource) ModelObject() interface{} {
+	return &KubernetesFleetUpdateRunResourceModel{}
+}
+
+func (r KubernetesFleetUpdateRunResource) ResourceType() string {
+	return "azurerm_kubernetes_fleet_update_run"
+}
+
+func (r KubernetesFleetUpdateRunResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return updateruns.ValidateUpdateRunID
+}
+
+func (r KubernetesFleetUpdateRunResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.FleetUpdateRunsClient
+
+			var model KubernetesFleetUpdateRunResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			fleetId, err := fleets.ParseFleetID(model.KubernetesFleetManagerId)
+			if err != nil {
+				return err
+			}
+
+			id := updateruns.NewUpdateRunID(fleetId.SubscriptionId, fleetId.ResourceGroupName, fleetId.FleetName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := updateruns.UpdateRun{
+				Properties: &updateruns.UpdateRunProperties{
+					ManagedClusterUpdate: expandKubernetesFleetUpdateRunManagedClusterUpdate(model.ManagedClusterUpdate),
+				},
+			}
+			if model.FleetUpdateStrategyId != "" {
+				payload.Properties.UpdateStrategyId = utils.String(model.FleetUpdateStrategyId)
+			}
+			if len(model.Stage) > 0 {
+				payload.Properties.Strategy = &updateruns.UpdateRunStrategy{
+					Stages: expandKubernetesFleetUpdateRunStages(model.Stage),
+				}
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			// the ID is set prior to starting the Update Run so that a failure to start it taints (rather than
+			// orphans) the resource
+			metadata.SetID(id)
+
+			if err := client.StartThenPoll(ctx, id); err != nil {
+				return fmt.Errorf("starting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r KubernetesFleetUpdateRunResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.FleetUpdateRunsClient
+
+			id, err := updateruns.ParseUpdateRunID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := KubernetesFleetUpdateRunResourceModel{
+				Name:                     id.UpdateRunName,
+				KubernetesFleetManagerId: fleets.NewFleetID(id.SubscriptionId, id.ResourceGroupName, id.FleetName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.ManagedClusterUpdate = flattenKubernetesFleetUpdateRunManagedClusterUpdate(props.ManagedClusterUpdate)
+
+					if props.UpdateStrategyId != nil {
+						updateStrategyId, err := fleetupdatestrategies.ParseUpdateStrategyIDInsensitively(*props.UpdateStrategyId)
+						if err != nil {
+							return err
+						}
+						state.FleetUpdateStrategyId = updateStrategyId.ID()
+					} else if props.Strategy != nil {
+						// when an Update Strategy is referenced the API returns a copy of its Stages, which
+						// would otherwise conflict with `fleet_update_strategy_id`
+						state.Stage = flattenKubernetesFleetUpdateRunStages(props.Strategy.Stages)
+					}
+
+					if props.Status != nil && props.Status.Status != nil && props.Status.Status.State != nil {
+						state.Status = string(*props.Status.Status.State)
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r KubernetesFleetUpdateRunResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.FleetUpdateRunsClient
+
+			id, err := updateruns.ParseUpdateRunID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return nil
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			// an Update Run which is in progress can't be deleted, so it needs to be stopped first
+			if model := resp.Model; model != nil && model.Properties != nil {
+				if status := model.Properties.Status; status != nil && status.Status != nil && status.Status.State != nil {
+					if *status.Status.State == updateruns.UpdateStateRunning {
+						log.Printf("[DEBUG] Stopping %s..", *id)
+						if err := client.StopThenPoll(ctx, *id); err != nil {
+							return fmt.Errorf("stopping %s: %+v", *id, err)
+						}
+					}
+				}
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandKubernetesFleetUpdateRunManagedClusterUpdate(input []KubernetesFleetUpdateRunManagedClusterUpdate) updateruns.ManagedClusterUpdate {
+	output := updateruns.ManagedClusterUpdate{}
+	if len(input) == 0 {
+		return output
+	}
+
+	if len(input[0].Upgrade) > 0 {
+		upgrade := input[0].Upgrade[0]
+		output.Upgrade = updateruns.ManagedClusterUpgradeSpec{
+			Type: updateruns.ManagedClusterUpgradeType(upgrade.Type),
+		}
+		if upgrade.KubernetesVersion != "" {
+			output.Upgrade.KubernetesVersion = utils.String(upgrade.KubernetesVersion)
+		}
+	}
+
+	if len(input[0].NodeImageSelection) > 0 {
+		output.NodeImageSelection = &updateruns.NodeImageSelection{
+			Type: updateruns.NodeImageSelectionType(input[0].NodeImageSelection[0].Type),
+		}
+	}
+
+	return output
+}
+
+func flattenKubernetesFleetUpdateRunManagedClusterUpdate(input updateruns.ManagedClusterUpdate) []KubernetesFleetUpdateRunManagedClusterUpdate {
+	output := KubernetesFleetUpdateRunManagedClusterUpdate{
+		Upgrade: []KubernetesFleetUpdateRunUpgrade{
+			{
+				Type:              string(input.Upgrade.Type),
+				KubernetesVersion: utils.NormalizeNilableString(input.Upgrade.KubernetesVersion),
+			},
+		},
+		NodeImageSelection: []KubernetesFleetUpdateRunNodeImageSelection{},
+	}
+
+	if input.NodeImageSelection != nil {
+		output.NodeImageSelection = append(output.NodeImageSelection, KubernetesFleetUpdateRunNodeImageSelection{
+			Type: string(input.NodeImageSelection.Type),
+		})
+	}
+
+	return []KubernetesFleetUpdateRunManagedClusterUpdate{output}
+}
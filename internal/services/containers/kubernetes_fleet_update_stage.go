@@ -0,0 +1,168 @@
+package containers
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2023-10-15/fleetupdatestrategies"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2023-10-15/updateruns"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// KubernetesFleetUpdateStageModel is a Stage of an Update Strategy, within which the Groups of Fleet Members are
+// updated in parallel - with the Stages updated sequentially in the order specified
+type KubernetesFleetUpdateStageModel struct {
+	Name                    string                            `tfschema:"name"`
+	Group                   []KubernetesFleetUpdateGroupModel `tfschema:"group"`
+	AfterStageWaitInSeconds int64                             `tfschema:"after_stage_wait_in_seconds"`
+}
+
+type KubernetesFleetUpdateGroupModel struct {
+	Name string `tfschema:"name"`
+}
+
+// kubernetesFleetUpdateStageSchema returns the schema for the `stage` block used by both Update Strategies and
+// Update Runs, the latter of which can't be updated once created
+func kubernetesFleetUpdateStageSchema(required, forceNew bool) *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Required: required,
+		Optional: !required,
+		ForceNew: forceNew,
+		MinItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ForceNew:     forceNew,
+					ValidateFunc: validate.KubernetesFleetUpdateName,
+				},
+
+				"group": {
+					Type:     pluginsdk.TypeList,
+					Required: true,
+					ForceNew: forceNew,
+					MinItems: 1,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"name": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ForceNew:     forceNew,
+								ValidateFunc: validate.KubernetesFleetUpdateName,
+							},
+						},
+					},
+				},
+
+				"after_stage_wait_in_seconds": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					ForceNew:     forceNew,
+					ValidateFunc: validation.IntBetween(0, 3600),
+				},
+			},
+		},
+	}
+}
+
+func expandKubernetesFleetUpdateStrategyStages(input []KubernetesFleetUpdateStageModel) []fleetupdatestrategies.UpdateStage {
+	stages := make([]fleetupdatestrategies.UpdateStage, 0)
+	for _, stage := range input {
+		groups := make([]fleetupdatestrategies.UpdateGroup, 0)
+		for _, group := range stage.Group {
+			groups = append(groups, fleetupdatestrategies.UpdateGroup{
+				Name: group.Name,
+			})
+		}
+
+		output := fleetupdatestrategies.UpdateStage{
+			Name:   stage.Name,
+			Groups: &groups,
+		}
+		if stage.AfterStageWaitInSeconds != 0 {
+			output.AfterStageWaitInSeconds = utils.Int64(stage.AfterStageWaitInSeconds)
+		}
+		stages = append(stages, output)
+	}
+
+	return stages
+}
+
+func flattenKubernetesFleetUpdateStrategyStages(input []fleetupdatestrategies.UpdateStage) []KubernetesFleetUpdateStageModel {
+	stages := make([]KubernetesFleetUpdateStageModel, 0)
+	for _, stage := range input {
+		groups := make([]KubernetesFleetUpdateGroupModel, 0)
+		if stage.Groups != nil {
+			for _, group := range *stage.Groups {
+				groups = append(groups, KubernetesFleetUpdateGroupModel{
+					Name: group.Name,
+				})
+			}
+		}
+
+		afterStageWaitInSeconds := int64(0)
+		if stage.AfterStageWaitInSeconds != nil {
+			afterStageWaitInSeconds = *stage.AfterStageWaitInSeconds
+		}
+
+		stages = append(stages, KubernetesFleetUpdateStageModel{
+			Name:                    stage.Name,
+			Group:                   groups,
+			AfterStageWaitInSeconds: afterStageWaitInSeconds,
+		})
+	}
+
+	return stages
+}
+
+func expandKubernetesFleetUpdateRunStages(input []KubernetesFleetUpdateStageModel) []updateruns.UpdateStage {
+	stages := make([]updateruns.UpdateStage, 0)
+	for _, stage := range input {
+		groups := make([]updateruns.UpdateGroup, 0)
+		for _, group := range stage.Group {
+			groups = append(groups, updateruns.UpdateGroup{
+				Name: group.Name,
+			})
+		}
+
+		output := updateruns.UpdateStage{
+			Name:   stage.Name,
+			Groups: &groups,
+		}
+		if stage.AfterStageWaitInSeconds != 0 {
+			output.AfterStageWaitInSeconds = utils.Int64(stage.AfterStageWaitInSeconds)
+		}
+		stages = append(stages, output)
+	}
+
+	return stages
+}
+
+func flattenKubernetesFleetUpdateRunStages(input []updateruns.UpdateStage) []KubernetesFleetUpdateStageModel {
+	stages := make([]KubernetesFleetUpdateStageModel, 0)
+	for _, stage := range input {
+		groups := make([]KubernetesFleetUpdateGroupModel, 0)
+		if stage.Groups != nil {
+			for _, group := range *stage.Groups {
+				groups = append(groups, KubernetesFleetUpdateGroupModel{
+					Name: group.Name,
+				})
+			}
+		}
+
+		afterStageWaitInSeconds := int64(0)
+		if stage.AfterStageWaitInSeconds != nil {
+			afterStageWaitInSeconds = *stage.AfterStageWaitInSeconds
+		}
+
+		stages = append(stages, KubernetesFleetUpdateStageModel{
+			Name:                    stage.Name,
+			Group:                   groups,
+			AfterStageWaitInSeconds: afterStageWaitInSeconds,
+		})
+	}
+
+	return stages
+}
@@ -0,0 +1,199 @@
+package containers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2023-10-15/fleets"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2023-10-15/fleetupdatestrategies"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+var _ sdk.ResourceWithUpdate = KubernetesFleetUpdateStrategyResource{}
+
+type KubernetesFleetUpdateStrategyResource struct{}
+
+type KubernetesFleetUpdateStrategyResourceModel struct {
+	Name                     string                            `tfschema:"name"`
+	KubernetesFleetManagerId string                            `tfschema:"kubernetes_fleet_manager_id"`
+	Stage                    []KubernetesFleetUpdateStageModel `tfschema:"stage"`
+}
+
+func (r KubernetesFleetUpdateStrategyResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.KubernetesFleetUpdateName,
+		},
+
+		"kubernetes_fleet_manager_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: fleets.ValidateFleetID,
+		},
+
+		"stage": kubernetesFleetUpdateStageSchema(true, false),
+	}
+}
+
+func (r KubernetesFleetUpdateStrategyResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r KubernetesFleetUpdateStrategyResource) ModelObject() interface{} {
+	return &KubernetesFleetUpdateStrategyResourceModel{}
+}
+
+func (r KubernetesFleetUpdateStrategyResource) ResourceType() string {
+	return "azurerm_kubernetes_fleet_update_strategy"
+}
+
+func (r KubernetesFleetUpdateStrategyResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return fleetupdatestrategies.ValidateUpdateStrategyID
+}
+
+func (r KubernetesFleetUpdateStrategyResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.FleetUpdateStrategiesClient
+
+			var model KubernetesFleetUpdateStrategyResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			fleetId, err := fleets.ParseFleetID(model.KubernetesFleetManagerId)
+			if err != nil {
+				return err
+			}
+
+			id := fleetupdatestrategies.NewUpdateStrategyID(fleetId.SubscriptionId, fleetId.ResourceGroupName, fleetId.FleetName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := fleetupdatestrategies.FleetUpdateStrategy{
+				Properties: &fleetupdatestrategies.FleetUpdateStrategyProperties{
+					Strategy: fleetupdatestrategies.UpdateRunStrategy{
+						Stages: expandKubernetesFleetUpdateStrategyStages(model.Stage),
+					},
+				},
+			}
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r KubernetesFleetUpdateStrategyResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.FleetUpdateStrategiesClient
+
+			id, err := fleetupdatestrategies.ParseUpdateStrategyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := KubernetesFleetUpdateStrategyResourceModel{
+				Name:                     id.UpdateStrategyName,
+				KubernetesFleetManagerId: fleets.NewFleetID(id.SubscriptionId, id.ResourceGroupName, id.FleetName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.Stage = flattenKubernetesFleetUpdateStrategyStages(props.Strategy.Stages)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r KubernetesFleetUpdateStrategyResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.FleetUpdateStrategiesClient
+
+			id, err := fleetupdatestrategies.ParseUpdateStrategyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model KubernetesFleetUpdateStrategyResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if resp.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+			if resp.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+			}
+
+			payload := *resp.Model
+			if metadata.ResourceData.HasChange("stage") {
+				payload.Properties.Strategy.Stages = expandKubernetesFleetUpdateStrategyStages(model.Stage)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r KubernetesFleetUpdateStrategyResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.FleetUpdateStrategiesClient
+
+			id, err := fleetupdatestrategies.ParseUpdateStrategyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -50,6 +50,11 @@ func (r Registration) DataSources() []sdk.DataSource {
 
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
+		ContainerRegistryConnectedRegistryResource{},
 		ContainerRegistryTaskResource{},
+		KubernetesFleetManagerResource{},
+		KubernetesFleetMemberResource{},
+		KubernetesFleetUpdateRunResource{},
+		KubernetesFleetUpdateStrategyResource{},
 	}
 }
@@ -0,0 +1,77 @@
+package fleetmembers
+
+import "strings"
+
+type CreatedByType string
+
+const (
+	CreatedByTypeApplication     CreatedByType = "Application"
+	CreatedByTypeKey             CreatedByType = "Key"
+	CreatedByTypeManagedIdentity CreatedByType = "ManagedIdentity"
+	CreatedByTypeUser            CreatedByType = "User"
+)
+
+func PossibleValuesForCreatedByType() []string {
+	return []string{
+		string(CreatedByTypeApplication),
+		string(CreatedByTypeKey),
+		string(CreatedByTypeManagedIdentity),
+		string(CreatedByTypeUser),
+	}
+}
+
+func parseCreatedByType(input string) (*CreatedByType, error) {
+	vals := map[string]CreatedByType{
+		"application":     CreatedByTypeApplication,
+		"key":             CreatedByTypeKey,
+		"managedidentity": CreatedByTypeManagedIdentity,
+		"user":            CreatedByTypeUser,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := CreatedByType(input)
+	return &out, nil
+}
+
+type FleetMemberProvisioningState string
+
+const (
+	FleetMemberProvisioningStateCanceled  FleetMemberProvisioningState = "Canceled"
+	FleetMemberProvisioningStateFailed    FleetMemberProvisioningState = "Failed"
+	FleetMemberProvisioningStateJoining   FleetMemberProvisioningState = "Joining"
+	FleetMemberProvisioningStateLeaving   FleetMemberProvisioningState = "Leaving"
+	FleetMemberProvisioningStateSucceeded FleetMemberProvisioningState = "Succeeded"
+	FleetMemberProvisioningStateUpdating  FleetMemberProvisioningState = "Updating"
+)
+
+func PossibleValuesForFleetMemberProvisioningState() []string {
+	return []string{
+		string(FleetMemberProvisioningStateCanceled),
+		string(FleetMemberProvisioningStateFailed),
+		string(FleetMemberProvisioningStateJoining),
+		string(FleetMemberProvisioningStateLeaving),
+		string(FleetMemberProvisioningStateSucceeded),
+		string(FleetMemberProvisioningStateUpdating),
+	}
+}
+
+func parseFleetMemberProvisioningState(input string) (*FleetMemberProvisioningState, error) {
+	vals := map[string]FleetMemberProvisioningState{
+		"canceled":  FleetMemberProvisioningStateCanceled,
+		"failed":    FleetMemberProvisioningStateFailed,
+		"joining":   FleetMemberProvisioningStateJoining,
+		"leaving":   FleetMemberProvisioningStateLeaving,
+		"succeeded": FleetMemberProvisioningStateSucceeded,
+		"updating":  FleetMemberProvisioningStateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := FleetMemberProvisioningState(input)
+	return &out, nil
+}
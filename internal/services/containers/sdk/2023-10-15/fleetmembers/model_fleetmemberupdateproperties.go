@@ -0,0 +1,5 @@
+package fleetmembers
+
+type FleetMemberUpdateProperties struct {
+	Group *string `json:"group,omitempty"`
+}
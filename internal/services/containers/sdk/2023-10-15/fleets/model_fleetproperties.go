@@ -0,0 +1,6 @@
+package fleets
+
+type FleetProperties struct {
+	HubProfile        *FleetHubProfile        `json:"hubProfile,omitempty"`
+	ProvisioningState *FleetProvisioningState `json:"provisioningState,omitempty"`
+}
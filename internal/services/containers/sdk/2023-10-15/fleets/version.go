@@ -0,0 +1,9 @@
+package fleets
+
+import "fmt"
+
+const defaultApiVersion = "2023-10-15"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/fleets/%s", defaultApiVersion)
+}
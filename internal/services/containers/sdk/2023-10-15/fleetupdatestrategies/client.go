@@ -0,0 +1,15 @@
+package fleetupdatestrategies
+
+import "github.com/Azure/go-autorest/autorest"
+
+type FleetUpdateStrategiesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewFleetUpdateStrategiesClientWithBaseURI(endpoint string) FleetUpdateStrategiesClient {
+	return FleetUpdateStrategiesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
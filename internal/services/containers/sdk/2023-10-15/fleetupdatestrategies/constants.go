@@ -0,0 +1,68 @@
+package fleetupdatestrategies
+
+import "strings"
+
+type CreatedByType string
+
+const (
+	CreatedByTypeApplication     CreatedByType = "Application"
+	CreatedByTypeKey             CreatedByType = "Key"
+	CreatedByTypeManagedIdentity CreatedByType = "ManagedIdentity"
+	CreatedByTypeUser            CreatedByType = "User"
+)
+
+func PossibleValuesForCreatedByType() []string {
+	return []string{
+		string(CreatedByTypeApplication),
+		string(CreatedByTypeKey),
+		string(CreatedByTypeManagedIdentity),
+		string(CreatedByTypeUser),
+	}
+}
+
+func parseCreatedByType(input string) (*CreatedByType, error) {
+	vals := map[string]CreatedByType{
+		"application":     CreatedByTypeApplication,
+		"key":             CreatedByTypeKey,
+		"managedidentity": CreatedByTypeManagedIdentity,
+		"user":            CreatedByTypeUser,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := CreatedByType(input)
+	return &out, nil
+}
+
+type FleetUpdateStrategyProvisioningState string
+
+const (
+	FleetUpdateStrategyProvisioningStateCanceled  FleetUpdateStrategyProvisioningState = "Canceled"
+	FleetUpdateStrategyProvisioningStateFailed    FleetUpdateStrategyProvisioningState = "Failed"
+	FleetUpdateStrategyProvisioningStateSucceeded FleetUpdateStrategyProvisioningState = "Succeeded"
+)
+
+func PossibleValuesForFleetUpdateStrategyProvisioningState() []string {
+	return []string{
+		string(FleetUpdateStrategyProvisioningStateCanceled),
+		string(FleetUpdateStrategyProvisioningStateFailed),
+		string(FleetUpdateStrategyProvisioningStateSucceeded),
+	}
+}
+
+func parseFleetUpdateStrategyProvisioningState(input string) (*FleetUpdateStrategyProvisioningState, error) {
+	vals := map[string]FleetUpdateStrategyProvisioningState{
+		"canceled":  FleetUpdateStrategyProvisioningStateCanceled,
+		"failed":    FleetUpdateStrategyProvisioningStateFailed,
+		"succeeded": FleetUpdateStrategyProvisioningStateSucceeded,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := FleetUpdateStrategyProvisioningState(input)
+	return &out, nil
+}
@@ -0,0 +1,6 @@
+package fleetupdatestrategies
+
+type FleetUpdateStrategyProperties struct {
+	ProvisioningState *FleetUpdateStrategyProvisioningState `json:"provisioningState,omitempty"`
+	Strategy          UpdateRunStrategy                     `json:"strategy"`
+}
@@ -0,0 +1,15 @@
+package updateruns
+
+import "github.com/Azure/go-autorest/autorest"
+
+type UpdateRunsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewUpdateRunsClientWithBaseURI(endpoint string) UpdateRunsClient {
+	return UpdateRunsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,6 @@
+package updateruns
+
+type ManagedClusterUpgradeSpec struct {
+	KubernetesVersion *string                   `json:"kubernetesVersion,omitempty"`
+	Type              ManagedClusterUpgradeType `json:"type"`
+}
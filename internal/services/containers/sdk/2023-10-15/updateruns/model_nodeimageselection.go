@@ -0,0 +1,5 @@
+package updateruns
+
+type NodeImageSelection struct {
+	Type NodeImageSelectionType `json:"type"`
+}
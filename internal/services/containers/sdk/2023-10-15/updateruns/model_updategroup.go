@@ -0,0 +1,5 @@
+package updateruns
+
+type UpdateGroup struct {
+	Name string `json:"name"`
+}
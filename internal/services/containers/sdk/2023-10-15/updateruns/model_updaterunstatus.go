@@ -0,0 +1,5 @@
+package updateruns
+
+type UpdateRunStatus struct {
+	Status *UpdateStatus `json:"status,omitempty"`
+}
@@ -0,0 +1,5 @@
+package updateruns
+
+type UpdateRunStrategy struct {
+	Stages []UpdateStage `json:"stages"`
+}
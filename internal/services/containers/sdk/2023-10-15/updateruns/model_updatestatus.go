@@ -0,0 +1,7 @@
+package updateruns
+
+type UpdateStatus struct {
+	CompletedTime *string      `json:"completedTime,omitempty"`
+	StartTime     *string      `json:"startTime,omitempty"`
+	State         *UpdateState `json:"state,omitempty"`
+}
@@ -0,0 +1,9 @@
+package updateruns
+
+import "fmt"
+
+const defaultApiVersion = "2023-10-15"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/updateruns/%s", defaultApiVersion)
+}
@@ -0,0 +1,30 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// KubernetesFleetName validates the name of a Kubernetes Fleet Manager or a Kubernetes Fleet Member
+func KubernetesFleetName(v interface{}, k string) (warnings []string, errors []error) {
+	return validateKubernetesFleetName(v, k, 63)
+}
+
+// KubernetesFleetUpdateName validates the name of a Kubernetes Fleet Update Strategy or a Kubernetes Fleet Update Run
+func KubernetesFleetUpdateName(v interface{}, k string) (warnings []string, errors []error) {
+	return validateKubernetesFleetName(v, k, 50)
+}
+
+func validateKubernetesFleetName(v interface{}, k string, maxLength int) (warnings []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", k))
+		return
+	}
+
+	if len(value) > maxLength || !regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must be between 1 and %d characters, may only contain lowercase alphanumeric characters and hyphens, and must start and end with an alphanumeric character", k, maxLength))
+	}
+
+	return
+}
@@ -0,0 +1,86 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestKubernetesFleetName(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "",
+			ErrCount: 1,
+		},
+		{
+			Value:    "a",
+			ErrCount: 0,
+		},
+		{
+			Value:    "example-fleet-1",
+			ErrCount: 0,
+		},
+		{
+			Value:    "Example",
+			ErrCount: 1,
+		},
+		{
+			Value:    "-fleet",
+			ErrCount: 1,
+		},
+		{
+			Value:    "fleet-",
+			ErrCount: 1,
+		},
+		{
+			Value:    "fleet_1",
+			ErrCount: 1,
+		},
+		{
+			Value:    strings.Repeat("a", 63),
+			ErrCount: 0,
+		},
+		{
+			Value:    strings.Repeat("a", 64),
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := KubernetesFleetName(tc.Value, "name")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Kubernetes Fleet Name %q to trigger %d validation errors but got %d", tc.Value, tc.ErrCount, len(errors))
+		}
+	}
+}
+
+func TestKubernetesFleetUpdateName(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "example-stage-1",
+			ErrCount: 0,
+		},
+		{
+			Value:    strings.Repeat("a", 50),
+			ErrCount: 0,
+		},
+		{
+			Value:    strings.Repeat("a", 51),
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := KubernetesFleetUpdateName(tc.Value, "name")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Kubernetes Fleet Update Name %q to trigger %d validation errors but got %d", tc.Value, tc.ErrCount, len(errors))
+		}
+	}
+}
@@ -0,0 +1,25 @@
+package client
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/defendereasm/sdk/2023-04-01-preview/labels"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/defendereasm/sdk/2023-04-01-preview/workspaces"
+)
+
+type Client struct {
+	LabelsClient     *labels.LabelsClient
+	WorkspacesClient *workspaces.WorkspacesClient
+}
+
+func NewClient(o *common.ClientOptions) *Client {
+	labelsClient := labels.NewLabelsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&labelsClient.Client, o.ResourceManagerAuthorizer)
+
+	workspacesClient := workspaces.NewWorkspacesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&workspacesClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		LabelsClient:     &labelsClient,
+		WorkspacesClient: &workspacesClient,
+	}
+}
@@ -0,0 +1,211 @@
+package defendereasm
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/defendereasm/sdk/2023-04-01-preview/labels"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.ResourceWithUpdate = WorkspaceLabelResource{}
+
+type WorkspaceLabelResource struct{}
+
+type WorkspaceLabelResourceModel struct {
+	Name        string `tfschema:"name"`
+	WorkspaceId string `tfschema:"workspace_id"`
+	Color       string `tfschema:"color"`
+	DisplayName string `tfschema:"display_name"`
+}
+
+func (r WorkspaceLabelResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: labels.ValidateWorkspaceID,
+		},
+
+		"color": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"display_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r WorkspaceLabelResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r WorkspaceLabelResource) ModelObject() interface{} {
+	return &WorkspaceLabelResourceModel{}
+}
+
+func (r WorkspaceLabelResource) ResourceType() string {
+	return "azurerm_defender_easm_workspace_label"
+}
+
+func (r WorkspaceLabelResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return labels.ValidateLabelID
+}
+
+func (r WorkspaceLabelResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DefenderEASM.LabelsClient
+
+			var model WorkspaceLabelResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			workspaceId, err := labels.ParseWorkspaceID(model.WorkspaceId)
+			if err != nil {
+				return err
+			}
+
+			id := labels.NewLabelID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.WorkspaceName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := labels.LabelResource{
+				Properties: &labels.LabelResourceProperties{},
+			}
+
+			if model.Color != "" {
+				payload.Properties.Color = utils.String(model.Color)
+			}
+
+			if model.DisplayName != "" {
+				payload.Properties.DisplayName = utils.String(model.DisplayName)
+			}
+
+			if err := client.CreateAndUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r WorkspaceLabelResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DefenderEASM.LabelsClient
+
+			id, err := labels.ParseLabelID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := WorkspaceLabelResourceModel{
+				Name:        id.LabelName,
+				WorkspaceId: labels.NewWorkspaceID(id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.Color = utils.NormalizeNilableString(props.Color)
+					state.DisplayName = utils.NormalizeNilableString(props.DisplayName)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r WorkspaceLabelResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DefenderEASM.LabelsClient
+
+			id, err := labels.ParseLabelID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model WorkspaceLabelResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			payload := labels.LabelPatchResource{
+				Properties: &labels.LabelResourceProperties{},
+			}
+
+			if metadata.ResourceData.HasChange("color") {
+				payload.Properties.Color = utils.String(model.Color)
+			}
+
+			if metadata.ResourceData.HasChange("display_name") {
+				payload.Properties.DisplayName = utils.String(model.DisplayName)
+			}
+
+			if _, err := client.Update(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r WorkspaceLabelResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DefenderEASM.LabelsClient
+
+			id, err := labels.ParseLabelID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,123 @@
+package defendereasm_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/defendereasm/sdk/2023-04-01-preview/labels"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type WorkspaceLabelResource struct{}
+
+func TestAccDefenderEASMWorkspaceLabel_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_defender_easm_workspace_label", "test")
+	r := WorkspaceLabelResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDefenderEASMWorkspaceLabel_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_defender_easm_workspace_label", "test")
+	r := WorkspaceLabelResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccDefenderEASMWorkspaceLabel_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_defender_easm_workspace_label", "test")
+	r := WorkspaceLabelResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r WorkspaceLabelResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := labels.ParseLabelID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.DefenderEASM.LabelsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r WorkspaceLabelResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_defender_easm_workspace_label" "test" {
+  name         = "acctest-label-%d"
+  workspace_id = azurerm_defender_easm_workspace.test.id
+  display_name = "Acceptance Test"
+}
+`, WorkspaceResource{}.basic(data), data.RandomInteger)
+}
+
+func (r WorkspaceLabelResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_defender_easm_workspace_label" "import" {
+  name         = azurerm_defender_easm_workspace_label.test.name
+  workspace_id = azurerm_defender_easm_workspace_label.test.workspace_id
+  display_name = azurerm_defender_easm_workspace_label.test.display_name
+}
+`, r.basic(data))
+}
+
+func (r WorkspaceLabelResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_defender_easm_workspace_label" "test" {
+  name         = "acctest-label-%d"
+  workspace_id = azurerm_defender_easm_workspace.test.id
+  display_name = "Acceptance Test Updated"
+  color        = "blue"
+}
+`, WorkspaceResource{}.basic(data), data.RandomInteger)
+}
@@ -0,0 +1,30 @@
+package defendereasm
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+var _ sdk.TypedServiceRegistration = Registration{}
+
+type Registration struct{}
+
+func (r Registration) Name() string {
+	return "Defender EASM"
+}
+
+func (r Registration) WebsiteCategories() []string {
+	return []string{
+		"Defender EASM",
+	}
+}
+
+func (r Registration) DataSources() []sdk.DataSource {
+	return []sdk.DataSource{}
+}
+
+func (r Registration) Resources() []sdk.Resource {
+	return []sdk.Resource{
+		WorkspaceResource{},
+		WorkspaceLabelResource{},
+	}
+}
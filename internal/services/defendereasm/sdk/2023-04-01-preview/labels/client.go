@@ -0,0 +1,15 @@
+package labels
+
+import "github.com/Azure/go-autorest/autorest"
+
+type LabelsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewLabelsClientWithBaseURI(endpoint string) LabelsClient {
+	return LabelsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,83 @@
+package labels
+
+import "strings"
+
+type CreatedByType string
+
+const (
+	CreatedByTypeApplication     CreatedByType = "Application"
+	CreatedByTypeKey             CreatedByType = "Key"
+	CreatedByTypeManagedIdentity CreatedByType = "ManagedIdentity"
+	CreatedByTypeUser            CreatedByType = "User"
+)
+
+func PossibleValuesForCreatedByType() []string {
+	return []string{
+		string(CreatedByTypeApplication),
+		string(CreatedByTypeKey),
+		string(CreatedByTypeManagedIdentity),
+		string(CreatedByTypeUser),
+	}
+}
+
+func parseCreatedByType(input string) (*CreatedByType, error) {
+	vals := map[string]CreatedByType{
+		"application":     CreatedByTypeApplication,
+		"key":             CreatedByTypeKey,
+		"managedidentity": CreatedByTypeManagedIdentity,
+		"user":            CreatedByTypeUser,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := CreatedByType(input)
+	return &out, nil
+}
+
+type ResourceState string
+
+const (
+	ResourceStateAccepted     ResourceState = "Accepted"
+	ResourceStateCanceled     ResourceState = "Canceled"
+	ResourceStateCreating     ResourceState = "Creating"
+	ResourceStateDeleting     ResourceState = "Deleting"
+	ResourceStateFailed       ResourceState = "Failed"
+	ResourceStateNotSpecified ResourceState = "NotSpecified"
+	ResourceStateSucceeded    ResourceState = "Succeeded"
+	ResourceStateUpdating     ResourceState = "Updating"
+)
+
+func PossibleValuesForResourceState() []string {
+	return []string{
+		string(ResourceStateAccepted),
+		string(ResourceStateCanceled),
+		string(ResourceStateCreating),
+		string(ResourceStateDeleting),
+		string(ResourceStateFailed),
+		string(ResourceStateNotSpecified),
+		string(ResourceStateSucceeded),
+		string(ResourceStateUpdating),
+	}
+}
+
+func parseResourceState(input string) (*ResourceState, error) {
+	vals := map[string]ResourceState{
+		"accepted":     ResourceStateAccepted,
+		"canceled":     ResourceStateCanceled,
+		"creating":     ResourceStateCreating,
+		"deleting":     ResourceStateDeleting,
+		"failed":       ResourceStateFailed,
+		"notspecified": ResourceStateNotSpecified,
+		"succeeded":    ResourceStateSucceeded,
+		"updating":     ResourceStateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ResourceState(input)
+	return &out, nil
+}
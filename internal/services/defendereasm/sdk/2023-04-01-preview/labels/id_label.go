@@ -0,0 +1,137 @@
+package labels
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = LabelId{}
+
+// LabelId is a struct representing the Resource ID for a Label
+type LabelId struct {
+	SubscriptionId    string
+	ResourceGroupName string
+	WorkspaceName     string
+	LabelName         string
+}
+
+// NewLabelID returns a new LabelId struct
+func NewLabelID(subscriptionId string, resourceGroupName string, workspaceName string, labelName string) LabelId {
+	return LabelId{
+		SubscriptionId:    subscriptionId,
+		ResourceGroupName: resourceGroupName,
+		WorkspaceName:     workspaceName,
+		LabelName:         labelName,
+	}
+}
+
+// ParseLabelID parses 'input' into a LabelId
+func ParseLabelID(input string) (*LabelId, error) {
+	parser := resourceids.NewParserFromResourceIdType(LabelId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := LabelId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.WorkspaceName, ok = parsed.Parsed["workspaceName"]; !ok {
+		return nil, fmt.Errorf("the segment 'workspaceName' was not found in the resource id %q", input)
+	}
+
+	if id.LabelName, ok = parsed.Parsed["labelName"]; !ok {
+		return nil, fmt.Errorf("the segment 'labelName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseLabelIDInsensitively parses 'input' case-insensitively into a LabelId
+// note: this method should only be used for API response data and not user input
+func ParseLabelIDInsensitively(input string) (*LabelId, error) {
+	parser := resourceids.NewParserFromResourceIdType(LabelId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := LabelId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.WorkspaceName, ok = parsed.Parsed["workspaceName"]; !ok {
+		return nil, fmt.Errorf("the segment 'workspaceName' was not found in the resource id %q", input)
+	}
+
+	if id.LabelName, ok = parsed.Parsed["labelName"]; !ok {
+		return nil, fmt.Errorf("the segment 'labelName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateLabelID checks that 'input' can be parsed as a Label ID
+func ValidateLabelID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseLabelID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Label ID
+func (id LabelId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Easm/workspaces/%s/labels/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName, id.LabelName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Label ID
+func (id LabelId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftEasm", "Microsoft.Easm", "Microsoft.Easm"),
+		resourceids.StaticSegment("staticWorkspaces", "workspaces", "workspaces"),
+		resourceids.UserSpecifiedSegment("workspaceName", "workspaceValue"),
+		resourceids.StaticSegment("staticLabels", "labels", "labels"),
+		resourceids.UserSpecifiedSegment("labelName", "labelValue"),
+	}
+}
+
+// String returns a human-readable description of this Label ID
+func (id LabelId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Workspace Name: %q", id.WorkspaceName),
+		fmt.Sprintf("Label Name: %q", id.LabelName),
+	}
+	return fmt.Sprintf("Label (%s)", strings.Join(components, "\n"))
+}
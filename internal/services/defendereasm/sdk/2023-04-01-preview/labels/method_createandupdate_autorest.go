@@ -0,0 +1,75 @@
+package labels
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+type CreateAndUpdateResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// CreateAndUpdate ...
+func (c LabelsClient) CreateAndUpdate(ctx context.Context, id LabelId, input LabelResource) (result CreateAndUpdateResponse, err error) {
+	req, err := c.preparerForCreateAndUpdate(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "labels.LabelsClient", "CreateAndUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForCreateAndUpdate(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "labels.LabelsClient", "CreateAndUpdate", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// CreateAndUpdateThenPoll performs CreateAndUpdate then polls until it's completed
+func (c LabelsClient) CreateAndUpdateThenPoll(ctx context.Context, id LabelId, input LabelResource) error {
+	result, err := c.CreateAndUpdate(ctx, id, input)
+	if err != nil {
+		return fmt.Errorf("performing CreateAndUpdate: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after CreateAndUpdate: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForCreateAndUpdate prepares the CreateAndUpdate request.
+func (c LabelsClient) preparerForCreateAndUpdate(ctx context.Context, id LabelId, input LabelResource) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForCreateAndUpdate sends the CreateAndUpdate request. The method will close the
+// http.Response Body if it receives an error.
+func (c LabelsClient) senderForCreateAndUpdate(ctx context.Context, req *http.Request) (future CreateAndUpdateResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
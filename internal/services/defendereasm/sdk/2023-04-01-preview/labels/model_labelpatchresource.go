@@ -0,0 +1,5 @@
+package labels
+
+type LabelPatchResource struct {
+	Properties *LabelResourceProperties `json:"properties,omitempty"`
+}
@@ -0,0 +1,9 @@
+package labels
+
+type LabelResource struct {
+	Id         *string                  `json:"id,omitempty"`
+	Name       *string                  `json:"name,omitempty"`
+	Properties *LabelResourceProperties `json:"properties,omitempty"`
+	SystemData *SystemData              `json:"systemData,omitempty"`
+	Type       *string                  `json:"type,omitempty"`
+}
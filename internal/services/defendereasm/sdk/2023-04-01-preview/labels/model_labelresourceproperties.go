@@ -0,0 +1,7 @@
+package labels
+
+type LabelResourceProperties struct {
+	Color             *string        `json:"color,omitempty"`
+	DisplayName       *string        `json:"displayName,omitempty"`
+	ProvisioningState *ResourceState `json:"provisioningState,omitempty"`
+}
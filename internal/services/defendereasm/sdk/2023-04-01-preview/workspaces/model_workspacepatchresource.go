@@ -0,0 +1,5 @@
+package workspaces
+
+type WorkspacePatchResource struct {
+	Tags *map[string]string `json:"tags,omitempty"`
+}
@@ -0,0 +1,6 @@
+package workspaces
+
+type WorkspaceResourceProperties struct {
+	DataPlaneEndpoint *string        `json:"dataPlaneEndpoint,omitempty"`
+	ProvisioningState *ResourceState `json:"provisioningState,omitempty"`
+}
@@ -199,6 +199,16 @@ func TestApplicationGroupIDInsensitively(t *testing.T) {
 				Name:           "applicationGroup1",
 			},
 		},
+
+		{
+			// upper-cased root segment names
+			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/Microsoft.DesktopVirtualization/applicationGroups/applicationGroup1",
+			Expected: &ApplicationGroupId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "resGroup1",
+				Name:           "applicationGroup1",
+			},
+		},
 	}
 
 	for _, v := range testData {
@@ -231,6 +231,17 @@ func TestApplicationIDInsensitively(t *testing.T) {
 				Name:                 "application1",
 			},
 		},
+
+		{
+			// upper-cased root segment names
+			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/Microsoft.DesktopVirtualization/applicationGroups/applicationGroup1/applications/application1",
+			Expected: &ApplicationId{
+				SubscriptionId:       "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:        "resGroup1",
+				ApplicationGroupName: "applicationGroup1",
+				Name:                 "application1",
+			},
+		},
 	}
 
 	for _, v := range testData {
@@ -199,6 +199,16 @@ func TestHostPoolIDInsensitively(t *testing.T) {
 				Name:           "pool1",
 			},
 		},
+
+		{
+			// upper-cased root segment names
+			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/Microsoft.DesktopVirtualization/hostPools/pool1",
+			Expected: &HostPoolId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "resGroup1",
+				Name:           "pool1",
+			},
+		},
 	}
 
 	for _, v := range testData {
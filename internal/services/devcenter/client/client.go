@@ -0,0 +1,31 @@
+package client
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devcenter/sdk/2023-04-01/devboxdefinitions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devcenter/sdk/2023-04-01/networkconnections"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devcenter/sdk/2023-04-01/pools"
+)
+
+type Client struct {
+	DevBoxDefinitionsClient  *devboxdefinitions.DevBoxDefinitionsClient
+	NetworkConnectionsClient *networkconnections.NetworkConnectionsClient
+	PoolsClient              *pools.PoolsClient
+}
+
+func NewClient(o *common.ClientOptions) *Client {
+	devBoxDefinitionsClient := devboxdefinitions.NewDevBoxDefinitionsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&devBoxDefinitionsClient.Client, o.ResourceManagerAuthorizer)
+
+	networkConnectionsClient := networkconnections.NewNetworkConnectionsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&networkConnectionsClient.Client, o.ResourceManagerAuthorizer)
+
+	poolsClient := pools.NewPoolsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&poolsClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		DevBoxDefinitionsClient:  &devBoxDefinitionsClient,
+		NetworkConnectionsClient: &networkConnectionsClient,
+		PoolsClient:              &poolsClient,
+	}
+}
@@ -0,0 +1,248 @@
+package devcenter
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devcenter/sdk/2023-04-01/devboxdefinitions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devcenter/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.ResourceWithUpdate = DevBoxDefinitionResource{}
+
+type DevBoxDefinitionResource struct{}
+
+type DevBoxDefinitionResourceModel struct {
+	Name                    string            `tfschema:"name"`
+	DevCenterId             string            `tfschema:"dev_center_id"`
+	Location                string            `tfschema:"location"`
+	ImageReferenceId        string            `tfschema:"image_reference_id"`
+	SkuName                 string            `tfschema:"sku_name"`
+	HibernateSupportEnabled bool              `tfschema:"hibernate_support_enabled"`
+	ImageExactVersion       string            `tfschema:"image_exact_version"`
+	Tags                    map[string]string `tfschema:"tags"`
+}
+
+func (r DevBoxDefinitionResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.DevCenterResourceName,
+		},
+
+		"dev_center_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: devboxdefinitions.ValidateDevCenterID,
+		},
+
+		"location": location.Schema(),
+
+		"image_reference_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: azure.ValidateResourceID,
+		},
+
+		"sku_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"hibernate_support_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r DevBoxDefinitionResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"image_exact_version": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r DevBoxDefinitionResource) ModelObject() interface{} {
+	return &DevBoxDefinitionResourceModel{}
+}
+
+func (r DevBoxDefinitionResource) ResourceType() string {
+	return "azurerm_dev_box_definition"
+}
+
+func (r DevBoxDefinitionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return devboxdefinitions.ValidateDevCenterDevBoxDefinitionID
+}
+
+func (r DevBoxDefinitionResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DevCenter.DevBoxDefinitionsClient
+
+			var model DevBoxDefinitionResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			devCenterId, err := devboxdefinitions.ParseDevCenterID(model.DevCenterId)
+			if err != nil {
+				return err
+			}
+
+			id := devboxdefinitions.NewDevCenterDevBoxDefinitionID(devCenterId.SubscriptionId, devCenterId.ResourceGroupName, devCenterId.DevCenterName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, expandDevBoxDefinition(model)); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r DevBoxDefinitionResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DevCenter.DevBoxDefinitionsClient
+
+			id, err := devboxdefinitions.ParseDevCenterDevBoxDefinitionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := DevBoxDefinitionResourceModel{
+				Name:        id.DevBoxDefinitionName,
+				DevCenterId: devboxdefinitions.NewDevCenterID(id.SubscriptionId, id.ResourceGroupName, id.DevCenterName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+
+				if props := model.Properties; props != nil {
+					if props.HibernateSupport != nil {
+						state.HibernateSupportEnabled = *props.HibernateSupport == devboxdefinitions.HibernateSupportEnabled
+					}
+
+					if image := props.ImageReference; image != nil {
+						state.ImageReferenceId = utils.NormalizeNilableString(image.Id)
+						state.ImageExactVersion = utils.NormalizeNilableString(image.ExactVersion)
+					}
+
+					if sku := props.Sku; sku != nil {
+						state.SkuName = sku.Name
+					}
+				}
+
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r DevBoxDefinitionResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DevCenter.DevBoxDefinitionsClient
+
+			id, err := devboxdefinitions.ParseDevCenterDevBoxDefinitionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model DevBoxDefinitionResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, expandDevBoxDefinition(model)); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r DevBoxDefinitionResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DevCenter.DevBoxDefinitionsClient
+
+			id, err := devboxdefinitions.ParseDevCenterDevBoxDefinitionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandDevBoxDefinition(input DevBoxDefinitionResourceModel) devboxdefinitions.DevBoxDefinition {
+	hibernateSupport := devboxdefinitions.HibernateSupportDisabled
+	if input.HibernateSupportEnabled {
+		hibernateSupport = devboxdefinitions.HibernateSupportEnabled
+	}
+
+	return devboxdefinitions.DevBoxDefinition{
+		Location: location.Normalize(input.Location),
+		Properties: &devboxdefinitions.DevBoxDefinitionProperties{
+			HibernateSupport: &hibernateSupport,
+			ImageReference: &devboxdefinitions.ImageReference{
+				Id: utils.String(input.ImageReferenceId),
+			},
+			Sku: &devboxdefinitions.Sku{
+				Name: input.SkuName,
+			},
+		},
+		Tags: &input.Tags,
+	}
+}
@@ -0,0 +1,158 @@
+package devcenter_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devcenter/sdk/2023-04-01/devboxdefinitions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type DevBoxDefinitionResource struct{}
+
+// Dev Box Definitions are created within an existing Dev Center, which isn't currently supported by the provider -
+// as such these tests require the ID of a Dev Center to be specified.
+func preCheckDevBoxDefinition(t *testing.T) {
+	if os.Getenv("ARM_TEST_DEV_CENTER_ID") == "" {
+		t.Skip("Skipping as ARM_TEST_DEV_CENTER_ID is not specified")
+	}
+}
+
+func TestAccDevBoxDefinition_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dev_box_definition", "test")
+	r := DevBoxDefinitionResource{}
+	preCheckDevBoxDefinition(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("image_exact_version").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDevBoxDefinition_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dev_box_definition", "test")
+	r := DevBoxDefinitionResource{}
+	preCheckDevBoxDefinition(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccDevBoxDefinition_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dev_box_definition", "test")
+	r := DevBoxDefinitionResource{}
+	preCheckDevBoxDefinition(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("hibernate_support_enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r DevBoxDefinitionResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := devboxdefinitions.ParseDevCenterDevBoxDefinitionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.DevCenter.DevBoxDefinitionsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r DevBoxDefinitionResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_dev_box_definition" "test" {
+  name               = "acctest-dbd-%[1]d"
+  dev_center_id      = %[2]q
+  location           = %[3]q
+  image_reference_id = "%[2]s/galleries/default/images/microsoftwindowsdesktop_windows-ent-cpc_win11-22h2-ent-cpc-os"
+  sku_name           = "general_i_8c32gb256ssd_v2"
+}
+`, data.RandomInteger, os.Getenv("ARM_TEST_DEV_CENTER_ID"), data.Locations.Primary)
+}
+
+func (r DevBoxDefinitionResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dev_box_definition" "import" {
+  name               = azurerm_dev_box_definition.test.name
+  dev_center_id      = azurerm_dev_box_definition.test.dev_center_id
+  location           = azurerm_dev_box_definition.test.location
+  image_reference_id = azurerm_dev_box_definition.test.image_reference_id
+  sku_name           = azurerm_dev_box_definition.test.sku_name
+}
+`, r.basic(data))
+}
+
+func (r DevBoxDefinitionResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_dev_box_definition" "test" {
+  name                      = "acctest-dbd-%[1]d"
+  dev_center_id             = %[2]q
+  location                  = %[3]q
+  image_reference_id        = "%[2]s/galleries/default/images/microsoftwindowsdesktop_windows-ent-cpc_win11-22h2-ent-cpc-m365"
+  sku_name                  = "general_i_8c32gb512ssd_v2"
+  hibernate_support_enabled = true
+
+  tags = {
+    ENV = "Test"
+  }
+}
+`, data.RandomInteger, os.Getenv("ARM_TEST_DEV_CENTER_ID"), data.Locations.Primary)
+}
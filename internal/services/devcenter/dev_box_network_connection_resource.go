@@ -0,0 +1,290 @@
+package devcenter
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devcenter/sdk/2023-04-01/networkconnections"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devcenter/validate"
+	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.ResourceWithUpdate = DevBoxNetworkConnectionResource{}
+
+type DevBoxNetworkConnectionResource struct{}
+
+type DevBoxNetworkConnectionResourceModel struct {
+	Name              string            `tfschema:"name"`
+	ResourceGroupName string            `tfschema:"resource_group_name"`
+	Location          string            `tfschema:"location"`
+	DomainJoinType    string            `tfschema:"domain_join_type"`
+	SubnetId          string            `tfschema:"subnet_id"`
+	DomainName        string            `tfschema:"domain_name"`
+	DomainUsername    string            `tfschema:"domain_username"`
+	DomainPassword    string            `tfschema:"domain_password"`
+	OrganizationUnit  string            `tfschema:"organization_unit"`
+	HealthCheckStatus string            `tfschema:"health_check_status"`
+	Tags              map[string]string `tfschema:"tags"`
+}
+
+func (r DevBoxNetworkConnectionResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.DevCenterResourceName,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": location.Schema(),
+
+		"domain_join_type": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(networkconnections.PossibleValuesForDomainJoinType(), false),
+		},
+
+		"subnet_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: networkValidate.SubnetID,
+		},
+
+		"domain_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"domain_username": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"domain_password": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"organization_unit": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r DevBoxNetworkConnectionResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"health_check_status": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r DevBoxNetworkConnectionResource) ModelObject() interface{} {
+	return &DevBoxNetworkConnectionResourceModel{}
+}
+
+func (r DevBoxNetworkConnectionResource) ResourceType() string {
+	return "azurerm_dev_box_network_connection"
+}
+
+func (r DevBoxNetworkConnectionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return networkconnections.ValidateNetworkConnectionID
+}
+
+func (r DevBoxNetworkConnectionResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DevCenter.NetworkConnectionsClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model DevBoxNetworkConnectionResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := networkconnections.NewNetworkConnectionID(subscriptionId, model.ResourceGroupName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload, err := expandDevBoxNetworkConnection(model)
+			if err != nil {
+				return err
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, *payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r DevBoxNetworkConnectionResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DevCenter.NetworkConnectionsClient
+
+			id, err := networkconnections.ParseNetworkConnectionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := DevBoxNetworkConnectionResourceModel{
+				Name:              id.NetworkConnectionName,
+				ResourceGroupName: id.ResourceGroupName,
+				// the domain password isn't returned by the API
+				DomainPassword: metadata.ResourceData.Get("domain_password").(string),
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+
+				if props := model.Properties; props != nil {
+					state.DomainJoinType = string(props.DomainJoinType)
+					state.DomainName = utils.NormalizeNilableString(props.DomainName)
+					state.DomainUsername = utils.NormalizeNilableString(props.DomainUsername)
+					state.OrganizationUnit = utils.NormalizeNilableString(props.OrganizationUnit)
+
+					if props.SubnetId != nil {
+						state.SubnetId = *props.SubnetId
+					}
+
+					if props.HealthCheckStatus != nil {
+						state.HealthCheckStatus = string(*props.HealthCheckStatus)
+					}
+				}
+
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r DevBoxNetworkConnectionResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DevCenter.NetworkConnectionsClient
+
+			id, err := networkconnections.ParseNetworkConnectionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model DevBoxNetworkConnectionResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			payload, err := expandDevBoxNetworkConnection(model)
+			if err != nil {
+				return err
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, *payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r DevBoxNetworkConnectionResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DevCenter.NetworkConnectionsClient
+
+			id, err := networkconnections.ParseNetworkConnectionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandDevBoxNetworkConnection(input DevBoxNetworkConnectionResourceModel) (*networkconnections.NetworkConnection, error) {
+	props := networkconnections.NetworkProperties{
+		DomainJoinType: networkconnections.DomainJoinType(input.DomainJoinType),
+		SubnetId:       utils.String(input.SubnetId),
+	}
+
+	if props.DomainJoinType == networkconnections.DomainJoinTypeHybridAzureADJoin {
+		if input.DomainName == "" || input.DomainUsername == "" || input.DomainPassword == "" {
+			return nil, fmt.Errorf("`domain_name`, `domain_username` and `domain_password` must be specified when `domain_join_type` is `%s`", networkconnections.DomainJoinTypeHybridAzureADJoin)
+		}
+	} else if input.DomainName != "" || input.DomainUsername != "" || input.DomainPassword != "" || input.OrganizationUnit != "" {
+		return nil, fmt.Errorf("`domain_name`, `domain_username`, `domain_password` and `organization_unit` can only be specified when `domain_join_type` is `%s`", networkconnections.DomainJoinTypeHybridAzureADJoin)
+	}
+
+	if input.DomainName != "" {
+		props.DomainName = utils.String(input.DomainName)
+	}
+
+	if input.DomainUsername != "" {
+		props.DomainUsername = utils.String(input.DomainUsername)
+	}
+
+	if input.DomainPassword != "" {
+		props.DomainPassword = utils.String(input.DomainPassword)
+	}
+
+	if input.OrganizationUnit != "" {
+		props.OrganizationUnit = utils.String(input.OrganizationUnit)
+	}
+
+	return &networkconnections.NetworkConnection{
+		Location:   location.Normalize(input.Location),
+		Properties: &props,
+		Tags:       &input.Tags,
+	}, nil
+}
@@ -0,0 +1,256 @@
+package devcenter
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devcenter/sdk/2023-04-01/pools"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devcenter/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.ResourceWithUpdate = DevBoxPoolResource{}
+
+type DevBoxPoolResource struct{}
+
+type DevBoxPoolResourceModel struct {
+	Name                               string            `tfschema:"name"`
+	DevCenterProjectId                 string            `tfschema:"dev_center_project_id"`
+	Location                           string            `tfschema:"location"`
+	DevBoxDefinitionName               string            `tfschema:"dev_box_definition_name"`
+	NetworkConnectionName              string            `tfschema:"network_connection_name"`
+	LocalAdministratorEnabled          bool              `tfschema:"local_administrator_enabled"`
+	StopOnDisconnectGracePeriodMinutes int               `tfschema:"stop_on_disconnect_grace_period_minutes"`
+	Tags                               map[string]string `tfschema:"tags"`
+}
+
+func (r DevBoxPoolResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.DevCenterResourceName,
+		},
+
+		"dev_center_project_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: pools.ValidateProjectID,
+		},
+
+		"location": location.Schema(),
+
+		"dev_box_definition_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validate.DevCenterResourceName,
+		},
+
+		"network_connection_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"local_administrator_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Required: true,
+		},
+
+		"stop_on_disconnect_grace_period_minutes": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntBetween(60, 480),
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r DevBoxPoolResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r DevBoxPoolResource) ModelObject() interface{} {
+	return &DevBoxPoolResourceModel{}
+}
+
+func (r DevBoxPoolResource) ResourceType() string {
+	return "azurerm_dev_box_pool"
+}
+
+func (r DevBoxPoolResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return pools.ValidatePoolID
+}
+
+func (r DevBoxPoolResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DevCenter.PoolsClient
+
+			var model DevBoxPoolResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			projectId, err := pools.ParseProjectID(model.DevCenterProjectId)
+			if err != nil {
+				return err
+			}
+
+			id := pools.NewPoolID(projectId.SubscriptionId, projectId.ResourceGroupName, projectId.ProjectName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, expandDevBoxPool(model)); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r DevBoxPoolResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DevCenter.PoolsClient
+
+			id, err := pools.ParsePoolID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := DevBoxPoolResourceModel{
+				Name:               id.PoolName,
+				DevCenterProjectId: pools.NewProjectID(id.SubscriptionId, id.ResourceGroupName, id.ProjectName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+
+				if props := model.Properties; props != nil {
+					state.DevBoxDefinitionName = utils.NormalizeNilableString(props.DevBoxDefinitionName)
+					state.NetworkConnectionName = utils.NormalizeNilableString(props.NetworkConnectionName)
+
+					if props.LocalAdministrator != nil {
+						state.LocalAdministratorEnabled = *props.LocalAdministrator == pools.LocalAdminStatusEnabled
+					}
+
+					if stopOnDisconnect := props.StopOnDisconnect; stopOnDisconnect != nil && stopOnDisconnect.Status != nil && *stopOnDisconnect.Status == pools.StopOnDisconnectEnableStatusEnabled {
+						if stopOnDisconnect.GracePeriodMinutes != nil {
+							state.StopOnDisconnectGracePeriodMinutes = int(*stopOnDisconnect.GracePeriodMinutes)
+						}
+					}
+				}
+
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r DevBoxPoolResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DevCenter.PoolsClient
+
+			id, err := pools.ParsePoolID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model DevBoxPoolResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, expandDevBoxPool(model)); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r DevBoxPoolResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DevCenter.PoolsClient
+
+			id, err := pools.ParsePoolID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandDevBoxPool(input DevBoxPoolResourceModel) pools.Pool {
+	licenseType := pools.LicenseTypeWindowsClient
+
+	localAdministrator := pools.LocalAdminStatusDisabled
+	if input.LocalAdministratorEnabled {
+		localAdministrator = pools.LocalAdminStatusEnabled
+	}
+
+	stopOnDisconnectStatus := pools.StopOnDisconnectEnableStatusDisabled
+	stopOnDisconnect := pools.StopOnDisconnectConfiguration{
+		Status: &stopOnDisconnectStatus,
+	}
+	if input.StopOnDisconnectGracePeriodMinutes != 0 {
+		stopOnDisconnectStatus = pools.StopOnDisconnectEnableStatusEnabled
+		stopOnDisconnect.GracePeriodMinutes = utils.Int64(int64(input.StopOnDisconnectGracePeriodMinutes))
+	}
+
+	return pools.Pool{
+		Location: location.Normalize(input.Location),
+		Properties: &pools.PoolProperties{
+			DevBoxDefinitionName:  utils.String(input.DevBoxDefinitionName),
+			LicenseType:           &licenseType,
+			LocalAdministrator:    &localAdministrator,
+			NetworkConnectionName: utils.String(input.NetworkConnectionName),
+			StopOnDisconnect:      &stopOnDisconnect,
+		},
+		Tags: &input.Tags,
+	}
+}
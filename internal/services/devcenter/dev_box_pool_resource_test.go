@@ -0,0 +1,156 @@
+package devcenter_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devcenter/sdk/2023-04-01/pools"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type DevBoxPoolResource struct{}
+
+// Dev Box Pools are created within an existing Dev Center Project, using a Network Connection which has been attached
+// to the Dev Center - as such these tests require the Dev Center, Project and Attached Network to be specified.
+func preCheckDevBoxPool(t *testing.T) {
+	if os.Getenv("ARM_TEST_DEV_CENTER_ID") == "" || os.Getenv("ARM_TEST_DEV_CENTER_PROJECT_ID") == "" || os.Getenv("ARM_TEST_DEV_CENTER_ATTACHED_NETWORK_NAME") == "" {
+		t.Skip("Skipping as ARM_TEST_DEV_CENTER_ID, ARM_TEST_DEV_CENTER_PROJECT_ID and/or ARM_TEST_DEV_CENTER_ATTACHED_NETWORK_NAME are not specified")
+	}
+}
+
+func TestAccDevBoxPool_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dev_box_pool", "test")
+	r := DevBoxPoolResource{}
+	preCheckDevBoxPool(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDevBoxPool_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dev_box_pool", "test")
+	r := DevBoxPoolResource{}
+	preCheckDevBoxPool(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccDevBoxPool_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dev_box_pool", "test")
+	r := DevBoxPoolResource{}
+	preCheckDevBoxPool(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("stop_on_disconnect_grace_period_minutes").HasValue("60"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r DevBoxPoolResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := pools.ParsePoolID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.DevCenter.PoolsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r DevBoxPoolResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dev_box_pool" "test" {
+  name                        = "acctest-dbp-%d"
+  dev_center_project_id       = %q
+  location                    = azurerm_dev_box_definition.test.location
+  dev_box_definition_name     = azurerm_dev_box_definition.test.name
+  network_connection_name     = %q
+  local_administrator_enabled = false
+}
+`, DevBoxDefinitionResource{}.basic(data), data.RandomInteger, os.Getenv("ARM_TEST_DEV_CENTER_PROJECT_ID"), os.Getenv("ARM_TEST_DEV_CENTER_ATTACHED_NETWORK_NAME"))
+}
+
+func (r DevBoxPoolResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dev_box_pool" "import" {
+  name                        = azurerm_dev_box_pool.test.name
+  dev_center_project_id       = azurerm_dev_box_pool.test.dev_center_project_id
+  location                    = azurerm_dev_box_pool.test.location
+  dev_box_definition_name     = azurerm_dev_box_pool.test.dev_box_definition_name
+  network_connection_name     = azurerm_dev_box_pool.test.network_connection_name
+  local_administrator_enabled = azurerm_dev_box_pool.test.local_administrator_enabled
+}
+`, r.basic(data))
+}
+
+func (r DevBoxPoolResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dev_box_pool" "test" {
+  name                                    = "acctest-dbp-%d"
+  dev_center_project_id                   = %q
+  location                                = azurerm_dev_box_definition.test.location
+  dev_box_definition_name                 = azurerm_dev_box_definition.test.name
+  network_connection_name                 = %q
+  local_administrator_enabled             = true
+  stop_on_disconnect_grace_period_minutes = 60
+
+  tags = {
+    ENV = "Test"
+  }
+}
+`, DevBoxDefinitionResource{}.basic(data), data.RandomInteger, os.Getenv("ARM_TEST_DEV_CENTER_PROJECT_ID"), os.Getenv("ARM_TEST_DEV_CENTER_ATTACHED_NETWORK_NAME"))
+}
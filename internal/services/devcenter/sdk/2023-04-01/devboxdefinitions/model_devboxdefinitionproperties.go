@@ -0,0 +1,9 @@
+package devboxdefinitions
+
+type DevBoxDefinitionProperties struct {
+	HibernateSupport  *HibernateSupport  `json:"hibernateSupport,omitempty"`
+	ImageReference    *ImageReference    `json:"imageReference,omitempty"`
+	OsStorageType     *string            `json:"osStorageType,omitempty"`
+	ProvisioningState *ProvisioningState `json:"provisioningState,omitempty"`
+	Sku               *Sku               `json:"sku,omitempty"`
+}
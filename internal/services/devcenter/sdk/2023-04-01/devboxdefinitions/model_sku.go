@@ -0,0 +1,5 @@
+package devboxdefinitions
+
+type Sku struct {
+	Name string `json:"name"`
+}
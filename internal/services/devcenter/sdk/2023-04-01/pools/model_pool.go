@@ -0,0 +1,11 @@
+package pools
+
+type Pool struct {
+	Id         *string            `json:"id,omitempty"`
+	Location   string             `json:"location"`
+	Name       *string            `json:"name,omitempty"`
+	Properties *PoolProperties    `json:"properties,omitempty"`
+	SystemData *SystemData        `json:"systemData,omitempty"`
+	Tags       *map[string]string `json:"tags,omitempty"`
+	Type       *string            `json:"type,omitempty"`
+}
@@ -0,0 +1,6 @@
+package pools
+
+type StopOnDisconnectConfiguration struct {
+	GracePeriodMinutes *int64                        `json:"gracePeriodMinutes,omitempty"`
+	Status             *StopOnDisconnectEnableStatus `json:"status,omitempty"`
+}
@@ -0,0 +1,16 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// DevCenterResourceName validates the name of a Dev Box Definition, Network Connection or Pool
+func DevCenterResourceName(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+	if matched := regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9-_.]{2,62}$`).Match([]byte(value)); !matched {
+		errors = append(errors, fmt.Errorf("%q must be between 3 and 63 characters, may only contain alphanumeric characters, hyphens, underscores and periods, and must start with an alphanumeric character", k))
+	}
+
+	return warnings, errors
+}
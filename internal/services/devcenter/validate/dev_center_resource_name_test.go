@@ -0,0 +1,54 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDevCenterResourceName(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "",
+			ErrCount: 1,
+		},
+		{
+			Value:    "ab",
+			ErrCount: 1,
+		},
+		{
+			Value:    "abc",
+			ErrCount: 0,
+		},
+		{
+			Value:    "devbox-definition_1.0",
+			ErrCount: 0,
+		},
+		{
+			Value:    "-devbox",
+			ErrCount: 1,
+		},
+		{
+			Value:    "dev box",
+			ErrCount: 1,
+		},
+		{
+			Value:    strings.Repeat("a", 63),
+			ErrCount: 0,
+		},
+		{
+			Value:    strings.Repeat("a", 64),
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := DevCenterResourceName(tc.Value, "name")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Dev Center Resource Name %q to trigger %d validation errors but got %d", tc.Value, tc.ErrCount, len(errors))
+		}
+	}
+}
@@ -0,0 +1,25 @@
+package client
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/deviceregistry/sdk/2023-11-01-preview/assetendpointprofiles"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/deviceregistry/sdk/2023-11-01-preview/assets"
+)
+
+type Client struct {
+	AssetEndpointProfilesClient *assetendpointprofiles.AssetEndpointProfilesClient
+	AssetsClient                *assets.AssetsClient
+}
+
+func NewClient(o *common.ClientOptions) *Client {
+	assetEndpointProfilesClient := assetendpointprofiles.NewAssetEndpointProfilesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&assetEndpointProfilesClient.Client, o.ResourceManagerAuthorizer)
+
+	assetsClient := assets.NewAssetsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&assetsClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		AssetEndpointProfilesClient: &assetEndpointProfilesClient,
+		AssetsClient:                &assetsClient,
+	}
+}
@@ -0,0 +1,468 @@
+package deviceregistry
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/deviceregistry/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/deviceregistry/sdk/2023-11-01-preview/assetendpointprofiles"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/deviceregistry/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.ResourceWithUpdate = AssetEndpointProfileResource{}
+
+type AssetEndpointProfileResource struct{}
+
+type AssetEndpointProfileResourceModel struct {
+	Name                               string                                     `tfschema:"name"`
+	ResourceGroupName                  string                                     `tfschema:"resource_group_name"`
+	Location                           string                                     `tfschema:"location"`
+	CustomLocationId                   string                                     `tfschema:"custom_location_id"`
+	TargetAddress                      string                                     `tfschema:"target_address"`
+	AdditionalConfiguration            string                                     `tfschema:"additional_configuration"`
+	UserAuthentication                 []AssetEndpointProfileUserAuthentication   `tfschema:"user_authentication"`
+	TransportAuthenticationCertificate []AssetEndpointProfileTransportCertificate `tfschema:"transport_authentication_certificate"`
+	Uuid                               string                                     `tfschema:"uuid"`
+	Tags                               map[string]string                          `tfschema:"tags"`
+}
+
+type AssetEndpointProfileUserAuthentication struct {
+	Mode                 string `tfschema:"mode"`
+	UsernameReference    string `tfschema:"username_reference"`
+	PasswordReference    string `tfschema:"password_reference"`
+	CertificateReference string `tfschema:"certificate_reference"`
+}
+
+type AssetEndpointProfileTransportCertificate struct {
+	Thumbprint        string `tfschema:"thumbprint"`
+	SecretReference   string `tfschema:"secret_reference"`
+	PasswordReference string `tfschema:"password_reference"`
+}
+
+func (r AssetEndpointProfileResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validateDeviceRegistryName,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": location.Schema(),
+
+		"custom_location_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.CustomLocationID,
+		},
+
+		"target_address": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"additional_configuration": {
+			Type:             pluginsdk.TypeString,
+			Optional:         true,
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
+		},
+
+		"user_authentication": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"mode": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(assetendpointprofiles.PossibleValuesForUserAuthenticationMode(), false),
+					},
+
+					"username_reference": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"password_reference": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"certificate_reference": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"transport_authentication_certificate": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"thumbprint": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"secret_reference": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"password_reference": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r AssetEndpointProfileResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"uuid": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r AssetEndpointProfileResource) ModelObject() interface{} {
+	return &AssetEndpointProfileResourceModel{}
+}
+
+func (r AssetEndpointProfileResource) ResourceType() string {
+	return "azurerm_device_registry_asset_endpoint_profile"
+}
+
+func (r AssetEndpointProfileResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return assetendpointprofiles.ValidateAssetEndpointProfileID
+}
+
+func (r AssetEndpointProfileResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DeviceRegistry.AssetEndpointProfilesClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model AssetEndpointProfileResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := assetendpointprofiles.NewAssetEndpointProfileID(subscriptionId, model.ResourceGroupName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			userAuthentication, err := expandAssetEndpointProfileUserAuthentication(model.UserAuthentication)
+			if err != nil {
+				return err
+			}
+
+			payload := assetendpointprofiles.AssetEndpointProfile{
+				ExtendedLocation: assetendpointprofiles.ExtendedLocation{
+					Name: model.CustomLocationId,
+					Type: "CustomLocation",
+				},
+				Location: location.Normalize(model.Location),
+				Properties: &assetendpointprofiles.AssetEndpointProfileProperties{
+					TargetAddress:           model.TargetAddress,
+					TransportAuthentication: expandAssetEndpointProfileTransportAuthentication(model.TransportAuthenticationCertificate),
+					UserAuthentication:      userAuthentication,
+				},
+				Tags: &model.Tags,
+			}
+
+			if model.AdditionalConfiguration != "" {
+				payload.Properties.AdditionalConfiguration = utils.String(model.AdditionalConfiguration)
+			}
+
+			if err := client.CreateOrReplaceThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r AssetEndpointProfileResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DeviceRegistry.AssetEndpointProfilesClient
+
+			id, err := assetendpointprofiles.ParseAssetEndpointProfileID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := AssetEndpointProfileResourceModel{
+				Name:              id.AssetEndpointProfileName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+
+				customLocationId, err := parse.CustomLocationIDInsensitively(model.ExtendedLocation.Name)
+				if err != nil {
+					return err
+				}
+				state.CustomLocationId = customLocationId.ID()
+
+				if props := model.Properties; props != nil {
+					state.TargetAddress = props.TargetAddress
+					state.AdditionalConfiguration = utils.NormalizeNilableString(props.AdditionalConfiguration)
+					state.TransportAuthenticationCertificate = flattenAssetEndpointProfileTransportAuthentication(props.TransportAuthentication)
+					state.UserAuthentication = flattenAssetEndpointProfileUserAuthentication(props.UserAuthentication)
+					state.Uuid = utils.NormalizeNilableString(props.Uuid)
+				}
+
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r AssetEndpointProfileResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DeviceRegistry.AssetEndpointProfilesClient
+
+			id, err := assetendpointprofiles.ParseAssetEndpointProfileID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model AssetEndpointProfileResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			payload := assetendpointprofiles.AssetEndpointProfileUpdate{
+				Properties: &assetendpointprofiles.AssetEndpointProfileUpdateProperties{},
+			}
+
+			if metadata.ResourceData.HasChange("target_address") {
+				payload.Properties.TargetAddress = utils.String(model.TargetAddress)
+			}
+
+			if metadata.ResourceData.HasChange("additional_configuration") {
+				payload.Properties.AdditionalConfiguration = utils.String(model.AdditionalConfiguration)
+			}
+
+			if metadata.ResourceData.HasChange("user_authentication") {
+				userAuthentication, err := expandAssetEndpointProfileUserAuthentication(model.UserAuthentication)
+				if err != nil {
+					return err
+				}
+
+				// removing the `user_authentication` block falls back to anonymous authentication
+				if userAuthentication == nil {
+					userAuthentication = &assetendpointprofiles.UserAuthentication{
+						Mode: assetendpointprofiles.UserAuthenticationModeAnonymous,
+					}
+				}
+				payload.Properties.UserAuthentication = userAuthentication
+			}
+
+			if metadata.ResourceData.HasChange("transport_authentication_certificate") {
+				payload.Properties.TransportAuthentication = expandAssetEndpointProfileTransportAuthentication(model.TransportAuthenticationCertificate)
+				if payload.Properties.TransportAuthentication == nil {
+					payload.Properties.TransportAuthentication = &assetendpointprofiles.TransportAuthentication{
+						OwnCertificates: []assetendpointprofiles.OwnCertificate{},
+					}
+				}
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = &model.Tags
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r AssetEndpointProfileResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DeviceRegistry.AssetEndpointProfilesClient
+
+			id, err := assetendpointprofiles.ParseAssetEndpointProfileID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandAssetEndpointProfileUserAuthentication(input []AssetEndpointProfileUserAuthentication) (*assetendpointprofiles.UserAuthentication, error) {
+	if len(input) == 0 {
+		return nil, nil
+	}
+
+	v := input[0]
+	output := assetendpointprofiles.UserAuthentication{
+		Mode: assetendpointprofiles.UserAuthenticationMode(v.Mode),
+	}
+
+	switch output.Mode {
+	case assetendpointprofiles.UserAuthenticationModeUsernamePassword:
+		if v.UsernameReference == "" || v.PasswordReference == "" {
+			return nil, fmt.Errorf("`username_reference` and `password_reference` must be specified when `mode` is `%s`", output.Mode)
+		}
+		if v.CertificateReference != "" {
+			return nil, fmt.Errorf("`certificate_reference` cannot be specified when `mode` is `%s`", output.Mode)
+		}
+		output.UsernamePasswordCredentials = &assetendpointprofiles.UsernamePasswordCredentials{
+			PasswordReference: v.PasswordReference,
+			UsernameReference: v.UsernameReference,
+		}
+
+	case assetendpointprofiles.UserAuthenticationModeCertificate:
+		if v.CertificateReference == "" {
+			return nil, fmt.Errorf("`certificate_reference` must be specified when `mode` is `%s`", output.Mode)
+		}
+		if v.UsernameReference != "" || v.PasswordReference != "" {
+			return nil, fmt.Errorf("`username_reference` and `password_reference` cannot be specified when `mode` is `%s`", output.Mode)
+		}
+		output.X509Credentials = &assetendpointprofiles.X509Credentials{
+			CertificateReference: v.CertificateReference,
+		}
+
+	default:
+		if v.UsernameReference != "" || v.PasswordReference != "" || v.CertificateReference != "" {
+			return nil, fmt.Errorf("credential references cannot be specified when `mode` is `%s`", output.Mode)
+		}
+	}
+
+	return &output, nil
+}
+
+func flattenAssetEndpointProfileUserAuthentication(input *assetendpointprofiles.UserAuthentication) []AssetEndpointProfileUserAuthentication {
+	if input == nil {
+		return []AssetEndpointProfileUserAuthentication{}
+	}
+
+	output := AssetEndpointProfileUserAuthentication{
+		Mode: string(input.Mode),
+	}
+
+	if creds := input.UsernamePasswordCredentials; creds != nil {
+		output.PasswordReference = creds.PasswordReference
+		output.UsernameReference = creds.UsernameReference
+	}
+
+	if creds := input.X509Credentials; creds != nil {
+		output.CertificateReference = creds.CertificateReference
+	}
+
+	return []AssetEndpointProfileUserAuthentication{output}
+}
+
+func expandAssetEndpointProfileTransportAuthentication(input []AssetEndpointProfileTransportCertificate) *assetendpointprofiles.TransportAuthentication {
+	if len(input) == 0 {
+		return nil
+	}
+
+	certificates := make([]assetendpointprofiles.OwnCertificate, 0)
+	for _, v := range input {
+		certificate := assetendpointprofiles.OwnCertificate{
+			CertSecretReference: utils.String(v.SecretReference),
+			CertThumbprint:      utils.String(v.Thumbprint),
+		}
+
+		if v.PasswordReference != "" {
+			certificate.CertPasswordReference = utils.String(v.PasswordReference)
+		}
+
+		certificates = append(certificates, certificate)
+	}
+
+	return &assetendpointprofiles.TransportAuthentication{
+		OwnCertificates: certificates,
+	}
+}
+
+func flattenAssetEndpointProfileTransportAuthentication(input *assetendpointprofiles.TransportAuthentication) []AssetEndpointProfileTransportCertificate {
+	output := make([]AssetEndpointProfileTransportCertificate, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range input.OwnCertificates {
+		output = append(output, AssetEndpointProfileTransportCertificate{
+			PasswordReference: utils.NormalizeNilableString(v.CertPasswordReference),
+			SecretReference:   utils.NormalizeNilableString(v.CertSecretReference),
+			Thumbprint:        utils.NormalizeNilableString(v.CertThumbprint),
+		})
+	}
+
+	return output
+}
+
+func validateDeviceRegistryName(i interface{}, k string) ([]string, []error) {
+	return validation.StringMatch(
+		regexp.MustCompile(`^[a-z0-9][a-z0-9-]{1,61}[a-z0-9]$`),
+		fmt.Sprintf("%q must be between 3 and 63 characters long, start and end with a lowercase letter or number and can only contain lowercase letters, numbers and hyphens", k),
+	)(i, k)
+}
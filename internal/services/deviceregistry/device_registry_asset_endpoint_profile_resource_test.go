@@ -0,0 +1,173 @@
+package deviceregistry_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/deviceregistry/sdk/2023-11-01-preview/assetendpointprofiles"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type AssetEndpointProfileResource struct{}
+
+// Device Registry resources are deployed to a Custom Location on an Arc-enabled Kubernetes Cluster running
+// Azure IoT Operations, which must exist in the Primary test location - as such these tests require an existing
+// Custom Location.
+func preCheckDeviceRegistry(t *testing.T) {
+	if os.Getenv("ARM_TEST_CUSTOM_LOCATION_ID") == "" {
+		t.Skip("Skipping as ARM_TEST_CUSTOM_LOCATION_ID is not specified")
+	}
+}
+
+func TestAccDeviceRegistryAssetEndpointProfile_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_device_registry_asset_endpoint_profile", "test")
+	r := AssetEndpointProfileResource{}
+	preCheckDeviceRegistry(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("uuid").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDeviceRegistryAssetEndpointProfile_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_device_registry_asset_endpoint_profile", "test")
+	r := AssetEndpointProfileResource{}
+	preCheckDeviceRegistry(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccDeviceRegistryAssetEndpointProfile_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_device_registry_asset_endpoint_profile", "test")
+	r := AssetEndpointProfileResource{}
+	preCheckDeviceRegistry(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r AssetEndpointProfileResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := assetendpointprofiles.ParseAssetEndpointProfileID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.DeviceRegistry.AssetEndpointProfilesClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r AssetEndpointProfileResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_device_registry_asset_endpoint_profile" "test" {
+  name                = "acctest-aep-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  custom_location_id  = %q
+  target_address      = "opc.tcp://opcplc-000000:50000"
+}
+`, r.template(data), data.RandomInteger, os.Getenv("ARM_TEST_CUSTOM_LOCATION_ID"))
+}
+
+func (r AssetEndpointProfileResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_device_registry_asset_endpoint_profile" "import" {
+  name                = azurerm_device_registry_asset_endpoint_profile.test.name
+  resource_group_name = azurerm_device_registry_asset_endpoint_profile.test.resource_group_name
+  location            = azurerm_device_registry_asset_endpoint_profile.test.location
+  custom_location_id  = azurerm_device_registry_asset_endpoint_profile.test.custom_location_id
+  target_address      = azurerm_device_registry_asset_endpoint_profile.test.target_address
+}
+`, r.basic(data))
+}
+
+func (r AssetEndpointProfileResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_device_registry_asset_endpoint_profile" "test" {
+  name                     = "acctest-aep-%d"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  custom_location_id       = %q
+  target_address           = "opc.tcp://opcplc-000000:50001"
+  additional_configuration = jsonencode({ "defaults" : { "publishingIntervalMilliseconds" : 1000 } })
+
+  user_authentication {
+    mode               = "UsernamePassword"
+    username_reference = "aio-opc-ua-broker-user-authentication-settings/username"
+    password_reference = "aio-opc-ua-broker-user-authentication-settings/password"
+  }
+
+  tags = {
+    environment = "test"
+  }
+}
+`, r.template(data), data.RandomInteger, os.Getenv("ARM_TEST_CUSTOM_LOCATION_ID"))
+}
+
+func (r AssetEndpointProfileResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-deviceregistry-%d"
+  location = %q
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
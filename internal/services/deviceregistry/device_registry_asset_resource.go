@@ -0,0 +1,674 @@
+package deviceregistry
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/deviceregistry/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/deviceregistry/sdk/2023-11-01-preview/assets"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/deviceregistry/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.ResourceWithUpdate = AssetResource{}
+
+type AssetResource struct{}
+
+type AssetResourceModel struct {
+	Name                           string            `tfschema:"name"`
+	ResourceGroupName              string            `tfschema:"resource_group_name"`
+	Location                       string            `tfschema:"location"`
+	CustomLocationId               string            `tfschema:"custom_location_id"`
+	AssetEndpointProfileName       string            `tfschema:"asset_endpoint_profile_name"`
+	Enabled                        bool              `tfschema:"enabled"`
+	ExternalAssetId                string            `tfschema:"external_asset_id"`
+	DisplayName                    string            `tfschema:"display_name"`
+	Description                    string            `tfschema:"description"`
+	Manufacturer                   string            `tfschema:"manufacturer"`
+	ManufacturerUri                string            `tfschema:"manufacturer_uri"`
+	Model                          string            `tfschema:"model"`
+	ProductCode                    string            `tfschema:"product_code"`
+	HardwareRevision               string            `tfschema:"hardware_revision"`
+	SoftwareRevision               string            `tfschema:"software_revision"`
+	DocumentationUri               string            `tfschema:"documentation_uri"`
+	SerialNumber                   string            `tfschema:"serial_number"`
+	Attributes                     map[string]string `tfschema:"attributes"`
+	DefaultDataPointsConfiguration string            `tfschema:"default_data_points_configuration"`
+	DefaultEventsConfiguration     string            `tfschema:"default_events_configuration"`
+	DataPoint                      []AssetDataPoint  `tfschema:"data_point"`
+	Event                          []AssetEvent      `tfschema:"event"`
+	Uuid                           string            `tfschema:"uuid"`
+	Version                        int64             `tfschema:"version"`
+	Tags                           map[string]string `tfschema:"tags"`
+}
+
+type AssetDataPoint struct {
+	Name              string `tfschema:"name"`
+	DataSource        string `tfschema:"data_source"`
+	CapabilityId      string `tfschema:"capability_id"`
+	ObservabilityMode string `tfschema:"observability_mode"`
+	Configuration     string `tfschema:"configuration"`
+}
+
+type AssetEvent struct {
+	Name              string `tfschema:"name"`
+	EventNotifier     string `tfschema:"event_notifier"`
+	CapabilityId      string `tfschema:"capability_id"`
+	ObservabilityMode string `tfschema:"observability_mode"`
+	Configuration     string `tfschema:"configuration"`
+}
+
+func (r AssetResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validateDeviceRegistryName,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": location.Schema(),
+
+		"custom_location_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.CustomLocationID,
+		},
+
+		"asset_endpoint_profile_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validateDeviceRegistryName,
+		},
+
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"external_asset_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"display_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"manufacturer": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"manufacturer_uri": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+		},
+
+		"model": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"product_code": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"hardware_revision": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"software_revision": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"documentation_uri": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+		},
+
+		"serial_number": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"attributes": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"default_data_points_configuration": {
+			Type:             pluginsdk.TypeString,
+			Optional:         true,
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
+		},
+
+		"default_events_configuration": {
+			Type:             pluginsdk.TypeString,
+			Optional:         true,
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
+		},
+
+		"data_point": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"data_source": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"capability_id": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"observability_mode": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Default:      string(assets.DataPointsObservabilityModeNone),
+						ValidateFunc: validation.StringInSlice(assets.PossibleValuesForDataPointsObservabilityMode(), false),
+					},
+
+					"configuration": {
+						Type:             pluginsdk.TypeString,
+						Optional:         true,
+						ValidateFunc:     validation.StringIsJSON,
+						DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
+					},
+				},
+			},
+		},
+
+		"event": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"event_notifier": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"capability_id": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"observability_mode": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Default:      string(assets.EventsObservabilityModeNone),
+						ValidateFunc: validation.StringInSlice(assets.PossibleValuesForEventsObservabilityMode(), false),
+					},
+
+					"configuration": {
+						Type:             pluginsdk.TypeString,
+						Optional:         true,
+						ValidateFunc:     validation.StringIsJSON,
+						DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
+					},
+				},
+			},
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r AssetResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"uuid": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"version": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+	}
+}
+
+func (r AssetResource) ModelObject() interface{} {
+	return &AssetResourceModel{}
+}
+
+func (r AssetResource) ResourceType() string {
+	return "azurerm_device_registry_asset"
+}
+
+func (r AssetResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return assets.ValidateAssetID
+}
+
+func (r AssetResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DeviceRegistry.AssetsClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model AssetResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := assets.NewAssetID(subscriptionId, model.ResourceGroupName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			props := assets.AssetProperties{
+				AssetEndpointProfileUri: model.AssetEndpointProfileName,
+				Attributes:              expandAssetAttributes(model.Attributes),
+				DataPoints:              expandAssetDataPoints(model.DataPoint),
+				Enabled:                 utils.Bool(model.Enabled),
+				Events:                  expandAssetEvents(model.Event),
+			}
+
+			if model.ExternalAssetId != "" {
+				props.ExternalAssetId = utils.String(model.ExternalAssetId)
+			}
+			if model.DisplayName != "" {
+				props.DisplayName = utils.String(model.DisplayName)
+			}
+			if model.Description != "" {
+				props.Description = utils.String(model.Description)
+			}
+			if model.Manufacturer != "" {
+				props.Manufacturer = utils.String(model.Manufacturer)
+			}
+			if model.ManufacturerUri != "" {
+				props.ManufacturerUri = utils.String(model.ManufacturerUri)
+			}
+			if model.Model != "" {
+				props.Model = utils.String(model.Model)
+			}
+			if model.ProductCode != "" {
+				props.ProductCode = utils.String(model.ProductCode)
+			}
+			if model.HardwareRevision != "" {
+				props.HardwareRevision = utils.String(model.HardwareRevision)
+			}
+			if model.SoftwareRevision != "" {
+				props.SoftwareRevision = utils.String(model.SoftwareRevision)
+			}
+			if model.DocumentationUri != "" {
+				props.DocumentationUri = utils.String(model.DocumentationUri)
+			}
+			if model.SerialNumber != "" {
+				props.SerialNumber = utils.String(model.SerialNumber)
+			}
+			if model.DefaultDataPointsConfiguration != "" {
+				props.DefaultDataPointsConfiguration = utils.String(model.DefaultDataPointsConfiguration)
+			}
+			if model.DefaultEventsConfiguration != "" {
+				props.DefaultEventsConfiguration = utils.String(model.DefaultEventsConfiguration)
+			}
+
+			payload := assets.Asset{
+				ExtendedLocation: assets.ExtendedLocation{
+					Name: model.CustomLocationId,
+					Type: "CustomLocation",
+				},
+				Location:   location.Normalize(model.Location),
+				Properties: &props,
+				Tags:       &model.Tags,
+			}
+
+			if err := client.CreateOrReplaceThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r AssetResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DeviceRegistry.AssetsClient
+
+			id, err := assets.ParseAssetID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := AssetResourceModel{
+				Name:              id.AssetName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+
+				customLocationId, err := parse.CustomLocationIDInsensitively(model.ExtendedLocation.Name)
+				if err != nil {
+					return err
+				}
+				state.CustomLocationId = customLocationId.ID()
+
+				if props := model.Properties; props != nil {
+					state.AssetEndpointProfileName = props.AssetEndpointProfileUri
+					state.Attributes = flattenAssetAttributes(props.Attributes)
+					state.DataPoint = flattenAssetDataPoints(props.DataPoints)
+					state.DefaultDataPointsConfiguration = utils.NormalizeNilableString(props.DefaultDataPointsConfiguration)
+					state.DefaultEventsConfiguration = utils.NormalizeNilableString(props.DefaultEventsConfiguration)
+					state.Description = utils.NormalizeNilableString(props.Description)
+					state.DisplayName = utils.NormalizeNilableString(props.DisplayName)
+					state.DocumentationUri = utils.NormalizeNilableString(props.DocumentationUri)
+					state.Enabled = props.Enabled == nil || *props.Enabled
+					state.Event = flattenAssetEvents(props.Events)
+					state.ExternalAssetId = utils.NormalizeNilableString(props.ExternalAssetId)
+					state.HardwareRevision = utils.NormalizeNilableString(props.HardwareRevision)
+					state.Manufacturer = utils.NormalizeNilableString(props.Manufacturer)
+					state.ManufacturerUri = utils.NormalizeNilableString(props.ManufacturerUri)
+					state.Model = utils.NormalizeNilableString(props.Model)
+					state.ProductCode = utils.NormalizeNilableString(props.ProductCode)
+					state.SerialNumber = utils.NormalizeNilableString(props.SerialNumber)
+					state.SoftwareRevision = utils.NormalizeNilableString(props.SoftwareRevision)
+					state.Uuid = utils.NormalizeNilableString(props.Uuid)
+
+					if props.Version != nil {
+						state.Version = *props.Version
+					}
+				}
+
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r AssetResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DeviceRegistry.AssetsClient
+
+			id, err := assets.ParseAssetID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model AssetResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			props := assets.AssetUpdateProperties{}
+			d := metadata.ResourceData
+
+			if d.HasChange("enabled") {
+				props.Enabled = utils.Bool(model.Enabled)
+			}
+			if d.HasChange("display_name") {
+				props.DisplayName = utils.String(model.DisplayName)
+			}
+			if d.HasChange("description") {
+				props.Description = utils.String(model.Description)
+			}
+			if d.HasChange("manufacturer") {
+				props.Manufacturer = utils.String(model.Manufacturer)
+			}
+			if d.HasChange("manufacturer_uri") {
+				props.ManufacturerUri = utils.String(model.ManufacturerUri)
+			}
+			if d.HasChange("model") {
+				props.Model = utils.String(model.Model)
+			}
+			if d.HasChange("product_code") {
+				props.ProductCode = utils.String(model.ProductCode)
+			}
+			if d.HasChange("hardware_revision") {
+				props.HardwareRevision = utils.String(model.HardwareRevision)
+			}
+			if d.HasChange("software_revision") {
+				props.SoftwareRevision = utils.String(model.SoftwareRevision)
+			}
+			if d.HasChange("documentation_uri") {
+				props.DocumentationUri = utils.String(model.DocumentationUri)
+			}
+			if d.HasChange("serial_number") {
+				props.SerialNumber = utils.String(model.SerialNumber)
+			}
+			if d.HasChange("attributes") {
+				props.Attributes = expandAssetAttributes(model.Attributes)
+			}
+			if d.HasChange("default_data_points_configuration") {
+				props.DefaultDataPointsConfiguration = utils.String(model.DefaultDataPointsConfiguration)
+			}
+			if d.HasChange("default_events_configuration") {
+				props.DefaultEventsConfiguration = utils.String(model.DefaultEventsConfiguration)
+			}
+			if d.HasChange("data_point") {
+				props.DataPoints = expandAssetDataPoints(model.DataPoint)
+			}
+			if d.HasChange("event") {
+				props.Events = expandAssetEvents(model.Event)
+			}
+
+			payload := assets.AssetUpdate{
+				Properties: &props,
+			}
+
+			if d.HasChange("tags") {
+				payload.Tags = &model.Tags
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r AssetResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DeviceRegistry.AssetsClient
+
+			id, err := assets.ParseAssetID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandAssetAttributes(input map[string]string) *map[string]interface{} {
+	output := make(map[string]interface{})
+	for k, v := range input {
+		output[k] = v
+	}
+	return &output
+}
+
+func flattenAssetAttributes(input *map[string]interface{}) map[string]string {
+	output := make(map[string]string)
+	if input == nil {
+		return output
+	}
+
+	for k, v := range *input {
+		output[k] = fmt.Sprintf("%v", v)
+	}
+	return output
+}
+
+func expandAssetDataPoints(input []AssetDataPoint) *[]assets.DataPoint {
+	output := make([]assets.DataPoint, 0)
+	for _, v := range input {
+		mode := assets.DataPointsObservabilityMode(v.ObservabilityMode)
+		dataPoint := assets.DataPoint{
+			DataSource:        v.DataSource,
+			Name:              utils.String(v.Name),
+			ObservabilityMode: &mode,
+		}
+
+		if v.CapabilityId != "" {
+			dataPoint.CapabilityId = utils.String(v.CapabilityId)
+		}
+
+		if v.Configuration != "" {
+			dataPoint.DataPointConfiguration = utils.String(v.Configuration)
+		}
+
+		output = append(output, dataPoint)
+	}
+	return &output
+}
+
+func flattenAssetDataPoints(input *[]assets.DataPoint) []AssetDataPoint {
+	output := make([]AssetDataPoint, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		mode := ""
+		if v.ObservabilityMode != nil {
+			mode = string(*v.ObservabilityMode)
+		}
+
+		output = append(output, AssetDataPoint{
+			CapabilityId:      utils.NormalizeNilableString(v.CapabilityId),
+			Configuration:     utils.NormalizeNilableString(v.DataPointConfiguration),
+			DataSource:        v.DataSource,
+			Name:              utils.NormalizeNilableString(v.Name),
+			ObservabilityMode: mode,
+		})
+	}
+	return output
+}
+
+func expandAssetEvents(input []AssetEvent) *[]assets.Event {
+	output := make([]assets.Event, 0)
+	for _, v := range input {
+		mode := assets.EventsObservabilityMode(v.ObservabilityMode)
+		event := assets.Event{
+			EventNotifier:     v.EventNotifier,
+			Name:              utils.String(v.Name),
+			ObservabilityMode: &mode,
+		}
+
+		if v.CapabilityId != "" {
+			event.CapabilityId = utils.String(v.CapabilityId)
+		}
+
+		if v.Configuration != "" {
+			event.EventConfiguration = utils.String(v.Configuration)
+		}
+
+		output = append(output, event)
+	}
+	return &output
+}
+
+func flattenAssetEvents(input *[]assets.Event) []AssetEvent {
+	output := make([]AssetEvent, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		mode := ""
+		if v.ObservabilityMode != nil {
+			mode = string(*v.ObservabilityMode)
+		}
+
+		output = append(output, AssetEvent{
+			CapabilityId:      utils.NormalizeNilableString(v.CapabilityId),
+			Configuration:     utils.NormalizeNilableString(v.EventConfiguration),
+			EventNotifier:     v.EventNotifier,
+			Name:              utils.NormalizeNilableString(v.Name),
+			ObservabilityMode: mode,
+		})
+	}
+	return output
+}
@@ -0,0 +1,192 @@
+package deviceregistry_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/deviceregistry/sdk/2023-11-01-preview/assets"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type AssetResource struct{}
+
+func TestAccDeviceRegistryAsset_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_device_registry_asset", "test")
+	r := AssetResource{}
+	preCheckDeviceRegistry(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("uuid").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDeviceRegistryAsset_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_device_registry_asset", "test")
+	r := AssetResource{}
+	preCheckDeviceRegistry(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccDeviceRegistryAsset_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_device_registry_asset", "test")
+	r := AssetResource{}
+	preCheckDeviceRegistry(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDeviceRegistryAsset_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_device_registry_asset", "test")
+	r := AssetResource{}
+	preCheckDeviceRegistry(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r AssetResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := assets.ParseAssetID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.DeviceRegistry.AssetsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r AssetResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_device_registry_asset" "test" {
+  name                        = "acctest-asset-%d"
+  resource_group_name         = azurerm_resource_group.test.name
+  location                    = azurerm_resource_group.test.location
+  custom_location_id          = azurerm_device_registry_asset_endpoint_profile.test.custom_location_id
+  asset_endpoint_profile_name = azurerm_device_registry_asset_endpoint_profile.test.name
+}
+`, AssetEndpointProfileResource{}.basic(data), data.RandomInteger)
+}
+
+func (r AssetResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_device_registry_asset" "import" {
+  name                        = azurerm_device_registry_asset.test.name
+  resource_group_name         = azurerm_device_registry_asset.test.resource_group_name
+  location                    = azurerm_device_registry_asset.test.location
+  custom_location_id          = azurerm_device_registry_asset.test.custom_location_id
+  asset_endpoint_profile_name = azurerm_device_registry_asset.test.asset_endpoint_profile_name
+}
+`, r.basic(data))
+}
+
+func (r AssetResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_device_registry_asset" "test" {
+  name                        = "acctest-asset-%d"
+  resource_group_name         = azurerm_resource_group.test.name
+  location                    = azurerm_resource_group.test.location
+  custom_location_id          = azurerm_device_registry_asset_endpoint_profile.test.custom_location_id
+  asset_endpoint_profile_name = azurerm_device_registry_asset_endpoint_profile.test.name
+  enabled                     = false
+  display_name                = "Thermostat"
+  description                 = "A thermostat on the factory floor"
+  manufacturer                = "Contoso"
+  manufacturer_uri            = "https://www.contoso.com/manufacturerUri"
+  model                       = "ContosoModel"
+  product_code                = "SA34VDG"
+  hardware_revision           = "1.0"
+  software_revision           = "2.0"
+  documentation_uri           = "https://www.example.com/manual"
+  serial_number               = "64-103816-519918-8"
+
+  attributes = {
+    floor = "1"
+  }
+
+  default_data_points_configuration = jsonencode({ "publishingInterval" : 1000, "samplingInterval" : 500, "queueSize" : 1 })
+  default_events_configuration      = jsonencode({ "publishingInterval" : 1000, "samplingInterval" : 500, "queueSize" : 1 })
+
+  data_point {
+    name               = "temperature"
+    data_source        = "nsu=http://microsoft.com/Opc/OpcPlc/;s=FastUInt1"
+    capability_id      = "dtmi:com:example:Thermostat:__temperature;1"
+    observability_mode = "counter"
+    configuration      = jsonencode({ "publishingInterval" : 8, "samplingInterval" : 8, "queueSize" : 4 })
+  }
+
+  event {
+    name               = "overheat"
+    event_notifier     = "nsu=http://microsoft.com/Opc/OpcPlc/;s=Event1"
+    capability_id      = "dtmi:com:example:Thermostat:__overheat;1"
+    observability_mode = "log"
+  }
+
+  tags = {
+    environment = "test"
+  }
+}
+`, AssetEndpointProfileResource{}.basic(data), data.RandomInteger)
+}
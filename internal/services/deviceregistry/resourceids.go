@@ -0,0 +1,3 @@
+package deviceregistry
+
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=CustomLocation -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ExtendedLocation/customLocations/location1 -rewrite=true
@@ -0,0 +1,15 @@
+package assetendpointprofiles
+
+import "github.com/Azure/go-autorest/autorest"
+
+type AssetEndpointProfilesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewAssetEndpointProfilesClientWithBaseURI(endpoint string) AssetEndpointProfilesClient {
+	return AssetEndpointProfilesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,124 @@
+package assetendpointprofiles
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = AssetEndpointProfileId{}
+
+// AssetEndpointProfileId is a struct representing the Resource ID for a Asset Endpoint Profile
+type AssetEndpointProfileId struct {
+	SubscriptionId           string
+	ResourceGroupName        string
+	AssetEndpointProfileName string
+}
+
+// NewAssetEndpointProfileID returns a new AssetEndpointProfileId struct
+func NewAssetEndpointProfileID(subscriptionId string, resourceGroupName string, assetEndpointProfileName string) AssetEndpointProfileId {
+	return AssetEndpointProfileId{
+		SubscriptionId:           subscriptionId,
+		ResourceGroupName:        resourceGroupName,
+		AssetEndpointProfileName: assetEndpointProfileName,
+	}
+}
+
+// ParseAssetEndpointProfileID parses 'input' into a AssetEndpointProfileId
+func ParseAssetEndpointProfileID(input string) (*AssetEndpointProfileId, error) {
+	parser := resourceids.NewParserFromResourceIdType(AssetEndpointProfileId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := AssetEndpointProfileId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.AssetEndpointProfileName, ok = parsed.Parsed["assetEndpointProfileName"]; !ok {
+		return nil, fmt.Errorf("the segment 'assetEndpointProfileName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseAssetEndpointProfileIDInsensitively parses 'input' case-insensitively into a AssetEndpointProfileId
+// note: this method should only be used for API response data and not user input
+func ParseAssetEndpointProfileIDInsensitively(input string) (*AssetEndpointProfileId, error) {
+	parser := resourceids.NewParserFromResourceIdType(AssetEndpointProfileId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := AssetEndpointProfileId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.AssetEndpointProfileName, ok = parsed.Parsed["assetEndpointProfileName"]; !ok {
+		return nil, fmt.Errorf("the segment 'assetEndpointProfileName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateAssetEndpointProfileID checks that 'input' can be parsed as a Asset Endpoint Profile ID
+func ValidateAssetEndpointProfileID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseAssetEndpointProfileID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Asset Endpoint Profile ID
+func (id AssetEndpointProfileId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DeviceRegistry/assetEndpointProfiles/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.AssetEndpointProfileName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Asset Endpoint Profile ID
+func (id AssetEndpointProfileId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftDeviceRegistry", "Microsoft.DeviceRegistry", "Microsoft.DeviceRegistry"),
+		resourceids.StaticSegment("staticAssetEndpointProfiles", "assetEndpointProfiles", "assetEndpointProfiles"),
+		resourceids.UserSpecifiedSegment("assetEndpointProfileName", "assetEndpointProfileValue"),
+	}
+}
+
+// String returns a human-readable description of this Asset Endpoint Profile ID
+func (id AssetEndpointProfileId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Asset Endpoint Profile Name: %q", id.AssetEndpointProfileName),
+	}
+	return fmt.Sprintf("Asset Endpoint Profile (%s)", strings.Join(components, "\n"))
+}
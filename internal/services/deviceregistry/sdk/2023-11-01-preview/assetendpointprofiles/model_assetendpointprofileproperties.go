@@ -0,0 +1,10 @@
+package assetendpointprofiles
+
+type AssetEndpointProfileProperties struct {
+	AdditionalConfiguration *string                  `json:"additionalConfiguration,omitempty"`
+	ProvisioningState       *ProvisioningState       `json:"provisioningState,omitempty"`
+	TargetAddress           string                   `json:"targetAddress"`
+	TransportAuthentication *TransportAuthentication `json:"transportAuthentication,omitempty"`
+	UserAuthentication      *UserAuthentication      `json:"userAuthentication,omitempty"`
+	Uuid                    *string                  `json:"uuid,omitempty"`
+}
@@ -0,0 +1,6 @@
+package assetendpointprofiles
+
+type AssetEndpointProfileUpdate struct {
+	Properties *AssetEndpointProfileUpdateProperties `json:"properties,omitempty"`
+	Tags       *map[string]string                    `json:"tags,omitempty"`
+}
@@ -0,0 +1,8 @@
+package assetendpointprofiles
+
+type AssetEndpointProfileUpdateProperties struct {
+	AdditionalConfiguration *string                  `json:"additionalConfiguration,omitempty"`
+	TargetAddress           *string                  `json:"targetAddress,omitempty"`
+	TransportAuthentication *TransportAuthentication `json:"transportAuthentication,omitempty"`
+	UserAuthentication      *UserAuthentication      `json:"userAuthentication,omitempty"`
+}
@@ -0,0 +1,6 @@
+package assetendpointprofiles
+
+type ExtendedLocation struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
@@ -0,0 +1,7 @@
+package assetendpointprofiles
+
+type OwnCertificate struct {
+	CertPasswordReference *string `json:"certPasswordReference,omitempty"`
+	CertSecretReference   *string `json:"certSecretReference,omitempty"`
+	CertThumbprint        *string `json:"certThumbprint,omitempty"`
+}
@@ -0,0 +1,5 @@
+package assetendpointprofiles
+
+type TransportAuthentication struct {
+	OwnCertificates []OwnCertificate `json:"ownCertificates"`
+}
@@ -0,0 +1,7 @@
+package assetendpointprofiles
+
+type UserAuthentication struct {
+	Mode                        UserAuthenticationMode       `json:"mode"`
+	UsernamePasswordCredentials *UsernamePasswordCredentials `json:"usernamePasswordCredentials,omitempty"`
+	X509Credentials             *X509Credentials             `json:"x509Credentials,omitempty"`
+}
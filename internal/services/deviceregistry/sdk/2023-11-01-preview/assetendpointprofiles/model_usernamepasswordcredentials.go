@@ -0,0 +1,6 @@
+package assetendpointprofiles
+
+type UsernamePasswordCredentials struct {
+	PasswordReference string `json:"passwordReference"`
+	UsernameReference string `json:"usernameReference"`
+}
@@ -0,0 +1,5 @@
+package assetendpointprofiles
+
+type X509Credentials struct {
+	CertificateReference string `json:"certificateReference"`
+}
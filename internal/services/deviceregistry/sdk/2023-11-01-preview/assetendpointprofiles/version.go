@@ -0,0 +1,9 @@
+package assetendpointprofiles
+
+import "fmt"
+
+const defaultApiVersion = "2023-11-01-preview"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/assetendpointprofiles/%s", defaultApiVersion)
+}
@@ -0,0 +1,15 @@
+package assets
+
+import "github.com/Azure/go-autorest/autorest"
+
+type AssetsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewAssetsClientWithBaseURI(endpoint string) AssetsClient {
+	return AssetsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,139 @@
+package assets
+
+import "strings"
+
+type CreatedByType string
+
+const (
+	CreatedByTypeApplication     CreatedByType = "Application"
+	CreatedByTypeKey             CreatedByType = "Key"
+	CreatedByTypeManagedIdentity CreatedByType = "ManagedIdentity"
+	CreatedByTypeUser            CreatedByType = "User"
+)
+
+func PossibleValuesForCreatedByType() []string {
+	return []string{
+		string(CreatedByTypeApplication),
+		string(CreatedByTypeKey),
+		string(CreatedByTypeManagedIdentity),
+		string(CreatedByTypeUser),
+	}
+}
+
+func parseCreatedByType(input string) (*CreatedByType, error) {
+	vals := map[string]CreatedByType{
+		"application":     CreatedByTypeApplication,
+		"key":             CreatedByTypeKey,
+		"managedidentity": CreatedByTypeManagedIdentity,
+		"user":            CreatedByTypeUser,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := CreatedByType(input)
+	return &out, nil
+}
+
+type DataPointsObservabilityMode string
+
+const (
+	DataPointsObservabilityModeCounter   DataPointsObservabilityMode = "counter"
+	DataPointsObservabilityModeGauge     DataPointsObservabilityMode = "gauge"
+	DataPointsObservabilityModeHistogram DataPointsObservabilityMode = "histogram"
+	DataPointsObservabilityModeLog       DataPointsObservabilityMode = "log"
+	DataPointsObservabilityModeNone      DataPointsObservabilityMode = "none"
+)
+
+func PossibleValuesForDataPointsObservabilityMode() []string {
+	return []string{
+		string(DataPointsObservabilityModeCounter),
+		string(DataPointsObservabilityModeGauge),
+		string(DataPointsObservabilityModeHistogram),
+		string(DataPointsObservabilityModeLog),
+		string(DataPointsObservabilityModeNone),
+	}
+}
+
+func parseDataPointsObservabilityMode(input string) (*DataPointsObservabilityMode, error) {
+	vals := map[string]DataPointsObservabilityMode{
+		"counter":   DataPointsObservabilityModeCounter,
+		"gauge":     DataPointsObservabilityModeGauge,
+		"histogram": DataPointsObservabilityModeHistogram,
+		"log":       DataPointsObservabilityModeLog,
+		"none":      DataPointsObservabilityModeNone,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := DataPointsObservabilityMode(input)
+	return &out, nil
+}
+
+type EventsObservabilityMode string
+
+const (
+	EventsObservabilityModeLog  EventsObservabilityMode = "log"
+	EventsObservabilityModeNone EventsObservabilityMode = "none"
+)
+
+func PossibleValuesForEventsObservabilityMode() []string {
+	return []string{
+		string(EventsObservabilityModeLog),
+		string(EventsObservabilityModeNone),
+	}
+}
+
+func parseEventsObservabilityMode(input string) (*EventsObservabilityMode, error) {
+	vals := map[string]EventsObservabilityMode{
+		"log":  EventsObservabilityModeLog,
+		"none": EventsObservabilityModeNone,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := EventsObservabilityMode(input)
+	return &out, nil
+}
+
+type ProvisioningState string
+
+const (
+	ProvisioningStateAccepted  ProvisioningState = "Accepted"
+	ProvisioningStateCanceled  ProvisioningState = "Canceled"
+	ProvisioningStateDeleting  ProvisioningState = "Deleting"
+	ProvisioningStateFailed    ProvisioningState = "Failed"
+	ProvisioningStateSucceeded ProvisioningState = "Succeeded"
+)
+
+func PossibleValuesForProvisioningState() []string {
+	return []string{
+		string(ProvisioningStateAccepted),
+		string(ProvisioningStateCanceled),
+		string(ProvisioningStateDeleting),
+		string(ProvisioningStateFailed),
+		string(ProvisioningStateSucceeded),
+	}
+}
+
+func parseProvisioningState(input string) (*ProvisioningState, error) {
+	vals := map[string]ProvisioningState{
+		"accepted":  ProvisioningStateAccepted,
+		"canceled":  ProvisioningStateCanceled,
+		"deleting":  ProvisioningStateDeleting,
+		"failed":    ProvisioningStateFailed,
+		"succeeded": ProvisioningStateSucceeded,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ProvisioningState(input)
+	return &out, nil
+}
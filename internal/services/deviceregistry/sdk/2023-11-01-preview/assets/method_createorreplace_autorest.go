@@ -0,0 +1,75 @@
+package assets
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+type CreateOrReplaceResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// CreateOrReplace ...
+func (c AssetsClient) CreateOrReplace(ctx context.Context, id AssetId, input Asset) (result CreateOrReplaceResponse, err error) {
+	req, err := c.preparerForCreateOrReplace(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "assets.AssetsClient", "CreateOrReplace", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForCreateOrReplace(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "assets.AssetsClient", "CreateOrReplace", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// CreateOrReplaceThenPoll performs CreateOrReplace then polls until it's completed
+func (c AssetsClient) CreateOrReplaceThenPoll(ctx context.Context, id AssetId, input Asset) error {
+	result, err := c.CreateOrReplace(ctx, id, input)
+	if err != nil {
+		return fmt.Errorf("performing CreateOrReplace: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after CreateOrReplace: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForCreateOrReplace prepares the CreateOrReplace request.
+func (c AssetsClient) preparerForCreateOrReplace(ctx context.Context, id AssetId, input Asset) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForCreateOrReplace sends the CreateOrReplace request. The method will close the
+// http.Response Body if it receives an error.
+func (c AssetsClient) senderForCreateOrReplace(ctx context.Context, req *http.Request) (future CreateOrReplaceResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
@@ -0,0 +1,12 @@
+package assets
+
+type Asset struct {
+	ExtendedLocation ExtendedLocation   `json:"extendedLocation"`
+	Id               *string            `json:"id,omitempty"`
+	Location         string             `json:"location"`
+	Name             *string            `json:"name,omitempty"`
+	Properties       *AssetProperties   `json:"properties,omitempty"`
+	SystemData       *SystemData        `json:"systemData,omitempty"`
+	Tags             *map[string]string `json:"tags,omitempty"`
+	Type             *string            `json:"type,omitempty"`
+}
@@ -0,0 +1,25 @@
+package assets
+
+type AssetProperties struct {
+	AssetEndpointProfileUri        string                  `json:"assetEndpointProfileUri"`
+	Attributes                     *map[string]interface{} `json:"attributes,omitempty"`
+	DataPoints                     *[]DataPoint            `json:"dataPoints,omitempty"`
+	DefaultDataPointsConfiguration *string                 `json:"defaultDataPointsConfiguration,omitempty"`
+	DefaultEventsConfiguration     *string                 `json:"defaultEventsConfiguration,omitempty"`
+	Description                    *string                 `json:"description,omitempty"`
+	DisplayName                    *string                 `json:"displayName,omitempty"`
+	DocumentationUri               *string                 `json:"documentationUri,omitempty"`
+	Enabled                        *bool                   `json:"enabled,omitempty"`
+	Events                         *[]Event                `json:"events,omitempty"`
+	ExternalAssetId                *string                 `json:"externalAssetId,omitempty"`
+	HardwareRevision               *string                 `json:"hardwareRevision,omitempty"`
+	Manufacturer                   *string                 `json:"manufacturer,omitempty"`
+	ManufacturerUri                *string                 `json:"manufacturerUri,omitempty"`
+	Model                          *string                 `json:"model,omitempty"`
+	ProductCode                    *string                 `json:"productCode,omitempty"`
+	ProvisioningState              *ProvisioningState      `json:"provisioningState,omitempty"`
+	SerialNumber                   *string                 `json:"serialNumber,omitempty"`
+	SoftwareRevision               *string                 `json:"softwareRevision,omitempty"`
+	Uuid                           *string                 `json:"uuid,omitempty"`
+	Version                        *int64                  `json:"version,omitempty"`
+}
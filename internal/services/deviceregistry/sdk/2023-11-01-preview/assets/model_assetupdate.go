@@ -0,0 +1,6 @@
+package assets
+
+type AssetUpdate struct {
+	Properties *AssetUpdateProperties `json:"properties,omitempty"`
+	Tags       *map[string]string     `json:"tags,omitempty"`
+}
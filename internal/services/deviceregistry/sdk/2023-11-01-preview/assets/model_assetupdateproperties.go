@@ -0,0 +1,20 @@
+package assets
+
+type AssetUpdateProperties struct {
+	Attributes                     *map[string]interface{} `json:"attributes,omitempty"`
+	DataPoints                     *[]DataPoint            `json:"dataPoints,omitempty"`
+	DefaultDataPointsConfiguration *string                 `json:"defaultDataPointsConfiguration,omitempty"`
+	DefaultEventsConfiguration     *string                 `json:"defaultEventsConfiguration,omitempty"`
+	Description                    *string                 `json:"description,omitempty"`
+	DisplayName                    *string                 `json:"displayName,omitempty"`
+	DocumentationUri               *string                 `json:"documentationUri,omitempty"`
+	Enabled                        *bool                   `json:"enabled,omitempty"`
+	Events                         *[]Event                `json:"events,omitempty"`
+	HardwareRevision               *string                 `json:"hardwareRevision,omitempty"`
+	Manufacturer                   *string                 `json:"manufacturer,omitempty"`
+	ManufacturerUri                *string                 `json:"manufacturerUri,omitempty"`
+	Model                          *string                 `json:"model,omitempty"`
+	ProductCode                    *string                 `json:"productCode,omitempty"`
+	SerialNumber                   *string                 `json:"serialNumber,omitempty"`
+	SoftwareRevision               *string                 `json:"softwareRevision,omitempty"`
+}
@@ -0,0 +1,9 @@
+package assets
+
+type DataPoint struct {
+	CapabilityId           *string                      `json:"capabilityId,omitempty"`
+	DataPointConfiguration *string                      `json:"dataPointConfiguration,omitempty"`
+	DataSource             string                       `json:"dataSource"`
+	Name                   *string                      `json:"name,omitempty"`
+	ObservabilityMode      *DataPointsObservabilityMode `json:"observabilityMode,omitempty"`
+}
@@ -0,0 +1,9 @@
+package assets
+
+type Event struct {
+	CapabilityId       *string                  `json:"capabilityId,omitempty"`
+	EventConfiguration *string                  `json:"eventConfiguration,omitempty"`
+	EventNotifier      string                   `json:"eventNotifier"`
+	Name               *string                  `json:"name,omitempty"`
+	ObservabilityMode  *EventsObservabilityMode `json:"observabilityMode,omitempty"`
+}
@@ -0,0 +1,662 @@
+package devopsinfrastructure
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devopsinfrastructure/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devopsinfrastructure/sdk/2024-10-19/pools"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devopsinfrastructure/validate"
+	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+const (
+	managedDevOpsPoolAgentProfileKindStateful  = "Stateful"
+	managedDevOpsPoolAgentProfileKindStateless = "Stateless"
+	managedDevOpsPoolFabricProfileKindVmss     = "Vmss"
+	managedDevOpsPoolOrganizationKindAzDevOps  = "AzureDevOps"
+)
+
+var _ sdk.ResourceWithUpdate = ManagedDevOpsPoolResource{}
+
+type ManagedDevOpsPoolResource struct{}
+
+type ManagedDevOpsPoolResourceModel struct {
+	Name                           string                                 `tfschema:"name"`
+	ResourceGroupName              string                                 `tfschema:"resource_group_name"`
+	Location                       string                                 `tfschema:"location"`
+	DevCenterProjectId             string                                 `tfschema:"dev_center_project_id"`
+	MaximumConcurrency             int                                    `tfschema:"maximum_concurrency"`
+	AgentProfile                   []ManagedDevOpsPoolAgentProfile        `tfschema:"agent_profile"`
+	VmssFabricProfile              []ManagedDevOpsPoolVmssFabricProfile   `tfschema:"vmss_fabric_profile"`
+	AzureDevOpsOrganizationProfile []ManagedDevOpsPoolOrganizationProfile `tfschema:"azure_devops_organization_profile"`
+	Tags                           map[string]string                      `tfschema:"tags"`
+}
+
+type ManagedDevOpsPoolAgentProfile struct {
+	Kind                string `tfschema:"kind"`
+	MaxAgentLifetime    string `tfschema:"max_agent_lifetime"`
+	GracePeriodTimeSpan string `tfschema:"grace_period_time_span"`
+}
+
+type ManagedDevOpsPoolVmssFabricProfile struct {
+	SkuName                  string                   `tfschema:"sku_name"`
+	Image                    []ManagedDevOpsPoolImage `tfschema:"image"`
+	LogonType                string                   `tfschema:"logon_type"`
+	OsDiskStorageAccountType string                   `tfschema:"os_disk_storage_account_type"`
+	SubnetId                 string                   `tfschema:"subnet_id"`
+}
+
+type ManagedDevOpsPoolImage struct {
+	ResourceId         string   `tfschema:"resource_id"`
+	WellKnownImageName string   `tfschema:"well_known_image_name"`
+	Aliases            []string `tfschema:"aliases"`
+	Buffer             string   `tfschema:"buffer"`
+}
+
+type ManagedDevOpsPoolOrganizationProfile struct {
+	Organization      []ManagedDevOpsPoolOrganization      `tfschema:"organization"`
+	PermissionProfile []ManagedDevOpsPoolPermissionProfile `tfschema:"permission_profile"`
+}
+
+type ManagedDevOpsPoolOrganization struct {
+	Url         string   `tfschema:"url"`
+	Projects    []string `tfschema:"projects"`
+	Parallelism int      `tfschema:"parallelism"`
+}
+
+type ManagedDevOpsPoolPermissionProfile struct {
+	Kind   string   `tfschema:"kind"`
+	Users  []string `tfschema:"users"`
+	Groups []string `tfschema:"groups"`
+}
+
+func (r ManagedDevOpsPoolResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9-.]{1,42}[a-zA-Z0-9]$`),
+				"`name` must be between 3 and 44 characters, can only contain alphanumeric characters, hyphens and periods, and must start and end with an alphanumeric character",
+			),
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": location.Schema(),
+
+		"dev_center_project_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validate.DevCenterProjectID,
+		},
+
+		"maximum_concurrency": {
+			Type:         pluginsdk.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntBetween(1, 10000),
+		},
+
+		"agent_profile": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"kind": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							managedDevOpsPoolAgentProfileKindStateful,
+							managedDevOpsPoolAgentProfileKindStateless,
+						}, false),
+					},
+
+					"max_agent_lifetime": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"grace_period_time_span": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"vmss_fabric_profile": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"sku_name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"image": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"resource_id": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"well_known_image_name": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"aliases": {
+									Type:     pluginsdk.TypeList,
+									Optional: true,
+									Elem: &pluginsdk.Schema{
+										Type:         pluginsdk.TypeString,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+								},
+
+								"buffer": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									Default:      "*",
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+							},
+						},
+					},
+
+					"logon_type": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Default:      string(pools.LogonTypeService),
+						ValidateFunc: validation.StringInSlice(pools.PossibleValuesForLogonType(), false),
+					},
+
+					"os_disk_storage_account_type": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Default:      string(pools.StorageAccountTypeStandard),
+						ValidateFunc: validation.StringInSlice(pools.PossibleValuesForStorageAccountType(), false),
+					},
+
+					"subnet_id": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: networkValidate.SubnetID,
+					},
+				},
+			},
+		},
+
+		"azure_devops_organization_profile": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"organization": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"url": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.IsURLWithHTTPS,
+								},
+
+								"projects": {
+									Type:     pluginsdk.TypeList,
+									Optional: true,
+									Elem: &pluginsdk.Schema{
+										Type:         pluginsdk.TypeString,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+								},
+
+								"parallelism": {
+									Type:         pluginsdk.TypeInt,
+									Optional:     true,
+									ValidateFunc: validation.IntBetween(1, 10000),
+								},
+							},
+						},
+					},
+
+					"permission_profile": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"kind": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringInSlice(pools.PossibleValuesForAzureDevOpsPermissionType(), false),
+								},
+
+								"users": {
+									Type:     pluginsdk.TypeList,
+									Optional: true,
+									Elem: &pluginsdk.Schema{
+										Type:         pluginsdk.TypeString,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+								},
+
+								"groups": {
+									Type:     pluginsdk.TypeList,
+									Optional: true,
+									Elem: &pluginsdk.Schema{
+										Type:         pluginsdk.TypeString,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r ManagedDevOpsPoolResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ManagedDevOpsPoolResource) ModelObject() interface{} {
+	return &ManagedDevOpsPoolResourceModel{}
+}
+
+func (r ManagedDevOpsPoolResource) ResourceType() string {
+	return "azurerm_managed_devops_pool"
+}
+
+func (r ManagedDevOpsPoolResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return pools.ValidatePoolID
+}
+
+func (r ManagedDevOpsPoolResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DevOpsInfrastructure.PoolsClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model ManagedDevOpsPoolResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := pools.NewPoolID(subscriptionId, model.ResourceGroupName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload, err := expandManagedDevOpsPool(model)
+			if err != nil {
+				return err
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, *payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ManagedDevOpsPoolResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DevOpsInfrastructure.PoolsClient
+
+			id, err := pools.ParsePoolID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ManagedDevOpsPoolResourceModel{
+				Name:              id.PoolName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+
+				if props := model.Properties; props != nil {
+					devCenterProjectId, err := parse.DevCenterProjectIDInsensitively(props.DevCenterProjectResourceId)
+					if err != nil {
+						return err
+					}
+					state.DevCenterProjectId = devCenterProjectId.ID()
+					state.MaximumConcurrency = int(props.MaximumConcurrency)
+					state.AgentProfile = flattenManagedDevOpsPoolAgentProfile(props.AgentProfile)
+
+					vmssFabricProfile, err := flattenManagedDevOpsPoolVmssFabricProfile(props.FabricProfile)
+					if err != nil {
+						return err
+					}
+					state.VmssFabricProfile = vmssFabricProfile
+					state.AzureDevOpsOrganizationProfile = flattenManagedDevOpsPoolOrganizationProfile(props.OrganizationProfile)
+				}
+
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ManagedDevOpsPoolResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DevOpsInfrastructure.PoolsClient
+
+			id, err := pools.ParsePoolID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ManagedDevOpsPoolResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// the pool is replaced as a whole since the PATCH operation doesn't allow removing nested values
+			payload, err := expandManagedDevOpsPool(model)
+			if err != nil {
+				return err
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, *payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ManagedDevOpsPoolResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DevOpsInfrastructure.PoolsClient
+
+			id, err := pools.ParsePoolID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandManagedDevOpsPool(input ManagedDevOpsPoolResourceModel) (*pools.Pool, error) {
+	agentProfile, err := expandManagedDevOpsPoolAgentProfile(input.AgentProfile)
+	if err != nil {
+		return nil, err
+	}
+
+	fabricProfile, err := expandManagedDevOpsPoolVmssFabricProfile(input.VmssFabricProfile)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pools.Pool{
+		Location: location.Normalize(input.Location),
+		Properties: &pools.PoolProperties{
+			AgentProfile:               agentProfile,
+			DevCenterProjectResourceId: input.DevCenterProjectId,
+			FabricProfile:              fabricProfile,
+			MaximumConcurrency:         int64(input.MaximumConcurrency),
+			OrganizationProfile:        expandManagedDevOpsPoolOrganizationProfile(input.AzureDevOpsOrganizationProfile),
+		},
+		Tags: &input.Tags,
+	}, nil
+}
+
+func expandManagedDevOpsPoolAgentProfile(input []ManagedDevOpsPoolAgentProfile) (pools.AgentProfile, error) {
+	v := input[0]
+	output := pools.AgentProfile{
+		Kind: v.Kind,
+	}
+
+	if v.Kind == managedDevOpsPoolAgentProfileKindStateless {
+		if v.MaxAgentLifetime != "" || v.GracePeriodTimeSpan != "" {
+			return output, fmt.Errorf("`max_agent_lifetime` and `grace_period_time_span` can only be specified when `kind` is `%s`", managedDevOpsPoolAgentProfileKindStateful)
+		}
+		return output, nil
+	}
+
+	if v.MaxAgentLifetime != "" {
+		output.MaxAgentLifetime = utils.String(v.MaxAgentLifetime)
+	}
+	if v.GracePeriodTimeSpan != "" {
+		output.GracePeriodTimeSpan = utils.String(v.GracePeriodTimeSpan)
+	}
+
+	return output, nil
+}
+
+func flattenManagedDevOpsPoolAgentProfile(input pools.AgentProfile) []ManagedDevOpsPoolAgentProfile {
+	return []ManagedDevOpsPoolAgentProfile{
+		{
+			Kind:                input.Kind,
+			MaxAgentLifetime:    utils.NormalizeNilableString(input.MaxAgentLifetime),
+			GracePeriodTimeSpan: utils.NormalizeNilableString(input.GracePeriodTimeSpan),
+		},
+	}
+}
+
+func expandManagedDevOpsPoolVmssFabricProfile(input []ManagedDevOpsPoolVmssFabricProfile) (pools.FabricProfile, error) {
+	v := input[0]
+
+	images := make([]pools.PoolImage, 0)
+	for _, image := range v.Image {
+		if (image.ResourceId == "") == (image.WellKnownImageName == "") {
+			return pools.FabricProfile{}, fmt.Errorf("exactly one of `resource_id` or `well_known_image_name` must be specified for each `image`")
+		}
+
+		aliases := image.Aliases
+		output := pools.PoolImage{
+			Aliases: &aliases,
+			Buffer:  utils.String(image.Buffer),
+		}
+		if image.ResourceId != "" {
+			output.ResourceId = utils.String(image.ResourceId)
+		}
+		if image.WellKnownImageName != "" {
+			output.WellKnownImageName = utils.String(image.WellKnownImageName)
+		}
+		images = append(images, output)
+	}
+
+	logonType := pools.LogonType(v.LogonType)
+	storageAccountType := pools.StorageAccountType(v.OsDiskStorageAccountType)
+	output := pools.FabricProfile{
+		Images: images,
+		Kind:   managedDevOpsPoolFabricProfileKindVmss,
+		OsProfile: &pools.OsProfile{
+			LogonType: &logonType,
+		},
+		Sku: pools.DevOpsAzureSku{
+			Name: v.SkuName,
+		},
+		StorageProfile: &pools.StorageProfile{
+			OsDiskStorageAccountType: &storageAccountType,
+		},
+	}
+
+	if v.SubnetId != "" {
+		output.NetworkProfile = &pools.NetworkProfile{
+			SubnetId: v.SubnetId,
+		}
+	}
+
+	return output, nil
+}
+
+func flattenManagedDevOpsPoolVmssFabricProfile(input pools.FabricProfile) ([]ManagedDevOpsPoolVmssFabricProfile, error) {
+	if input.Kind != managedDevOpsPoolFabricProfileKindVmss {
+		return nil, fmt.Errorf("unsupported fabric profile kind %q", input.Kind)
+	}
+
+	output := ManagedDevOpsPoolVmssFabricProfile{
+		SkuName: input.Sku.Name,
+	}
+
+	images := make([]ManagedDevOpsPoolImage, 0)
+	for _, image := range input.Images {
+		aliases := make([]string, 0)
+		if image.Aliases != nil {
+			aliases = *image.Aliases
+		}
+
+		images = append(images, ManagedDevOpsPoolImage{
+			ResourceId:         utils.NormalizeNilableString(image.ResourceId),
+			WellKnownImageName: utils.NormalizeNilableString(image.WellKnownImageName),
+			Aliases:            aliases,
+			Buffer:             utils.NormalizeNilableString(image.Buffer),
+		})
+	}
+	output.Image = images
+
+	if input.OsProfile != nil && input.OsProfile.LogonType != nil {
+		output.LogonType = string(*input.OsProfile.LogonType)
+	}
+
+	if input.StorageProfile != nil && input.StorageProfile.OsDiskStorageAccountType != nil {
+		output.OsDiskStorageAccountType = string(*input.StorageProfile.OsDiskStorageAccountType)
+	}
+
+	if input.NetworkProfile != nil {
+		output.SubnetId = input.NetworkProfile.SubnetId
+	}
+
+	return []ManagedDevOpsPoolVmssFabricProfile{output}, nil
+}
+
+func expandManagedDevOpsPoolOrganizationProfile(input []ManagedDevOpsPoolOrganizationProfile) pools.OrganizationProfile {
+	v := input[0]
+
+	organizations := make([]pools.Organization, 0)
+	for _, org := range v.Organization {
+		output := pools.Organization{
+			Url: org.Url,
+		}
+		if len(org.Projects) > 0 {
+			projects := org.Projects
+			output.Projects = &projects
+		}
+		if org.Parallelism > 0 {
+			output.Parallelism = utils.Int64(int64(org.Parallelism))
+		}
+		organizations = append(organizations, output)
+	}
+
+	output := pools.OrganizationProfile{
+		Kind:          managedDevOpsPoolOrganizationKindAzDevOps,
+		Organizations: organizations,
+	}
+
+	if len(v.PermissionProfile) > 0 {
+		permission := v.PermissionProfile[0]
+		output.PermissionProfile = &pools.AzureDevOpsPermissionProfile{
+			Kind: pools.AzureDevOpsPermissionType(permission.Kind),
+		}
+		if len(permission.Users) > 0 {
+			output.PermissionProfile.Users = &permission.Users
+		}
+		if len(permission.Groups) > 0 {
+			output.PermissionProfile.Groups = &permission.Groups
+		}
+	}
+
+	return output
+}
+
+func flattenManagedDevOpsPoolOrganizationProfile(input pools.OrganizationProfile) []ManagedDevOpsPoolOrganizationProfile {
+	organizations := make([]ManagedDevOpsPoolOrganization, 0)
+	for _, org := range input.Organizations {
+		output := ManagedDevOpsPoolOrganization{
+			Url: org.Url,
+		}
+		if org.Projects != nil {
+			output.Projects = *org.Projects
+		}
+		if org.Parallelism != nil {
+			output.Parallelism = int(*org.Parallelism)
+		}
+		organizations = append(organizations, output)
+	}
+
+	output := ManagedDevOpsPoolOrganizationProfile{
+		Organization:      organizations,
+		PermissionProfile: []ManagedDevOpsPoolPermissionProfile{},
+	}
+
+	if permission := input.PermissionProfile; permission != nil {
+		profile := ManagedDevOpsPoolPermissionProfile{
+			Kind: string(permission.Kind),
+		}
+		if permission.Users != nil {
+			profile.Users = *permission.Users
+		}
+		if permission.Groups != nil {
+			profile.Groups = *permission.Groups
+		}
+		output.PermissionProfile = append(output.PermissionProfile, profile)
+	}
+
+	return []ManagedDevOpsPoolOrganizationProfile{output}
+}
@@ -0,0 +1,273 @@
+package devopsinfrastructure_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devopsinfrastructure/sdk/2024-10-19/pools"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ManagedDevOpsPoolResource struct{}
+
+// Managed DevOps Pools are attached to an existing Dev Center Project and register agents against an Azure DevOps
+// Organization which must be connected to the test tenant - as such these tests require both to be specified.
+func preCheckManagedDevOpsPool(t *testing.T) {
+	if os.Getenv("ARM_TEST_DEV_CENTER_PROJECT_ID") == "" || os.Getenv("ARM_TEST_AZURE_DEVOPS_ORGANIZATION_URL") == "" {
+		t.Skip("Skipping as ARM_TEST_DEV_CENTER_PROJECT_ID and/or ARM_TEST_AZURE_DEVOPS_ORGANIZATION_URL are not specified")
+	}
+}
+
+func TestAccManagedDevOpsPool_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_managed_devops_pool", "test")
+	r := ManagedDevOpsPoolResource{}
+	preCheckManagedDevOpsPool(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccManagedDevOpsPool_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_managed_devops_pool", "test")
+	r := ManagedDevOpsPoolResource{}
+	preCheckManagedDevOpsPool(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccManagedDevOpsPool_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_managed_devops_pool", "test")
+	r := ManagedDevOpsPoolResource{}
+	preCheckManagedDevOpsPool(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccManagedDevOpsPool_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_managed_devops_pool", "test")
+	r := ManagedDevOpsPoolResource{}
+	preCheckManagedDevOpsPool(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ManagedDevOpsPoolResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := pools.ParsePoolID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.DevOpsInfrastructure.PoolsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r ManagedDevOpsPoolResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_managed_devops_pool" "test" {
+  name                  = "acctest-mdp-%d"
+  resource_group_name   = azurerm_resource_group.test.name
+  location              = azurerm_resource_group.test.location
+  dev_center_project_id = %q
+  maximum_concurrency   = 1
+
+  agent_profile {
+    kind = "Stateless"
+  }
+
+  vmss_fabric_profile {
+    sku_name = "Standard_D2ads_v5"
+
+    image {
+      well_known_image_name = "ubuntu-22.04/latest"
+    }
+  }
+
+  azure_devops_organization_profile {
+    organization {
+      url = %q
+    }
+  }
+}
+`, r.template(data), data.RandomInteger, os.Getenv("ARM_TEST_DEV_CENTER_PROJECT_ID"), os.Getenv("ARM_TEST_AZURE_DEVOPS_ORGANIZATION_URL"))
+}
+
+func (r ManagedDevOpsPoolResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_managed_devops_pool" "import" {
+  name                  = azurerm_managed_devops_pool.test.name
+  resource_group_name   = azurerm_managed_devops_pool.test.resource_group_name
+  location              = azurerm_managed_devops_pool.test.location
+  dev_center_project_id = azurerm_managed_devops_pool.test.dev_center_project_id
+  maximum_concurrency   = azurerm_managed_devops_pool.test.maximum_concurrency
+
+  agent_profile {
+    kind = "Stateless"
+  }
+
+  vmss_fabric_profile {
+    sku_name = "Standard_D2ads_v5"
+
+    image {
+      well_known_image_name = "ubuntu-22.04/latest"
+    }
+  }
+
+  azure_devops_organization_profile {
+    organization {
+      url = %q
+    }
+  }
+}
+`, r.basic(data), os.Getenv("ARM_TEST_AZURE_DEVOPS_ORGANIZATION_URL"))
+}
+
+func (r ManagedDevOpsPoolResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvnet-%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "internal"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.0.2.0/24"]
+
+  delegation {
+    name = "pools"
+
+    service_delegation {
+      name = "Microsoft.DevOpsInfrastructure/pools"
+    }
+  }
+}
+
+resource "azurerm_managed_devops_pool" "test" {
+  name                  = "acctest-mdp-%d"
+  resource_group_name   = azurerm_resource_group.test.name
+  location              = azurerm_resource_group.test.location
+  dev_center_project_id = %q
+  maximum_concurrency   = 2
+
+  agent_profile {
+    kind                   = "Stateful"
+    max_agent_lifetime     = "7.00:00:00"
+    grace_period_time_span = "00:30:00"
+  }
+
+  vmss_fabric_profile {
+    sku_name                     = "Standard_D4ads_v5"
+    logon_type                   = "Interactive"
+    os_disk_storage_account_type = "StandardSSD"
+    subnet_id                    = azurerm_subnet.test.id
+
+    image {
+      well_known_image_name = "ubuntu-22.04/latest"
+      aliases               = ["ubuntu-22.04"]
+      buffer                = "*"
+    }
+
+    image {
+      well_known_image_name = "windows-2022/latest"
+      buffer                = "0"
+    }
+  }
+
+  azure_devops_organization_profile {
+    organization {
+      url         = %q
+      parallelism = 2
+    }
+
+    permission_profile {
+      kind = "CreatorOnly"
+    }
+  }
+
+  tags = {
+    environment = "test"
+  }
+}
+`, r.template(data), data.RandomInteger, data.RandomInteger, os.Getenv("ARM_TEST_DEV_CENTER_PROJECT_ID"), os.Getenv("ARM_TEST_AZURE_DEVOPS_ORGANIZATION_URL"))
+}
+
+func (r ManagedDevOpsPoolResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-mdp-%d"
+  location = %q
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
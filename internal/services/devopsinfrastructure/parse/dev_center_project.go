@@ -0,0 +1,114 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceid"
+)
+
+type DevCenterProjectId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	ProjectName    string
+}
+
+func NewDevCenterProjectID(subscriptionId, resourceGroup, projectName string) DevCenterProjectId {
+	return DevCenterProjectId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ProjectName:    projectName,
+	}
+}
+
+func (id DevCenterProjectId) String() string {
+	segments := []string{
+		fmt.Sprintf("Project Name %q", id.ProjectName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Dev Center Project", segmentsStr)
+}
+
+func (id DevCenterProjectId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DevCenter/projects/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ProjectName)
+}
+
+// DevCenterProjectID parses a DevCenterProject ID into an DevCenterProjectId struct
+func DevCenterProjectID(input string) (*DevCenterProjectId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := DevCenterProjectId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.ProjectName, err = id.PopSegment("projects"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// DevCenterProjectIDInsensitively parses an DevCenterProject ID into an DevCenterProjectId struct, insensitively
+// This should only be used to parse an ID for rewriting, the DevCenterProjectID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func DevCenterProjectIDInsensitively(input string) (*DevCenterProjectId, error) {
+	id, err := resourceid.ParseAzureResourceIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := DevCenterProjectId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	// find the correct casing for the 'projects' segment
+	projectsKey := "projects"
+	for key := range id.Path {
+		if strings.EqualFold(key, projectsKey) {
+			projectsKey = key
+			break
+		}
+	}
+	if resourceId.ProjectName, err = id.PopSegment(projectsKey); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
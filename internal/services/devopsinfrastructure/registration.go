@@ -0,0 +1,29 @@
+package devopsinfrastructure
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+var _ sdk.TypedServiceRegistration = Registration{}
+
+type Registration struct{}
+
+func (r Registration) Name() string {
+	return "Managed DevOps Pools"
+}
+
+func (r Registration) WebsiteCategories() []string {
+	return []string{
+		"Managed DevOps Pools",
+	}
+}
+
+func (r Registration) DataSources() []sdk.DataSource {
+	return []sdk.DataSource{}
+}
+
+func (r Registration) Resources() []sdk.Resource {
+	return []sdk.Resource{
+		ManagedDevOpsPoolResource{},
+	}
+}
@@ -0,0 +1,170 @@
+package pools
+
+import "strings"
+
+type AzureDevOpsPermissionType string
+
+const (
+	AzureDevOpsPermissionTypeCreatorOnly      AzureDevOpsPermissionType = "CreatorOnly"
+	AzureDevOpsPermissionTypeInherit          AzureDevOpsPermissionType = "Inherit"
+	AzureDevOpsPermissionTypeSpecificAccounts AzureDevOpsPermissionType = "SpecificAccounts"
+)
+
+func PossibleValuesForAzureDevOpsPermissionType() []string {
+	return []string{
+		string(AzureDevOpsPermissionTypeCreatorOnly),
+		string(AzureDevOpsPermissionTypeInherit),
+		string(AzureDevOpsPermissionTypeSpecificAccounts),
+	}
+}
+
+func parseAzureDevOpsPermissionType(input string) (*AzureDevOpsPermissionType, error) {
+	vals := map[string]AzureDevOpsPermissionType{
+		"creatoronly":      AzureDevOpsPermissionTypeCreatorOnly,
+		"inherit":          AzureDevOpsPermissionTypeInherit,
+		"specificaccounts": AzureDevOpsPermissionTypeSpecificAccounts,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := AzureDevOpsPermissionType(input)
+	return &out, nil
+}
+
+type CreatedByType string
+
+const (
+	CreatedByTypeApplication     CreatedByType = "Application"
+	CreatedByTypeKey             CreatedByType = "Key"
+	CreatedByTypeManagedIdentity CreatedByType = "ManagedIdentity"
+	CreatedByTypeUser            CreatedByType = "User"
+)
+
+func PossibleValuesForCreatedByType() []string {
+	return []string{
+		string(CreatedByTypeApplication),
+		string(CreatedByTypeKey),
+		string(CreatedByTypeManagedIdentity),
+		string(CreatedByTypeUser),
+	}
+}
+
+func parseCreatedByType(input string) (*CreatedByType, error) {
+	vals := map[string]CreatedByType{
+		"application":     CreatedByTypeApplication,
+		"key":             CreatedByTypeKey,
+		"managedidentity": CreatedByTypeManagedIdentity,
+		"user":            CreatedByTypeUser,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := CreatedByType(input)
+	return &out, nil
+}
+
+type LogonType string
+
+const (
+	LogonTypeInteractive LogonType = "Interactive"
+	LogonTypeService     LogonType = "Service"
+)
+
+func PossibleValuesForLogonType() []string {
+	return []string{
+		string(LogonTypeInteractive),
+		string(LogonTypeService),
+	}
+}
+
+func parseLogonType(input string) (*LogonType, error) {
+	vals := map[string]LogonType{
+		"interactive": LogonTypeInteractive,
+		"service":     LogonTypeService,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := LogonType(input)
+	return &out, nil
+}
+
+type ProvisioningState string
+
+const (
+	ProvisioningStateAccepted     ProvisioningState = "Accepted"
+	ProvisioningStateCanceled     ProvisioningState = "Canceled"
+	ProvisioningStateDeleting     ProvisioningState = "Deleting"
+	ProvisioningStateFailed       ProvisioningState = "Failed"
+	ProvisioningStateProvisioning ProvisioningState = "Provisioning"
+	ProvisioningStateSucceeded    ProvisioningState = "Succeeded"
+	ProvisioningStateUpdating     ProvisioningState = "Updating"
+)
+
+func PossibleValuesForProvisioningState() []string {
+	return []string{
+		string(ProvisioningStateAccepted),
+		string(ProvisioningStateCanceled),
+		string(ProvisioningStateDeleting),
+		string(ProvisioningStateFailed),
+		string(ProvisioningStateProvisioning),
+		string(ProvisioningStateSucceeded),
+		string(ProvisioningStateUpdating),
+	}
+}
+
+func parseProvisioningState(input string) (*ProvisioningState, error) {
+	vals := map[string]ProvisioningState{
+		"accepted":     ProvisioningStateAccepted,
+		"canceled":     ProvisioningStateCanceled,
+		"deleting":     ProvisioningStateDeleting,
+		"failed":       ProvisioningStateFailed,
+		"provisioning": ProvisioningStateProvisioning,
+		"succeeded":    ProvisioningStateSucceeded,
+		"updating":     ProvisioningStateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ProvisioningState(input)
+	return &out, nil
+}
+
+type StorageAccountType string
+
+const (
+	StorageAccountTypePremium     StorageAccountType = "Premium"
+	StorageAccountTypeStandard    StorageAccountType = "Standard"
+	StorageAccountTypeStandardSSD StorageAccountType = "StandardSSD"
+)
+
+func PossibleValuesForStorageAccountType() []string {
+	return []string{
+		string(StorageAccountTypePremium),
+		string(StorageAccountTypeStandard),
+		string(StorageAccountTypeStandardSSD),
+	}
+}
+
+func parseStorageAccountType(input string) (*StorageAccountType, error) {
+	vals := map[string]StorageAccountType{
+		"premium":     StorageAccountTypePremium,
+		"standard":    StorageAccountTypeStandard,
+		"standardssd": StorageAccountTypeStandardSSD,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := StorageAccountType(input)
+	return &out, nil
+}
@@ -0,0 +1,7 @@
+package pools
+
+type AgentProfile struct {
+	GracePeriodTimeSpan *string `json:"gracePeriodTimeSpan,omitempty"`
+	Kind                string  `json:"kind"`
+	MaxAgentLifetime    *string `json:"maxAgentLifetime,omitempty"`
+}
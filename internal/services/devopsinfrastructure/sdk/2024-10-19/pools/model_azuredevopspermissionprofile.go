@@ -0,0 +1,7 @@
+package pools
+
+type AzureDevOpsPermissionProfile struct {
+	Groups *[]string                 `json:"groups,omitempty"`
+	Kind   AzureDevOpsPermissionType `json:"kind"`
+	Users  *[]string                 `json:"users,omitempty"`
+}
@@ -0,0 +1,5 @@
+package pools
+
+type DevOpsAzureSku struct {
+	Name string `json:"name"`
+}
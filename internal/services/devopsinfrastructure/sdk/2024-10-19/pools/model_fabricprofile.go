@@ -0,0 +1,10 @@
+package pools
+
+type FabricProfile struct {
+	Images         []PoolImage     `json:"images"`
+	Kind           string          `json:"kind"`
+	NetworkProfile *NetworkProfile `json:"networkProfile,omitempty"`
+	OsProfile      *OsProfile      `json:"osProfile,omitempty"`
+	Sku            DevOpsAzureSku  `json:"sku"`
+	StorageProfile *StorageProfile `json:"storageProfile,omitempty"`
+}
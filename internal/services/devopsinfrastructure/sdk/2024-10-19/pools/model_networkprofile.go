@@ -0,0 +1,5 @@
+package pools
+
+type NetworkProfile struct {
+	SubnetId string `json:"subnetId"`
+}
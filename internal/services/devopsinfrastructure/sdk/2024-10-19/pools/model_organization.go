@@ -0,0 +1,7 @@
+package pools
+
+type Organization struct {
+	Parallelism *int64    `json:"parallelism,omitempty"`
+	Projects    *[]string `json:"projects,omitempty"`
+	Url         string    `json:"url"`
+}
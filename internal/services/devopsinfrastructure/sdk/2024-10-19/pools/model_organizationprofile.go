@@ -0,0 +1,7 @@
+package pools
+
+type OrganizationProfile struct {
+	Kind              string                        `json:"kind"`
+	Organizations     []Organization                `json:"organizations"`
+	PermissionProfile *AzureDevOpsPermissionProfile `json:"permissionProfile,omitempty"`
+}
@@ -0,0 +1,5 @@
+package pools
+
+type OsProfile struct {
+	LogonType *LogonType `json:"logonType,omitempty"`
+}
@@ -0,0 +1,8 @@
+package pools
+
+type PoolImage struct {
+	Aliases            *[]string `json:"aliases,omitempty"`
+	Buffer             *string   `json:"buffer,omitempty"`
+	ResourceId         *string   `json:"resourceId,omitempty"`
+	WellKnownImageName *string   `json:"wellKnownImageName,omitempty"`
+}
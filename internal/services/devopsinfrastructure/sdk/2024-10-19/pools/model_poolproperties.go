@@ -0,0 +1,10 @@
+package pools
+
+type PoolProperties struct {
+	AgentProfile               AgentProfile        `json:"agentProfile"`
+	DevCenterProjectResourceId string              `json:"devCenterProjectResourceId"`
+	FabricProfile              FabricProfile       `json:"fabricProfile"`
+	MaximumConcurrency         int64               `json:"maximumConcurrency"`
+	OrganizationProfile        OrganizationProfile `json:"organizationProfile"`
+	ProvisioningState          *ProvisioningState  `json:"provisioningState,omitempty"`
+}
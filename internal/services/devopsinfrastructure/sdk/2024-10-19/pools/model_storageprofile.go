@@ -0,0 +1,5 @@
+package pools
+
+type StorageProfile struct {
+	OsDiskStorageAccountType *StorageAccountType `json:"osDiskStorageAccountType,omitempty"`
+}
@@ -0,0 +1,9 @@
+package pools
+
+import "fmt"
+
+const defaultApiVersion = "2024-10-19"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/pools/%s", defaultApiVersion)
+}
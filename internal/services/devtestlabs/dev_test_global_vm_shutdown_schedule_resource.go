@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceid"
 	computeParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
 	computeValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devtestlabs/parse"
@@ -25,9 +26,8 @@ func resourceDevTestGlobalVMShutdownSchedule() *pluginsdk.Resource {
 		Read:   resourceDevTestGlobalVMShutdownScheduleRead,
 		Update: resourceDevTestGlobalVMShutdownScheduleCreateUpdate,
 		Delete: resourceDevTestGlobalVMShutdownScheduleDelete,
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.ScheduleID(id)
-			return err
+		Importer: pluginsdk.ImporterNormalizingResourceId(func(id string) (resourceid.Formatter, error) {
+			return parse.ScheduleIDInsensitively(id)
 		}),
 
 		Timeouts: &pluginsdk.ResourceTimeout{
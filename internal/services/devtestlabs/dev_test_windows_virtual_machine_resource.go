@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceid"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devtestlabs/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devtestlabs/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devtestlabs/validate"
@@ -25,9 +26,8 @@ func resourceArmDevTestWindowsVirtualMachine() *pluginsdk.Resource {
 		Read:   resourceArmDevTestWindowsVirtualMachineRead,
 		Update: resourceArmDevTestWindowsVirtualMachineCreateUpdate,
 		Delete: resourceArmDevTestWindowsVirtualMachineDelete,
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.DevTestVirtualMachineID(id)
-			return err
+		Importer: pluginsdk.ImporterNormalizingResourceId(func(id string) (resourceid.Formatter, error) {
+			return parse.DevTestVirtualMachineIDInsensitively(id)
 		}),
 
 		SchemaVersion: 1,
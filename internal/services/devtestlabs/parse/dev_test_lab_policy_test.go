@@ -263,6 +263,18 @@ func TestDevTestLabPolicyIDInsensitively(t *testing.T) {
 				PolicyName:     "policy1",
 			},
 		},
+
+		{
+			// upper-cased root segment names
+			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/group1/PROVIDERS/Microsoft.DevTestLab/labs/lab1/policySets/policyset1/policies/policy1",
+			Expected: &DevTestLabPolicyId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "group1",
+				LabName:        "lab1",
+				PolicySetName:  "policyset1",
+				PolicyName:     "policy1",
+			},
+		},
 	}
 
 	for _, v := range testData {
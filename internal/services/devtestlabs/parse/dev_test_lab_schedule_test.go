@@ -231,6 +231,17 @@ func TestDevTestLabScheduleIDInsensitively(t *testing.T) {
 				ScheduleName:   "schedule1",
 			},
 		},
+
+		{
+			// upper-cased root segment names
+			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/group1/PROVIDERS/Microsoft.DevTestLab/labs/lab1/schedules/schedule1",
+			Expected: &DevTestLabScheduleId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "group1",
+				LabName:        "lab1",
+				ScheduleName:   "schedule1",
+			},
+		},
 	}
 
 	for _, v := range testData {
@@ -199,6 +199,16 @@ func TestDevTestLabIDInsensitively(t *testing.T) {
 				LabName:        "lab1",
 			},
 		},
+
+		{
+			// upper-cased root segment names
+			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/group1/PROVIDERS/Microsoft.DevTestLab/labs/lab1",
+			Expected: &DevTestLabId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "group1",
+				LabName:        "lab1",
+			},
+		},
 	}
 
 	for _, v := range testData {
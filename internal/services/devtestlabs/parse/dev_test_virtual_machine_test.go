@@ -231,6 +231,17 @@ func TestDevTestVirtualMachineIDInsensitively(t *testing.T) {
 				VirtualMachineName: "vm1",
 			},
 		},
+
+		{
+			// upper-cased root segment names
+			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/group1/PROVIDERS/Microsoft.DevTestLab/labs/lab1/virtualMachines/vm1",
+			Expected: &DevTestVirtualMachineId{
+				SubscriptionId:     "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:      "group1",
+				LabName:            "lab1",
+				VirtualMachineName: "vm1",
+			},
+		},
 	}
 
 	for _, v := range testData {
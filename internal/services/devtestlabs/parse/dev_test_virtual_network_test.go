@@ -231,6 +231,17 @@ func TestDevTestVirtualNetworkIDInsensitively(t *testing.T) {
 				VirtualNetworkName: "vnet1",
 			},
 		},
+
+		{
+			// upper-cased root segment names
+			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/group1/PROVIDERS/Microsoft.DevTestLab/labs/lab1/virtualNetworks/vnet1",
+			Expected: &DevTestVirtualNetworkId{
+				SubscriptionId:     "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:      "group1",
+				LabName:            "lab1",
+				VirtualNetworkName: "vnet1",
+			},
+		},
 	}
 
 	for _, v := range testData {
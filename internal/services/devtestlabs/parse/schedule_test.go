@@ -199,6 +199,16 @@ func TestScheduleIDInsensitively(t *testing.T) {
 				Name:           "schedule1",
 			},
 		},
+
+		{
+			// upper-cased root segment names
+			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/group1/PROVIDERS/Microsoft.DevTestLab/schedules/schedule1",
+			Expected: &ScheduleId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "group1",
+				Name:           "schedule1",
+			},
+		},
 	}
 
 	for _, v := range testData {
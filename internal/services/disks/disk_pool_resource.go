@@ -8,9 +8,9 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
-	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/disks/sdk/2021-08-01/diskpools"
@@ -43,7 +43,7 @@ func (DiskPoolResource) Arguments() map[string]*schema.Schema {
 			ValidateFunc: disksValidate.DiskPoolName(),
 		},
 
-		"location": commonschema.Location(),
+		"location": location.Schema(),
 
 		"resource_group_name": commonschema.ResourceGroupName(),
 
@@ -46,7 +46,7 @@ func (StorageDisksPoolResource) Arguments() map[string]*pluginsdk.Schema {
 			ValidateFunc: disksValidate.DiskPoolName(),
 		},
 		"resource_group_name": commonschema.ResourceGroupName(),
-		"location":            commonschema.Location(),
+		"location":            location.Schema(),
 		"availability_zones": {
 			Type:     pluginsdk.TypeList,
 			Required: true,
@@ -0,0 +1,247 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/sdk/2023-07-01-preview/dnssecconfigs"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.Resource = DnsZoneDnssecConfigResource{}
+
+type DnsZoneDnssecConfigResource struct{}
+
+type DnsZoneDnssecConfigModel struct {
+	DnsZoneId  string                          `tfschema:"dns_zone_id"`
+	SigningKey []DnsZoneDnssecConfigSigningKey `tfschema:"signing_key"`
+}
+
+type DnsZoneDnssecConfigSigningKey struct {
+	DelegationSignerInfo  []DnsZoneDnssecConfigDelegationSignerInfo `tfschema:"delegation_signer_info"`
+	Flags                 int64                                     `tfschema:"flags"`
+	KeyTag                int64                                     `tfschema:"key_tag"`
+	Protocol              int64                                     `tfschema:"protocol"`
+	PublicKey             string                                    `tfschema:"public_key"`
+	SecurityAlgorithmType int64                                     `tfschema:"security_algorithm_type"`
+}
+
+type DnsZoneDnssecConfigDelegationSignerInfo struct {
+	DigestAlgorithmType int64  `tfschema:"digest_algorithm_type"`
+	DigestValue         string `tfschema:"digest_value"`
+	Record              string `tfschema:"record"`
+}
+
+func (r DnsZoneDnssecConfigResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"dns_zone_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.DnsZoneID,
+		},
+	}
+}
+
+func (r DnsZoneDnssecConfigResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"signing_key": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"delegation_signer_info": {
+						Type:     pluginsdk.TypeList,
+						Computed: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"digest_algorithm_type": {
+									Type:     pluginsdk.TypeInt,
+									Computed: true,
+								},
+
+								"digest_value": {
+									Type:     pluginsdk.TypeString,
+									Computed: true,
+								},
+
+								"record": {
+									Type:     pluginsdk.TypeString,
+									Computed: true,
+								},
+							},
+						},
+					},
+
+					"flags": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"key_tag": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"protocol": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"public_key": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"security_algorithm_type": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r DnsZoneDnssecConfigResource) ModelObject() interface{} {
+	return &DnsZoneDnssecConfigModel{}
+}
+
+func (r DnsZoneDnssecConfigResource) ResourceType() string {
+	return "azurerm_dns_zone_dnssec_config"
+}
+
+func (r DnsZoneDnssecConfigResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.DnsZoneDnssecConfigID
+}
+
+func (r DnsZoneDnssecConfigResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Dns.DnssecConfigsClient
+
+			var model DnsZoneDnssecConfigModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			zoneId, err := parse.DnsZoneID(model.DnsZoneId)
+			if err != nil {
+				return err
+			}
+
+			// a DNS Zone can only contain a single DNSSEC Configuration, which is always named `default`
+			id := parse.NewDnsZoneDnssecConfigID(zoneId.SubscriptionId, zoneId.ResourceGroup, zoneId.Name, "default")
+			sdkZoneId := dnssecconfigs.NewDnsZoneID(zoneId.SubscriptionId, zoneId.ResourceGroup, zoneId.Name)
+
+			existing, err := client.Get(ctx, sdkZoneId)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, sdkZoneId); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r DnsZoneDnssecConfigResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Dns.DnssecConfigsClient
+
+			id, err := parse.DnsZoneDnssecConfigID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, dnssecconfigs.NewDnsZoneID(id.SubscriptionId, id.ResourceGroup, id.DnszoneName))
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := DnsZoneDnssecConfigModel{
+				DnsZoneId: parse.NewDnsZoneID(id.SubscriptionId, id.ResourceGroup, id.DnszoneName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.SigningKey = flattenDnsZoneDnssecConfigSigningKeys(props.SigningKeys)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r DnsZoneDnssecConfigResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Dns.DnssecConfigsClient
+
+			id, err := parse.DnsZoneDnssecConfigID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, dnssecconfigs.NewDnsZoneID(id.SubscriptionId, id.ResourceGroup, id.DnszoneName)); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func flattenDnsZoneDnssecConfigSigningKeys(input *[]dnssecconfigs.SigningKey) []DnsZoneDnssecConfigSigningKey {
+	results := make([]DnsZoneDnssecConfigSigningKey, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, v := range *input {
+		signingKey := DnsZoneDnssecConfigSigningKey{
+			Flags:                 utils.NormaliseNilableInt64(v.Flags),
+			KeyTag:                utils.NormaliseNilableInt64(v.KeyTag),
+			Protocol:              utils.NormaliseNilableInt64(v.Protocol),
+			PublicKey:             utils.NormalizeNilableString(v.PublicKey),
+			SecurityAlgorithmType: utils.NormaliseNilableInt64(v.SecurityAlgorithmType),
+		}
+
+		delegationSignerInfo := make([]DnsZoneDnssecConfigDelegationSignerInfo, 0)
+		if v.DelegationSignerInfo != nil {
+			for _, info := range *v.DelegationSignerInfo {
+				delegationSignerInfo = append(delegationSignerInfo, DnsZoneDnssecConfigDelegationSignerInfo{
+					DigestAlgorithmType: utils.NormaliseNilableInt64(info.DigestAlgorithmType),
+					DigestValue:         utils.NormalizeNilableString(info.DigestValue),
+					Record:              utils.NormalizeNilableString(info.Record),
+				})
+			}
+		}
+		signingKey.DelegationSignerInfo = delegationSignerInfo
+
+		results = append(results, signingKey)
+	}
+
+	return results
+}
@@ -0,0 +1,87 @@
+package dns_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/sdk/2023-07-01-preview/dnssecconfigs"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type DnsZoneDnssecConfigResource struct{}
+
+func TestAccDnsZoneDnssecConfig_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dns_zone_dnssec_config", "test")
+	r := DnsZoneDnssecConfigResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("signing_key.#").Exists(),
+				check.That(data.ResourceName).Key("signing_key.0.delegation_signer_info.0.record").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDnsZoneDnssecConfig_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dns_zone_dnssec_config", "test")
+	r := DnsZoneDnssecConfigResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (DnsZoneDnssecConfigResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.DnsZoneDnssecConfigID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Dns.DnssecConfigsClient.Get(ctx, dnssecconfigs.NewDnsZoneID(id.SubscriptionId, id.ResourceGroup, id.DnszoneName))
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (DnsZoneDnssecConfigResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dns_zone_dnssec_config" "test" {
+  dns_zone_id = azurerm_dns_zone.test.id
+}
+`, DnsZoneResource{}.basic(data))
+}
+
+func (r DnsZoneDnssecConfigResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dns_zone_dnssec_config" "import" {
+  dns_zone_id = azurerm_dns_zone_dnssec_config.test.dns_zone_id
+}
+`, r.basic(data))
+}
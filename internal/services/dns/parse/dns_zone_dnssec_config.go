@@ -0,0 +1,75 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type DnsZoneDnssecConfigId struct {
+	SubscriptionId   string
+	ResourceGroup    string
+	DnszoneName      string
+	DnssecConfigName string
+}
+
+func NewDnsZoneDnssecConfigID(subscriptionId, resourceGroup, dnszoneName, dnssecConfigName string) DnsZoneDnssecConfigId {
+	return DnsZoneDnssecConfigId{
+		SubscriptionId:   subscriptionId,
+		ResourceGroup:    resourceGroup,
+		DnszoneName:      dnszoneName,
+		DnssecConfigName: dnssecConfigName,
+	}
+}
+
+func (id DnsZoneDnssecConfigId) String() string {
+	segments := []string{
+		fmt.Sprintf("Dnssec Config Name %q", id.DnssecConfigName),
+		fmt.Sprintf("Dnszone Name %q", id.DnszoneName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Dns Zone Dnssec Config", segmentsStr)
+}
+
+func (id DnsZoneDnssecConfigId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/dnszones/%s/dnssecConfigs/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.DnszoneName, id.DnssecConfigName)
+}
+
+// DnsZoneDnssecConfigID parses a DnsZoneDnssecConfig ID into an DnsZoneDnssecConfigId struct
+func DnsZoneDnssecConfigID(input string) (*DnsZoneDnssecConfigId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := DnsZoneDnssecConfigId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.DnszoneName, err = id.PopSegment("dnszones"); err != nil {
+		return nil, err
+	}
+	if resourceId.DnssecConfigName, err = id.PopSegment("dnssecConfigs"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,37 @@
+package client
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/elastic/sdk/2024-03-01/monitorsresource"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/elastic/sdk/2024-03-01/openai"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/elastic/sdk/2024-03-01/rules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/elastic/sdk/2024-03-01/trafficfilters"
+)
+
+type Client struct {
+	MonitorClient        *monitorsresource.MonitorsResourceClient
+	OpenAIClient         *openai.OpenAIClient
+	TagRuleClient        *rules.RulesClient
+	TrafficFiltersClient *trafficfilters.TrafficFiltersClient
+}
+
+func NewClient(o *common.ClientOptions) *Client {
+	monitorClient := monitorsresource.NewMonitorsResourceClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&monitorClient.Client, o.ResourceManagerAuthorizer)
+
+	openAIClient := openai.NewOpenAIClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&openAIClient.Client, o.ResourceManagerAuthorizer)
+
+	tagRuleClient := rules.NewRulesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&tagRuleClient.Client, o.ResourceManagerAuthorizer)
+
+	trafficFiltersClient := trafficfilters.NewTrafficFiltersClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&trafficFiltersClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		MonitorClient:        &monitorClient,
+		OpenAIClient:         &openAIClient,
+		TagRuleClient:        &tagRuleClient,
+		TrafficFiltersClient: &trafficFiltersClient,
+	}
+}
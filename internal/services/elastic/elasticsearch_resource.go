@@ -0,0 +1,462 @@
+package elastic
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/elastic/sdk/2024-03-01/monitorsresource"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/elastic/sdk/2024-03-01/rules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// the tag rules of a Monitor are exposed through a single rule set, which the API requires to be named `default`
+const defaultTagRuleName = "default"
+
+var _ sdk.ResourceWithUpdate = ElasticsearchResource{}
+
+type ElasticsearchResource struct{}
+
+type ElasticsearchResourceModel struct {
+	Name                     string            `tfschema:"name"`
+	ResourceGroupName        string            `tfschema:"resource_group_name"`
+	Location                 string            `tfschema:"location"`
+	SkuName                  string            `tfschema:"sku_name"`
+	ElasticCloudEmailAddress string            `tfschema:"elastic_cloud_email_address"`
+	MonitoringEnabled        bool              `tfschema:"monitoring_enabled"`
+	Logs                     []LogsModel       `tfschema:"logs"`
+	Tags                     map[string]string `tfschema:"tags"`
+
+	ElasticCloudDeploymentId  string `tfschema:"elastic_cloud_deployment_id"`
+	ElasticCloudSsoDefaultUrl string `tfschema:"elastic_cloud_sso_default_url"`
+	ElasticCloudUserId        string `tfschema:"elastic_cloud_user_id"`
+	ElasticsearchServiceUrl   string `tfschema:"elasticsearch_service_url"`
+	KibanaServiceUrl          string `tfschema:"kibana_service_url"`
+	KibanaSsoUri              string `tfschema:"kibana_sso_uri"`
+}
+
+type LogsModel struct {
+	FilteringTag         []FilteringTagModel `tfschema:"filtering_tag"`
+	SendActivityLogs     bool                `tfschema:"send_activity_logs"`
+	SendAzureAdLogs      bool                `tfschema:"send_azuread_logs"`
+	SendSubscriptionLogs bool                `tfschema:"send_subscription_logs"`
+}
+
+type FilteringTagModel struct {
+	Action string `tfschema:"action"`
+	Name   string `tfschema:"name"`
+	Value  string `tfschema:"value"`
+}
+
+func (r ElasticsearchResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": location.Schema(),
+
+		"sku_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"elastic_cloud_email_address": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"monitoring_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			ForceNew: true,
+			Default:  true,
+		},
+
+		"logs": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"filtering_tag": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"action": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringInSlice(rules.PossibleValuesForTagAction(), false),
+								},
+
+								"name": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"value": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+							},
+						},
+					},
+
+					"send_activity_logs": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+
+					"send_azuread_logs": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+
+					"send_subscription_logs": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+				},
+			},
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r ElasticsearchResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"elastic_cloud_deployment_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"elastic_cloud_sso_default_url": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"elastic_cloud_user_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"elasticsearch_service_url": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"kibana_service_url": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"kibana_sso_uri": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r ElasticsearchResource) ModelObject() interface{} {
+	return &ElasticsearchResourceModel{}
+}
+
+func (r ElasticsearchResource) ResourceType() string {
+	return "azurerm_elastic_cloud_elasticsearch"
+}
+
+func (r ElasticsearchResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return monitorsresource.ValidateMonitorID
+}
+
+func (r ElasticsearchResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Elastic.MonitorClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model ElasticsearchResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := monitorsresource.NewMonitorID(subscriptionId, model.ResourceGroupName, model.Name)
+			existing, err := client.MonitorsGet(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			monitoringStatus := monitorsresource.MonitoringStatusDisabled
+			if model.MonitoringEnabled {
+				monitoringStatus = monitorsresource.MonitoringStatusEnabled
+			}
+
+			payload := monitorsresource.ElasticMonitorResource{
+				Location: location.Normalize(model.Location),
+				Properties: &monitorsresource.MonitorProperties{
+					MonitoringStatus: &monitoringStatus,
+					UserInfo: &monitorsresource.UserInfo{
+						EmailAddress: utils.String(model.ElasticCloudEmailAddress),
+					},
+				},
+				Sku: &monitorsresource.ResourceSku{
+					Name: model.SkuName,
+				},
+				Tags: &model.Tags,
+			}
+
+			if err := client.MonitorsCreateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			if len(model.Logs) > 0 {
+				if err := updateElasticsearchTagRules(ctx, metadata, id, model.Logs); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ElasticsearchResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Elastic.MonitorClient
+			tagRuleClient := metadata.Client.Elastic.TagRuleClient
+
+			id, err := monitorsresource.ParseMonitorID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.MonitorsGet(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ElasticsearchResourceModel{
+				Name:              id.MonitorName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+
+				if model.Sku != nil {
+					state.SkuName = model.Sku.Name
+				}
+
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+
+				if props := model.Properties; props != nil {
+					state.MonitoringEnabled = props.MonitoringStatus != nil && *props.MonitoringStatus == monitorsresource.MonitoringStatusEnabled
+
+					if elastic := props.ElasticProperties; elastic != nil {
+						if deployment := elastic.ElasticCloudDeployment; deployment != nil {
+							state.ElasticCloudDeploymentId = utils.NormalizeNilableString(deployment.DeploymentId)
+							state.ElasticsearchServiceUrl = utils.NormalizeNilableString(deployment.ElasticsearchServiceUrl)
+							state.KibanaServiceUrl = utils.NormalizeNilableString(deployment.KibanaServiceUrl)
+							state.KibanaSsoUri = utils.NormalizeNilableString(deployment.KibanaSsoUrl)
+						}
+
+						if user := elastic.ElasticCloudUser; user != nil {
+							state.ElasticCloudEmailAddress = utils.NormalizeNilableString(user.EmailAddress)
+							state.ElasticCloudSsoDefaultUrl = utils.NormalizeNilableString(user.ElasticCloudSsoDefaultUrl)
+							state.ElasticCloudUserId = utils.NormalizeNilableString(user.Id)
+						}
+					}
+				}
+			}
+
+			// the user info isn't returned once the Monitor has been provisioned, so fall back to the configured value
+			if state.ElasticCloudEmailAddress == "" {
+				state.ElasticCloudEmailAddress = metadata.ResourceData.Get("elastic_cloud_email_address").(string)
+			}
+
+			tagRuleId := rules.NewTagRuleID(id.SubscriptionId, id.ResourceGroupName, id.MonitorName, defaultTagRuleName)
+			tagRuleResp, err := tagRuleClient.TagRulesGet(ctx, tagRuleId)
+			if err != nil && !response.WasNotFound(tagRuleResp.HttpResponse) {
+				return fmt.Errorf("retrieving %s: %+v", tagRuleId, err)
+			}
+			if tagRuleResp.Model != nil && tagRuleResp.Model.Properties != nil {
+				state.Logs = flattenElasticsearchLogRules(tagRuleResp.Model.Properties.LogRules)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ElasticsearchResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Elastic.MonitorClient
+
+			id, err := monitorsresource.ParseMonitorID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ElasticsearchResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload := monitorsresource.ElasticMonitorResourceUpdateParameters{
+					Tags: &model.Tags,
+				}
+				if _, err := client.MonitorsUpdate(ctx, *id, payload); err != nil {
+					return fmt.Errorf("updating %s: %+v", *id, err)
+				}
+			}
+
+			if metadata.ResourceData.HasChange("logs") {
+				if err := updateElasticsearchTagRules(ctx, metadata, *id, model.Logs); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ElasticsearchResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Elastic.MonitorClient
+
+			id, err := monitorsresource.ParseMonitorID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.MonitorsDeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func updateElasticsearchTagRules(ctx context.Context, metadata sdk.ResourceMetaData, id monitorsresource.MonitorId, input []LogsModel) error {
+	client := metadata.Client.Elastic.TagRuleClient
+	tagRuleId := rules.NewTagRuleID(id.SubscriptionId, id.ResourceGroupName, id.MonitorName, defaultTagRuleName)
+
+	payload := rules.MonitoringTagRules{
+		Properties: &rules.MonitoringTagRulesProperties{
+			LogRules: expandElasticsearchLogRules(input),
+		},
+	}
+
+	if _, err := client.TagRulesCreateOrUpdate(ctx, tagRuleId, payload); err != nil {
+		return fmt.Errorf("updating the logs of %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+func expandElasticsearchLogRules(input []LogsModel) *rules.LogRules {
+	// removing the `logs` block disables sending any logs, rather than deleting the rule set
+	if len(input) == 0 {
+		return &rules.LogRules{
+			FilteringTags:        &[]rules.FilteringTag{},
+			SendAadLogs:          utils.Bool(false),
+			SendActivityLogs:     utils.Bool(false),
+			SendSubscriptionLogs: utils.Bool(false),
+		}
+	}
+
+	logs := input[0]
+	filteringTags := make([]rules.FilteringTag, 0)
+	for _, v := range logs.FilteringTag {
+		action := rules.TagAction(v.Action)
+		filteringTags = append(filteringTags, rules.FilteringTag{
+			Action: &action,
+			Name:   utils.String(v.Name),
+			Value:  utils.String(v.Value),
+		})
+	}
+
+	return &rules.LogRules{
+		FilteringTags:        &filteringTags,
+		SendAadLogs:          utils.Bool(logs.SendAzureAdLogs),
+		SendActivityLogs:     utils.Bool(logs.SendActivityLogs),
+		SendSubscriptionLogs: utils.Bool(logs.SendSubscriptionLogs),
+	}
+}
+
+func flattenElasticsearchLogRules(input *rules.LogRules) []LogsModel {
+	if input == nil {
+		return []LogsModel{}
+	}
+
+	filteringTags := make([]FilteringTagModel, 0)
+	if input.FilteringTags != nil {
+		for _, v := range *input.FilteringTags {
+			action := ""
+			if v.Action != nil {
+				action = string(*v.Action)
+			}
+			filteringTags = append(filteringTags, FilteringTagModel{
+				Action: action,
+				Name:   utils.NormalizeNilableString(v.Name),
+				Value:  utils.NormalizeNilableString(v.Value),
+			})
+		}
+	}
+
+	logs := LogsModel{
+		FilteringTag:         filteringTags,
+		SendActivityLogs:     utils.NormaliseNilableBool(input.SendActivityLogs),
+		SendAzureAdLogs:      utils.NormaliseNilableBool(input.SendAadLogs),
+		SendSubscriptionLogs: utils.NormaliseNilableBool(input.SendSubscriptionLogs),
+	}
+
+	// an empty rule set is equivalent to omitting the `logs` block
+	if len(filteringTags) == 0 && !logs.SendActivityLogs && !logs.SendAzureAdLogs && !logs.SendSubscriptionLogs {
+		return []LogsModel{}
+	}
+
+	return []LogsModel{logs}
+}
@@ -0,0 +1,181 @@
+package elastic_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/elastic/sdk/2024-03-01/monitorsresource"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ElasticsearchResource struct{}
+
+func TestAccElasticsearch_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_elastic_cloud_elasticsearch", "test")
+	r := ElasticsearchResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("elasticsearch_service_url").Exists(),
+				check.That(data.ResourceName).Key("kibana_service_url").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccElasticsearch_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_elastic_cloud_elasticsearch", "test")
+	r := ElasticsearchResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccElasticsearch_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_elastic_cloud_elasticsearch", "test")
+	r := ElasticsearchResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccElasticsearch_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_elastic_cloud_elasticsearch", "test")
+	r := ElasticsearchResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ElasticsearchResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := monitorsresource.ParseMonitorID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Elastic.MonitorClient.MonitorsGet(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r ElasticsearchResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_elastic_cloud_elasticsearch" "test" {
+  name                        = "acctest-estc%d"
+  resource_group_name         = azurerm_resource_group.test.name
+  location                    = azurerm_resource_group.test.location
+  sku_name                    = "ess-consumption-2024_Monthly"
+  elastic_cloud_email_address = "terraform-acctest@hashicorp.com"
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ElasticsearchResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_elastic_cloud_elasticsearch" "import" {
+  name                        = azurerm_elastic_cloud_elasticsearch.test.name
+  resource_group_name         = azurerm_elastic_cloud_elasticsearch.test.resource_group_name
+  location                    = azurerm_elastic_cloud_elasticsearch.test.location
+  sku_name                    = azurerm_elastic_cloud_elasticsearch.test.sku_name
+  elastic_cloud_email_address = azurerm_elastic_cloud_elasticsearch.test.elastic_cloud_email_address
+}
+`, r.basic(data))
+}
+
+func (r ElasticsearchResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_elastic_cloud_elasticsearch" "test" {
+  name                        = "acctest-estc%d"
+  resource_group_name         = azurerm_resource_group.test.name
+  location                    = azurerm_resource_group.test.location
+  sku_name                    = "ess-consumption-2024_Monthly"
+  elastic_cloud_email_address = "terraform-acctest@hashicorp.com"
+
+  logs {
+    filtering_tag {
+      action = "Include"
+      name   = "TerraformAccTest"
+      value  = "RandomValue%d"
+    }
+
+    send_activity_logs     = true
+    send_azuread_logs      = true
+    send_subscription_logs = true
+  }
+
+  tags = {
+    ENV = "Test"
+  }
+}
+`, r.template(data), data.RandomInteger, data.RandomInteger)
+}
+
+func (ElasticsearchResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-elastic-%d"
+  location = "%s"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
@@ -0,0 +1,229 @@
+package elastic
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/elastic/sdk/2024-03-01/openai"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.ResourceWithUpdate = OpenAIIntegrationResource{}
+
+type OpenAIIntegrationResource struct{}
+
+type OpenAIIntegrationResourceModel struct {
+	Name                        string `tfschema:"name"`
+	ElasticCloudElasticsearchId string `tfschema:"elastic_cloud_elasticsearch_id"`
+	OpenAIResourceId            string `tfschema:"openai_resource_id"`
+	OpenAIResourceEndpoint      string `tfschema:"openai_resource_endpoint"`
+	Key                         string `tfschema:"key"`
+	LastRefreshAt               string `tfschema:"last_refresh_at"`
+	Status                      string `tfschema:"status"`
+}
+
+func (r OpenAIIntegrationResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"elastic_cloud_elasticsearch_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: openai.ValidateMonitorID,
+		},
+
+		"openai_resource_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: azure.ValidateResourceID,
+		},
+
+		"openai_resource_endpoint": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.IsURLWithHTTPS,
+		},
+
+		"key": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r OpenAIIntegrationResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"last_refresh_at": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"status": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r OpenAIIntegrationResource) ModelObject() interface{} {
+	return &OpenAIIntegrationResourceModel{}
+}
+
+func (r OpenAIIntegrationResource) ResourceType() string {
+	return "azurerm_elastic_cloud_openai_integration"
+}
+
+func (r OpenAIIntegrationResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return openai.ValidateOpenAIIntegrationID
+}
+
+func (r OpenAIIntegrationResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Elastic.OpenAIClient
+
+			var model OpenAIIntegrationResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			monitorId, err := openai.ParseMonitorID(model.ElasticCloudElasticsearchId)
+			if err != nil {
+				return err
+			}
+
+			id := openai.NewOpenAIIntegrationID(monitorId.SubscriptionId, monitorId.ResourceGroupName, monitorId.MonitorName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, expandOpenAIIntegration(model)); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r OpenAIIntegrationResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Elastic.OpenAIClient
+
+			id, err := openai.ParseOpenAIIntegrationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := OpenAIIntegrationResourceModel{
+				Name:                        id.IntegrationName,
+				ElasticCloudElasticsearchId: openai.NewMonitorID(id.SubscriptionId, id.ResourceGroupName, id.MonitorName).ID(),
+				// the key isn't returned by the API
+				Key: metadata.ResourceData.Get("key").(string),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.LastRefreshAt = utils.NormalizeNilableString(props.LastRefreshAt)
+					state.OpenAIResourceEndpoint = utils.NormalizeNilableString(props.OpenAIResourceEndpoint)
+					state.OpenAIResourceId = utils.NormalizeNilableString(props.OpenAIResourceId)
+				}
+			}
+
+			statusResp, err := client.GetStatus(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving status of %s: %+v", *id, err)
+			}
+			if statusResp.Model != nil && statusResp.Model.Properties != nil {
+				state.Status = utils.NormalizeNilableString(statusResp.Model.Properties.Status)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r OpenAIIntegrationResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Elastic.OpenAIClient
+
+			id, err := openai.ParseOpenAIIntegrationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model OpenAIIntegrationResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, *id, expandOpenAIIntegration(model)); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r OpenAIIntegrationResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Elastic.OpenAIClient
+
+			id, err := openai.ParseOpenAIIntegrationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandOpenAIIntegration(input OpenAIIntegrationResourceModel) openai.OpenAIIntegrationRPModel {
+	return openai.OpenAIIntegrationRPModel{
+		Properties: &openai.OpenAIIntegrationProperties{
+			Key:                    utils.String(input.Key),
+			OpenAIResourceEndpoint: utils.String(input.OpenAIResourceEndpoint),
+			OpenAIResourceId:       utils.String(input.OpenAIResourceId),
+		},
+	}
+}
@@ -0,0 +1,108 @@
+package elastic_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/elastic/sdk/2024-03-01/openai"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type OpenAIIntegrationResource struct{}
+
+func TestAccOpenAIIntegration_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_elastic_cloud_openai_integration", "test")
+	r := OpenAIIntegrationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("status").Exists(),
+			),
+		},
+		data.ImportStep("key"),
+	})
+}
+
+func TestAccOpenAIIntegration_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_elastic_cloud_openai_integration", "test")
+	r := OpenAIIntegrationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (r OpenAIIntegrationResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := openai.ParseOpenAIIntegrationID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Elastic.OpenAIClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r OpenAIIntegrationResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_elastic_cloud_openai_integration" "test" {
+  name                           = "acctest-oai%d"
+  elastic_cloud_elasticsearch_id = azurerm_elastic_cloud_elasticsearch.test.id
+  openai_resource_id             = azurerm_cognitive_account.test.id
+  openai_resource_endpoint       = azurerm_cognitive_account.test.endpoint
+  key                            = azurerm_cognitive_account.test.primary_access_key
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r OpenAIIntegrationResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_elastic_cloud_openai_integration" "import" {
+  name                           = azurerm_elastic_cloud_openai_integration.test.name
+  elastic_cloud_elasticsearch_id = azurerm_elastic_cloud_openai_integration.test.elastic_cloud_elasticsearch_id
+  openai_resource_id             = azurerm_elastic_cloud_openai_integration.test.openai_resource_id
+  openai_resource_endpoint       = azurerm_elastic_cloud_openai_integration.test.openai_resource_endpoint
+  key                            = azurerm_elastic_cloud_openai_integration.test.key
+}
+`, r.basic(data))
+}
+
+func (OpenAIIntegrationResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cognitive_account" "test" {
+  name                  = "acctest-cogacc-%d"
+  location              = azurerm_resource_group.test.location
+  resource_group_name   = azurerm_resource_group.test.name
+  kind                  = "OpenAI"
+  sku_name              = "S0"
+  custom_subdomain_name = "acctest-cogacc-%d"
+}
+`, ElasticsearchResource{}.basic(data), data.RandomInteger, data.RandomInteger)
+}
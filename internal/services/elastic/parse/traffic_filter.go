@@ -0,0 +1,75 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type TrafficFilterId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	MonitorName    string
+	Name           string
+}
+
+func NewTrafficFilterID(subscriptionId, resourceGroup, monitorName, name string) TrafficFilterId {
+	return TrafficFilterId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		MonitorName:    monitorName,
+		Name:           name,
+	}
+}
+
+func (id TrafficFilterId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Monitor Name %q", id.MonitorName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Traffic Filter", segmentsStr)
+}
+
+func (id TrafficFilterId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Elastic/monitors/%s/trafficFilters/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.MonitorName, id.Name)
+}
+
+// TrafficFilterID parses a TrafficFilter ID into an TrafficFilterId struct
+func TrafficFilterID(input string) (*TrafficFilterId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := TrafficFilterId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.MonitorName, err = id.PopSegment("monitors"); err != nil {
+		return nil, err
+	}
+	if resourceId.Name, err = id.PopSegment("trafficFilters"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,31 @@
+package elastic
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+var _ sdk.TypedServiceRegistration = Registration{}
+
+type Registration struct{}
+
+func (r Registration) Name() string {
+	return "Elastic"
+}
+
+func (r Registration) WebsiteCategories() []string {
+	return []string{
+		"Elastic",
+	}
+}
+
+func (r Registration) DataSources() []sdk.DataSource {
+	return []sdk.DataSource{}
+}
+
+func (r Registration) Resources() []sdk.Resource {
+	return []sdk.Resource{
+		ElasticsearchResource{},
+		OpenAIIntegrationResource{},
+		TrafficFilterResource{},
+	}
+}
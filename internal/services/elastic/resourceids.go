@@ -0,0 +1,3 @@
+package elastic
+
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=TrafficFilter -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Elastic/monitors/monitor1/trafficFilters/filter1
@@ -0,0 +1,142 @@
+package monitorsresource
+
+import "strings"
+
+type CreatedByType string
+
+const (
+	CreatedByTypeApplication     CreatedByType = "Application"
+	CreatedByTypeKey             CreatedByType = "Key"
+	CreatedByTypeManagedIdentity CreatedByType = "ManagedIdentity"
+	CreatedByTypeUser            CreatedByType = "User"
+)
+
+func PossibleValuesForCreatedByType() []string {
+	return []string{
+		string(CreatedByTypeApplication),
+		string(CreatedByTypeKey),
+		string(CreatedByTypeManagedIdentity),
+		string(CreatedByTypeUser),
+	}
+}
+
+func parseCreatedByType(input string) (*CreatedByType, error) {
+	vals := map[string]CreatedByType{
+		"application":     CreatedByTypeApplication,
+		"key":             CreatedByTypeKey,
+		"managedidentity": CreatedByTypeManagedIdentity,
+		"user":            CreatedByTypeUser,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := CreatedByType(input)
+	return &out, nil
+}
+
+type LiftrResourceCategories string
+
+const (
+	LiftrResourceCategoriesMonitorLogs LiftrResourceCategories = "MonitorLogs"
+	LiftrResourceCategoriesUnknown     LiftrResourceCategories = "Unknown"
+)
+
+func PossibleValuesForLiftrResourceCategories() []string {
+	return []string{
+		string(LiftrResourceCategoriesMonitorLogs),
+		string(LiftrResourceCategoriesUnknown),
+	}
+}
+
+func parseLiftrResourceCategories(input string) (*LiftrResourceCategories, error) {
+	vals := map[string]LiftrResourceCategories{
+		"monitorlogs": LiftrResourceCategoriesMonitorLogs,
+		"unknown":     LiftrResourceCategoriesUnknown,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := LiftrResourceCategories(input)
+	return &out, nil
+}
+
+type MonitoringStatus string
+
+const (
+	MonitoringStatusDisabled MonitoringStatus = "Disabled"
+	MonitoringStatusEnabled  MonitoringStatus = "Enabled"
+)
+
+func PossibleValuesForMonitoringStatus() []string {
+	return []string{
+		string(MonitoringStatusDisabled),
+		string(MonitoringStatusEnabled),
+	}
+}
+
+func parseMonitoringStatus(input string) (*MonitoringStatus, error) {
+	vals := map[string]MonitoringStatus{
+		"disabled": MonitoringStatusDisabled,
+		"enabled":  MonitoringStatusEnabled,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := MonitoringStatus(input)
+	return &out, nil
+}
+
+type ProvisioningState string
+
+const (
+	ProvisioningStateAccepted     ProvisioningState = "Accepted"
+	ProvisioningStateCanceled     ProvisioningState = "Canceled"
+	ProvisioningStateCreating     ProvisioningState = "Creating"
+	ProvisioningStateDeleted      ProvisioningState = "Deleted"
+	ProvisioningStateDeleting     ProvisioningState = "Deleting"
+	ProvisioningStateFailed       ProvisioningState = "Failed"
+	ProvisioningStateNotSpecified ProvisioningState = "NotSpecified"
+	ProvisioningStateSucceeded    ProvisioningState = "Succeeded"
+	ProvisioningStateUpdating     ProvisioningState = "Updating"
+)
+
+func PossibleValuesForProvisioningState() []string {
+	return []string{
+		string(ProvisioningStateAccepted),
+		string(ProvisioningStateCanceled),
+		string(ProvisioningStateCreating),
+		string(ProvisioningStateDeleted),
+		string(ProvisioningStateDeleting),
+		string(ProvisioningStateFailed),
+		string(ProvisioningStateNotSpecified),
+		string(ProvisioningStateSucceeded),
+		string(ProvisioningStateUpdating),
+	}
+}
+
+func parseProvisioningState(input string) (*ProvisioningState, error) {
+	vals := map[string]ProvisioningState{
+		"accepted":     ProvisioningStateAccepted,
+		"canceled":     ProvisioningStateCanceled,
+		"creating":     ProvisioningStateCreating,
+		"deleted":      ProvisioningStateDeleted,
+		"deleting":     ProvisioningStateDeleting,
+		"failed":       ProvisioningStateFailed,
+		"notspecified": ProvisioningStateNotSpecified,
+		"succeeded":    ProvisioningStateSucceeded,
+		"updating":     ProvisioningStateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ProvisioningState(input)
+	return &out, nil
+}
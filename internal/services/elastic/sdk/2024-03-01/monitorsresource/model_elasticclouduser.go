@@ -0,0 +1,7 @@
+package monitorsresource
+
+type ElasticCloudUser struct {
+	ElasticCloudSsoDefaultUrl *string `json:"elasticCloudSsoDefaultUrl,omitempty"`
+	EmailAddress              *string `json:"emailAddress,omitempty"`
+	Id                        *string `json:"id,omitempty"`
+}
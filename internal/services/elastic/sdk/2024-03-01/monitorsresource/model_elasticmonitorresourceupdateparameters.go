@@ -0,0 +1,5 @@
+package monitorsresource
+
+type ElasticMonitorResourceUpdateParameters struct {
+	Tags *map[string]string `json:"tags,omitempty"`
+}
@@ -0,0 +1,6 @@
+package monitorsresource
+
+type ElasticProperties struct {
+	ElasticCloudDeployment *ElasticCloudDeployment `json:"elasticCloudDeployment,omitempty"`
+	ElasticCloudUser       *ElasticCloudUser       `json:"elasticCloudUser,omitempty"`
+}
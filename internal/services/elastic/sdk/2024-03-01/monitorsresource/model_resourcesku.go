@@ -0,0 +1,5 @@
+package monitorsresource
+
+type ResourceSku struct {
+	Name string `json:"name"`
+}
@@ -0,0 +1,15 @@
+package openai
+
+import "github.com/Azure/go-autorest/autorest"
+
+type OpenAIClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewOpenAIClientWithBaseURI(endpoint string) OpenAIClient {
+	return OpenAIClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
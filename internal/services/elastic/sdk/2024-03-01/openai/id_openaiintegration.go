@@ -0,0 +1,137 @@
+package openai
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = OpenAIIntegrationId{}
+
+// OpenAIIntegrationId is a struct representing the Resource ID for a Open AI Integration
+type OpenAIIntegrationId struct {
+	SubscriptionId    string
+	ResourceGroupName string
+	MonitorName       string
+	IntegrationName   string
+}
+
+// NewOpenAIIntegrationID returns a new OpenAIIntegrationId struct
+func NewOpenAIIntegrationID(subscriptionId string, resourceGroupName string, monitorName string, integrationName string) OpenAIIntegrationId {
+	return OpenAIIntegrationId{
+		SubscriptionId:    subscriptionId,
+		ResourceGroupName: resourceGroupName,
+		MonitorName:       monitorName,
+		IntegrationName:   integrationName,
+	}
+}
+
+// ParseOpenAIIntegrationID parses 'input' into a OpenAIIntegrationId
+func ParseOpenAIIntegrationID(input string) (*OpenAIIntegrationId, error) {
+	parser := resourceids.NewParserFromResourceIdType(OpenAIIntegrationId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := OpenAIIntegrationId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.MonitorName, ok = parsed.Parsed["monitorName"]; !ok {
+		return nil, fmt.Errorf("the segment 'monitorName' was not found in the resource id %q", input)
+	}
+
+	if id.IntegrationName, ok = parsed.Parsed["integrationName"]; !ok {
+		return nil, fmt.Errorf("the segment 'integrationName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseOpenAIIntegrationIDInsensitively parses 'input' case-insensitively into a OpenAIIntegrationId
+// note: this method should only be used for API response data and not user input
+func ParseOpenAIIntegrationIDInsensitively(input string) (*OpenAIIntegrationId, error) {
+	parser := resourceids.NewParserFromResourceIdType(OpenAIIntegrationId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := OpenAIIntegrationId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.MonitorName, ok = parsed.Parsed["monitorName"]; !ok {
+		return nil, fmt.Errorf("the segment 'monitorName' was not found in the resource id %q", input)
+	}
+
+	if id.IntegrationName, ok = parsed.Parsed["integrationName"]; !ok {
+		return nil, fmt.Errorf("the segment 'integrationName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateOpenAIIntegrationID checks that 'input' can be parsed as a Open AI Integration ID
+func ValidateOpenAIIntegrationID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseOpenAIIntegrationID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Open AI Integration ID
+func (id OpenAIIntegrationId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Elastic/monitors/%s/openAIIntegrations/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.MonitorName, id.IntegrationName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Open AI Integration ID
+func (id OpenAIIntegrationId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftElastic", "Microsoft.Elastic", "Microsoft.Elastic"),
+		resourceids.StaticSegment("staticMonitors", "monitors", "monitors"),
+		resourceids.UserSpecifiedSegment("monitorName", "monitorValue"),
+		resourceids.StaticSegment("staticOpenAIIntegrations", "openAIIntegrations", "openAIIntegrations"),
+		resourceids.UserSpecifiedSegment("integrationName", "integrationValue"),
+	}
+}
+
+// String returns a human-readable description of this Open AI Integration ID
+func (id OpenAIIntegrationId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Monitor Name: %q", id.MonitorName),
+		fmt.Sprintf("Integration Name: %q", id.IntegrationName),
+	}
+	return fmt.Sprintf("Open AI Integration (%s)", strings.Join(components, "\n"))
+}
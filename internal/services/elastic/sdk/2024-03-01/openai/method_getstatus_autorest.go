@@ -0,0 +1,65 @@
+package openai
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type GetStatusResponse struct {
+	HttpResponse *http.Response
+	Model        *OpenAIIntegrationStatusResponse
+}
+
+// GetStatus ...
+func (c OpenAIClient) GetStatus(ctx context.Context, id OpenAIIntegrationId) (result GetStatusResponse, err error) {
+	req, err := c.preparerForGetStatus(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "openai.OpenAIClient", "GetStatus", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "openai.OpenAIClient", "GetStatus", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForGetStatus(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "openai.OpenAIClient", "GetStatus", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForGetStatus prepares the GetStatus request.
+func (c OpenAIClient) preparerForGetStatus(ctx context.Context, id OpenAIIntegrationId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPost(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(fmt.Sprintf("%s/getStatus", id.ID())),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForGetStatus handles the response to the GetStatus request. The method always
+// closes the http.Response Body.
+func (c OpenAIClient) responderForGetStatus(resp *http.Response) (result GetStatusResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
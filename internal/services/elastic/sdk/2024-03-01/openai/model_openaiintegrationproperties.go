@@ -0,0 +1,8 @@
+package openai
+
+type OpenAIIntegrationProperties struct {
+	Key                    *string `json:"key,omitempty"`
+	LastRefreshAt          *string `json:"lastRefreshAt,omitempty"`
+	OpenAIResourceEndpoint *string `json:"openAIResourceEndpoint,omitempty"`
+	OpenAIResourceId       *string `json:"openAIResourceId,omitempty"`
+}
@@ -0,0 +1,9 @@
+package openai
+
+type OpenAIIntegrationRPModel struct {
+	Id         *string                      `json:"id,omitempty"`
+	Name       *string                      `json:"name,omitempty"`
+	Properties *OpenAIIntegrationProperties `json:"properties,omitempty"`
+	SystemData *SystemData                  `json:"systemData,omitempty"`
+	Type       *string                      `json:"type,omitempty"`
+}
@@ -0,0 +1,5 @@
+package openai
+
+type OpenAIIntegrationStatusResponse struct {
+	Properties *OpenAIIntegrationStatusResponseProperties `json:"properties,omitempty"`
+}
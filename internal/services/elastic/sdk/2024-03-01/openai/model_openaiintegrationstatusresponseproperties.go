@@ -0,0 +1,5 @@
+package openai
+
+type OpenAIIntegrationStatusResponseProperties struct {
+	Status *string `json:"status,omitempty"`
+}
@@ -0,0 +1,9 @@
+package openai
+
+import "fmt"
+
+const defaultApiVersion = "2024-03-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/openai/%s", defaultApiVersion)
+}
@@ -0,0 +1,64 @@
+package rules
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type TagRulesGetResponse struct {
+	HttpResponse *http.Response
+	Model        *MonitoringTagRules
+}
+
+// TagRulesGet ...
+func (c RulesClient) TagRulesGet(ctx context.Context, id TagRuleId) (result TagRulesGetResponse, err error) {
+	req, err := c.preparerForTagRulesGet(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "rules.RulesClient", "TagRulesGet", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "rules.RulesClient", "TagRulesGet", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForTagRulesGet(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "rules.RulesClient", "TagRulesGet", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForTagRulesGet prepares the TagRulesGet request.
+func (c RulesClient) preparerForTagRulesGet(ctx context.Context, id TagRuleId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForTagRulesGet handles the response to the TagRulesGet request. The method always
+// closes the http.Response Body.
+func (c RulesClient) responderForTagRulesGet(resp *http.Response) (result TagRulesGetResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
@@ -0,0 +1,15 @@
+package trafficfilters
+
+import "github.com/Azure/go-autorest/autorest"
+
+type TrafficFiltersClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewTrafficFiltersClientWithBaseURI(endpoint string) TrafficFiltersClient {
+	return TrafficFiltersClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
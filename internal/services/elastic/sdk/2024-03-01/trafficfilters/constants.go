@@ -0,0 +1,31 @@
+package trafficfilters
+
+import "strings"
+
+type Type string
+
+const (
+	TypeAzurePrivateEndpoint Type = "azure_private_endpoint"
+	TypeIP                   Type = "ip"
+)
+
+func PossibleValuesForType() []string {
+	return []string{
+		string(TypeAzurePrivateEndpoint),
+		string(TypeIP),
+	}
+}
+
+func parseType(input string) (*Type, error) {
+	vals := map[string]Type{
+		"azure_private_endpoint": TypeAzurePrivateEndpoint,
+		"ip":                     TypeIP,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := Type(input)
+	return &out, nil
+}
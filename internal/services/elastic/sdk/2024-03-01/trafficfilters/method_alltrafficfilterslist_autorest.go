@@ -0,0 +1,65 @@
+package trafficfilters
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type AllTrafficFiltersListResponse struct {
+	HttpResponse *http.Response
+	Model        *ElasticTrafficFilterResponse
+}
+
+// AllTrafficFiltersList ...
+func (c TrafficFiltersClient) AllTrafficFiltersList(ctx context.Context, id MonitorId) (result AllTrafficFiltersListResponse, err error) {
+	req, err := c.preparerForAllTrafficFiltersList(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "trafficfilters.TrafficFiltersClient", "AllTrafficFiltersList", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "trafficfilters.TrafficFiltersClient", "AllTrafficFiltersList", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForAllTrafficFiltersList(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "trafficfilters.TrafficFiltersClient", "AllTrafficFiltersList", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForAllTrafficFiltersList prepares the AllTrafficFiltersList request.
+func (c TrafficFiltersClient) preparerForAllTrafficFiltersList(ctx context.Context, id MonitorId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPost(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(fmt.Sprintf("%s/listAllTrafficFilters", id.ID())),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForAllTrafficFiltersList handles the response to the AllTrafficFiltersList request. The method always
+// closes the http.Response Body.
+func (c TrafficFiltersClient) responderForAllTrafficFiltersList(resp *http.Response) (result AllTrafficFiltersListResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
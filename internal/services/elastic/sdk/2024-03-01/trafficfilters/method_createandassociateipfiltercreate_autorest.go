@@ -0,0 +1,108 @@
+package trafficfilters
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+type CreateAndAssociateIPFilterCreateResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+type CreateAndAssociateIPFilterCreateOperationOptions struct {
+	Ips  *string
+	Name *string
+}
+
+func DefaultCreateAndAssociateIPFilterCreateOperationOptions() CreateAndAssociateIPFilterCreateOperationOptions {
+	return CreateAndAssociateIPFilterCreateOperationOptions{}
+}
+
+func (o CreateAndAssociateIPFilterCreateOperationOptions) toHeaders() map[string]interface{} {
+	out := make(map[string]interface{})
+
+	return out
+}
+
+func (o CreateAndAssociateIPFilterCreateOperationOptions) toQueryString() map[string]interface{} {
+	out := make(map[string]interface{})
+
+	if o.Ips != nil {
+		out["ips"] = *o.Ips
+	}
+
+	if o.Name != nil {
+		out["name"] = *o.Name
+	}
+
+	return out
+}
+
+// CreateAndAssociateIPFilterCreate ...
+func (c TrafficFiltersClient) CreateAndAssociateIPFilterCreate(ctx context.Context, id MonitorId, options CreateAndAssociateIPFilterCreateOperationOptions) (result CreateAndAssociateIPFilterCreateResponse, err error) {
+	req, err := c.preparerForCreateAndAssociateIPFilterCreate(ctx, id, options)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "trafficfilters.TrafficFiltersClient", "CreateAndAssociateIPFilterCreate", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForCreateAndAssociateIPFilterCreate(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "trafficfilters.TrafficFiltersClient", "CreateAndAssociateIPFilterCreate", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// CreateAndAssociateIPFilterCreateThenPoll performs CreateAndAssociateIPFilterCreate then polls until it's completed
+func (c TrafficFiltersClient) CreateAndAssociateIPFilterCreateThenPoll(ctx context.Context, id MonitorId, options CreateAndAssociateIPFilterCreateOperationOptions) error {
+	result, err := c.CreateAndAssociateIPFilterCreate(ctx, id, options)
+	if err != nil {
+		return fmt.Errorf("performing CreateAndAssociateIPFilterCreate: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after CreateAndAssociateIPFilterCreate: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForCreateAndAssociateIPFilterCreate prepares the CreateAndAssociateIPFilterCreate request.
+func (c TrafficFiltersClient) preparerForCreateAndAssociateIPFilterCreate(ctx context.Context, id MonitorId, options CreateAndAssociateIPFilterCreateOperationOptions) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	for k, v := range options.toQueryString() {
+		queryParameters[k] = autorest.Encode("query", v)
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPost(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithHeaders(options.toHeaders()),
+		autorest.WithPath(fmt.Sprintf("%s/createAndAssociateIPFilter", id.ID())),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForCreateAndAssociateIPFilterCreate sends the CreateAndAssociateIPFilterCreate request. The method will close the
+// http.Response Body if it receives an error.
+func (c TrafficFiltersClient) senderForCreateAndAssociateIPFilterCreate(ctx context.Context, req *http.Request) (future CreateAndAssociateIPFilterCreateResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
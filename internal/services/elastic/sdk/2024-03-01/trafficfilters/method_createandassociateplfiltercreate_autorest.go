@@ -0,0 +1,113 @@
+package trafficfilters
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+type CreateAndAssociatePLFilterCreateResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+type CreateAndAssociatePLFilterCreateOperationOptions struct {
+	Name                *string
+	PrivateEndpointGuid *string
+	PrivateEndpointName *string
+}
+
+func DefaultCreateAndAssociatePLFilterCreateOperationOptions() CreateAndAssociatePLFilterCreateOperationOptions {
+	return CreateAndAssociatePLFilterCreateOperationOptions{}
+}
+
+func (o CreateAndAssociatePLFilterCreateOperationOptions) toHeaders() map[string]interface{} {
+	out := make(map[string]interface{})
+
+	return out
+}
+
+func (o CreateAndAssociatePLFilterCreateOperationOptions) toQueryString() map[string]interface{} {
+	out := make(map[string]interface{})
+
+	if o.Name != nil {
+		out["name"] = *o.Name
+	}
+
+	if o.PrivateEndpointGuid != nil {
+		out["privateEndpointGuid"] = *o.PrivateEndpointGuid
+	}
+
+	if o.PrivateEndpointName != nil {
+		out["privateEndpointName"] = *o.PrivateEndpointName
+	}
+
+	return out
+}
+
+// CreateAndAssociatePLFilterCreate ...
+func (c TrafficFiltersClient) CreateAndAssociatePLFilterCreate(ctx context.Context, id MonitorId, options CreateAndAssociatePLFilterCreateOperationOptions) (result CreateAndAssociatePLFilterCreateResponse, err error) {
+	req, err := c.preparerForCreateAndAssociatePLFilterCreate(ctx, id, options)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "trafficfilters.TrafficFiltersClient", "CreateAndAssociatePLFilterCreate", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForCreateAndAssociatePLFilterCreate(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "trafficfilters.TrafficFiltersClient", "CreateAndAssociatePLFilterCreate", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// CreateAndAssociatePLFilterCreateThenPoll performs CreateAndAssociatePLFilterCreate then polls until it's completed
+func (c TrafficFiltersClient) CreateAndAssociatePLFilterCreateThenPoll(ctx context.Context, id MonitorId, options CreateAndAssociatePLFilterCreateOperationOptions) error {
+	result, err := c.CreateAndAssociatePLFilterCreate(ctx, id, options)
+	if err != nil {
+		return fmt.Errorf("performing CreateAndAssociatePLFilterCreate: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after CreateAndAssociatePLFilterCreate: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForCreateAndAssociatePLFilterCreate prepares the CreateAndAssociatePLFilterCreate request.
+func (c TrafficFiltersClient) preparerForCreateAndAssociatePLFilterCreate(ctx context.Context, id MonitorId, options CreateAndAssociatePLFilterCreateOperationOptions) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	for k, v := range options.toQueryString() {
+		queryParameters[k] = autorest.Encode("query", v)
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPost(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithHeaders(options.toHeaders()),
+		autorest.WithPath(fmt.Sprintf("%s/createAndAssociatePLFilter", id.ID())),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForCreateAndAssociatePLFilterCreate sends the CreateAndAssociatePLFilterCreate request. The method will close the
+// http.Response Body if it receives an error.
+func (c TrafficFiltersClient) senderForCreateAndAssociatePLFilterCreate(ctx context.Context, req *http.Request) (future CreateAndAssociatePLFilterCreateResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
@@ -0,0 +1,92 @@
+package trafficfilters
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type DetachAndDeleteTrafficFilterDeleteResponse struct {
+	HttpResponse *http.Response
+}
+
+type DetachAndDeleteTrafficFilterDeleteOperationOptions struct {
+	RulesetId *string
+}
+
+func DefaultDetachAndDeleteTrafficFilterDeleteOperationOptions() DetachAndDeleteTrafficFilterDeleteOperationOptions {
+	return DetachAndDeleteTrafficFilterDeleteOperationOptions{}
+}
+
+func (o DetachAndDeleteTrafficFilterDeleteOperationOptions) toHeaders() map[string]interface{} {
+	out := make(map[string]interface{})
+
+	return out
+}
+
+func (o DetachAndDeleteTrafficFilterDeleteOperationOptions) toQueryString() map[string]interface{} {
+	out := make(map[string]interface{})
+
+	if o.RulesetId != nil {
+		out["rulesetId"] = *o.RulesetId
+	}
+
+	return out
+}
+
+// DetachAndDeleteTrafficFilterDelete ...
+func (c TrafficFiltersClient) DetachAndDeleteTrafficFilterDelete(ctx context.Context, id MonitorId, options DetachAndDeleteTrafficFilterDeleteOperationOptions) (result DetachAndDeleteTrafficFilterDeleteResponse, err error) {
+	req, err := c.preparerForDetachAndDeleteTrafficFilterDelete(ctx, id, options)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "trafficfilters.TrafficFiltersClient", "DetachAndDeleteTrafficFilterDelete", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "trafficfilters.TrafficFiltersClient", "DetachAndDeleteTrafficFilterDelete", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForDetachAndDeleteTrafficFilterDelete(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "trafficfilters.TrafficFiltersClient", "DetachAndDeleteTrafficFilterDelete", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForDetachAndDeleteTrafficFilterDelete prepares the DetachAndDeleteTrafficFilterDelete request.
+func (c TrafficFiltersClient) preparerForDetachAndDeleteTrafficFilterDelete(ctx context.Context, id MonitorId, options DetachAndDeleteTrafficFilterDeleteOperationOptions) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	for k, v := range options.toQueryString() {
+		queryParameters[k] = autorest.Encode("query", v)
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPost(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithHeaders(options.toHeaders()),
+		autorest.WithPath(fmt.Sprintf("%s/detachAndDeleteTrafficFilter", id.ID())),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForDetachAndDeleteTrafficFilterDelete handles the response to the DetachAndDeleteTrafficFilterDelete request. The method always
+// closes the http.Response Body.
+func (c TrafficFiltersClient) responderForDetachAndDeleteTrafficFilterDelete(resp *http.Response) (result DetachAndDeleteTrafficFilterDeleteResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
@@ -0,0 +1,11 @@
+package trafficfilters
+
+type ElasticTrafficFilter struct {
+	Description      *string                     `json:"description,omitempty"`
+	Id               *string                     `json:"id,omitempty"`
+	IncludeByDefault *bool                       `json:"includeByDefault,omitempty"`
+	Name             *string                     `json:"name,omitempty"`
+	Region           *string                     `json:"region,omitempty"`
+	Rules            *[]ElasticTrafficFilterRule `json:"rules,omitempty"`
+	Type             *Type                       `json:"type,omitempty"`
+}
@@ -0,0 +1,5 @@
+package trafficfilters
+
+type ElasticTrafficFilterResponse struct {
+	Rulesets *[]ElasticTrafficFilter `json:"rulesets,omitempty"`
+}
@@ -0,0 +1,9 @@
+package trafficfilters
+
+type ElasticTrafficFilterRule struct {
+	AzureEndpointGuid *string `json:"azureEndpointGuid,omitempty"`
+	AzureEndpointName *string `json:"azureEndpointName,omitempty"`
+	Description       *string `json:"description,omitempty"`
+	Id                *string `json:"id,omitempty"`
+	Source            *string `json:"source,omitempty"`
+}
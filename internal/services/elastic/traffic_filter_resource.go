@@ -0,0 +1,266 @@
+package elastic
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/elastic/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/elastic/sdk/2024-03-01/trafficfilters"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/elastic/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.Resource = TrafficFilterResource{}
+
+type TrafficFilterResource struct{}
+
+type TrafficFilterResourceModel struct {
+	Name                        string   `tfschema:"name"`
+	ElasticCloudElasticsearchId string   `tfschema:"elastic_cloud_elasticsearch_id"`
+	Type                        string   `tfschema:"type"`
+	IPAddresses                 []string `tfschema:"ip_addresses"`
+	PrivateEndpointGuid         string   `tfschema:"private_endpoint_guid"`
+	PrivateEndpointName         string   `tfschema:"private_endpoint_name"`
+	RulesetId                   string   `tfschema:"ruleset_id"`
+}
+
+func (r TrafficFilterResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"elastic_cloud_elasticsearch_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: trafficfilters.ValidateMonitorID,
+		},
+
+		"type": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(trafficfilters.PossibleValuesForType(), false),
+		},
+
+		"ip_addresses": {
+			Type:          pluginsdk.TypeList,
+			Optional:      true,
+			ForceNew:      true,
+			MinItems:      1,
+			ConflictsWith: []string{"private_endpoint_guid", "private_endpoint_name"},
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"private_endpoint_guid": {
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			ForceNew:      true,
+			ValidateFunc:  validation.IsUUID,
+			RequiredWith:  []string{"private_endpoint_name"},
+			ConflictsWith: []string{"ip_addresses"},
+		},
+
+		"private_endpoint_name": {
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			ForceNew:      true,
+			ValidateFunc:  validation.StringIsNotEmpty,
+			RequiredWith:  []string{"private_endpoint_guid"},
+			ConflictsWith: []string{"ip_addresses"},
+		},
+	}
+}
+
+func (r TrafficFilterResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"ruleset_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r TrafficFilterResource) ModelObject() interface{} {
+	return &TrafficFilterResourceModel{}
+}
+
+func (r TrafficFilterResource) ResourceType() string {
+	return "azurerm_elastic_cloud_traffic_filter"
+}
+
+func (r TrafficFilterResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.TrafficFilterID
+}
+
+func (r TrafficFilterResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Elastic.TrafficFiltersClient
+
+			var model TrafficFilterResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			monitorId, err := trafficfilters.ParseMonitorID(model.ElasticCloudElasticsearchId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewTrafficFilterID(monitorId.SubscriptionId, monitorId.ResourceGroupName, monitorId.MonitorName, model.Name)
+
+			// Traffic Filters aren't individually addressable, so look the Rule Set up by name
+			existing, err := findTrafficFilter(ctx, client, id)
+			if err != nil {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if existing != nil {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			switch trafficfilters.Type(model.Type) {
+			case trafficfilters.TypeIP:
+				if len(model.IPAddresses) == 0 {
+					return fmt.Errorf("`ip_addresses` must be specified when `type` is `%s`", trafficfilters.TypeIP)
+				}
+				options := trafficfilters.CreateAndAssociateIPFilterCreateOperationOptions{
+					Ips:  utils.String(strings.Join(model.IPAddresses, ",")),
+					Name: utils.String(model.Name),
+				}
+				if err := client.CreateAndAssociateIPFilterCreateThenPoll(ctx, *monitorId, options); err != nil {
+					return fmt.Errorf("creating %s: %+v", id, err)
+				}
+
+			case trafficfilters.TypeAzurePrivateEndpoint:
+				if model.PrivateEndpointGuid == "" || model.PrivateEndpointName == "" {
+					return fmt.Errorf("`private_endpoint_guid` and `private_endpoint_name` must be specified when `type` is `%s`", trafficfilters.TypeAzurePrivateEndpoint)
+				}
+				options := trafficfilters.CreateAndAssociatePLFilterCreateOperationOptions{
+					Name:                utils.String(model.Name),
+					PrivateEndpointGuid: utils.String(model.PrivateEndpointGuid),
+					PrivateEndpointName: utils.String(model.PrivateEndpointName),
+				}
+				if err := client.CreateAndAssociatePLFilterCreateThenPoll(ctx, *monitorId, options); err != nil {
+					return fmt.Errorf("creating %s: %+v", id, err)
+				}
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r TrafficFilterResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Elastic.TrafficFiltersClient
+
+			id, err := parse.TrafficFilterID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			filter, err := findTrafficFilter(ctx, client, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if filter == nil {
+				return metadata.MarkAsGone(id)
+			}
+
+			state := TrafficFilterResourceModel{
+				Name:                        id.Name,
+				ElasticCloudElasticsearchId: trafficfilters.NewMonitorID(id.SubscriptionId, id.ResourceGroup, id.MonitorName).ID(),
+				RulesetId:                   utils.NormalizeNilableString(filter.Id),
+			}
+
+			if filter.Type != nil {
+				state.Type = string(*filter.Type)
+			}
+
+			if filter.Rules != nil {
+				for _, rule := range *filter.Rules {
+					switch trafficfilters.Type(state.Type) {
+					case trafficfilters.TypeIP:
+						state.IPAddresses = append(state.IPAddresses, utils.NormalizeNilableString(rule.Source))
+					case trafficfilters.TypeAzurePrivateEndpoint:
+						state.PrivateEndpointGuid = utils.NormalizeNilableString(rule.AzureEndpointGuid)
+						state.PrivateEndpointName = utils.NormalizeNilableString(rule.AzureEndpointName)
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r TrafficFilterResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Elastic.TrafficFiltersClient
+
+			id, err := parse.TrafficFilterID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			filter, err := findTrafficFilter(ctx, client, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if filter == nil {
+				return nil
+			}
+
+			monitorId := trafficfilters.NewMonitorID(id.SubscriptionId, id.ResourceGroup, id.MonitorName)
+			options := trafficfilters.DetachAndDeleteTrafficFilterDeleteOperationOptions{
+				RulesetId: filter.Id,
+			}
+			if _, err := client.DetachAndDeleteTrafficFilterDelete(ctx, monitorId, options); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// findTrafficFilter returns the Rule Set matching the name of the Traffic Filter, or nil if it doesn't exist
+func findTrafficFilter(ctx context.Context, client *trafficfilters.TrafficFiltersClient, id parse.TrafficFilterId) (*trafficfilters.ElasticTrafficFilter, error) {
+	monitorId := trafficfilters.NewMonitorID(id.SubscriptionId, id.ResourceGroup, id.MonitorName)
+	resp, err := client.AllTrafficFiltersList(ctx, monitorId)
+	if err != nil {
+		return nil, fmt.Errorf("listing Traffic Filters for %s: %+v", monitorId, err)
+	}
+
+	if resp.Model == nil || resp.Model.Rulesets == nil {
+		return nil, nil
+	}
+
+	for _, v := range *resp.Model.Rulesets {
+		if v.Name != nil && *v.Name == id.Name {
+			filter := v
+			return &filter, nil
+		}
+	}
+
+	return nil, nil
+}
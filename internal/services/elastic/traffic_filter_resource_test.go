@@ -0,0 +1,97 @@
+package elastic_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/elastic/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/elastic/sdk/2024-03-01/trafficfilters"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type TrafficFilterResource struct{}
+
+func TestAccTrafficFilter_ip(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_elastic_cloud_traffic_filter", "test")
+	r := TrafficFilterResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.ip(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("ruleset_id").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccTrafficFilter_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_elastic_cloud_traffic_filter", "test")
+	r := TrafficFilterResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.ip(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (r TrafficFilterResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.TrafficFilterID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	monitorId := trafficfilters.NewMonitorID(id.SubscriptionId, id.ResourceGroup, id.MonitorName)
+	resp, err := client.Elastic.TrafficFiltersClient.AllTrafficFiltersList(ctx, monitorId)
+	if err != nil {
+		return nil, fmt.Errorf("listing Traffic Filters for %s: %+v", monitorId, err)
+	}
+
+	if resp.Model != nil && resp.Model.Rulesets != nil {
+		for _, v := range *resp.Model.Rulesets {
+			if v.Name != nil && *v.Name == id.Name {
+				return utils.Bool(true), nil
+			}
+		}
+	}
+
+	return utils.Bool(false), nil
+}
+
+func (r TrafficFilterResource) ip(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_elastic_cloud_traffic_filter" "test" {
+  name                           = "acctest-tf%d"
+  elastic_cloud_elasticsearch_id = azurerm_elastic_cloud_elasticsearch.test.id
+  type                           = "ip"
+  ip_addresses                   = ["10.0.0.0/16", "192.168.0.1"]
+}
+`, ElasticsearchResource{}.basic(data), data.RandomInteger)
+}
+
+func (r TrafficFilterResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_elastic_cloud_traffic_filter" "import" {
+  name                           = azurerm_elastic_cloud_traffic_filter.test.name
+  elastic_cloud_elasticsearch_id = azurerm_elastic_cloud_traffic_filter.test.elastic_cloud_elasticsearch_id
+  type                           = azurerm_elastic_cloud_traffic_filter.test.type
+  ip_addresses                   = azurerm_elastic_cloud_traffic_filter.test.ip_addresses
+}
+`, r.ip(data))
+}
@@ -0,0 +1,289 @@
+package eventhub
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub/sdk/2021-01-01-preview/namespaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub/sdk/2022-01-01-preview/applicationgroups"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type NamespaceApplicationGroupObject struct {
+	Name                     string                                      `tfschema:"name"`
+	NamespaceId              string                                      `tfschema:"namespace_id"`
+	ClientAppGroupIdentifier string                                      `tfschema:"client_app_group_identifier"`
+	Enabled                  bool                                        `tfschema:"enabled"`
+	ThrottlingPolicies       []NamespaceApplicationGroupThrottlingPolicy `tfschema:"throttling_policy"`
+}
+
+type NamespaceApplicationGroupThrottlingPolicy struct {
+	Name               string `tfschema:"name"`
+	MetricId           string `tfschema:"metric_id"`
+	RateLimitThreshold int    `tfschema:"rate_limit_threshold"`
+}
+
+var _ sdk.Resource = NamespaceApplicationGroupResource{}
+var _ sdk.ResourceWithUpdate = NamespaceApplicationGroupResource{}
+
+type NamespaceApplicationGroupResource struct {
+}
+
+func (r NamespaceApplicationGroupResource) ResourceType() string {
+	return "azurerm_eventhub_namespace_application_group"
+}
+
+func (r NamespaceApplicationGroupResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9._-]{0,254}$`),
+				"The name can contain only letters, numbers, periods, hyphens and underscores, must start with a letter or number and can be up to 255 characters long.",
+			),
+		},
+
+		"namespace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: namespaces.ValidateNamespaceID,
+		},
+
+		// this is either the name of a Shared Access Policy (`SASKeyName=...`) or the Client ID of an Azure Active Directory Application (`AADAppId=...`)
+		"client_app_group_identifier": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^(SASKeyName|AADAppId)=.+$`),
+				"The `client_app_group_identifier` must be in the format `SASKeyName={name}` or `AADAppId={clientId}`.",
+			),
+		},
+
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"throttling_policy": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"metric_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(applicationgroups.PossibleValuesForMetricId(), false),
+					},
+
+					"rate_limit_threshold": {
+						Type:         pluginsdk.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r NamespaceApplicationGroupResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r NamespaceApplicationGroupResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			metadata.Logger.Info("Decoding state..")
+			var state NamespaceApplicationGroupObject
+			if err := metadata.Decode(&state); err != nil {
+				return err
+			}
+
+			client := metadata.Client.Eventhub.ApplicationGroupsClient
+
+			namespaceId, err := namespaces.ParseNamespaceID(state.NamespaceId)
+			if err != nil {
+				return err
+			}
+
+			id := applicationgroups.NewApplicationGroupID(namespaceId.SubscriptionId, namespaceId.ResourceGroupName, namespaceId.NamespaceName, state.Name)
+			metadata.Logger.Infof("creating %s..", id)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			parameters := applicationgroups.ApplicationGroup{
+				Properties: &applicationgroups.ApplicationGroupProperties{
+					ClientAppGroupIdentifier: state.ClientAppGroupIdentifier,
+					IsEnabled:                utils.Bool(state.Enabled),
+					Policies:                 expandNamespaceApplicationGroupThrottlingPolicies(state.ThrottlingPolicies),
+				},
+			}
+
+			if _, err := client.CreateOrUpdateApplicationGroup(ctx, id, parameters); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+func (r NamespaceApplicationGroupResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := applicationgroups.ParseApplicationGroupID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			metadata.Logger.Info("Decoding state..")
+			var state NamespaceApplicationGroupObject
+			if err := metadata.Decode(&state); err != nil {
+				return err
+			}
+
+			metadata.Logger.Infof("updating %s..", *id)
+			client := metadata.Client.Eventhub.ApplicationGroupsClient
+
+			parameters := applicationgroups.ApplicationGroup{
+				Properties: &applicationgroups.ApplicationGroupProperties{
+					ClientAppGroupIdentifier: state.ClientAppGroupIdentifier,
+					IsEnabled:                utils.Bool(state.Enabled),
+					Policies:                 expandNamespaceApplicationGroupThrottlingPolicies(state.ThrottlingPolicies),
+				},
+			}
+
+			if _, err := client.CreateOrUpdateApplicationGroup(ctx, *id, parameters); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+func (r NamespaceApplicationGroupResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Eventhub.ApplicationGroupsClient
+			id, err := applicationgroups.ParseApplicationGroupID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			metadata.Logger.Infof("retrieving %s..", *id)
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := NamespaceApplicationGroupObject{
+				Name:        id.ApplicationGroupName,
+				NamespaceId: namespaces.NewNamespaceID(id.SubscriptionId, id.ResourceGroupName, id.NamespaceName).ID(),
+			}
+
+			if model := resp.Model; model != nil && model.Properties != nil {
+				props := model.Properties
+				state.ClientAppGroupIdentifier = props.ClientAppGroupIdentifier
+				state.Enabled = props.IsEnabled != nil && *props.IsEnabled
+				state.ThrottlingPolicies = flattenNamespaceApplicationGroupThrottlingPolicies(props.Policies)
+			}
+
+			return metadata.Encode(&state)
+		},
+		Timeout: 5 * time.Minute,
+	}
+}
+
+func (r NamespaceApplicationGroupResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Eventhub.ApplicationGroupsClient
+			id, err := applicationgroups.ParseApplicationGroupID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			metadata.Logger.Infof("deleting %s..", *id)
+			if resp, err := client.Delete(ctx, *id); err != nil {
+				if !response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("deleting %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+func (r NamespaceApplicationGroupResource) ModelObject() interface{} {
+	return &NamespaceApplicationGroupObject{}
+}
+
+func (r NamespaceApplicationGroupResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return applicationgroups.ValidateApplicationGroupID
+}
+
+func expandNamespaceApplicationGroupThrottlingPolicies(input []NamespaceApplicationGroupThrottlingPolicy) *[]applicationgroups.ApplicationGroupPolicy {
+	policies := make([]applicationgroups.ApplicationGroupPolicy, 0)
+	for _, v := range input {
+		policies = append(policies, applicationgroups.ApplicationGroupPolicy{
+			Name:               v.Name,
+			Type:               applicationgroups.ApplicationGroupPolicyTypeThrottlingPolicy,
+			MetricId:           applicationgroups.MetricId(v.MetricId),
+			RateLimitThreshold: int64(v.RateLimitThreshold),
+		})
+	}
+	return &policies
+}
+
+func flattenNamespaceApplicationGroupThrottlingPolicies(input *[]applicationgroups.ApplicationGroupPolicy) []NamespaceApplicationGroupThrottlingPolicy {
+	policies := make([]NamespaceApplicationGroupThrottlingPolicy, 0)
+	if input == nil {
+		return policies
+	}
+
+	for _, v := range *input {
+		// Throttling Policies are the only type of policy at this time, but guard against new types being added
+		if v.Type != applicationgroups.ApplicationGroupPolicyTypeThrottlingPolicy {
+			continue
+		}
+
+		policies = append(policies, NamespaceApplicationGroupThrottlingPolicy{
+			Name:               v.Name,
+			MetricId:           string(v.MetricId),
+			RateLimitThreshold: int(v.RateLimitThreshold),
+		})
+	}
+	return policies
+}
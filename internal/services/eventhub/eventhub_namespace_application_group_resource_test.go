@@ -0,0 +1,186 @@
+package eventhub_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub/sdk/2022-01-01-preview/applicationgroups"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type EventHubNamespaceApplicationGroupResource struct {
+}
+
+func TestAccEventHubNamespaceApplicationGroup_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventhub_namespace_application_group", "test")
+	r := EventHubNamespaceApplicationGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccEventHubNamespaceApplicationGroup_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventhub_namespace_application_group", "test")
+	r := EventHubNamespaceApplicationGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config:      r.requiresImport(data),
+			ExpectError: acceptance.RequiresImportError("azurerm_eventhub_namespace_application_group"),
+		},
+	})
+}
+
+func TestAccEventHubNamespaceApplicationGroup_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventhub_namespace_application_group", "test")
+	r := EventHubNamespaceApplicationGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("throttling_policy.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccEventHubNamespaceApplicationGroup_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventhub_namespace_application_group", "test")
+	r := EventHubNamespaceApplicationGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("throttling_policy.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (EventHubNamespaceApplicationGroupResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := applicationgroups.ParseApplicationGroupID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Eventhub.ApplicationGroupsClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (EventHubNamespaceApplicationGroupResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-eventhub-%d"
+  location = "%s"
+}
+
+resource "azurerm_eventhub_namespace" "test" {
+  name                = "acctesteventhubnamespace-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "Standard"
+}
+
+resource "azurerm_eventhub_namespace_authorization_rule" "test" {
+  name                = "acctest-producer-%d"
+  namespace_name      = azurerm_eventhub_namespace.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  send                = true
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
+}
+
+func (r EventHubNamespaceApplicationGroupResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventhub_namespace_application_group" "test" {
+  name                        = "acctest-appgroup-%d"
+  namespace_id                = azurerm_eventhub_namespace.test.id
+  client_app_group_identifier = "SASKeyName=${azurerm_eventhub_namespace_authorization_rule.test.name}"
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r EventHubNamespaceApplicationGroupResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventhub_namespace_application_group" "import" {
+  name                        = azurerm_eventhub_namespace_application_group.test.name
+  namespace_id                = azurerm_eventhub_namespace_application_group.test.namespace_id
+  client_app_group_identifier = azurerm_eventhub_namespace_application_group.test.client_app_group_identifier
+}
+`, r.basic(data))
+}
+
+func (r EventHubNamespaceApplicationGroupResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventhub_namespace_application_group" "test" {
+  name                        = "acctest-appgroup-%d"
+  namespace_id                = azurerm_eventhub_namespace.test.id
+  client_app_group_identifier = "SASKeyName=${azurerm_eventhub_namespace_authorization_rule.test.name}"
+  enabled                     = false
+
+  throttling_policy {
+    name                 = "incoming-messages"
+    metric_id            = "IncomingMessages"
+    rate_limit_threshold = 1000
+  }
+
+  throttling_policy {
+    name                 = "incoming-bytes"
+    metric_id            = "IncomingBytes"
+    rate_limit_threshold = 1048576
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
@@ -228,22 +228,23 @@ func resourceEventHubNamespace() *pluginsdk.Resource {
 
 			"tags": tags.Schema(),
 		},
-		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, d *pluginsdk.ResourceDiff, v interface{}) error {
-			oldSku, newSku := d.GetChange("sku")
-			if d.HasChange("sku") {
-				if strings.EqualFold(newSku.(string), string(namespaces.SkuNamePremium)) || strings.EqualFold(oldSku.(string), string(namespaces.SkuTierPremium)) {
-					log.Printf("[DEBUG] cannot migrate a namespace from or to Premium SKU")
-					d.ForceNew("sku")
-				}
-				if strings.EqualFold(newSku.(string), string(namespaces.SkuTierPremium)) {
+		CustomizeDiff: pluginsdk.CustomDiffInSequence(
+			// a Namespace can be changed between the Basic and Standard tiers in-place, but not from or to Premium
+			pluginsdk.ForceNewUnlessTransitionSupported("sku", map[string][]string{
+				string(namespaces.SkuNameBasic):    {string(namespaces.SkuNameStandard)},
+				string(namespaces.SkuNameStandard): {string(namespaces.SkuNameBasic)},
+			}),
+			func(ctx context.Context, d *pluginsdk.ResourceDiff, v interface{}) error {
+				_, newSku := d.GetChange("sku")
+				if d.HasChange("sku") && strings.EqualFold(newSku.(string), string(namespaces.SkuTierPremium)) {
 					zoneRedundant := d.Get("zone_redundant").(bool)
 					if !zoneRedundant {
 						return fmt.Errorf("zone_redundant needs to be set to true when using premium SKU")
 					}
 				}
-			}
-			return nil
-		}),
+				return nil
+			},
+		),
 	}
 }
 
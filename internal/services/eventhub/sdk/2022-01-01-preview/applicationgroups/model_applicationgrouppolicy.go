@@ -0,0 +1,8 @@
+package applicationgroups
+
+type ApplicationGroupPolicy struct {
+	MetricId           MetricId                   `json:"metricId"`
+	Name               string                     `json:"name"`
+	RateLimitThreshold int64                      `json:"rateLimitThreshold"`
+	Type               ApplicationGroupPolicyType `json:"type"`
+}
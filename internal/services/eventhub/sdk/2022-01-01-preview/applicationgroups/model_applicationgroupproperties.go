@@ -0,0 +1,7 @@
+package applicationgroups
+
+type ApplicationGroupProperties struct {
+	ClientAppGroupIdentifier string                    `json:"clientAppGroupIdentifier"`
+	IsEnabled                *bool                     `json:"isEnabled,omitempty"`
+	Policies                 *[]ApplicationGroupPolicy `json:"policies,omitempty"`
+}
@@ -131,10 +131,12 @@ func resourceFrontDoorFirewallPolicy() *pluginsdk.Resource {
 							}, false),
 						},
 
+						// the rate limit window can only be one or five minutes
 						"rate_limit_duration_in_minutes": {
-							Type:     pluginsdk.TypeInt,
-							Optional: true,
-							Default:  1,
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validation.IntInSlice([]int{1, 5}),
 						},
 
 						"rate_limit_threshold": {
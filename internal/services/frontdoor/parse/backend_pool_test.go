@@ -231,6 +231,17 @@ func TestBackendPoolIDInsensitively(t *testing.T) {
 				Name:           "pool1",
 			},
 		},
+
+		{
+			// upper-cased root segment names
+			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/Microsoft.Network/frontDoors/frontdoor1/backendPools/pool1",
+			Expected: &BackendPoolId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "resGroup1",
+				FrontDoorName:  "frontdoor1",
+				Name:           "pool1",
+			},
+		},
 	}
 
 	for _, v := range testData {
@@ -231,6 +231,17 @@ func TestCustomHttpsConfigurationIDInsensitively(t *testing.T) {
 				CustomHttpsConfigurationName: "endpoint1",
 			},
 		},
+
+		{
+			// upper-cased root segment names
+			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/Microsoft.Network/frontDoors/frontdoor1/customHttpsConfiguration/endpoint1",
+			Expected: &CustomHttpsConfigurationId{
+				SubscriptionId:               "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:                "resGroup1",
+				FrontDoorName:                "frontdoor1",
+				CustomHttpsConfigurationName: "endpoint1",
+			},
+		},
 	}
 
 	for _, v := range testData {
@@ -199,6 +199,16 @@ func TestFrontDoorIDInsensitively(t *testing.T) {
 				Name:           "frontdoor1",
 			},
 		},
+
+		{
+			// upper-cased root segment names
+			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/Microsoft.Network/frontDoors/frontdoor1",
+			Expected: &FrontDoorId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "resGroup1",
+				Name:           "frontdoor1",
+			},
+		},
 	}
 
 	for _, v := range testData {
@@ -231,6 +231,17 @@ func TestHealthProbeIDInsensitively(t *testing.T) {
 				HealthProbeSettingName: "probe1",
 			},
 		},
+
+		{
+			// upper-cased root segment names
+			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/Microsoft.Network/frontDoors/frontdoor1/healthProbeSettings/probe1",
+			Expected: &HealthProbeId{
+				SubscriptionId:         "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:          "resGroup1",
+				FrontDoorName:          "frontdoor1",
+				HealthProbeSettingName: "probe1",
+			},
+		},
 	}
 
 	for _, v := range testData {
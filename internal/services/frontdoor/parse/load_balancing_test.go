@@ -231,6 +231,17 @@ func TestLoadBalancingIDInsensitively(t *testing.T) {
 				LoadBalancingSettingName: "setting1",
 			},
 		},
+
+		{
+			// upper-cased root segment names
+			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/Microsoft.Network/frontDoors/frontdoor1/loadBalancingSettings/setting1",
+			Expected: &LoadBalancingId{
+				SubscriptionId:           "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:            "resGroup1",
+				FrontDoorName:            "frontdoor1",
+				LoadBalancingSettingName: "setting1",
+			},
+		},
 	}
 
 	for _, v := range testData {
@@ -231,6 +231,17 @@ func TestRulesEngineIDInsensitively(t *testing.T) {
 				Name:           "rule1",
 			},
 		},
+
+		{
+			// upper-cased root segment names
+			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/Microsoft.Network/frontdoors/frontdoor1/rulesengines/rule1",
+			Expected: &RulesEngineId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "resGroup1",
+				FrontdoorName:  "frontdoor1",
+				Name:           "rule1",
+			},
+		},
 	}
 
 	for _, v := range testData {
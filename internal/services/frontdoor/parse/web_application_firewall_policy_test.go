@@ -199,6 +199,16 @@ func TestWebApplicationFirewallPolicyIDInsensitively(t *testing.T) {
 				FrontDoorWebApplicationFirewallPolicyName: "policy1",
 			},
 		},
+
+		{
+			// upper-cased root segment names
+			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/Microsoft.Network/frontDoorWebApplicationFirewallPolicies/policy1",
+			Expected: &WebApplicationFirewallPolicyId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "resGroup1",
+				FrontDoorWebApplicationFirewallPolicyName: "policy1",
+			},
+		},
 	}
 
 	for _, v := range testData {
@@ -0,0 +1,19 @@
+package client
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/graphservices/sdk/2023-04-13/graphservicesprods"
+)
+
+type Client struct {
+	AccountsClient *graphservicesprods.GraphServicesProdsClient
+}
+
+func NewClient(o *common.ClientOptions) *Client {
+	accountsClient := graphservicesprods.NewGraphServicesProdsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&accountsClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		AccountsClient: &accountsClient,
+	}
+}
@@ -0,0 +1,15 @@
+package graphservicesprods
+
+import "github.com/Azure/go-autorest/autorest"
+
+type GraphServicesProdsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewGraphServicesProdsClientWithBaseURI(endpoint string) GraphServicesProdsClient {
+	return GraphServicesProdsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
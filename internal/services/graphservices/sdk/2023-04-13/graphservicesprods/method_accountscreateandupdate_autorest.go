@@ -0,0 +1,75 @@
+package graphservicesprods
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+type AccountsCreateAndUpdateResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// AccountsCreateAndUpdate ...
+func (c GraphServicesProdsClient) AccountsCreateAndUpdate(ctx context.Context, id AccountId, input AccountResource) (result AccountsCreateAndUpdateResponse, err error) {
+	req, err := c.preparerForAccountsCreateAndUpdate(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "graphservicesprods.GraphServicesProdsClient", "AccountsCreateAndUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForAccountsCreateAndUpdate(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "graphservicesprods.GraphServicesProdsClient", "AccountsCreateAndUpdate", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// AccountsCreateAndUpdateThenPoll performs AccountsCreateAndUpdate then polls until it's completed
+func (c GraphServicesProdsClient) AccountsCreateAndUpdateThenPoll(ctx context.Context, id AccountId, input AccountResource) error {
+	result, err := c.AccountsCreateAndUpdate(ctx, id, input)
+	if err != nil {
+		return fmt.Errorf("performing AccountsCreateAndUpdate: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after AccountsCreateAndUpdate: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForAccountsCreateAndUpdate prepares the AccountsCreateAndUpdate request.
+func (c GraphServicesProdsClient) preparerForAccountsCreateAndUpdate(ctx context.Context, id AccountId, input AccountResource) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForAccountsCreateAndUpdate sends the AccountsCreateAndUpdate request. The method will close the
+// http.Response Body if it receives an error.
+func (c GraphServicesProdsClient) senderForAccountsCreateAndUpdate(ctx context.Context, req *http.Request) (future AccountsCreateAndUpdateResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
@@ -0,0 +1,61 @@
+package graphservicesprods
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type AccountsDeleteResponse struct {
+	HttpResponse *http.Response
+}
+
+// AccountsDelete ...
+func (c GraphServicesProdsClient) AccountsDelete(ctx context.Context, id AccountId) (result AccountsDeleteResponse, err error) {
+	req, err := c.preparerForAccountsDelete(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "graphservicesprods.GraphServicesProdsClient", "AccountsDelete", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "graphservicesprods.GraphServicesProdsClient", "AccountsDelete", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForAccountsDelete(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "graphservicesprods.GraphServicesProdsClient", "AccountsDelete", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForAccountsDelete prepares the AccountsDelete request.
+func (c GraphServicesProdsClient) preparerForAccountsDelete(ctx context.Context, id AccountId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsDelete(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForAccountsDelete handles the response to the AccountsDelete request. The method always
+// closes the http.Response Body.
+func (c GraphServicesProdsClient) responderForAccountsDelete(resp *http.Response) (result AccountsDeleteResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusNoContent),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
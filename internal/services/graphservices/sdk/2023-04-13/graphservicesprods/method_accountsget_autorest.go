@@ -0,0 +1,64 @@
+package graphservicesprods
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type AccountsGetResponse struct {
+	HttpResponse *http.Response
+	Model        *AccountResource
+}
+
+// AccountsGet ...
+func (c GraphServicesProdsClient) AccountsGet(ctx context.Context, id AccountId) (result AccountsGetResponse, err error) {
+	req, err := c.preparerForAccountsGet(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "graphservicesprods.GraphServicesProdsClient", "AccountsGet", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "graphservicesprods.GraphServicesProdsClient", "AccountsGet", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForAccountsGet(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "graphservicesprods.GraphServicesProdsClient", "AccountsGet", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForAccountsGet prepares the AccountsGet request.
+func (c GraphServicesProdsClient) preparerForAccountsGet(ctx context.Context, id AccountId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForAccountsGet handles the response to the AccountsGet request. The method always
+// closes the http.Response Body.
+func (c GraphServicesProdsClient) responderForAccountsGet(resp *http.Response) (result AccountsGetResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
@@ -0,0 +1,65 @@
+package graphservicesprods
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type AccountsUpdateResponse struct {
+	HttpResponse *http.Response
+	Model        *AccountResource
+}
+
+// AccountsUpdate ...
+func (c GraphServicesProdsClient) AccountsUpdate(ctx context.Context, id AccountId, input TagUpdate) (result AccountsUpdateResponse, err error) {
+	req, err := c.preparerForAccountsUpdate(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "graphservicesprods.GraphServicesProdsClient", "AccountsUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "graphservicesprods.GraphServicesProdsClient", "AccountsUpdate", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForAccountsUpdate(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "graphservicesprods.GraphServicesProdsClient", "AccountsUpdate", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForAccountsUpdate prepares the AccountsUpdate request.
+func (c GraphServicesProdsClient) preparerForAccountsUpdate(ctx context.Context, id AccountId, input TagUpdate) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPatch(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForAccountsUpdate handles the response to the AccountsUpdate request. The method always
+// closes the http.Response Body.
+func (c GraphServicesProdsClient) responderForAccountsUpdate(resp *http.Response) (result AccountsUpdateResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
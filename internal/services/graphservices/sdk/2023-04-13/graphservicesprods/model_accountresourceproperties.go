@@ -0,0 +1,7 @@
+package graphservicesprods
+
+type AccountResourceProperties struct {
+	AppId             string             `json:"appId"`
+	BillingPlanId     *string            `json:"billingPlanId,omitempty"`
+	ProvisioningState *ProvisioningState `json:"provisioningState,omitempty"`
+}
@@ -0,0 +1,5 @@
+package graphservicesprods
+
+type TagUpdate struct {
+	Tags *map[string]string `json:"tags,omitempty"`
+}
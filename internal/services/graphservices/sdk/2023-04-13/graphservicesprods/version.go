@@ -0,0 +1,9 @@
+package graphservicesprods
+
+import "fmt"
+
+const defaultApiVersion = "2023-04-13"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/graphservicesprods/%s", defaultApiVersion)
+}
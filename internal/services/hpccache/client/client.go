@@ -3,10 +3,13 @@ package client
 import (
 	"github.com/Azure/azure-sdk-for-go/services/storagecache/mgmt/2021-09-01/storagecache"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/hpccache/sdk/2023-05-01/caches"
 )
 
 type Client struct {
 	CachesClient         *storagecache.CachesClient
+	PrimingJobsClient    *caches.CachesClient
+	StorageTargetClient  *storagecache.StorageTargetClient
 	StorageTargetsClient *storagecache.StorageTargetsClient
 }
 
@@ -14,11 +17,20 @@ func NewClient(options *common.ClientOptions) *Client {
 	cachesClient := storagecache.NewCachesClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
 	options.ConfigureClient(&cachesClient.Client, options.ResourceManagerAuthorizer)
 
+	// priming jobs are only available in 2022-05-01 and later, so are managed using an embedded SDK
+	primingJobsClient := caches.NewCachesClientWithBaseURI(options.ResourceManagerEndpoint)
+	options.ConfigureClient(&primingJobsClient.Client, options.ResourceManagerAuthorizer)
+
+	storageTargetClient := storagecache.NewStorageTargetClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
+	options.ConfigureClient(&storageTargetClient.Client, options.ResourceManagerAuthorizer)
+
 	storageTargetsClient := storagecache.NewStorageTargetsClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
 	options.ConfigureClient(&storageTargetsClient.Client, options.ResourceManagerAuthorizer)
 
 	return &Client{
 		CachesClient:         &cachesClient,
+		PrimingJobsClient:    &primingJobsClient,
+		StorageTargetClient:  &storageTargetClient,
 		StorageTargetsClient: &storageTargetsClient,
 	}
 }
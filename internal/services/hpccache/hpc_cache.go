@@ -1,9 +1,11 @@
 package hpccache
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/Azure/azure-sdk-for-go/services/storagecache/mgmt/2021-09-01/storagecache"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/hpccache/parse"
 )
 
 func CacheGetAccessPolicyRuleByScope(policyRules []storagecache.NfsAccessRule, scope storagecache.NfsAccessRuleScope) (storagecache.NfsAccessRule, bool) {
@@ -57,3 +59,36 @@ func CacheInsertOrUpdateAccessPolicy(policies []storagecache.NfsAccessPolicy, po
 
 	return append(newPolicies, policy), nil
 }
+
+// CacheSetStorageTargetSuspended suspends or resumes client access to a Storage Target - any data which
+// has been written to the HPC Cache is flushed to the Storage Target prior to it being suspended.
+func CacheSetStorageTargetSuspended(ctx context.Context, client *storagecache.StorageTargetClient, id parse.StorageTargetId, suspended bool) error {
+	if !suspended {
+		future, err := client.Resume(ctx, id.ResourceGroup, id.CacheName, id.Name)
+		if err != nil {
+			return fmt.Errorf("resuming %s: %+v", id, err)
+		}
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for %s to be resumed: %+v", id, err)
+		}
+		return nil
+	}
+
+	flushFuture, err := client.Flush(ctx, id.ResourceGroup, id.CacheName, id.Name)
+	if err != nil {
+		return fmt.Errorf("flushing %s: %+v", id, err)
+	}
+	if err := flushFuture.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for %s to be flushed: %+v", id, err)
+	}
+
+	suspendFuture, err := client.Suspend(ctx, id.ResourceGroup, id.CacheName, id.Name)
+	if err != nil {
+		return fmt.Errorf("suspending %s: %+v", id, err)
+	}
+	if err := suspendFuture.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for %s to be suspended: %+v", id, err)
+	}
+
+	return nil
+}
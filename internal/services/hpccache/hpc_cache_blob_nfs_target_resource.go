@@ -89,6 +89,12 @@ func resourceHPCCacheBlobNFSTarget() *pluginsdk.Resource {
 				Default:      "default",
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
+
+			"suspended": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 	}
 }
@@ -149,6 +155,13 @@ func resourceHPCCacheBlobNFSTargetCreateUpdate(d *pluginsdk.ResourceData, meta i
 		return fmt.Errorf("waiting for %s: %+v", id, err)
 	}
 
+	if d.HasChange("suspended") {
+		targetClient := meta.(*clients.Client).HPCCache.StorageTargetClient
+		if err := CacheSetStorageTargetSuspended(ctx, targetClient, id, d.Get("suspended").(bool)); err != nil {
+			return err
+		}
+	}
+
 	d.SetId(id.ID())
 
 	return resourceHPCCacheBlobNFSTargetRead(d, meta)
@@ -207,6 +220,7 @@ func resourceHPCCacheBlobNFSTargetRead(d *pluginsdk.ResourceData, meta interface
 		}
 		d.Set("namespace_path", namespacePath)
 		d.Set("access_policy_name", accessPolicy)
+		d.Set("suspended", props.State == storagecache.OperationalStateTypeSuspended)
 	}
 	return nil
 }
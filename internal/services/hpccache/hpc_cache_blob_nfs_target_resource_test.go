@@ -89,6 +89,37 @@ func TestAccHPCCacheBlobNFSTarget_update(t *testing.T) {
 	})
 }
 
+func TestAccHPCCacheBlobNFSTarget_suspended(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_hpc_cache_blob_nfs_target", "test")
+	r := HPCCacheBlobNFSTargetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.suspended(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("suspended").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("suspended").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccHPCCacheBlobNFSTarget_requiresImport(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_hpc_cache_blob_nfs_target", "test")
 	r := HPCCacheBlobNFSTargetResource{}
@@ -148,6 +179,22 @@ resource "azurerm_hpc_cache_blob_nfs_target" "test" {
 `, r.template(data), data.RandomString)
 }
 
+func (r HPCCacheBlobNFSTargetResource) suspended(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_hpc_cache_blob_nfs_target" "test" {
+  name                 = "acctest-HPCCTGT-%s"
+  resource_group_name  = azurerm_resource_group.test.name
+  cache_name           = azurerm_hpc_cache.test.name
+  storage_container_id = jsondecode(azurerm_resource_group_template_deployment.storage-containers.output_content).id.value
+  namespace_path       = "/p1"
+  usage_model          = "READ_HEAVY_INFREQ"
+  suspended            = true
+}
+`, r.template(data), data.RandomString)
+}
+
 func (r HPCCacheBlobNFSTargetResource) accessPolicy(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
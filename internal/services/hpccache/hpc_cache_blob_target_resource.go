@@ -73,6 +73,12 @@ func resourceHPCCacheBlobTarget() *pluginsdk.Resource {
 				Default:      "default",
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
+
+			"suspended": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 	}
 }
@@ -139,6 +145,15 @@ func resourceHPCCacheBlobTargetCreateOrUpdate(d *pluginsdk.ResourceData, meta in
 		return fmt.Errorf("retrieving HPC Cache Blob Target %q (Resource Group %q): `id` was nil", name, resourceGroup)
 	}
 
+	id := parse.NewStorageTargetID(meta.(*clients.Client).Account.SubscriptionId, resourceGroup, cache, name)
+
+	if d.HasChange("suspended") {
+		targetClient := meta.(*clients.Client).HPCCache.StorageTargetClient
+		if err := CacheSetStorageTargetSuspended(ctx, targetClient, id, d.Get("suspended").(bool)); err != nil {
+			return err
+		}
+	}
+
 	d.SetId(*read.ID)
 
 	return resourceHPCCacheBlobTargetRead(d, meta)
@@ -190,6 +205,7 @@ func resourceHPCCacheBlobTargetRead(d *pluginsdk.ResourceData, meta interface{})
 		}
 		d.Set("namespace_path", namespacePath)
 		d.Set("access_policy_name", accessPolicy)
+		d.Set("suspended", props.State == storagecache.OperationalStateTypeSuspended)
 	}
 
 	return nil
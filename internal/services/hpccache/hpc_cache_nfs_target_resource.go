@@ -110,6 +110,12 @@ func resourceHPCCacheNFSTarget() *pluginsdk.Resource {
 					"WRITE_WORKLOAD_CLOUDWS",
 				}, false),
 			},
+
+			"suspended": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 	}
 }
@@ -167,6 +173,15 @@ func resourceHPCCacheNFSTargetCreateOrUpdate(d *pluginsdk.ResourceData, meta int
 		return fmt.Errorf("retrieving HPC Cache NFS Target %q (Resource Group %q): `id` was nil", name, resourceGroup)
 	}
 
+	id := parse.NewStorageTargetID(meta.(*clients.Client).Account.SubscriptionId, resourceGroup, cache, name)
+
+	if d.HasChange("suspended") {
+		targetClient := meta.(*clients.Client).HPCCache.StorageTargetClient
+		if err := CacheSetStorageTargetSuspended(ctx, targetClient, id, d.Get("suspended").(bool)); err != nil {
+			return err
+		}
+	}
+
 	d.SetId(*read.ID)
 
 	return resourceHPCCacheNFSTargetRead(d, meta)
@@ -207,6 +222,7 @@ func resourceHPCCacheNFSTargetRead(d *pluginsdk.ResourceData, meta interface{})
 		if err := d.Set("namespace_junction", flattenNamespaceJunctions(props.Junctions)); err != nil {
 			return fmt.Errorf(`Error setting "namespace_junction" %q (Resource Group %q, Cahe %q): %+v`, id.Name, id.ResourceGroup, id.CacheName, err)
 		}
+		d.Set("suspended", props.State == storagecache.OperationalStateTypeSuspended)
 	}
 
 	return nil
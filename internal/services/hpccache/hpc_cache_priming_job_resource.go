@@ -0,0 +1,233 @@
+package hpccache
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/hpccache/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/hpccache/sdk/2023-05-01/caches"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/hpccache/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func resourceHPCCachePrimingJob() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceHPCCachePrimingJobCreate,
+		Read:   resourceHPCCachePrimingJobRead,
+		Delete: resourceHPCCachePrimingJobDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.CachePrimingJobID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"hpc_cache_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.CacheID,
+			},
+
+			// the manifest is commonly referenced using a SAS URL
+			"manifest_url": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.IsURLWithHTTPS,
+			},
+
+			"state": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"status": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"details": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"percent_complete": {
+				Type:     pluginsdk.TypeFloat,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceHPCCachePrimingJobCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).HPCCache.PrimingJobsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	cacheId, err := parse.CacheID(d.Get("hpc_cache_id").(string))
+	if err != nil {
+		return err
+	}
+	id := parse.NewCachePrimingJobID(cacheId.SubscriptionId, cacheId.ResourceGroup, cacheId.Name, d.Get("name").(string))
+
+	locks.ByID(cacheId.ID())
+	defer locks.UnlockByID(cacheId.ID())
+
+	sdkCacheId := caches.NewCacheID(id.SubscriptionId, id.ResourceGroup, id.CacheName)
+	existing, err := client.Get(ctx, sdkCacheId)
+	if err != nil {
+		return fmt.Errorf("checking for containing HPC Cache %s: %+v", cacheId, err)
+	}
+	if hpcCachePrimingJobByName(existing.Model, id.PrimingJobName) != nil {
+		return tf.ImportAsExistsError("azurerm_hpc_cache_priming_job", id.ID())
+	}
+
+	payload := caches.PrimingJob{
+		PrimingJobName:     id.PrimingJobName,
+		PrimingManifestUrl: d.Get("manifest_url").(string),
+	}
+	if err := client.StartPrimingJobThenPoll(ctx, sdkCacheId, payload); err != nil {
+		return fmt.Errorf("starting %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceHPCCachePrimingJobRead(d, meta)
+}
+
+func resourceHPCCachePrimingJobRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).HPCCache.PrimingJobsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.CachePrimingJobID(d.Id())
+	if err != nil {
+		return err
+	}
+	cacheId := parse.NewCacheID(id.SubscriptionId, id.ResourceGroup, id.CacheName)
+
+	resp, err := client.Get(ctx, caches.NewCacheID(id.SubscriptionId, id.ResourceGroup, id.CacheName))
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] The containing HPC Cache %s was not found - removing %s from state!", cacheId, id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", cacheId, err)
+	}
+
+	job := hpcCachePrimingJobByName(resp.Model, id.PrimingJobName)
+	if job == nil {
+		log.Printf("[DEBUG] %s was not found - removing from state!", id)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", id.PrimingJobName)
+	d.Set("hpc_cache_id", cacheId.ID())
+	// the manifest URL isn't returned by the API, so is taken from the config/state
+
+	state := ""
+	if job.PrimingJobState != nil {
+		state = string(*job.PrimingJobState)
+	}
+	d.Set("state", state)
+
+	status := ""
+	if job.PrimingJobStatus != nil {
+		status = *job.PrimingJobStatus
+	}
+	d.Set("status", status)
+
+	details := ""
+	if job.PrimingJobDetails != nil {
+		details = *job.PrimingJobDetails
+	}
+	d.Set("details", details)
+
+	percentComplete := 0.0
+	if job.PrimingJobPercentComplete != nil {
+		percentComplete = *job.PrimingJobPercentComplete
+	}
+	d.Set("percent_complete", percentComplete)
+
+	return nil
+}
+
+func resourceHPCCachePrimingJobDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).HPCCache.PrimingJobsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.CachePrimingJobID(d.Id())
+	if err != nil {
+		return err
+	}
+	cacheId := parse.NewCacheID(id.SubscriptionId, id.ResourceGroup, id.CacheName)
+
+	locks.ByID(cacheId.ID())
+	defer locks.UnlockByID(cacheId.ID())
+
+	sdkCacheId := caches.NewCacheID(id.SubscriptionId, id.ResourceGroup, id.CacheName)
+	existing, err := client.Get(ctx, sdkCacheId)
+	if err != nil {
+		if response.WasNotFound(existing.HttpResponse) {
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", cacheId, err)
+	}
+
+	job := hpcCachePrimingJobByName(existing.Model, id.PrimingJobName)
+	if job == nil || job.PrimingJobId == nil {
+		return nil
+	}
+
+	// stopping a Priming Job both cancels it (if it's still in progress) and removes it from the HPC Cache
+	payload := caches.PrimingJobIdParameter{
+		PrimingJobId: *job.PrimingJobId,
+	}
+	if err := client.StopPrimingJobThenPoll(ctx, sdkCacheId, payload); err != nil {
+		return fmt.Errorf("stopping %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+func hpcCachePrimingJobByName(cache *caches.Cache, name string) *caches.PrimingJob {
+	if cache == nil || cache.Properties == nil || cache.Properties.PrimingJobs == nil {
+		return nil
+	}
+
+	for _, job := range *cache.Properties.PrimingJobs {
+		if strings.EqualFold(job.PrimingJobName, name) {
+			job := job
+			return &job
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,111 @@
+package hpccache_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/hpccache/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/hpccache/sdk/2023-05-01/caches"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type HPCCachePrimingJobResource struct{}
+
+func TestAccHPCCachePrimingJob_basic(t *testing.T) {
+	// the manifest has to list files which exist within the storage target, so must be provisioned up front
+	if os.Getenv("ARM_TEST_HPC_CACHE_PRIMING_MANIFEST_URL") == "" {
+		t.Skip("Skipping as `ARM_TEST_HPC_CACHE_PRIMING_MANIFEST_URL` is not specified")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_hpc_cache_priming_job", "test")
+	r := HPCCachePrimingJobResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("state").Exists(),
+			),
+		},
+		data.ImportStep("manifest_url"),
+	})
+}
+
+func TestAccHPCCachePrimingJob_requiresImport(t *testing.T) {
+	if os.Getenv("ARM_TEST_HPC_CACHE_PRIMING_MANIFEST_URL") == "" {
+		t.Skip("Skipping as `ARM_TEST_HPC_CACHE_PRIMING_MANIFEST_URL` is not specified")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_hpc_cache_priming_job", "test")
+	r := HPCCachePrimingJobResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (r HPCCachePrimingJobResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.CachePrimingJobID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.HPCCache.PrimingJobsClient.Get(ctx, caches.NewCacheID(id.SubscriptionId, id.ResourceGroup, id.CacheName))
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	if model := resp.Model; model != nil && model.Properties != nil && model.Properties.PrimingJobs != nil {
+		for _, job := range *model.Properties.PrimingJobs {
+			if strings.EqualFold(job.PrimingJobName, id.PrimingJobName) {
+				return utils.Bool(true), nil
+			}
+		}
+	}
+
+	return utils.Bool(false), nil
+}
+
+func (r HPCCachePrimingJobResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_hpc_cache_priming_job" "test" {
+  name         = "acctest-primingjob-%d"
+  hpc_cache_id = azurerm_hpc_cache.test.id
+  manifest_url = "%s"
+
+  depends_on = [azurerm_hpc_cache_blob_nfs_target.test]
+}
+`, HPCCacheBlobNFSTargetResource{}.basic(data), data.RandomInteger, os.Getenv("ARM_TEST_HPC_CACHE_PRIMING_MANIFEST_URL"))
+}
+
+func (r HPCCachePrimingJobResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_hpc_cache_priming_job" "import" {
+  name         = azurerm_hpc_cache_priming_job.test.name
+  hpc_cache_id = azurerm_hpc_cache_priming_job.test.hpc_cache_id
+  manifest_url = azurerm_hpc_cache_priming_job.test.manifest_url
+}
+`, r.basic(data))
+}
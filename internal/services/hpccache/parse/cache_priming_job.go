@@ -0,0 +1,75 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type CachePrimingJobId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	CacheName      string
+	PrimingJobName string
+}
+
+func NewCachePrimingJobID(subscriptionId, resourceGroup, cacheName, primingJobName string) CachePrimingJobId {
+	return CachePrimingJobId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		CacheName:      cacheName,
+		PrimingJobName: primingJobName,
+	}
+}
+
+func (id CachePrimingJobId) String() string {
+	segments := []string{
+		fmt.Sprintf("Priming Job Name %q", id.PrimingJobName),
+		fmt.Sprintf("Cache Name %q", id.CacheName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Cache Priming Job", segmentsStr)
+}
+
+func (id CachePrimingJobId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.StorageCache/caches/%s/primingJobs/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.CacheName, id.PrimingJobName)
+}
+
+// CachePrimingJobID parses a CachePrimingJob ID into an CachePrimingJobId struct
+func CachePrimingJobID(input string) (*CachePrimingJobId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := CachePrimingJobId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.CacheName, err = id.PopSegment("caches"); err != nil {
+		return nil, err
+	}
+	if resourceId.PrimingJobName, err = id.PopSegment("primingJobs"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -31,5 +31,6 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_hpc_cache_blob_target":     resourceHPCCacheBlobTarget(),
 		"azurerm_hpc_cache_blob_nfs_target": resourceHPCCacheBlobNFSTarget(),
 		"azurerm_hpc_cache_nfs_target":      resourceHPCCacheNFSTarget(),
+		"azurerm_hpc_cache_priming_job":     resourceHPCCachePrimingJob(),
 	}
 }
@@ -0,0 +1,15 @@
+package caches
+
+import "github.com/Azure/go-autorest/autorest"
+
+type CachesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewCachesClientWithBaseURI(endpoint string) CachesClient {
+	return CachesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
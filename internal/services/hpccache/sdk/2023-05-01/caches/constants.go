@@ -0,0 +1,77 @@
+package caches
+
+import "strings"
+
+type PrimingJobState string
+
+const (
+	PrimingJobStateComplete PrimingJobState = "Complete"
+	PrimingJobStatePaused   PrimingJobState = "Paused"
+	PrimingJobStateQueued   PrimingJobState = "Queued"
+	PrimingJobStateRunning  PrimingJobState = "Running"
+)
+
+func PossibleValuesForPrimingJobState() []string {
+	return []string{
+		string(PrimingJobStateComplete),
+		string(PrimingJobStatePaused),
+		string(PrimingJobStateQueued),
+		string(PrimingJobStateRunning),
+	}
+}
+
+func parsePrimingJobState(input string) (*PrimingJobState, error) {
+	vals := map[string]PrimingJobState{
+		"complete": PrimingJobStateComplete,
+		"paused":   PrimingJobStatePaused,
+		"queued":   PrimingJobStateQueued,
+		"running":  PrimingJobStateRunning,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := PrimingJobState(input)
+	return &out, nil
+}
+
+type ProvisioningStateType string
+
+const (
+	ProvisioningStateTypeCanceled  ProvisioningStateType = "Canceled"
+	ProvisioningStateTypeCreating  ProvisioningStateType = "Creating"
+	ProvisioningStateTypeDeleting  ProvisioningStateType = "Deleting"
+	ProvisioningStateTypeFailed    ProvisioningStateType = "Failed"
+	ProvisioningStateTypeSucceeded ProvisioningStateType = "Succeeded"
+	ProvisioningStateTypeUpdating  ProvisioningStateType = "Updating"
+)
+
+func PossibleValuesForProvisioningStateType() []string {
+	return []string{
+		string(ProvisioningStateTypeCanceled),
+		string(ProvisioningStateTypeCreating),
+		string(ProvisioningStateTypeDeleting),
+		string(ProvisioningStateTypeFailed),
+		string(ProvisioningStateTypeSucceeded),
+		string(ProvisioningStateTypeUpdating),
+	}
+}
+
+func parseProvisioningStateType(input string) (*ProvisioningStateType, error) {
+	vals := map[string]ProvisioningStateType{
+		"canceled":  ProvisioningStateTypeCanceled,
+		"creating":  ProvisioningStateTypeCreating,
+		"deleting":  ProvisioningStateTypeDeleting,
+		"failed":    ProvisioningStateTypeFailed,
+		"succeeded": ProvisioningStateTypeSucceeded,
+		"updating":  ProvisioningStateTypeUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ProvisioningStateType(input)
+	return &out, nil
+}
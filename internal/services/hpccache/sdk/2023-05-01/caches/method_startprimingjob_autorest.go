@@ -0,0 +1,75 @@
+package caches
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+type StartPrimingJobResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// StartPrimingJob ...
+func (c CachesClient) StartPrimingJob(ctx context.Context, id CacheId, input PrimingJob) (result StartPrimingJobResponse, err error) {
+	req, err := c.preparerForStartPrimingJob(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "caches.CachesClient", "StartPrimingJob", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForStartPrimingJob(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "caches.CachesClient", "StartPrimingJob", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// StartPrimingJobThenPoll performs StartPrimingJob then polls until it's completed
+func (c CachesClient) StartPrimingJobThenPoll(ctx context.Context, id CacheId, input PrimingJob) error {
+	result, err := c.StartPrimingJob(ctx, id, input)
+	if err != nil {
+		return fmt.Errorf("performing StartPrimingJob: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after StartPrimingJob: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForStartPrimingJob prepares the StartPrimingJob request.
+func (c CachesClient) preparerForStartPrimingJob(ctx context.Context, id CacheId, input PrimingJob) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPost(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(fmt.Sprintf("%s/startPrimingJob", id.ID())),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForStartPrimingJob sends the StartPrimingJob request. The method will close the
+// http.Response Body if it receives an error.
+func (c CachesClient) senderForStartPrimingJob(ctx context.Context, req *http.Request) (future StartPrimingJobResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
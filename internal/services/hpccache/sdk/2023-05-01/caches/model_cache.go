@@ -0,0 +1,9 @@
+package caches
+
+type Cache struct {
+	Id         *string          `json:"id,omitempty"`
+	Location   *string          `json:"location,omitempty"`
+	Name       *string          `json:"name,omitempty"`
+	Properties *CacheProperties `json:"properties,omitempty"`
+	Type       *string          `json:"type,omitempty"`
+}
@@ -0,0 +1,6 @@
+package caches
+
+type CacheProperties struct {
+	PrimingJobs       *[]PrimingJob          `json:"primingJobs,omitempty"`
+	ProvisioningState *ProvisioningStateType `json:"provisioningState,omitempty"`
+}
@@ -0,0 +1,11 @@
+package caches
+
+type PrimingJob struct {
+	PrimingJobDetails         *string          `json:"primingJobDetails,omitempty"`
+	PrimingJobId              *string          `json:"primingJobId,omitempty"`
+	PrimingJobName            string           `json:"primingJobName"`
+	PrimingJobPercentComplete *float64         `json:"primingJobPercentComplete,omitempty"`
+	PrimingJobState           *PrimingJobState `json:"primingJobState,omitempty"`
+	PrimingJobStatus          *string          `json:"primingJobStatus,omitempty"`
+	PrimingManifestUrl        string           `json:"primingManifestUrl"`
+}
@@ -0,0 +1,5 @@
+package caches
+
+type PrimingJobIdParameter struct {
+	PrimingJobId string `json:"primingJobId"`
+}
@@ -0,0 +1,19 @@
+package client
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/imagebuilder/sdk/2022-02-14/imagetemplates"
+)
+
+type Client struct {
+	ImageTemplatesClient *imagetemplates.ImageTemplatesClient
+}
+
+func NewClient(o *common.ClientOptions) *Client {
+	imageTemplatesClient := imagetemplates.NewImageTemplatesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&imageTemplatesClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		ImageTemplatesClient: &imageTemplatesClient,
+	}
+}
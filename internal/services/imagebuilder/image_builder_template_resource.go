@@ -0,0 +1,1037 @@
+package imagebuilder
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	computeValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/imagebuilder/sdk/2022-02-14/imagetemplates"
+	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	resourceValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+const (
+	imageBuilderSourceTypePlatformImage      = "PlatformImage"
+	imageBuilderSourceTypeManagedImage       = "ManagedImage"
+	imageBuilderSourceTypeSharedImageVersion = "SharedImageVersion"
+
+	imageBuilderCustomizerTypeFile           = "File"
+	imageBuilderCustomizerTypePowerShell     = "PowerShell"
+	imageBuilderCustomizerTypeShell          = "Shell"
+	imageBuilderCustomizerTypeWindowsRestart = "WindowsRestart"
+
+	imageBuilderDistributorTypeManagedImage = "ManagedImage"
+	imageBuilderDistributorTypeSharedImage  = "SharedImage"
+	imageBuilderDistributorTypeVhd          = "VHD"
+)
+
+var _ sdk.ResourceWithUpdate = ImageBuilderTemplateResource{}
+
+type ImageBuilderTemplateResource struct{}
+
+type ImageBuilderTemplateResourceModel struct {
+	Name                       string                                        `tfschema:"name"`
+	ResourceGroupName          string                                        `tfschema:"resource_group_name"`
+	Location                   string                                        `tfschema:"location"`
+	Identity                   []ImageBuilderTemplateIdentity                `tfschema:"identity"`
+	BuildTimeoutInMinutes      int                                           `tfschema:"build_timeout_in_minutes"`
+	VmSize                     string                                        `tfschema:"vm_size"`
+	OsDiskSizeGb               int                                           `tfschema:"os_disk_size_gb"`
+	SubnetId                   string                                        `tfschema:"subnet_id"`
+	StagingResourceGroupId     string                                        `tfschema:"staging_resource_group_id"`
+	PlatformImageSource        []ImageBuilderTemplatePlatformImageSource     `tfschema:"platform_image_source"`
+	ManagedImageSourceId       string                                        `tfschema:"managed_image_source_id"`
+	SharedImageVersionSourceId string                                        `tfschema:"shared_image_version_source_id"`
+	Customizer                 []ImageBuilderTemplateCustomizer              `tfschema:"customizer"`
+	ManagedImageDistribution   []ImageBuilderTemplateManagedImageDistributor `tfschema:"managed_image_distribution"`
+	SharedImageDistribution    []ImageBuilderTemplateSharedImageDistributor  `tfschema:"shared_image_distribution"`
+	VhdDistribution            []ImageBuilderTemplateVhdDistributor          `tfschema:"vhd_distribution"`
+	Tags                       map[string]string                             `tfschema:"tags"`
+}
+
+type ImageBuilderTemplateIdentity struct {
+	Type        string   `tfschema:"type"`
+	IdentityIds []string `tfschema:"identity_ids"`
+}
+
+type ImageBuilderTemplatePlatformImageSource struct {
+	Publisher string `tfschema:"publisher"`
+	Offer     string `tfschema:"offer"`
+	Sku       string `tfschema:"sku"`
+	Version   string `tfschema:"version"`
+}
+
+type ImageBuilderTemplateCustomizer struct {
+	Type                string   `tfschema:"type"`
+	Name                string   `tfschema:"name"`
+	ScriptUri           string   `tfschema:"script_uri"`
+	Inline              []string `tfschema:"inline"`
+	Sha256Checksum      string   `tfschema:"sha256_checksum"`
+	RunElevated         bool     `tfschema:"run_elevated"`
+	RunAsSystem         bool     `tfschema:"run_as_system"`
+	ValidExitCodes      []int    `tfschema:"valid_exit_codes"`
+	SourceUri           string   `tfschema:"source_uri"`
+	Destination         string   `tfschema:"destination"`
+	RestartCommand      string   `tfschema:"restart_command"`
+	RestartCheckCommand string   `tfschema:"restart_check_command"`
+	RestartTimeout      string   `tfschema:"restart_timeout"`
+}
+
+type ImageBuilderTemplateManagedImageDistributor struct {
+	RunOutputName string            `tfschema:"run_output_name"`
+	ImageId       string            `tfschema:"image_id"`
+	Location      string            `tfschema:"location"`
+	ArtifactTags  map[string]string `tfschema:"artifact_tags"`
+}
+
+type ImageBuilderTemplateSharedImageDistributor struct {
+	RunOutputName      string            `tfschema:"run_output_name"`
+	GalleryImageId     string            `tfschema:"gallery_image_id"`
+	ReplicationRegions []string          `tfschema:"replication_regions"`
+	StorageAccountType string            `tfschema:"storage_account_type"`
+	ExcludeFromLatest  bool              `tfschema:"exclude_from_latest"`
+	ArtifactTags       map[string]string `tfschema:"artifact_tags"`
+}
+
+type ImageBuilderTemplateVhdDistributor struct {
+	RunOutputName string            `tfschema:"run_output_name"`
+	ArtifactTags  map[string]string `tfschema:"artifact_tags"`
+}
+
+func (r ImageBuilderTemplateResource) Arguments() map[string]*pluginsdk.Schema {
+	sourceFields := []string{
+		"platform_image_source",
+		"managed_image_source_id",
+		"shared_image_version_source_id",
+	}
+	distributionFields := []string{
+		"managed_image_distribution",
+		"shared_image_distribution",
+		"vhd_distribution",
+	}
+
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,62}[a-zA-Z0-9_]$`),
+				"`name` must be between 1 and 64 characters, can only contain alphanumeric characters, hyphens, underscores and periods, must start with an alphanumeric character and end with an alphanumeric character or underscore",
+			),
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": location.Schema(),
+
+		"identity": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"type": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							string(identity.TypeUserAssigned),
+						}, false),
+					},
+
+					"identity_ids": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						MinItems: 1,
+						MaxItems: 1,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: commonids.ValidateUserAssignedIdentityID,
+						},
+					},
+				},
+			},
+		},
+
+		"build_timeout_in_minutes": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      240,
+			ValidateFunc: validation.IntBetween(0, 960),
+		},
+
+		"vm_size": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"os_disk_size_gb": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ForceNew:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+
+		"subnet_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: networkValidate.SubnetID,
+		},
+
+		"staging_resource_group_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: resourceValidate.ResourceGroupID,
+		},
+
+		"platform_image_source": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			ForceNew:     true,
+			MaxItems:     1,
+			ExactlyOneOf: sourceFields,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"publisher": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"offer": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"sku": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"version": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						Default:      "latest",
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"managed_image_source_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ExactlyOneOf: sourceFields,
+			ValidateFunc: computeValidate.ImageID,
+		},
+
+		"shared_image_version_source_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ExactlyOneOf: sourceFields,
+			ValidateFunc: validation.Any(
+				computeValidate.SharedImageID,
+				computeValidate.SharedImageVersionID,
+			),
+		},
+
+		"customizer": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"type": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+						ForceNew: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							imageBuilderCustomizerTypeFile,
+							imageBuilderCustomizerTypePowerShell,
+							imageBuilderCustomizerTypeShell,
+							imageBuilderCustomizerTypeWindowsRestart,
+						}, false),
+					},
+
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"script_uri": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+					},
+
+					"inline": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						ForceNew: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+
+					"sha256_checksum": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-fA-F0-9]{64}$`), "`sha256_checksum` must be a SHA256 checksum"),
+					},
+
+					"run_elevated": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						ForceNew: true,
+						Default:  false,
+					},
+
+					"run_as_system": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						ForceNew: true,
+						Default:  false,
+					},
+
+					"valid_exit_codes": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						ForceNew: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeInt,
+						},
+					},
+
+					"source_uri": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+					},
+
+					"destination": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"restart_command": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"restart_check_command": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"restart_timeout": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[0-9]+[smh]$`), "`restart_timeout` must be a duration such as `5m` or `2h`"),
+					},
+				},
+			},
+		},
+
+		"managed_image_distribution": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			ForceNew:     true,
+			AtLeastOneOf: distributionFields,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"run_output_name": imageBuilderRunOutputNameSchema(),
+
+					"image_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: computeValidate.ImageID,
+					},
+
+					"location": location.Schema(),
+
+					"artifact_tags": imageBuilderArtifactTagsSchema(),
+				},
+			},
+		},
+
+		"shared_image_distribution": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			ForceNew:     true,
+			AtLeastOneOf: distributionFields,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"run_output_name": imageBuilderRunOutputNameSchema(),
+
+					"gallery_image_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: computeValidate.SharedImageID,
+					},
+
+					"replication_regions": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						ForceNew: true,
+						MinItems: 1,
+						Elem: &pluginsdk.Schema{
+							Type:             pluginsdk.TypeString,
+							ValidateFunc:     location.EnhancedValidate,
+							StateFunc:        location.StateFunc,
+							DiffSuppressFunc: location.DiffSuppressFunc,
+						},
+					},
+
+					"storage_account_type": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						Default:      string(imagetemplates.SharedImageStorageAccountTypeStandardLRS),
+						ValidateFunc: validation.StringInSlice(imagetemplates.PossibleValuesForSharedImageStorageAccountType(), false),
+					},
+
+					"exclude_from_latest": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						ForceNew: true,
+						Default:  false,
+					},
+
+					"artifact_tags": imageBuilderArtifactTagsSchema(),
+				},
+			},
+		},
+
+		"vhd_distribution": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			ForceNew:     true,
+			AtLeastOneOf: distributionFields,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"run_output_name": imageBuilderRunOutputNameSchema(),
+
+					"artifact_tags": imageBuilderArtifactTagsSchema(),
+				},
+			},
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r ImageBuilderTemplateResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ImageBuilderTemplateResource) ModelObject() interface{} {
+	return &ImageBuilderTemplateResourceModel{}
+}
+
+func (r ImageBuilderTemplateResource) ResourceType() string {
+	return "azurerm_image_builder_template"
+}
+
+func (r ImageBuilderTemplateResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return imagetemplates.ValidateImageTemplateID
+}
+
+func (r ImageBuilderTemplateResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ImageBuilder.ImageTemplatesClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model ImageBuilderTemplateResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := imagetemplates.NewImageTemplateID(subscriptionId, model.ResourceGroupName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			properties, err := expandImageBuilderTemplateProperties(model)
+			if err != nil {
+				return err
+			}
+
+			payload := imagetemplates.ImageTemplate{
+				Identity:   expandImageBuilderTemplateIdentity(model.Identity),
+				Location:   location.Normalize(model.Location),
+				Properties: properties,
+				Tags:       &model.Tags,
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ImageBuilderTemplateResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ImageBuilder.ImageTemplatesClient
+
+			id, err := imagetemplates.ParseImageTemplateID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ImageBuilderTemplateResourceModel{
+				Name:              id.ImageTemplateName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+
+				identity, err := flattenImageBuilderTemplateIdentity(model.Identity)
+				if err != nil {
+					return err
+				}
+				state.Identity = identity
+
+				if props := model.Properties; props != nil {
+					if err := flattenImageBuilderTemplateProperties(*props, &state); err != nil {
+						return err
+					}
+				}
+
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ImageBuilderTemplateResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ImageBuilder.ImageTemplatesClient
+
+			id, err := imagetemplates.ParseImageTemplateID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ImageBuilderTemplateResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// Image Templates are immutable, with only the Identity and Tags able to be updated
+			payload := imagetemplates.ImageTemplateUpdateParameters{}
+			if metadata.ResourceData.HasChange("identity") {
+				identity := expandImageBuilderTemplateIdentity(model.Identity)
+				payload.Identity = &identity
+			}
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = &model.Tags
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ImageBuilderTemplateResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ImageBuilder.ImageTemplatesClient
+
+			id, err := imagetemplates.ParseImageTemplateID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func imageBuilderRunOutputNameSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeString,
+		Required: true,
+		ForceNew: true,
+		ValidateFunc: validation.StringMatch(
+			regexp.MustCompile(`^[A-Za-z0-9-_.]{1,64}$`),
+			"`run_output_name` must be between 1 and 64 characters and can only contain alphanumeric characters, hyphens, underscores and periods",
+		),
+	}
+}
+
+func imageBuilderArtifactTagsSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeMap,
+		Optional: true,
+		ForceNew: true,
+		Elem: &pluginsdk.Schema{
+			Type: pluginsdk.TypeString,
+		},
+	}
+}
+
+func expandImageBuilderTemplateIdentity(input []ImageBuilderTemplateIdentity) identity.UserAssignedMap {
+	output := identity.UserAssignedMap{
+		Type:        identity.TypeNone,
+		IdentityIds: map[string]identity.UserAssignedIdentityDetails{},
+	}
+
+	if len(input) == 0 {
+		return output
+	}
+
+	output.Type = identity.Type(input[0].Type)
+	for _, v := range input[0].IdentityIds {
+		output.IdentityIds[v] = identity.UserAssignedIdentityDetails{}
+	}
+
+	return output
+}
+
+func flattenImageBuilderTemplateIdentity(input identity.UserAssignedMap) ([]ImageBuilderTemplateIdentity, error) {
+	if input.Type != identity.TypeUserAssigned {
+		return []ImageBuilderTemplateIdentity{}, nil
+	}
+
+	identityIds := make([]string, 0)
+	for raw := range input.IdentityIds {
+		id, err := commonids.ParseUserAssignedIdentityIDInsensitively(raw)
+		if err != nil {
+			return nil, fmt.Errorf("parsing %q as a User Assigned Identity ID: %+v", raw, err)
+		}
+		identityIds = append(identityIds, id.ID())
+	}
+
+	return []ImageBuilderTemplateIdentity{
+		{
+			Type:        string(input.Type),
+			IdentityIds: identityIds,
+		},
+	}, nil
+}
+
+func expandImageBuilderTemplateProperties(input ImageBuilderTemplateResourceModel) (*imagetemplates.ImageTemplateProperties, error) {
+	customizers, err := expandImageBuilderTemplateCustomizers(input.Customizer)
+	if err != nil {
+		return nil, err
+	}
+
+	output := imagetemplates.ImageTemplateProperties{
+		BuildTimeoutInMinutes: utils.Int64(int64(input.BuildTimeoutInMinutes)),
+		Customize:             customizers,
+		Distribute:            expandImageBuilderTemplateDistributors(input),
+		Source:                expandImageBuilderTemplateSource(input),
+		VMProfile:             &imagetemplates.ImageTemplateVMProfile{},
+	}
+
+	if input.StagingResourceGroupId != "" {
+		output.StagingResourceGroup = utils.String(input.StagingResourceGroupId)
+	}
+
+	if input.VmSize != "" {
+		output.VMProfile.VMSize = utils.String(input.VmSize)
+	}
+
+	if input.OsDiskSizeGb != 0 {
+		output.VMProfile.OsDiskSizeGB = utils.Int64(int64(input.OsDiskSizeGb))
+	}
+
+	if input.SubnetId != "" {
+		output.VMProfile.VnetConfig = &imagetemplates.VirtualNetworkConfig{
+			SubnetId: utils.String(input.SubnetId),
+		}
+	}
+
+	return &output, nil
+}
+
+func flattenImageBuilderTemplateProperties(input imagetemplates.ImageTemplateProperties, state *ImageBuilderTemplateResourceModel) error {
+	if input.BuildTimeoutInMinutes != nil {
+		state.BuildTimeoutInMinutes = int(*input.BuildTimeoutInMinutes)
+	}
+
+	if input.StagingResourceGroup != nil && *input.StagingResourceGroup != "" {
+		stagingResourceGroupId, err := commonids.ParseResourceGroupIDInsensitively(*input.StagingResourceGroup)
+		if err != nil {
+			return err
+		}
+		state.StagingResourceGroupId = stagingResourceGroupId.ID()
+	}
+
+	if profile := input.VMProfile; profile != nil {
+		if profile.VMSize != nil {
+			state.VmSize = *profile.VMSize
+		}
+		if profile.OsDiskSizeGB != nil {
+			state.OsDiskSizeGb = int(*profile.OsDiskSizeGB)
+		}
+		if profile.VnetConfig != nil && profile.VnetConfig.SubnetId != nil {
+			state.SubnetId = *profile.VnetConfig.SubnetId
+		}
+	}
+
+	switch input.Source.Type {
+	case imageBuilderSourceTypePlatformImage:
+		state.PlatformImageSource = []ImageBuilderTemplatePlatformImageSource{
+			{
+				Publisher: utils.NormalizeNilableString(input.Source.Publisher),
+				Offer:     utils.NormalizeNilableString(input.Source.Offer),
+				Sku:       utils.NormalizeNilableString(input.Source.Sku),
+				Version:   utils.NormalizeNilableString(input.Source.Version),
+			},
+		}
+	case imageBuilderSourceTypeManagedImage:
+		state.ManagedImageSourceId = utils.NormalizeNilableString(input.Source.ImageId)
+	case imageBuilderSourceTypeSharedImageVersion:
+		state.SharedImageVersionSourceId = utils.NormalizeNilableString(input.Source.ImageVersionId)
+	}
+
+	state.Customizer = flattenImageBuilderTemplateCustomizers(input.Customize)
+	flattenImageBuilderTemplateDistributors(input.Distribute, state)
+
+	return nil
+}
+
+func expandImageBuilderTemplateSource(input ImageBuilderTemplateResourceModel) imagetemplates.ImageTemplateSource {
+	if len(input.PlatformImageSource) > 0 {
+		v := input.PlatformImageSource[0]
+		return imagetemplates.ImageTemplateSource{
+			Type:      imageBuilderSourceTypePlatformImage,
+			Publisher: utils.String(v.Publisher),
+			Offer:     utils.String(v.Offer),
+			Sku:       utils.String(v.Sku),
+			Version:   utils.String(v.Version),
+		}
+	}
+
+	if input.ManagedImageSourceId != "" {
+		return imagetemplates.ImageTemplateSource{
+			Type:    imageBuilderSourceTypeManagedImage,
+			ImageId: utils.String(input.ManagedImageSourceId),
+		}
+	}
+
+	return imagetemplates.ImageTemplateSource{
+		Type:           imageBuilderSourceTypeSharedImageVersion,
+		ImageVersionId: utils.String(input.SharedImageVersionSourceId),
+	}
+}
+
+func expandImageBuilderTemplateCustomizers(input []ImageBuilderTemplateCustomizer) (*[]imagetemplates.ImageTemplateCustomizer, error) {
+	output := make([]imagetemplates.ImageTemplateCustomizer, 0)
+
+	for i, v := range input {
+		customizer := imagetemplates.ImageTemplateCustomizer{
+			Type: v.Type,
+		}
+		if v.Name != "" {
+			customizer.Name = utils.String(v.Name)
+		}
+
+		// the fields which are supported differ by the type of customizer, so ensure only those are specified
+		supported := map[string]bool{}
+		switch v.Type {
+		case imageBuilderCustomizerTypeShell, imageBuilderCustomizerTypePowerShell:
+			if (v.ScriptUri == "") == (len(v.Inline) == 0) {
+				return nil, fmt.Errorf("exactly one of `script_uri` or `inline` must be specified for the %s customizer at index %d", v.Type, i)
+			}
+			if v.ScriptUri != "" {
+				customizer.ScriptUri = utils.String(v.ScriptUri)
+			}
+			if len(v.Inline) > 0 {
+				customizer.Inline = &v.Inline
+			}
+			if v.Sha256Checksum != "" {
+				customizer.Sha256Checksum = utils.String(v.Sha256Checksum)
+			}
+			supported["script_uri"] = true
+			supported["inline"] = true
+			supported["sha256_checksum"] = true
+
+			if v.Type == imageBuilderCustomizerTypePowerShell {
+				customizer.RunElevated = utils.Bool(v.RunElevated)
+				customizer.RunAsSystem = utils.Bool(v.RunAsSystem)
+
+				validExitCodes := make([]int64, 0)
+				for _, code := range v.ValidExitCodes {
+					validExitCodes = append(validExitCodes, int64(code))
+				}
+				customizer.ValidExitCodes = &validExitCodes
+
+				supported["run_elevated"] = true
+				supported["run_as_system"] = true
+				supported["valid_exit_codes"] = true
+			}
+
+		case imageBuilderCustomizerTypeFile:
+			if v.SourceUri == "" || v.Destination == "" {
+				return nil, fmt.Errorf("`source_uri` and `destination` must be specified for the %s customizer at index %d", v.Type, i)
+			}
+			customizer.SourceUri = utils.String(v.SourceUri)
+			customizer.Destination = utils.String(v.Destination)
+			if v.Sha256Checksum != "" {
+				customizer.Sha256Checksum = utils.String(v.Sha256Checksum)
+			}
+			supported["source_uri"] = true
+			supported["destination"] = true
+			supported["sha256_checksum"] = true
+
+		case imageBuilderCustomizerTypeWindowsRestart:
+			if v.RestartCommand != "" {
+				customizer.RestartCommand = utils.String(v.RestartCommand)
+			}
+			if v.RestartCheckCommand != "" {
+				customizer.RestartCheckCommand = utils.String(v.RestartCheckCommand)
+			}
+			if v.RestartTimeout != "" {
+				customizer.RestartTimeout = utils.String(v.RestartTimeout)
+			}
+			supported["restart_command"] = true
+			supported["restart_check_command"] = true
+			supported["restart_timeout"] = true
+		}
+
+		specified := map[string]bool{
+			"script_uri":            v.ScriptUri != "",
+			"inline":                len(v.Inline) > 0,
+			"sha256_checksum":       v.Sha256Checksum != "",
+			"run_elevated":          v.RunElevated,
+			"run_as_system":         v.RunAsSystem,
+			"valid_exit_codes":      len(v.ValidExitCodes) > 0,
+			"source_uri":            v.SourceUri != "",
+			"destination":           v.Destination != "",
+			"restart_command":       v.RestartCommand != "",
+			"restart_check_command": v.RestartCheckCommand != "",
+			"restart_timeout":       v.RestartTimeout != "",
+		}
+		unsupported := make([]string, 0)
+		for field, isSpecified := range specified {
+			if isSpecified && !supported[field] {
+				unsupported = append(unsupported, fmt.Sprintf("`%s`", field))
+			}
+		}
+		if len(unsupported) > 0 {
+			sort.Strings(unsupported)
+			return nil, fmt.Errorf("%s cannot be specified for the %s customizer at index %d", strings.Join(unsupported, ", "), v.Type, i)
+		}
+
+		output = append(output, customizer)
+	}
+
+	return &output, nil
+}
+
+func flattenImageBuilderTemplateCustomizers(input *[]imagetemplates.ImageTemplateCustomizer) []ImageBuilderTemplateCustomizer {
+	output := make([]ImageBuilderTemplateCustomizer, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		customizer := ImageBuilderTemplateCustomizer{
+			Type:                v.Type,
+			Name:                utils.NormalizeNilableString(v.Name),
+			ScriptUri:           utils.NormalizeNilableString(v.ScriptUri),
+			Sha256Checksum:      utils.NormalizeNilableString(v.Sha256Checksum),
+			SourceUri:           utils.NormalizeNilableString(v.SourceUri),
+			Destination:         utils.NormalizeNilableString(v.Destination),
+			RestartCommand:      utils.NormalizeNilableString(v.RestartCommand),
+			RestartCheckCommand: utils.NormalizeNilableString(v.RestartCheckCommand),
+			RestartTimeout:      utils.NormalizeNilableString(v.RestartTimeout),
+		}
+
+		if v.Inline != nil {
+			customizer.Inline = *v.Inline
+		}
+		if v.RunElevated != nil {
+			customizer.RunElevated = *v.RunElevated
+		}
+		if v.RunAsSystem != nil {
+			customizer.RunAsSystem = *v.RunAsSystem
+		}
+		if v.ValidExitCodes != nil {
+			for _, code := range *v.ValidExitCodes {
+				customizer.ValidExitCodes = append(customizer.ValidExitCodes, int(code))
+			}
+		}
+
+		output = append(output, customizer)
+	}
+
+	return output
+}
+
+func expandImageBuilderTemplateDistributors(input ImageBuilderTemplateResourceModel) []imagetemplates.ImageTemplateDistributor {
+	output := make([]imagetemplates.ImageTemplateDistributor, 0)
+
+	for _, v := range input.ManagedImageDistribution {
+		output = append(output, imagetemplates.ImageTemplateDistributor{
+			Type:          imageBuilderDistributorTypeManagedImage,
+			RunOutputName: v.RunOutputName,
+			ImageId:       utils.String(v.ImageId),
+			Location:      utils.String(location.Normalize(v.Location)),
+			ArtifactTags:  &v.ArtifactTags,
+		})
+	}
+
+	for _, v := range input.SharedImageDistribution {
+		replicationRegions := make([]string, 0)
+		for _, region := range v.ReplicationRegions {
+			replicationRegions = append(replicationRegions, location.Normalize(region))
+		}
+		storageAccountType := imagetemplates.SharedImageStorageAccountType(v.StorageAccountType)
+
+		output = append(output, imagetemplates.ImageTemplateDistributor{
+			Type:               imageBuilderDistributorTypeSharedImage,
+			RunOutputName:      v.RunOutputName,
+			GalleryImageId:     utils.String(v.GalleryImageId),
+			ReplicationRegions: &replicationRegions,
+			StorageAccountType: &storageAccountType,
+			ExcludeFromLatest:  utils.Bool(v.ExcludeFromLatest),
+			ArtifactTags:       &v.ArtifactTags,
+		})
+	}
+
+	for _, v := range input.VhdDistribution {
+		output = append(output, imagetemplates.ImageTemplateDistributor{
+			Type:          imageBuilderDistributorTypeVhd,
+			RunOutputName: v.RunOutputName,
+			ArtifactTags:  &v.ArtifactTags,
+		})
+	}
+
+	return output
+}
+
+func flattenImageBuilderTemplateDistributors(input []imagetemplates.ImageTemplateDistributor, state *ImageBuilderTemplateResourceModel) {
+	state.ManagedImageDistribution = make([]ImageBuilderTemplateManagedImageDistributor, 0)
+	state.SharedImageDistribution = make([]ImageBuilderTemplateSharedImageDistributor, 0)
+	state.VhdDistribution = make([]ImageBuilderTemplateVhdDistributor, 0)
+
+	for _, v := range input {
+		artifactTags := map[string]string{}
+		if v.ArtifactTags != nil {
+			artifactTags = *v.ArtifactTags
+		}
+
+		switch v.Type {
+		case imageBuilderDistributorTypeManagedImage:
+			state.ManagedImageDistribution = append(state.ManagedImageDistribution, ImageBuilderTemplateManagedImageDistributor{
+				RunOutputName: v.RunOutputName,
+				ImageId:       utils.NormalizeNilableString(v.ImageId),
+				Location:      location.NormalizeNilable(v.Location),
+				ArtifactTags:  artifactTags,
+			})
+
+		case imageBuilderDistributorTypeSharedImage:
+			replicationRegions := make([]string, 0)
+			if v.ReplicationRegions != nil {
+				for _, region := range *v.ReplicationRegions {
+					replicationRegions = append(replicationRegions, location.Normalize(region))
+				}
+			}
+			storageAccountType := ""
+			if v.StorageAccountType != nil {
+				storageAccountType = string(*v.StorageAccountType)
+			}
+			excludeFromLatest := false
+			if v.ExcludeFromLatest != nil {
+				excludeFromLatest = *v.ExcludeFromLatest
+			}
+
+			state.SharedImageDistribution = append(state.SharedImageDistribution, ImageBuilderTemplateSharedImageDistributor{
+				RunOutputName:      v.RunOutputName,
+				GalleryImageId:     utils.NormalizeNilableString(v.GalleryImageId),
+				ReplicationRegions: replicationRegions,
+				StorageAccountType: storageAccountType,
+				ExcludeFromLatest:  excludeFromLatest,
+				ArtifactTags:       artifactTags,
+			})
+
+		case imageBuilderDistributorTypeVhd:
+			state.VhdDistribution = append(state.VhdDistribution, ImageBuilderTemplateVhdDistributor{
+				RunOutputName: v.RunOutputName,
+				ArtifactTags:  artifactTags,
+			})
+		}
+	}
+}
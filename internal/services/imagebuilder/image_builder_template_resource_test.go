@@ -0,0 +1,313 @@
+package imagebuilder_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/imagebuilder/sdk/2022-02-14/imagetemplates"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ImageBuilderTemplateResource struct{}
+
+func TestAccImageBuilderTemplate_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_image_builder_template", "test")
+	r := ImageBuilderTemplateResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccImageBuilderTemplate_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_image_builder_template", "test")
+	r := ImageBuilderTemplateResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccImageBuilderTemplate_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_image_builder_template", "test")
+	r := ImageBuilderTemplateResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("customizer.#").HasValue("3"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccImageBuilderTemplate_updateTags(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_image_builder_template", "test")
+	r := ImageBuilderTemplateResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.tags(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("tags.%").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ImageBuilderTemplateResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := imagetemplates.ParseImageTemplateID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.ImageBuilder.ImageTemplatesClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r ImageBuilderTemplateResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_subscription" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-aib-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_user_assigned_identity" "test" {
+  name                = "acctestuai%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_role_assignment" "test" {
+  scope                = azurerm_resource_group.test.id
+  role_definition_name = "Contributor"
+  principal_id         = azurerm_user_assigned_identity.test.principal_id
+}
+
+resource "azurerm_shared_image_gallery" "test" {
+  name                = "acctestsig%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_shared_image" "test" {
+  name                = "acctestimg%[1]d"
+  gallery_name        = azurerm_shared_image_gallery.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  os_type             = "Linux"
+
+  identifier {
+    publisher = "AccTesPublisher%[1]d"
+    offer     = "AccTesOffer%[1]d"
+    sku       = "AccTesSku%[1]d"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r ImageBuilderTemplateResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_image_builder_template" "test" {
+  name                = "acctest-aib-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  identity {
+    type         = "UserAssigned"
+    identity_ids = [azurerm_user_assigned_identity.test.id]
+  }
+
+  platform_image_source {
+    publisher = "Canonical"
+    offer     = "0001-com-ubuntu-server-focal"
+    sku       = "20_04-lts"
+  }
+
+  shared_image_distribution {
+    run_output_name     = "acctest"
+    gallery_image_id    = azurerm_shared_image.test.id
+    replication_regions = [azurerm_resource_group.test.location]
+  }
+
+  depends_on = [azurerm_role_assignment.test]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ImageBuilderTemplateResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_image_builder_template" "import" {
+  name                = azurerm_image_builder_template.test.name
+  resource_group_name = azurerm_image_builder_template.test.resource_group_name
+  location            = azurerm_image_builder_template.test.location
+
+  identity {
+    type         = "UserAssigned"
+    identity_ids = [azurerm_user_assigned_identity.test.id]
+  }
+
+  platform_image_source {
+    publisher = "Canonical"
+    offer     = "0001-com-ubuntu-server-focal"
+    sku       = "20_04-lts"
+  }
+
+  shared_image_distribution {
+    run_output_name     = "acctest"
+    gallery_image_id    = azurerm_shared_image.test.id
+    replication_regions = [azurerm_resource_group.test.location]
+  }
+}
+`, r.basic(data))
+}
+
+func (r ImageBuilderTemplateResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_image_builder_template" "test" {
+  name                     = "acctest-aib-%[2]d"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  build_timeout_in_minutes = 90
+  vm_size                  = "Standard_D2s_v3"
+  os_disk_size_gb          = 64
+
+  identity {
+    type         = "UserAssigned"
+    identity_ids = [azurerm_user_assigned_identity.test.id]
+  }
+
+  platform_image_source {
+    publisher = "Canonical"
+    offer     = "0001-com-ubuntu-server-focal"
+    sku       = "20_04-lts"
+    version   = "latest"
+  }
+
+  customizer {
+    type   = "Shell"
+    name   = "update"
+    inline = ["sudo apt-get update"]
+  }
+
+  customizer {
+    type        = "File"
+    name        = "motd"
+    source_uri  = "https://raw.githubusercontent.com/hashicorp/terraform-provider-azurerm/main/README.md"
+    destination = "/tmp/README.md"
+  }
+
+  customizer {
+    type   = "Shell"
+    name   = "cleanup"
+    inline = ["rm /tmp/README.md"]
+  }
+
+  shared_image_distribution {
+    run_output_name      = "acctest-sig"
+    gallery_image_id     = azurerm_shared_image.test.id
+    replication_regions  = [azurerm_resource_group.test.location]
+    storage_account_type = "Standard_ZRS"
+    exclude_from_latest  = true
+
+    artifact_tags = {
+      source = "acctest"
+    }
+  }
+
+  vhd_distribution {
+    run_output_name = "acctest-vhd"
+  }
+
+  tags = {
+    ENV = "Test"
+  }
+
+  depends_on = [azurerm_role_assignment.test]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ImageBuilderTemplateResource) tags(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_image_builder_template" "test" {
+  name                = "acctest-aib-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  identity {
+    type         = "UserAssigned"
+    identity_ids = [azurerm_user_assigned_identity.test.id]
+  }
+
+  platform_image_source {
+    publisher = "Canonical"
+    offer     = "0001-com-ubuntu-server-focal"
+    sku       = "20_04-lts"
+  }
+
+  shared_image_distribution {
+    run_output_name     = "acctest"
+    gallery_image_id    = azurerm_shared_image.test.id
+    replication_regions = [azurerm_resource_group.test.location]
+  }
+
+  tags = {
+    ENV = "Test"
+  }
+
+  depends_on = [azurerm_role_assignment.test]
+}
+`, r.template(data), data.RandomInteger)
+}
@@ -0,0 +1,178 @@
+package imagebuilder
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/imagebuilder/sdk/2022-02-14/imagetemplates"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.Resource = ImageBuilderTemplateRunResource{}
+
+// ImageBuilderTemplateRunResource builds the image described by an Image Builder Template when it's created - since
+// a run is an action rather than something which exists in Azure, there's nothing to remove when this is destroyed.
+type ImageBuilderTemplateRunResource struct{}
+
+type ImageBuilderTemplateRunResourceModel struct {
+	ImageBuilderTemplateId string            `tfschema:"image_builder_template_id"`
+	Triggers               map[string]string `tfschema:"triggers"`
+	RunState               string            `tfschema:"run_state"`
+	RunSubState            string            `tfschema:"run_sub_state"`
+	Message                string            `tfschema:"message"`
+	StartTime              string            `tfschema:"start_time"`
+	EndTime                string            `tfschema:"end_time"`
+}
+
+func (r ImageBuilderTemplateRunResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"image_builder_template_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: imagetemplates.ValidateImageTemplateID,
+		},
+
+		"triggers": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r ImageBuilderTemplateRunResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"run_state": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"run_sub_state": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"message": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"start_time": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"end_time": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r ImageBuilderTemplateRunResource) ModelObject() interface{} {
+	return &ImageBuilderTemplateRunResourceModel{}
+}
+
+func (r ImageBuilderTemplateRunResource) ResourceType() string {
+	return "azurerm_image_builder_template_run"
+}
+
+func (r ImageBuilderTemplateRunResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return imagetemplates.ValidateImageTemplateID
+}
+
+func (r ImageBuilderTemplateRunResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		// the maximum build timeout for an Image Template is 16 hours, plus time to distribute the image
+		Timeout: 18 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ImageBuilder.ImageTemplatesClient
+
+			var model ImageBuilderTemplateRunResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := imagetemplates.ParseImageTemplateID(model.ImageBuilderTemplateId)
+			if err != nil {
+				return err
+			}
+
+			if err := client.RunThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("running %s: %+v", *id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ImageBuilderTemplateRunResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ImageBuilder.ImageTemplatesClient
+
+			id, err := imagetemplates.ParseImageTemplateID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var state ImageBuilderTemplateRunResourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state.ImageBuilderTemplateId = id.ID()
+			state.RunState = ""
+			state.RunSubState = ""
+			state.Message = ""
+			state.StartTime = ""
+			state.EndTime = ""
+
+			if model := resp.Model; model != nil && model.Properties != nil {
+				if status := model.Properties.LastRunStatus; status != nil {
+					if status.RunState != nil {
+						state.RunState = string(*status.RunState)
+					}
+					if status.RunSubState != nil {
+						state.RunSubState = string(*status.RunSubState)
+					}
+					state.Message = utils.NormalizeNilableString(status.Message)
+					state.StartTime = utils.NormalizeNilableString(status.StartTime)
+					state.EndTime = utils.NormalizeNilableString(status.EndTime)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ImageBuilderTemplateRunResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			log.Printf("[DEBUG] Running an Image Builder Template can't be undone - removing %q from state only", metadata.ResourceData.Id())
+			return nil
+		},
+	}
+}
@@ -0,0 +1,67 @@
+package imagebuilder_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/imagebuilder/sdk/2022-02-14/imagetemplates"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ImageBuilderTemplateRunResource struct{}
+
+func TestAccImageBuilderTemplateRun_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_image_builder_template_run", "test")
+	r := ImageBuilderTemplateRunResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("run_state").HasValue("Succeeded"),
+			),
+		},
+	})
+}
+
+func (r ImageBuilderTemplateRunResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := imagetemplates.ParseImageTemplateID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.ImageBuilder.ImageTemplatesClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	if resp.Model == nil || resp.Model.Properties == nil || resp.Model.Properties.LastRunStatus == nil {
+		return utils.Bool(false), nil
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (r ImageBuilderTemplateRunResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_image_builder_template_run" "test" {
+  image_builder_template_id = azurerm_image_builder_template.test.id
+
+  triggers = {
+    version = "1"
+  }
+}
+`, ImageBuilderTemplateResource{}.basic(data))
+}
@@ -0,0 +1,30 @@
+package imagebuilder
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+var _ sdk.TypedServiceRegistration = Registration{}
+
+type Registration struct{}
+
+func (r Registration) Name() string {
+	return "Image Builder"
+}
+
+func (r Registration) WebsiteCategories() []string {
+	return []string{
+		"Image Builder",
+	}
+}
+
+func (r Registration) DataSources() []sdk.DataSource {
+	return []sdk.DataSource{}
+}
+
+func (r Registration) Resources() []sdk.Resource {
+	return []sdk.Resource{
+		ImageBuilderTemplateResource{},
+		ImageBuilderTemplateRunResource{},
+	}
+}
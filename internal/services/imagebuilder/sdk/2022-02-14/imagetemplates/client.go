@@ -0,0 +1,15 @@
+package imagetemplates
+
+import "github.com/Azure/go-autorest/autorest"
+
+type ImageTemplatesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewImageTemplatesClientWithBaseURI(endpoint string) ImageTemplatesClient {
+	return ImageTemplatesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
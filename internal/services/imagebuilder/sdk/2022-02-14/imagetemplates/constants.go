@@ -0,0 +1,154 @@
+package imagetemplates
+
+import "strings"
+
+type ProvisioningState string
+
+const (
+	ProvisioningStateCanceled  ProvisioningState = "Canceled"
+	ProvisioningStateCreating  ProvisioningState = "Creating"
+	ProvisioningStateDeleting  ProvisioningState = "Deleting"
+	ProvisioningStateFailed    ProvisioningState = "Failed"
+	ProvisioningStateSucceeded ProvisioningState = "Succeeded"
+	ProvisioningStateUpdating  ProvisioningState = "Updating"
+)
+
+func PossibleValuesForProvisioningState() []string {
+	return []string{
+		string(ProvisioningStateCanceled),
+		string(ProvisioningStateCreating),
+		string(ProvisioningStateDeleting),
+		string(ProvisioningStateFailed),
+		string(ProvisioningStateSucceeded),
+		string(ProvisioningStateUpdating),
+	}
+}
+
+func parseProvisioningState(input string) (*ProvisioningState, error) {
+	vals := map[string]ProvisioningState{
+		"canceled":  ProvisioningStateCanceled,
+		"creating":  ProvisioningStateCreating,
+		"deleting":  ProvisioningStateDeleting,
+		"failed":    ProvisioningStateFailed,
+		"succeeded": ProvisioningStateSucceeded,
+		"updating":  ProvisioningStateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ProvisioningState(input)
+	return &out, nil
+}
+
+type RunState string
+
+const (
+	RunStateCanceled           RunState = "Canceled"
+	RunStateCanceling          RunState = "Canceling"
+	RunStateFailed             RunState = "Failed"
+	RunStatePartiallySucceeded RunState = "PartiallySucceeded"
+	RunStateRunning            RunState = "Running"
+	RunStateSucceeded          RunState = "Succeeded"
+)
+
+func PossibleValuesForRunState() []string {
+	return []string{
+		string(RunStateCanceled),
+		string(RunStateCanceling),
+		string(RunStateFailed),
+		string(RunStatePartiallySucceeded),
+		string(RunStateRunning),
+		string(RunStateSucceeded),
+	}
+}
+
+func parseRunState(input string) (*RunState, error) {
+	vals := map[string]RunState{
+		"canceled":           RunStateCanceled,
+		"canceling":          RunStateCanceling,
+		"failed":             RunStateFailed,
+		"partiallysucceeded": RunStatePartiallySucceeded,
+		"running":            RunStateRunning,
+		"succeeded":          RunStateSucceeded,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := RunState(input)
+	return &out, nil
+}
+
+type RunSubState string
+
+const (
+	RunSubStateBuilding     RunSubState = "Building"
+	RunSubStateCustomizing  RunSubState = "Customizing"
+	RunSubStateDistributing RunSubState = "Distributing"
+	RunSubStateOptimizing   RunSubState = "Optimizing"
+	RunSubStateQueued       RunSubState = "Queued"
+	RunSubStateValidating   RunSubState = "Validating"
+)
+
+func PossibleValuesForRunSubState() []string {
+	return []string{
+		string(RunSubStateBuilding),
+		string(RunSubStateCustomizing),
+		string(RunSubStateDistributing),
+		string(RunSubStateOptimizing),
+		string(RunSubStateQueued),
+		string(RunSubStateValidating),
+	}
+}
+
+func parseRunSubState(input string) (*RunSubState, error) {
+	vals := map[string]RunSubState{
+		"building":     RunSubStateBuilding,
+		"customizing":  RunSubStateCustomizing,
+		"distributing": RunSubStateDistributing,
+		"optimizing":   RunSubStateOptimizing,
+		"queued":       RunSubStateQueued,
+		"validating":   RunSubStateValidating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := RunSubState(input)
+	return &out, nil
+}
+
+type SharedImageStorageAccountType string
+
+const (
+	SharedImageStorageAccountTypePremiumLRS  SharedImageStorageAccountType = "Premium_LRS"
+	SharedImageStorageAccountTypeStandardLRS SharedImageStorageAccountType = "Standard_LRS"
+	SharedImageStorageAccountTypeStandardZRS SharedImageStorageAccountType = "Standard_ZRS"
+)
+
+func PossibleValuesForSharedImageStorageAccountType() []string {
+	return []string{
+		string(SharedImageStorageAccountTypePremiumLRS),
+		string(SharedImageStorageAccountTypeStandardLRS),
+		string(SharedImageStorageAccountTypeStandardZRS),
+	}
+}
+
+func parseSharedImageStorageAccountType(input string) (*SharedImageStorageAccountType, error) {
+	vals := map[string]SharedImageStorageAccountType{
+		"premium_lrs":  SharedImageStorageAccountTypePremiumLRS,
+		"standard_lrs": SharedImageStorageAccountTypeStandardLRS,
+		"standard_zrs": SharedImageStorageAccountTypeStandardZRS,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := SharedImageStorageAccountType(input)
+	return &out, nil
+}
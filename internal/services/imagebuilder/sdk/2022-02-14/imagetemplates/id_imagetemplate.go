@@ -0,0 +1,124 @@
+package imagetemplates
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = ImageTemplateId{}
+
+// ImageTemplateId is a struct representing the Resource ID for a Image Template
+type ImageTemplateId struct {
+	SubscriptionId    string
+	ResourceGroupName string
+	ImageTemplateName string
+}
+
+// NewImageTemplateID returns a new ImageTemplateId struct
+func NewImageTemplateID(subscriptionId string, resourceGroupName string, imageTemplateName string) ImageTemplateId {
+	return ImageTemplateId{
+		SubscriptionId:    subscriptionId,
+		ResourceGroupName: resourceGroupName,
+		ImageTemplateName: imageTemplateName,
+	}
+}
+
+// ParseImageTemplateID parses 'input' into a ImageTemplateId
+func ParseImageTemplateID(input string) (*ImageTemplateId, error) {
+	parser := resourceids.NewParserFromResourceIdType(ImageTemplateId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := ImageTemplateId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.ImageTemplateName, ok = parsed.Parsed["imageTemplateName"]; !ok {
+		return nil, fmt.Errorf("the segment 'imageTemplateName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseImageTemplateIDInsensitively parses 'input' case-insensitively into a ImageTemplateId
+// note: this method should only be used for API response data and not user input
+func ParseImageTemplateIDInsensitively(input string) (*ImageTemplateId, error) {
+	parser := resourceids.NewParserFromResourceIdType(ImageTemplateId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := ImageTemplateId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.ImageTemplateName, ok = parsed.Parsed["imageTemplateName"]; !ok {
+		return nil, fmt.Errorf("the segment 'imageTemplateName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateImageTemplateID checks that 'input' can be parsed as a Image Template ID
+func ValidateImageTemplateID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseImageTemplateID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Image Template ID
+func (id ImageTemplateId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.VirtualMachineImages/imageTemplates/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.ImageTemplateName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Image Template ID
+func (id ImageTemplateId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftVirtualMachineImages", "Microsoft.VirtualMachineImages", "Microsoft.VirtualMachineImages"),
+		resourceids.StaticSegment("staticImageTemplates", "imageTemplates", "imageTemplates"),
+		resourceids.UserSpecifiedSegment("imageTemplateName", "imageTemplateValue"),
+	}
+}
+
+// String returns a human-readable description of this Image Template ID
+func (id ImageTemplateId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Image Template Name: %q", id.ImageTemplateName),
+	}
+	return fmt.Sprintf("Image Template (%s)", strings.Join(components, "\n"))
+}
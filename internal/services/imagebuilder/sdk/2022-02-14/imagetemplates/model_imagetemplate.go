@@ -0,0 +1,15 @@
+package imagetemplates
+
+import (
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+)
+
+type ImageTemplate struct {
+	Id         *string                  `json:"id,omitempty"`
+	Identity   identity.UserAssignedMap `json:"identity"`
+	Location   string                   `json:"location"`
+	Name       *string                  `json:"name,omitempty"`
+	Properties *ImageTemplateProperties `json:"properties,omitempty"`
+	Tags       *map[string]string       `json:"tags,omitempty"`
+	Type       *string                  `json:"type,omitempty"`
+}
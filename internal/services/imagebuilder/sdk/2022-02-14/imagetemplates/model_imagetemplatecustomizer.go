@@ -0,0 +1,17 @@
+package imagetemplates
+
+type ImageTemplateCustomizer struct {
+	Destination         *string   `json:"destination,omitempty"`
+	Inline              *[]string `json:"inline,omitempty"`
+	Name                *string   `json:"name,omitempty"`
+	RestartCheckCommand *string   `json:"restartCheckCommand,omitempty"`
+	RestartCommand      *string   `json:"restartCommand,omitempty"`
+	RestartTimeout      *string   `json:"restartTimeout,omitempty"`
+	RunAsSystem         *bool     `json:"runAsSystem,omitempty"`
+	RunElevated         *bool     `json:"runElevated,omitempty"`
+	ScriptUri           *string   `json:"scriptUri,omitempty"`
+	Sha256Checksum      *string   `json:"sha256Checksum,omitempty"`
+	SourceUri           *string   `json:"sourceUri,omitempty"`
+	Type                string    `json:"type"`
+	ValidExitCodes      *[]int64  `json:"validExitCodes,omitempty"`
+}
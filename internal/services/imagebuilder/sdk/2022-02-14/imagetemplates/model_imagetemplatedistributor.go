@@ -0,0 +1,13 @@
+package imagetemplates
+
+type ImageTemplateDistributor struct {
+	ArtifactTags       *map[string]string             `json:"artifactTags,omitempty"`
+	ExcludeFromLatest  *bool                          `json:"excludeFromLatest,omitempty"`
+	GalleryImageId     *string                        `json:"galleryImageId,omitempty"`
+	ImageId            *string                        `json:"imageId,omitempty"`
+	Location           *string                        `json:"location,omitempty"`
+	ReplicationRegions *[]string                      `json:"replicationRegions,omitempty"`
+	RunOutputName      string                         `json:"runOutputName"`
+	StorageAccountType *SharedImageStorageAccountType `json:"storageAccountType,omitempty"`
+	Type               string                         `json:"type"`
+}
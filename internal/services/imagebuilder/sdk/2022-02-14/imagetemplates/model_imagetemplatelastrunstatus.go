@@ -0,0 +1,9 @@
+package imagetemplates
+
+type ImageTemplateLastRunStatus struct {
+	EndTime     *string      `json:"endTime,omitempty"`
+	Message     *string      `json:"message,omitempty"`
+	RunState    *RunState    `json:"runState,omitempty"`
+	RunSubState *RunSubState `json:"runSubState,omitempty"`
+	StartTime   *string      `json:"startTime,omitempty"`
+}
@@ -0,0 +1,14 @@
+package imagetemplates
+
+type ImageTemplateProperties struct {
+	BuildTimeoutInMinutes     *int64                      `json:"buildTimeoutInMinutes,omitempty"`
+	Customize                 *[]ImageTemplateCustomizer  `json:"customize,omitempty"`
+	Distribute                []ImageTemplateDistributor  `json:"distribute"`
+	ExactStagingResourceGroup *string                     `json:"exactStagingResourceGroup,omitempty"`
+	LastRunStatus             *ImageTemplateLastRunStatus `json:"lastRunStatus,omitempty"`
+	ProvisioningError         *ProvisioningError          `json:"provisioningError,omitempty"`
+	ProvisioningState         *ProvisioningState          `json:"provisioningState,omitempty"`
+	Source                    ImageTemplateSource         `json:"source"`
+	StagingResourceGroup      *string                     `json:"stagingResourceGroup,omitempty"`
+	VMProfile                 *ImageTemplateVMProfile     `json:"vmProfile,omitempty"`
+}
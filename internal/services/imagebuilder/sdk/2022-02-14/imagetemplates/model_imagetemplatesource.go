@@ -0,0 +1,11 @@
+package imagetemplates
+
+type ImageTemplateSource struct {
+	ImageId        *string `json:"imageId,omitempty"`
+	ImageVersionId *string `json:"imageVersionId,omitempty"`
+	Offer          *string `json:"offer,omitempty"`
+	Publisher      *string `json:"publisher,omitempty"`
+	Sku            *string `json:"sku,omitempty"`
+	Type           string  `json:"type"`
+	Version        *string `json:"version,omitempty"`
+}
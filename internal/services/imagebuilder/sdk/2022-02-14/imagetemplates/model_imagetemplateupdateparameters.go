@@ -0,0 +1,10 @@
+package imagetemplates
+
+import (
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+)
+
+type ImageTemplateUpdateParameters struct {
+	Identity *identity.UserAssignedMap `json:"identity,omitempty"`
+	Tags     *map[string]string        `json:"tags,omitempty"`
+}
@@ -0,0 +1,8 @@
+package imagetemplates
+
+type ImageTemplateVMProfile struct {
+	OsDiskSizeGB           *int64                `json:"osDiskSizeGB,omitempty"`
+	UserAssignedIdentities *[]string             `json:"userAssignedIdentities,omitempty"`
+	VMSize                 *string               `json:"vmSize,omitempty"`
+	VnetConfig             *VirtualNetworkConfig `json:"vnetConfig,omitempty"`
+}
@@ -0,0 +1,6 @@
+package imagetemplates
+
+type ProvisioningError struct {
+	Message               *string `json:"message,omitempty"`
+	ProvisioningErrorCode *string `json:"provisioningErrorCode,omitempty"`
+}
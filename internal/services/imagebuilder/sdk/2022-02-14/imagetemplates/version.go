@@ -0,0 +1,9 @@
+package imagetemplates
+
+import "fmt"
+
+const defaultApiVersion = "2022-02-14"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/imagetemplates/%s", defaultApiVersion)
+}
@@ -199,6 +199,16 @@ func TestApplicationIDInsensitively(t *testing.T) {
 				IoTAppName:     "app1",
 			},
 		},
+
+		{
+			// upper-cased root segment names
+			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/Microsoft.IoTCentral/ioTApps/app1",
+			Expected: &ApplicationId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "resGroup1",
+				IoTAppName:     "app1",
+			},
+		},
 	}
 
 	for _, v := range testData {
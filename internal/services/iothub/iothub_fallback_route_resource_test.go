@@ -56,6 +56,30 @@ func TestAccIotHubFallbackRoute_update(t *testing.T) {
 	})
 }
 
+func TestAccIotHubFallbackRoute_updateIoTHub(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_iothub_fallback_route", "test")
+	r := IotHubFallbackRouteResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			// updating the IoT Hub shouldn't remove the Fallback Route managed by this resource
+			Config: r.iotHubUpdated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("source").HasValue("DeviceConnectionStateEvents"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (t IotHubFallbackRouteResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.FallbackRouteID(state.ID)
 	if err != nil {
@@ -199,3 +223,69 @@ resource "azurerm_iothub_fallback_route" "test" {
 }
 `, data.RandomInteger, data.Locations.Primary, data.RandomString)
 }
+
+func (IotHubFallbackRouteResource) iotHubUpdated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-iothub-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%[3]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "test-%[1]d"
+  storage_account_name  = azurerm_storage_account.test.name
+  container_access_type = "private"
+}
+
+resource "azurerm_iothub" "test" {
+  name                = "acctestIoTHub-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  sku {
+    name     = "S1"
+    capacity = "1"
+  }
+
+  tags = {
+    purpose = "testing"
+    updated = "true"
+  }
+}
+
+resource "azurerm_iothub_endpoint_storage_container" "test" {
+  resource_group_name = azurerm_resource_group.test.name
+  iothub_name         = azurerm_iothub.test.name
+  name                = "acctest"
+
+  connection_string          = azurerm_storage_account.test.primary_blob_connection_string
+  batch_frequency_in_seconds = 60
+  max_chunk_size_in_bytes    = 10485760
+  container_name             = azurerm_storage_container.test.name
+  encoding                   = "Avro"
+  file_name_format           = "{iothub}/{partition}_{YYYY}_{MM}_{DD}_{HH}_{mm}"
+}
+
+resource "azurerm_iothub_fallback_route" "test" {
+  resource_group_name = azurerm_resource_group.test.name
+  iothub_name         = azurerm_iothub.test.name
+
+  source         = "DeviceConnectionStateEvents"
+  condition      = "true"
+  endpoint_names = [azurerm_iothub_endpoint_storage_container.test.name]
+  enabled        = true
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
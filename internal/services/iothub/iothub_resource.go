@@ -21,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/iothub/parse"
 	iothubValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/iothub/validate"
 	msiparse "github.com/hashicorp/terraform-provider-azurerm/internal/services/msi/parse"
+	msivalidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/msi/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
@@ -171,6 +172,20 @@ func resourceIotHub() *pluginsdk.Resource {
 							Type:     pluginsdk.TypeString,
 							Required: true,
 						},
+						"authentication_type": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							Default:  string(devices.AuthenticationTypeKeyBased),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(devices.AuthenticationTypeKeyBased),
+								string(devices.AuthenticationTypeIdentityBased),
+							}, false),
+						},
+						"identity_id": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: msivalidate.UserAssignedIdentityID,
+						},
 						"notifications": {
 							Type:     pluginsdk.TypeBool,
 							Optional: true,
@@ -559,14 +574,14 @@ func resourceIotHubCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) err
 	locks.ByName(id.Name, IothubResourceName)
 	defer locks.UnlockByName(id.Name, IothubResourceName)
 
-	if d.IsNewResource() {
-		existing, err := client.Get(ctx, id.ResourceGroup, id.Name)
-		if err != nil {
-			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("checking for presence of %s: %+v", id, err)
-			}
+	existing, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of %s: %+v", id, err)
 		}
+	}
 
+	if d.IsNewResource() {
 		if existing.ID != nil && *existing.ID != "" {
 			return tf.ImportAsExistsError("azurerm_iothub", *existing.ID)
 		}
@@ -603,6 +618,25 @@ func resourceIotHubCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) err
 		routingProperties.Endpoints = expandIoTHubEndpoints(d, subscriptionId)
 	}
 
+	// the routes, endpoints, enrichments and fallback route can also be managed using their standalone
+	// resources - when these haven't been changed here, retain what's currently configured on the IoT Hub
+	// rather than sending the (possibly stale) values from the state, which would overwrite those resources
+	if !d.IsNewResource() && existing.Properties != nil && existing.Properties.Routing != nil {
+		existingRouting := existing.Properties.Routing
+		if !d.HasChange("route") {
+			routingProperties.Routes = existingRouting.Routes
+		}
+		if !d.HasChange("enrichment") {
+			routingProperties.Enrichments = existingRouting.Enrichments
+		}
+		if !d.HasChange("fallback_route") {
+			routingProperties.FallbackRoute = existingRouting.FallbackRoute
+		}
+		if !d.HasChange("endpoint") {
+			routingProperties.Endpoints = existingRouting.Endpoints
+		}
+	}
+
 	storageEndpoints, messagingEndpoints, enableFileUploadNotifications := expandIoTHubFileUpload(d)
 	if err != nil {
 		return fmt.Errorf("expanding `file_upload`: %+v", err)
@@ -959,9 +993,16 @@ func expandIoTHubFileUpload(d *pluginsdk.ResourceData) (map[string]*devices.Stor
 		lockDuration := fileUploadMap["lock_duration"].(string)
 
 		storageEndpointProperties["$default"] = &devices.StorageEndpointProperties{
-			SasTTLAsIso8601:  &sasTTL,
-			ConnectionString: &connectionStr,
-			ContainerName:    &containerName,
+			SasTTLAsIso8601:    &sasTTL,
+			ConnectionString:   &connectionStr,
+			ContainerName:      &containerName,
+			AuthenticationType: devices.AuthenticationType(fileUploadMap["authentication_type"].(string)),
+		}
+
+		if identityId := fileUploadMap["identity_id"].(string); identityId != "" {
+			storageEndpointProperties["$default"].Identity = &devices.ManagedIdentity{
+				UserAssignedIdentity: utils.String(identityId),
+			}
 		}
 
 		messagingEndpointProperties["fileNotifications"] = &devices.MessagingEndpointProperties{
@@ -1162,6 +1203,18 @@ func flattenIoTHubFileUpload(storageEndpoints map[string]*devices.StorageEndpoin
 			output["sas_ttl"] = *sasTTLAsIso8601
 		}
 
+		authenticationType := string(devices.AuthenticationTypeKeyBased)
+		if storageEndpointProperties.AuthenticationType != "" {
+			authenticationType = string(storageEndpointProperties.AuthenticationType)
+		}
+		output["authentication_type"] = authenticationType
+
+		identityId := ""
+		if storageEndpointProperties.Identity != nil && storageEndpointProperties.Identity.UserAssignedIdentity != nil {
+			identityId = *storageEndpointProperties.Identity.UserAssignedIdentity
+		}
+		output["identity_id"] = identityId
+
 		if messagingEndpointProperties, ok := messagingEndpoints["fileNotifications"]; ok {
 			if lockDurationAsIso8601 := messagingEndpointProperties.LockDurationAsIso8601; lockDurationAsIso8601 != nil {
 				output["lock_duration"] = *lockDurationAsIso8601
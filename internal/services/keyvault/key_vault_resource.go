@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/networkrules"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
@@ -40,6 +41,20 @@ var armKeyVaultSkuFamily = "A"
 var keyVaultResourceName = "azurerm_key_vault"
 
 func resourceKeyVault() *pluginsdk.Resource {
+	upgraders := map[int]pluginsdk.StateUpgrade{
+		0: migration.KeyVaultV0ToV1{},
+		1: migration.KeyVaultV1ToV2{},
+	}
+	schemaVersion := 2
+
+	// TODO: (v3.0) Add the following to the migration guide:
+	// *Breaking Change* In this version the `network_acls` block is renamed to `network_rules` and `bypass`
+	// within it becomes a set, for consistency with other resources supporting Network Rules.
+	if features.ThreePointOh() {
+		upgraders[2] = migration.KeyVaultV2ToV3{}
+		schemaVersion = 3
+	}
+
 	return &pluginsdk.Resource{
 		Create: resourceKeyVaultCreate,
 		Read:   resourceKeyVaultRead,
@@ -49,11 +64,8 @@ func resourceKeyVault() *pluginsdk.Resource {
 		// TODO: replace this with an importer which validates the ID during import
 		Importer: pluginsdk.DefaultImporter(),
 
-		SchemaVersion: 2,
-		StateUpgraders: pluginsdk.StateUpgrades(map[int]pluginsdk.StateUpgrade{
-			0: migration.KeyVaultV0ToV1{},
-			1: migration.KeyVaultV1ToV2{},
-		}),
+		SchemaVersion:  schemaVersion,
+		StateUpgraders: pluginsdk.StateUpgrades(upgraders),
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -141,7 +153,52 @@ func resourceKeyVault() *pluginsdk.Resource {
 					Optional: true,
 				},
 
-				"network_acls": {
+				"purge_protection_enabled": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+				},
+
+				"soft_delete_retention_days": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					Default:      90,
+					ValidateFunc: validation.IntBetween(7, 90),
+				},
+
+				"contact": {
+					Type:     pluginsdk.TypeSet,
+					Optional: true,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"email": {
+								Type:     pluginsdk.TypeString,
+								Required: true,
+							},
+							"name": {
+								Type:     pluginsdk.TypeString,
+								Optional: true,
+							},
+							"phone": {
+								Type:     pluginsdk.TypeString,
+								Optional: true,
+							},
+						},
+					},
+				},
+
+				"tags": tags.Schema(),
+
+				// Computed
+				"vault_uri": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+			}
+
+			if features.ThreePointOh() {
+				rSchema["network_rules"] = keyVaultNetworkRules.Schema()
+			} else {
+				rSchema["network_acls"] = &pluginsdk.Schema{
 					Type:     pluginsdk.TypeList,
 					Optional: true,
 					Computed: true,
@@ -184,48 +241,7 @@ func resourceKeyVault() *pluginsdk.Resource {
 							},
 						},
 					},
-				},
-
-				"purge_protection_enabled": {
-					Type:     pluginsdk.TypeBool,
-					Optional: true,
-				},
-
-				"soft_delete_retention_days": {
-					Type:         pluginsdk.TypeInt,
-					Optional:     true,
-					Default:      90,
-					ValidateFunc: validation.IntBetween(7, 90),
-				},
-
-				"contact": {
-					Type:     pluginsdk.TypeSet,
-					Optional: true,
-					Elem: &pluginsdk.Resource{
-						Schema: map[string]*pluginsdk.Schema{
-							"email": {
-								Type:     pluginsdk.TypeString,
-								Required: true,
-							},
-							"name": {
-								Type:     pluginsdk.TypeString,
-								Optional: true,
-							},
-							"phone": {
-								Type:     pluginsdk.TypeString,
-								Optional: true,
-							},
-						},
-					},
-				},
-
-				"tags": tags.Schema(),
-
-				// Computed
-				"vault_uri": {
-					Type:     pluginsdk.TypeString,
-					Computed: true,
-				},
+				}
 			}
 
 			if !features.ThreePointOh() {
@@ -300,8 +316,8 @@ func resourceKeyVaultCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	policies := d.Get("access_policy").([]interface{})
 	accessPolicies := expandAccessPolicies(policies)
 
-	networkAclsRaw := d.Get("network_acls").([]interface{})
-	networkAcls, subnetIds := expandKeyVaultNetworkAcls(networkAclsRaw)
+	networkAclsRaw := d.Get(keyVaultNetworkRulesKey()).([]interface{})
+	networkAcls, subnetIds := expandKeyVaultNetworkRules(networkAclsRaw)
 
 	sku := keyvault.Sku{
 		Family: &armKeyVaultSkuFamily,
@@ -474,13 +490,13 @@ func resourceKeyVaultUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 		update.Properties.EnableRbacAuthorization = utils.Bool(d.Get("enable_rbac_authorization").(bool))
 	}
 
-	if d.HasChange("network_acls") {
+	if d.HasChange(keyVaultNetworkRulesKey()) {
 		if update.Properties == nil {
 			update.Properties = &keyvault.VaultPatchProperties{}
 		}
 
-		networkAclsRaw := d.Get("network_acls").([]interface{})
-		networkAcls, subnetIds := expandKeyVaultNetworkAcls(networkAclsRaw)
+		networkAclsRaw := d.Get(keyVaultNetworkRulesKey()).([]interface{})
+		networkAcls, subnetIds := expandKeyVaultNetworkRules(networkAclsRaw)
 
 		// also lock on the Virtual Network ID's since modifications in the networking stack are exclusive
 		virtualNetworkNames := make([]string, 0)
@@ -666,8 +682,8 @@ func resourceKeyVaultRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	}
 	d.Set("sku_name", skuName)
 
-	if err := d.Set("network_acls", flattenKeyVaultNetworkAcls(props.NetworkAcls)); err != nil {
-		return fmt.Errorf("setting `network_acls` for KeyVault %q: %+v", *resp.Name, err)
+	if err := d.Set(keyVaultNetworkRulesKey(), flattenKeyVaultNetworkRules(props.NetworkAcls)); err != nil {
+		return fmt.Errorf("setting `%s` for KeyVault %q: %+v", keyVaultNetworkRulesKey(), *resp.Name, err)
 	}
 
 	flattenedPolicies := flattenAccessPolicies(props.AccessPolicies)
@@ -820,6 +836,61 @@ func keyVaultRefreshFunc(vaultUri string) pluginsdk.StateRefreshFunc {
 	}
 }
 
+var keyVaultNetworkRules = networkrules.Options{
+	BypassValues: []string{
+		string(keyvault.AzureServices),
+		string(keyvault.None),
+	},
+	SingleBypassValue: true,
+}
+
+// TODO: (v3.0) remove this once `network_acls` has been renamed to `network_rules`
+func keyVaultNetworkRulesKey() string {
+	if features.ThreePointOh() {
+		return "network_rules"
+	}
+
+	return "network_acls"
+}
+
+func expandKeyVaultNetworkRules(input []interface{}) (*keyvault.NetworkRuleSet, []string) {
+	if !features.ThreePointOh() {
+		return expandKeyVaultNetworkAcls(input)
+	}
+
+	subnetIds := make([]string, 0)
+	rules := keyVaultNetworkRules.Expand(input)
+	if rules == nil {
+		return nil, subnetIds
+	}
+
+	ipRules := make([]keyvault.IPRule, 0)
+	for _, v := range rules.IPRules {
+		ipRules = append(ipRules, keyvault.IPRule{
+			Value: utils.String(v),
+		})
+	}
+
+	networkRules := make([]keyvault.VirtualNetworkRule, 0)
+	for _, v := range rules.VirtualNetworkRules {
+		subnetIds = append(subnetIds, v.SubnetId)
+		networkRules = append(networkRules, keyvault.VirtualNetworkRule{
+			ID: utils.String(v.SubnetId),
+		})
+	}
+
+	ruleSet := keyvault.NetworkRuleSet{
+		DefaultAction:       keyvault.NetworkRuleAction(rules.DefaultAction),
+		IPRules:             &ipRules,
+		VirtualNetworkRules: &networkRules,
+	}
+	if len(rules.Bypass) > 0 {
+		ruleSet.Bypass = keyvault.NetworkRuleBypassOptions(rules.Bypass[0])
+	}
+
+	return &ruleSet, subnetIds
+}
+
 func expandKeyVaultNetworkAcls(input []interface{}) (*keyvault.NetworkRuleSet, []string) {
 	subnetIds := make([]string, 0)
 	if len(input) == 0 {
@@ -879,6 +950,48 @@ func expandKeyVaultCertificateContactList(input []interface{}) *[]KeyVaultMgmt.C
 	return &results
 }
 
+func flattenKeyVaultNetworkRules(input *keyvault.NetworkRuleSet) []interface{} {
+	if !features.ThreePointOh() {
+		return flattenKeyVaultNetworkAcls(input)
+	}
+
+	rules := networkrules.NetworkRules{
+		DefaultAction:       string(keyvault.Allow),
+		Bypass:              []string{string(keyvault.AzureServices)},
+		IPRules:             make([]string, 0),
+		VirtualNetworkRules: make([]networkrules.VirtualNetworkRule, 0),
+	}
+
+	if input != nil {
+		rules.DefaultAction = string(input.DefaultAction)
+		rules.Bypass = []string{string(input.Bypass)}
+
+		if input.IPRules != nil {
+			for _, v := range *input.IPRules {
+				if v.Value == nil {
+					continue
+				}
+
+				rules.IPRules = append(rules.IPRules, *v.Value)
+			}
+		}
+
+		if input.VirtualNetworkRules != nil {
+			for _, v := range *input.VirtualNetworkRules {
+				if v.ID == nil {
+					continue
+				}
+
+				rules.VirtualNetworkRules = append(rules.VirtualNetworkRules, networkrules.VirtualNetworkRule{
+					SubnetId: *v.ID,
+				})
+			}
+		}
+	}
+
+	return keyVaultNetworkRules.Flatten(&rules)
+}
+
 func flattenKeyVaultNetworkAcls(input *keyvault.NetworkRuleSet) []interface{} {
 	if input == nil {
 		return []interface{}{
@@ -0,0 +1,208 @@
+package keyvault
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/v7.1/keyvault"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func dataSourceKeyVaultSecretVersions() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceKeyVaultSecretVersionsRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: keyVaultValidate.NestedItemName,
+			},
+
+			"key_vault_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: keyVaultValidate.VaultID,
+			},
+
+			"versionless_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"versions": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"version": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"enabled": {
+							Type:     pluginsdk.TypeBool,
+							Computed: true,
+						},
+
+						"created_date": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"updated_date": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"not_before_date": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"expiration_date": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceKeyVaultSecretVersionsRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	keyVaultsClient := meta.(*clients.Client).KeyVault
+	client := meta.(*clients.Client).KeyVault.ManagementClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	keyVaultId, err := parse.VaultID(d.Get("key_vault_id").(string))
+	if err != nil {
+		return err
+	}
+
+	keyVaultBaseUri, err := keyVaultsClient.BaseUriForKeyVault(ctx, *keyVaultId)
+	if err != nil {
+		return fmt.Errorf("looking up Secret %q vault url from id %q: %+v", name, *keyVaultId, err)
+	}
+
+	iterator, err := client.GetSecretVersionsComplete(ctx, *keyVaultBaseUri, name, utils.Int32(25))
+	if err != nil {
+		return fmt.Errorf("listing versions of KeyVault Secret %q (KeyVault URI %q): %+v", name, *keyVaultBaseUri, err)
+	}
+
+	items := make([]keyvault.SecretItem, 0)
+	for iterator.NotDone() {
+		items = append(items, iterator.Value())
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("listing versions of KeyVault Secret %q (KeyVault URI %q): %+v", name, *keyVaultBaseUri, err)
+		}
+	}
+
+	// the API returns an empty list rather than a 404 when the Secret doesn't exist
+	if len(items) == 0 {
+		return fmt.Errorf("KeyVault Secret %q (KeyVault URI %q) does not exist", name, *keyVaultBaseUri)
+	}
+
+	versions, err := flattenKeyVaultSecretVersions(items)
+	if err != nil {
+		return err
+	}
+
+	id, err := parse.NewNestedItemID(*keyVaultBaseUri, "secrets", name, "")
+	if err != nil {
+		return err
+	}
+
+	d.SetId(id.VersionlessID())
+
+	d.Set("name", name)
+	d.Set("key_vault_id", keyVaultId.ID())
+	d.Set("versionless_id", id.VersionlessID())
+
+	if err := d.Set("versions", versions); err != nil {
+		return fmt.Errorf("setting `versions`: %+v", err)
+	}
+
+	return nil
+}
+
+// flattenKeyVaultSecretVersions returns the versions ordered from newest to oldest, so the version
+// prior to the current one is always the second item in the list
+func flattenKeyVaultSecretVersions(input []keyvault.SecretItem) ([]interface{}, error) {
+	sort.SliceStable(input, func(i, j int) bool {
+		return keyVaultSecretItemCreated(input[i]).After(keyVaultSecretItemCreated(input[j]))
+	})
+
+	results := make([]interface{}, 0)
+	for _, item := range input {
+		if item.ID == nil {
+			continue
+		}
+
+		id, err := parse.ParseNestedItemID(*item.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		enabled := false
+		created := ""
+		updated := ""
+		notBefore := ""
+		expires := ""
+		if attributes := item.Attributes; attributes != nil {
+			if attributes.Enabled != nil {
+				enabled = *attributes.Enabled
+			}
+			if attributes.Created != nil {
+				created = time.Time(*attributes.Created).Format(time.RFC3339)
+			}
+			if attributes.Updated != nil {
+				updated = time.Time(*attributes.Updated).Format(time.RFC3339)
+			}
+			if attributes.NotBefore != nil {
+				notBefore = time.Time(*attributes.NotBefore).Format(time.RFC3339)
+			}
+			if attributes.Expires != nil {
+				expires = time.Time(*attributes.Expires).Format(time.RFC3339)
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"id":              id.ID(),
+			"version":         id.Version,
+			"enabled":         enabled,
+			"created_date":    created,
+			"updated_date":    updated,
+			"not_before_date": notBefore,
+			"expiration_date": expires,
+		})
+	}
+
+	return results, nil
+}
+
+func keyVaultSecretItemCreated(input keyvault.SecretItem) time.Time {
+	if input.Attributes == nil || input.Attributes.Created == nil {
+		return time.Time{}
+	}
+
+	return time.Time(*input.Attributes.Created)
+}
@@ -0,0 +1,43 @@
+package keyvault_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type KeyVaultSecretVersionsDataSource struct {
+}
+
+func TestAccDataSourceKeyVaultSecretVersions_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_key_vault_secret_versions", "test")
+	r := KeyVaultSecretVersionsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: KeyVaultSecretResource{}.basic(data),
+		},
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("versions.#").HasValue("2"),
+				check.That(data.ResourceName).Key("versions.0.enabled").HasValue("true"),
+				check.That(data.ResourceName).Key("versions.0.created_date").Exists(),
+				check.That(data.ResourceName).Key("versionless_id").HasValue(fmt.Sprintf("https://acctestkv-%s.vault.azure.net/secrets/secret-%s", data.RandomString, data.RandomString)),
+			),
+		},
+	})
+}
+
+func (KeyVaultSecretVersionsDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_key_vault_secret_versions" "test" {
+  name         = azurerm_key_vault_secret.test.name
+  key_vault_id = azurerm_key_vault.test.id
+}
+`, KeyVaultSecretResource{}.basicUpdated(data))
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"strings"
 
+	"github.com/hashicorp/terraform-provider-azurerm/internal/networkrules"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/set"
 )
@@ -229,6 +230,10 @@ var _ pluginsdk.StateUpgrade = KeyVaultV1ToV2{}
 type KeyVaultV1ToV2 struct{}
 
 func (KeyVaultV1ToV2) Schema() map[string]*pluginsdk.Schema {
+	return keyVaultSchemaForV1AndV2()
+}
+
+func keyVaultSchemaForV1AndV2() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
 			Type:     pluginsdk.TypeString,
@@ -458,3 +463,19 @@ func (KeyVaultV1ToV2) UpgradeFunc() pluginsdk.StateUpgraderFunc {
 		return rawState, nil
 	}
 }
+
+var _ pluginsdk.StateUpgrade = KeyVaultV2ToV3{}
+
+type KeyVaultV2ToV3 struct{}
+
+func (KeyVaultV2ToV3) Schema() map[string]*pluginsdk.Schema {
+	return keyVaultSchemaForV1AndV2()
+}
+
+func (KeyVaultV2ToV3) UpgradeFunc() pluginsdk.StateUpgraderFunc {
+	return func(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+		// the `network_acls` block has been renamed to `network_rules`, with `bypass` becoming a set
+		// for consistency with the other resources supporting Network Rules
+		return networkrules.MigrateState(rawState, "network_acls"), nil
+	}
+}
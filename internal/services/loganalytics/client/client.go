@@ -4,6 +4,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/operationalinsights/mgmt/2020-08-01/operationalinsights"
 	"github.com/Azure/azure-sdk-for-go/services/preview/operationsmanagement/mgmt/2015-11-01-preview/operationsmanagement"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/sdk/2025-02-01/workspaces"
 )
 
 type Client struct {
@@ -17,6 +18,7 @@ type Client struct {
 	SolutionsClient            *operationsmanagement.SolutionsClient
 	StorageInsightsClient      *operationalinsights.StorageInsightConfigsClient
 	WorkspacesClient           *operationalinsights.WorkspacesClient
+	WorkspaceReplicationClient *workspaces.WorkspacesClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -50,6 +52,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	LinkedStorageAccountClient := operationalinsights.NewLinkedStorageAccountsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&LinkedStorageAccountClient.Client, o.ResourceManagerAuthorizer)
 
+	WorkspaceReplicationClient := workspaces.NewWorkspacesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&WorkspaceReplicationClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
 		ClusterClient:              &ClusterClient,
 		DataExportClient:           &DataExportClient,
@@ -61,5 +66,6 @@ func NewClient(o *common.ClientOptions) *Client {
 		SolutionsClient:            &SolutionsClient,
 		StorageInsightsClient:      &StorageInsightsClient,
 		WorkspacesClient:           &WorkspacesClient,
+		WorkspaceReplicationClient: &WorkspaceReplicationClient,
 	}
 }
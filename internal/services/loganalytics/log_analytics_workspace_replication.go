@@ -0,0 +1,163 @@
+package loganalytics
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/sdk/2025-02-01/workspaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// logAnalyticsWorkspaceUpdateReplication reconciles the replication (and failover) configuration of an existing
+// workspace - since replication can't be sent as a part of the PUT this is applied separately once the workspace exists
+func logAnalyticsWorkspaceUpdateReplication(ctx context.Context, client *workspaces.WorkspacesClient, id workspaces.WorkspaceId, d *pluginsdk.ResourceData) error {
+	oldRaw, newRaw := d.GetChange("replication")
+	oldLocation, oldFailoverActive := expandLogAnalyticsWorkspaceReplication(oldRaw.([]interface{}))
+	newLocation, newFailoverActive := expandLogAnalyticsWorkspaceReplication(newRaw.([]interface{}))
+	if d.IsNewResource() {
+		oldLocation, oldFailoverActive = "", false
+	}
+
+	locationChanged := !strings.EqualFold(oldLocation, newLocation)
+
+	// failing back has to happen before the replication can be disabled or moved to another region
+	if oldFailoverActive && (!newFailoverActive || locationChanged) {
+		log.Printf("[DEBUG] Failing back %s..", id)
+		if err := client.WorkspacesFailbackThenPoll(ctx, id); err != nil {
+			return fmt.Errorf("failing back %s: %+v", id, err)
+		}
+	}
+
+	if oldLocation != "" && locationChanged {
+		log.Printf("[DEBUG] Disabling replication for %s..", id)
+		payload := workspaces.WorkspacePatch{
+			Properties: &workspaces.WorkspacePatchProperties{
+				Replication: &workspaces.WorkspaceReplicationProperties{
+					Enabled: utils.Bool(false),
+				},
+			},
+		}
+		if _, err := client.WorkspacesUpdate(ctx, id, payload); err != nil {
+			return fmt.Errorf("disabling replication for %s: %+v", id, err)
+		}
+		if err := logAnalyticsWorkspaceWaitForReplication(ctx, client, id); err != nil {
+			return err
+		}
+	}
+
+	if newLocation != "" && locationChanged {
+		log.Printf("[DEBUG] Enabling replication to %q for %s..", newLocation, id)
+		payload := workspaces.WorkspacePatch{
+			Properties: &workspaces.WorkspacePatchProperties{
+				Replication: &workspaces.WorkspaceReplicationProperties{
+					Enabled:  utils.Bool(true),
+					Location: utils.String(newLocation),
+				},
+			},
+		}
+		if _, err := client.WorkspacesUpdate(ctx, id, payload); err != nil {
+			return fmt.Errorf("enabling replication for %s: %+v", id, err)
+		}
+		if err := logAnalyticsWorkspaceWaitForReplication(ctx, client, id); err != nil {
+			return err
+		}
+	}
+
+	if newFailoverActive && (!oldFailoverActive || locationChanged) {
+		// the failover is triggered against the workspace in the secondary region
+		failoverId := workspaces.NewLocationWorkspaceID(id.SubscriptionId, id.ResourceGroupName, newLocation, id.WorkspaceName)
+		log.Printf("[DEBUG] Failing over %s..", failoverId)
+		if err := client.WorkspacesFailoverThenPoll(ctx, failoverId); err != nil {
+			return fmt.Errorf("failing over %s: %+v", failoverId, err)
+		}
+	}
+
+	return nil
+}
+
+func logAnalyticsWorkspaceWaitForReplication(ctx context.Context, client *workspaces.WorkspacesClient, id workspaces.WorkspaceId) error {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("internal-error: context had no deadline")
+	}
+
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending: []string{
+			string(workspaces.WorkspaceReplicationStateDisableRequested),
+			string(workspaces.WorkspaceReplicationStateDisabling),
+			string(workspaces.WorkspaceReplicationStateEnableRequested),
+			string(workspaces.WorkspaceReplicationStateEnabling),
+			string(workspaces.WorkspaceReplicationStateRollbackRequested),
+			string(workspaces.WorkspaceReplicationStateRollingBack),
+		},
+		Target: []string{
+			string(workspaces.WorkspaceReplicationStateSucceeded),
+		},
+		MinTimeout: 30 * time.Second,
+		Timeout:    time.Until(deadline),
+		Refresh:    logAnalyticsWorkspaceReplicationRefresh(ctx, client, id),
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for the replication of %s to finish provisioning: %+v", id, err)
+	}
+
+	return nil
+}
+
+func logAnalyticsWorkspaceReplicationRefresh(ctx context.Context, client *workspaces.WorkspacesClient, id workspaces.WorkspaceId) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		log.Printf("[INFO] checking on the replication state of %s", id)
+
+		resp, err := client.WorkspacesGet(ctx, id)
+		if err != nil {
+			return nil, "", fmt.Errorf("polling for the replication state of %s: %+v", id, err)
+		}
+
+		if model := resp.Model; model != nil && model.Properties != nil && model.Properties.Replication != nil {
+			if state := model.Properties.Replication.ProvisioningState; state != nil {
+				return resp, string(*state), nil
+			}
+		}
+
+		// the replication state isn't returned until replication has been configured at least once
+		return resp, string(workspaces.WorkspaceReplicationStateSucceeded), nil
+	}
+}
+
+func expandLogAnalyticsWorkspaceReplication(input []interface{}) (string, bool) {
+	if len(input) == 0 || input[0] == nil {
+		return "", false
+	}
+
+	raw := input[0].(map[string]interface{})
+	return azure.NormalizeLocation(raw["location"].(string)), raw["failover_active"].(bool)
+}
+
+func flattenLogAnalyticsWorkspaceReplication(input *workspaces.WorkspaceProperties) []interface{} {
+	if input == nil || input.Replication == nil || input.Replication.Enabled == nil || !*input.Replication.Enabled {
+		return []interface{}{}
+	}
+
+	replicationLocation := ""
+	if input.Replication.Location != nil {
+		replicationLocation = azure.NormalizeLocation(*input.Replication.Location)
+	}
+
+	failoverActive := false
+	if failover := input.Failover; failover != nil && failover.State != nil {
+		failoverActive = *failover.State == workspaces.WorkspaceFailoverStateActive || *failover.State == workspaces.WorkspaceFailoverStateActivating
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"location":        replicationLocation,
+			"failover_active": failoverActive,
+		},
+	}
+}
@@ -11,8 +11,10 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/sdk/2025-02-01/workspaces"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -110,6 +112,29 @@ func resourceLogAnalyticsWorkspace() *pluginsdk.Resource {
 				ValidateFunc:     validation.FloatAtLeast(-1.0),
 			},
 
+			"replication": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"location": {
+							Type:             pluginsdk.TypeString,
+							Required:         true,
+							ValidateFunc:     location.EnhancedValidate,
+							StateFunc:        location.StateFunc,
+							DiffSuppressFunc: location.DiffSuppressFunc,
+						},
+
+						"failover_active": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+
 			"workspace_id": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
@@ -240,12 +265,21 @@ func resourceLogAnalyticsWorkspaceCreateUpdate(d *pluginsdk.ResourceData, meta i
 
 	d.SetId(id.ID())
 
+	if d.HasChange("replication") {
+		replicationClient := meta.(*clients.Client).LogAnalytics.WorkspaceReplicationClient
+		workspaceId := workspaces.NewWorkspaceID(id.SubscriptionId, id.ResourceGroup, id.WorkspaceName)
+		if err := logAnalyticsWorkspaceUpdateReplication(ctx, replicationClient, workspaceId, d); err != nil {
+			return err
+		}
+	}
+
 	return resourceLogAnalyticsWorkspaceRead(d, meta)
 }
 
 func resourceLogAnalyticsWorkspaceRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).LogAnalytics.WorkspacesClient
 	sharedKeysClient := meta.(*clients.Client).LogAnalytics.SharedKeysClient
+	replicationClient := meta.(*clients.Client).LogAnalytics.WorkspaceReplicationClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 	id, err := parse.LogAnalyticsWorkspaceID(d.Id())
@@ -290,6 +324,18 @@ func resourceLogAnalyticsWorkspaceRead(d *pluginsdk.ResourceData, meta interface
 		d.Set("daily_quota_gb", utils.Float(-1))
 	}
 
+	replicationResp, err := replicationClient.WorkspacesGet(ctx, workspaces.NewWorkspaceID(id.SubscriptionId, id.ResourceGroup, id.WorkspaceName))
+	if err != nil {
+		return fmt.Errorf("retrieving replication configuration for Log Analytics Workspace %q (Resource Group %q): %+v", id.WorkspaceName, id.ResourceGroup, err)
+	}
+	var replicationProps *workspaces.WorkspaceProperties
+	if model := replicationResp.Model; model != nil {
+		replicationProps = model.Properties
+	}
+	if err := d.Set("replication", flattenLogAnalyticsWorkspaceReplication(replicationProps)); err != nil {
+		return fmt.Errorf("setting `replication`: %+v", err)
+	}
+
 	sharedKeys, err := sharedKeysClient.GetSharedKeys(ctx, id.ResourceGroup, id.WorkspaceName)
 	if err != nil {
 		log.Printf("[ERROR] Unable to List Shared keys for Log Analytics workspaces %s: %+v", id.WorkspaceName, err)
@@ -0,0 +1,74 @@
+package workspaces
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+type WorkspacesFailbackResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// WorkspacesFailback ...
+func (c WorkspacesClient) WorkspacesFailback(ctx context.Context, id WorkspaceId) (result WorkspacesFailbackResponse, err error) {
+	req, err := c.preparerForWorkspacesFailback(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "workspaces.WorkspacesClient", "WorkspacesFailback", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForWorkspacesFailback(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "workspaces.WorkspacesClient", "WorkspacesFailback", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// WorkspacesFailbackThenPoll performs WorkspacesFailback then polls until it's completed
+func (c WorkspacesClient) WorkspacesFailbackThenPoll(ctx context.Context, id WorkspaceId) error {
+	result, err := c.WorkspacesFailback(ctx, id)
+	if err != nil {
+		return fmt.Errorf("performing WorkspacesFailback: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after WorkspacesFailback: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForWorkspacesFailback prepares the WorkspacesFailback request.
+func (c WorkspacesClient) preparerForWorkspacesFailback(ctx context.Context, id WorkspaceId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPost(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(fmt.Sprintf("%s/failback", id.ID())),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForWorkspacesFailback sends the WorkspacesFailback request. The method will close the
+// http.Response Body if it receives an error.
+func (c WorkspacesClient) senderForWorkspacesFailback(ctx context.Context, req *http.Request) (future WorkspacesFailbackResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
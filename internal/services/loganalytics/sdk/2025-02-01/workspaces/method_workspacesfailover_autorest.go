@@ -0,0 +1,74 @@
+package workspaces
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+type WorkspacesFailoverResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// WorkspacesFailover ...
+func (c WorkspacesClient) WorkspacesFailover(ctx context.Context, id LocationWorkspaceId) (result WorkspacesFailoverResponse, err error) {
+	req, err := c.preparerForWorkspacesFailover(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "workspaces.WorkspacesClient", "WorkspacesFailover", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForWorkspacesFailover(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "workspaces.WorkspacesClient", "WorkspacesFailover", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// WorkspacesFailoverThenPoll performs WorkspacesFailover then polls until it's completed
+func (c WorkspacesClient) WorkspacesFailoverThenPoll(ctx context.Context, id LocationWorkspaceId) error {
+	result, err := c.WorkspacesFailover(ctx, id)
+	if err != nil {
+		return fmt.Errorf("performing WorkspacesFailover: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after WorkspacesFailover: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForWorkspacesFailover prepares the WorkspacesFailover request.
+func (c WorkspacesClient) preparerForWorkspacesFailover(ctx context.Context, id LocationWorkspaceId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPost(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(fmt.Sprintf("%s/failover", id.ID())),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForWorkspacesFailover sends the WorkspacesFailover request. The method will close the
+// http.Response Body if it receives an error.
+func (c WorkspacesClient) senderForWorkspacesFailover(ctx context.Context, req *http.Request) (future WorkspacesFailoverResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
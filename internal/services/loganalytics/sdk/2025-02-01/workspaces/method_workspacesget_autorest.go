@@ -0,0 +1,64 @@
+package workspaces
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type WorkspacesGetResponse struct {
+	HttpResponse *http.Response
+	Model        *Workspace
+}
+
+// WorkspacesGet ...
+func (c WorkspacesClient) WorkspacesGet(ctx context.Context, id WorkspaceId) (result WorkspacesGetResponse, err error) {
+	req, err := c.preparerForWorkspacesGet(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "workspaces.WorkspacesClient", "WorkspacesGet", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "workspaces.WorkspacesClient", "WorkspacesGet", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForWorkspacesGet(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "workspaces.WorkspacesClient", "WorkspacesGet", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForWorkspacesGet prepares the WorkspacesGet request.
+func (c WorkspacesClient) preparerForWorkspacesGet(ctx context.Context, id WorkspaceId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForWorkspacesGet handles the response to the WorkspacesGet request. The method always
+// closes the http.Response Body.
+func (c WorkspacesClient) responderForWorkspacesGet(resp *http.Response) (result WorkspacesGetResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
@@ -0,0 +1,65 @@
+package workspaces
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type WorkspacesUpdateResponse struct {
+	HttpResponse *http.Response
+	Model        *Workspace
+}
+
+// WorkspacesUpdate ...
+func (c WorkspacesClient) WorkspacesUpdate(ctx context.Context, id WorkspaceId, input WorkspacePatch) (result WorkspacesUpdateResponse, err error) {
+	req, err := c.preparerForWorkspacesUpdate(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "workspaces.WorkspacesClient", "WorkspacesUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "workspaces.WorkspacesClient", "WorkspacesUpdate", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForWorkspacesUpdate(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "workspaces.WorkspacesClient", "WorkspacesUpdate", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForWorkspacesUpdate prepares the WorkspacesUpdate request.
+func (c WorkspacesClient) preparerForWorkspacesUpdate(ctx context.Context, id WorkspaceId, input WorkspacePatch) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPatch(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForWorkspacesUpdate handles the response to the WorkspacesUpdate request. The method always
+// closes the http.Response Body.
+func (c WorkspacesClient) responderForWorkspacesUpdate(resp *http.Response) (result WorkspacesUpdateResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
@@ -0,0 +1,6 @@
+package workspaces
+
+type WorkspaceFailoverProperties struct {
+	LastModifiedDate *string                 `json:"lastModifiedDate,omitempty"`
+	State            *WorkspaceFailoverState `json:"state,omitempty"`
+}
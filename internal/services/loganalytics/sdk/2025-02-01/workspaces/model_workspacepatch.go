@@ -0,0 +1,5 @@
+package workspaces
+
+type WorkspacePatch struct {
+	Properties *WorkspacePatchProperties `json:"properties,omitempty"`
+}
@@ -0,0 +1,5 @@
+package workspaces
+
+type WorkspacePatchProperties struct {
+	Replication *WorkspaceReplicationProperties `json:"replication,omitempty"`
+}
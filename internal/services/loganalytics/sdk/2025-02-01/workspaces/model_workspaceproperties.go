@@ -0,0 +1,8 @@
+package workspaces
+
+type WorkspaceProperties struct {
+	CustomerId        *string                         `json:"customerId,omitempty"`
+	Failover          *WorkspaceFailoverProperties    `json:"failover,omitempty"`
+	ProvisioningState *string                         `json:"provisioningState,omitempty"`
+	Replication       *WorkspaceReplicationProperties `json:"replication,omitempty"`
+}
@@ -0,0 +1,9 @@
+package workspaces
+
+type WorkspaceReplicationProperties struct {
+	CreatedDate       *string                    `json:"createdDate,omitempty"`
+	Enabled           *bool                      `json:"enabled,omitempty"`
+	LastModifiedDate  *string                    `json:"lastModifiedDate,omitempty"`
+	Location          *string                    `json:"location,omitempty"`
+	ProvisioningState *WorkspaceReplicationState `json:"provisioningState,omitempty"`
+}
@@ -0,0 +1,9 @@
+package workspaces
+
+import "fmt"
+
+const defaultApiVersion = "2025-02-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/workspaces/%s", defaultApiVersion)
+}
@@ -0,0 +1,179 @@
+package logic
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/logic/sdk/2016-06-01/connections"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func dataSourceApiConnection() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceApiConnectionRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupNameForDataSource(),
+
+			"location": azure.SchemaLocationForDataSource(),
+
+			"connection_runtime_url": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"display_name": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"managed_api_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"non_secret_parameter_values": {
+				Type:     pluginsdk.TypeMap,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"overall_status": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"status": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"error_code": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"error_message": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"status": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"target": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"tags": tags.SchemaDataSource(),
+		},
+	}
+}
+
+func dataSourceApiConnectionRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Logic.ConnectionsClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := connections.NewConnectionID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+
+	resp, err := client.Get(ctx, id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return fmt.Errorf("%s was not found", id)
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	d.Set("name", id.ConnectionName)
+	d.Set("resource_group_name", id.ResourceGroupName)
+
+	if model := resp.Model; model != nil {
+		d.Set("location", azure.NormalizeLocation(utils.NormalizeNilableString(model.Location)))
+
+		if props := model.Properties; props != nil {
+			d.Set("connection_runtime_url", utils.NormalizeNilableString(props.ConnectionRuntimeUrl))
+			d.Set("display_name", utils.NormalizeNilableString(props.DisplayName))
+			d.Set("overall_status", utils.NormalizeNilableString(props.OverallStatus))
+
+			managedApiId := ""
+			if props.Api != nil {
+				managedApiId = utils.NormalizeNilableString(props.Api.Id)
+			}
+			d.Set("managed_api_id", managedApiId)
+
+			nonSecretParameterValues := make(map[string]interface{})
+			if props.NonSecretParameterValues != nil {
+				for k, v := range *props.NonSecretParameterValues {
+					nonSecretParameterValues[k] = v
+				}
+			}
+			if err := d.Set("non_secret_parameter_values", nonSecretParameterValues); err != nil {
+				return fmt.Errorf("setting `non_secret_parameter_values`: %+v", err)
+			}
+
+			if err := d.Set("status", flattenApiConnectionStatuses(props.Statuses)); err != nil {
+				return fmt.Errorf("setting `status`: %+v", err)
+			}
+		}
+
+		if model.Tags != nil {
+			return tags.FlattenAndSet(d, tags.FromTypedObject(*model.Tags))
+		}
+	}
+
+	return nil
+}
+
+func flattenApiConnectionStatuses(input *[]connections.ConnectionStatusDefinition) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		errorCode := ""
+		errorMessage := ""
+		if item.Error != nil && item.Error.Properties != nil {
+			errorCode = utils.NormalizeNilableString(item.Error.Properties.Code)
+			errorMessage = utils.NormalizeNilableString(item.Error.Properties.Message)
+		}
+
+		results = append(results, map[string]interface{}{
+			"error_code":    errorCode,
+			"error_message": errorMessage,
+			"status":        utils.NormalizeNilableString(item.Status),
+			"target":        utils.NormalizeNilableString(item.Target),
+		})
+	}
+
+	return results
+}
@@ -0,0 +1,92 @@
+package logic_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type ApiConnectionDataSource struct{}
+
+func TestAccApiConnectionDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_api_connection", "test")
+	r := ApiConnectionDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("display_name").HasValue("Service Bus"),
+				check.That(data.ResourceName).Key("managed_api_id").Exists(),
+				check.That(data.ResourceName).Key("status.#").Exists(),
+				check.That(data.ResourceName).Key("tags.%").HasValue("1"),
+			),
+		},
+	})
+}
+
+func (ApiConnectionDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-logic-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_servicebus_namespace" "test" {
+  name                = "acctestsbn-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "Basic"
+}
+
+data "azurerm_managed_api" "test" {
+  name     = "servicebus"
+  location = azurerm_resource_group.test.location
+}
+
+# there's no dedicated resource for API Connections, so these are provisioned using a template
+resource "azurerm_resource_group_template_deployment" "test" {
+  name                = "acctest-conn-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  deployment_mode     = "Incremental"
+
+  template_content = jsonencode({
+    "$schema"      = "https://schema.management.azure.com/schemas/2019-04-01/deploymentTemplate.json#"
+    contentVersion = "1.0.0.0"
+    resources = [
+      {
+        type       = "Microsoft.Web/connections"
+        apiVersion = "2016-06-01"
+        name       = "acctestconn-%[1]d"
+        location   = azurerm_resource_group.test.location
+        tags = {
+          environment = "acctest"
+        }
+        properties = {
+          displayName = "Service Bus"
+          api = {
+            id = data.azurerm_managed_api.test.id
+          }
+          parameterValues = {
+            connectionString = azurerm_servicebus_namespace.test.default_primary_connection_string
+          }
+        }
+      }
+    ]
+  })
+}
+
+data "azurerm_api_connection" "test" {
+  name                = "acctestconn-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+
+  depends_on = [azurerm_resource_group_template_deployment.test]
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
@@ -3,9 +3,12 @@ package client
 import (
 	"github.com/Azure/azure-sdk-for-go/services/logic/mgmt/2019-05-01/logic"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/logic/sdk/2016-06-01/connections"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/logic/sdk/2016-06-01/managedapis"
 )
 
 type Client struct {
+	ConnectionsClient                          *connections.ConnectionsClient
 	IntegrationAccountClient                   *logic.IntegrationAccountsClient
 	IntegrationAccountAgreementClient          *logic.IntegrationAccountAgreementsClient
 	IntegrationAccountAssemblyClient           *logic.IntegrationAccountAssembliesClient
@@ -16,11 +19,15 @@ type Client struct {
 	IntegrationAccountSchemaClient             *logic.IntegrationAccountSchemasClient
 	IntegrationAccountSessionClient            *logic.IntegrationAccountSessionsClient
 	IntegrationServiceEnvironmentClient        *logic.IntegrationServiceEnvironmentsClient
+	ManagedApisClient                          *managedapis.ManagedAPIsClient
 	WorkflowClient                             *logic.WorkflowsClient
 	TriggersClient                             *logic.WorkflowTriggersClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
+	connectionsClient := connections.NewConnectionsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&connectionsClient.Client, o.ResourceManagerAuthorizer)
+
 	integrationAccountClient := logic.NewIntegrationAccountsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&integrationAccountClient.Client, o.ResourceManagerAuthorizer)
 
@@ -51,6 +58,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	integrationServiceEnvironmentClient := logic.NewIntegrationServiceEnvironmentsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&integrationServiceEnvironmentClient.Client, o.ResourceManagerAuthorizer)
 
+	managedApisClient := managedapis.NewManagedAPIsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&managedApisClient.Client, o.ResourceManagerAuthorizer)
+
 	workflowClient := logic.NewWorkflowsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&workflowClient.Client, o.ResourceManagerAuthorizer)
 
@@ -58,6 +68,7 @@ func NewClient(o *common.ClientOptions) *Client {
 	o.ConfigureClient(&triggersClient.Client, o.ResourceManagerAuthorizer)
 
 	return &Client{
+		ConnectionsClient:                          &connectionsClient,
 		IntegrationAccountClient:                   &integrationAccountClient,
 		IntegrationAccountAgreementClient:          &integrationAccountAgreementClient,
 		IntegrationAccountAssemblyClient:           &integrationAccountAssemblyClient,
@@ -68,6 +79,7 @@ func NewClient(o *common.ClientOptions) *Client {
 		IntegrationAccountSchemaClient:             &integrationAccountSchemaClient,
 		IntegrationAccountSessionClient:            &integrationAccountSessionClient,
 		IntegrationServiceEnvironmentClient:        &integrationServiceEnvironmentClient,
+		ManagedApisClient:                          &managedApisClient,
 		WorkflowClient:                             &workflowClient,
 		TriggersClient:                             &triggersClient,
 	}
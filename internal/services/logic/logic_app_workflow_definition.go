@@ -0,0 +1,353 @@
+package logic
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/logic/mgmt/2019-05-01/logic"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/logic/sdk/2016-06-01/connections"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/logic/sdk/2016-06-01/managedapis"
+	msiParser "github.com/hashicorp/terraform-provider-azurerm/internal/services/msi/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// logicAppWorkflowConnectionsParameterName is the name of the Workflow Parameter used by Actions and Triggers to
+// reference the API Connections used by the Logic App Workflow
+const logicAppWorkflowConnectionsParameterName = "$connections"
+
+// parseLogicAppWorkflowParameterType returns the Parameter Type for the `type` within a Parameter Definition, which
+// is case-insensitive
+func parseLogicAppWorkflowParameterType(input string) logic.ParameterType {
+	for _, v := range logic.PossibleParameterTypeValues() {
+		if strings.EqualFold(string(v), input) {
+			return v
+		}
+	}
+
+	return logic.ParameterType(input)
+}
+
+// suppressLogicAppWorkflowParameterDefinitionDiff suppresses the diff between two JSON encoded Parameter Definitions
+// when these are equivalent, since the API returns the keys in a different order, the `type` in a different casing
+// and omits any empty `metadata` and `allowedValues`
+func suppressLogicAppWorkflowParameterDefinitionDiff(k, old, new string, _ *pluginsdk.ResourceData) bool {
+	if strings.HasSuffix(k, ".%") || old == "" || new == "" {
+		return false
+	}
+
+	oldDefinition, err := normalizeLogicAppWorkflowParameterDefinition(old)
+	if err != nil {
+		return false
+	}
+	newDefinition, err := normalizeLogicAppWorkflowParameterDefinition(new)
+	if err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(oldDefinition, newDefinition)
+}
+
+func normalizeLogicAppWorkflowParameterDefinition(input string) (map[string]interface{}, error) {
+	var definition map[string]interface{}
+	if err := json.Unmarshal([]byte(input), &definition); err != nil {
+		return nil, err
+	}
+	if definition == nil {
+		return nil, fmt.Errorf("the Parameter Definition must be a JSON object")
+	}
+
+	if v, ok := definition["type"].(string); ok {
+		definition["type"] = strings.ToLower(v)
+	}
+
+	if v, ok := definition["defaultValue"]; ok && v == nil {
+		delete(definition, "defaultValue")
+	}
+	if v, ok := definition["metadata"]; ok {
+		if metadata, isMap := v.(map[string]interface{}); v == nil || (isMap && len(metadata) == 0) {
+			delete(definition, "metadata")
+		}
+	}
+	if v, ok := definition["allowedValues"]; ok {
+		if allowedValues, isList := v.([]interface{}); v == nil || (isList && len(allowedValues) == 0) {
+			delete(definition, "allowedValues")
+		}
+	}
+
+	return definition, nil
+}
+
+// suppressLogicAppWorkflowParameterValueDiff suppresses the diff between the JSON encoded values of two Array or
+// Object Parameters when these are equivalent, since the API returns the keys of objects in a different order
+func suppressLogicAppWorkflowParameterValueDiff(k, old, new string, _ *pluginsdk.ResourceData) bool {
+	if strings.HasSuffix(k, ".%") || !isJsonArrayOrObject(old) || !isJsonArrayOrObject(new) {
+		return false
+	}
+
+	var oldValue, newValue interface{}
+	if err := json.Unmarshal([]byte(old), &oldValue); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(new), &newValue); err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(oldValue, newValue)
+}
+
+func isJsonArrayOrObject(input string) bool {
+	v := strings.TrimSpace(input)
+	return strings.HasPrefix(v, "{") || strings.HasPrefix(v, "[")
+}
+
+// expandLogicAppWorkflowApiConnections adds the `$connections` Parameter Definition and Parameter used by Actions and
+// Triggers to reference the API Connections to the Workflow Parameters and Parameters
+func expandLogicAppWorkflowApiConnections(input []interface{}, workflowParameters map[string]interface{}, parameters map[string]*logic.WorkflowParameter) (map[string]interface{}, error) {
+	if len(input) == 0 {
+		return workflowParameters, nil
+	}
+
+	if _, ok := workflowParameters[logicAppWorkflowConnectionsParameterName]; ok {
+		return nil, fmt.Errorf("the `%s` parameter cannot be specified within `workflow_parameters` when `api_connection` is specified", logicAppWorkflowConnectionsParameterName)
+	}
+
+	value := make(map[string]interface{})
+	for _, item := range input {
+		v := item.(map[string]interface{})
+		name := v["name"].(string)
+
+		connectionId, err := connections.ParseConnectionID(v["connection_id"].(string))
+		if err != nil {
+			return nil, err
+		}
+
+		connection := map[string]interface{}{
+			"connectionId":   connectionId.ID(),
+			"connectionName": connectionId.ConnectionName,
+			"id":             v["managed_api_id"].(string),
+		}
+
+		userAssignedIdentityId := v["user_assigned_identity_id"].(string)
+		if v["managed_identity_authentication_enabled"].(bool) {
+			authentication := map[string]interface{}{
+				"type": "ManagedServiceIdentity",
+			}
+			if userAssignedIdentityId != "" {
+				authentication["identity"] = userAssignedIdentityId
+			}
+			connection["connectionProperties"] = map[string]interface{}{
+				"authentication": authentication,
+			}
+		} else if userAssignedIdentityId != "" {
+			return nil, fmt.Errorf("`user_assigned_identity_id` can only be specified for the API Connection %q when `managed_identity_authentication_enabled` is set to `true`", name)
+		}
+
+		value[name] = connection
+	}
+
+	if workflowParameters == nil {
+		workflowParameters = make(map[string]interface{})
+	}
+	workflowParameters[logicAppWorkflowConnectionsParameterName] = map[string]interface{}{
+		"type":         string(logic.ParameterTypeObject),
+		"defaultValue": map[string]interface{}{},
+	}
+	parameters[logicAppWorkflowConnectionsParameterName] = &logic.WorkflowParameter{
+		Type:  logic.ParameterTypeObject,
+		Value: value,
+	}
+
+	return workflowParameters, nil
+}
+
+func flattenLogicAppWorkflowApiConnections(input *logic.WorkflowParameter) ([]interface{}, error) {
+	results := make([]interface{}, 0)
+	if input == nil || input.Value == nil {
+		return results, nil
+	}
+
+	value, ok := input.Value.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("the value of parameter %s is expected to be map[string]interface{}, but got %T", logicAppWorkflowConnectionsParameterName, input.Value)
+	}
+
+	for name, raw := range value {
+		connection, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		connectionId := ""
+		if v, ok := connection["connectionId"].(string); ok {
+			parsed, err := connections.ParseConnectionIDInsensitively(v)
+			if err != nil {
+				return nil, err
+			}
+			connectionId = parsed.ID()
+		}
+
+		managedApiId := ""
+		if v, ok := connection["id"].(string); ok {
+			parsed, err := managedapis.ParseManagedApiIDInsensitively(v)
+			if err != nil {
+				return nil, err
+			}
+			managedApiId = parsed.ID()
+		}
+
+		managedIdentityAuthenticationEnabled := false
+		userAssignedIdentityId := ""
+		if properties, ok := connection["connectionProperties"].(map[string]interface{}); ok {
+			if authentication, ok := properties["authentication"].(map[string]interface{}); ok {
+				if v, ok := authentication["type"].(string); ok && strings.EqualFold(v, "ManagedServiceIdentity") {
+					managedIdentityAuthenticationEnabled = true
+				}
+				if v, ok := authentication["identity"].(string); ok && v != "" {
+					parsed, err := msiParser.UserAssignedIdentityIDInsensitively(v)
+					if err != nil {
+						return nil, err
+					}
+					userAssignedIdentityId = parsed.ID()
+				}
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":           name,
+			"connection_id":  connectionId,
+			"managed_api_id": managedApiId,
+			"managed_identity_authentication_enabled": managedIdentityAuthenticationEnabled,
+			"user_assigned_identity_id":               userAssignedIdentityId,
+		})
+	}
+
+	return results, nil
+}
+
+func isLogicAppWorkflowSecureParameterType(input logic.ParameterType) bool {
+	return input == logic.ParameterTypeSecureString || input == logic.ParameterTypeSecureObject
+}
+
+// expandLogicAppWorkflowTypedParameters adds the Parameter Definition and Parameter for each `parameter` block to the
+// Workflow Parameters and Parameters
+func expandLogicAppWorkflowTypedParameters(input []interface{}, workflowParameters map[string]interface{}, parameters map[string]*logic.WorkflowParameter) (map[string]interface{}, error) {
+	if len(input) == 0 {
+		return workflowParameters, nil
+	}
+
+	if workflowParameters == nil {
+		workflowParameters = make(map[string]interface{})
+	}
+	for _, item := range input {
+		v := item.(map[string]interface{})
+		name := v["name"].(string)
+		parameterType := parseLogicAppWorkflowParameterType(v["type"].(string))
+
+		if _, ok := workflowParameters[name]; ok {
+			return nil, fmt.Errorf("the parameter %q cannot be specified within both `parameter` and `workflow_parameters`", name)
+		}
+
+		value := v["value"].(string)
+		secureValue := v["secure_value"].(string)
+		if isLogicAppWorkflowSecureParameterType(parameterType) {
+			if value != "" {
+				return nil, fmt.Errorf("`value` cannot be specified for the %s parameter %q, `secure_value` must be used instead", parameterType, name)
+			}
+			value = secureValue
+		} else if secureValue != "" {
+			return nil, fmt.Errorf("`secure_value` can only be specified for the parameter %q when `type` is `%s` or `%s`", name, logic.ParameterTypeSecureString, logic.ParameterTypeSecureObject)
+		}
+
+		if value == "" && parameterType != logic.ParameterTypeString && parameterType != logic.ParameterTypeSecureString {
+			return nil, fmt.Errorf("a value must be specified for the %s parameter %q", parameterType, name)
+		}
+
+		expanded, err := expandLogicAppWorkflowParameterValue(name, parameterType, value)
+		if err != nil {
+			return nil, err
+		}
+
+		workflowParameters[name] = map[string]interface{}{
+			"type": string(parameterType),
+		}
+		parameters[name] = &logic.WorkflowParameter{
+			Type:  parameterType,
+			Value: expanded,
+		}
+	}
+
+	return workflowParameters, nil
+}
+
+// flattenLogicAppWorkflowTypedParameters flattens the Parameters which are defined using a `parameter` block within
+// the state. The values of Secure Parameters aren't returned from the API and so are retained from the state, as are
+// the values of Array and Object Parameters which are equivalent to the value returned from the API
+func flattenLogicAppWorkflowTypedParameters(inState []interface{}, definitions map[string]interface{}, values map[string]*logic.WorkflowParameter) ([]interface{}, error) {
+	results := make([]interface{}, 0)
+	for _, item := range inState {
+		existing := item.(map[string]interface{})
+		name := existing["name"].(string)
+
+		definition, ok := definitions[name].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		definitionType, _ := definition["type"].(string)
+		parameterType := parseLogicAppWorkflowParameterType(definitionType)
+
+		value := ""
+		secureValue := ""
+		if isLogicAppWorkflowSecureParameterType(parameterType) {
+			secureValue = existing["secure_value"].(string)
+		} else if v := values[name]; v != nil {
+			flattened, err := flattenLogicAppWorkflowParameterValue(name, parameterType, v)
+			if err != nil {
+				return nil, err
+			}
+			value = flattened
+
+			if existingValue := existing["value"].(string); suppressLogicAppWorkflowParameterValueDiff("", existingValue, value, nil) {
+				value = existingValue
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":         name,
+			"type":         string(parameterType),
+			"value":        value,
+			"secure_value": secureValue,
+		})
+	}
+
+	return results, nil
+}
+
+// withoutLogicAppWorkflowTypedParameters returns the Parameter Definitions and Parameters excluding those which are
+// defined using a `parameter` block
+func withoutLogicAppWorkflowTypedParameters(typedParameters []interface{}, definitions map[string]interface{}, values map[string]*logic.WorkflowParameter) (map[string]interface{}, map[string]*logic.WorkflowParameter) {
+	if len(typedParameters) == 0 {
+		return definitions, values
+	}
+
+	names := make(map[string]struct{})
+	for _, item := range typedParameters {
+		names[item.(map[string]interface{})["name"].(string)] = struct{}{}
+	}
+
+	outputDefinitions := make(map[string]interface{})
+	for k, v := range definitions {
+		if _, ok := names[k]; !ok {
+			outputDefinitions[k] = v
+		}
+	}
+	outputValues := make(map[string]*logic.WorkflowParameter)
+	for k, v := range values {
+		if _, ok := names[k]; !ok {
+			outputValues[k] = v
+		}
+	}
+
+	return outputDefinitions, outputValues
+}
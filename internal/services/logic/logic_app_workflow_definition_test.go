@@ -0,0 +1,306 @@
+package logic
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/logic/mgmt/2019-05-01/logic"
+)
+
+func TestSuppressLogicAppWorkflowParameterDefinitionDiff(t *testing.T) {
+	testData := []struct {
+		name     string
+		old      string
+		new      string
+		suppress bool
+	}{
+		{
+			name:     "identical",
+			old:      `{"type":"String"}`,
+			new:      `{"type":"String"}`,
+			suppress: true,
+		},
+		{
+			name:     "key ordering",
+			old:      `{"defaultValue":"abc","type":"String"}`,
+			new:      `{"type":"String","defaultValue":"abc"}`,
+			suppress: true,
+		},
+		{
+			name:     "type casing",
+			old:      `{"type":"String"}`,
+			new:      `{"type":"string"}`,
+			suppress: true,
+		},
+		{
+			name:     "empty metadata",
+			old:      `{"type":"Object"}`,
+			new:      `{"type":"Object","metadata":{},"allowedValues":[]}`,
+			suppress: true,
+		},
+		{
+			name:     "different type",
+			old:      `{"type":"String"}`,
+			new:      `{"type":"SecureString"}`,
+			suppress: false,
+		},
+		{
+			name:     "different default value",
+			old:      `{"type":"String","defaultValue":"abc"}`,
+			new:      `{"type":"String","defaultValue":""}`,
+			suppress: false,
+		},
+		{
+			name:     "new parameter",
+			old:      ``,
+			new:      `{"type":"String"}`,
+			suppress: false,
+		},
+		{
+			name:     "invalid json",
+			old:      `{"type":"String"}`,
+			new:      `{"type":`,
+			suppress: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Run(v.name, func(t *testing.T) {
+			if actual := suppressLogicAppWorkflowParameterDefinitionDiff("workflow_parameters.example", v.old, v.new, nil); actual != v.suppress {
+				t.Fatalf("expected %t but got %t", v.suppress, actual)
+			}
+		})
+	}
+}
+
+func TestSuppressLogicAppWorkflowParameterValueDiff(t *testing.T) {
+	testData := []struct {
+		name     string
+		old      string
+		new      string
+		suppress bool
+	}{
+		{
+			name:     "object key ordering",
+			old:      `{"array":[1,2,3],"s":"foo"}`,
+			new:      `{"s": "foo", "array": [1, 2, 3]}`,
+			suppress: true,
+		},
+		{
+			name:     "array ordering",
+			old:      `[1,2,3]`,
+			new:      `[3,2,1]`,
+			suppress: false,
+		},
+		{
+			name:     "numbers",
+			old:      `1`,
+			new:      `1.0`,
+			suppress: false,
+		},
+		{
+			name:     "strings",
+			old:      `abc`,
+			new:      `abc `,
+			suppress: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Run(v.name, func(t *testing.T) {
+			if actual := suppressLogicAppWorkflowParameterValueDiff("parameters.example", v.old, v.new, nil); actual != v.suppress {
+				t.Fatalf("expected %t but got %t", v.suppress, actual)
+			}
+		})
+	}
+}
+
+func TestExpandLogicAppWorkflowApiConnections(t *testing.T) {
+	input := []interface{}{
+		map[string]interface{}{
+			"name":           "office365",
+			"connection_id":  "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Web/connections/connection1",
+			"managed_api_id": "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Web/locations/westeurope/managedApis/office365",
+			"managed_identity_authentication_enabled": true,
+			"user_assigned_identity_id":               "",
+		},
+	}
+	parameters := map[string]*logic.WorkflowParameter{}
+
+	workflowParameters, err := expandLogicAppWorkflowApiConnections(input, nil, parameters)
+	if err != nil {
+		t.Fatalf("expanding: %+v", err)
+	}
+	if _, ok := workflowParameters[logicAppWorkflowConnectionsParameterName]; !ok {
+		t.Fatalf("expected the %q parameter to be defined", logicAppWorkflowConnectionsParameterName)
+	}
+
+	flattened, err := flattenLogicAppWorkflowApiConnections(parameters[logicAppWorkflowConnectionsParameterName])
+	if err != nil {
+		t.Fatalf("flattening: %+v", err)
+	}
+	if len(flattened) != 1 {
+		t.Fatalf("expected 1 API Connection but got %d", len(flattened))
+	}
+	actual := flattened[0].(map[string]interface{})
+	for k, v := range input[0].(map[string]interface{}) {
+		if actual[k] != v {
+			t.Fatalf("expected %q to be %v but got %v", k, v, actual[k])
+		}
+	}
+
+	if _, err := expandLogicAppWorkflowApiConnections(input, workflowParameters, parameters); err == nil {
+		t.Fatalf("expected an error when the %q parameter is already defined but didn't get one", logicAppWorkflowConnectionsParameterName)
+	}
+}
+
+func TestExpandLogicAppWorkflowTypedParameters(t *testing.T) {
+	testData := []struct {
+		name  string
+		input map[string]interface{}
+		value interface{}
+		error bool
+	}{
+		{
+			name: "string",
+			input: map[string]interface{}{
+				"name":         "example",
+				"type":         "String",
+				"value":        "hello",
+				"secure_value": "",
+			},
+			value: "hello",
+		},
+		{
+			name: "object",
+			input: map[string]interface{}{
+				"name":         "example",
+				"type":         "Object",
+				"value":        `{"foo":"bar"}`,
+				"secure_value": "",
+			},
+			value: map[string]interface{}{"foo": "bar"},
+		},
+		{
+			name: "secure string",
+			input: map[string]interface{}{
+				"name":         "example",
+				"type":         "SecureString",
+				"value":        "",
+				"secure_value": "s3cr3t",
+			},
+			value: "s3cr3t",
+		},
+		{
+			name: "secure string using value",
+			input: map[string]interface{}{
+				"name":         "example",
+				"type":         "SecureString",
+				"value":        "s3cr3t",
+				"secure_value": "",
+			},
+			error: true,
+		},
+		{
+			name: "secure value for a non-secure type",
+			input: map[string]interface{}{
+				"name":         "example",
+				"type":         "Int",
+				"value":        "",
+				"secure_value": "123",
+			},
+			error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Run(v.name, func(t *testing.T) {
+			parameters := map[string]*logic.WorkflowParameter{}
+			workflowParameters, err := expandLogicAppWorkflowTypedParameters([]interface{}{v.input}, nil, parameters)
+			if err != nil {
+				if v.error {
+					return
+				}
+				t.Fatalf("expanding: %+v", err)
+			}
+			if v.error {
+				t.Fatalf("expected an error but didn't get one")
+			}
+
+			definition := workflowParameters["example"].(map[string]interface{})
+			if definition["type"] != v.input["type"] {
+				t.Fatalf("expected the type to be %q but got %q", v.input["type"], definition["type"])
+			}
+			if !reflect.DeepEqual(parameters["example"].Value, v.value) {
+				t.Fatalf("expected the value to be %+v but got %+v", v.value, parameters["example"].Value)
+			}
+		})
+	}
+
+	if _, err := expandLogicAppWorkflowTypedParameters([]interface{}{testData[0].input}, map[string]interface{}{"example": map[string]interface{}{}}, map[string]*logic.WorkflowParameter{}); err == nil {
+		t.Fatalf("expected an error when the parameter is also defined within `workflow_parameters` but didn't get one")
+	}
+}
+
+func TestFlattenLogicAppWorkflowTypedParameters(t *testing.T) {
+	inState := []interface{}{
+		map[string]interface{}{
+			"name":         "obj",
+			"type":         "Object",
+			"value":        `{ "b": 2, "a": 1 }`,
+			"secure_value": "",
+		},
+		map[string]interface{}{
+			"name":         "secret",
+			"type":         "SecureString",
+			"value":        "",
+			"secure_value": "s3cr3t",
+		},
+		map[string]interface{}{
+			"name":         "removed",
+			"type":         "String",
+			"value":        "hello",
+			"secure_value": "",
+		},
+	}
+	definitions := map[string]interface{}{
+		"obj":    map[string]interface{}{"type": "object"},
+		"secret": map[string]interface{}{"type": "SecureString"},
+		"other":  map[string]interface{}{"type": "String"},
+	}
+	values := map[string]*logic.WorkflowParameter{
+		"obj":    {Type: logic.ParameterTypeObject, Value: map[string]interface{}{"a": float64(1), "b": float64(2)}},
+		"secret": {Type: logic.ParameterTypeSecureString},
+		"other":  {Type: logic.ParameterTypeString, Value: "other"},
+	}
+
+	actual, err := flattenLogicAppWorkflowTypedParameters(inState, definitions, values)
+	if err != nil {
+		t.Fatalf("flattening: %+v", err)
+	}
+	expected := []interface{}{
+		map[string]interface{}{
+			"name":         "obj",
+			"type":         "Object",
+			"value":        `{ "b": 2, "a": 1 }`,
+			"secure_value": "",
+		},
+		map[string]interface{}{
+			"name":         "secret",
+			"type":         "SecureString",
+			"value":        "",
+			"secure_value": "s3cr3t",
+		},
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected %+v but got %+v", expected, actual)
+	}
+
+	remainingDefinitions, remainingValues := withoutLogicAppWorkflowTypedParameters(actual, definitions, values)
+	if len(remainingDefinitions) != 1 || remainingDefinitions["other"] == nil {
+		t.Fatalf("expected only the `other` Parameter Definition to remain but got %+v", remainingDefinitions)
+	}
+	if len(remainingValues) != 1 || remainingValues["other"] == nil {
+		t.Fatalf("expected only the `other` Parameter to remain but got %+v", remainingValues)
+	}
+}
@@ -16,8 +16,11 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/logic/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/logic/sdk/2016-06-01/connections"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/logic/sdk/2016-06-01/managedapis"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/logic/validate"
 	msiParser "github.com/hashicorp/terraform-provider-azurerm/internal/services/msi/parse"
+	msiValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/msi/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -206,13 +209,91 @@ func resourceLogicAppWorkflow() *pluginsdk.Resource {
 				ValidateFunc: validate.IntegrationAccountID,
 			},
 
-			// TODO: should Parameters be split out into their own object to allow validation on the different sub-types?
+			"api_connection": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"connection_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: connections.ValidateConnectionID,
+						},
+
+						"managed_api_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: managedapis.ValidateManagedApiID,
+						},
+
+						"managed_identity_authentication_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"user_assigned_identity_id": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: msiValidate.UserAssignedIdentityID,
+						},
+					},
+				},
+			},
+
+			"parameter": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"type": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(logic.ParameterTypeArray),
+								string(logic.ParameterTypeBool),
+								string(logic.ParameterTypeFloat),
+								string(logic.ParameterTypeInt),
+								string(logic.ParameterTypeObject),
+								string(logic.ParameterTypeSecureObject),
+								string(logic.ParameterTypeSecureString),
+								string(logic.ParameterTypeString),
+							}, false),
+						},
+
+						"value": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+						},
+
+						"secure_value": {
+							Type:      pluginsdk.TypeString,
+							Optional:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+
 			"parameters": {
 				Type:     pluginsdk.TypeMap,
 				Optional: true,
 				Elem: &pluginsdk.Schema{
 					Type: pluginsdk.TypeString,
 				},
+				DiffSuppressFunc: suppressLogicAppWorkflowParameterValueDiff,
 			},
 
 			"enabled": {
@@ -241,6 +322,7 @@ func resourceLogicAppWorkflow() *pluginsdk.Resource {
 				Elem: &pluginsdk.Schema{
 					Type: pluginsdk.TypeString,
 				},
+				DiffSuppressFunc: suppressLogicAppWorkflowParameterDefinitionDiff,
 			},
 
 			"access_endpoint": {
@@ -310,6 +392,14 @@ func resourceLogicAppWorkflowCreate(d *pluginsdk.ResourceData, meta interface{})
 	if err != nil {
 		return err
 	}
+	workflowParameters, err = expandLogicAppWorkflowTypedParameters(d.Get("parameter").(*pluginsdk.Set).List(), workflowParameters, parameters)
+	if err != nil {
+		return fmt.Errorf("expanding `parameter`: %+v", err)
+	}
+	workflowParameters, err = expandLogicAppWorkflowApiConnections(d.Get("api_connection").(*pluginsdk.Set).List(), workflowParameters, parameters)
+	if err != nil {
+		return fmt.Errorf("expanding `api_connection`: %+v", err)
+	}
 	t := d.Get("tags").(map[string]interface{})
 
 	isEnabled := logic.WorkflowStateEnabled
@@ -401,6 +491,14 @@ func resourceLogicAppWorkflowUpdate(d *pluginsdk.ResourceData, meta interface{})
 	if err != nil {
 		return err
 	}
+	workflowParameters, err = expandLogicAppWorkflowTypedParameters(d.Get("parameter").(*pluginsdk.Set).List(), workflowParameters, parameters)
+	if err != nil {
+		return fmt.Errorf("expanding `parameter`: %+v", err)
+	}
+	workflowParameters, err = expandLogicAppWorkflowApiConnections(d.Get("api_connection").(*pluginsdk.Set).List(), workflowParameters, parameters)
+	if err != nil {
+		return fmt.Errorf("expanding `api_connection`: %+v", err)
+	}
 
 	t := d.Get("tags").(map[string]interface{})
 
@@ -513,7 +611,45 @@ func resourceLogicAppWorkflowRead(d *pluginsdk.ResourceData, meta interface{}) e
 					d.Set("workflow_version", v["contentVersion"].(string))
 				}
 				if p, ok := v["parameters"]; ok {
-					workflowParameters, err := flattenLogicAppWorkflowWorkflowParameters(p.(map[string]interface{}))
+					parameterDefinitions := p.(map[string]interface{})
+					parameterValues := props.Parameters
+
+					// the `$connections` parameter is exposed as `api_connection` - unless it's been defined within `workflow_parameters`
+					apiConnections := make([]interface{}, 0)
+					if _, ok := d.Get("workflow_parameters").(map[string]interface{})[logicAppWorkflowConnectionsParameterName]; !ok {
+						apiConnections, err = flattenLogicAppWorkflowApiConnections(parameterValues[logicAppWorkflowConnectionsParameterName])
+						if err != nil {
+							return fmt.Errorf("flattening `api_connection`: %+v", err)
+						}
+
+						parameterDefinitions = make(map[string]interface{})
+						for k, v := range p.(map[string]interface{}) {
+							if k != logicAppWorkflowConnectionsParameterName {
+								parameterDefinitions[k] = v
+							}
+						}
+						parameterValues = make(map[string]*logic.WorkflowParameter)
+						for k, v := range props.Parameters {
+							if k != logicAppWorkflowConnectionsParameterName {
+								parameterValues[k] = v
+							}
+						}
+					}
+					if err := d.Set("api_connection", apiConnections); err != nil {
+						return fmt.Errorf("setting `api_connection`: %+v", err)
+					}
+
+					// the Parameters defined using a `parameter` block are exposed there, rather than within `workflow_parameters` and `parameters`
+					typedParameters, err := flattenLogicAppWorkflowTypedParameters(d.Get("parameter").(*pluginsdk.Set).List(), parameterDefinitions, parameterValues)
+					if err != nil {
+						return fmt.Errorf("flattening `parameter`: %+v", err)
+					}
+					if err := d.Set("parameter", typedParameters); err != nil {
+						return fmt.Errorf("setting `parameter`: %+v", err)
+					}
+					parameterDefinitions, parameterValues = withoutLogicAppWorkflowTypedParameters(typedParameters, parameterDefinitions, parameterValues)
+
+					workflowParameters, err := flattenLogicAppWorkflowWorkflowParameters(parameterDefinitions)
 					if err != nil {
 						return fmt.Errorf("flattening `workflow_parameters`: %+v", err)
 					}
@@ -523,7 +659,7 @@ func resourceLogicAppWorkflowRead(d *pluginsdk.ResourceData, meta interface{}) e
 
 					// The props.Parameters (the value of the param) is accompany with the "parameters" (the definition of the param) inside the props.Definition.
 					// We will need to make use of the definition of the parameters in order to properly flatten the value of the parameters being set (for kinds of types).
-					parameters, err := flattenLogicAppWorkflowParameters(d, props.Parameters, p.(map[string]interface{}))
+					parameters, err := flattenLogicAppWorkflowParameters(d, parameterValues, parameterDefinitions)
 					if err != nil {
 						return fmt.Errorf("flattening `parameters`: %v", err)
 					}
@@ -589,46 +725,11 @@ func expandLogicAppWorkflowParameters(input map[string]interface{}, paramDefs ma
 			return nil, fmt.Errorf("no parameter definition for %s", k)
 		}
 		def := defRaw.(map[string]interface{})
-		t := logic.ParameterType(def["type"].(string))
-
-		v := v.(string)
+		t := parseLogicAppWorkflowParameterType(def["type"].(string))
 
-		var value interface{}
-		switch t {
-		case logic.ParameterTypeBool:
-			var uv bool
-			if err := json.Unmarshal([]byte(v), &uv); err != nil {
-				return nil, fmt.Errorf("unmarshalling %s to bool: %v", k, err)
-			}
-			value = uv
-		case logic.ParameterTypeFloat:
-			var uv float64
-			if err := json.Unmarshal([]byte(v), &uv); err != nil {
-				return nil, fmt.Errorf("unmarshalling %s to float64: %v", k, err)
-			}
-			value = uv
-		case logic.ParameterTypeInt:
-			var uv int
-			if err := json.Unmarshal([]byte(v), &uv); err != nil {
-				return nil, fmt.Errorf("unmarshalling %s to int: %v", k, err)
-			}
-			value = uv
-		case logic.ParameterTypeArray:
-			var uv []interface{}
-			if err := json.Unmarshal([]byte(v), &uv); err != nil {
-				return nil, fmt.Errorf("unmarshalling %s to []interface{}: %v", k, err)
-			}
-			value = uv
-		case logic.ParameterTypeObject,
-			logic.ParameterTypeSecureObject:
-			var uv map[string]interface{}
-			if err := json.Unmarshal([]byte(v), &uv); err != nil {
-				return nil, fmt.Errorf("unmarshalling %s to map[string]interface{}: %v", k, err)
-			}
-			value = uv
-		case logic.ParameterTypeString,
-			logic.ParameterTypeSecureString:
-			value = v
+		value, err := expandLogicAppWorkflowParameterValue(k, t, v.(string))
+		if err != nil {
+			return nil, err
 		}
 
 		output[k] = &logic.WorkflowParameter{
@@ -640,6 +741,50 @@ func expandLogicAppWorkflowParameters(input map[string]interface{}, paramDefs ma
 	return output, nil
 }
 
+// expandLogicAppWorkflowParameterValue converts the string value `v` of the Parameter `k` into the value sent to the
+// API for the Parameter Type `t` - where Arrays and Objects are JSON encoded
+func expandLogicAppWorkflowParameterValue(k string, t logic.ParameterType, v string) (interface{}, error) {
+	var value interface{}
+	switch t {
+	case logic.ParameterTypeBool:
+		var uv bool
+		if err := json.Unmarshal([]byte(v), &uv); err != nil {
+			return nil, fmt.Errorf("unmarshalling %s to bool: %v", k, err)
+		}
+		value = uv
+	case logic.ParameterTypeFloat:
+		var uv float64
+		if err := json.Unmarshal([]byte(v), &uv); err != nil {
+			return nil, fmt.Errorf("unmarshalling %s to float64: %v", k, err)
+		}
+		value = uv
+	case logic.ParameterTypeInt:
+		var uv int
+		if err := json.Unmarshal([]byte(v), &uv); err != nil {
+			return nil, fmt.Errorf("unmarshalling %s to int: %v", k, err)
+		}
+		value = uv
+	case logic.ParameterTypeArray:
+		var uv []interface{}
+		if err := json.Unmarshal([]byte(v), &uv); err != nil {
+			return nil, fmt.Errorf("unmarshalling %s to []interface{}: %v", k, err)
+		}
+		value = uv
+	case logic.ParameterTypeObject,
+		logic.ParameterTypeSecureObject:
+		var uv map[string]interface{}
+		if err := json.Unmarshal([]byte(v), &uv); err != nil {
+			return nil, fmt.Errorf("unmarshalling %s to map[string]interface{}: %v", k, err)
+		}
+		value = uv
+	case logic.ParameterTypeString,
+		logic.ParameterTypeSecureString:
+		value = v
+	}
+
+	return value, nil
+}
+
 func flattenLogicAppWorkflowParameters(d *pluginsdk.ResourceData, input map[string]*logic.WorkflowParameter, paramDefs map[string]interface{}) (map[string]interface{}, error) {
 	output := make(map[string]interface{})
 
@@ -664,69 +809,22 @@ func flattenLogicAppWorkflowParameters(d *pluginsdk.ResourceData, input map[stri
 		}
 
 		def := defRaw.(map[string]interface{})
-		t := logic.ParameterType(def["type"].(string))
+		t := parseLogicAppWorkflowParameterType(def["type"].(string))
 
 		var value string
 		switch t {
-		case logic.ParameterTypeBool:
-			tv, ok := v.Value.(bool)
-			if !ok {
-				return nil, fmt.Errorf("the value of parameter %s is expected to be bool, but got %T", k, v.Value)
-			}
-			value = "true"
-			if !tv {
-				value = "false"
-			}
-		case logic.ParameterTypeFloat:
-			// Note that the json unmarshalled response doesn't differ between float and int, as json has only type number.
-			tv, ok := v.Value.(float64)
-			if !ok {
-				return nil, fmt.Errorf("the value of parameter %s is expected to be float64, but got %T", k, v.Value)
-			}
-			value = strconv.FormatFloat(tv, 'f', -1, 64)
-		case logic.ParameterTypeInt:
-			// Note that the json unmarshalled response doesn't differ between float and int, as json has only type number.
-			tv, ok := v.Value.(float64)
-			if !ok {
-				return nil, fmt.Errorf("the value of parameter %s is expected to be float64, but got %T", k, v.Value)
-			}
-			value = strconv.Itoa(int(tv))
-
-		case logic.ParameterTypeArray:
-			tv, ok := v.Value.([]interface{})
-			if !ok {
-				return nil, fmt.Errorf("the value of parameter %s is expected to be []interface{}, but got %T", k, v.Value)
-			}
-			obj, err := json.Marshal(tv)
-			if err != nil {
-				return nil, fmt.Errorf("converting %+v from json: %v", tv, err)
-			}
-			value = string(obj)
-
-		case logic.ParameterTypeObject:
-			tv, ok := v.Value.(map[string]interface{})
-			if !ok {
-				return nil, fmt.Errorf("the value of parameter %s is expected to be map[string]interface{}, but got %T", k, v.Value)
-			}
-			obj, err := json.Marshal(tv)
-			if err != nil {
-				return nil, fmt.Errorf("converting %+v from json: %v", tv, err)
-			}
-			value = string(obj)
-
-		case logic.ParameterTypeString:
-			tv, ok := v.Value.(string)
-			if !ok {
-				return nil, fmt.Errorf("the value of parameter %s is expected to be string, but got %T", k, v.Value)
-			}
-			value = tv
-
 		case logic.ParameterTypeSecureString,
 			logic.ParameterTypeSecureObject:
 			// This is not returned from API, we will try to read them from the state instead.
 			if v, ok := paramInState[k]; ok {
 				value = v.(string) // The value in state here is guaranteed to be a string, so directly cast the type.
 			}
+		default:
+			var err error
+			value, err = flattenLogicAppWorkflowParameterValue(k, t, v)
+			if err != nil {
+				return nil, err
+			}
 		}
 
 		output[k] = value
@@ -735,6 +833,69 @@ func flattenLogicAppWorkflowParameters(d *pluginsdk.ResourceData, input map[stri
 	return output, nil
 }
 
+// flattenLogicAppWorkflowParameterValue converts the value of the Parameter `k` returned from the API into a string
+// for the Parameter Type `t` - the values of Secure Parameters aren't returned from the API and so are flattened as
+// an empty string
+func flattenLogicAppWorkflowParameterValue(k string, t logic.ParameterType, v *logic.WorkflowParameter) (string, error) {
+	var value string
+	switch t {
+	case logic.ParameterTypeBool:
+		tv, ok := v.Value.(bool)
+		if !ok {
+			return "", fmt.Errorf("the value of parameter %s is expected to be bool, but got %T", k, v.Value)
+		}
+		value = "true"
+		if !tv {
+			value = "false"
+		}
+	case logic.ParameterTypeFloat:
+		// Note that the json unmarshalled response doesn't differ between float and int, as json has only type number.
+		tv, ok := v.Value.(float64)
+		if !ok {
+			return "", fmt.Errorf("the value of parameter %s is expected to be float64, but got %T", k, v.Value)
+		}
+		value = strconv.FormatFloat(tv, 'f', -1, 64)
+	case logic.ParameterTypeInt:
+		// Note that the json unmarshalled response doesn't differ between float and int, as json has only type number.
+		tv, ok := v.Value.(float64)
+		if !ok {
+			return "", fmt.Errorf("the value of parameter %s is expected to be float64, but got %T", k, v.Value)
+		}
+		value = strconv.Itoa(int(tv))
+
+	case logic.ParameterTypeArray:
+		tv, ok := v.Value.([]interface{})
+		if !ok {
+			return "", fmt.Errorf("the value of parameter %s is expected to be []interface{}, but got %T", k, v.Value)
+		}
+		obj, err := json.Marshal(tv)
+		if err != nil {
+			return "", fmt.Errorf("converting %+v from json: %v", tv, err)
+		}
+		value = string(obj)
+
+	case logic.ParameterTypeObject:
+		tv, ok := v.Value.(map[string]interface{})
+		if !ok {
+			return "", fmt.Errorf("the value of parameter %s is expected to be map[string]interface{}, but got %T", k, v.Value)
+		}
+		obj, err := json.Marshal(tv)
+		if err != nil {
+			return "", fmt.Errorf("converting %+v from json: %v", tv, err)
+		}
+		value = string(obj)
+
+	case logic.ParameterTypeString:
+		tv, ok := v.Value.(string)
+		if !ok {
+			return "", fmt.Errorf("the value of parameter %s is expected to be string, but got %T", k, v.Value)
+		}
+		value = tv
+	}
+
+	return value, nil
+}
+
 func expandLogicAppWorkflowWorkflowParameters(input map[string]interface{}) (map[string]interface{}, error) {
 	if len(input) == 0 {
 		return nil, nil
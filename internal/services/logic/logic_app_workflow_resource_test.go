@@ -148,6 +148,43 @@ func TestAccLogicAppWorkflow_parameters(t *testing.T) {
 	})
 }
 
+func TestAccLogicAppWorkflow_parametersUnordered(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_logic_app_workflow", "test")
+	r := LogicAppWorkflowResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.parametersUnordered(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config:   r.parametersUnordered(data),
+			PlanOnly: true,
+		},
+	})
+}
+
+func TestAccLogicAppWorkflow_typedParameters(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_logic_app_workflow", "test")
+	r := LogicAppWorkflowResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.typedParameters(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("parameter.#").HasValue("4"),
+			),
+		},
+		{
+			Config:   r.typedParameters(data),
+			PlanOnly: true,
+		},
+	})
+}
+
 func TestAccLogicAppWorkflow_accessControl(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_logic_app_workflow", "test")
 	r := LogicAppWorkflowResource{}
@@ -414,6 +451,102 @@ resource "azurerm_logic_app_workflow" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
 }
 
+func (LogicAppWorkflowResource) typedParameters(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-logic-%d"
+  location = "%s"
+}
+
+resource "azurerm_logic_app_workflow" "test" {
+  name                = "acctestlaw-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  parameter {
+    name  = "str"
+    type  = "String"
+    value = "value"
+  }
+
+  parameter {
+    name = "obj"
+    type = "Object"
+    value = jsonencode({
+      s = "foo"
+      obj = {
+        i = 123
+      }
+    })
+  }
+
+  parameter {
+    name         = "secstr"
+    type         = "SecureString"
+    secure_value = "s3cr3t"
+  }
+
+  parameter {
+    name = "secobj"
+    type = "SecureObject"
+    secure_value = jsonencode({
+      foo = "foo"
+    })
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
+func (LogicAppWorkflowResource) parametersUnordered(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-logic-%d"
+  location = "%s"
+}
+
+resource "azurerm_logic_app_workflow" "test" {
+  name                = "acctestlaw-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  workflow_parameters = {
+    str = <<JSON
+{
+  "type": "string",
+  "metadata": {},
+  "defaultValue": "default"
+}
+JSON
+    obj = <<JSON
+{
+  "type": "object"
+}
+JSON
+  }
+
+  parameters = {
+    str = "value"
+    obj = <<JSON
+{
+  "s": "foo",
+  "obj": {
+    "i": 123
+  },
+  "array": [1, 2, 3]
+}
+JSON
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
 func (LogicAppWorkflowResource) accessControl(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -0,0 +1,160 @@
+package logic
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/logic/sdk/2016-06-01/managedapis"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func dataSourceManagedApi() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceManagedApiRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"location": azure.SchemaLocation(),
+
+			"api_type": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"capabilities": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
+			},
+
+			"description": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"display_name": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"icon_url": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"oauth_identity_providers": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
+			},
+
+			"runtime_urls": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
+			},
+
+			"tags": tags.SchemaDataSource(),
+		},
+	}
+}
+
+func dataSourceManagedApiRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Logic.ManagedApisClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := managedapis.NewManagedApiID(subscriptionId, azure.NormalizeLocation(d.Get("location").(string)), d.Get("name").(string))
+
+	resp, err := client.ManagedApisGet(ctx, id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return fmt.Errorf("%s was not found", id)
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	d.Set("name", id.ManagedApiName)
+	d.Set("location", id.LocationName)
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			d.Set("capabilities", utils.FlattenStringSlice(props.Capabilities))
+			d.Set("runtime_urls", utils.FlattenStringSlice(props.RuntimeUrls))
+
+			description := ""
+			displayName := ""
+			iconUrl := ""
+			if info := props.GeneralInformation; info != nil {
+				description = utils.NormalizeNilableString(info.Description)
+				displayName = utils.NormalizeNilableString(info.DisplayName)
+				iconUrl = utils.NormalizeNilableString(info.IconUrl)
+			}
+			d.Set("description", description)
+			d.Set("display_name", displayName)
+			d.Set("icon_url", iconUrl)
+
+			apiType := ""
+			if metadata := props.Metadata; metadata != nil {
+				apiType = utils.NormalizeNilableString(metadata.ApiType)
+			}
+			d.Set("api_type", apiType)
+
+			if err := d.Set("oauth_identity_providers", flattenManagedApiOAuthIdentityProviders(props.ConnectionParameters)); err != nil {
+				return fmt.Errorf("setting `oauth_identity_providers`: %+v", err)
+			}
+		}
+
+		if model.Tags != nil {
+			return tags.FlattenAndSet(d, tags.FromTypedObject(*model.Tags))
+		}
+	}
+
+	return nil
+}
+
+func flattenManagedApiOAuthIdentityProviders(input *map[string]managedapis.ConnectionParameter) []string {
+	results := make([]string, 0)
+	if input == nil {
+		return results
+	}
+
+	seen := make(map[string]struct{})
+	for _, v := range *input {
+		if v.OAuthSettings == nil || v.OAuthSettings.IdentityProvider == nil {
+			continue
+		}
+
+		provider := *v.OAuthSettings.IdentityProvider
+		if _, ok := seen[provider]; ok {
+			continue
+		}
+		seen[provider] = struct{}{}
+		results = append(results, provider)
+	}
+
+	// the Connection Parameters are returned as a map, so sort these to give a consistent ordering
+	sort.Strings(results)
+
+	return results
+}
@@ -0,0 +1,40 @@
+package logic_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type ManagedApiDataSource struct{}
+
+func TestAccManagedApiDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_managed_api", "test")
+	r := ManagedApiDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("display_name").HasValue("Office 365 Outlook"),
+				check.That(data.ResourceName).Key("runtime_urls.#").Exists(),
+				check.That(data.ResourceName).Key("oauth_identity_providers.#").HasValue("1"),
+			),
+		},
+	})
+}
+
+func (ManagedApiDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_managed_api" "test" {
+  name     = "office365"
+  location = "%s"
+}
+`, data.Locations.Primary)
+}
@@ -21,8 +21,10 @@ func (r Registration) WebsiteCategories() []string {
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
+		"azurerm_api_connection":                dataSourceApiConnection(),
 		"azurerm_logic_app_workflow":            dataSourceLogicAppWorkflow(),
 		"azurerm_logic_app_integration_account": dataSourceLogicAppIntegrationAccount(),
+		"azurerm_managed_api":                   dataSourceManagedApi(),
 	}
 }
 
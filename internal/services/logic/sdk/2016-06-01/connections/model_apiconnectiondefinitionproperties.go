@@ -0,0 +1,15 @@
+package connections
+
+type ApiConnectionDefinitionProperties struct {
+	Api                      *ApiReference                 `json:"api,omitempty"`
+	ChangedTime              *string                       `json:"changedTime,omitempty"`
+	ConnectionRuntimeUrl     *string                       `json:"connectionRuntimeUrl,omitempty"`
+	CreatedTime              *string                       `json:"createdTime,omitempty"`
+	CustomParameterValues    *map[string]string            `json:"customParameterValues,omitempty"`
+	DisplayName              *string                       `json:"displayName,omitempty"`
+	NonSecretParameterValues *map[string]string            `json:"nonSecretParameterValues,omitempty"`
+	OverallStatus            *string                       `json:"overallStatus,omitempty"`
+	ParameterValues          *map[string]string            `json:"parameterValues,omitempty"`
+	Statuses                 *[]ConnectionStatusDefinition `json:"statuses,omitempty"`
+	TestLinks                *[]ApiConnectionTestLink      `json:"testLinks,omitempty"`
+}
@@ -0,0 +1,6 @@
+package connections
+
+type ConnectionErrorProperties struct {
+	Code    *string `json:"code,omitempty"`
+	Message *string `json:"message,omitempty"`
+}
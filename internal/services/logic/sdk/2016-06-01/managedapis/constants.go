@@ -0,0 +1,52 @@
+package managedapis
+
+import "strings"
+
+type ConnectionParameterType string
+
+const (
+	ConnectionParameterTypeArray        ConnectionParameterType = "array"
+	ConnectionParameterTypeBool         ConnectionParameterType = "bool"
+	ConnectionParameterTypeConnection   ConnectionParameterType = "connection"
+	ConnectionParameterTypeInt          ConnectionParameterType = "int"
+	ConnectionParameterTypeOauthSetting ConnectionParameterType = "oauthSetting"
+	ConnectionParameterTypeObject       ConnectionParameterType = "object"
+	ConnectionParameterTypeSecureobject ConnectionParameterType = "secureobject"
+	ConnectionParameterTypeSecurestring ConnectionParameterType = "securestring"
+	ConnectionParameterTypeString       ConnectionParameterType = "string"
+)
+
+func PossibleValuesForConnectionParameterType() []string {
+	return []string{
+		string(ConnectionParameterTypeArray),
+		string(ConnectionParameterTypeBool),
+		string(ConnectionParameterTypeConnection),
+		string(ConnectionParameterTypeInt),
+		string(ConnectionParameterTypeOauthSetting),
+		string(ConnectionParameterTypeObject),
+		string(ConnectionParameterTypeSecureobject),
+		string(ConnectionParameterTypeSecurestring),
+		string(ConnectionParameterTypeString),
+	}
+}
+
+func parseConnectionParameterType(input string) (*ConnectionParameterType, error) {
+	vals := map[string]ConnectionParameterType{
+		"array":        ConnectionParameterTypeArray,
+		"bool":         ConnectionParameterTypeBool,
+		"connection":   ConnectionParameterTypeConnection,
+		"int":          ConnectionParameterTypeInt,
+		"oauthsetting": ConnectionParameterTypeOauthSetting,
+		"object":       ConnectionParameterTypeObject,
+		"secureobject": ConnectionParameterTypeSecureobject,
+		"securestring": ConnectionParameterTypeSecurestring,
+		"string":       ConnectionParameterTypeString,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ConnectionParameterType(input)
+	return &out, nil
+}
@@ -0,0 +1,183 @@
+package managedapis
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type ManagedApisListResponse struct {
+	HttpResponse *http.Response
+	Model        *[]ManagedApiDefinition
+
+	nextLink     *string
+	nextPageFunc func(ctx context.Context, nextLink string) (ManagedApisListResponse, error)
+}
+
+type ManagedApisListCompleteResult struct {
+	Items []ManagedApiDefinition
+}
+
+func (r ManagedApisListResponse) HasMore() bool {
+	return r.nextLink != nil
+}
+
+func (r ManagedApisListResponse) LoadMore(ctx context.Context) (resp ManagedApisListResponse, err error) {
+	if !r.HasMore() {
+		err = fmt.Errorf("no more pages returned")
+		return
+	}
+	return r.nextPageFunc(ctx, *r.nextLink)
+}
+
+// ManagedApisList ...
+func (c ManagedAPIsClient) ManagedApisList(ctx context.Context, id LocationId) (resp ManagedApisListResponse, err error) {
+	req, err := c.preparerForManagedApisList(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "managedapis.ManagedAPIsClient", "ManagedApisList", nil, "Failure preparing request")
+		return
+	}
+
+	resp.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "managedapis.ManagedAPIsClient", "ManagedApisList", resp.HttpResponse, "Failure sending request")
+		return
+	}
+
+	resp, err = c.responderForManagedApisList(resp.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "managedapis.ManagedAPIsClient", "ManagedApisList", resp.HttpResponse, "Failure responding to request")
+		return
+	}
+	return
+}
+
+// ManagedApisListComplete retrieves all of the results into a single object
+func (c ManagedAPIsClient) ManagedApisListComplete(ctx context.Context, id LocationId) (ManagedApisListCompleteResult, error) {
+	return c.ManagedApisListCompleteMatchingPredicate(ctx, id, ManagedApiDefinitionPredicate{})
+}
+
+// ManagedApisListCompleteMatchingPredicate retrieves all of the results and then applied the predicate
+func (c ManagedAPIsClient) ManagedApisListCompleteMatchingPredicate(ctx context.Context, id LocationId, predicate ManagedApiDefinitionPredicate) (resp ManagedApisListCompleteResult, err error) {
+	items := make([]ManagedApiDefinition, 0)
+
+	page, err := c.ManagedApisList(ctx, id)
+	if err != nil {
+		err = fmt.Errorf("loading the initial page: %+v", err)
+		return
+	}
+	if page.Model != nil {
+		for _, v := range *page.Model {
+			if predicate.Matches(v) {
+				items = append(items, v)
+			}
+		}
+	}
+
+	for page.HasMore() {
+		page, err = page.LoadMore(ctx)
+		if err != nil {
+			err = fmt.Errorf("loading the next page: %+v", err)
+			return
+		}
+
+		if page.Model != nil {
+			for _, v := range *page.Model {
+				if predicate.Matches(v) {
+					items = append(items, v)
+				}
+			}
+		}
+	}
+
+	out := ManagedApisListCompleteResult{
+		Items: items,
+	}
+	return out, nil
+}
+
+// preparerForManagedApisList prepares the ManagedApisList request.
+func (c ManagedAPIsClient) preparerForManagedApisList(ctx context.Context, id LocationId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(fmt.Sprintf("%s/managedApis", id.ID())),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// preparerForManagedApisListWithNextLink prepares the ManagedApisList request with the given nextLink token.
+func (c ManagedAPIsClient) preparerForManagedApisListWithNextLink(ctx context.Context, nextLink string) (*http.Request, error) {
+	uri, err := url.Parse(nextLink)
+	if err != nil {
+		return nil, fmt.Errorf("parsing nextLink %q: %+v", nextLink, err)
+	}
+	queryParameters := map[string]interface{}{}
+	for k, v := range uri.Query() {
+		if len(v) == 0 {
+			continue
+		}
+		val := v[0]
+		val = autorest.Encode("query", val)
+		queryParameters[k] = val
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(uri.Path),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForManagedApisList handles the response to the ManagedApisList request. The method always
+// closes the http.Response Body.
+func (c ManagedAPIsClient) responderForManagedApisList(resp *http.Response) (result ManagedApisListResponse, err error) {
+	type page struct {
+		Values   []ManagedApiDefinition `json:"value"`
+		NextLink *string                `json:"nextLink"`
+	}
+	var respObj page
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&respObj),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	result.Model = &respObj.Values
+	result.nextLink = respObj.NextLink
+	if respObj.NextLink != nil {
+		result.nextPageFunc = func(ctx context.Context, nextLink string) (result ManagedApisListResponse, err error) {
+			req, err := c.preparerForManagedApisListWithNextLink(ctx, nextLink)
+			if err != nil {
+				err = autorest.NewErrorWithError(err, "managedapis.ManagedAPIsClient", "ManagedApisList", nil, "Failure preparing request")
+				return
+			}
+
+			result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+			if err != nil {
+				err = autorest.NewErrorWithError(err, "managedapis.ManagedAPIsClient", "ManagedApisList", result.HttpResponse, "Failure sending request")
+				return
+			}
+
+			result, err = c.responderForManagedApisList(result.HttpResponse)
+			if err != nil {
+				err = autorest.NewErrorWithError(err, "managedapis.ManagedAPIsClient", "ManagedApisList", result.HttpResponse, "Failure responding to request")
+				return
+			}
+
+			return
+		}
+	}
+	return
+}
@@ -0,0 +1,8 @@
+package managedapis
+
+type ApiOAuthSettings struct {
+	ClientId         *string   `json:"clientId,omitempty"`
+	IdentityProvider *string   `json:"identityProvider,omitempty"`
+	RedirectUrl      *string   `json:"redirectUrl,omitempty"`
+	Scopes           *[]string `json:"scopes,omitempty"`
+}
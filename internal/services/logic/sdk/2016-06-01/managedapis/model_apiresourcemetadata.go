@@ -0,0 +1,10 @@
+package managedapis
+
+type ApiResourceMetadata struct {
+	ApiType        *string            `json:"apiType,omitempty"`
+	BrandColor     *string            `json:"brandColor,omitempty"`
+	ConnectionType *string            `json:"connectionType,omitempty"`
+	HideKey        *string            `json:"hideKey,omitempty"`
+	Source         *string            `json:"source,omitempty"`
+	Tags           *map[string]string `json:"tags,omitempty"`
+}
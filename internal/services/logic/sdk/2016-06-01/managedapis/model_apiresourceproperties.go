@@ -0,0 +1,11 @@
+package managedapis
+
+type ApiResourceProperties struct {
+	Capabilities         *[]string                       `json:"capabilities,omitempty"`
+	ConnectionParameters *map[string]ConnectionParameter `json:"connectionParameters,omitempty"`
+	GeneralInformation   *ApiResourceGeneralInformation  `json:"generalInformation,omitempty"`
+	Metadata             *ApiResourceMetadata            `json:"metadata,omitempty"`
+	Name                 *string                         `json:"name,omitempty"`
+	RuntimeUrls          *[]string                       `json:"runtimeUrls,omitempty"`
+	Swagger              *interface{}                    `json:"swagger,omitempty"`
+}
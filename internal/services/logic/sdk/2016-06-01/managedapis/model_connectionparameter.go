@@ -0,0 +1,6 @@
+package managedapis
+
+type ConnectionParameter struct {
+	OAuthSettings *ApiOAuthSettings        `json:"oAuthSettings,omitempty"`
+	Type          *ConnectionParameterType `json:"type,omitempty"`
+}
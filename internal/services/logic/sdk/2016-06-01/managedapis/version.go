@@ -0,0 +1,9 @@
+package managedapis
+
+import "fmt"
+
+const defaultApiVersion = "2016-06-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/managedapis/%s", defaultApiVersion)
+}
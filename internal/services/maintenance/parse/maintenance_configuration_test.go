@@ -199,6 +199,16 @@ func TestMaintenanceConfigurationIDInsensitively(t *testing.T) {
 				Name:           "maintenanceConfiguration1",
 			},
 		},
+
+		{
+			// upper-cased root segment names
+			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/Microsoft.Maintenance/maintenanceConfigurations/maintenanceConfiguration1",
+			Expected: &MaintenanceConfigurationId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "resGroup1",
+				Name:           "maintenanceConfiguration1",
+			},
+		},
 	}
 
 	for _, v := range testData {
@@ -0,0 +1,199 @@
+package managementgroup
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2020-05-01/managementgroups"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/managementgroup/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/managementgroup/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func dataSourceManagementGroupDescendants() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceManagementGroupDescendantsRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"management_group_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.ManagementGroupID,
+			},
+
+			"management_groups": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"display_name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"parent_management_group_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"subscriptions": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"subscription_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"display_name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"parent_management_group_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"management_group_ids": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
+			},
+
+			"subscription_ids": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceManagementGroupDescendantsRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ManagementGroups.GroupsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ManagementGroupID(d.Get("management_group_id").(string))
+	if err != nil {
+		return err
+	}
+
+	iterator, err := client.GetDescendantsComplete(ctx, id.Name, "", nil)
+	if err != nil {
+		if utils.ResponseWasForbidden(iterator.Response().Response) || utils.ResponseWasNotFound(iterator.Response().Response) {
+			return fmt.Errorf("Management Group %q was not found", id.Name)
+		}
+
+		return fmt.Errorf("listing descendants of Management Group %q: %+v", id.Name, err)
+	}
+
+	descendants := make([]managementgroups.DescendantInfo, 0)
+	for iterator.NotDone() {
+		descendants = append(descendants, iterator.Value())
+
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("listing descendants of Management Group %q: %+v", id.Name, err)
+		}
+	}
+
+	managementGroups, subscriptions := flattenManagementGroupDescendants(descendants)
+
+	d.SetId(id.ID())
+	d.Set("management_group_id", id.ID())
+
+	if err := d.Set("management_groups", managementGroups); err != nil {
+		return fmt.Errorf("setting `management_groups`: %+v", err)
+	}
+	if err := d.Set("subscriptions", subscriptions); err != nil {
+		return fmt.Errorf("setting `subscriptions`: %+v", err)
+	}
+
+	managementGroupIds := make([]interface{}, 0)
+	for _, v := range managementGroups {
+		managementGroupIds = append(managementGroupIds, v.(map[string]interface{})["id"])
+	}
+	d.Set("management_group_ids", managementGroupIds)
+
+	subscriptionIds := make([]interface{}, 0)
+	for _, v := range subscriptions {
+		subscriptionIds = append(subscriptionIds, v.(map[string]interface{})["subscription_id"])
+	}
+	d.Set("subscription_ids", subscriptionIds)
+
+	return nil
+}
+
+// flattenManagementGroupDescendants splits the descendants of a Management Group into the
+// Management Groups and the Subscriptions, in the order returned by the API
+func flattenManagementGroupDescendants(input []managementgroups.DescendantInfo) ([]interface{}, []interface{}) {
+	managementGroups := make([]interface{}, 0)
+	subscriptions := make([]interface{}, 0)
+
+	for _, item := range input {
+		if item.ID == nil {
+			continue
+		}
+
+		displayName := ""
+		parentId := ""
+		if props := item.DescendantInfoProperties; props != nil {
+			if props.DisplayName != nil {
+				displayName = *props.DisplayName
+			}
+			if props.Parent != nil && props.Parent.ID != nil {
+				parentId = *props.Parent.ID
+			}
+		}
+
+		name := ""
+		if item.Name != nil {
+			name = *item.Name
+		}
+
+		// the type is either `Microsoft.Management/managementGroups` or `/subscriptions`
+		if item.Type != nil && strings.EqualFold(*item.Type, "/subscriptions") {
+			subscriptions = append(subscriptions, map[string]interface{}{
+				"subscription_id":            name,
+				"display_name":               displayName,
+				"parent_management_group_id": parentId,
+			})
+			continue
+		}
+
+		managementGroups = append(managementGroups, map[string]interface{}{
+			"id":                         *item.ID,
+			"name":                       name,
+			"display_name":               displayName,
+			"parent_management_group_id": parentId,
+		})
+	}
+
+	return managementGroups, subscriptions
+}
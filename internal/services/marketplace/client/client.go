@@ -0,0 +1,25 @@
+package client
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/marketplace/sdk/2023-01-01/privatestorecollection"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/marketplace/sdk/2023-01-01/privatestorecollectionoffer"
+)
+
+type Client struct {
+	PrivateStoreCollectionClient      *privatestorecollection.PrivateStoreCollectionClient
+	PrivateStoreCollectionOfferClient *privatestorecollectionoffer.PrivateStoreCollectionOfferClient
+}
+
+func NewClient(o *common.ClientOptions) *Client {
+	privateStoreCollectionClient := privatestorecollection.NewPrivateStoreCollectionClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&privateStoreCollectionClient.Client, o.ResourceManagerAuthorizer)
+
+	privateStoreCollectionOfferClient := privatestorecollectionoffer.NewPrivateStoreCollectionOfferClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&privateStoreCollectionOfferClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		PrivateStoreCollectionClient:      &privateStoreCollectionClient,
+		PrivateStoreCollectionOfferClient: &privateStoreCollectionOfferClient,
+	}
+}
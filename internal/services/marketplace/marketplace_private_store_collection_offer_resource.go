@@ -0,0 +1,246 @@
+package marketplace
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceid"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/marketplace/sdk/2023-01-01/privatestorecollectionoffer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var (
+	_ sdk.ResourceWithUpdate           = MarketplacePrivateStoreCollectionOfferResource{}
+	_ sdk.ResourceWithNormalizedImport = MarketplacePrivateStoreCollectionOfferResource{}
+)
+
+type MarketplacePrivateStoreCollectionOfferResource struct{}
+
+type MarketplacePrivateStoreCollectionOfferResourceModel struct {
+	CollectionId         string   `tfschema:"collection_id"`
+	OfferId              string   `tfschema:"offer_id"`
+	PlanIds              []string `tfschema:"plan_ids"`
+	OfferDisplayName     string   `tfschema:"offer_display_name"`
+	PublisherDisplayName string   `tfschema:"publisher_display_name"`
+}
+
+func (r MarketplacePrivateStoreCollectionOfferResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"collection_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: privatestorecollectionoffer.ValidateCollectionID,
+		},
+
+		// the unique ID of the Offer is in the format `{publisherId}.{offerId}`
+		"offer_id": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[^.\s/]+\.[^\s/]+$`),
+				"`offer_id` must be in the format `{publisherId}.{offerId}`",
+			),
+		},
+
+		"plan_ids": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+	}
+}
+
+func (r MarketplacePrivateStoreCollectionOfferResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"offer_display_name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"publisher_display_name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r MarketplacePrivateStoreCollectionOfferResource) ModelObject() interface{} {
+	return &MarketplacePrivateStoreCollectionOfferResourceModel{}
+}
+
+func (r MarketplacePrivateStoreCollectionOfferResource) ResourceType() string {
+	return "azurerm_marketplace_private_store_collection_offer"
+}
+
+func (r MarketplacePrivateStoreCollectionOfferResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return privatestorecollectionoffer.ValidateOfferID
+}
+
+func (r MarketplacePrivateStoreCollectionOfferResource) IDInsensitiveParseFunc() pluginsdk.IDInsensitiveParseFunc {
+	return func(id string) (resourceid.Formatter, error) {
+		return privatestorecollectionoffer.ParseOfferIDInsensitively(id)
+	}
+}
+
+func (r MarketplacePrivateStoreCollectionOfferResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Marketplace.PrivateStoreCollectionOfferClient
+
+			var model MarketplacePrivateStoreCollectionOfferResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			collectionId, err := privatestorecollectionoffer.ParseCollectionID(model.CollectionId)
+			if err != nil {
+				return err
+			}
+
+			id := privatestorecollectionoffer.NewOfferID(collectionId.PrivateStoreId, collectionId.CollectionId, model.OfferId)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := privatestorecollectionoffer.Offer{
+				Properties: &privatestorecollectionoffer.OfferProperties{
+					SpecificPlanIdsLimitation: expandMarketplacePrivateStoreCollectionOfferPlanIds(model.PlanIds),
+				},
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, payload); err != nil {
+				return fmt.Errorf("approving %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r MarketplacePrivateStoreCollectionOfferResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Marketplace.PrivateStoreCollectionOfferClient
+
+			id, err := privatestorecollectionoffer.ParseOfferID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := MarketplacePrivateStoreCollectionOfferResourceModel{
+				CollectionId: privatestorecollectionoffer.NewCollectionID(id.PrivateStoreId, id.CollectionId).ID(),
+				OfferId:      id.OfferId,
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.OfferDisplayName = utils.NormalizeNilableString(props.OfferDisplayName)
+					state.PublisherDisplayName = utils.NormalizeNilableString(props.PublisherDisplayName)
+
+					planIds := make([]string, 0)
+					if props.SpecificPlanIdsLimitation != nil {
+						planIds = *props.SpecificPlanIdsLimitation
+					}
+					state.PlanIds = planIds
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r MarketplacePrivateStoreCollectionOfferResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Marketplace.PrivateStoreCollectionOfferClient
+
+			id, err := privatestorecollectionoffer.ParseOfferID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model MarketplacePrivateStoreCollectionOfferResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil || existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+			}
+
+			// the eTag must be sent when updating an existing Offer, to avoid overwriting concurrent changes
+			payload := privatestorecollectionoffer.Offer{
+				Properties: &privatestorecollectionoffer.OfferProperties{
+					ETag:                      existing.Model.Properties.ETag,
+					SpecificPlanIdsLimitation: expandMarketplacePrivateStoreCollectionOfferPlanIds(model.PlanIds),
+				},
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r MarketplacePrivateStoreCollectionOfferResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Marketplace.PrivateStoreCollectionOfferClient
+
+			id, err := privatestorecollectionoffer.ParseOfferID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// expandMarketplacePrivateStoreCollectionOfferPlanIds returns the Plans of the Offer which are approved - where
+// no Plans are specified all Plans of the Offer are approved
+func expandMarketplacePrivateStoreCollectionOfferPlanIds(input []string) *[]string {
+	planIds := make([]string, 0)
+	planIds = append(planIds, input...)
+	return &planIds
+}
@@ -0,0 +1,145 @@
+package marketplace_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/marketplace/sdk/2023-01-01/privatestorecollectionoffer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type MarketplacePrivateStoreCollectionOfferResource struct{}
+
+func TestAccMarketplacePrivateStoreCollectionOffer_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_marketplace_private_store_collection_offer", "test")
+	r := MarketplacePrivateStoreCollectionOfferResource{}
+	preCheckMarketplacePrivateStore(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("offer_display_name").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMarketplacePrivateStoreCollectionOffer_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_marketplace_private_store_collection_offer", "test")
+	r := MarketplacePrivateStoreCollectionOfferResource{}
+	preCheckMarketplacePrivateStore(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccMarketplacePrivateStoreCollectionOffer_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_marketplace_private_store_collection_offer", "test")
+	r := MarketplacePrivateStoreCollectionOfferResource{}
+	preCheckMarketplacePrivateStore(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("plan_ids.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r MarketplacePrivateStoreCollectionOfferResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := privatestorecollectionoffer.ParseOfferID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Marketplace.PrivateStoreCollectionOfferClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r MarketplacePrivateStoreCollectionOfferResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_marketplace_private_store_collection_offer" "test" {
+  collection_id = azurerm_marketplace_private_store_collection.test.id
+  offer_id      = "canonical.0001-com-ubuntu-server-focal"
+}
+`, r.template(data))
+}
+
+func (r MarketplacePrivateStoreCollectionOfferResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_marketplace_private_store_collection_offer" "import" {
+  collection_id = azurerm_marketplace_private_store_collection_offer.test.collection_id
+  offer_id      = azurerm_marketplace_private_store_collection_offer.test.offer_id
+}
+`, r.basic(data))
+}
+
+func (r MarketplacePrivateStoreCollectionOfferResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_marketplace_private_store_collection_offer" "test" {
+  collection_id = azurerm_marketplace_private_store_collection.test.id
+  offer_id      = "canonical.0001-com-ubuntu-server-focal"
+  plan_ids      = ["20_04-lts-gen2"]
+}
+`, r.template(data))
+}
+
+func (r MarketplacePrivateStoreCollectionOfferResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_marketplace_private_store_collection" "test" {
+  name                      = "acctest-collection-%d"
+  all_subscriptions_enabled = true
+}
+`, data.RandomInteger)
+}
@@ -0,0 +1,277 @@
+package marketplace
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceid"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/marketplace/sdk/2023-01-01/privatestorecollection"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var (
+	_ sdk.ResourceWithUpdate           = MarketplacePrivateStoreCollectionResource{}
+	_ sdk.ResourceWithNormalizedImport = MarketplacePrivateStoreCollectionResource{}
+)
+
+type MarketplacePrivateStoreCollectionResource struct{}
+
+type MarketplacePrivateStoreCollectionResourceModel struct {
+	Name                    string   `tfschema:"name"`
+	PrivateStoreId          string   `tfschema:"private_store_id"`
+	Enabled                 bool     `tfschema:"enabled"`
+	AllSubscriptionsEnabled bool     `tfschema:"all_subscriptions_enabled"`
+	SubscriptionIds         []string `tfschema:"subscription_ids"`
+	NumberOfOffers          int      `tfschema:"number_of_offers"`
+}
+
+func (r MarketplacePrivateStoreCollectionResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		// the ID of the Private Marketplace is the ID of the Tenant
+		"private_store_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsUUID,
+		},
+
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"all_subscriptions_enabled": {
+			Type:          pluginsdk.TypeBool,
+			Optional:      true,
+			Default:       false,
+			ConflictsWith: []string{"subscription_ids"},
+		},
+
+		"subscription_ids": {
+			Type:          pluginsdk.TypeList,
+			Optional:      true,
+			ConflictsWith: []string{"all_subscriptions_enabled"},
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.IsUUID,
+			},
+		},
+	}
+}
+
+func (r MarketplacePrivateStoreCollectionResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"number_of_offers": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+	}
+}
+
+func (r MarketplacePrivateStoreCollectionResource) ModelObject() interface{} {
+	return &MarketplacePrivateStoreCollectionResourceModel{}
+}
+
+func (r MarketplacePrivateStoreCollectionResource) ResourceType() string {
+	return "azurerm_marketplace_private_store_collection"
+}
+
+func (r MarketplacePrivateStoreCollectionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return privatestorecollection.ValidateCollectionID
+}
+
+func (r MarketplacePrivateStoreCollectionResource) IDInsensitiveParseFunc() pluginsdk.IDInsensitiveParseFunc {
+	return func(id string) (resourceid.Formatter, error) {
+		return privatestorecollection.ParseCollectionIDInsensitively(id)
+	}
+}
+
+func (r MarketplacePrivateStoreCollectionResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Marketplace.PrivateStoreCollectionClient
+
+			var model MarketplacePrivateStoreCollectionResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			privateStoreId := model.PrivateStoreId
+			if privateStoreId == "" {
+				privateStoreId = metadata.Client.Account.TenantId
+			}
+
+			// the ID of a Collection is a UUID generated by the caller, so Collections are unique by name instead
+			storeId := privatestorecollection.NewPrivateStoreID(privateStoreId)
+			collections, err := client.ListComplete(ctx, storeId)
+			if err != nil {
+				return fmt.Errorf("listing Collections within %s: %+v", storeId, err)
+			}
+			for _, item := range collections.Items {
+				if item.Properties == nil || item.Properties.CollectionId == nil || !strings.EqualFold(utils.NormalizeNilableString(item.Properties.CollectionName), model.Name) {
+					continue
+				}
+
+				existingId := privatestorecollection.NewCollectionID(privateStoreId, *item.Properties.CollectionId)
+				return metadata.ResourceRequiresImport(r.ResourceType(), existingId)
+			}
+
+			collectionId, err := uuid.GenerateUUID()
+			if err != nil {
+				return fmt.Errorf("generating a Collection ID: %+v", err)
+			}
+			id := privatestorecollection.NewCollectionID(privateStoreId, collectionId)
+
+			payload := privatestorecollection.Collection{
+				Properties: &privatestorecollection.CollectionProperties{
+					AllSubscriptions:  utils.Bool(model.AllSubscriptionsEnabled),
+					CollectionName:    utils.String(model.Name),
+					Enabled:           utils.Bool(model.Enabled),
+					SubscriptionsList: expandMarketplacePrivateStoreCollectionSubscriptionIds(model),
+				},
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r MarketplacePrivateStoreCollectionResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Marketplace.PrivateStoreCollectionClient
+
+			id, err := privatestorecollection.ParseCollectionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := MarketplacePrivateStoreCollectionResourceModel{
+				PrivateStoreId: id.PrivateStoreId,
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.Name = utils.NormalizeNilableString(props.CollectionName)
+					state.Enabled = utils.NormaliseNilableBool(props.Enabled)
+					state.AllSubscriptionsEnabled = utils.NormaliseNilableBool(props.AllSubscriptions)
+					state.NumberOfOffers = int(utils.NormaliseNilableInt64(props.NumberOfOffers))
+
+					subscriptionIds := make([]string, 0)
+					if !state.AllSubscriptionsEnabled && props.SubscriptionsList != nil {
+						subscriptionIds = *props.SubscriptionsList
+					}
+					state.SubscriptionIds = subscriptionIds
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r MarketplacePrivateStoreCollectionResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Marketplace.PrivateStoreCollectionClient
+
+			id, err := privatestorecollection.ParseCollectionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model MarketplacePrivateStoreCollectionResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil || existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+			}
+
+			payload := *existing.Model
+			if metadata.ResourceData.HasChange("name") {
+				payload.Properties.CollectionName = utils.String(model.Name)
+			}
+			if metadata.ResourceData.HasChange("enabled") {
+				payload.Properties.Enabled = utils.Bool(model.Enabled)
+			}
+			if metadata.ResourceData.HasChange("all_subscriptions_enabled") || metadata.ResourceData.HasChange("subscription_ids") {
+				payload.Properties.AllSubscriptions = utils.Bool(model.AllSubscriptionsEnabled)
+				payload.Properties.SubscriptionsList = expandMarketplacePrivateStoreCollectionSubscriptionIds(model)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r MarketplacePrivateStoreCollectionResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Marketplace.PrivateStoreCollectionClient
+
+			id, err := privatestorecollection.ParseCollectionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandMarketplacePrivateStoreCollectionSubscriptionIds(input MarketplacePrivateStoreCollectionResourceModel) *[]string {
+	// the API rejects a list of Subscriptions when the Collection applies to all Subscriptions
+	if input.AllSubscriptionsEnabled {
+		return nil
+	}
+
+	subscriptionIds := make([]string, 0)
+	subscriptionIds = append(subscriptionIds, input.SubscriptionIds...)
+	return &subscriptionIds
+}
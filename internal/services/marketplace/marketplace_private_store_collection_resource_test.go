@@ -0,0 +1,163 @@
+package marketplace_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/marketplace/sdk/2023-01-01/privatestorecollection"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type MarketplacePrivateStoreCollectionResource struct{}
+
+// The Private Marketplace is shared by the whole test tenant and managing it requires the Marketplace Admin role
+// to be assigned at the Tenant scope - as such these tests have to be opted into.
+func preCheckMarketplacePrivateStore(t *testing.T) {
+	if os.Getenv("ARM_TEST_MARKETPLACE_PRIVATE_STORE") == "" {
+		t.Skip("Skipping as ARM_TEST_MARKETPLACE_PRIVATE_STORE is not specified")
+	}
+}
+
+func TestAccMarketplacePrivateStoreCollection_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_marketplace_private_store_collection", "test")
+	r := MarketplacePrivateStoreCollectionResource{}
+	preCheckMarketplacePrivateStore(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("private_store_id").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMarketplacePrivateStoreCollection_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_marketplace_private_store_collection", "test")
+	r := MarketplacePrivateStoreCollectionResource{}
+	preCheckMarketplacePrivateStore(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccMarketplacePrivateStoreCollection_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_marketplace_private_store_collection", "test")
+	r := MarketplacePrivateStoreCollectionResource{}
+	preCheckMarketplacePrivateStore(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMarketplacePrivateStoreCollection_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_marketplace_private_store_collection", "test")
+	r := MarketplacePrivateStoreCollectionResource{}
+	preCheckMarketplacePrivateStore(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r MarketplacePrivateStoreCollectionResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := privatestorecollection.ParseCollectionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Marketplace.PrivateStoreCollectionClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r MarketplacePrivateStoreCollectionResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_marketplace_private_store_collection" "test" {
+  name                      = "acctest-collection-%d"
+  all_subscriptions_enabled = true
+}
+`, data.RandomInteger)
+}
+
+func (r MarketplacePrivateStoreCollectionResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_marketplace_private_store_collection" "import" {
+  name                      = azurerm_marketplace_private_store_collection.test.name
+  all_subscriptions_enabled = azurerm_marketplace_private_store_collection.test.all_subscriptions_enabled
+}
+`, r.basic(data))
+}
+
+func (r MarketplacePrivateStoreCollectionResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_marketplace_private_store_collection" "test" {
+  name             = "acctest-collection-updated-%d"
+  private_store_id = data.azurerm_client_config.current.tenant_id
+  enabled          = false
+  subscription_ids = [data.azurerm_client_config.current.subscription_id]
+}
+`, data.RandomInteger)
+}
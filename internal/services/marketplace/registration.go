@@ -0,0 +1,30 @@
+package marketplace
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+var _ sdk.TypedServiceRegistration = Registration{}
+
+type Registration struct{}
+
+func (r Registration) Name() string {
+	return "Marketplace"
+}
+
+func (r Registration) WebsiteCategories() []string {
+	return []string{
+		"Marketplace",
+	}
+}
+
+func (r Registration) DataSources() []sdk.DataSource {
+	return []sdk.DataSource{}
+}
+
+func (r Registration) Resources() []sdk.Resource {
+	return []sdk.Resource{
+		MarketplacePrivateStoreCollectionResource{},
+		MarketplacePrivateStoreCollectionOfferResource{},
+	}
+}
@@ -0,0 +1,15 @@
+package privatestorecollection
+
+import "github.com/Azure/go-autorest/autorest"
+
+type PrivateStoreCollectionClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewPrivateStoreCollectionClientWithBaseURI(endpoint string) PrivateStoreCollectionClient {
+	return PrivateStoreCollectionClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
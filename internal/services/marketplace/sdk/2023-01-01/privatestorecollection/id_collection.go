@@ -0,0 +1,111 @@
+package privatestorecollection
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = CollectionId{}
+
+// CollectionId is a struct representing the Resource ID for a Collection
+type CollectionId struct {
+	PrivateStoreId string
+	CollectionId   string
+}
+
+// NewCollectionID returns a new CollectionId struct
+func NewCollectionID(privateStoreId string, collectionId string) CollectionId {
+	return CollectionId{
+		PrivateStoreId: privateStoreId,
+		CollectionId:   collectionId,
+	}
+}
+
+// ParseCollectionID parses 'input' into a CollectionId
+func ParseCollectionID(input string) (*CollectionId, error) {
+	parser := resourceids.NewParserFromResourceIdType(CollectionId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := CollectionId{}
+
+	if id.PrivateStoreId, ok = parsed.Parsed["privateStoreId"]; !ok {
+		return nil, fmt.Errorf("the segment 'privateStoreId' was not found in the resource id %q", input)
+	}
+
+	if id.CollectionId, ok = parsed.Parsed["collectionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'collectionId' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseCollectionIDInsensitively parses 'input' case-insensitively into a CollectionId
+// note: this method should only be used for API response data and not user input
+func ParseCollectionIDInsensitively(input string) (*CollectionId, error) {
+	parser := resourceids.NewParserFromResourceIdType(CollectionId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := CollectionId{}
+
+	if id.PrivateStoreId, ok = parsed.Parsed["privateStoreId"]; !ok {
+		return nil, fmt.Errorf("the segment 'privateStoreId' was not found in the resource id %q", input)
+	}
+
+	if id.CollectionId, ok = parsed.Parsed["collectionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'collectionId' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateCollectionID checks that 'input' can be parsed as a Collection ID
+func ValidateCollectionID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseCollectionID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Collection ID
+func (id CollectionId) ID() string {
+	fmtString := "/providers/Microsoft.Marketplace/privateStores/%s/collections/%s"
+	return fmt.Sprintf(fmtString, id.PrivateStoreId, id.CollectionId)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Collection ID
+func (id CollectionId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftMarketplace", "Microsoft.Marketplace", "Microsoft.Marketplace"),
+		resourceids.StaticSegment("staticPrivateStores", "privateStores", "privateStores"),
+		resourceids.UserSpecifiedSegment("privateStoreId", "privateStoreIdValue"),
+		resourceids.StaticSegment("staticCollections", "collections", "collections"),
+		resourceids.UserSpecifiedSegment("collectionId", "collectionIdValue"),
+	}
+}
+
+// String returns a human-readable description of this Collection ID
+func (id CollectionId) String() string {
+	components := []string{
+		fmt.Sprintf("Private Store: %q", id.PrivateStoreId),
+		fmt.Sprintf("Collection: %q", id.CollectionId),
+	}
+	return fmt.Sprintf("Collection (%s)", strings.Join(components, "\n"))
+}
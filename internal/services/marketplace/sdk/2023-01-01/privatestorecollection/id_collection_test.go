@@ -0,0 +1,234 @@
+package privatestorecollection
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = CollectionId{}
+
+func TestNewCollectionID(t *testing.T) {
+	id := NewCollectionID("privateStoreIdValue", "collectionIdValue")
+
+	if id.PrivateStoreId != "privateStoreIdValue" {
+		t.Fatalf("Expected %q but got %q for Segment 'PrivateStoreId'", id.PrivateStoreId, "privateStoreIdValue")
+	}
+
+	if id.CollectionId != "collectionIdValue" {
+		t.Fatalf("Expected %q but got %q for Segment 'CollectionId'", id.CollectionId, "collectionIdValue")
+	}
+}
+
+func TestFormatCollectionID(t *testing.T) {
+	actual := NewCollectionID("privateStoreIdValue", "collectionIdValue").ID()
+	expected := "/providers/Microsoft.Marketplace/privateStores/privateStoreIdValue/collections/collectionIdValue"
+	if actual != expected {
+		t.Fatalf("Expected the Formatted ID to be %q but got %q", expected, actual)
+	}
+}
+
+func TestParseCollectionID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *CollectionId
+	}{
+		{
+			// Incomplete URI
+			Input: "",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/providers",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/providers/Microsoft.Marketplace",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/providers/Microsoft.Marketplace/privateStores",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/providers/Microsoft.Marketplace/privateStores/privateStoreIdValue",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/providers/Microsoft.Marketplace/privateStores/privateStoreIdValue/collections",
+			Error: true,
+		},
+		{
+			// Valid URI
+			Input: "/providers/Microsoft.Marketplace/privateStores/privateStoreIdValue/collections/collectionIdValue",
+			Expected: &CollectionId{
+				PrivateStoreId: "privateStoreIdValue",
+				CollectionId:   "collectionIdValue",
+			},
+		},
+		{
+			// Invalid (Valid Uri with Extra segment)
+			Input: "/providers/Microsoft.Marketplace/privateStores/privateStoreIdValue/collections/collectionIdValue/extra",
+			Error: true,
+		},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ParseCollectionID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %+v", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.PrivateStoreId != v.Expected.PrivateStoreId {
+			t.Fatalf("Expected %q but got %q for PrivateStoreId", v.Expected.PrivateStoreId, actual.PrivateStoreId)
+		}
+
+		if actual.CollectionId != v.Expected.CollectionId {
+			t.Fatalf("Expected %q but got %q for CollectionId", v.Expected.CollectionId, actual.CollectionId)
+		}
+
+	}
+}
+
+func TestParseCollectionIDInsensitively(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *CollectionId
+	}{
+		{
+			// Incomplete URI
+			Input: "",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/providers",
+			Error: true,
+		},
+		{
+			// Incomplete URI (mIxEd CaSe since this is insensitive)
+			Input: "/pRoViDeRs",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/providers/Microsoft.Marketplace",
+			Error: true,
+		},
+		{
+			// Incomplete URI (mIxEd CaSe since this is insensitive)
+			Input: "/pRoViDeRs/mIcRoSoFt.mArKeTpLaCe",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/providers/Microsoft.Marketplace/privateStores",
+			Error: true,
+		},
+		{
+			// Incomplete URI (mIxEd CaSe since this is insensitive)
+			Input: "/pRoViDeRs/mIcRoSoFt.mArKeTpLaCe/pRiVaTeStOrEs",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/providers/Microsoft.Marketplace/privateStores/privateStoreIdValue",
+			Error: true,
+		},
+		{
+			// Incomplete URI (mIxEd CaSe since this is insensitive)
+			Input: "/pRoViDeRs/mIcRoSoFt.mArKeTpLaCe/pRiVaTeStOrEs/pRiVaTeStOrEiDvAlUe",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/providers/Microsoft.Marketplace/privateStores/privateStoreIdValue/collections",
+			Error: true,
+		},
+		{
+			// Incomplete URI (mIxEd CaSe since this is insensitive)
+			Input: "/pRoViDeRs/mIcRoSoFt.mArKeTpLaCe/pRiVaTeStOrEs/pRiVaTeStOrEiDvAlUe/cOlLeCtIoNs",
+			Error: true,
+		},
+		{
+			// Valid URI
+			Input: "/providers/Microsoft.Marketplace/privateStores/privateStoreIdValue/collections/collectionIdValue",
+			Expected: &CollectionId{
+				PrivateStoreId: "privateStoreIdValue",
+				CollectionId:   "collectionIdValue",
+			},
+		},
+		{
+			// Invalid (Valid Uri with Extra segment)
+			Input: "/providers/Microsoft.Marketplace/privateStores/privateStoreIdValue/collections/collectionIdValue/extra",
+			Error: true,
+		},
+		{
+			// Valid URI (mIxEd CaSe since this is insensitive)
+			Input: "/pRoViDeRs/mIcRoSoFt.mArKeTpLaCe/pRiVaTeStOrEs/pRiVaTeStOrEiDvAlUe/cOlLeCtIoNs/cOlLeCtIoNiDvAlUe",
+			Expected: &CollectionId{
+				PrivateStoreId: "pRiVaTeStOrEiDvAlUe",
+				CollectionId:   "cOlLeCtIoNiDvAlUe",
+			},
+		},
+		{
+			// Invalid (Valid Uri with Extra segment - mIxEd CaSe since this is insensitive)
+			Input: "/pRoViDeRs/mIcRoSoFt.mArKeTpLaCe/pRiVaTeStOrEs/pRiVaTeStOrEiDvAlUe/cOlLeCtIoNs/cOlLeCtIoNiDvAlUe/extra",
+			Error: true,
+		},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ParseCollectionIDInsensitively(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %+v", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.PrivateStoreId != v.Expected.PrivateStoreId {
+			t.Fatalf("Expected %q but got %q for PrivateStoreId", v.Expected.PrivateStoreId, actual.PrivateStoreId)
+		}
+
+		if actual.CollectionId != v.Expected.CollectionId {
+			t.Fatalf("Expected %q but got %q for CollectionId", v.Expected.CollectionId, actual.CollectionId)
+		}
+
+	}
+}
+
+func TestSegmentsForCollectionId(t *testing.T) {
+	segments := CollectionId{}.Segments()
+	if len(segments) == 0 {
+		t.Fatalf("CollectionId has no segments")
+	}
+
+	uniqueNames := make(map[string]struct{}, 0)
+	for _, segment := range segments {
+		uniqueNames[segment.Name] = struct{}{}
+	}
+	if len(uniqueNames) != len(segments) {
+		t.Fatalf("Expected the Segments to be unique but got %q unique segments and %d total segments", len(uniqueNames), len(segments))
+	}
+}
@@ -0,0 +1,189 @@
+package privatestorecollection
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = PrivateStoreId{}
+
+func TestNewPrivateStoreID(t *testing.T) {
+	id := NewPrivateStoreID("privateStoreIdValue")
+
+	if id.PrivateStoreId != "privateStoreIdValue" {
+		t.Fatalf("Expected %q but got %q for Segment 'PrivateStoreId'", id.PrivateStoreId, "privateStoreIdValue")
+	}
+}
+
+func TestFormatPrivateStoreID(t *testing.T) {
+	actual := NewPrivateStoreID("privateStoreIdValue").ID()
+	expected := "/providers/Microsoft.Marketplace/privateStores/privateStoreIdValue"
+	if actual != expected {
+		t.Fatalf("Expected the Formatted ID to be %q but got %q", expected, actual)
+	}
+}
+
+func TestParsePrivateStoreID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *PrivateStoreId
+	}{
+		{
+			// Incomplete URI
+			Input: "",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/providers",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/providers/Microsoft.Marketplace",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/providers/Microsoft.Marketplace/privateStores",
+			Error: true,
+		},
+		{
+			// Valid URI
+			Input: "/providers/Microsoft.Marketplace/privateStores/privateStoreIdValue",
+			Expected: &PrivateStoreId{
+				PrivateStoreId: "privateStoreIdValue",
+			},
+		},
+		{
+			// Invalid (Valid Uri with Extra segment)
+			Input: "/providers/Microsoft.Marketplace/privateStores/privateStoreIdValue/extra",
+			Error: true,
+		},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ParsePrivateStoreID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %+v", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.PrivateStoreId != v.Expected.PrivateStoreId {
+			t.Fatalf("Expected %q but got %q for PrivateStoreId", v.Expected.PrivateStoreId, actual.PrivateStoreId)
+		}
+
+	}
+}
+
+func TestParsePrivateStoreIDInsensitively(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *PrivateStoreId
+	}{
+		{
+			// Incomplete URI
+			Input: "",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/providers",
+			Error: true,
+		},
+		{
+			// Incomplete URI (mIxEd CaSe since this is insensitive)
+			Input: "/pRoViDeRs",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/providers/Microsoft.Marketplace",
+			Error: true,
+		},
+		{
+			// Incomplete URI (mIxEd CaSe since this is insensitive)
+			Input: "/pRoViDeRs/mIcRoSoFt.mArKeTpLaCe",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/providers/Microsoft.Marketplace/privateStores",
+			Error: true,
+		},
+		{
+			// Incomplete URI (mIxEd CaSe since this is insensitive)
+			Input: "/pRoViDeRs/mIcRoSoFt.mArKeTpLaCe/pRiVaTeStOrEs",
+			Error: true,
+		},
+		{
+			// Valid URI
+			Input: "/providers/Microsoft.Marketplace/privateStores/privateStoreIdValue",
+			Expected: &PrivateStoreId{
+				PrivateStoreId: "privateStoreIdValue",
+			},
+		},
+		{
+			// Invalid (Valid Uri with Extra segment)
+			Input: "/providers/Microsoft.Marketplace/privateStores/privateStoreIdValue/extra",
+			Error: true,
+		},
+		{
+			// Valid URI (mIxEd CaSe since this is insensitive)
+			Input: "/pRoViDeRs/mIcRoSoFt.mArKeTpLaCe/pRiVaTeStOrEs/pRiVaTeStOrEiDvAlUe",
+			Expected: &PrivateStoreId{
+				PrivateStoreId: "pRiVaTeStOrEiDvAlUe",
+			},
+		},
+		{
+			// Invalid (Valid Uri with Extra segment - mIxEd CaSe since this is insensitive)
+			Input: "/pRoViDeRs/mIcRoSoFt.mArKeTpLaCe/pRiVaTeStOrEs/pRiVaTeStOrEiDvAlUe/extra",
+			Error: true,
+		},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ParsePrivateStoreIDInsensitively(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %+v", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.PrivateStoreId != v.Expected.PrivateStoreId {
+			t.Fatalf("Expected %q but got %q for PrivateStoreId", v.Expected.PrivateStoreId, actual.PrivateStoreId)
+		}
+
+	}
+}
+
+func TestSegmentsForPrivateStoreId(t *testing.T) {
+	segments := PrivateStoreId{}.Segments()
+	if len(segments) == 0 {
+		t.Fatalf("PrivateStoreId has no segments")
+	}
+
+	uniqueNames := make(map[string]struct{}, 0)
+	for _, segment := range segments {
+		uniqueNames[segment.Name] = struct{}{}
+	}
+	if len(uniqueNames) != len(segments) {
+		t.Fatalf("Expected the Segments to be unique but got %q unique segments and %d total segments", len(uniqueNames), len(segments))
+	}
+}
@@ -0,0 +1,9 @@
+package privatestorecollection
+
+type Collection struct {
+	Id         *string               `json:"id,omitempty"`
+	Name       *string               `json:"name,omitempty"`
+	Properties *CollectionProperties `json:"properties,omitempty"`
+	SystemData *SystemData           `json:"systemData,omitempty"`
+	Type       *string               `json:"type,omitempty"`
+}
@@ -0,0 +1,31 @@
+package privatestorecollection
+
+import (
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/dates"
+)
+
+type CollectionProperties struct {
+	AllSubscriptions          *bool     `json:"allSubscriptions,omitempty"`
+	ApproveAllItems           *bool     `json:"approveAllItems,omitempty"`
+	ApproveAllItemsModifiedAt *string   `json:"approveAllItemsModifiedAt,omitempty"`
+	Claim                     *string   `json:"claim,omitempty"`
+	CollectionId              *string   `json:"collectionId,omitempty"`
+	CollectionName            *string   `json:"collectionName,omitempty"`
+	Enabled                   *bool     `json:"enabled,omitempty"`
+	NumberOfOffers            *int64    `json:"numberOfOffers,omitempty"`
+	SubscriptionsList         *[]string `json:"subscriptionsList,omitempty"`
+}
+
+func (o CollectionProperties) GetApproveAllItemsModifiedAtAsTime() (*time.Time, error) {
+	if o.ApproveAllItemsModifiedAt == nil {
+		return nil, nil
+	}
+	return dates.ParseAsFormat(o.ApproveAllItemsModifiedAt, "2006-01-02T15:04:05Z07:00")
+}
+
+func (o CollectionProperties) SetApproveAllItemsModifiedAtAsTime(input time.Time) {
+	formatted := input.Format("2006-01-02T15:04:05Z07:00")
+	o.ApproveAllItemsModifiedAt = &formatted
+}
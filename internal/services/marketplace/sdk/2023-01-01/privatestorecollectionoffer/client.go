@@ -0,0 +1,15 @@
+package privatestorecollectionoffer
+
+import "github.com/Azure/go-autorest/autorest"
+
+type PrivateStoreCollectionOfferClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewPrivateStoreCollectionOfferClientWithBaseURI(endpoint string) PrivateStoreCollectionOfferClient {
+	return PrivateStoreCollectionOfferClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
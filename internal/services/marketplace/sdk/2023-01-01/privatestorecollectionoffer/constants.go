@@ -0,0 +1,71 @@
+package privatestorecollectionoffer
+
+import "strings"
+
+type Accessibility string
+
+const (
+	AccessibilityPrivateSubscriptionOnLevel Accessibility = "PrivateSubscriptionOnLevel"
+	AccessibilityPrivateTenantOnLevel       Accessibility = "PrivateTenantOnLevel"
+	AccessibilityPublic                     Accessibility = "Public"
+	AccessibilityUnknown                    Accessibility = "Unknown"
+)
+
+func PossibleValuesForAccessibility() []string {
+	return []string{
+		string(AccessibilityPrivateSubscriptionOnLevel),
+		string(AccessibilityPrivateTenantOnLevel),
+		string(AccessibilityPublic),
+		string(AccessibilityUnknown),
+	}
+}
+
+func parseAccessibility(input string) (*Accessibility, error) {
+	vals := map[string]Accessibility{
+		"privatesubscriptiononlevel": AccessibilityPrivateSubscriptionOnLevel,
+		"privatetenantonlevel":       AccessibilityPrivateTenantOnLevel,
+		"public":                     AccessibilityPublic,
+		"unknown":                    AccessibilityUnknown,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := Accessibility(input)
+	return &out, nil
+}
+
+type CreatedByType string
+
+const (
+	CreatedByTypeApplication     CreatedByType = "Application"
+	CreatedByTypeKey             CreatedByType = "Key"
+	CreatedByTypeManagedIdentity CreatedByType = "ManagedIdentity"
+	CreatedByTypeUser            CreatedByType = "User"
+)
+
+func PossibleValuesForCreatedByType() []string {
+	return []string{
+		string(CreatedByTypeApplication),
+		string(CreatedByTypeKey),
+		string(CreatedByTypeManagedIdentity),
+		string(CreatedByTypeUser),
+	}
+}
+
+func parseCreatedByType(input string) (*CreatedByType, error) {
+	vals := map[string]CreatedByType{
+		"application":     CreatedByTypeApplication,
+		"key":             CreatedByTypeKey,
+		"managedidentity": CreatedByTypeManagedIdentity,
+		"user":            CreatedByTypeUser,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := CreatedByType(input)
+	return &out, nil
+}
@@ -0,0 +1,124 @@
+package privatestorecollectionoffer
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = OfferId{}
+
+// OfferId is a struct representing the Resource ID for a Offer
+type OfferId struct {
+	PrivateStoreId string
+	CollectionId   string
+	OfferId        string
+}
+
+// NewOfferID returns a new OfferId struct
+func NewOfferID(privateStoreId string, collectionId string, offerId string) OfferId {
+	return OfferId{
+		PrivateStoreId: privateStoreId,
+		CollectionId:   collectionId,
+		OfferId:        offerId,
+	}
+}
+
+// ParseOfferID parses 'input' into a OfferId
+func ParseOfferID(input string) (*OfferId, error) {
+	parser := resourceids.NewParserFromResourceIdType(OfferId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := OfferId{}
+
+	if id.PrivateStoreId, ok = parsed.Parsed["privateStoreId"]; !ok {
+		return nil, fmt.Errorf("the segment 'privateStoreId' was not found in the resource id %q", input)
+	}
+
+	if id.CollectionId, ok = parsed.Parsed["collectionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'collectionId' was not found in the resource id %q", input)
+	}
+
+	if id.OfferId, ok = parsed.Parsed["offerId"]; !ok {
+		return nil, fmt.Errorf("the segment 'offerId' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseOfferIDInsensitively parses 'input' case-insensitively into a OfferId
+// note: this method should only be used for API response data and not user input
+func ParseOfferIDInsensitively(input string) (*OfferId, error) {
+	parser := resourceids.NewParserFromResourceIdType(OfferId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := OfferId{}
+
+	if id.PrivateStoreId, ok = parsed.Parsed["privateStoreId"]; !ok {
+		return nil, fmt.Errorf("the segment 'privateStoreId' was not found in the resource id %q", input)
+	}
+
+	if id.CollectionId, ok = parsed.Parsed["collectionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'collectionId' was not found in the resource id %q", input)
+	}
+
+	if id.OfferId, ok = parsed.Parsed["offerId"]; !ok {
+		return nil, fmt.Errorf("the segment 'offerId' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateOfferID checks that 'input' can be parsed as a Offer ID
+func ValidateOfferID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseOfferID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Offer ID
+func (id OfferId) ID() string {
+	fmtString := "/providers/Microsoft.Marketplace/privateStores/%s/collections/%s/offers/%s"
+	return fmt.Sprintf(fmtString, id.PrivateStoreId, id.CollectionId, id.OfferId)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Offer ID
+func (id OfferId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftMarketplace", "Microsoft.Marketplace", "Microsoft.Marketplace"),
+		resourceids.StaticSegment("staticPrivateStores", "privateStores", "privateStores"),
+		resourceids.UserSpecifiedSegment("privateStoreId", "privateStoreIdValue"),
+		resourceids.StaticSegment("staticCollections", "collections", "collections"),
+		resourceids.UserSpecifiedSegment("collectionId", "collectionIdValue"),
+		resourceids.StaticSegment("staticOffers", "offers", "offers"),
+		resourceids.UserSpecifiedSegment("offerId", "offerIdValue"),
+	}
+}
+
+// String returns a human-readable description of this Offer ID
+func (id OfferId) String() string {
+	components := []string{
+		fmt.Sprintf("Private Store: %q", id.PrivateStoreId),
+		fmt.Sprintf("Collection: %q", id.CollectionId),
+		fmt.Sprintf("Offer: %q", id.OfferId),
+	}
+	return fmt.Sprintf("Offer (%s)", strings.Join(components, "\n"))
+}
@@ -0,0 +1,279 @@
+package privatestorecollectionoffer
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = OfferId{}
+
+func TestNewOfferID(t *testing.T) {
+	id := NewOfferID("privateStoreIdValue", "collectionIdValue", "offerIdValue")
+
+	if id.PrivateStoreId != "privateStoreIdValue" {
+		t.Fatalf("Expected %q but got %q for Segment 'PrivateStoreId'", id.PrivateStoreId, "privateStoreIdValue")
+	}
+
+	if id.CollectionId != "collectionIdValue" {
+		t.Fatalf("Expected %q but got %q for Segment 'CollectionId'", id.CollectionId, "collectionIdValue")
+	}
+
+	if id.OfferId != "offerIdValue" {
+		t.Fatalf("Expected %q but got %q for Segment 'OfferId'", id.OfferId, "offerIdValue")
+	}
+}
+
+func TestFormatOfferID(t *testing.T) {
+	actual := NewOfferID("privateStoreIdValue", "collectionIdValue", "offerIdValue").ID()
+	expected := "/providers/Microsoft.Marketplace/privateStores/privateStoreIdValue/collections/collectionIdValue/offers/offerIdValue"
+	if actual != expected {
+		t.Fatalf("Expected the Formatted ID to be %q but got %q", expected, actual)
+	}
+}
+
+func TestParseOfferID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *OfferId
+	}{
+		{
+			// Incomplete URI
+			Input: "",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/providers",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/providers/Microsoft.Marketplace",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/providers/Microsoft.Marketplace/privateStores",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/providers/Microsoft.Marketplace/privateStores/privateStoreIdValue",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/providers/Microsoft.Marketplace/privateStores/privateStoreIdValue/collections",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/providers/Microsoft.Marketplace/privateStores/privateStoreIdValue/collections/collectionIdValue",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/providers/Microsoft.Marketplace/privateStores/privateStoreIdValue/collections/collectionIdValue/offers",
+			Error: true,
+		},
+		{
+			// Valid URI
+			Input: "/providers/Microsoft.Marketplace/privateStores/privateStoreIdValue/collections/collectionIdValue/offers/offerIdValue",
+			Expected: &OfferId{
+				PrivateStoreId: "privateStoreIdValue",
+				CollectionId:   "collectionIdValue",
+				OfferId:        "offerIdValue",
+			},
+		},
+		{
+			// Invalid (Valid Uri with Extra segment)
+			Input: "/providers/Microsoft.Marketplace/privateStores/privateStoreIdValue/collections/collectionIdValue/offers/offerIdValue/extra",
+			Error: true,
+		},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ParseOfferID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %+v", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.PrivateStoreId != v.Expected.PrivateStoreId {
+			t.Fatalf("Expected %q but got %q for PrivateStoreId", v.Expected.PrivateStoreId, actual.PrivateStoreId)
+		}
+
+		if actual.CollectionId != v.Expected.CollectionId {
+			t.Fatalf("Expected %q but got %q for CollectionId", v.Expected.CollectionId, actual.CollectionId)
+		}
+
+		if actual.OfferId != v.Expected.OfferId {
+			t.Fatalf("Expected %q but got %q for OfferId", v.Expected.OfferId, actual.OfferId)
+		}
+
+	}
+}
+
+func TestParseOfferIDInsensitively(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *OfferId
+	}{
+		{
+			// Incomplete URI
+			Input: "",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/providers",
+			Error: true,
+		},
+		{
+			// Incomplete URI (mIxEd CaSe since this is insensitive)
+			Input: "/pRoViDeRs",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/providers/Microsoft.Marketplace",
+			Error: true,
+		},
+		{
+			// Incomplete URI (mIxEd CaSe since this is insensitive)
+			Input: "/pRoViDeRs/mIcRoSoFt.mArKeTpLaCe",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/providers/Microsoft.Marketplace/privateStores",
+			Error: true,
+		},
+		{
+			// Incomplete URI (mIxEd CaSe since this is insensitive)
+			Input: "/pRoViDeRs/mIcRoSoFt.mArKeTpLaCe/pRiVaTeStOrEs",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/providers/Microsoft.Marketplace/privateStores/privateStoreIdValue",
+			Error: true,
+		},
+		{
+			// Incomplete URI (mIxEd CaSe since this is insensitive)
+			Input: "/pRoViDeRs/mIcRoSoFt.mArKeTpLaCe/pRiVaTeStOrEs/pRiVaTeStOrEiDvAlUe",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/providers/Microsoft.Marketplace/privateStores/privateStoreIdValue/collections",
+			Error: true,
+		},
+		{
+			// Incomplete URI (mIxEd CaSe since this is insensitive)
+			Input: "/pRoViDeRs/mIcRoSoFt.mArKeTpLaCe/pRiVaTeStOrEs/pRiVaTeStOrEiDvAlUe/cOlLeCtIoNs",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/providers/Microsoft.Marketplace/privateStores/privateStoreIdValue/collections/collectionIdValue",
+			Error: true,
+		},
+		{
+			// Incomplete URI (mIxEd CaSe since this is insensitive)
+			Input: "/pRoViDeRs/mIcRoSoFt.mArKeTpLaCe/pRiVaTeStOrEs/pRiVaTeStOrEiDvAlUe/cOlLeCtIoNs/cOlLeCtIoNiDvAlUe",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/providers/Microsoft.Marketplace/privateStores/privateStoreIdValue/collections/collectionIdValue/offers",
+			Error: true,
+		},
+		{
+			// Incomplete URI (mIxEd CaSe since this is insensitive)
+			Input: "/pRoViDeRs/mIcRoSoFt.mArKeTpLaCe/pRiVaTeStOrEs/pRiVaTeStOrEiDvAlUe/cOlLeCtIoNs/cOlLeCtIoNiDvAlUe/oFfErS",
+			Error: true,
+		},
+		{
+			// Valid URI
+			Input: "/providers/Microsoft.Marketplace/privateStores/privateStoreIdValue/collections/collectionIdValue/offers/offerIdValue",
+			Expected: &OfferId{
+				PrivateStoreId: "privateStoreIdValue",
+				CollectionId:   "collectionIdValue",
+				OfferId:        "offerIdValue",
+			},
+		},
+		{
+			// Invalid (Valid Uri with Extra segment)
+			Input: "/providers/Microsoft.Marketplace/privateStores/privateStoreIdValue/collections/collectionIdValue/offers/offerIdValue/extra",
+			Error: true,
+		},
+		{
+			// Valid URI (mIxEd CaSe since this is insensitive)
+			Input: "/pRoViDeRs/mIcRoSoFt.mArKeTpLaCe/pRiVaTeStOrEs/pRiVaTeStOrEiDvAlUe/cOlLeCtIoNs/cOlLeCtIoNiDvAlUe/oFfErS/oFfErIdVaLuE",
+			Expected: &OfferId{
+				PrivateStoreId: "pRiVaTeStOrEiDvAlUe",
+				CollectionId:   "cOlLeCtIoNiDvAlUe",
+				OfferId:        "oFfErIdVaLuE",
+			},
+		},
+		{
+			// Invalid (Valid Uri with Extra segment - mIxEd CaSe since this is insensitive)
+			Input: "/pRoViDeRs/mIcRoSoFt.mArKeTpLaCe/pRiVaTeStOrEs/pRiVaTeStOrEiDvAlUe/cOlLeCtIoNs/cOlLeCtIoNiDvAlUe/oFfErS/oFfErIdVaLuE/extra",
+			Error: true,
+		},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ParseOfferIDInsensitively(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %+v", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.PrivateStoreId != v.Expected.PrivateStoreId {
+			t.Fatalf("Expected %q but got %q for PrivateStoreId", v.Expected.PrivateStoreId, actual.PrivateStoreId)
+		}
+
+		if actual.CollectionId != v.Expected.CollectionId {
+			t.Fatalf("Expected %q but got %q for CollectionId", v.Expected.CollectionId, actual.CollectionId)
+		}
+
+		if actual.OfferId != v.Expected.OfferId {
+			t.Fatalf("Expected %q but got %q for OfferId", v.Expected.OfferId, actual.OfferId)
+		}
+
+	}
+}
+
+func TestSegmentsForOfferId(t *testing.T) {
+	segments := OfferId{}.Segments()
+	if len(segments) == 0 {
+		t.Fatalf("OfferId has no segments")
+	}
+
+	uniqueNames := make(map[string]struct{}, 0)
+	for _, segment := range segments {
+		uniqueNames[segment.Name] = struct{}{}
+	}
+	if len(uniqueNames) != len(segments) {
+		t.Fatalf("Expected the Segments to be unique but got %q unique segments and %d total segments", len(uniqueNames), len(segments))
+	}
+}
@@ -0,0 +1,9 @@
+package privatestorecollectionoffer
+
+type Offer struct {
+	Id         *string          `json:"id,omitempty"`
+	Name       *string          `json:"name,omitempty"`
+	Properties *OfferProperties `json:"properties,omitempty"`
+	SystemData *SystemData      `json:"systemData,omitempty"`
+	Type       *string          `json:"type,omitempty"`
+}
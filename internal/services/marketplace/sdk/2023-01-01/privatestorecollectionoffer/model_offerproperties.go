@@ -0,0 +1,15 @@
+package privatestorecollectionoffer
+
+type OfferProperties struct {
+	CreatedAt                      *string            `json:"createdAt,omitempty"`
+	ETag                           *string            `json:"eTag,omitempty"`
+	IconFileUris                   *map[string]string `json:"iconFileUris,omitempty"`
+	ModifiedAt                     *string            `json:"modifiedAt,omitempty"`
+	OfferDisplayName               *string            `json:"offerDisplayName,omitempty"`
+	Plans                          *[]Plan            `json:"plans,omitempty"`
+	PrivateStoreId                 *string            `json:"privateStoreId,omitempty"`
+	PublisherDisplayName           *string            `json:"publisherDisplayName,omitempty"`
+	SpecificPlanIdsLimitation      *[]string          `json:"specificPlanIdsLimitation,omitempty"`
+	UniqueOfferId                  *string            `json:"uniqueOfferId,omitempty"`
+	UpdateSuppressedDueIdempotence *bool              `json:"updateSuppressedDueIdempotence,omitempty"`
+}
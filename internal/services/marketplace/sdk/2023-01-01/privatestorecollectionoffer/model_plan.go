@@ -0,0 +1,10 @@
+package privatestorecollectionoffer
+
+type Plan struct {
+	Accessibility     *Accessibility `json:"accessibility,omitempty"`
+	AltStackReference *string        `json:"altStackReference,omitempty"`
+	PlanDisplayName   *string        `json:"planDisplayName,omitempty"`
+	PlanId            *string        `json:"planId,omitempty"`
+	SkuId             *string        `json:"skuId,omitempty"`
+	StackType         *string        `json:"stackType,omitempty"`
+}
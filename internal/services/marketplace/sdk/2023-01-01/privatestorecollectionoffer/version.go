@@ -0,0 +1,9 @@
+package privatestorecollectionoffer
+
+import "fmt"
+
+const defaultApiVersion = "2023-01-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/privatestorecollectionoffer/%s", defaultApiVersion)
+}
@@ -0,0 +1,31 @@
+package client
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/modsimworkbench/sdk/2024-02-28-preview/chambers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/modsimworkbench/sdk/2024-02-28-preview/connectors"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/modsimworkbench/sdk/2024-02-28-preview/workbenches"
+)
+
+type Client struct {
+	ChambersClient    *chambers.ChambersClient
+	ConnectorsClient  *connectors.ConnectorsClient
+	WorkbenchesClient *workbenches.WorkbenchesClient
+}
+
+func NewClient(o *common.ClientOptions) *Client {
+	chambersClient := chambers.NewChambersClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&chambersClient.Client, o.ResourceManagerAuthorizer)
+
+	connectorsClient := connectors.NewConnectorsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&connectorsClient.Client, o.ResourceManagerAuthorizer)
+
+	workbenchesClient := workbenches.NewWorkbenchesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&workbenchesClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		ChambersClient:    &chambersClient,
+		ConnectorsClient:  &connectorsClient,
+		WorkbenchesClient: &workbenchesClient,
+	}
+}
@@ -0,0 +1,202 @@
+package modsimworkbench
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/modsimworkbench/sdk/2024-02-28-preview/chambers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/modsimworkbench/sdk/2024-02-28-preview/workbenches"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/modsimworkbench/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+var _ sdk.ResourceWithUpdate = ModelingAndSimulationWorkbenchChamberResource{}
+
+type ModelingAndSimulationWorkbenchChamberResource struct{}
+
+type ModelingAndSimulationWorkbenchChamberResourceModel struct {
+	Name        string            `tfschema:"name"`
+	WorkbenchId string            `tfschema:"workbench_id"`
+	Location    string            `tfschema:"location"`
+	Tags        map[string]string `tfschema:"tags"`
+}
+
+func (r ModelingAndSimulationWorkbenchChamberResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.WorkbenchResourceName,
+		},
+
+		"workbench_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: workbenches.ValidateWorkbenchID,
+		},
+
+		"location": location.Schema(),
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r ModelingAndSimulationWorkbenchChamberResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ModelingAndSimulationWorkbenchChamberResource) ModelObject() interface{} {
+	return &ModelingAndSimulationWorkbenchChamberResourceModel{}
+}
+
+func (r ModelingAndSimulationWorkbenchChamberResource) ResourceType() string {
+	return "azurerm_modeling_and_simulation_workbench_chamber"
+}
+
+func (r ModelingAndSimulationWorkbenchChamberResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return chambers.ValidateChamberID
+}
+
+func (r ModelingAndSimulationWorkbenchChamberResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		// provisioning a Chamber deploys the underlying compute, storage and networking so can take some time
+		Timeout: 90 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ModSimWorkbench.ChambersClient
+
+			var model ModelingAndSimulationWorkbenchChamberResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			workbenchId, err := workbenches.ParseWorkbenchID(model.WorkbenchId)
+			if err != nil {
+				return err
+			}
+
+			id := chambers.NewChamberID(workbenchId.SubscriptionId, workbenchId.ResourceGroupName, workbenchId.WorkbenchName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := chambers.Chamber{
+				Location:   location.Normalize(model.Location),
+				Properties: &chambers.ChamberProperties{},
+				Tags:       &model.Tags,
+			}
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ModelingAndSimulationWorkbenchChamberResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ModSimWorkbench.ChambersClient
+
+			id, err := chambers.ParseChamberID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ModelingAndSimulationWorkbenchChamberResourceModel{
+				Name:        id.ChamberName,
+				WorkbenchId: workbenches.NewWorkbenchID(id.SubscriptionId, id.ResourceGroupName, id.WorkbenchName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ModelingAndSimulationWorkbenchChamberResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ModSimWorkbench.ChambersClient
+
+			id, err := chambers.ParseChamberID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ModelingAndSimulationWorkbenchChamberResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if resp.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+
+			payload := *resp.Model
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = &model.Tags
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ModelingAndSimulationWorkbenchChamberResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 90 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ModSimWorkbench.ChambersClient
+
+			id, err := chambers.ParseChamberID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
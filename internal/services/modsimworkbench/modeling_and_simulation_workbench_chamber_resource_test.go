@@ -0,0 +1,127 @@
+package modsimworkbench_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/modsimworkbench/sdk/2024-02-28-preview/chambers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ModelingAndSimulationWorkbenchChamberResource struct{}
+
+func TestAccModelingAndSimulationWorkbenchChamber_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_modeling_and_simulation_workbench_chamber", "test")
+	r := ModelingAndSimulationWorkbenchChamberResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccModelingAndSimulationWorkbenchChamber_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_modeling_and_simulation_workbench_chamber", "test")
+	r := ModelingAndSimulationWorkbenchChamberResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccModelingAndSimulationWorkbenchChamber_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_modeling_and_simulation_workbench_chamber", "test")
+	r := ModelingAndSimulationWorkbenchChamberResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("tags.ENV").HasValue("Test"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ModelingAndSimulationWorkbenchChamberResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := chambers.ParseChamberID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.ModSimWorkbench.ChambersClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r ModelingAndSimulationWorkbenchChamberResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_modeling_and_simulation_workbench_chamber" "test" {
+  name         = "acctest-mswc-%d"
+  workbench_id = azurerm_modeling_and_simulation_workbench.test.id
+  location     = azurerm_modeling_and_simulation_workbench.test.location
+}
+`, ModelingAndSimulationWorkbenchResource{}.basic(data), data.RandomInteger)
+}
+
+func (r ModelingAndSimulationWorkbenchChamberResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_modeling_and_simulation_workbench_chamber" "import" {
+  name         = azurerm_modeling_and_simulation_workbench_chamber.test.name
+  workbench_id = azurerm_modeling_and_simulation_workbench_chamber.test.workbench_id
+  location     = azurerm_modeling_and_simulation_workbench_chamber.test.location
+}
+`, r.basic(data))
+}
+
+func (r ModelingAndSimulationWorkbenchChamberResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_modeling_and_simulation_workbench_chamber" "test" {
+  name         = "acctest-mswc-%d"
+  workbench_id = azurerm_modeling_and_simulation_workbench.test.id
+  location     = azurerm_modeling_and_simulation_workbench.test.location
+
+  tags = {
+    ENV = "Test"
+  }
+}
+`, ModelingAndSimulationWorkbenchResource{}.basic(data), data.RandomInteger)
+}
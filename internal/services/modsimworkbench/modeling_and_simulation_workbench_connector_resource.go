@@ -0,0 +1,274 @@
+package modsimworkbench
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/modsimworkbench/sdk/2024-02-28-preview/chambers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/modsimworkbench/sdk/2024-02-28-preview/connectors"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/modsimworkbench/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.ResourceWithUpdate = ModelingAndSimulationWorkbenchConnectorResource{}
+
+type ModelingAndSimulationWorkbenchConnectorResource struct{}
+
+type ModelingAndSimulationWorkbenchConnectorResourceModel struct {
+	Name                     string            `tfschema:"name"`
+	ChamberId                string            `tfschema:"chamber_id"`
+	Location                 string            `tfschema:"location"`
+	NetworkType              string            `tfschema:"network_type"`
+	RemoteAllowedIpAddresses []string          `tfschema:"remote_allowed_ip_addresses"`
+	Tags                     map[string]string `tfschema:"tags"`
+}
+
+func (r ModelingAndSimulationWorkbenchConnectorResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.WorkbenchResourceName,
+		},
+
+		"chamber_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: chambers.ValidateChamberID,
+		},
+
+		"location": location.Schema(),
+
+		"network_type": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(connectors.PossibleValuesForNetworkType(), false),
+		},
+
+		"remote_allowed_ip_addresses": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.Any(validation.IsIPv4Address, validation.IsCIDR),
+			},
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r ModelingAndSimulationWorkbenchConnectorResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ModelingAndSimulationWorkbenchConnectorResource) ModelObject() interface{} {
+	return &ModelingAndSimulationWorkbenchConnectorResourceModel{}
+}
+
+func (r ModelingAndSimulationWorkbenchConnectorResource) ResourceType() string {
+	return "azurerm_modeling_and_simulation_workbench_connector"
+}
+
+func (r ModelingAndSimulationWorkbenchConnectorResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return connectors.ValidateConnectorID
+}
+
+func (r ModelingAndSimulationWorkbenchConnectorResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ModSimWorkbench.ConnectorsClient
+
+			var model ModelingAndSimulationWorkbenchConnectorResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			chamberId, err := chambers.ParseChamberID(model.ChamberId)
+			if err != nil {
+				return err
+			}
+
+			id := connectors.NewConnectorID(chamberId.SubscriptionId, chamberId.ResourceGroupName, chamberId.WorkbenchName, chamberId.ChamberName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			properties, err := expandModelingAndSimulationWorkbenchConnectorProperties(model)
+			if err != nil {
+				return err
+			}
+
+			payload := connectors.Connector{
+				Location:   location.Normalize(model.Location),
+				Properties: properties,
+				Tags:       &model.Tags,
+			}
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ModelingAndSimulationWorkbenchConnectorResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ModSimWorkbench.ConnectorsClient
+
+			id, err := connectors.ParseConnectorID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ModelingAndSimulationWorkbenchConnectorResourceModel{
+				Name:      id.ConnectorName,
+				ChamberId: chambers.NewChamberID(id.SubscriptionId, id.ResourceGroupName, id.WorkbenchName, id.ChamberName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+
+				if props := model.Properties; props != nil {
+					state.NetworkType = string(props.NetworkType)
+					state.RemoteAllowedIpAddresses = flattenModelingAndSimulationWorkbenchConnectorRemoteAllowlistEntries(props.RemoteAllowlistEntries)
+				}
+
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ModelingAndSimulationWorkbenchConnectorResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ModSimWorkbench.ConnectorsClient
+
+			id, err := connectors.ParseConnectorID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ModelingAndSimulationWorkbenchConnectorResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if resp.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+
+			payload := *resp.Model
+			if metadata.ResourceData.HasChange("remote_allowed_ip_addresses") {
+				properties, err := expandModelingAndSimulationWorkbenchConnectorProperties(model)
+				if err != nil {
+					return err
+				}
+				payload.Properties = properties
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = &model.Tags
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ModelingAndSimulationWorkbenchConnectorResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ModSimWorkbench.ConnectorsClient
+
+			id, err := connectors.ParseConnectorID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandModelingAndSimulationWorkbenchConnectorProperties(input ModelingAndSimulationWorkbenchConnectorResourceModel) (*connectors.ConnectorProperties, error) {
+	networkType := connectors.NetworkType(input.NetworkType)
+	properties := connectors.ConnectorProperties{
+		NetworkType: networkType,
+	}
+
+	if len(input.RemoteAllowedIpAddresses) > 0 {
+		if networkType != connectors.NetworkTypeRemote {
+			return nil, fmt.Errorf("`remote_allowed_ip_addresses` can only be specified when `network_type` is set to `%s`", string(connectors.NetworkTypeRemote))
+		}
+
+		entries := make([]connectors.RemoteAllowlistEntry, 0)
+		for _, v := range input.RemoteAllowedIpAddresses {
+			entries = append(entries, connectors.RemoteAllowlistEntry{
+				Address: v,
+			})
+		}
+		properties.RemoteAllowlistEntries = &entries
+	}
+
+	return &properties, nil
+}
+
+func flattenModelingAndSimulationWorkbenchConnectorRemoteAllowlistEntries(input *[]connectors.RemoteAllowlistEntry) []string {
+	results := make([]string, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, v := range *input {
+		results = append(results, v.Address)
+	}
+
+	return results
+}
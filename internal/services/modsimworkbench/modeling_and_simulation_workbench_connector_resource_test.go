@@ -0,0 +1,138 @@
+package modsimworkbench_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/modsimworkbench/sdk/2024-02-28-preview/connectors"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ModelingAndSimulationWorkbenchConnectorResource struct{}
+
+func TestAccModelingAndSimulationWorkbenchConnector_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_modeling_and_simulation_workbench_connector", "test")
+	r := ModelingAndSimulationWorkbenchConnectorResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccModelingAndSimulationWorkbenchConnector_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_modeling_and_simulation_workbench_connector", "test")
+	r := ModelingAndSimulationWorkbenchConnectorResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccModelingAndSimulationWorkbenchConnector_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_modeling_and_simulation_workbench_connector", "test")
+	r := ModelingAndSimulationWorkbenchConnectorResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("remote_allowed_ip_addresses.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ModelingAndSimulationWorkbenchConnectorResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := connectors.ParseConnectorID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.ModSimWorkbench.ConnectorsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r ModelingAndSimulationWorkbenchConnectorResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_modeling_and_simulation_workbench_connector" "test" {
+  name         = "acctest-mswcn-%d"
+  chamber_id   = azurerm_modeling_and_simulation_workbench_chamber.test.id
+  location     = azurerm_modeling_and_simulation_workbench_chamber.test.location
+  network_type = "Remote"
+}
+`, ModelingAndSimulationWorkbenchChamberResource{}.basic(data), data.RandomInteger)
+}
+
+func (r ModelingAndSimulationWorkbenchConnectorResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_modeling_and_simulation_workbench_connector" "import" {
+  name         = azurerm_modeling_and_simulation_workbench_connector.test.name
+  chamber_id   = azurerm_modeling_and_simulation_workbench_connector.test.chamber_id
+  location     = azurerm_modeling_and_simulation_workbench_connector.test.location
+  network_type = azurerm_modeling_and_simulation_workbench_connector.test.network_type
+}
+`, r.basic(data))
+}
+
+func (r ModelingAndSimulationWorkbenchConnectorResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_modeling_and_simulation_workbench_connector" "test" {
+  name                        = "acctest-mswcn-%d"
+  chamber_id                  = azurerm_modeling_and_simulation_workbench_chamber.test.id
+  location                    = azurerm_modeling_and_simulation_workbench_chamber.test.location
+  network_type                = "Remote"
+  remote_allowed_ip_addresses = ["203.0.113.10", "198.51.100.0/24"]
+
+  tags = {
+    ENV = "Test"
+  }
+}
+`, ModelingAndSimulationWorkbenchChamberResource{}.basic(data), data.RandomInteger)
+}
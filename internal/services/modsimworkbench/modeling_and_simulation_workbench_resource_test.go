@@ -0,0 +1,147 @@
+package modsimworkbench_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/modsimworkbench/sdk/2024-02-28-preview/workbenches"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ModelingAndSimulationWorkbenchResource struct{}
+
+func TestAccModelingAndSimulationWorkbench_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_modeling_and_simulation_workbench", "test")
+	r := ModelingAndSimulationWorkbenchResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccModelingAndSimulationWorkbench_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_modeling_and_simulation_workbench", "test")
+	r := ModelingAndSimulationWorkbenchResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccModelingAndSimulationWorkbench_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_modeling_and_simulation_workbench", "test")
+	r := ModelingAndSimulationWorkbenchResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("tags.ENV").HasValue("Test"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ModelingAndSimulationWorkbenchResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := workbenches.ParseWorkbenchID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.ModSimWorkbench.WorkbenchesClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r ModelingAndSimulationWorkbenchResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-modsim-%d"
+  location = %q
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r ModelingAndSimulationWorkbenchResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_modeling_and_simulation_workbench" "test" {
+  name                = "acctest-msw-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ModelingAndSimulationWorkbenchResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_modeling_and_simulation_workbench" "import" {
+  name                = azurerm_modeling_and_simulation_workbench.test.name
+  resource_group_name = azurerm_modeling_and_simulation_workbench.test.resource_group_name
+  location            = azurerm_modeling_and_simulation_workbench.test.location
+}
+`, r.basic(data))
+}
+
+func (r ModelingAndSimulationWorkbenchResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_modeling_and_simulation_workbench" "test" {
+  name                = "acctest-msw-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  tags = {
+    ENV = "Test"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
@@ -0,0 +1,31 @@
+package modsimworkbench
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+var _ sdk.TypedServiceRegistration = Registration{}
+
+type Registration struct{}
+
+func (r Registration) Name() string {
+	return "Modeling and Simulation Workbench"
+}
+
+func (r Registration) WebsiteCategories() []string {
+	return []string{
+		"Modeling and Simulation Workbench",
+	}
+}
+
+func (r Registration) DataSources() []sdk.DataSource {
+	return []sdk.DataSource{}
+}
+
+func (r Registration) Resources() []sdk.Resource {
+	return []sdk.Resource{
+		ModelingAndSimulationWorkbenchResource{},
+		ModelingAndSimulationWorkbenchChamberResource{},
+		ModelingAndSimulationWorkbenchConnectorResource{},
+	}
+}
@@ -0,0 +1,15 @@
+package chambers
+
+import "github.com/Azure/go-autorest/autorest"
+
+type ChambersClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewChambersClientWithBaseURI(endpoint string) ChambersClient {
+	return ChambersClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
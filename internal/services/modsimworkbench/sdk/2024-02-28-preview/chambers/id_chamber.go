@@ -0,0 +1,137 @@
+package chambers
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = ChamberId{}
+
+// ChamberId is a struct representing the Resource ID for a Chamber
+type ChamberId struct {
+	SubscriptionId    string
+	ResourceGroupName string
+	WorkbenchName     string
+	ChamberName       string
+}
+
+// NewChamberID returns a new ChamberId struct
+func NewChamberID(subscriptionId string, resourceGroupName string, workbenchName string, chamberName string) ChamberId {
+	return ChamberId{
+		SubscriptionId:    subscriptionId,
+		ResourceGroupName: resourceGroupName,
+		WorkbenchName:     workbenchName,
+		ChamberName:       chamberName,
+	}
+}
+
+// ParseChamberID parses 'input' into a ChamberId
+func ParseChamberID(input string) (*ChamberId, error) {
+	parser := resourceids.NewParserFromResourceIdType(ChamberId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := ChamberId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.WorkbenchName, ok = parsed.Parsed["workbenchName"]; !ok {
+		return nil, fmt.Errorf("the segment 'workbenchName' was not found in the resource id %q", input)
+	}
+
+	if id.ChamberName, ok = parsed.Parsed["chamberName"]; !ok {
+		return nil, fmt.Errorf("the segment 'chamberName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseChamberIDInsensitively parses 'input' case-insensitively into a ChamberId
+// note: this method should only be used for API response data and not user input
+func ParseChamberIDInsensitively(input string) (*ChamberId, error) {
+	parser := resourceids.NewParserFromResourceIdType(ChamberId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := ChamberId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.WorkbenchName, ok = parsed.Parsed["workbenchName"]; !ok {
+		return nil, fmt.Errorf("the segment 'workbenchName' was not found in the resource id %q", input)
+	}
+
+	if id.ChamberName, ok = parsed.Parsed["chamberName"]; !ok {
+		return nil, fmt.Errorf("the segment 'chamberName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateChamberID checks that 'input' can be parsed as a Chamber ID
+func ValidateChamberID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseChamberID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Chamber ID
+func (id ChamberId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ModSimWorkbench/workbenches/%s/chambers/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.WorkbenchName, id.ChamberName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Chamber ID
+func (id ChamberId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftModSimWorkbench", "Microsoft.ModSimWorkbench", "Microsoft.ModSimWorkbench"),
+		resourceids.StaticSegment("staticWorkbenches", "workbenches", "workbenches"),
+		resourceids.UserSpecifiedSegment("workbenchName", "workbenchValue"),
+		resourceids.StaticSegment("staticChambers", "chambers", "chambers"),
+		resourceids.UserSpecifiedSegment("chamberName", "chamberValue"),
+	}
+}
+
+// String returns a human-readable description of this Chamber ID
+func (id ChamberId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Workbench Name: %q", id.WorkbenchName),
+		fmt.Sprintf("Chamber Name: %q", id.ChamberName),
+	}
+	return fmt.Sprintf("Chamber (%s)", strings.Join(components, "\n"))
+}
@@ -0,0 +1,5 @@
+package chambers
+
+type ChamberProperties struct {
+	ProvisioningState *ProvisioningState `json:"provisioningState,omitempty"`
+}
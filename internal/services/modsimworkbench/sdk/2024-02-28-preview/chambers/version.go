@@ -0,0 +1,9 @@
+package chambers
+
+import "fmt"
+
+const defaultApiVersion = "2024-02-28-preview"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/chambers/%s", defaultApiVersion)
+}
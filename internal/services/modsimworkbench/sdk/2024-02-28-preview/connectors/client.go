@@ -0,0 +1,15 @@
+package connectors
+
+import "github.com/Azure/go-autorest/autorest"
+
+type ConnectorsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewConnectorsClientWithBaseURI(endpoint string) ConnectorsClient {
+	return ConnectorsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
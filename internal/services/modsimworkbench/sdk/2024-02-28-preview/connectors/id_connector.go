@@ -0,0 +1,150 @@
+package connectors
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = ConnectorId{}
+
+// ConnectorId is a struct representing the Resource ID for a Connector
+type ConnectorId struct {
+	SubscriptionId    string
+	ResourceGroupName string
+	WorkbenchName     string
+	ChamberName       string
+	ConnectorName     string
+}
+
+// NewConnectorID returns a new ConnectorId struct
+func NewConnectorID(subscriptionId string, resourceGroupName string, workbenchName string, chamberName string, connectorName string) ConnectorId {
+	return ConnectorId{
+		SubscriptionId:    subscriptionId,
+		ResourceGroupName: resourceGroupName,
+		WorkbenchName:     workbenchName,
+		ChamberName:       chamberName,
+		ConnectorName:     connectorName,
+	}
+}
+
+// ParseConnectorID parses 'input' into a ConnectorId
+func ParseConnectorID(input string) (*ConnectorId, error) {
+	parser := resourceids.NewParserFromResourceIdType(ConnectorId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := ConnectorId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.WorkbenchName, ok = parsed.Parsed["workbenchName"]; !ok {
+		return nil, fmt.Errorf("the segment 'workbenchName' was not found in the resource id %q", input)
+	}
+
+	if id.ChamberName, ok = parsed.Parsed["chamberName"]; !ok {
+		return nil, fmt.Errorf("the segment 'chamberName' was not found in the resource id %q", input)
+	}
+
+	if id.ConnectorName, ok = parsed.Parsed["connectorName"]; !ok {
+		return nil, fmt.Errorf("the segment 'connectorName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseConnectorIDInsensitively parses 'input' case-insensitively into a ConnectorId
+// note: this method should only be used for API response data and not user input
+func ParseConnectorIDInsensitively(input string) (*ConnectorId, error) {
+	parser := resourceids.NewParserFromResourceIdType(ConnectorId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := ConnectorId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.WorkbenchName, ok = parsed.Parsed["workbenchName"]; !ok {
+		return nil, fmt.Errorf("the segment 'workbenchName' was not found in the resource id %q", input)
+	}
+
+	if id.ChamberName, ok = parsed.Parsed["chamberName"]; !ok {
+		return nil, fmt.Errorf("the segment 'chamberName' was not found in the resource id %q", input)
+	}
+
+	if id.ConnectorName, ok = parsed.Parsed["connectorName"]; !ok {
+		return nil, fmt.Errorf("the segment 'connectorName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateConnectorID checks that 'input' can be parsed as a Connector ID
+func ValidateConnectorID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseConnectorID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Connector ID
+func (id ConnectorId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ModSimWorkbench/workbenches/%s/chambers/%s/connectors/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.WorkbenchName, id.ChamberName, id.ConnectorName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Connector ID
+func (id ConnectorId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftModSimWorkbench", "Microsoft.ModSimWorkbench", "Microsoft.ModSimWorkbench"),
+		resourceids.StaticSegment("staticWorkbenches", "workbenches", "workbenches"),
+		resourceids.UserSpecifiedSegment("workbenchName", "workbenchValue"),
+		resourceids.StaticSegment("staticChambers", "chambers", "chambers"),
+		resourceids.UserSpecifiedSegment("chamberName", "chamberValue"),
+		resourceids.StaticSegment("staticConnectors", "connectors", "connectors"),
+		resourceids.UserSpecifiedSegment("connectorName", "connectorValue"),
+	}
+}
+
+// String returns a human-readable description of this Connector ID
+func (id ConnectorId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Workbench Name: %q", id.WorkbenchName),
+		fmt.Sprintf("Chamber Name: %q", id.ChamberName),
+		fmt.Sprintf("Connector Name: %q", id.ConnectorName),
+	}
+	return fmt.Sprintf("Connector (%s)", strings.Join(components, "\n"))
+}
@@ -0,0 +1,7 @@
+package connectors
+
+type ConnectorProperties struct {
+	NetworkType            NetworkType             `json:"networkType"`
+	ProvisioningState      *ProvisioningState      `json:"provisioningState,omitempty"`
+	RemoteAllowlistEntries *[]RemoteAllowlistEntry `json:"remoteAllowlistEntries,omitempty"`
+}
@@ -0,0 +1,5 @@
+package connectors
+
+type RemoteAllowlistEntry struct {
+	Address string `json:"address"`
+}
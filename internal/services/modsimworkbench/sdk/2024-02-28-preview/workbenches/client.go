@@ -0,0 +1,15 @@
+package workbenches
+
+import "github.com/Azure/go-autorest/autorest"
+
+type WorkbenchesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewWorkbenchesClientWithBaseURI(endpoint string) WorkbenchesClient {
+	return WorkbenchesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,124 @@
+package workbenches
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = WorkbenchId{}
+
+// WorkbenchId is a struct representing the Resource ID for a Workbench
+type WorkbenchId struct {
+	SubscriptionId    string
+	ResourceGroupName string
+	WorkbenchName     string
+}
+
+// NewWorkbenchID returns a new WorkbenchId struct
+func NewWorkbenchID(subscriptionId string, resourceGroupName string, workbenchName string) WorkbenchId {
+	return WorkbenchId{
+		SubscriptionId:    subscriptionId,
+		ResourceGroupName: resourceGroupName,
+		WorkbenchName:     workbenchName,
+	}
+}
+
+// ParseWorkbenchID parses 'input' into a WorkbenchId
+func ParseWorkbenchID(input string) (*WorkbenchId, error) {
+	parser := resourceids.NewParserFromResourceIdType(WorkbenchId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := WorkbenchId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.WorkbenchName, ok = parsed.Parsed["workbenchName"]; !ok {
+		return nil, fmt.Errorf("the segment 'workbenchName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseWorkbenchIDInsensitively parses 'input' case-insensitively into a WorkbenchId
+// note: this method should only be used for API response data and not user input
+func ParseWorkbenchIDInsensitively(input string) (*WorkbenchId, error) {
+	parser := resourceids.NewParserFromResourceIdType(WorkbenchId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := WorkbenchId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.WorkbenchName, ok = parsed.Parsed["workbenchName"]; !ok {
+		return nil, fmt.Errorf("the segment 'workbenchName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateWorkbenchID checks that 'input' can be parsed as a Workbench ID
+func ValidateWorkbenchID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseWorkbenchID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Workbench ID
+func (id WorkbenchId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ModSimWorkbench/workbenches/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.WorkbenchName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Workbench ID
+func (id WorkbenchId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftModSimWorkbench", "Microsoft.ModSimWorkbench", "Microsoft.ModSimWorkbench"),
+		resourceids.StaticSegment("staticWorkbenches", "workbenches", "workbenches"),
+		resourceids.UserSpecifiedSegment("workbenchName", "workbenchValue"),
+	}
+}
+
+// String returns a human-readable description of this Workbench ID
+func (id WorkbenchId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Workbench Name: %q", id.WorkbenchName),
+	}
+	return fmt.Sprintf("Workbench (%s)", strings.Join(components, "\n"))
+}
@@ -0,0 +1,5 @@
+package workbenches
+
+type WorkbenchProperties struct {
+	ProvisioningState *ProvisioningState `json:"provisioningState,omitempty"`
+}
@@ -0,0 +1,16 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// WorkbenchResourceName validates the name of a Modeling and Simulation Workbench, Chamber or Connector
+func WorkbenchResourceName(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+	if matched := regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9-]{1,62}[a-zA-Z0-9]$`).Match([]byte(value)); !matched {
+		errors = append(errors, fmt.Errorf("%q must be between 3 and 64 characters, may only contain alphanumeric characters and hyphens, and must start and end with an alphanumeric character", k))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,58 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestWorkbenchResourceName(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "",
+			ErrCount: 1,
+		},
+		{
+			Value:    "ab",
+			ErrCount: 1,
+		},
+		{
+			Value:    "abc",
+			ErrCount: 0,
+		},
+		{
+			Value:    "example-chamber-1",
+			ErrCount: 0,
+		},
+		{
+			Value:    "-chamber",
+			ErrCount: 1,
+		},
+		{
+			Value:    "chamber-",
+			ErrCount: 1,
+		},
+		{
+			Value:    "chamber_1",
+			ErrCount: 1,
+		},
+		{
+			Value:    strings.Repeat("a", 64),
+			ErrCount: 0,
+		},
+		{
+			Value:    strings.Repeat("a", 65),
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := WorkbenchResourceName(tc.Value, "name")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Workbench Resource Name %q to trigger %d validation errors but got %d", tc.Value, tc.ErrCount, len(errors))
+		}
+	}
+}
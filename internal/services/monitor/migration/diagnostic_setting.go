@@ -0,0 +1,194 @@
+package migration
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+var _ pluginsdk.StateUpgrade = DiagnosticSettingUpgradeV0ToV1{}
+
+type DiagnosticSettingUpgradeV0ToV1 struct{}
+
+func (DiagnosticSettingUpgradeV0ToV1) Schema() map[string]*pluginsdk.Schema {
+	return diagnosticSettingSchemaForV0()
+}
+
+func (DiagnosticSettingUpgradeV0ToV1) UpgradeFunc() pluginsdk.StateUpgraderFunc {
+	return func(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+		// the `log` block has been superseded by `enabled_log`, which only contains the enabled categories,
+		// and `retention_policy` has been removed from both the log and metric blocks
+		enabledLogs := make([]interface{}, 0)
+		if v, ok := rawState["enabled_log"].([]interface{}); ok {
+			for _, raw := range v {
+				item, ok := raw.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				delete(item, "retention_policy")
+				enabledLogs = append(enabledLogs, item)
+			}
+		}
+
+		if v, ok := rawState["log"].([]interface{}); ok && len(enabledLogs) == 0 {
+			for _, raw := range v {
+				item, ok := raw.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				if enabled, ok := item["enabled"].(bool); ok && !enabled {
+					continue
+				}
+
+				enabledLogs = append(enabledLogs, map[string]interface{}{
+					"category":       item["category"],
+					"category_group": "",
+				})
+			}
+		}
+		delete(rawState, "log")
+		rawState["enabled_log"] = enabledLogs
+
+		if v, ok := rawState["metric"].([]interface{}); ok {
+			for _, raw := range v {
+				if item, ok := raw.(map[string]interface{}); ok {
+					delete(item, "retention_policy")
+				}
+			}
+		}
+
+		log.Printf("[DEBUG] Migrated %d `log` blocks into `enabled_log`", len(enabledLogs))
+
+		return rawState, nil
+	}
+}
+
+func diagnosticSettingSchemaForV0() map[string]*pluginsdk.Schema {
+	retentionPolicySchema := func() *pluginsdk.Schema {
+		return &pluginsdk.Schema{
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"enabled": {
+						Type:     pluginsdk.TypeBool,
+						Required: true,
+					},
+
+					"days": {
+						Type:     pluginsdk.TypeInt,
+						Optional: true,
+					},
+				},
+			},
+		}
+	}
+
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+
+		"target_resource_id": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+
+		"eventhub_name": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			ForceNew: true,
+		},
+
+		"eventhub_authorization_rule_id": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			ForceNew: true,
+		},
+
+		"log_analytics_workspace_id": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+
+		"storage_account_id": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			ForceNew: true,
+		},
+
+		"log_analytics_destination_type": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+
+		"log": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"category": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+					},
+
+					"enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  true,
+					},
+
+					"retention_policy": retentionPolicySchema(),
+				},
+			},
+		},
+
+		"enabled_log": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"category": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+					},
+
+					"category_group": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+					},
+
+					"retention_policy": retentionPolicySchema(),
+				},
+			},
+		},
+
+		"metric": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"category": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+					},
+
+					"enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  true,
+					},
+
+					"retention_policy": retentionPolicySchema(),
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,105 @@
+package migration
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestDiagnosticSettingV0ToV1(t *testing.T) {
+	testData := []struct {
+		name     string
+		input    map[string]interface{}
+		expected map[string]interface{}
+	}{
+		{
+			name: "log blocks",
+			input: map[string]interface{}{
+				"log": []interface{}{
+					map[string]interface{}{
+						"category": "AuditEvent",
+						"enabled":  true,
+						"retention_policy": []interface{}{
+							map[string]interface{}{
+								"enabled": true,
+								"days":    7,
+							},
+						},
+					},
+					map[string]interface{}{
+						"category":         "AzurePolicyEvaluationDetails",
+						"enabled":          false,
+						"retention_policy": []interface{}{},
+					},
+				},
+				"metric": []interface{}{
+					map[string]interface{}{
+						"category":         "AllMetrics",
+						"enabled":          true,
+						"retention_policy": []interface{}{},
+					},
+				},
+			},
+			expected: map[string]interface{}{
+				"enabled_log": []interface{}{
+					map[string]interface{}{
+						"category":       "AuditEvent",
+						"category_group": "",
+					},
+				},
+				"metric": []interface{}{
+					map[string]interface{}{
+						"category": "AllMetrics",
+						"enabled":  true,
+					},
+				},
+			},
+		},
+		{
+			name: "enabled_log blocks",
+			input: map[string]interface{}{
+				"log": []interface{}{
+					map[string]interface{}{
+						"category":         "AuditEvent",
+						"enabled":          true,
+						"retention_policy": []interface{}{},
+					},
+				},
+				"enabled_log": []interface{}{
+					map[string]interface{}{
+						"category":         "",
+						"category_group":   "allLogs",
+						"retention_policy": []interface{}{},
+					},
+				},
+			},
+			expected: map[string]interface{}{
+				"enabled_log": []interface{}{
+					map[string]interface{}{
+						"category":       "",
+						"category_group": "allLogs",
+					},
+				},
+			},
+		},
+		{
+			name:  "no logs",
+			input: map[string]interface{}{},
+			expected: map[string]interface{}{
+				"enabled_log": []interface{}{},
+			},
+		},
+	}
+
+	for _, test := range testData {
+		t.Logf("Testing %q...", test.name)
+		result, err := DiagnosticSettingUpgradeV0ToV1{}.UpgradeFunc()(context.TODO(), test.input, nil)
+		if err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+
+		if !reflect.DeepEqual(test.expected, result) {
+			t.Fatalf("expected %+v but got %+v", test.expected, result)
+		}
+	}
+}
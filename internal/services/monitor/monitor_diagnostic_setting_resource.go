@@ -7,15 +7,17 @@ import (
 	"strings"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2021-07-01-preview/insights"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	authRuleParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub/sdk/2017-04-01/authorizationrulesnamespaces"
 	eventhubValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub/validate"
 	logAnalyticsParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/parse"
 	logAnalyticsValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/migration"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/sdk/2021-05-01-preview/diagnosticsettings"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/validate"
 	storageParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
 	storageValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
@@ -26,7 +28,7 @@ import (
 )
 
 func resourceMonitorDiagnosticSetting() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceMonitorDiagnosticSettingCreateUpdate,
 		Read:   resourceMonitorDiagnosticSettingRead,
 		Update: resourceMonitorDiagnosticSettingCreateUpdate,
@@ -93,40 +95,21 @@ func resourceMonitorDiagnosticSetting() *pluginsdk.Resource {
 				}, false),
 			},
 
-			"log": {
+			"enabled_log": {
 				Type:     pluginsdk.TypeSet,
 				Optional: true,
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
 						"category": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-						},
-
-						"enabled": {
-							Type:     pluginsdk.TypeBool,
-							Optional: true,
-							Default:  true,
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
 						},
 
-						"retention_policy": {
-							Type:     pluginsdk.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &pluginsdk.Resource{
-								Schema: map[string]*pluginsdk.Schema{
-									"enabled": {
-										Type:     pluginsdk.TypeBool,
-										Required: true,
-									},
-
-									"days": {
-										Type:         pluginsdk.TypeInt,
-										Optional:     true,
-										ValidateFunc: validation.IntAtLeast(0),
-									},
-								},
-							},
+						"category_group": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
 						},
 					},
 				},
@@ -147,27 +130,69 @@ func resourceMonitorDiagnosticSetting() *pluginsdk.Resource {
 							Optional: true,
 							Default:  true,
 						},
+					},
+				},
+			},
+		},
+	}
 
-						"retention_policy": {
-							Type:     pluginsdk.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &pluginsdk.Resource{
-								Schema: map[string]*pluginsdk.Schema{
-									"enabled": {
-										Type:     pluginsdk.TypeBool,
-										Required: true,
-									},
-
-									"days": {
-										Type:         pluginsdk.TypeInt,
-										Optional:     true,
-										ValidateFunc: validation.IntAtLeast(0),
-									},
-								},
-							},
-						},
+	if !features.ThreePointOh() {
+		resource.Schema["log"] = &pluginsdk.Schema{
+			Type:          pluginsdk.TypeSet,
+			Optional:      true,
+			Computed:      true,
+			Deprecated:    "`log` has been superseded by `enabled_log` and will be removed in version 3.0 of the AzureRM Provider.",
+			ConflictsWith: []string{"enabled_log"},
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"category": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+					},
+
+					"enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  true,
 					},
+
+					"retention_policy": monitorDiagnosticSettingRetentionPolicySchema(),
+				},
+			},
+		}
+
+		resource.Schema["enabled_log"].Computed = true
+		resource.Schema["enabled_log"].ConflictsWith = []string{"log"}
+		resource.Schema["enabled_log"].Elem.(*pluginsdk.Resource).Schema["retention_policy"] = monitorDiagnosticSettingRetentionPolicySchema()
+		resource.Schema["metric"].Elem.(*pluginsdk.Resource).Schema["retention_policy"] = monitorDiagnosticSettingRetentionPolicySchema()
+	} else {
+		// the `log` block and `retention_policy` are removed in 3.0 - so existing `log` blocks are moved into `enabled_log`
+		resource.SchemaVersion = 1
+		resource.StateUpgraders = pluginsdk.StateUpgrades(map[int]pluginsdk.StateUpgrade{
+			0: migration.DiagnosticSettingUpgradeV0ToV1{},
+		})
+	}
+
+	return resource
+}
+
+func monitorDiagnosticSettingRetentionPolicySchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:       pluginsdk.TypeList,
+		Optional:   true,
+		MaxItems:   1,
+		Deprecated: "`retention_policy` has been deprecated in favour of the `azurerm_storage_management_policy` resource and will be removed in version 3.0 of the AzureRM Provider - to learn more go to https://aka.ms/diagnostic_settings_log_retention",
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"enabled": {
+					Type:     pluginsdk.TypeBool,
+					Required: true,
+				},
+
+				"days": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(0),
 				},
 			},
 		},
@@ -182,41 +207,52 @@ func resourceMonitorDiagnosticSettingCreateUpdate(d *pluginsdk.ResourceData, met
 
 	name := d.Get("name").(string)
 	actualResourceId := d.Get("target_resource_id").(string)
+	id := diagnosticsettings.NewScopedDiagnosticSettingID(actualResourceId, name)
 
 	if d.IsNewResource() {
-		existing, err := client.Get(ctx, actualResourceId, name)
+		existing, err := client.Get(ctx, id)
 		if err != nil {
-			if !utils.ResponseWasNotFound(existing.Response) {
+			if !response.WasNotFound(existing.HttpResponse) {
 				return fmt.Errorf("checking for presence of existing Monitor Diagnostic Setting %q for Resource %q: %s", name, actualResourceId, err)
 			}
 		}
 
-		if existing.ID != nil && *existing.ID != "" {
-			return tf.ImportAsExistsError("azurerm_monitor_diagnostic_setting", *existing.ID)
+		if existing.Model != nil && existing.Model.Id != nil && *existing.Model.Id != "" {
+			return tf.ImportAsExistsError("azurerm_monitor_diagnostic_setting", *existing.Model.Id)
 		}
 	}
 
-	logsRaw := d.Get("log").(*pluginsdk.Set).List()
-	logs := expandMonitorDiagnosticsSettingsLogs(logsRaw)
-	metricsRaw := d.Get("metric").(*pluginsdk.Set).List()
-	metrics := expandMonitorDiagnosticsSettingsMetrics(metricsRaw)
+	logs, err := expandMonitorDiagnosticsSettingsEnabledLogs(d.Get("enabled_log").(*pluginsdk.Set).List())
+	if err != nil {
+		return err
+	}
+	if !features.ThreePointOh() {
+		// both `log` and `enabled_log` are Computed, so use the block which has been changed in the config
+		if len(logs) == 0 || (d.HasChange("log") && !d.HasChange("enabled_log")) {
+			logs = expandMonitorDiagnosticsSettingsLogs(d.Get("log").(*pluginsdk.Set).List())
+		}
+	}
+	metrics := expandMonitorDiagnosticsSettingsMetrics(d.Get("metric").(*pluginsdk.Set).List())
 
 	// if no blocks are specified  the API "creates" but 404's on Read
 	if len(logs) == 0 && len(metrics) == 0 {
-		return fmt.Errorf("At least one `log` or `metric` block must be specified")
+		if !features.ThreePointOh() {
+			return fmt.Errorf("At least one `enabled_log`, `log` or `metric` block must be specified")
+		}
+		return fmt.Errorf("At least one `enabled_log` or `metric` block must be specified")
 	}
 
 	// also if there's none enabled
 	valid := false
 	for _, v := range logs {
-		if v.Enabled != nil && *v.Enabled {
+		if v.Enabled {
 			valid = true
 			break
 		}
 	}
 	if !valid {
 		for _, v := range metrics {
-			if v.Enabled != nil && *v.Enabled {
+			if v.Enabled {
 				valid = true
 				break
 			}
@@ -224,11 +260,11 @@ func resourceMonitorDiagnosticSettingCreateUpdate(d *pluginsdk.ResourceData, met
 	}
 
 	if !valid {
-		return fmt.Errorf("At least one `log` or `metric` must be enabled")
+		return fmt.Errorf("At least one `enabled_log` or `metric` must be enabled")
 	}
 
-	properties := insights.DiagnosticSettingsResource{
-		DiagnosticSettings: &insights.DiagnosticSettings{
+	properties := diagnosticsettings.DiagnosticSettingsResource{
+		Properties: &diagnosticsettings.DiagnosticSettings{
 			Logs:    &logs,
 			Metrics: &metrics,
 		},
@@ -238,26 +274,26 @@ func resourceMonitorDiagnosticSettingCreateUpdate(d *pluginsdk.ResourceData, met
 	eventHubAuthorizationRuleId := d.Get("eventhub_authorization_rule_id").(string)
 	eventHubName := d.Get("eventhub_name").(string)
 	if eventHubAuthorizationRuleId != "" {
-		properties.DiagnosticSettings.EventHubAuthorizationRuleID = utils.String(eventHubAuthorizationRuleId)
-		properties.DiagnosticSettings.EventHubName = utils.String(eventHubName)
+		properties.Properties.EventHubAuthorizationRuleId = utils.String(eventHubAuthorizationRuleId)
+		properties.Properties.EventHubName = utils.String(eventHubName)
 		valid = true
 	}
 
 	workspaceId := d.Get("log_analytics_workspace_id").(string)
 	if workspaceId != "" {
-		properties.DiagnosticSettings.WorkspaceID = utils.String(workspaceId)
+		properties.Properties.WorkspaceId = utils.String(workspaceId)
 		valid = true
 	}
 
 	storageAccountId := d.Get("storage_account_id").(string)
 	if storageAccountId != "" {
-		properties.DiagnosticSettings.StorageAccountID = utils.String(storageAccountId)
+		properties.Properties.StorageAccountId = utils.String(storageAccountId)
 		valid = true
 	}
 
 	if v := d.Get("log_analytics_destination_type").(string); v != "" {
 		if workspaceId != "" {
-			properties.DiagnosticSettings.LogAnalyticsDestinationType = &v
+			properties.Properties.LogAnalyticsDestinationType = &v
 		} else {
 			return fmt.Errorf("`log_analytics_workspace_id` must be set for `log_analytics_destination_type` to be used")
 		}
@@ -267,17 +303,15 @@ func resourceMonitorDiagnosticSettingCreateUpdate(d *pluginsdk.ResourceData, met
 		return fmt.Errorf("Either a `eventhub_authorization_rule_id`, `log_analytics_workspace_id` or `storage_account_id` must be set")
 	}
 
-	// the Azure SDK prefixes the URI with a `/` such this makes a bad request if we don't trim the `/`
-	targetResourceId := strings.TrimPrefix(actualResourceId, "/")
-	if _, err := client.CreateOrUpdate(ctx, targetResourceId, properties, name); err != nil {
+	if _, err := client.CreateOrUpdate(ctx, id, properties); err != nil {
 		return fmt.Errorf("creating Monitor Diagnostics Setting %q for Resource %q: %+v", name, actualResourceId, err)
 	}
 
-	read, err := client.Get(ctx, targetResourceId, name)
+	read, err := client.Get(ctx, id)
 	if err != nil {
 		return err
 	}
-	if read.ID == nil {
+	if read.Model == nil || read.Model.Id == nil {
 		return fmt.Errorf("Cannot read ID for Monitor Diagnostics %q for Resource ID %q", name, actualResourceId)
 	}
 
@@ -297,10 +331,9 @@ func resourceMonitorDiagnosticSettingRead(d *pluginsdk.ResourceData, meta interf
 	}
 
 	actualResourceId := id.ResourceID
-	targetResourceId := strings.TrimPrefix(actualResourceId, "/")
-	resp, err := client.Get(ctx, targetResourceId, id.Name)
+	resp, err := client.Get(ctx, diagnosticsettings.NewScopedDiagnosticSettingID(actualResourceId, id.Name))
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if response.WasNotFound(resp.HttpResponse) {
 			log.Printf("[WARN] Monitor Diagnostics Setting %q was not found for Resource %q - removing from state!", id.Name, actualResourceId)
 			d.SetId("")
 			return nil
@@ -312,49 +345,64 @@ func resourceMonitorDiagnosticSettingRead(d *pluginsdk.ResourceData, meta interf
 	d.Set("name", id.Name)
 	d.Set("target_resource_id", id.ResourceID)
 
-	d.Set("eventhub_name", resp.EventHubName)
-	eventhubAuthorizationRuleId := ""
-	if resp.EventHubAuthorizationRuleID != nil && *resp.EventHubAuthorizationRuleID != "" {
-		authRuleId := utils.NormalizeNilableString(resp.EventHubAuthorizationRuleID)
-		parsedId, err := authRuleParse.ParseAuthorizationRuleID(authRuleId)
-		if err != nil {
-			return err
+	if model := resp.Model; model != nil && model.Properties != nil {
+		props := model.Properties
+
+		d.Set("eventhub_name", props.EventHubName)
+		eventhubAuthorizationRuleId := ""
+		if props.EventHubAuthorizationRuleId != nil && *props.EventHubAuthorizationRuleId != "" {
+			authRuleId := utils.NormalizeNilableString(props.EventHubAuthorizationRuleId)
+			parsedId, err := authRuleParse.ParseAuthorizationRuleID(authRuleId)
+			if err != nil {
+				return err
+			}
+
+			eventhubAuthorizationRuleId = parsedId.ID()
 		}
+		d.Set("eventhub_authorization_rule_id", eventhubAuthorizationRuleId)
 
-		eventhubAuthorizationRuleId = parsedId.ID()
-	}
-	d.Set("eventhub_authorization_rule_id", eventhubAuthorizationRuleId)
+		workspaceId := ""
+		if props.WorkspaceId != nil && *props.WorkspaceId != "" {
+			parsedId, err := logAnalyticsParse.LogAnalyticsWorkspaceID(*props.WorkspaceId)
+			if err != nil {
+				return err
+			}
 
-	workspaceId := ""
-	if resp.WorkspaceID != nil && *resp.WorkspaceID != "" {
-		parsedId, err := logAnalyticsParse.LogAnalyticsWorkspaceID(*resp.WorkspaceID)
-		if err != nil {
-			return err
+			workspaceId = parsedId.ID()
 		}
+		d.Set("log_analytics_workspace_id", workspaceId)
 
-		workspaceId = parsedId.ID()
-	}
-	d.Set("log_analytics_workspace_id", workspaceId)
+		storageAccountId := ""
+		if props.StorageAccountId != nil && *props.StorageAccountId != "" {
+			parsedId, err := storageParse.StorageAccountID(*props.StorageAccountId)
+			if err != nil {
+				return err
+			}
+
+			storageAccountId = parsedId.ID()
+		}
+		d.Set("storage_account_id", storageAccountId)
+
+		d.Set("log_analytics_destination_type", props.LogAnalyticsDestinationType)
 
-	storageAccountId := ""
-	if resp.StorageAccountID != nil && *resp.StorageAccountID != "" {
-		parsedId, err := storageParse.StorageAccountID(*resp.StorageAccountID)
+		categoryGroups, err := monitorDiagnosticSettingCategoryGroups(ctx, client, actualResourceId, d.Get("enabled_log").(*pluginsdk.Set).List(), props.Logs)
 		if err != nil {
 			return err
 		}
 
-		storageAccountId = parsedId.ID()
-	}
-	d.Set("storage_account_id", storageAccountId)
-
-	d.Set("log_analytics_destination_type", resp.LogAnalyticsDestinationType)
+		if err := d.Set("enabled_log", flattenMonitorDiagnosticEnabledLogs(props.Logs, d.Get("enabled_log").(*pluginsdk.Set).List(), categoryGroups)); err != nil {
+			return fmt.Errorf("setting `enabled_log`: %+v", err)
+		}
 
-	if err := d.Set("log", flattenMonitorDiagnosticLogs(resp.Logs)); err != nil {
-		return fmt.Errorf("setting `log`: %+v", err)
-	}
+		if !features.ThreePointOh() {
+			if err := d.Set("log", flattenMonitorDiagnosticLogs(props.Logs)); err != nil {
+				return fmt.Errorf("setting `log`: %+v", err)
+			}
+		}
 
-	if err := d.Set("metric", flattenMonitorDiagnosticMetrics(resp.Metrics)); err != nil {
-		return fmt.Errorf("setting `metric`: %+v", err)
+		if err := d.Set("metric", flattenMonitorDiagnosticMetrics(props.Metrics)); err != nil {
+			return fmt.Errorf("setting `metric`: %+v", err)
+		}
 	}
 
 	return nil
@@ -370,11 +418,11 @@ func resourceMonitorDiagnosticSettingDelete(d *pluginsdk.ResourceData, meta inte
 		return err
 	}
 
-	targetResourceId := strings.TrimPrefix(id.ResourceID, "/")
-	resp, err := client.Delete(ctx, targetResourceId, id.Name)
+	settingId := diagnosticsettings.NewScopedDiagnosticSettingID(id.ResourceID, id.Name)
+	resp, err := client.Delete(ctx, settingId)
 	if err != nil {
-		if !response.WasNotFound(resp.Response) {
-			return fmt.Errorf("deleting Monitor Diagnostics Setting %q for Resource %q: %+v", id.Name, targetResourceId, err)
+		if !response.WasNotFound(resp.HttpResponse) {
+			return fmt.Errorf("deleting Monitor Diagnostics Setting %q for Resource %q: %+v", id.Name, id.ResourceID, err)
 		}
 	}
 
@@ -383,7 +431,7 @@ func resourceMonitorDiagnosticSettingDelete(d *pluginsdk.ResourceData, meta inte
 	stateConf := &pluginsdk.StateChangeConf{
 		Pending:                   []string{"Exists"},
 		Target:                    []string{"NotFound"},
-		Refresh:                   monitorDiagnosticSettingDeletedRefreshFunc(ctx, client, targetResourceId, id.Name),
+		Refresh:                   monitorDiagnosticSettingDeletedRefreshFunc(ctx, client, settingId),
 		MinTimeout:                15 * time.Second,
 		ContinuousTargetOccurence: 5,
 		Timeout:                   d.Timeout(pluginsdk.TimeoutDelete),
@@ -396,11 +444,11 @@ func resourceMonitorDiagnosticSettingDelete(d *pluginsdk.ResourceData, meta inte
 	return nil
 }
 
-func monitorDiagnosticSettingDeletedRefreshFunc(ctx context.Context, client *insights.DiagnosticSettingsClient, targetResourceId string, name string) pluginsdk.StateRefreshFunc {
+func monitorDiagnosticSettingDeletedRefreshFunc(ctx context.Context, client *diagnosticsettings.DiagnosticSettingsClient, id diagnosticsettings.ScopedDiagnosticSettingId) pluginsdk.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		res, err := client.Get(ctx, targetResourceId, name)
+		res, err := client.Get(ctx, id)
 		if err != nil {
-			if utils.ResponseWasNotFound(res.Response) {
+			if response.WasNotFound(res.HttpResponse) {
 				return "NotFound", "NotFound", nil
 			}
 			return nil, "", fmt.Errorf("issuing read request in monitorDiagnosticSettingDeletedRefreshFunc: %s", err)
@@ -410,103 +458,194 @@ func monitorDiagnosticSettingDeletedRefreshFunc(ctx context.Context, client *ins
 	}
 }
 
-func expandMonitorDiagnosticsSettingsLogs(input []interface{}) []insights.LogSettings {
-	results := make([]insights.LogSettings, 0)
+// monitorDiagnosticSettingCategoryGroups returns the log categories contained within each category group which is
+// either configured or returned for this Diagnostic Setting. Azure may return a category group as the individual
+// categories it contains, so these are needed to map the response back onto the configured `enabled_log` blocks.
+func monitorDiagnosticSettingCategoryGroups(ctx context.Context, client *diagnosticsettings.DiagnosticSettingsClient, resourceId string, existing []interface{}, input *[]diagnosticsettings.LogSettings) (map[string][]string, error) {
+	usesCategoryGroups := false
+	for _, raw := range existing {
+		if v, ok := raw.(map[string]interface{}); ok && v["category_group"].(string) != "" {
+			usesCategoryGroups = true
+			break
+		}
+	}
+	if !usesCategoryGroups && input != nil {
+		for _, v := range *input {
+			if v.CategoryGroup != nil && *v.CategoryGroup != "" {
+				usesCategoryGroups = true
+				break
+			}
+		}
+	}
+
+	// looking up the categories is an additional API call, so only do this when it's needed
+	if !usesCategoryGroups {
+		return nil, nil
+	}
+
+	resp, err := client.CategoryList(ctx, diagnosticsettings.NewResourceUriID(resourceId))
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Diagnostic Setting Categories for Resource %q: %+v", resourceId, err)
+	}
+
+	groups := make(map[string][]string)
+	if resp.Model == nil || resp.Model.Value == nil {
+		return groups, nil
+	}
+
+	for _, v := range *resp.Model.Value {
+		if v.Name == nil || v.Properties == nil || v.Properties.CategoryGroups == nil {
+			continue
+		}
+		if v.Properties.CategoryType != nil && *v.Properties.CategoryType != diagnosticsettings.CategoryTypeLogs {
+			continue
+		}
+
+		for _, group := range *v.Properties.CategoryGroups {
+			key := strings.ToLower(group)
+			groups[key] = append(groups[key], *v.Name)
+		}
+	}
+
+	return groups, nil
+}
+
+func expandMonitorDiagnosticsSettingsEnabledLogs(input []interface{}) ([]diagnosticsettings.LogSettings, error) {
+	results := make([]diagnosticsettings.LogSettings, 0)
 
 	for _, raw := range input {
 		v := raw.(map[string]interface{})
 
 		category := v["category"].(string)
-		enabled := v["enabled"].(bool)
-		policiesRaw := v["retention_policy"].([]interface{})
-		var retentionPolicy *insights.RetentionPolicy
-		if len(policiesRaw) != 0 {
-			policyRaw := policiesRaw[0].(map[string]interface{})
-			retentionDays := policyRaw["days"].(int)
-			retentionEnabled := policyRaw["enabled"].(bool)
-			retentionPolicy = &insights.RetentionPolicy{
-				Days:    utils.Int32(int32(retentionDays)),
-				Enabled: utils.Bool(retentionEnabled),
-			}
+		categoryGroup := v["category_group"].(string)
+		if (category == "") == (categoryGroup == "") {
+			return nil, fmt.Errorf("exactly one of `category` or `category_group` must be specified within an `enabled_log` block")
+		}
+
+		output := diagnosticsettings.LogSettings{
+			Enabled: true,
+		}
+		if category != "" {
+			output.Category = utils.String(category)
+		} else {
+			output.CategoryGroup = utils.String(categoryGroup)
 		}
 
-		output := insights.LogSettings{
-			Category:        utils.String(category),
-			Enabled:         utils.Bool(enabled),
-			RetentionPolicy: retentionPolicy,
+		if !features.ThreePointOh() {
+			output.RetentionPolicy = expandMonitorDiagnosticsSettingsRetentionPolicy(v["retention_policy"].([]interface{}))
 		}
 
 		results = append(results, output)
 	}
 
-	return results
+	return results, nil
 }
 
-func flattenMonitorDiagnosticLogs(input *[]insights.LogSettings) []interface{} {
+func flattenMonitorDiagnosticEnabledLogs(input *[]diagnosticsettings.LogSettings, existing []interface{}, categoryGroups map[string][]string) []interface{} {
 	results := make([]interface{}, 0)
 	if input == nil {
 		return results
 	}
 
+	enabledCategories := make(map[string]diagnosticsettings.LogSettings)
+	enabledGroups := make(map[string]diagnosticsettings.LogSettings)
 	for _, v := range *input {
-		output := make(map[string]interface{})
-
-		if v.Category != nil {
-			output["category"] = *v.Category
+		if !v.Enabled {
+			continue
 		}
 
-		if v.Enabled != nil {
-			output["enabled"] = *v.Enabled
+		if v.CategoryGroup != nil && *v.CategoryGroup != "" {
+			enabledGroups[strings.ToLower(*v.CategoryGroup)] = v
+		} else if v.Category != nil && *v.Category != "" {
+			enabledCategories[strings.ToLower(*v.Category)] = v
 		}
+	}
 
-		policies := make([]interface{}, 0)
-
-		if inputPolicy := v.RetentionPolicy; inputPolicy != nil {
-			outputPolicy := make(map[string]interface{})
+	// when a configured category group is returned as the individual categories within it, and all of those
+	// categories are enabled, fold them back into the category group to avoid a perpetual diff
+	for _, raw := range existing {
+		v, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		group := v["category_group"].(string)
+		if group == "" {
+			continue
+		}
+		key := strings.ToLower(group)
+		if _, ok := enabledGroups[key]; ok {
+			continue
+		}
 
-			if inputPolicy.Days != nil {
-				outputPolicy["days"] = int(*inputPolicy.Days)
-			}
+		categories := categoryGroups[key]
+		if len(categories) == 0 {
+			continue
+		}
 
-			if inputPolicy.Enabled != nil {
-				outputPolicy["enabled"] = *inputPolicy.Enabled
+		allEnabled := true
+		for _, category := range categories {
+			if _, ok := enabledCategories[strings.ToLower(category)]; !ok {
+				allEnabled = false
+				break
 			}
+		}
+		if !allEnabled {
+			continue
+		}
 
-			policies = append(policies, outputPolicy)
+		enabledGroups[key] = diagnosticsettings.LogSettings{
+			CategoryGroup:   utils.String(group),
+			Enabled:         true,
+			RetentionPolicy: enabledCategories[strings.ToLower(categories[0])].RetentionPolicy,
 		}
+	}
 
-		output["retention_policy"] = policies
+	// individual categories covered by an enabled category group are implied by that group
+	for group := range enabledGroups {
+		for _, category := range categoryGroups[group] {
+			delete(enabledCategories, strings.ToLower(category))
+		}
+	}
 
-		results = append(results, output)
+	for _, v := range enabledGroups {
+		results = append(results, flattenMonitorDiagnosticEnabledLog(v))
+	}
+	for _, v := range enabledCategories {
+		results = append(results, flattenMonitorDiagnosticEnabledLog(v))
 	}
 
 	return results
 }
 
-func expandMonitorDiagnosticsSettingsMetrics(input []interface{}) []insights.MetricSettings {
-	results := make([]insights.MetricSettings, 0)
+func flattenMonitorDiagnosticEnabledLog(input diagnosticsettings.LogSettings) map[string]interface{} {
+	output := map[string]interface{}{
+		"category":       "",
+		"category_group": "",
+	}
+
+	if input.CategoryGroup != nil && *input.CategoryGroup != "" {
+		output["category_group"] = *input.CategoryGroup
+	} else if input.Category != nil {
+		output["category"] = *input.Category
+	}
+
+	if !features.ThreePointOh() {
+		output["retention_policy"] = flattenMonitorDiagnosticsSettingsRetentionPolicy(input.RetentionPolicy)
+	}
+
+	return output
+}
+
+func expandMonitorDiagnosticsSettingsLogs(input []interface{}) []diagnosticsettings.LogSettings {
+	results := make([]diagnosticsettings.LogSettings, 0)
 
 	for _, raw := range input {
 		v := raw.(map[string]interface{})
 
-		category := v["category"].(string)
-		enabled := v["enabled"].(bool)
-
-		policiesRaw := v["retention_policy"].([]interface{})
-		var retentionPolicy *insights.RetentionPolicy
-		if len(policiesRaw) > 0 && policiesRaw[0] != nil {
-			policyRaw := policiesRaw[0].(map[string]interface{})
-			retentionDays := policyRaw["days"].(int)
-			retentionEnabled := policyRaw["enabled"].(bool)
-			retentionPolicy = &insights.RetentionPolicy{
-				Days:    utils.Int32(int32(retentionDays)),
-				Enabled: utils.Bool(retentionEnabled),
-			}
-		}
-		output := insights.MetricSettings{
-			Category:        utils.String(category),
-			Enabled:         utils.Bool(enabled),
-			RetentionPolicy: retentionPolicy,
+		output := diagnosticsettings.LogSettings{
+			Category:        utils.String(v["category"].(string)),
+			Enabled:         v["enabled"].(bool),
+			RetentionPolicy: expandMonitorDiagnosticsSettingsRetentionPolicy(v["retention_policy"].([]interface{})),
 		}
 
 		results = append(results, output)
@@ -515,40 +654,68 @@ func expandMonitorDiagnosticsSettingsMetrics(input []interface{}) []insights.Met
 	return results
 }
 
-func flattenMonitorDiagnosticMetrics(input *[]insights.MetricSettings) []interface{} {
+func flattenMonitorDiagnosticLogs(input *[]diagnosticsettings.LogSettings) []interface{} {
 	results := make([]interface{}, 0)
 	if input == nil {
 		return results
 	}
 
 	for _, v := range *input {
-		output := make(map[string]interface{})
+		// category groups can only be represented within the `enabled_log` block
+		if v.Category == nil || *v.Category == "" {
+			continue
+		}
 
-		if v.Category != nil {
-			output["category"] = *v.Category
+		results = append(results, map[string]interface{}{
+			"category":         *v.Category,
+			"enabled":          v.Enabled,
+			"retention_policy": flattenMonitorDiagnosticsSettingsRetentionPolicy(v.RetentionPolicy),
+		})
+	}
+
+	return results
+}
+
+func expandMonitorDiagnosticsSettingsMetrics(input []interface{}) []diagnosticsettings.MetricSettings {
+	results := make([]diagnosticsettings.MetricSettings, 0)
+
+	for _, raw := range input {
+		v := raw.(map[string]interface{})
+
+		output := diagnosticsettings.MetricSettings{
+			Category: utils.String(v["category"].(string)),
+			Enabled:  v["enabled"].(bool),
 		}
 
-		if v.Enabled != nil {
-			output["enabled"] = *v.Enabled
+		if !features.ThreePointOh() {
+			output.RetentionPolicy = expandMonitorDiagnosticsSettingsRetentionPolicy(v["retention_policy"].([]interface{}))
 		}
 
-		policies := make([]interface{}, 0)
+		results = append(results, output)
+	}
 
-		if inputPolicy := v.RetentionPolicy; inputPolicy != nil {
-			outputPolicy := make(map[string]interface{})
+	return results
+}
 
-			if inputPolicy.Days != nil {
-				outputPolicy["days"] = int(*inputPolicy.Days)
-			}
+func flattenMonitorDiagnosticMetrics(input *[]diagnosticsettings.MetricSettings) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
 
-			if inputPolicy.Enabled != nil {
-				outputPolicy["enabled"] = *inputPolicy.Enabled
-			}
+	for _, v := range *input {
+		output := map[string]interface{}{
+			"category": "",
+			"enabled":  v.Enabled,
+		}
 
-			policies = append(policies, outputPolicy)
+		if v.Category != nil {
+			output["category"] = *v.Category
 		}
 
-		output["retention_policy"] = policies
+		if !features.ThreePointOh() {
+			output["retention_policy"] = flattenMonitorDiagnosticsSettingsRetentionPolicy(v.RetentionPolicy)
+		}
 
 		results = append(results, output)
 	}
@@ -556,6 +723,31 @@ func flattenMonitorDiagnosticMetrics(input *[]insights.MetricSettings) []interfa
 	return results
 }
 
+func expandMonitorDiagnosticsSettingsRetentionPolicy(input []interface{}) *diagnosticsettings.RetentionPolicy {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	return &diagnosticsettings.RetentionPolicy{
+		Days:    int64(v["days"].(int)),
+		Enabled: v["enabled"].(bool),
+	}
+}
+
+func flattenMonitorDiagnosticsSettingsRetentionPolicy(input *diagnosticsettings.RetentionPolicy) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"days":    int(input.Days),
+			"enabled": input.Enabled,
+		},
+	}
+}
+
 type monitorDiagnosticId struct {
 	ResourceID string
 	Name       string
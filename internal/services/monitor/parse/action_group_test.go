@@ -199,6 +199,16 @@ func TestActionGroupIDInsensitively(t *testing.T) {
 				Name:           "actionGroup1",
 			},
 		},
+
+		{
+			// upper-cased root segment names
+			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/group1/PROVIDERS/Microsoft.Insights/actionGroups/actionGroup1",
+			Expected: &ActionGroupId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "group1",
+				Name:           "actionGroup1",
+			},
+		},
 	}
 
 	for _, v := range testData {
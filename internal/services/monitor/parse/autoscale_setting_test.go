@@ -199,6 +199,16 @@ func TestAutoscaleSettingIDInsensitively(t *testing.T) {
 				Name:           "setting1",
 			},
 		},
+
+		{
+			// upper-cased root segment names
+			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/group1/PROVIDERS/Microsoft.Insights/autoscaleSettings/setting1",
+			Expected: &AutoscaleSettingId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "group1",
+				Name:           "setting1",
+			},
+		},
 	}
 
 	for _, v := range testData {
@@ -199,6 +199,16 @@ func TestMetricAlertIDInsensitively(t *testing.T) {
 				Name:           "alert1",
 			},
 		},
+
+		{
+			// upper-cased root segment names
+			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/group1/PROVIDERS/Microsoft.Insights/metricAlerts/alert1",
+			Expected: &MetricAlertId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "group1",
+				Name:           "alert1",
+			},
+		},
 	}
 
 	for _, v := range testData {
@@ -199,6 +199,16 @@ func TestScheduledQueryRulesIDInsensitively(t *testing.T) {
 				ScheduledQueryRuleName: "rule1",
 			},
 		},
+
+		{
+			// upper-cased root segment names
+			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/group1/PROVIDERS/Microsoft.Insights/scheduledQueryRules/rule1",
+			Expected: &ScheduledQueryRulesId{
+				SubscriptionId:         "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:          "group1",
+				ScheduledQueryRuleName: "rule1",
+			},
+		},
 	}
 
 	for _, v := range testData {
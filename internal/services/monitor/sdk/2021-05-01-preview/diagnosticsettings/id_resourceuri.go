@@ -0,0 +1,95 @@
+package diagnosticsettings
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = ResourceUriId{}
+
+// ResourceUriId is a struct representing the Resource ID for a Resource Uri
+type ResourceUriId struct {
+	Scope string
+}
+
+// NewResourceUriID returns a new ResourceUriId struct
+func NewResourceUriID(scope string) ResourceUriId {
+	return ResourceUriId{
+		Scope: scope,
+	}
+}
+
+// ParseResourceUriID parses 'input' into a ResourceUriId
+func ParseResourceUriID(input string) (*ResourceUriId, error) {
+	parser := resourceids.NewParserFromResourceIdType(ResourceUriId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := ResourceUriId{}
+
+	if id.Scope, ok = parsed.Parsed["scope"]; !ok {
+		return nil, fmt.Errorf("the segment 'scope' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseResourceUriIDInsensitively parses 'input' case-insensitively into a ResourceUriId
+// note: this method should only be used for API response data and not user input
+func ParseResourceUriIDInsensitively(input string) (*ResourceUriId, error) {
+	parser := resourceids.NewParserFromResourceIdType(ResourceUriId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := ResourceUriId{}
+
+	if id.Scope, ok = parsed.Parsed["scope"]; !ok {
+		return nil, fmt.Errorf("the segment 'scope' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateResourceUriID checks that 'input' can be parsed as a Resource Uri ID
+func ValidateResourceUriID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseResourceUriID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Resource Uri ID
+func (id ResourceUriId) ID() string {
+	fmtString := "/%s"
+	return fmt.Sprintf(fmtString, strings.TrimPrefix(id.Scope, "/"))
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Resource Uri ID
+func (id ResourceUriId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.ScopeSegment("scope", "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/some-resource-group"),
+	}
+}
+
+// String returns a human-readable description of this Resource Uri ID
+func (id ResourceUriId) String() string {
+	components := []string{
+		fmt.Sprintf("Scope: %q", id.Scope),
+	}
+	return fmt.Sprintf("Resource Uri (%s)", strings.Join(components, "\n"))
+}
@@ -0,0 +1,65 @@
+package diagnosticsettings
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type CategoryListResponse struct {
+	HttpResponse *http.Response
+	Model        *DiagnosticSettingsCategoryResourceCollection
+}
+
+// CategoryList ...
+func (c DiagnosticSettingsClient) CategoryList(ctx context.Context, id ResourceUriId) (result CategoryListResponse, err error) {
+	req, err := c.preparerForCategoryList(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "diagnosticsettings.DiagnosticSettingsClient", "CategoryList", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "diagnosticsettings.DiagnosticSettingsClient", "CategoryList", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForCategoryList(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "diagnosticsettings.DiagnosticSettingsClient", "CategoryList", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForCategoryList prepares the CategoryList request.
+func (c DiagnosticSettingsClient) preparerForCategoryList(ctx context.Context, id ResourceUriId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(fmt.Sprintf("%s/providers/Microsoft.Insights/diagnosticSettingsCategories", id.ID())),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForCategoryList handles the response to the CategoryList request. The method always
+// closes the http.Response Body.
+func (c DiagnosticSettingsClient) responderForCategoryList(resp *http.Response) (result CategoryListResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
@@ -0,0 +1,6 @@
+package diagnosticsettings
+
+type DiagnosticSettingsCategory struct {
+	CategoryGroups *[]string     `json:"categoryGroups,omitempty"`
+	CategoryType   *CategoryType `json:"categoryType,omitempty"`
+}
@@ -0,0 +1,5 @@
+package diagnosticsettings
+
+type DiagnosticSettingsCategoryResourceCollection struct {
+	Value *[]DiagnosticSettingsCategoryResource `json:"value,omitempty"`
+}
@@ -0,0 +1,8 @@
+package diagnosticsettings
+
+type LogSettings struct {
+	Category        *string          `json:"category,omitempty"`
+	CategoryGroup   *string          `json:"categoryGroup,omitempty"`
+	Enabled         bool             `json:"enabled"`
+	RetentionPolicy *RetentionPolicy `json:"retentionPolicy,omitempty"`
+}
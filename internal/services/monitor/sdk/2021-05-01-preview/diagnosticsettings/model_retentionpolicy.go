@@ -0,0 +1,6 @@
+package diagnosticsettings
+
+type RetentionPolicy struct {
+	Days    int64 `json:"days"`
+	Enabled bool  `json:"enabled"`
+}
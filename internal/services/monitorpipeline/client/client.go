@@ -0,0 +1,19 @@
+package client
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitorpipeline/sdk/2023-10-01-preview/pipelinegroups"
+)
+
+type Client struct {
+	PipelineGroupsClient *pipelinegroups.PipelineGroupsClient
+}
+
+func NewClient(o *common.ClientOptions) *Client {
+	pipelineGroupsClient := pipelinegroups.NewPipelineGroupsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&pipelineGroupsClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		PipelineGroupsClient: &pipelineGroupsClient,
+	}
+}
@@ -0,0 +1,1173 @@
+package monitorpipeline
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitorpipeline/sdk/2023-10-01-preview/pipelinegroups"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.ResourceWithUpdate = PipelineGroupResource{}
+
+type PipelineGroupResource struct{}
+
+type PipelineGroupResourceModel struct {
+	Name                    string                         `tfschema:"name"`
+	ResourceGroupName       string                         `tfschema:"resource_group_name"`
+	Location                string                         `tfschema:"location"`
+	CustomLocationId        string                         `tfschema:"custom_location_id"`
+	Replicas                int                            `tfschema:"replicas"`
+	Receiver                []ReceiverModel                `tfschema:"receiver"`
+	Processor               []ProcessorModel               `tfschema:"processor"`
+	Exporter                []ExporterModel                `tfschema:"exporter"`
+	Service                 []ServiceModel                 `tfschema:"service"`
+	NetworkingConfiguration []NetworkingConfigurationModel `tfschema:"networking_configuration"`
+	Tags                    map[string]string              `tfschema:"tags"`
+}
+
+type ReceiverModel struct {
+	Name   string                `tfschema:"name"`
+	Type   string                `tfschema:"type"`
+	Otlp   []OtlpReceiverModel   `tfschema:"otlp"`
+	Syslog []SyslogReceiverModel `tfschema:"syslog"`
+	Udp    []UdpReceiverModel    `tfschema:"udp"`
+}
+
+type OtlpReceiverModel struct {
+	Endpoint string `tfschema:"endpoint"`
+}
+
+type SyslogReceiverModel struct {
+	Endpoint string `tfschema:"endpoint"`
+	Protocol string `tfschema:"protocol"`
+}
+
+type UdpReceiverModel struct {
+	Endpoint        string `tfschema:"endpoint"`
+	Encoding        string `tfschema:"encoding"`
+	ReadQueueLength int    `tfschema:"read_queue_length"`
+}
+
+type ProcessorModel struct {
+	Name  string                `tfschema:"name"`
+	Type  string                `tfschema:"type"`
+	Batch []BatchProcessorModel `tfschema:"batch"`
+}
+
+type BatchProcessorModel struct {
+	BatchSize int `tfschema:"batch_size"`
+	Timeout   int `tfschema:"timeout"`
+}
+
+type ExporterModel struct {
+	Name                      string                                   `tfschema:"name"`
+	Type                      string                                   `tfschema:"type"`
+	AzureMonitorWorkspaceLogs []AzureMonitorWorkspaceLogsExporterModel `tfschema:"azure_monitor_workspace_logs"`
+}
+
+type AzureMonitorWorkspaceLogsExporterModel struct {
+	Api         []ApiConfigModel   `tfschema:"api"`
+	Cache       []CacheModel       `tfschema:"cache"`
+	Concurrency []ConcurrencyModel `tfschema:"concurrency"`
+}
+
+type ApiConfigModel struct {
+	DataCollectionEndpointUrl string           `tfschema:"data_collection_endpoint_url"`
+	DataCollectionRule        string           `tfschema:"data_collection_rule"`
+	Stream                    string           `tfschema:"stream"`
+	Schema                    []SchemaMapModel `tfschema:"schema"`
+}
+
+type SchemaMapModel struct {
+	RecordMap   []FieldMapModel `tfschema:"record_map"`
+	ResourceMap []FieldMapModel `tfschema:"resource_map"`
+	ScopeMap    []FieldMapModel `tfschema:"scope_map"`
+}
+
+type FieldMapModel struct {
+	From string `tfschema:"from"`
+	To   string `tfschema:"to"`
+}
+
+type CacheModel struct {
+	MaxStorageUsage int `tfschema:"max_storage_usage"`
+	RetentionPeriod int `tfschema:"retention_period"`
+}
+
+type ConcurrencyModel struct {
+	BatchQueueSize int `tfschema:"batch_queue_size"`
+	WorkerCount    int `tfschema:"worker_count"`
+}
+
+type ServiceModel struct {
+	Pipeline             []PipelineModel `tfschema:"pipeline"`
+	PersistentVolumeName string          `tfschema:"persistent_volume_name"`
+}
+
+type PipelineModel struct {
+	Name       string   `tfschema:"name"`
+	Type       string   `tfschema:"type"`
+	Receivers  []string `tfschema:"receivers"`
+	Processors []string `tfschema:"processors"`
+	Exporters  []string `tfschema:"exporters"`
+}
+
+type NetworkingConfigurationModel struct {
+	ExternalNetworkingMode string                 `tfschema:"external_networking_mode"`
+	Host                   string                 `tfschema:"host"`
+	Route                  []NetworkingRouteModel `tfschema:"route"`
+}
+
+type NetworkingRouteModel struct {
+	Receiver  string `tfschema:"receiver"`
+	Port      int    `tfschema:"port"`
+	Path      string `tfschema:"path"`
+	Subdomain string `tfschema:"subdomain"`
+}
+
+func (r PipelineGroupResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": location.Schema(),
+
+		"custom_location_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: azure.ValidateResourceID,
+		},
+
+		"receiver": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"type": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(pipelinegroups.PossibleValuesForReceiverType(), false),
+					},
+
+					"otlp": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"endpoint": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+							},
+						},
+					},
+
+					"syslog": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"endpoint": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"protocol": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									Default:      string(pipelinegroups.SyslogProtocolRfc5424),
+									ValidateFunc: validation.StringInSlice(pipelinegroups.PossibleValuesForSyslogProtocol(), false),
+								},
+							},
+						},
+					},
+
+					"udp": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"endpoint": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"encoding": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									Default:      string(pipelinegroups.StreamEncodingTypeNop),
+									ValidateFunc: validation.StringInSlice(pipelinegroups.PossibleValuesForStreamEncodingType(), false),
+								},
+
+								"read_queue_length": {
+									Type:         pluginsdk.TypeInt,
+									Optional:     true,
+									Default:      1000,
+									ValidateFunc: validation.IntBetween(100, 100000),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+
+		"exporter": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"type": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(pipelinegroups.PossibleValuesForExporterType(), false),
+					},
+
+					"azure_monitor_workspace_logs": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"api": {
+									Type:     pluginsdk.TypeList,
+									Required: true,
+									MaxItems: 1,
+									Elem: &pluginsdk.Resource{
+										Schema: map[string]*pluginsdk.Schema{
+											"data_collection_endpoint_url": {
+												Type:         pluginsdk.TypeString,
+												Required:     true,
+												ValidateFunc: validation.IsURLWithHTTPS,
+											},
+
+											"data_collection_rule": {
+												Type:         pluginsdk.TypeString,
+												Required:     true,
+												ValidateFunc: validation.StringIsNotEmpty,
+											},
+
+											"stream": {
+												Type:         pluginsdk.TypeString,
+												Required:     true,
+												ValidateFunc: validation.StringIsNotEmpty,
+											},
+
+											"schema": {
+												Type:     pluginsdk.TypeList,
+												Required: true,
+												MaxItems: 1,
+												Elem: &pluginsdk.Resource{
+													Schema: map[string]*pluginsdk.Schema{
+														"record_map": fieldMapSchema(true),
+
+														"resource_map": fieldMapSchema(false),
+
+														"scope_map": fieldMapSchema(false),
+													},
+												},
+											},
+										},
+									},
+								},
+
+								"cache": {
+									Type:     pluginsdk.TypeList,
+									Optional: true,
+									MaxItems: 1,
+									Elem: &pluginsdk.Resource{
+										Schema: map[string]*pluginsdk.Schema{
+											"max_storage_usage": {
+												Type:         pluginsdk.TypeInt,
+												Optional:     true,
+												ValidateFunc: validation.IntAtLeast(100),
+											},
+
+											"retention_period": {
+												Type:         pluginsdk.TypeInt,
+												Optional:     true,
+												ValidateFunc: validation.IntBetween(1, 14400),
+											},
+										},
+									},
+								},
+
+								"concurrency": {
+									Type:     pluginsdk.TypeList,
+									Optional: true,
+									MaxItems: 1,
+									Elem: &pluginsdk.Resource{
+										Schema: map[string]*pluginsdk.Schema{
+											"batch_queue_size": {
+												Type:         pluginsdk.TypeInt,
+												Optional:     true,
+												ValidateFunc: validation.IntBetween(1, 1024),
+											},
+
+											"worker_count": {
+												Type:         pluginsdk.TypeInt,
+												Optional:     true,
+												ValidateFunc: validation.IntBetween(1, 64),
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+
+		"service": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"pipeline": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						MinItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"name": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"type": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									Default:      string(pipelinegroups.PipelineTypeLogs),
+									ValidateFunc: validation.StringInSlice(pipelinegroups.PossibleValuesForPipelineType(), false),
+								},
+
+								"receivers": {
+									Type:     pluginsdk.TypeList,
+									Required: true,
+									MinItems: 1,
+									Elem: &pluginsdk.Schema{
+										Type:         pluginsdk.TypeString,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+								},
+
+								"processors": {
+									Type:     pluginsdk.TypeList,
+									Optional: true,
+									Elem: &pluginsdk.Schema{
+										Type:         pluginsdk.TypeString,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+								},
+
+								"exporters": {
+									Type:     pluginsdk.TypeList,
+									Required: true,
+									MinItems: 1,
+									Elem: &pluginsdk.Schema{
+										Type:         pluginsdk.TypeString,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+								},
+							},
+						},
+					},
+
+					"persistent_volume_name": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"processor": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"type": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(pipelinegroups.PossibleValuesForProcessorType(), false),
+					},
+
+					"batch": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"batch_size": {
+									Type:         pluginsdk.TypeInt,
+									Optional:     true,
+									Default:      8192,
+									ValidateFunc: validation.IntBetween(10, 100000),
+								},
+
+								"timeout": {
+									Type:         pluginsdk.TypeInt,
+									Optional:     true,
+									Default:      200,
+									ValidateFunc: validation.IntBetween(10, 60000),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+
+		"networking_configuration": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"external_networking_mode": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(pipelinegroups.PossibleValuesForExternalNetworkingMode(), false),
+					},
+
+					"route": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						MinItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"receiver": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"port": {
+									Type:         pluginsdk.TypeInt,
+									Optional:     true,
+									ValidateFunc: validation.IsPortNumber,
+								},
+
+								"path": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"subdomain": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+							},
+						},
+					},
+
+					"host": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"replicas": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      1,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r PipelineGroupResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r PipelineGroupResource) ModelObject() interface{} {
+	return &PipelineGroupResourceModel{}
+}
+
+func (r PipelineGroupResource) ResourceType() string {
+	return "azurerm_monitor_pipeline_group"
+}
+
+func (r PipelineGroupResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return pipelinegroups.ValidatePipelineGroupID
+}
+
+func (r PipelineGroupResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MonitorPipeline.PipelineGroupsClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model PipelineGroupResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := pipelinegroups.NewPipelineGroupID(subscriptionId, model.ResourceGroupName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := pipelinegroups.PipelineGroup{
+				Location: location.Normalize(model.Location),
+				ExtendedLocation: &pipelinegroups.ExtendedLocation{
+					Name: model.CustomLocationId,
+					Type: pipelinegroups.ExtendedLocationTypeCustomLocation,
+				},
+				Properties: &pipelinegroups.PipelineGroupProperties{
+					Exporters:                expandPipelineGroupExporters(model.Exporter),
+					NetworkingConfigurations: expandPipelineGroupNetworkingConfigurations(model.NetworkingConfiguration),
+					Processors:               expandPipelineGroupProcessors(model.Processor),
+					Receivers:                expandPipelineGroupReceivers(model.Receiver),
+					Replicas:                 utils.Int64(int64(model.Replicas)),
+					Service:                  expandPipelineGroupService(model.Service),
+				},
+				Tags: &model.Tags,
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r PipelineGroupResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MonitorPipeline.PipelineGroupsClient
+
+			id, err := pipelinegroups.ParsePipelineGroupID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := PipelineGroupResourceModel{
+				Name:              id.PipelineGroupName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+
+				if model.ExtendedLocation != nil {
+					state.CustomLocationId = model.ExtendedLocation.Name
+				}
+
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+
+				if props := model.Properties; props != nil {
+					state.Exporter = flattenPipelineGroupExporters(props.Exporters)
+					state.NetworkingConfiguration = flattenPipelineGroupNetworkingConfigurations(props.NetworkingConfigurations)
+					state.Processor = flattenPipelineGroupProcessors(props.Processors)
+					state.Receiver = flattenPipelineGroupReceivers(props.Receivers)
+					state.Service = flattenPipelineGroupService(props.Service)
+
+					if props.Replicas != nil {
+						state.Replicas = int(*props.Replicas)
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r PipelineGroupResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MonitorPipeline.PipelineGroupsClient
+
+			id, err := pipelinegroups.ParsePipelineGroupID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model PipelineGroupResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			payload := pipelinegroups.PipelineGroupUpdate{
+				Properties: &pipelinegroups.PipelineGroupUpdateProperties{},
+			}
+
+			if metadata.ResourceData.HasChange("exporter") {
+				exporters := expandPipelineGroupExporters(model.Exporter)
+				payload.Properties.Exporters = &exporters
+			}
+
+			if metadata.ResourceData.HasChange("networking_configuration") {
+				payload.Properties.NetworkingConfigurations = expandPipelineGroupNetworkingConfigurations(model.NetworkingConfiguration)
+			}
+
+			if metadata.ResourceData.HasChange("processor") {
+				processors := expandPipelineGroupProcessors(model.Processor)
+				payload.Properties.Processors = &processors
+			}
+
+			if metadata.ResourceData.HasChange("receiver") {
+				receivers := expandPipelineGroupReceivers(model.Receiver)
+				payload.Properties.Receivers = &receivers
+			}
+
+			if metadata.ResourceData.HasChange("replicas") {
+				payload.Properties.Replicas = utils.Int64(int64(model.Replicas))
+			}
+
+			if metadata.ResourceData.HasChange("service") {
+				service := expandPipelineGroupService(model.Service)
+				payload.Properties.Service = &service
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = &model.Tags
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r PipelineGroupResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MonitorPipeline.PipelineGroupsClient
+
+			id, err := pipelinegroups.ParsePipelineGroupID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func fieldMapSchema(required bool) *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Required: required,
+		Optional: !required,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"from": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"to": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+		},
+	}
+}
+
+func expandPipelineGroupReceivers(input []ReceiverModel) []pipelinegroups.Receiver {
+	receivers := make([]pipelinegroups.Receiver, 0)
+	for _, v := range input {
+		receiver := pipelinegroups.Receiver{
+			Name: v.Name,
+			Type: pipelinegroups.ReceiverType(v.Type),
+		}
+
+		if len(v.Otlp) > 0 {
+			receiver.Otlp = &pipelinegroups.OtlpReceiver{
+				Endpoint: v.Otlp[0].Endpoint,
+			}
+		}
+
+		if len(v.Syslog) > 0 {
+			protocol := pipelinegroups.SyslogProtocol(v.Syslog[0].Protocol)
+			receiver.Syslog = &pipelinegroups.SyslogReceiver{
+				Endpoint: v.Syslog[0].Endpoint,
+				Protocol: &protocol,
+			}
+		}
+
+		if len(v.Udp) > 0 {
+			encoding := pipelinegroups.StreamEncodingType(v.Udp[0].Encoding)
+			receiver.Udp = &pipelinegroups.UdpReceiver{
+				Encoding:        &encoding,
+				Endpoint:        v.Udp[0].Endpoint,
+				ReadQueueLength: utils.Int64(int64(v.Udp[0].ReadQueueLength)),
+			}
+		}
+
+		receivers = append(receivers, receiver)
+	}
+
+	return receivers
+}
+
+func flattenPipelineGroupReceivers(input []pipelinegroups.Receiver) []ReceiverModel {
+	output := make([]ReceiverModel, 0)
+	for _, v := range input {
+		receiver := ReceiverModel{
+			Name: v.Name,
+			Type: string(v.Type),
+		}
+
+		if otlp := v.Otlp; otlp != nil {
+			receiver.Otlp = []OtlpReceiverModel{
+				{
+					Endpoint: otlp.Endpoint,
+				},
+			}
+		}
+
+		if syslog := v.Syslog; syslog != nil {
+			protocol := ""
+			if syslog.Protocol != nil {
+				protocol = string(*syslog.Protocol)
+			}
+			receiver.Syslog = []SyslogReceiverModel{
+				{
+					Endpoint: syslog.Endpoint,
+					Protocol: protocol,
+				},
+			}
+		}
+
+		if udp := v.Udp; udp != nil {
+			encoding := ""
+			if udp.Encoding != nil {
+				encoding = string(*udp.Encoding)
+			}
+			readQueueLength := 0
+			if udp.ReadQueueLength != nil {
+				readQueueLength = int(*udp.ReadQueueLength)
+			}
+			receiver.Udp = []UdpReceiverModel{
+				{
+					Endpoint:        udp.Endpoint,
+					Encoding:        encoding,
+					ReadQueueLength: readQueueLength,
+				},
+			}
+		}
+
+		output = append(output, receiver)
+	}
+
+	return output
+}
+
+func expandPipelineGroupProcessors(input []ProcessorModel) []pipelinegroups.Processor {
+	processors := make([]pipelinegroups.Processor, 0)
+	for _, v := range input {
+		processor := pipelinegroups.Processor{
+			Name: v.Name,
+			Type: pipelinegroups.ProcessorType(v.Type),
+		}
+
+		if len(v.Batch) > 0 {
+			processor.Batch = &pipelinegroups.BatchProcessor{
+				BatchSize: utils.Int64(int64(v.Batch[0].BatchSize)),
+				Timeout:   utils.Int64(int64(v.Batch[0].Timeout)),
+			}
+		}
+
+		processors = append(processors, processor)
+	}
+
+	return processors
+}
+
+func flattenPipelineGroupProcessors(input []pipelinegroups.Processor) []ProcessorModel {
+	output := make([]ProcessorModel, 0)
+	for _, v := range input {
+		processor := ProcessorModel{
+			Name: v.Name,
+			Type: string(v.Type),
+		}
+
+		if batch := v.Batch; batch != nil {
+			batchSize := 0
+			if batch.BatchSize != nil {
+				batchSize = int(*batch.BatchSize)
+			}
+			timeout := 0
+			if batch.Timeout != nil {
+				timeout = int(*batch.Timeout)
+			}
+			processor.Batch = []BatchProcessorModel{
+				{
+					BatchSize: batchSize,
+					Timeout:   timeout,
+				},
+			}
+		}
+
+		output = append(output, processor)
+	}
+
+	return output
+}
+
+func expandPipelineGroupExporters(input []ExporterModel) []pipelinegroups.Exporter {
+	exporters := make([]pipelinegroups.Exporter, 0)
+	for _, v := range input {
+		exporter := pipelinegroups.Exporter{
+			Name: v.Name,
+			Type: pipelinegroups.ExporterType(v.Type),
+		}
+
+		if len(v.AzureMonitorWorkspaceLogs) > 0 {
+			logs := v.AzureMonitorWorkspaceLogs[0]
+			config := pipelinegroups.AzureMonitorWorkspaceLogsExporter{}
+
+			if len(logs.Api) > 0 {
+				api := logs.Api[0]
+				config.Api = pipelinegroups.AzureMonitorWorkspaceLogsApiConfig{
+					DataCollectionEndpointUrl: api.DataCollectionEndpointUrl,
+					DataCollectionRule:        api.DataCollectionRule,
+					Stream:                    api.Stream,
+				}
+
+				if len(api.Schema) > 0 {
+					schema := api.Schema[0]
+					recordMap := make([]pipelinegroups.RecordMap, 0)
+					for _, m := range schema.RecordMap {
+						recordMap = append(recordMap, pipelinegroups.RecordMap{
+							From: m.From,
+							To:   m.To,
+						})
+					}
+					resourceMap := make([]pipelinegroups.ResourceMap, 0)
+					for _, m := range schema.ResourceMap {
+						resourceMap = append(resourceMap, pipelinegroups.ResourceMap{
+							From: m.From,
+							To:   m.To,
+						})
+					}
+					scopeMap := make([]pipelinegroups.ScopeMap, 0)
+					for _, m := range schema.ScopeMap {
+						scopeMap = append(scopeMap, pipelinegroups.ScopeMap{
+							From: m.From,
+							To:   m.To,
+						})
+					}
+					config.Api.Schema = pipelinegroups.SchemaMap{
+						RecordMap:   recordMap,
+						ResourceMap: &resourceMap,
+						ScopeMap:    &scopeMap,
+					}
+				}
+			}
+
+			if len(logs.Cache) > 0 {
+				config.Cache = &pipelinegroups.CacheConfiguration{}
+				if v := logs.Cache[0].MaxStorageUsage; v != 0 {
+					config.Cache.MaxStorageUsage = utils.Int64(int64(v))
+				}
+				if v := logs.Cache[0].RetentionPeriod; v != 0 {
+					config.Cache.RetentionPeriod = utils.Int64(int64(v))
+				}
+			}
+
+			if len(logs.Concurrency) > 0 {
+				config.Concurrency = &pipelinegroups.ConcurrencyConfiguration{}
+				if v := logs.Concurrency[0].BatchQueueSize; v != 0 {
+					config.Concurrency.BatchQueueSize = utils.Int64(int64(v))
+				}
+				if v := logs.Concurrency[0].WorkerCount; v != 0 {
+					config.Concurrency.WorkerCount = utils.Int64(int64(v))
+				}
+			}
+
+			exporter.AzureMonitorWorkspaceLogs = &config
+		}
+
+		exporters = append(exporters, exporter)
+	}
+
+	return exporters
+}
+
+func flattenPipelineGroupExporters(input []pipelinegroups.Exporter) []ExporterModel {
+	output := make([]ExporterModel, 0)
+	for _, v := range input {
+		exporter := ExporterModel{
+			Name: v.Name,
+			Type: string(v.Type),
+		}
+
+		if logs := v.AzureMonitorWorkspaceLogs; logs != nil {
+			recordMap := make([]FieldMapModel, 0)
+			for _, m := range logs.Api.Schema.RecordMap {
+				recordMap = append(recordMap, FieldMapModel{
+					From: m.From,
+					To:   m.To,
+				})
+			}
+			resourceMap := make([]FieldMapModel, 0)
+			if logs.Api.Schema.ResourceMap != nil {
+				for _, m := range *logs.Api.Schema.ResourceMap {
+					resourceMap = append(resourceMap, FieldMapModel{
+						From: m.From,
+						To:   m.To,
+					})
+				}
+			}
+			scopeMap := make([]FieldMapModel, 0)
+			if logs.Api.Schema.ScopeMap != nil {
+				for _, m := range *logs.Api.Schema.ScopeMap {
+					scopeMap = append(scopeMap, FieldMapModel{
+						From: m.From,
+						To:   m.To,
+					})
+				}
+			}
+
+			config := AzureMonitorWorkspaceLogsExporterModel{
+				Api: []ApiConfigModel{
+					{
+						DataCollectionEndpointUrl: logs.Api.DataCollectionEndpointUrl,
+						DataCollectionRule:        logs.Api.DataCollectionRule,
+						Stream:                    logs.Api.Stream,
+						Schema: []SchemaMapModel{
+							{
+								RecordMap:   recordMap,
+								ResourceMap: resourceMap,
+								ScopeMap:    scopeMap,
+							},
+						},
+					},
+				},
+			}
+
+			if cache := logs.Cache; cache != nil {
+				c := CacheModel{}
+				if cache.MaxStorageUsage != nil {
+					c.MaxStorageUsage = int(*cache.MaxStorageUsage)
+				}
+				if cache.RetentionPeriod != nil {
+					c.RetentionPeriod = int(*cache.RetentionPeriod)
+				}
+				config.Cache = []CacheModel{c}
+			}
+
+			if concurrency := logs.Concurrency; concurrency != nil {
+				c := ConcurrencyModel{}
+				if concurrency.BatchQueueSize != nil {
+					c.BatchQueueSize = int(*concurrency.BatchQueueSize)
+				}
+				if concurrency.WorkerCount != nil {
+					c.WorkerCount = int(*concurrency.WorkerCount)
+				}
+				config.Concurrency = []ConcurrencyModel{c}
+			}
+
+			exporter.AzureMonitorWorkspaceLogs = []AzureMonitorWorkspaceLogsExporterModel{config}
+		}
+
+		output = append(output, exporter)
+	}
+
+	return output
+}
+
+func expandPipelineGroupService(input []ServiceModel) pipelinegroups.Service {
+	service := pipelinegroups.Service{
+		Pipelines: make([]pipelinegroups.Pipeline, 0),
+	}
+	if len(input) == 0 {
+		return service
+	}
+
+	for _, v := range input[0].Pipeline {
+		processors := v.Processors
+		service.Pipelines = append(service.Pipelines, pipelinegroups.Pipeline{
+			Exporters:  v.Exporters,
+			Name:       v.Name,
+			Processors: &processors,
+			Receivers:  v.Receivers,
+			Type:       pipelinegroups.PipelineType(v.Type),
+		})
+	}
+
+	if input[0].PersistentVolumeName != "" {
+		service.Persistence = &pipelinegroups.PersistenceConfigurations{
+			PersistentVolumeName: input[0].PersistentVolumeName,
+		}
+	}
+
+	return service
+}
+
+func flattenPipelineGroupService(input pipelinegroups.Service) []ServiceModel {
+	pipelines := make([]PipelineModel, 0)
+	for _, v := range input.Pipelines {
+		processors := make([]string, 0)
+		if v.Processors != nil {
+			processors = *v.Processors
+		}
+		pipelines = append(pipelines, PipelineModel{
+			Name:       v.Name,
+			Type:       string(v.Type),
+			Receivers:  v.Receivers,
+			Processors: processors,
+			Exporters:  v.Exporters,
+		})
+	}
+
+	persistentVolumeName := ""
+	if input.Persistence != nil {
+		persistentVolumeName = input.Persistence.PersistentVolumeName
+	}
+
+	return []ServiceModel{
+		{
+			Pipeline:             pipelines,
+			PersistentVolumeName: persistentVolumeName,
+		},
+	}
+}
+
+func expandPipelineGroupNetworkingConfigurations(input []NetworkingConfigurationModel) *[]pipelinegroups.NetworkingConfiguration {
+	configurations := make([]pipelinegroups.NetworkingConfiguration, 0)
+	for _, v := range input {
+		routes := make([]pipelinegroups.NetworkingRoute, 0)
+		for _, route := range v.Route {
+			r := pipelinegroups.NetworkingRoute{
+				Receiver: route.Receiver,
+			}
+			if route.Port != 0 {
+				r.Port = utils.Int64(int64(route.Port))
+			}
+			if route.Path != "" {
+				r.Path = utils.String(route.Path)
+			}
+			if route.Subdomain != "" {
+				r.Subdomain = utils.String(route.Subdomain)
+			}
+			routes = append(routes, r)
+		}
+
+		configuration := pipelinegroups.NetworkingConfiguration{
+			ExternalNetworkingMode: pipelinegroups.ExternalNetworkingMode(v.ExternalNetworkingMode),
+			Routes:                 routes,
+		}
+		if v.Host != "" {
+			configuration.Host = utils.String(v.Host)
+		}
+
+		configurations = append(configurations, configuration)
+	}
+
+	return &configurations
+}
+
+func flattenPipelineGroupNetworkingConfigurations(input *[]pipelinegroups.NetworkingConfiguration) []NetworkingConfigurationModel {
+	output := make([]NetworkingConfigurationModel, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		routes := make([]NetworkingRouteModel, 0)
+		for _, route := range v.Routes {
+			routes = append(routes, NetworkingRouteModel{
+				Receiver:  route.Receiver,
+				Port:      int(utils.NormaliseNilableInt64(route.Port)),
+				Path:      utils.NormalizeNilableString(route.Path),
+				Subdomain: utils.NormalizeNilableString(route.Subdomain),
+			})
+		}
+
+		output = append(output, NetworkingConfigurationModel{
+			ExternalNetworkingMode: string(v.ExternalNetworkingMode),
+			Host:                   utils.NormalizeNilableString(v.Host),
+			Route:                  routes,
+		})
+	}
+
+	return output
+}
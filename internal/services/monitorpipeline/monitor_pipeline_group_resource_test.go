@@ -0,0 +1,344 @@
+package monitorpipeline_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitorpipeline/sdk/2023-10-01-preview/pipelinegroups"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type MonitorPipelineGroupResource struct{}
+
+func TestAccMonitorPipelineGroup_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_pipeline_group", "test")
+	r := MonitorPipelineGroupResource{}
+	r.preCheck(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMonitorPipelineGroup_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_pipeline_group", "test")
+	r := MonitorPipelineGroupResource{}
+	r.preCheck(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccMonitorPipelineGroup_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_pipeline_group", "test")
+	r := MonitorPipelineGroupResource{}
+	r.preCheck(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMonitorPipelineGroup_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_pipeline_group", "test")
+	r := MonitorPipelineGroupResource{}
+	r.preCheck(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r MonitorPipelineGroupResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := pipelinegroups.ParsePipelineGroupID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.MonitorPipeline.PipelineGroupsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+// a Pipeline Group runs on an Arc-enabled Kubernetes cluster, so the tests need an existing Custom Location
+func (MonitorPipelineGroupResource) preCheck(t *testing.T) {
+	if os.Getenv("ARM_TEST_CUSTOM_LOCATION_ID") == "" {
+		t.Skip("Skipping as `ARM_TEST_CUSTOM_LOCATION_ID` is not specified")
+	}
+}
+
+func (r MonitorPipelineGroupResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_pipeline_group" "test" {
+  name                = "acctest-mpg-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  custom_location_id  = "%s"
+
+  receiver {
+    name = "receiver-syslog"
+    type = "Syslog"
+
+    syslog {
+      endpoint = "0.0.0.0:514"
+    }
+  }
+
+  exporter {
+    name = "exporter-amw"
+    type = "AzureMonitorWorkspaceLogs"
+
+    azure_monitor_workspace_logs {
+      api {
+        data_collection_endpoint_url = "https://example.westeurope-1.ingest.monitor.azure.com"
+        data_collection_rule         = "dcr-00000000000000000000000000000000"
+        stream                       = "Custom-Syslog"
+
+        schema {
+          record_map {
+            from = "body"
+            to   = "Body"
+          }
+        }
+      }
+    }
+  }
+
+  service {
+    pipeline {
+      name      = "pipeline-logs"
+      receivers = ["receiver-syslog"]
+      exporters = ["exporter-amw"]
+    }
+  }
+}
+`, r.template(data), data.RandomInteger, os.Getenv("ARM_TEST_CUSTOM_LOCATION_ID"))
+}
+
+func (r MonitorPipelineGroupResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_pipeline_group" "import" {
+  name                = azurerm_monitor_pipeline_group.test.name
+  resource_group_name = azurerm_monitor_pipeline_group.test.resource_group_name
+  location            = azurerm_monitor_pipeline_group.test.location
+  custom_location_id  = azurerm_monitor_pipeline_group.test.custom_location_id
+
+  receiver {
+    name = "receiver-syslog"
+    type = "Syslog"
+
+    syslog {
+      endpoint = "0.0.0.0:514"
+    }
+  }
+
+  exporter {
+    name = "exporter-amw"
+    type = "AzureMonitorWorkspaceLogs"
+
+    azure_monitor_workspace_logs {
+      api {
+        data_collection_endpoint_url = "https://example.westeurope-1.ingest.monitor.azure.com"
+        data_collection_rule         = "dcr-00000000000000000000000000000000"
+        stream                       = "Custom-Syslog"
+
+        schema {
+          record_map {
+            from = "body"
+            to   = "Body"
+          }
+        }
+      }
+    }
+  }
+
+  service {
+    pipeline {
+      name      = "pipeline-logs"
+      receivers = ["receiver-syslog"]
+      exporters = ["exporter-amw"]
+    }
+  }
+}
+`, r.basic(data))
+}
+
+func (r MonitorPipelineGroupResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_pipeline_group" "test" {
+  name                = "acctest-mpg-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  custom_location_id  = "%s"
+  replicas            = 2
+
+  receiver {
+    name = "receiver-syslog"
+    type = "Syslog"
+
+    syslog {
+      endpoint = "0.0.0.0:514"
+      protocol = "rfc3164"
+    }
+  }
+
+  receiver {
+    name = "receiver-udp"
+    type = "UDP"
+
+    udp {
+      endpoint          = "0.0.0.0:8514"
+      encoding          = "utf-8"
+      read_queue_length = 2000
+    }
+  }
+
+  processor {
+    name = "processor-batch"
+    type = "Batch"
+
+    batch {
+      batch_size = 1000
+      timeout    = 500
+    }
+  }
+
+  exporter {
+    name = "exporter-amw"
+    type = "AzureMonitorWorkspaceLogs"
+
+    azure_monitor_workspace_logs {
+      api {
+        data_collection_endpoint_url = "https://example.westeurope-1.ingest.monitor.azure.com"
+        data_collection_rule         = "dcr-00000000000000000000000000000000"
+        stream                       = "Custom-Syslog"
+
+        schema {
+          record_map {
+            from = "body"
+            to   = "Body"
+          }
+
+          record_map {
+            from = "severity_text"
+            to   = "SeverityText"
+          }
+
+          resource_map {
+            from = "resource.attributes.host"
+            to   = "Host"
+          }
+        }
+      }
+
+      cache {
+        max_storage_usage = 1000
+        retention_period  = 60
+      }
+
+      concurrency {
+        batch_queue_size = 100
+        worker_count     = 4
+      }
+    }
+  }
+
+  service {
+    pipeline {
+      name       = "pipeline-logs"
+      receivers  = ["receiver-syslog", "receiver-udp"]
+      processors = ["processor-batch"]
+      exporters  = ["exporter-amw"]
+    }
+  }
+
+  networking_configuration {
+    external_networking_mode = "LoadBalancerOnly"
+
+    route {
+      receiver = "receiver-syslog"
+    }
+
+    route {
+      receiver = "receiver-udp"
+      port     = 8514
+    }
+  }
+
+  tags = {
+    ENV = "Test"
+  }
+}
+`, r.template(data), data.RandomInteger, os.Getenv("ARM_TEST_CUSTOM_LOCATION_ID"))
+}
+
+func (MonitorPipelineGroupResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-mpg-%d"
+  location = "%s"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
@@ -0,0 +1,29 @@
+package monitorpipeline
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+var _ sdk.TypedServiceRegistration = Registration{}
+
+type Registration struct{}
+
+func (r Registration) Name() string {
+	return "Monitor Pipeline"
+}
+
+func (r Registration) WebsiteCategories() []string {
+	return []string{
+		"Monitor",
+	}
+}
+
+func (r Registration) DataSources() []sdk.DataSource {
+	return []sdk.DataSource{}
+}
+
+func (r Registration) Resources() []sdk.Resource {
+	return []sdk.Resource{
+		PipelineGroupResource{},
+	}
+}
@@ -0,0 +1,15 @@
+package pipelinegroups
+
+import "github.com/Azure/go-autorest/autorest"
+
+type PipelineGroupsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewPipelineGroupsClientWithBaseURI(endpoint string) PipelineGroupsClient {
+	return PipelineGroupsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,295 @@
+package pipelinegroups
+
+import "strings"
+
+type CreatedByType string
+
+const (
+	CreatedByTypeApplication     CreatedByType = "Application"
+	CreatedByTypeKey             CreatedByType = "Key"
+	CreatedByTypeManagedIdentity CreatedByType = "ManagedIdentity"
+	CreatedByTypeUser            CreatedByType = "User"
+)
+
+func PossibleValuesForCreatedByType() []string {
+	return []string{
+		string(CreatedByTypeApplication),
+		string(CreatedByTypeKey),
+		string(CreatedByTypeManagedIdentity),
+		string(CreatedByTypeUser),
+	}
+}
+
+func parseCreatedByType(input string) (*CreatedByType, error) {
+	vals := map[string]CreatedByType{
+		"application":     CreatedByTypeApplication,
+		"key":             CreatedByTypeKey,
+		"managedidentity": CreatedByTypeManagedIdentity,
+		"user":            CreatedByTypeUser,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := CreatedByType(input)
+	return &out, nil
+}
+
+type ExporterType string
+
+const (
+	ExporterTypeAzureMonitorWorkspaceLogs ExporterType = "AzureMonitorWorkspaceLogs"
+)
+
+func PossibleValuesForExporterType() []string {
+	return []string{
+		string(ExporterTypeAzureMonitorWorkspaceLogs),
+	}
+}
+
+func parseExporterType(input string) (*ExporterType, error) {
+	vals := map[string]ExporterType{
+		"azuremonitorworkspacelogs": ExporterTypeAzureMonitorWorkspaceLogs,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ExporterType(input)
+	return &out, nil
+}
+
+type ExtendedLocationType string
+
+const (
+	ExtendedLocationTypeCustomLocation ExtendedLocationType = "CustomLocation"
+)
+
+func PossibleValuesForExtendedLocationType() []string {
+	return []string{
+		string(ExtendedLocationTypeCustomLocation),
+	}
+}
+
+func parseExtendedLocationType(input string) (*ExtendedLocationType, error) {
+	vals := map[string]ExtendedLocationType{
+		"customlocation": ExtendedLocationTypeCustomLocation,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ExtendedLocationType(input)
+	return &out, nil
+}
+
+type ExternalNetworkingMode string
+
+const (
+	ExternalNetworkingModeLoadBalancerOnly ExternalNetworkingMode = "LoadBalancerOnly"
+)
+
+func PossibleValuesForExternalNetworkingMode() []string {
+	return []string{
+		string(ExternalNetworkingModeLoadBalancerOnly),
+	}
+}
+
+func parseExternalNetworkingMode(input string) (*ExternalNetworkingMode, error) {
+	vals := map[string]ExternalNetworkingMode{
+		"loadbalanceronly": ExternalNetworkingModeLoadBalancerOnly,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ExternalNetworkingMode(input)
+	return &out, nil
+}
+
+type PipelineType string
+
+const (
+	PipelineTypeLogs PipelineType = "Logs"
+)
+
+func PossibleValuesForPipelineType() []string {
+	return []string{
+		string(PipelineTypeLogs),
+	}
+}
+
+func parsePipelineType(input string) (*PipelineType, error) {
+	vals := map[string]PipelineType{
+		"logs": PipelineTypeLogs,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := PipelineType(input)
+	return &out, nil
+}
+
+type ProcessorType string
+
+const (
+	ProcessorTypeBatch ProcessorType = "Batch"
+)
+
+func PossibleValuesForProcessorType() []string {
+	return []string{
+		string(ProcessorTypeBatch),
+	}
+}
+
+func parseProcessorType(input string) (*ProcessorType, error) {
+	vals := map[string]ProcessorType{
+		"batch": ProcessorTypeBatch,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ProcessorType(input)
+	return &out, nil
+}
+
+type ProvisioningState string
+
+const (
+	ProvisioningStateCanceled  ProvisioningState = "Canceled"
+	ProvisioningStateCreating  ProvisioningState = "Creating"
+	ProvisioningStateDeleting  ProvisioningState = "Deleting"
+	ProvisioningStateFailed    ProvisioningState = "Failed"
+	ProvisioningStateSucceeded ProvisioningState = "Succeeded"
+	ProvisioningStateUpdating  ProvisioningState = "Updating"
+)
+
+func PossibleValuesForProvisioningState() []string {
+	return []string{
+		string(ProvisioningStateCanceled),
+		string(ProvisioningStateCreating),
+		string(ProvisioningStateDeleting),
+		string(ProvisioningStateFailed),
+		string(ProvisioningStateSucceeded),
+		string(ProvisioningStateUpdating),
+	}
+}
+
+func parseProvisioningState(input string) (*ProvisioningState, error) {
+	vals := map[string]ProvisioningState{
+		"canceled":  ProvisioningStateCanceled,
+		"creating":  ProvisioningStateCreating,
+		"deleting":  ProvisioningStateDeleting,
+		"failed":    ProvisioningStateFailed,
+		"succeeded": ProvisioningStateSucceeded,
+		"updating":  ProvisioningStateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ProvisioningState(input)
+	return &out, nil
+}
+
+type ReceiverType string
+
+const (
+	ReceiverTypeOTLP   ReceiverType = "OTLP"
+	ReceiverTypeSyslog ReceiverType = "Syslog"
+	ReceiverTypeUDP    ReceiverType = "UDP"
+)
+
+func PossibleValuesForReceiverType() []string {
+	return []string{
+		string(ReceiverTypeOTLP),
+		string(ReceiverTypeSyslog),
+		string(ReceiverTypeUDP),
+	}
+}
+
+func parseReceiverType(input string) (*ReceiverType, error) {
+	vals := map[string]ReceiverType{
+		"otlp":   ReceiverTypeOTLP,
+		"syslog": ReceiverTypeSyslog,
+		"udp":    ReceiverTypeUDP,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ReceiverType(input)
+	return &out, nil
+}
+
+type StreamEncodingType string
+
+const (
+	StreamEncodingTypeAscii       StreamEncodingType = "ascii"
+	StreamEncodingTypeNop         StreamEncodingType = "nop"
+	StreamEncodingTypeUtfEight    StreamEncodingType = "utf-8"
+	StreamEncodingTypeUtfEightBom StreamEncodingType = "utf-8bom"
+)
+
+func PossibleValuesForStreamEncodingType() []string {
+	return []string{
+		string(StreamEncodingTypeAscii),
+		string(StreamEncodingTypeNop),
+		string(StreamEncodingTypeUtfEight),
+		string(StreamEncodingTypeUtfEightBom),
+	}
+}
+
+func parseStreamEncodingType(input string) (*StreamEncodingType, error) {
+	vals := map[string]StreamEncodingType{
+		"ascii":    StreamEncodingTypeAscii,
+		"nop":      StreamEncodingTypeNop,
+		"utf-8":    StreamEncodingTypeUtfEight,
+		"utf-8bom": StreamEncodingTypeUtfEightBom,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := StreamEncodingType(input)
+	return &out, nil
+}
+
+type SyslogProtocol string
+
+const (
+	SyslogProtocolRfc3164 SyslogProtocol = "rfc3164"
+	SyslogProtocolRfc5424 SyslogProtocol = "rfc5424"
+)
+
+func PossibleValuesForSyslogProtocol() []string {
+	return []string{
+		string(SyslogProtocolRfc3164),
+		string(SyslogProtocolRfc5424),
+	}
+}
+
+func parseSyslogProtocol(input string) (*SyslogProtocol, error) {
+	vals := map[string]SyslogProtocol{
+		"rfc3164": SyslogProtocolRfc3164,
+		"rfc5424": SyslogProtocolRfc5424,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := SyslogProtocol(input)
+	return &out, nil
+}
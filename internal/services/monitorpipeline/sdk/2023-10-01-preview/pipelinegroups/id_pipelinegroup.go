@@ -0,0 +1,124 @@
+package pipelinegroups
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = PipelineGroupId{}
+
+// PipelineGroupId is a struct representing the Resource ID for a Pipeline Group
+type PipelineGroupId struct {
+	SubscriptionId    string
+	ResourceGroupName string
+	PipelineGroupName string
+}
+
+// NewPipelineGroupID returns a new PipelineGroupId struct
+func NewPipelineGroupID(subscriptionId string, resourceGroupName string, pipelineGroupName string) PipelineGroupId {
+	return PipelineGroupId{
+		SubscriptionId:    subscriptionId,
+		ResourceGroupName: resourceGroupName,
+		PipelineGroupName: pipelineGroupName,
+	}
+}
+
+// ParsePipelineGroupID parses 'input' into a PipelineGroupId
+func ParsePipelineGroupID(input string) (*PipelineGroupId, error) {
+	parser := resourceids.NewParserFromResourceIdType(PipelineGroupId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := PipelineGroupId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.PipelineGroupName, ok = parsed.Parsed["pipelineGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'pipelineGroupName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParsePipelineGroupIDInsensitively parses 'input' case-insensitively into a PipelineGroupId
+// note: this method should only be used for API response data and not user input
+func ParsePipelineGroupIDInsensitively(input string) (*PipelineGroupId, error) {
+	parser := resourceids.NewParserFromResourceIdType(PipelineGroupId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := PipelineGroupId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.PipelineGroupName, ok = parsed.Parsed["pipelineGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'pipelineGroupName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidatePipelineGroupID checks that 'input' can be parsed as a Pipeline Group ID
+func ValidatePipelineGroupID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParsePipelineGroupID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Pipeline Group ID
+func (id PipelineGroupId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Monitor/pipelineGroups/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.PipelineGroupName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Pipeline Group ID
+func (id PipelineGroupId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftMonitor", "Microsoft.Monitor", "Microsoft.Monitor"),
+		resourceids.StaticSegment("staticPipelineGroups", "pipelineGroups", "pipelineGroups"),
+		resourceids.UserSpecifiedSegment("pipelineGroupName", "pipelineGroupValue"),
+	}
+}
+
+// String returns a human-readable description of this Pipeline Group ID
+func (id PipelineGroupId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Pipeline Group Name: %q", id.PipelineGroupName),
+	}
+	return fmt.Sprintf("Pipeline Group (%s)", strings.Join(components, "\n"))
+}
@@ -0,0 +1,8 @@
+package pipelinegroups
+
+type AzureMonitorWorkspaceLogsApiConfig struct {
+	DataCollectionEndpointUrl string    `json:"dataCollectionEndpointUrl"`
+	DataCollectionRule        string    `json:"dataCollectionRule"`
+	Schema                    SchemaMap `json:"schema"`
+	Stream                    string    `json:"stream"`
+}
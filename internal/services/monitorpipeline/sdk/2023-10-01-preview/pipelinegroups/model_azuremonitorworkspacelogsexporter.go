@@ -0,0 +1,7 @@
+package pipelinegroups
+
+type AzureMonitorWorkspaceLogsExporter struct {
+	Api         AzureMonitorWorkspaceLogsApiConfig `json:"api"`
+	Cache       *CacheConfiguration                `json:"cache,omitempty"`
+	Concurrency *ConcurrencyConfiguration          `json:"concurrency,omitempty"`
+}
@@ -0,0 +1,6 @@
+package pipelinegroups
+
+type BatchProcessor struct {
+	BatchSize *int64 `json:"batchSize,omitempty"`
+	Timeout   *int64 `json:"timeout,omitempty"`
+}
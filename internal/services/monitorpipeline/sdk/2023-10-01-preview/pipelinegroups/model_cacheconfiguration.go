@@ -0,0 +1,6 @@
+package pipelinegroups
+
+type CacheConfiguration struct {
+	MaxStorageUsage *int64 `json:"maxStorageUsage,omitempty"`
+	RetentionPeriod *int64 `json:"retentionPeriod,omitempty"`
+}
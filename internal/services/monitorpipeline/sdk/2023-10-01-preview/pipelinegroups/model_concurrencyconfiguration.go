@@ -0,0 +1,6 @@
+package pipelinegroups
+
+type ConcurrencyConfiguration struct {
+	BatchQueueSize *int64 `json:"batchQueueSize,omitempty"`
+	WorkerCount    *int64 `json:"workerCount,omitempty"`
+}
@@ -0,0 +1,7 @@
+package pipelinegroups
+
+type Exporter struct {
+	AzureMonitorWorkspaceLogs *AzureMonitorWorkspaceLogsExporter `json:"azureMonitorWorkspaceLogs,omitempty"`
+	Name                      string                             `json:"name"`
+	Type                      ExporterType                       `json:"type"`
+}
@@ -0,0 +1,6 @@
+package pipelinegroups
+
+type ExtendedLocation struct {
+	Name string               `json:"name"`
+	Type ExtendedLocationType `json:"type"`
+}
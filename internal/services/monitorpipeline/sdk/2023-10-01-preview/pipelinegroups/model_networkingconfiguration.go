@@ -0,0 +1,7 @@
+package pipelinegroups
+
+type NetworkingConfiguration struct {
+	ExternalNetworkingMode ExternalNetworkingMode `json:"externalNetworkingMode"`
+	Host                   *string                `json:"host,omitempty"`
+	Routes                 []NetworkingRoute      `json:"routes"`
+}
@@ -0,0 +1,8 @@
+package pipelinegroups
+
+type NetworkingRoute struct {
+	Path      *string `json:"path,omitempty"`
+	Port      *int64  `json:"port,omitempty"`
+	Receiver  string  `json:"receiver"`
+	Subdomain *string `json:"subdomain,omitempty"`
+}
@@ -0,0 +1,5 @@
+package pipelinegroups
+
+type OtlpReceiver struct {
+	Endpoint string `json:"endpoint"`
+}
@@ -0,0 +1,5 @@
+package pipelinegroups
+
+type PersistenceConfigurations struct {
+	PersistentVolumeName string `json:"persistentVolumeName"`
+}
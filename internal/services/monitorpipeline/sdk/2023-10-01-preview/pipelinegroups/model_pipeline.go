@@ -0,0 +1,9 @@
+package pipelinegroups
+
+type Pipeline struct {
+	Exporters  []string     `json:"exporters"`
+	Name       string       `json:"name"`
+	Processors *[]string    `json:"processors,omitempty"`
+	Receivers  []string     `json:"receivers"`
+	Type       PipelineType `json:"type"`
+}
@@ -0,0 +1,11 @@
+package pipelinegroups
+
+type PipelineGroupProperties struct {
+	Exporters                []Exporter                 `json:"exporters"`
+	NetworkingConfigurations *[]NetworkingConfiguration `json:"networkingConfigurations,omitempty"`
+	Processors               []Processor                `json:"processors"`
+	ProvisioningState        *ProvisioningState         `json:"provisioningState,omitempty"`
+	Receivers                []Receiver                 `json:"receivers"`
+	Replicas                 *int64                     `json:"replicas,omitempty"`
+	Service                  Service                    `json:"service"`
+}
@@ -0,0 +1,6 @@
+package pipelinegroups
+
+type PipelineGroupUpdate struct {
+	Properties *PipelineGroupUpdateProperties `json:"properties,omitempty"`
+	Tags       *map[string]string             `json:"tags,omitempty"`
+}
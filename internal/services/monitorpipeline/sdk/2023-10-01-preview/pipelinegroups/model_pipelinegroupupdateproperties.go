@@ -0,0 +1,10 @@
+package pipelinegroups
+
+type PipelineGroupUpdateProperties struct {
+	Exporters                *[]Exporter                `json:"exporters,omitempty"`
+	NetworkingConfigurations *[]NetworkingConfiguration `json:"networkingConfigurations,omitempty"`
+	Processors               *[]Processor               `json:"processors,omitempty"`
+	Receivers                *[]Receiver                `json:"receivers,omitempty"`
+	Replicas                 *int64                     `json:"replicas,omitempty"`
+	Service                  *Service                   `json:"service,omitempty"`
+}
@@ -0,0 +1,7 @@
+package pipelinegroups
+
+type Processor struct {
+	Batch *BatchProcessor `json:"batch,omitempty"`
+	Name  string          `json:"name"`
+	Type  ProcessorType   `json:"type"`
+}
@@ -0,0 +1,9 @@
+package pipelinegroups
+
+type Receiver struct {
+	Name   string          `json:"name"`
+	Otlp   *OtlpReceiver   `json:"otlp,omitempty"`
+	Syslog *SyslogReceiver `json:"syslog,omitempty"`
+	Type   ReceiverType    `json:"type"`
+	Udp    *UdpReceiver    `json:"udp,omitempty"`
+}
@@ -0,0 +1,6 @@
+package pipelinegroups
+
+type RecordMap struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
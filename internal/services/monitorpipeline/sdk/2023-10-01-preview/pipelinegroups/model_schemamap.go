@@ -0,0 +1,7 @@
+package pipelinegroups
+
+type SchemaMap struct {
+	RecordMap   []RecordMap    `json:"recordMap"`
+	ResourceMap *[]ResourceMap `json:"resourceMap,omitempty"`
+	ScopeMap    *[]ScopeMap    `json:"scopeMap,omitempty"`
+}
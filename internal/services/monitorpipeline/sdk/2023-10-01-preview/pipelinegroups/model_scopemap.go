@@ -0,0 +1,6 @@
+package pipelinegroups
+
+type ScopeMap struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
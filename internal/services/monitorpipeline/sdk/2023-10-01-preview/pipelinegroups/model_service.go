@@ -0,0 +1,6 @@
+package pipelinegroups
+
+type Service struct {
+	Persistence *PersistenceConfigurations `json:"persistence,omitempty"`
+	Pipelines   []Pipeline                 `json:"pipelines"`
+}
@@ -0,0 +1,6 @@
+package pipelinegroups
+
+type SyslogReceiver struct {
+	Endpoint string          `json:"endpoint"`
+	Protocol *SyslogProtocol `json:"protocol,omitempty"`
+}
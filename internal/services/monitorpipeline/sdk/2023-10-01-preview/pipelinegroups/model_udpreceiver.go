@@ -0,0 +1,7 @@
+package pipelinegroups
+
+type UdpReceiver struct {
+	Encoding        *StreamEncodingType `json:"encoding,omitempty"`
+	Endpoint        string              `json:"endpoint"`
+	ReadQueueLength *int64              `json:"readQueueLength,omitempty"`
+}
@@ -0,0 +1,9 @@
+package pipelinegroups
+
+import "fmt"
+
+const defaultApiVersion = "2023-10-01-preview"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/pipelinegroups/%s", defaultApiVersion)
+}
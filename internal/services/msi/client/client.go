@@ -3,17 +3,23 @@ package client
 import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/msi/sdk/2018-11-30/managedidentity"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/msi/sdk/2025-01-31-preview/federatedidentitycredentials"
 )
 
 type Client struct {
-	UserAssignedIdentitiesClient *managedidentity.ManagedIdentityClient
+	FederatedIdentityCredentialsClient *federatedidentitycredentials.FederatedIdentityCredentialsClient
+	UserAssignedIdentitiesClient       *managedidentity.ManagedIdentityClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
+	federatedIdentityCredentialsClient := federatedidentitycredentials.NewFederatedIdentityCredentialsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&federatedIdentityCredentialsClient.Client, o.ResourceManagerAuthorizer)
+
 	UserAssignedIdentitiesClient := managedidentity.NewManagedIdentityClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&UserAssignedIdentitiesClient.Client, o.ResourceManagerAuthorizer)
 
 	return &Client{
-		UserAssignedIdentitiesClient: &UserAssignedIdentitiesClient,
+		FederatedIdentityCredentialsClient: &federatedIdentityCredentialsClient,
+		UserAssignedIdentitiesClient:       &UserAssignedIdentitiesClient,
 	}
 }
@@ -0,0 +1,281 @@
+package msi
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/msi/sdk/2025-01-31-preview/federatedidentitycredentials"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.ResourceWithUpdate = FederatedIdentityCredentialResource{}
+
+type FederatedIdentityCredentialResource struct{}
+
+type FederatedIdentityCredentialResourceModel struct {
+	Name                     string   `tfschema:"name"`
+	UserAssignedIdentityId   string   `tfschema:"user_assigned_identity_id"`
+	Audience                 []string `tfschema:"audience"`
+	Issuer                   string   `tfschema:"issuer"`
+	Subject                  string   `tfschema:"subject"`
+	ClaimsMatchingExpression string   `tfschema:"claims_matching_expression"`
+}
+
+func (r FederatedIdentityCredentialResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validateFederatedIdentityCredentialName,
+		},
+
+		"user_assigned_identity_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: federatedidentitycredentials.ValidateUserAssignedIdentityID,
+		},
+
+		"audience": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"issuer": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.IsURLWithHTTPS,
+		},
+
+		"subject": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validateFederatedIdentityCredentialSubject,
+			ExactlyOneOf: []string{"subject", "claims_matching_expression"},
+		},
+
+		"claims_matching_expression": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			ExactlyOneOf: []string{"subject", "claims_matching_expression"},
+		},
+	}
+}
+
+func (r FederatedIdentityCredentialResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r FederatedIdentityCredentialResource) ModelObject() interface{} {
+	return &FederatedIdentityCredentialResourceModel{}
+}
+
+func (r FederatedIdentityCredentialResource) ResourceType() string {
+	return "azurerm_federated_identity_credential"
+}
+
+func (r FederatedIdentityCredentialResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return federatedidentitycredentials.ValidateFederatedIdentityCredentialID
+}
+
+func (r FederatedIdentityCredentialResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSI.FederatedIdentityCredentialsClient
+
+			var model FederatedIdentityCredentialResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			identityId, err := federatedidentitycredentials.ParseUserAssignedIdentityID(model.UserAssignedIdentityId)
+			if err != nil {
+				return err
+			}
+
+			id := federatedidentitycredentials.NewFederatedIdentityCredentialID(identityId.SubscriptionId, identityId.ResourceGroupName, identityId.ResourceName, model.Name)
+
+			// the API doesn't support the Federated Identity Credentials for an Identity being written concurrently
+			locks.ByID(identityId.ID())
+			defer locks.UnlockByID(identityId.ID())
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := expandFederatedIdentityCredential(model.Issuer, model.Subject, model.ClaimsMatchingExpression, model.Audience)
+			if _, err := client.CreateOrUpdate(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r FederatedIdentityCredentialResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSI.FederatedIdentityCredentialsClient
+
+			id, err := federatedidentitycredentials.ParseFederatedIdentityCredentialID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := FederatedIdentityCredentialResourceModel{
+				Name:                   id.FederatedIdentityCredentialResourceName,
+				UserAssignedIdentityId: federatedidentitycredentials.NewUserAssignedIdentityID(id.SubscriptionId, id.ResourceGroupName, id.ResourceName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.Audience = props.Audiences
+					state.Issuer = props.Issuer
+					state.Subject = utils.NormalizeNilableString(props.Subject)
+					if props.ClaimsMatchingExpression != nil {
+						state.ClaimsMatchingExpression = props.ClaimsMatchingExpression.Value
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r FederatedIdentityCredentialResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSI.FederatedIdentityCredentialsClient
+
+			id, err := federatedidentitycredentials.ParseFederatedIdentityCredentialID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model FederatedIdentityCredentialResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			identityId := federatedidentitycredentials.NewUserAssignedIdentityID(id.SubscriptionId, id.ResourceGroupName, id.ResourceName)
+			locks.ByID(identityId.ID())
+			defer locks.UnlockByID(identityId.ID())
+
+			payload := expandFederatedIdentityCredential(model.Issuer, model.Subject, model.ClaimsMatchingExpression, model.Audience)
+			if _, err := client.CreateOrUpdate(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r FederatedIdentityCredentialResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSI.FederatedIdentityCredentialsClient
+
+			id, err := federatedidentitycredentials.ParseFederatedIdentityCredentialID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			identityId := federatedidentitycredentials.NewUserAssignedIdentityID(id.SubscriptionId, id.ResourceGroupName, id.ResourceName)
+			locks.ByID(identityId.ID())
+			defer locks.UnlockByID(identityId.ID())
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandFederatedIdentityCredential(issuer, subject, claimsMatchingExpression string, audience []string) federatedidentitycredentials.FederatedIdentityCredential {
+	props := federatedidentitycredentials.FederatedIdentityCredentialProperties{
+		Audiences: audience,
+		Issuer:    issuer,
+	}
+
+	if subject != "" {
+		props.Subject = utils.String(subject)
+	}
+
+	// wildcards in the subject are only supported through a claims matching expression, which
+	// is currently limited to the GitHub, GitLab and Terraform Cloud issuers
+	if claimsMatchingExpression != "" {
+		props.ClaimsMatchingExpression = &federatedidentitycredentials.ClaimsMatchingExpression{
+			LanguageVersion: 1,
+			Value:           claimsMatchingExpression,
+		}
+	}
+
+	return federatedidentitycredentials.FederatedIdentityCredential{
+		Properties: &props,
+	}
+}
+
+func validateFederatedIdentityCredentialName(i interface{}, k string) ([]string, []error) {
+	return validation.StringMatch(
+		regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_-]{2,119}$`),
+		fmt.Sprintf("%q must be between 3 and 120 characters, can only contain alphanumeric characters, underscores and hyphens, and must start with an alphanumeric character", k),
+	)(i, k)
+}
+
+func validateFederatedIdentityCredentialSubject(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %q to be string", k)}
+	}
+
+	if strings.TrimSpace(v) == "" {
+		return nil, []error{fmt.Errorf("%q must not be empty", k)}
+	}
+
+	if len(v) > 600 {
+		return nil, []error{fmt.Errorf("%q must be at most 600 characters, got %d", k, len(v))}
+	}
+
+	if strings.Contains(v, "*") {
+		return nil, []error{fmt.Errorf("%q must be an exact match and cannot contain wildcards - a `claims_matching_expression` can be used instead for the issuers which support it", k)}
+	}
+
+	return nil, nil
+}
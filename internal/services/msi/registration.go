@@ -1,6 +1,7 @@
 package msi
 
 import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
@@ -8,6 +9,11 @@ import (
 
 type Registration struct{}
 
+var (
+	_ sdk.TypedServiceRegistration   = Registration{}
+	_ sdk.UntypedServiceRegistration = Registration{}
+)
+
 // Name is the name of this Service
 func (r Registration) Name() string {
 	return "Managed Service Identities"
@@ -33,3 +39,15 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_user_assigned_identity": resourceArmUserAssignedIdentity(),
 	}
 }
+
+// DataSources returns a list of Data Sources supported by this Service
+func (r Registration) DataSources() []sdk.DataSource {
+	return []sdk.DataSource{}
+}
+
+// Resources returns a list of Resources supported by this Service
+func (r Registration) Resources() []sdk.Resource {
+	return []sdk.Resource{
+		FederatedIdentityCredentialResource{},
+	}
+}
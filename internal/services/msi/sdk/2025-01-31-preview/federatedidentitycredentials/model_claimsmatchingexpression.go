@@ -0,0 +1,6 @@
+package federatedidentitycredentials
+
+type ClaimsMatchingExpression struct {
+	LanguageVersion int64  `json:"languageVersion"`
+	Value           string `json:"value"`
+}
@@ -0,0 +1,8 @@
+package federatedidentitycredentials
+
+type FederatedIdentityCredentialProperties struct {
+	Audiences                []string                  `json:"audiences"`
+	ClaimsMatchingExpression *ClaimsMatchingExpression `json:"claimsMatchingExpression,omitempty"`
+	Issuer                   string                    `json:"issuer"`
+	Subject                  *string                   `json:"subject,omitempty"`
+}
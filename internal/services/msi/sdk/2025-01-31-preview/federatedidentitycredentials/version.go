@@ -0,0 +1,9 @@
+package federatedidentitycredentials
+
+import "fmt"
+
+const defaultApiVersion = "2025-01-31-preview"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/federatedidentitycredentials/%s", defaultApiVersion)
+}
@@ -1,8 +1,10 @@
 package msi
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"sort"
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
@@ -10,8 +12,10 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/msi/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/msi/sdk/2018-11-30/managedidentity"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/msi/sdk/2025-01-31-preview/federatedidentitycredentials"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -54,6 +58,50 @@ func resourceArmUserAssignedIdentity() *pluginsdk.Resource {
 
 			"location": azure.SchemaLocation(),
 
+			"federated_credential": {
+				Type:       pluginsdk.TypeSet,
+				Optional:   true,
+				Computed:   true,
+				ConfigMode: pluginsdk.SchemaConfigModeAttr,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validateFederatedIdentityCredentialName,
+						},
+
+						"audience": {
+							Type:     pluginsdk.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+
+						"issuer": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsURLWithHTTPS,
+						},
+
+						"subject": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validateFederatedIdentityCredentialSubject,
+						},
+
+						"claims_matching_expression": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
 			"tags": tags.Schema(),
 
 			"principal_id": {
@@ -109,6 +157,12 @@ func resourceArmUserAssignedIdentityCreateUpdate(d *pluginsdk.ResourceData, meta
 		return fmt.Errorf("creating/updating %s: %+v", resourceId, err)
 	}
 
+	if d.HasChange("federated_credential") {
+		if err := updateUserAssignedIdentityFederatedCredentials(ctx, d, meta, resourceId); err != nil {
+			return err
+		}
+	}
+
 	d.SetId(resourceId.ID())
 	return resourceArmUserAssignedIdentityRead(d, meta)
 }
@@ -149,6 +203,16 @@ func resourceArmUserAssignedIdentityRead(d *pluginsdk.ResourceData, meta interfa
 		}
 	}
 
+	credentialsClient := meta.(*clients.Client).MSI.FederatedIdentityCredentialsClient
+	credentialsId := federatedidentitycredentials.NewUserAssignedIdentityID(id.SubscriptionId, id.ResourceGroupName, id.ResourceName)
+	credentials, err := credentialsClient.ListComplete(ctx, credentialsId)
+	if err != nil {
+		return fmt.Errorf("listing the Federated Identity Credentials for %s: %+v", *id, err)
+	}
+	if err := d.Set("federated_credential", flattenUserAssignedIdentityFederatedCredentials(credentials.Items)); err != nil {
+		return fmt.Errorf("setting `federated_credential`: %+v", err)
+	}
+
 	return nil
 }
 
@@ -168,3 +232,87 @@ func resourceArmUserAssignedIdentityDelete(d *pluginsdk.ResourceData, meta inter
 
 	return nil
 }
+
+// updateUserAssignedIdentityFederatedCredentials reconciles the Federated Identity Credentials defined inline
+// within the `federated_credential` block - removing those which are no longer defined, and creating/updating
+// the rest one at a time, since the API doesn't support these being written concurrently
+func updateUserAssignedIdentityFederatedCredentials(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}, identityId managedidentity.UserAssignedIdentitiesId) error {
+	client := meta.(*clients.Client).MSI.FederatedIdentityCredentialsClient
+
+	parentId := federatedidentitycredentials.NewUserAssignedIdentityID(identityId.SubscriptionId, identityId.ResourceGroupName, identityId.ResourceName)
+	locks.ByID(parentId.ID())
+	defer locks.UnlockByID(parentId.ID())
+
+	oldRaw, newRaw := d.GetChange("federated_credential")
+
+	desired := make(map[string]map[string]interface{})
+	for _, raw := range newRaw.(*pluginsdk.Set).List() {
+		v := raw.(map[string]interface{})
+		name := v["name"].(string)
+		if _, exists := desired[name]; exists {
+			return fmt.Errorf("the Federated Credential %q is defined more than once within `federated_credential`", name)
+		}
+		if (v["subject"].(string) == "") == (v["claims_matching_expression"].(string) == "") {
+			return fmt.Errorf("exactly one of `subject` or `claims_matching_expression` must be specified for the Federated Credential %q", name)
+		}
+		desired[name] = v
+	}
+
+	for _, raw := range oldRaw.(*pluginsdk.Set).List() {
+		name := raw.(map[string]interface{})["name"].(string)
+		if _, exists := desired[name]; exists {
+			continue
+		}
+
+		id := federatedidentitycredentials.NewFederatedIdentityCredentialID(identityId.SubscriptionId, identityId.ResourceGroupName, identityId.ResourceName, name)
+		if resp, err := client.Delete(ctx, id); err != nil && !response.WasNotFound(resp.HttpResponse) {
+			return fmt.Errorf("deleting %s: %+v", id, err)
+		}
+	}
+
+	names := make([]string, 0, len(desired))
+	for name := range desired {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		v := desired[name]
+		id := federatedidentitycredentials.NewFederatedIdentityCredentialID(identityId.SubscriptionId, identityId.ResourceGroupName, identityId.ResourceName, name)
+		payload := expandFederatedIdentityCredential(v["issuer"].(string), v["subject"].(string), v["claims_matching_expression"].(string), *utils.ExpandStringSlice(v["audience"].([]interface{})))
+		if _, err := client.CreateOrUpdate(ctx, id, payload); err != nil {
+			return fmt.Errorf("creating/updating %s: %+v", id, err)
+		}
+	}
+
+	return nil
+}
+
+func flattenUserAssignedIdentityFederatedCredentials(input []federatedidentitycredentials.FederatedIdentityCredential) []interface{} {
+	output := make([]interface{}, 0)
+
+	for _, item := range input {
+		issuer := ""
+		subject := ""
+		claimsMatchingExpression := ""
+		audience := make([]interface{}, 0)
+		if props := item.Properties; props != nil {
+			issuer = props.Issuer
+			subject = utils.NormalizeNilableString(props.Subject)
+			if props.ClaimsMatchingExpression != nil {
+				claimsMatchingExpression = props.ClaimsMatchingExpression.Value
+			}
+			audience = utils.FlattenStringSlice(&props.Audiences)
+		}
+
+		output = append(output, map[string]interface{}{
+			"name":                       utils.NormalizeNilableString(item.Name),
+			"audience":                   audience,
+			"issuer":                     issuer,
+			"subject":                    subject,
+			"claims_matching_expression": claimsMatchingExpression,
+		})
+	}
+
+	return output
+}
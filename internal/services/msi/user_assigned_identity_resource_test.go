@@ -52,6 +52,38 @@ func TestAccAzureRMUserAssignedIdentity_requiresImport(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMUserAssignedIdentity_federatedCredentials(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_user_assigned_identity", "test")
+	r := UserAssignedIdentityResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.federatedCredentials(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("federated_credential.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.federatedCredentialsUpdated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("federated_credential.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.federatedCredentialsRemoved(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("federated_credential.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (r UserAssignedIdentityResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := managedidentity.ParseUserAssignedIdentitiesID(state.ID)
 	if err != nil {
@@ -97,3 +129,83 @@ resource "azurerm_user_assigned_identity" "import" {
 }
 `, template)
 }
+
+func (r UserAssignedIdentityResource) federatedCredentials(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_user_assigned_identity" "test" {
+  name                = "acctest%s"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  federated_credential {
+    name     = "frontend"
+    audience = ["api://AzureADTokenExchange"]
+    issuer   = "https://oidc.prod-aks.azure.com/00000000-0000-0000-0000-000000000000/"
+    subject  = "system:serviceaccount:frontend:workload-identity"
+  }
+
+  federated_credential {
+    name     = "backend"
+    audience = ["api://AzureADTokenExchange"]
+    issuer   = "https://oidc.prod-aks.azure.com/00000000-0000-0000-0000-000000000000/"
+    subject  = "system:serviceaccount:backend:workload-identity"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
+
+func (r UserAssignedIdentityResource) federatedCredentialsUpdated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_user_assigned_identity" "test" {
+  name                = "acctest%s"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  federated_credential {
+    name     = "frontend"
+    audience = ["api://AzureADTokenExchange"]
+    issuer   = "https://oidc.prod-aks.azure.com/00000000-0000-0000-0000-000000000000/"
+    subject  = "system:serviceaccount:frontend:frontend"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
+
+func (r UserAssignedIdentityResource) federatedCredentialsRemoved(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_user_assigned_identity" "test" {
+  name                = "acctest%s"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  federated_credential = []
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
@@ -60,7 +60,9 @@ func dataSourceApplicationGatewayRead(d *pluginsdk.ResourceData, meta interface{
 		return fmt.Errorf("retrieving %s: %+v", id, err)
 	}
 
+	id.ResourceGroup = azure.NormalizeResourceGroupName(id.ResourceGroup, resp.ID)
 	d.SetId(id.ID())
+	d.Set("resource_group_name", id.ResourceGroup)
 
 	d.Set("location", location.NormalizeNilable(resp.Location))
 
@@ -7,11 +7,13 @@ import (
 	"strings"
 	"time"
 
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/v7.1/keyvault"
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	keyVaultParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
 	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
 	msiParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/msi/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
@@ -1164,6 +1166,11 @@ func resourceApplicationGateway() *pluginsdk.Resource {
 							ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
 						},
 
+						"key_vault_secret_version": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
 						"id": {
 							Type:     pluginsdk.TypeString,
 							Computed: true,
@@ -1686,7 +1693,7 @@ func resourceApplicationGatewayCreateUpdate(d *pluginsdk.ResourceData, meta inte
 			return fmt.Errorf("stopping %s: %+v", id, err)
 		}
 
-		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if err = clients.WaitForCompletion(ctx, future, client.Client); err != nil {
 			return fmt.Errorf("waiting for %s to stop: %+v", id, err)
 		}
 	}
@@ -1696,7 +1703,7 @@ func resourceApplicationGatewayCreateUpdate(d *pluginsdk.ResourceData, meta inte
 		return fmt.Errorf("creating/updating %s: %+v", id, err)
 	}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+	if err = clients.WaitForCompletion(ctx, future, client.Client); err != nil {
 		return fmt.Errorf("waiting for create/update of %s: %+v", id, err)
 	}
 
@@ -1706,7 +1713,7 @@ func resourceApplicationGatewayCreateUpdate(d *pluginsdk.ResourceData, meta inte
 			return fmt.Errorf("starting %s: %+v", id, err)
 		}
 
-		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if err = clients.WaitForCompletion(ctx, future, client.Client); err != nil {
 			return fmt.Errorf("waiting for %s to start: %+v", id, err)
 		}
 	}
@@ -1844,7 +1851,7 @@ func resourceApplicationGatewayRead(d *pluginsdk.ResourceData, meta interface{})
 			return fmt.Errorf("setting `autoscale_configuration`: %+v", setErr)
 		}
 
-		if setErr := d.Set("ssl_certificate", flattenApplicationGatewaySslCertificates(props.SslCertificates, d)); setErr != nil {
+		if setErr := d.Set("ssl_certificate", flattenApplicationGatewaySslCertificates(ctx, meta.(*clients.Client).KeyVault.ManagementClient, props.SslCertificates, d)); setErr != nil {
 			return fmt.Errorf("setting `ssl_certificate`: %+v", setErr)
 		}
 
@@ -1895,14 +1902,10 @@ func resourceApplicationGatewayDelete(d *pluginsdk.ResourceData, meta interface{
 	}
 
 	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
-	if err != nil {
+	if err = utils.WaitForDeleteIgnore404(ctx, client.Client, future.FutureAPI, err); err != nil {
 		return fmt.Errorf("deleting %s: %+v", *id, err)
 	}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
-	}
-
 	return nil
 }
 
@@ -2056,10 +2059,12 @@ func flattenApplicationGatewayTrustedRootCertificates(certs *[]network.Applicati
 
 	// since the certificate data isn't returned lets load any existing data
 	nameToDataMap := map[string]string{}
+	nameToKeyVaultSecretIdMap := map[string]string{}
 	if existing, ok := d.GetOk("trusted_root_certificate"); ok && existing != nil {
 		for _, c := range existing.([]interface{}) {
 			b := c.(map[string]interface{})
 			nameToDataMap[b["name"].(string)] = b["data"].(string)
+			nameToKeyVaultSecretIdMap[b["name"].(string)] = b["key_vault_secret_id"].(string)
 		}
 	}
 
@@ -2076,6 +2081,9 @@ func flattenApplicationGatewayTrustedRootCertificates(certs *[]network.Applicati
 				kvsid = *v
 			}
 		}
+		if v := cert.Name; v != nil {
+			kvsid = applicationGatewayKeyVaultSecretIdForState(nameToKeyVaultSecretIdMap[*v], kvsid)
+		}
 		output["key_vault_secret_id"] = kvsid
 
 		if v := cert.Name; v != nil {
@@ -3723,7 +3731,7 @@ func expandApplicationGatewaySslCertificates(d *pluginsdk.ResourceData) (*[]netw
 	return &results, nil
 }
 
-func flattenApplicationGatewaySslCertificates(input *[]network.ApplicationGatewaySslCertificate, d *pluginsdk.ResourceData) []interface{} {
+func flattenApplicationGatewaySslCertificates(ctx context.Context, keyVaultClient *keyvault.BaseClient, input *[]network.ApplicationGatewaySslCertificate, d *pluginsdk.ResourceData) []interface{} {
 	results := make([]interface{}, 0)
 	if input == nil {
 		return results
@@ -3750,6 +3758,7 @@ func flattenApplicationGatewaySslCertificates(input *[]network.ApplicationGatewa
 
 			if kvsid := props.KeyVaultSecretID; kvsid != nil {
 				output["key_vault_secret_id"] = *kvsid
+				output["key_vault_secret_version"] = applicationGatewayKeyVaultSecretVersion(ctx, keyVaultClient, *kvsid)
 			}
 		}
 
@@ -3761,6 +3770,10 @@ func flattenApplicationGatewaySslCertificates(input *[]network.ApplicationGatewa
 				existingName := existingCerts["name"].(string)
 
 				if name == existingName {
+					if kvsid, ok := output["key_vault_secret_id"].(string); ok {
+						output["key_vault_secret_id"] = applicationGatewayKeyVaultSecretIdForState(existingCerts["key_vault_secret_id"].(string), kvsid)
+					}
+
 					if data := existingCerts["data"]; data != nil {
 						v := utils.Base64EncodeIfNot(data.(string))
 						output["data"] = v
@@ -3779,6 +3792,64 @@ func flattenApplicationGatewaySslCertificates(input *[]network.ApplicationGatewa
 	return results
 }
 
+// applicationGatewayKeyVaultSecretIdForState returns the Key Vault Secret ID which should be persisted into the state.
+// When a versionless Secret ID is configured the Application Gateway follows the latest version of the Secret, so
+// a (versioned) ID for the same Secret being returned by the API shouldn't cause a diff once the Secret is rotated.
+func applicationGatewayKeyVaultSecretIdForState(configured string, returned string) string {
+	if configured == "" || returned == "" {
+		return returned
+	}
+
+	configuredId, err := keyVaultParse.ParseOptionallyVersionedNestedItemID(configured)
+	if err != nil || configuredId.Version != "" {
+		return returned
+	}
+
+	returnedId, err := keyVaultParse.ParseOptionallyVersionedNestedItemID(returned)
+	if err != nil {
+		return returned
+	}
+
+	if strings.EqualFold(configuredId.VersionlessID(), returnedId.VersionlessID()) {
+		return configured
+	}
+
+	return returned
+}
+
+// applicationGatewayKeyVaultSecretVersion returns the version of the Key Vault Secret used by the Application Gateway.
+// Where the Secret ID is versionless the Application Gateway uses the latest version of the Secret, which is looked
+// up from the Key Vault - since this is informational a failure to retrieve it is logged rather than returned.
+func applicationGatewayKeyVaultSecretVersion(ctx context.Context, client *keyvault.BaseClient, secretId string) string {
+	id, err := keyVaultParse.ParseOptionallyVersionedNestedItemID(secretId)
+	if err != nil {
+		log.Printf("[DEBUG] parsing Key Vault Secret ID %q: %+v", secretId, err)
+		return ""
+	}
+
+	if id.Version != "" {
+		return id.Version
+	}
+
+	resp, err := client.GetSecret(ctx, id.KeyVaultBaseUrl, id.Name, "")
+	if err != nil {
+		log.Printf("[WARN] retrieving the latest version of Key Vault Secret %q: %+v", secretId, err)
+		return ""
+	}
+
+	if resp.ID == nil {
+		return ""
+	}
+
+	latest, err := keyVaultParse.ParseNestedItemID(*resp.ID)
+	if err != nil {
+		log.Printf("[DEBUG] parsing Key Vault Secret ID %q: %+v", *resp.ID, err)
+		return ""
+	}
+
+	return latest.Version
+}
+
 func expandApplicationGatewayTrustedClientCertificates(d *pluginsdk.ResourceData) (*[]network.ApplicationGatewayTrustedClientCertificate, error) {
 	vs := d.Get("trusted_client_certificate").([]interface{})
 	results := make([]network.ApplicationGatewayTrustedClientCertificate, 0)
@@ -546,6 +546,7 @@ func TestAccApplicationGateway_sslCertificate_keyvault_versionless(t *testing.T)
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
 				check.That(data.ResourceName).Key("ssl_certificate.0.key_vault_secret_id").Exists(),
+				check.That(data.ResourceName).Key("ssl_certificate.0.key_vault_secret_version").Exists(),
 			),
 		},
 		data.ImportStep(),
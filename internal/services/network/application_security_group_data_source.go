@@ -53,6 +53,7 @@ func dataSourceApplicationSecurityGroupRead(d *pluginsdk.ResourceData, meta inte
 		return fmt.Errorf("retrieving %s: %+v", id, err)
 	}
 
+	id.ResourceGroup = azure.NormalizeResourceGroupName(id.ResourceGroup, resp.ID)
 	d.SetId(id.ID())
 
 	d.Set("name", id.Name)
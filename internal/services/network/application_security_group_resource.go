@@ -130,13 +130,9 @@ func resourceApplicationSecurityGroupDelete(d *pluginsdk.ResourceData, meta inte
 
 	log.Printf("[DEBUG] Deleting %s..", *id)
 	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
-	if err != nil {
+	if err = utils.WaitForDeleteIgnore404(ctx, client.Client, future.FutureAPI, err); err != nil {
 		return fmt.Errorf("deleting %s: %+v", *id, err)
 	}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for the deletion of %s: %+v", *id, err)
-	}
-
 	return nil
 }
@@ -3,12 +3,17 @@ package client
 import (
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-07-01/configurationpolicygroups"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-07-01/p2svpngateways"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-07-01/routemaps"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-07-01/webapplicationfirewallpolicies"
 )
 
 type Client struct {
 	ApplicationGatewaysClient              *network.ApplicationGatewaysClient
 	ApplicationSecurityGroupsClient        *network.ApplicationSecurityGroupsClient
 	BastionHostsClient                     *network.BastionHostsClient
+	ConfigurationPolicyGroupsClient        *configurationpolicygroups.ConfigurationPolicyGroupsClient
 	ConnectionMonitorsClient               *network.ConnectionMonitorsClient
 	DDOSProtectionPlansClient              *network.DdosProtectionPlansClient
 	ExpressRouteAuthsClient                *network.ExpressRouteCircuitAuthorizationsClient
@@ -18,6 +23,7 @@ type Client struct {
 	ExpressRouteGatewaysClient             *network.ExpressRouteGatewaysClient
 	ExpressRoutePeeringsClient             *network.ExpressRouteCircuitPeeringsClient
 	ExpressRoutePortsClient                *network.ExpressRoutePortsClient
+	ExpressRouteServiceProvidersClient     *network.ExpressRouteServiceProvidersClient
 	FlowLogsClient                         *network.FlowLogsClient
 	HubRouteTableClient                    *network.HubRouteTablesClient
 	HubVirtualNetworkConnectionClient      *network.HubVirtualNetworkConnectionsClient
@@ -25,7 +31,7 @@ type Client struct {
 	IPGroupsClient                         *network.IPGroupsClient
 	LocalNetworkGatewaysClient             *network.LocalNetworkGatewaysClient
 	NatRuleClient                          *network.NatRulesClient
-	PointToSiteVpnGatewaysClient           *network.P2sVpnGatewaysClient
+	PointToSiteVpnGatewaysClient           *p2svpngateways.P2sVpnGatewaysClient
 	ProfileClient                          *network.ProfilesClient
 	PacketCapturesClient                   *network.PacketCapturesClient
 	PrivateEndpointClient                  *network.PrivateEndpointsClient
@@ -33,6 +39,8 @@ type Client struct {
 	PublicIPPrefixesClient                 *network.PublicIPPrefixesClient
 	RoutesClient                           *network.RoutesClient
 	RouteFiltersClient                     *network.RouteFiltersClient
+	RouteMapsClient                        *routemaps.RouteMapsClient
+	RoutingIntentClient                    *network.RoutingIntentClient
 	RouteTablesClient                      *network.RouteTablesClient
 	SecurityGroupClient                    *network.SecurityGroupsClient
 	SecurityPartnerProviderClient          *network.SecurityPartnerProvidersClient
@@ -55,7 +63,7 @@ type Client struct {
 	VpnServerConfigurationsClient          *network.VpnServerConfigurationsClient
 	VpnSitesClient                         *network.VpnSitesClient
 	WatcherClient                          *network.WatchersClient
-	WebApplicationFirewallPoliciesClient   *network.WebApplicationFirewallPoliciesClient
+	WebApplicationFirewallPoliciesClient   *webapplicationfirewallpolicies.WebApplicationFirewallPoliciesClient
 	PrivateDnsZoneGroupClient              *network.PrivateDNSZoneGroupsClient
 	PrivateLinkServiceClient               *network.PrivateLinkServicesClient
 	ServiceAssociationLinkClient           *network.ServiceAssociationLinksClient
@@ -99,6 +107,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	ExpressRoutePortsClient := network.NewExpressRoutePortsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&ExpressRoutePortsClient.Client, o.ResourceManagerAuthorizer)
 
+	ExpressRouteServiceProvidersClient := network.NewExpressRouteServiceProvidersClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&ExpressRouteServiceProvidersClient.Client, o.ResourceManagerAuthorizer)
+
 	FlowLogsClient := network.NewFlowLogsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&FlowLogsClient.Client, o.ResourceManagerAuthorizer)
 
@@ -120,9 +131,12 @@ func NewClient(o *common.ClientOptions) *Client {
 	NatRuleClient := network.NewNatRulesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&NatRuleClient.Client, o.ResourceManagerAuthorizer)
 
-	pointToSiteVpnGatewaysClient := network.NewP2sVpnGatewaysClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	pointToSiteVpnGatewaysClient := p2svpngateways.NewP2sVpnGatewaysClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&pointToSiteVpnGatewaysClient.Client, o.ResourceManagerAuthorizer)
 
+	configurationPolicyGroupsClient := configurationpolicygroups.NewConfigurationPolicyGroupsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&configurationPolicyGroupsClient.Client, o.ResourceManagerAuthorizer)
+
 	vpnServerConfigurationsClient := network.NewVpnServerConfigurationsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&vpnServerConfigurationsClient.Client, o.ResourceManagerAuthorizer)
 
@@ -159,6 +173,12 @@ func NewClient(o *common.ClientOptions) *Client {
 	RouteFiltersClient := network.NewRouteFiltersClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&RouteFiltersClient.Client, o.ResourceManagerAuthorizer)
 
+	RouteMapsClient := routemaps.NewRouteMapsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&RouteMapsClient.Client, o.ResourceManagerAuthorizer)
+
+	RoutingIntentClient := network.NewRoutingIntentClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&RoutingIntentClient.Client, o.ResourceManagerAuthorizer)
+
 	RouteTablesClient := network.NewRouteTablesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&RouteTablesClient.Client, o.ResourceManagerAuthorizer)
 
@@ -216,7 +236,7 @@ func NewClient(o *common.ClientOptions) *Client {
 	WatcherClient := network.NewWatchersClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&WatcherClient.Client, o.ResourceManagerAuthorizer)
 
-	WebApplicationFirewallPoliciesClient := network.NewWebApplicationFirewallPoliciesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	WebApplicationFirewallPoliciesClient := webapplicationfirewallpolicies.NewWebApplicationFirewallPoliciesClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&WebApplicationFirewallPoliciesClient.Client, o.ResourceManagerAuthorizer)
 
 	ServiceAssociationLinkClient := network.NewServiceAssociationLinksClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
@@ -229,6 +249,7 @@ func NewClient(o *common.ClientOptions) *Client {
 		ApplicationGatewaysClient:              &ApplicationGatewaysClient,
 		ApplicationSecurityGroupsClient:        &ApplicationSecurityGroupsClient,
 		BastionHostsClient:                     &BastionHostsClient,
+		ConfigurationPolicyGroupsClient:        &configurationPolicyGroupsClient,
 		ConnectionMonitorsClient:               &ConnectionMonitorsClient,
 		DDOSProtectionPlansClient:              &DDOSProtectionPlansClient,
 		ExpressRouteAuthsClient:                &ExpressRouteAuthsClient,
@@ -238,6 +259,7 @@ func NewClient(o *common.ClientOptions) *Client {
 		ExpressRouteGatewaysClient:             &ExpressRouteGatewaysClient,
 		ExpressRoutePeeringsClient:             &ExpressRoutePeeringsClient,
 		ExpressRoutePortsClient:                &ExpressRoutePortsClient,
+		ExpressRouteServiceProvidersClient:     &ExpressRouteServiceProvidersClient,
 		FlowLogsClient:                         &FlowLogsClient,
 		HubRouteTableClient:                    &HubRouteTableClient,
 		HubVirtualNetworkConnectionClient:      &HubVirtualNetworkConnectionClient,
@@ -253,6 +275,8 @@ func NewClient(o *common.ClientOptions) *Client {
 		PublicIPPrefixesClient:                 &PublicIPPrefixesClient,
 		RoutesClient:                           &RoutesClient,
 		RouteFiltersClient:                     &RouteFiltersClient,
+		RouteMapsClient:                        &RouteMapsClient,
+		RoutingIntentClient:                    &RoutingIntentClient,
 		RouteTablesClient:                      &RouteTablesClient,
 		SecurityGroupClient:                    &SecurityGroupClient,
 		SecurityPartnerProviderClient:          &SecurityPartnerProviderClient,
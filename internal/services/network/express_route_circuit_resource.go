@@ -338,14 +338,10 @@ func resourceExpressRouteCircuitDelete(d *pluginsdk.ResourceData, meta interface
 	defer locks.UnlockByName(id.Name, expressRouteCircuitResourceName)
 
 	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
-	if err != nil {
+	if err = utils.WaitForDeleteIgnore404(ctx, client.Client, future.FutureAPI, err); err != nil {
 		return fmt.Errorf("deleting %s : %+v", *id, err)
 	}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for the deletion of %s: %+v", *id, err)
-	}
-
 	return err
 }
 
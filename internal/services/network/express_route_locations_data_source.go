@@ -0,0 +1,217 @@
+package network
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func dataSourceExpressRouteLocations() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceExpressRouteLocationsRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"service_provider_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"peering_location": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"peering_locations": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"service_providers": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"peering_locations": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"bandwidths_offered": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"name": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"value_in_mbps": {
+										Type:     pluginsdk.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceExpressRouteLocationsRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ExpressRouteServiceProvidersClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	serviceProviderName := d.Get("service_provider_name").(string)
+	peeringLocation := d.Get("peering_location").(string)
+
+	serviceProviders := make([]network.ExpressRouteServiceProvider, 0)
+	iterator, err := client.ListComplete(ctx)
+	if err != nil {
+		return fmt.Errorf("listing ExpressRoute Service Providers: %+v", err)
+	}
+	for iterator.NotDone() {
+		serviceProvider := iterator.Value()
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("listing ExpressRoute Service Providers: %+v", err)
+		}
+
+		if serviceProvider.Name == nil {
+			continue
+		}
+		if serviceProviderName != "" && !strings.EqualFold(*serviceProvider.Name, serviceProviderName) {
+			continue
+		}
+		if peeringLocation != "" && !expressRouteServiceProviderOffersPeeringLocation(serviceProvider, peeringLocation) {
+			continue
+		}
+
+		serviceProviders = append(serviceProviders, serviceProvider)
+	}
+
+	if len(serviceProviders) == 0 && (serviceProviderName != "" || peeringLocation != "") {
+		filters := make([]string, 0)
+		if serviceProviderName != "" {
+			filters = append(filters, fmt.Sprintf("named %q", serviceProviderName))
+		}
+		if peeringLocation != "" {
+			filters = append(filters, fmt.Sprintf("offering the Peering Location %q", peeringLocation))
+		}
+		return fmt.Errorf("no ExpressRoute Service Providers were found %s", strings.Join(filters, " and "))
+	}
+
+	d.SetId(fmt.Sprintf("/subscriptions/%s/providers/Microsoft.Network/expressRouteServiceProviders", subscriptionId))
+
+	peeringLocations, results := flattenExpressRouteServiceProviders(serviceProviders)
+	if err := d.Set("peering_locations", peeringLocations); err != nil {
+		return fmt.Errorf("setting `peering_locations`: %+v", err)
+	}
+	if err := d.Set("service_providers", results); err != nil {
+		return fmt.Errorf("setting `service_providers`: %+v", err)
+	}
+
+	return nil
+}
+
+func expressRouteServiceProviderOffersPeeringLocation(input network.ExpressRouteServiceProvider, peeringLocation string) bool {
+	if input.ExpressRouteServiceProviderPropertiesFormat == nil || input.PeeringLocations == nil {
+		return false
+	}
+
+	for _, v := range *input.PeeringLocations {
+		if strings.EqualFold(v, peeringLocation) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// flattenExpressRouteServiceProviders returns the distinct Peering Locations offered by any of the Service Providers
+// and the Service Providers themselves, both sorted by name
+func flattenExpressRouteServiceProviders(input []network.ExpressRouteServiceProvider) ([]interface{}, []interface{}) {
+	sort.Slice(input, func(i, j int) bool {
+		return *input[i].Name < *input[j].Name
+	})
+
+	distinctPeeringLocations := make(map[string]struct{})
+	results := make([]interface{}, 0)
+	for _, item := range input {
+		peeringLocations := make([]interface{}, 0)
+		bandwidthsOffered := make([]interface{}, 0)
+		if props := item.ExpressRouteServiceProviderPropertiesFormat; props != nil {
+			if props.PeeringLocations != nil {
+				for _, v := range *props.PeeringLocations {
+					peeringLocations = append(peeringLocations, v)
+					distinctPeeringLocations[v] = struct{}{}
+				}
+			}
+
+			if props.BandwidthsOffered != nil {
+				for _, v := range *props.BandwidthsOffered {
+					name := ""
+					if v.OfferName != nil {
+						name = *v.OfferName
+					}
+
+					valueInMbps := 0
+					if v.ValueInMbps != nil {
+						valueInMbps = int(*v.ValueInMbps)
+					}
+
+					bandwidthsOffered = append(bandwidthsOffered, map[string]interface{}{
+						"name":          name,
+						"value_in_mbps": valueInMbps,
+					})
+				}
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":               *item.Name,
+			"peering_locations":  peeringLocations,
+			"bandwidths_offered": bandwidthsOffered,
+		})
+	}
+
+	names := make([]string, 0, len(distinctPeeringLocations))
+	for v := range distinctPeeringLocations {
+		names = append(names, v)
+	}
+	sort.Strings(names)
+
+	peeringLocations := make([]interface{}, 0, len(names))
+	for _, v := range names {
+		peeringLocations = append(peeringLocations, v)
+	}
+
+	return peeringLocations, results
+}
@@ -0,0 +1,64 @@
+package network_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type ExpressRouteLocationsDataSource struct{}
+
+func TestAccDataSourceExpressRouteLocations_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_express_route_locations", "test")
+	r := ExpressRouteLocationsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("peering_locations.#").Exists(),
+				check.That(data.ResourceName).Key("service_providers.#").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceExpressRouteLocations_filtered(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_express_route_locations", "test")
+	r := ExpressRouteLocationsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.filtered(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("service_providers.#").HasValue("1"),
+				check.That(data.ResourceName).Key("service_providers.0.name").HasValue("Equinix"),
+				check.That(data.ResourceName).Key("service_providers.0.bandwidths_offered.#").Exists(),
+			),
+		},
+	})
+}
+
+func (ExpressRouteLocationsDataSource) basic() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_express_route_locations" "test" {}
+`
+}
+
+func (ExpressRouteLocationsDataSource) filtered() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_express_route_locations" "test" {
+  service_provider_name = "Equinix"
+  peering_location      = "Silicon Valley"
+}
+`
+}
@@ -64,7 +64,7 @@ func dataSourceIpGroupRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	d.SetId(*resp.ID)
 
 	d.Set("name", resp.Name)
-	d.Set("resource_group_name", resourceGroup)
+	d.Set("resource_group_name", azure.NormalizeResourceGroupName(resourceGroup, resp.ID))
 	if location := resp.Location; location != nil {
 		d.Set("location", azure.NormalizeLocation(*location))
 	}
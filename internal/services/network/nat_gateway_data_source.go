@@ -98,7 +98,7 @@ func dataSourceNatGatewayRead(d *pluginsdk.ResourceData, meta interface{}) error
 	d.SetId(*resp.ID)
 
 	d.Set("name", resp.Name)
-	d.Set("resource_group_name", resourceGroup)
+	d.Set("resource_group_name", azure.NormalizeResourceGroupName(resourceGroup, resp.ID))
 	if sku := resp.Sku; sku != nil {
 		d.Set("sku_name", resp.Sku.Name)
 	}
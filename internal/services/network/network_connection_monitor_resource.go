@@ -1,11 +1,12 @@
 package network
 
 import (
+	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network"
-	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
@@ -155,6 +156,7 @@ func resourceNetworkConnectionMonitor() *pluginsdk.Resource {
 							Optional: true,
 							ValidateFunc: validation.Any(
 								validation.IsIPv4Address,
+								validation.IsIPv6Address,
 								networkValidate.NetworkConnectionMonitorEndpointAddress,
 							),
 						},
@@ -514,9 +516,103 @@ func resourceNetworkConnectionMonitor() *pluginsdk.Resource {
 
 			"tags": tags.Schema(),
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, d *pluginsdk.ResourceDiff, v interface{}) error {
+			if err := validateNetworkConnectionMonitorTestConfigurations(d); err != nil {
+				return err
+			}
+
+			return validateNetworkConnectionMonitorTestGroups(d)
+		}),
 	}
 }
 
+// validateNetworkConnectionMonitorTestConfigurations ensures that only the configuration block for the `protocol`
+// of each Test Configuration is specified, since the API otherwise silently ignores the other blocks
+func validateNetworkConnectionMonitorTestConfigurations(d *pluginsdk.ResourceDiff) error {
+	if !d.NewValueKnown("test_configuration") {
+		return nil
+	}
+
+	protocolBlocks := map[network.ConnectionMonitorTestConfigurationProtocol]string{
+		network.ConnectionMonitorTestConfigurationProtocolHTTP: "http_configuration",
+		network.ConnectionMonitorTestConfigurationProtocolIcmp: "icmp_configuration",
+		network.ConnectionMonitorTestConfigurationProtocolTCP:  "tcp_configuration",
+	}
+
+	for _, raw := range d.Get("test_configuration").(*pluginsdk.Set).List() {
+		v := raw.(map[string]interface{})
+		name := v["name"].(string)
+		protocol := network.ConnectionMonitorTestConfigurationProtocol(v["protocol"].(string))
+
+		for blockProtocol, block := range protocolBlocks {
+			if blockProtocol != protocol && len(v[block].([]interface{})) > 0 {
+				return fmt.Errorf("`%s` cannot be specified for the Test Configuration %q since the `protocol` is %q", block, name, protocol)
+			}
+		}
+
+		if protocol == network.ConnectionMonitorTestConfigurationProtocolTCP && len(v["tcp_configuration"].([]interface{})) == 0 {
+			return fmt.Errorf("`tcp_configuration` must be specified for the Test Configuration %q since the `protocol` is %q", name, protocol)
+		}
+	}
+
+	return nil
+}
+
+// validateNetworkConnectionMonitorTestGroups ensures that the Endpoints and Test Configurations referenced by each
+// Test Group are defined within this Connection Monitor
+func validateNetworkConnectionMonitorTestGroups(d *pluginsdk.ResourceDiff) error {
+	if !d.NewValueKnown("endpoint") || !d.NewValueKnown("test_configuration") || !d.NewValueKnown("test_group") {
+		return nil
+	}
+
+	endpointNames := make(map[string]struct{})
+	for _, raw := range d.Get("endpoint").(*pluginsdk.Set).List() {
+		v := raw.(map[string]interface{})
+		endpointNames[v["name"].(string)] = struct{}{}
+	}
+
+	testConfigurationNames := make(map[string]struct{})
+	for _, raw := range d.Get("test_configuration").(*pluginsdk.Set).List() {
+		v := raw.(map[string]interface{})
+		testConfigurationNames[v["name"].(string)] = struct{}{}
+	}
+
+	for _, raw := range d.Get("test_group").(*pluginsdk.Set).List() {
+		v := raw.(map[string]interface{})
+		name := v["name"].(string)
+
+		for _, field := range []string{"source_endpoints", "destination_endpoints"} {
+			if missing := networkConnectionMonitorMissingNames(v[field].(*pluginsdk.Set).List(), endpointNames); len(missing) > 0 {
+				return fmt.Errorf("the Test Group %q references Endpoints in `%s` which aren't defined as an `endpoint`: %s", name, field, strings.Join(missing, ", "))
+			}
+		}
+
+		if missing := networkConnectionMonitorMissingNames(v["test_configuration_names"].(*pluginsdk.Set).List(), testConfigurationNames); len(missing) > 0 {
+			return fmt.Errorf("the Test Group %q references Test Configurations which aren't defined as a `test_configuration`: %s", name, strings.Join(missing, ", "))
+		}
+	}
+
+	return nil
+}
+
+func networkConnectionMonitorMissingNames(input []interface{}, names map[string]struct{}) []string {
+	missing := make([]string, 0)
+	for _, item := range input {
+		// values which are not yet known can't be validated
+		name, ok := item.(string)
+		if !ok || name == "" {
+			continue
+		}
+
+		if _, ok := names[name]; !ok {
+			missing = append(missing, name)
+		}
+	}
+
+	return missing
+}
+
 func resourceNetworkConnectionMonitorCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Network.ConnectionMonitorsClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
@@ -645,14 +741,8 @@ func resourceNetworkConnectionMonitorDelete(d *pluginsdk.ResourceData, meta inte
 	}
 
 	future, err := client.Delete(ctx, id.ResourceGroup, id.NetworkWatcherName, id.Name)
-	if err != nil {
-		if !response.WasNotFound(future.Response()) {
-			return fmt.Errorf("deleting %s: %+v", *id, err)
-		}
-	}
-
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for the deletion of %s: %+v", *id, err)
+	if err = utils.WaitForDeleteIgnore404(ctx, client.Client, future.FutureAPI, err); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
 	}
 
 	return nil
@@ -284,6 +284,45 @@ func testAccNetworkConnectionMonitor_updateEndpointIPAddressAndCoverageLevel(t *
 	})
 }
 
+func testAccNetworkConnectionMonitor_externalAddress(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_connection_monitor", "test")
+	r := NetworkConnectionMonitorResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.externalAddressConfig(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func testAccNetworkConnectionMonitor_undefinedTestGroupReferences(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_connection_monitor", "test")
+	r := NetworkConnectionMonitorResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.undefinedTestGroupReferencesConfig(data),
+			ExpectError: regexp.MustCompile("references Endpoints in `destination_endpoints` which aren't defined"),
+		},
+	})
+}
+
+func testAccNetworkConnectionMonitor_mismatchedProtocolConfiguration(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_connection_monitor", "test")
+	r := NetworkConnectionMonitorResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.mismatchedProtocolConfigurationConfig(data),
+			ExpectError: regexp.MustCompile("`http_configuration` cannot be specified"),
+		},
+	})
+}
+
 func (t NetworkConnectionMonitorResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.ConnectionMonitorID(state.ID)
 	if err != nil {
@@ -1032,3 +1071,142 @@ resource "azurerm_network_connection_monitor" "test" {
 }
 `, r.baseConfig(data), data.RandomInteger, data.RandomInteger)
 }
+
+func (r NetworkConnectionMonitorResource) externalAddressConfig(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_connection_monitor" "test" {
+  name               = "acctest-CM-%d"
+  network_watcher_id = azurerm_network_watcher.test.id
+  location           = azurerm_network_watcher.test.location
+
+  endpoint {
+    name                 = "source"
+    target_resource_type = "AzureVM"
+    target_resource_id   = azurerm_virtual_machine.src.id
+  }
+
+  endpoint {
+    name                 = "external"
+    target_resource_type = "ExternalAddress"
+    address              = "terraform.io"
+  }
+
+  test_configuration {
+    name                      = "http"
+    protocol                  = "Http"
+    test_frequency_in_seconds = 60
+
+    http_configuration {
+      method                   = "Get"
+      port                     = 443
+      prefer_https             = true
+      valid_status_code_ranges = ["2xx"]
+    }
+
+    success_threshold {
+      checks_failed_percent = 10
+      round_trip_time_ms    = 500
+    }
+  }
+
+  test_configuration {
+    name     = "icmp"
+    protocol = "Icmp"
+
+    icmp_configuration {
+      trace_route_enabled = false
+    }
+  }
+
+  test_group {
+    name                     = "external"
+    destination_endpoints    = ["external"]
+    source_endpoints         = ["source"]
+    test_configuration_names = ["http", "icmp"]
+  }
+
+  depends_on = [azurerm_virtual_machine_extension.src]
+}
+`, r.baseConfig(data), data.RandomInteger)
+}
+
+func (r NetworkConnectionMonitorResource) undefinedTestGroupReferencesConfig(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_connection_monitor" "test" {
+  name               = "acctest-CM-%d"
+  network_watcher_id = azurerm_network_watcher.test.id
+  location           = azurerm_network_watcher.test.location
+
+  endpoint {
+    name               = "source"
+    target_resource_id = azurerm_virtual_machine.src.id
+  }
+
+  test_configuration {
+    name     = "tcp"
+    protocol = "Tcp"
+
+    tcp_configuration {
+      port = 80
+    }
+  }
+
+  test_group {
+    name                     = "testtg"
+    destination_endpoints    = ["destination"]
+    source_endpoints         = ["source"]
+    test_configuration_names = ["tcp"]
+  }
+
+  depends_on = [azurerm_virtual_machine_extension.src]
+}
+`, r.baseConfig(data), data.RandomInteger)
+}
+
+func (r NetworkConnectionMonitorResource) mismatchedProtocolConfigurationConfig(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_connection_monitor" "test" {
+  name               = "acctest-CM-%d"
+  network_watcher_id = azurerm_network_watcher.test.id
+  location           = azurerm_network_watcher.test.location
+
+  endpoint {
+    name               = "source"
+    target_resource_id = azurerm_virtual_machine.src.id
+  }
+
+  endpoint {
+    name    = "destination"
+    address = "terraform.io"
+  }
+
+  test_configuration {
+    name     = "tcp"
+    protocol = "Tcp"
+
+    tcp_configuration {
+      port = 80
+    }
+
+    http_configuration {
+      method = "Get"
+    }
+  }
+
+  test_group {
+    name                     = "testtg"
+    destination_endpoints    = ["destination"]
+    source_endpoints         = ["source"]
+    test_configuration_names = ["tcp"]
+  }
+
+  depends_on = [azurerm_virtual_machine_extension.src]
+}
+`, r.baseConfig(data), data.RandomInteger)
+}
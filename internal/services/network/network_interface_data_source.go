@@ -187,6 +187,7 @@ func dataSourceNetworkInterfaceRead(d *pluginsdk.ResourceData, meta interface{})
 		return fmt.Errorf("retrieving %s: %+v", id, err)
 	}
 
+	id.ResourceGroup = azure.NormalizeResourceGroupName(id.ResourceGroup, resp.ID)
 	d.SetId(id.ID())
 
 	d.Set("name", id.Name)
@@ -230,11 +230,12 @@ func resourceNetworkProfileDelete(d *pluginsdk.ResourceData, meta interface{}) e
 	locks.MultipleByName(subnetsToLock, SubnetResourceName)
 	defer locks.UnlockMultipleByName(subnetsToLock, SubnetResourceName)
 
-	if _, err = client.Delete(ctx, id.ResourceGroup, id.Name); err != nil {
+	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
+	if err = utils.WaitForDeleteIgnore404(ctx, client.Client, future.FutureAPI, err); err != nil {
 		return fmt.Errorf("deleting %s: %+v", *id, err)
 	}
 
-	return err
+	return nil
 }
 
 func expandNetworkProfileContainerNetworkInterface(d *pluginsdk.ResourceData) *[]network.ContainerNetworkInterfaceConfiguration {
@@ -304,14 +304,10 @@ func resourceNetworkWatcherFlowLogDelete(d *pluginsdk.ResourceData, meta interfa
 	defer locks.UnlockByID(id.NetworkSecurityGroupID())
 
 	future, err := client.Delete(ctx, id.ResourceGroupName, id.NetworkWatcherName, id.Name())
-	if err != nil {
+	if err = utils.WaitForDeleteIgnore404(ctx, client.Client, future.FutureAPI, err); err != nil {
 		return fmt.Errorf("deleting %s: %v", id, err)
 	}
 
-	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for deletion of %s: %v", id, err)
-	}
-
 	return nil
 }
 
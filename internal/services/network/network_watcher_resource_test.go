@@ -44,21 +44,24 @@ func TestAccNetworkWatcher(t *testing.T) {
 			"requiresImport":             testAccPacketCapture_requiresImport,
 		},
 		"ConnectionMonitor": {
-			"addressBasic":                   testAccNetworkConnectionMonitor_addressBasic,
-			"addressComplete":                testAccNetworkConnectionMonitor_addressComplete,
-			"addressUpdate":                  testAccNetworkConnectionMonitor_addressUpdate,
-			"vmBasic":                        testAccNetworkConnectionMonitor_vmBasic,
-			"vmComplete":                     testAccNetworkConnectionMonitor_vmComplete,
-			"vmUpdate":                       testAccNetworkConnectionMonitor_vmUpdate,
-			"destinationUpdate":              testAccNetworkConnectionMonitor_destinationUpdate,
-			"missingDestinationInvalid":      testAccNetworkConnectionMonitor_missingDestination,
-			"bothDestinationsInvalid":        testAccNetworkConnectionMonitor_conflictingDestinations,
-			"requiresImport":                 testAccNetworkConnectionMonitor_requiresImport,
-			"httpConfiguration":              testAccNetworkConnectionMonitor_httpConfiguration,
-			"icmpConfiguration":              testAccNetworkConnectionMonitor_icmpConfiguration,
-			"bothAddressAndVirtualMachineId": testAccNetworkConnectionMonitor_withAddressAndVirtualMachineId,
-			"endpointType":                   testAccNetworkConnectionMonitor_endpointDeprecated,
-			"updateEndpoint":                 testAccNetworkConnectionMonitor_updateEndpointIPAddressAndCoverageLevel,
+			"addressBasic":                    testAccNetworkConnectionMonitor_addressBasic,
+			"addressComplete":                 testAccNetworkConnectionMonitor_addressComplete,
+			"addressUpdate":                   testAccNetworkConnectionMonitor_addressUpdate,
+			"vmBasic":                         testAccNetworkConnectionMonitor_vmBasic,
+			"vmComplete":                      testAccNetworkConnectionMonitor_vmComplete,
+			"vmUpdate":                        testAccNetworkConnectionMonitor_vmUpdate,
+			"destinationUpdate":               testAccNetworkConnectionMonitor_destinationUpdate,
+			"missingDestinationInvalid":       testAccNetworkConnectionMonitor_missingDestination,
+			"bothDestinationsInvalid":         testAccNetworkConnectionMonitor_conflictingDestinations,
+			"requiresImport":                  testAccNetworkConnectionMonitor_requiresImport,
+			"httpConfiguration":               testAccNetworkConnectionMonitor_httpConfiguration,
+			"icmpConfiguration":               testAccNetworkConnectionMonitor_icmpConfiguration,
+			"bothAddressAndVirtualMachineId":  testAccNetworkConnectionMonitor_withAddressAndVirtualMachineId,
+			"endpointType":                    testAccNetworkConnectionMonitor_endpointDeprecated,
+			"updateEndpoint":                  testAccNetworkConnectionMonitor_updateEndpointIPAddressAndCoverageLevel,
+			"externalAddress":                 testAccNetworkConnectionMonitor_externalAddress,
+			"undefinedTestGroupReferences":    testAccNetworkConnectionMonitor_undefinedTestGroupReferences,
+			"mismatchedProtocolConfiguration": testAccNetworkConnectionMonitor_mismatchedProtocolConfiguration,
 		},
 		"PacketCapture": {
 			"localDisk":                  testAccNetworkPacketCapture_localDisk,
@@ -231,6 +231,17 @@ func TestSubnetIDInsensitively(t *testing.T) {
 				Name:               "subnet1",
 			},
 		},
+
+		{
+			// upper-cased root segment names
+			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/Microsoft.Network/virtualNetworks/network1/subnets/subnet1",
+			Expected: &SubnetId{
+				SubscriptionId:     "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:      "resGroup1",
+				VirtualNetworkName: "network1",
+				Name:               "subnet1",
+			},
+		},
 	}
 
 	for _, v := range testData {
@@ -231,6 +231,17 @@ func TestVirtualNetworkDnsServersIDInsensitively(t *testing.T) {
 				DnsServerName:      "default",
 			},
 		},
+
+		{
+			// upper-cased root segment names
+			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/Microsoft.Network/virtualNetworks/network1/dnsServers/default",
+			Expected: &VirtualNetworkDnsServersId{
+				SubscriptionId:     "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:      "resGroup1",
+				VirtualNetworkName: "network1",
+				DnsServerName:      "default",
+			},
+		},
 	}
 
 	for _, v := range testData {
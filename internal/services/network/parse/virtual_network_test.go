@@ -199,6 +199,16 @@ func TestVirtualNetworkIDInsensitively(t *testing.T) {
 				Name:           "network1",
 			},
 		},
+
+		{
+			// upper-cased root segment names
+			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/Microsoft.Network/virtualNetworks/network1",
+			Expected: &VirtualNetworkId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "resGroup1",
+				Name:           "network1",
+			},
+		},
 	}
 
 	for _, v := range testData {
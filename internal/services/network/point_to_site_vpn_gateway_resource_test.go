@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-07-01/p2svpngateways"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
@@ -86,6 +87,22 @@ func TestAccPointToSiteVPNGateway_enableInternetSecurity(t *testing.T) {
 	})
 }
 
+func TestAccPointToSiteVPNGateway_configurationPolicyGroups(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_point_to_site_vpn_gateway", "test")
+	r := PointToSiteVPNGatewayResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.configurationPolicyGroups(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("connection_configuration.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccPointToSiteVPNGateway_tags(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_point_to_site_vpn_gateway", "test")
 	r := PointToSiteVPNGatewayResource{}
@@ -107,12 +124,12 @@ func (PointToSiteVPNGatewayResource) Exists(ctx context.Context, clients *client
 		return nil, err
 	}
 
-	resp, err := clients.Network.PointToSiteVpnGatewaysClient.Get(ctx, id.ResourceGroup, id.P2sVpnGatewayName)
+	resp, err := clients.Network.PointToSiteVpnGatewaysClient.Get(ctx, p2svpngateways.NewP2sVpnGatewayID(id.SubscriptionId, id.ResourceGroup, id.P2sVpnGatewayName))
 	if err != nil {
 		return nil, fmt.Errorf("reading Point to Site VPN Gateway (%s): %+v", *id, err)
 	}
 
-	return utils.Bool(resp.ID != nil), nil
+	return utils.Bool(resp.Model != nil), nil
 }
 
 func (r PointToSiteVPNGatewayResource) basic(data acceptance.TestData) string {
@@ -198,6 +215,63 @@ resource "azurerm_point_to_site_vpn_gateway" "test" {
 `, r.template(data), data.RandomInteger, data.RandomInteger)
 }
 
+func (r PointToSiteVPNGatewayResource) configurationPolicyGroups(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vpn_server_configuration_policy_group" "default" {
+  name                        = "acctestVPNPolicyGroup-default-%d"
+  vpn_server_configuration_id = azurerm_vpn_server_configuration.test.id
+  is_default                  = true
+
+  policy {
+    name  = "policy1"
+    type  = "CertificateGroupId"
+    value = "contoso.com"
+  }
+}
+
+resource "azurerm_vpn_server_configuration_policy_group" "engineering" {
+  name                        = "acctestVPNPolicyGroup-engineering-%d"
+  vpn_server_configuration_id = azurerm_vpn_server_configuration.test.id
+  priority                    = 1
+
+  policy {
+    name  = "policy1"
+    type  = "CertificateGroupId"
+    value = "engineering.contoso.com"
+  }
+}
+
+resource "azurerm_point_to_site_vpn_gateway" "test" {
+  name                        = "acctestp2sVPNG-%d"
+  location                    = azurerm_resource_group.test.location
+  resource_group_name         = azurerm_resource_group.test.name
+  virtual_hub_id              = azurerm_virtual_hub.test.id
+  vpn_server_configuration_id = azurerm_vpn_server_configuration.test.id
+  scale_unit                  = 1
+
+  connection_configuration {
+    name                           = "default"
+    configuration_policy_group_ids = [azurerm_vpn_server_configuration_policy_group.default.id]
+
+    vpn_client_address_pool {
+      address_prefixes = ["172.100.0.0/16"]
+    }
+  }
+
+  connection_configuration {
+    name                           = "engineering"
+    configuration_policy_group_ids = [azurerm_vpn_server_configuration_policy_group.engineering.id]
+
+    vpn_client_address_pool {
+      address_prefixes = ["172.101.0.0/16"]
+    }
+  }
+}
+`, r.template(data), data.RandomInteger, data.RandomInteger, data.RandomInteger)
+}
+
 func (r PointToSiteVPNGatewayResource) requiresImport(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
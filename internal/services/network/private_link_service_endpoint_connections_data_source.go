@@ -101,7 +101,7 @@ func dataSourcePrivateLinkServiceEndpointConnectionsRead(d *pluginsdk.ResourceDa
 
 	d.Set("service_id", serviceId)
 	d.Set("service_name", id.Name)
-	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("resource_group_name", azure.NormalizeResourceGroupName(id.ResourceGroup, resp.ID))
 	d.Set("location", azure.NormalizeLocation(*resp.Location))
 
 	if props := resp.PrivateLinkServiceProperties; props != nil {
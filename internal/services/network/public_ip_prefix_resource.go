@@ -266,13 +266,9 @@ func resourcePublicIpPrefixDelete(d *pluginsdk.ResourceData, meta interface{}) e
 	}
 
 	future, err := client.Delete(ctx, id.ResourceGroup, id.PublicIPPrefixeName)
-	if err != nil {
+	if err = utils.WaitForDeleteIgnore404(ctx, client.Client, future.FutureAPI, err); err != nil {
 		return fmt.Errorf("deleting %s: %+v", *id, err)
 	}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
-	}
-
 	return nil
 }
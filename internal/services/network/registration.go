@@ -24,6 +24,7 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 		"azurerm_application_gateway":                       dataSourceApplicationGateway(),
 		"azurerm_application_security_group":                dataSourceApplicationSecurityGroup(),
 		"azurerm_express_route_circuit":                     dataSourceExpressRouteCircuit(),
+		"azurerm_express_route_locations":                   dataSourceExpressRouteLocations(),
 		"azurerm_ip_group":                                  dataSourceIpGroup(),
 		"azurerm_nat_gateway":                               dataSourceNatGateway(),
 		"azurerm_network_ddos_protection_plan":              dataSourceNetworkDDoSProtectionPlan(),
@@ -40,6 +41,7 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 		"azurerm_route_table":                               dataSourceRouteTable(),
 		"azurerm_network_service_tags":                      dataSourceNetworkServiceTags(),
 		"azurerm_subnet":                                    dataSourceSubnet(),
+		"azurerm_subnet_ip_availability":                    dataSourceSubnetIPAvailability(),
 		"azurerm_virtual_hub":                               dataSourceVirtualHub(),
 		"azurerm_virtual_network_gateway":                   dataSourceVirtualNetworkGateway(),
 		"azurerm_virtual_network_gateway_connection":        dataSourceVirtualNetworkGatewayConnection(),
@@ -92,6 +94,7 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_network_watcher_flow_log":                  resourceNetworkWatcherFlowLog(),
 		"azurerm_network_watcher":                           resourceNetworkWatcher(),
 		"azurerm_route_filter":                              resourceRouteFilter(),
+		"azurerm_route_map":                                 resourceRouteMap(),
 		"azurerm_route_table":                               resourceRouteTable(),
 		"azurerm_route":                                     resourceRoute(),
 		"azurerm_virtual_hub_security_partner_provider":     resourceVirtualHubSecurityPartnerProvider(),
@@ -105,6 +108,7 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_virtual_hub_connection":                    resourceVirtualHubConnection(),
 		"azurerm_virtual_hub_ip":                            resourceVirtualHubIP(),
 		"azurerm_virtual_hub_route_table":                   resourceVirtualHubRouteTable(),
+		"azurerm_virtual_hub_routing_intent":                resourceVirtualHubRoutingIntent(),
 		"azurerm_virtual_hub_route_table_route":             resourceVirtualHubRouteTableRoute(),
 		"azurerm_virtual_network_dns_servers":               resourceVirtualNetworkDnsServers(),
 		"azurerm_virtual_network_gateway_connection":        resourceVirtualNetworkGatewayConnection(),
@@ -116,6 +120,7 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_vpn_gateway_connection":                    resourceVPNGatewayConnection(),
 		"azurerm_vpn_gateway_nat_rule":                      resourceVPNGatewayNatRule(),
 		"azurerm_vpn_server_configuration":                  resourceVPNServerConfiguration(),
+		"azurerm_vpn_server_configuration_policy_group":     resourceVPNServerConfigurationPolicyGroup(),
 		"azurerm_vpn_site":                                  resourceVpnSite(),
 		"azurerm_web_application_firewall_policy":           resourceWebApplicationFirewallPolicy(),
 	}
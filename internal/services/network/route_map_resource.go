@@ -0,0 +1,434 @@
+package network
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-07-01/routemaps"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceRouteMap() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceRouteMapCreateUpdate,
+		Read:   resourceRouteMapRead,
+		Update: resourceRouteMapCreateUpdate,
+		Delete: resourceRouteMapDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := routemaps.ParseRouteMapID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"virtual_hub_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: routemaps.ValidateVirtualHubID,
+			},
+
+			"rule": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"action": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"type": {
+										Type:     pluginsdk.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											string(routemaps.RouteMapActionTypeAdd),
+											string(routemaps.RouteMapActionTypeDrop),
+											string(routemaps.RouteMapActionTypeRemove),
+											string(routemaps.RouteMapActionTypeReplace),
+											string(routemaps.RouteMapActionTypeUnknown),
+										}, false),
+									},
+
+									"parameter": {
+										Type:     pluginsdk.TypeList,
+										Optional: true,
+										Elem: &pluginsdk.Resource{
+											Schema: map[string]*pluginsdk.Schema{
+												"as_path": {
+													Type:     pluginsdk.TypeList,
+													Optional: true,
+													Elem: &pluginsdk.Schema{
+														Type:         pluginsdk.TypeString,
+														ValidateFunc: validation.StringIsNotEmpty,
+													},
+												},
+
+												"community": {
+													Type:     pluginsdk.TypeList,
+													Optional: true,
+													Elem: &pluginsdk.Schema{
+														Type:         pluginsdk.TypeString,
+														ValidateFunc: validation.StringIsNotEmpty,
+													},
+												},
+
+												"route_prefix": {
+													Type:     pluginsdk.TypeList,
+													Optional: true,
+													Elem: &pluginsdk.Schema{
+														Type:         pluginsdk.TypeString,
+														ValidateFunc: validation.IsCIDR,
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+
+						"match_criterion": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"match_condition": {
+										Type:     pluginsdk.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											string(routemaps.RouteMapMatchConditionContains),
+											string(routemaps.RouteMapMatchConditionEquals),
+											string(routemaps.RouteMapMatchConditionNotContains),
+											string(routemaps.RouteMapMatchConditionNotEquals),
+											string(routemaps.RouteMapMatchConditionUnknown),
+										}, false),
+									},
+
+									"as_path": {
+										Type:     pluginsdk.TypeList,
+										Optional: true,
+										Elem: &pluginsdk.Schema{
+											Type:         pluginsdk.TypeString,
+											ValidateFunc: validation.StringIsNotEmpty,
+										},
+									},
+
+									"community": {
+										Type:     pluginsdk.TypeList,
+										Optional: true,
+										Elem: &pluginsdk.Schema{
+											Type:         pluginsdk.TypeString,
+											ValidateFunc: validation.StringIsNotEmpty,
+										},
+									},
+
+									"route_prefix": {
+										Type:     pluginsdk.TypeList,
+										Optional: true,
+										Elem: &pluginsdk.Schema{
+											Type:         pluginsdk.TypeString,
+											ValidateFunc: validation.IsCIDR,
+										},
+									},
+								},
+							},
+						},
+
+						"next_step_if_matched": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							Default:  string(routemaps.NextStepUnknown),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(routemaps.NextStepContinue),
+								string(routemaps.NextStepTerminate),
+								string(routemaps.NextStepUnknown),
+							}, false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceRouteMapCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.RouteMapsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	virtualHubId, err := routemaps.ParseVirtualHubID(d.Get("virtual_hub_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := routemaps.NewRouteMapID(virtualHubId.SubscriptionId, virtualHubId.ResourceGroupName, virtualHubId.VirtualHubName, d.Get("name").(string))
+
+	locks.ByName(id.VirtualHubName, virtualHubResourceName)
+	defer locks.UnlockByName(id.VirtualHubName, virtualHubResourceName)
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_route_map", id.ID())
+		}
+	}
+
+	parameters := routemaps.RouteMap{
+		Name: utils.String(id.RouteMapName),
+		Properties: &routemaps.RouteMapProperties{
+			Rules: expandRouteMapRules(d.Get("rule").([]interface{})),
+		},
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceRouteMapRead(d, meta)
+}
+
+func resourceRouteMapRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.RouteMapsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := routemaps.ParseRouteMapID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[INFO] %s does not exist - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.RouteMapName)
+	d.Set("virtual_hub_id", routemaps.NewVirtualHubID(id.SubscriptionId, id.ResourceGroupName, id.VirtualHubName).ID())
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			if err := d.Set("rule", flattenRouteMapRules(props.Rules)); err != nil {
+				return fmt.Errorf("setting `rule`: %+v", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceRouteMapDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.RouteMapsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := routemaps.ParseRouteMapID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.VirtualHubName, virtualHubResourceName)
+	defer locks.UnlockByName(id.VirtualHubName, virtualHubResourceName)
+
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandRouteMapRules(input []interface{}) *[]routemaps.RouteMapRule {
+	results := make([]routemaps.RouteMapRule, 0)
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		nextStep := routemaps.NextStep(v["next_step_if_matched"].(string))
+		results = append(results, routemaps.RouteMapRule{
+			Name:              utils.String(v["name"].(string)),
+			Actions:           expandRouteMapActions(v["action"].([]interface{})),
+			MatchCriteria:     expandRouteMapCriteria(v["match_criterion"].([]interface{})),
+			NextStepIfMatched: &nextStep,
+		})
+	}
+
+	return &results
+}
+
+func expandRouteMapActions(input []interface{}) *[]routemaps.Action {
+	results := make([]routemaps.Action, 0)
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		actionType := routemaps.RouteMapActionType(v["type"].(string))
+		results = append(results, routemaps.Action{
+			Type:       &actionType,
+			Parameters: expandRouteMapParameters(v["parameter"].([]interface{})),
+		})
+	}
+
+	return &results
+}
+
+func expandRouteMapParameters(input []interface{}) *[]routemaps.Parameter {
+	results := make([]routemaps.Parameter, 0)
+
+	for _, item := range input {
+		if item == nil {
+			continue
+		}
+		v := item.(map[string]interface{})
+
+		results = append(results, routemaps.Parameter{
+			AsPath:      utils.ExpandStringSlice(v["as_path"].([]interface{})),
+			Community:   utils.ExpandStringSlice(v["community"].([]interface{})),
+			RoutePrefix: utils.ExpandStringSlice(v["route_prefix"].([]interface{})),
+		})
+	}
+
+	return &results
+}
+
+func expandRouteMapCriteria(input []interface{}) *[]routemaps.Criterion {
+	results := make([]routemaps.Criterion, 0)
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		matchCondition := routemaps.RouteMapMatchCondition(v["match_condition"].(string))
+		results = append(results, routemaps.Criterion{
+			MatchCondition: &matchCondition,
+			AsPath:         utils.ExpandStringSlice(v["as_path"].([]interface{})),
+			Community:      utils.ExpandStringSlice(v["community"].([]interface{})),
+			RoutePrefix:    utils.ExpandStringSlice(v["route_prefix"].([]interface{})),
+		})
+	}
+
+	return &results
+}
+
+func flattenRouteMapRules(input *[]routemaps.RouteMapRule) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		var name string
+		if item.Name != nil {
+			name = *item.Name
+		}
+
+		var nextStep string
+		if item.NextStepIfMatched != nil {
+			nextStep = string(*item.NextStepIfMatched)
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":                 name,
+			"action":               flattenRouteMapActions(item.Actions),
+			"match_criterion":      flattenRouteMapCriteria(item.MatchCriteria),
+			"next_step_if_matched": nextStep,
+		})
+	}
+
+	return results
+}
+
+func flattenRouteMapActions(input *[]routemaps.Action) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		var actionType string
+		if item.Type != nil {
+			actionType = string(*item.Type)
+		}
+
+		parameters := make([]interface{}, 0)
+		if item.Parameters != nil {
+			for _, parameter := range *item.Parameters {
+				parameters = append(parameters, map[string]interface{}{
+					"as_path":      utils.FlattenStringSlice(parameter.AsPath),
+					"community":    utils.FlattenStringSlice(parameter.Community),
+					"route_prefix": utils.FlattenStringSlice(parameter.RoutePrefix),
+				})
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"type":      actionType,
+			"parameter": parameters,
+		})
+	}
+
+	return results
+}
+
+func flattenRouteMapCriteria(input *[]routemaps.Criterion) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		var matchCondition string
+		if item.MatchCondition != nil {
+			matchCondition = string(*item.MatchCondition)
+		}
+
+		results = append(results, map[string]interface{}{
+			"match_condition": matchCondition,
+			"as_path":         utils.FlattenStringSlice(item.AsPath),
+			"community":       utils.FlattenStringSlice(item.Community),
+			"route_prefix":    utils.FlattenStringSlice(item.RoutePrefix),
+		})
+	}
+
+	return results
+}
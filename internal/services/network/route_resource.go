@@ -179,13 +179,9 @@ func resourceRouteDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 	defer locks.UnlockByName(id.RouteTableName, routeTableResourceName)
 
 	future, err := client.Delete(ctx, id.ResourceGroup, id.RouteTableName, id.Name)
-	if err != nil {
+	if err = utils.WaitForDeleteIgnore404(ctx, client.Client, future.FutureAPI, err); err != nil {
 		return fmt.Errorf("deleting %s: %+v", *id, err)
 	}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
-	}
-
 	return nil
 }
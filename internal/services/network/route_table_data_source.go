@@ -92,7 +92,7 @@ func dataSourceRouteTableRead(d *pluginsdk.ResourceData, meta interface{}) error
 	d.SetId(*resp.ID)
 
 	d.Set("name", name)
-	d.Set("resource_group_name", resourceGroup)
+	d.Set("resource_group_name", azure.NormalizeResourceGroupName(resourceGroup, resp.ID))
 	if location := resp.Location; location != nil {
 		d.Set("location", azure.NormalizeLocation(*location))
 	}
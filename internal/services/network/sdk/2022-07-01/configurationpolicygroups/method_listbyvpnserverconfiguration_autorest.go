@@ -0,0 +1,183 @@
+package configurationpolicygroups
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type ListByVpnServerConfigurationResponse struct {
+	HttpResponse *http.Response
+	Model        *[]VpnServerConfigurationPolicyGroup
+
+	nextLink     *string
+	nextPageFunc func(ctx context.Context, nextLink string) (ListByVpnServerConfigurationResponse, error)
+}
+
+type ListByVpnServerConfigurationCompleteResult struct {
+	Items []VpnServerConfigurationPolicyGroup
+}
+
+func (r ListByVpnServerConfigurationResponse) HasMore() bool {
+	return r.nextLink != nil
+}
+
+func (r ListByVpnServerConfigurationResponse) LoadMore(ctx context.Context) (resp ListByVpnServerConfigurationResponse, err error) {
+	if !r.HasMore() {
+		err = fmt.Errorf("no more pages returned")
+		return
+	}
+	return r.nextPageFunc(ctx, *r.nextLink)
+}
+
+// ListByVpnServerConfiguration ...
+func (c ConfigurationPolicyGroupsClient) ListByVpnServerConfiguration(ctx context.Context, id VpnServerConfigurationId) (resp ListByVpnServerConfigurationResponse, err error) {
+	req, err := c.preparerForListByVpnServerConfiguration(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "configurationpolicygroups.ConfigurationPolicyGroupsClient", "ListByVpnServerConfiguration", nil, "Failure preparing request")
+		return
+	}
+
+	resp.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "configurationpolicygroups.ConfigurationPolicyGroupsClient", "ListByVpnServerConfiguration", resp.HttpResponse, "Failure sending request")
+		return
+	}
+
+	resp, err = c.responderForListByVpnServerConfiguration(resp.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "configurationpolicygroups.ConfigurationPolicyGroupsClient", "ListByVpnServerConfiguration", resp.HttpResponse, "Failure responding to request")
+		return
+	}
+	return
+}
+
+// ListByVpnServerConfigurationComplete retrieves all of the results into a single object
+func (c ConfigurationPolicyGroupsClient) ListByVpnServerConfigurationComplete(ctx context.Context, id VpnServerConfigurationId) (ListByVpnServerConfigurationCompleteResult, error) {
+	return c.ListByVpnServerConfigurationCompleteMatchingPredicate(ctx, id, VpnServerConfigurationPolicyGroupPredicate{})
+}
+
+// ListByVpnServerConfigurationCompleteMatchingPredicate retrieves all of the results and then applied the predicate
+func (c ConfigurationPolicyGroupsClient) ListByVpnServerConfigurationCompleteMatchingPredicate(ctx context.Context, id VpnServerConfigurationId, predicate VpnServerConfigurationPolicyGroupPredicate) (resp ListByVpnServerConfigurationCompleteResult, err error) {
+	items := make([]VpnServerConfigurationPolicyGroup, 0)
+
+	page, err := c.ListByVpnServerConfiguration(ctx, id)
+	if err != nil {
+		err = fmt.Errorf("loading the initial page: %+v", err)
+		return
+	}
+	if page.Model != nil {
+		for _, v := range *page.Model {
+			if predicate.Matches(v) {
+				items = append(items, v)
+			}
+		}
+	}
+
+	for page.HasMore() {
+		page, err = page.LoadMore(ctx)
+		if err != nil {
+			err = fmt.Errorf("loading the next page: %+v", err)
+			return
+		}
+
+		if page.Model != nil {
+			for _, v := range *page.Model {
+				if predicate.Matches(v) {
+					items = append(items, v)
+				}
+			}
+		}
+	}
+
+	out := ListByVpnServerConfigurationCompleteResult{
+		Items: items,
+	}
+	return out, nil
+}
+
+// preparerForListByVpnServerConfiguration prepares the ListByVpnServerConfiguration request.
+func (c ConfigurationPolicyGroupsClient) preparerForListByVpnServerConfiguration(ctx context.Context, id VpnServerConfigurationId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(fmt.Sprintf("%s/configurationPolicyGroups", id.ID())),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// preparerForListByVpnServerConfigurationWithNextLink prepares the ListByVpnServerConfiguration request with the given nextLink token.
+func (c ConfigurationPolicyGroupsClient) preparerForListByVpnServerConfigurationWithNextLink(ctx context.Context, nextLink string) (*http.Request, error) {
+	uri, err := url.Parse(nextLink)
+	if err != nil {
+		return nil, fmt.Errorf("parsing nextLink %q: %+v", nextLink, err)
+	}
+	queryParameters := map[string]interface{}{}
+	for k, v := range uri.Query() {
+		if len(v) == 0 {
+			continue
+		}
+		val := v[0]
+		val = autorest.Encode("query", val)
+		queryParameters[k] = val
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(uri.Path),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForListByVpnServerConfiguration handles the response to the ListByVpnServerConfiguration request. The method always
+// closes the http.Response Body.
+func (c ConfigurationPolicyGroupsClient) responderForListByVpnServerConfiguration(resp *http.Response) (result ListByVpnServerConfigurationResponse, err error) {
+	type page struct {
+		Values   []VpnServerConfigurationPolicyGroup `json:"value"`
+		NextLink *string                             `json:"nextLink"`
+	}
+	var respObj page
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&respObj),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	result.Model = &respObj.Values
+	result.nextLink = respObj.NextLink
+	if respObj.NextLink != nil {
+		result.nextPageFunc = func(ctx context.Context, nextLink string) (result ListByVpnServerConfigurationResponse, err error) {
+			req, err := c.preparerForListByVpnServerConfigurationWithNextLink(ctx, nextLink)
+			if err != nil {
+				err = autorest.NewErrorWithError(err, "configurationpolicygroups.ConfigurationPolicyGroupsClient", "ListByVpnServerConfiguration", nil, "Failure preparing request")
+				return
+			}
+
+			result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+			if err != nil {
+				err = autorest.NewErrorWithError(err, "configurationpolicygroups.ConfigurationPolicyGroupsClient", "ListByVpnServerConfiguration", result.HttpResponse, "Failure sending request")
+				return
+			}
+
+			result, err = c.responderForListByVpnServerConfiguration(result.HttpResponse)
+			if err != nil {
+				err = autorest.NewErrorWithError(err, "configurationpolicygroups.ConfigurationPolicyGroupsClient", "ListByVpnServerConfiguration", result.HttpResponse, "Failure responding to request")
+				return
+			}
+
+			return
+		}
+	}
+	return
+}
@@ -0,0 +1,5 @@
+package configurationpolicygroups
+
+type SubResource struct {
+	Id *string `json:"id,omitempty"`
+}
@@ -0,0 +1,7 @@
+package configurationpolicygroups
+
+type VpnServerConfigurationPolicyGroupMember struct {
+	AttributeType  *VpnPolicyMemberAttributeType `json:"attributeType,omitempty"`
+	AttributeValue *string                       `json:"attributeValue,omitempty"`
+	Name           *string                       `json:"name,omitempty"`
+}
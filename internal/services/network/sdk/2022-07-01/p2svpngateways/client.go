@@ -0,0 +1,15 @@
+package p2svpngateways
+
+import "github.com/Azure/go-autorest/autorest"
+
+type P2sVpnGatewaysClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewP2sVpnGatewaysClientWithBaseURI(endpoint string) P2sVpnGatewaysClient {
+	return P2sVpnGatewaysClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,124 @@
+package p2svpngateways
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = P2sVpnGatewayId{}
+
+// P2sVpnGatewayId is a struct representing the Resource ID for a P2s Vpn Gateway
+type P2sVpnGatewayId struct {
+	SubscriptionId    string
+	ResourceGroupName string
+	P2sVpnGatewayName string
+}
+
+// NewP2sVpnGatewayID returns a new P2sVpnGatewayId struct
+func NewP2sVpnGatewayID(subscriptionId string, resourceGroupName string, p2sVpnGatewayName string) P2sVpnGatewayId {
+	return P2sVpnGatewayId{
+		SubscriptionId:    subscriptionId,
+		ResourceGroupName: resourceGroupName,
+		P2sVpnGatewayName: p2sVpnGatewayName,
+	}
+}
+
+// ParseP2sVpnGatewayID parses 'input' into a P2sVpnGatewayId
+func ParseP2sVpnGatewayID(input string) (*P2sVpnGatewayId, error) {
+	parser := resourceids.NewParserFromResourceIdType(P2sVpnGatewayId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := P2sVpnGatewayId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.P2sVpnGatewayName, ok = parsed.Parsed["p2sVpnGatewayName"]; !ok {
+		return nil, fmt.Errorf("the segment 'p2sVpnGatewayName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseP2sVpnGatewayIDInsensitively parses 'input' case-insensitively into a P2sVpnGatewayId
+// note: this method should only be used for API response data and not user input
+func ParseP2sVpnGatewayIDInsensitively(input string) (*P2sVpnGatewayId, error) {
+	parser := resourceids.NewParserFromResourceIdType(P2sVpnGatewayId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := P2sVpnGatewayId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.P2sVpnGatewayName, ok = parsed.Parsed["p2sVpnGatewayName"]; !ok {
+		return nil, fmt.Errorf("the segment 'p2sVpnGatewayName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateP2sVpnGatewayID checks that 'input' can be parsed as a P2s Vpn Gateway ID
+func ValidateP2sVpnGatewayID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseP2sVpnGatewayID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted P2s Vpn Gateway ID
+func (id P2sVpnGatewayId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/p2sVpnGateways/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.P2sVpnGatewayName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this P2s Vpn Gateway ID
+func (id P2sVpnGatewayId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftNetwork", "Microsoft.Network", "Microsoft.Network"),
+		resourceids.StaticSegment("staticP2sVpnGateways", "p2sVpnGateways", "p2sVpnGateways"),
+		resourceids.UserSpecifiedSegment("p2sVpnGatewayName", "p2sVpnGatewayValue"),
+	}
+}
+
+// String returns a human-readable description of this P2s Vpn Gateway ID
+func (id P2sVpnGatewayId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("P2s Vpn Gateway Name: %q", id.P2sVpnGatewayName),
+	}
+	return fmt.Sprintf("P2s Vpn Gateway (%s)", strings.Join(components, "\n"))
+}
@@ -0,0 +1,5 @@
+package p2svpngateways
+
+type AddressSpace struct {
+	AddressPrefixes *[]string `json:"addressPrefixes,omitempty"`
+}
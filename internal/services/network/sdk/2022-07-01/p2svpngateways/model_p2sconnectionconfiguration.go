@@ -0,0 +1,8 @@
+package p2svpngateways
+
+type P2SConnectionConfiguration struct {
+	Etag       *string                               `json:"etag,omitempty"`
+	Id         *string                               `json:"id,omitempty"`
+	Name       *string                               `json:"name,omitempty"`
+	Properties *P2SConnectionConfigurationProperties `json:"properties,omitempty"`
+}
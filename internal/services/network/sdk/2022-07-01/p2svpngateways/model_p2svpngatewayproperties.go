@@ -0,0 +1,11 @@
+package p2svpngateways
+
+type P2SVpnGatewayProperties struct {
+	CustomDnsServers            *[]string                     `json:"customDnsServers,omitempty"`
+	IsRoutingPreferenceInternet *bool                         `json:"isRoutingPreferenceInternet,omitempty"`
+	P2SConnectionConfigurations *[]P2SConnectionConfiguration `json:"p2SConnectionConfigurations,omitempty"`
+	ProvisioningState           *ProvisioningState            `json:"provisioningState,omitempty"`
+	VirtualHub                  *SubResource                  `json:"virtualHub,omitempty"`
+	VpnGatewayScaleUnit         *int64                        `json:"vpnGatewayScaleUnit,omitempty"`
+	VpnServerConfiguration      *SubResource                  `json:"vpnServerConfiguration,omitempty"`
+}
@@ -0,0 +1,15 @@
+package routemaps
+
+import "github.com/Azure/go-autorest/autorest"
+
+type RouteMapsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewRouteMapsClientWithBaseURI(endpoint string) RouteMapsClient {
+	return RouteMapsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,7 @@
+package routemaps
+
+type Parameter struct {
+	AsPath      *[]string `json:"asPath,omitempty"`
+	Community   *[]string `json:"community,omitempty"`
+	RoutePrefix *[]string `json:"routePrefix,omitempty"`
+}
@@ -0,0 +1,9 @@
+package routemaps
+
+type RouteMap struct {
+	Etag       *string             `json:"etag,omitempty"`
+	Id         *string             `json:"id,omitempty"`
+	Name       *string             `json:"name,omitempty"`
+	Properties *RouteMapProperties `json:"properties,omitempty"`
+	Type       *string             `json:"type,omitempty"`
+}
@@ -0,0 +1,444 @@
+package webapplicationfirewallpolicies
+
+import "strings"
+
+type ActionType string
+
+const (
+	ActionTypeAllow          ActionType = "Allow"
+	ActionTypeAnomalyScoring ActionType = "AnomalyScoring"
+	ActionTypeBlock          ActionType = "Block"
+	ActionTypeLog            ActionType = "Log"
+)
+
+func PossibleValuesForActionType() []string {
+	return []string{
+		string(ActionTypeAllow),
+		string(ActionTypeAnomalyScoring),
+		string(ActionTypeBlock),
+		string(ActionTypeLog),
+	}
+}
+
+func parseActionType(input string) (*ActionType, error) {
+	vals := map[string]ActionType{
+		"allow":          ActionTypeAllow,
+		"anomalyscoring": ActionTypeAnomalyScoring,
+		"block":          ActionTypeBlock,
+		"log":            ActionTypeLog,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ActionType(input)
+	return &out, nil
+}
+
+type ManagedRuleEnabledState string
+
+const (
+	ManagedRuleEnabledStateDisabled ManagedRuleEnabledState = "Disabled"
+	ManagedRuleEnabledStateEnabled  ManagedRuleEnabledState = "Enabled"
+)
+
+func PossibleValuesForManagedRuleEnabledState() []string {
+	return []string{
+		string(ManagedRuleEnabledStateDisabled),
+		string(ManagedRuleEnabledStateEnabled),
+	}
+}
+
+func parseManagedRuleEnabledState(input string) (*ManagedRuleEnabledState, error) {
+	vals := map[string]ManagedRuleEnabledState{
+		"disabled": ManagedRuleEnabledStateDisabled,
+		"enabled":  ManagedRuleEnabledStateEnabled,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ManagedRuleEnabledState(input)
+	return &out, nil
+}
+
+type OwaspCrsExclusionEntryMatchVariable string
+
+const (
+	OwaspCrsExclusionEntryMatchVariableRequestArgKeys      OwaspCrsExclusionEntryMatchVariable = "RequestArgKeys"
+	OwaspCrsExclusionEntryMatchVariableRequestArgNames     OwaspCrsExclusionEntryMatchVariable = "RequestArgNames"
+	OwaspCrsExclusionEntryMatchVariableRequestArgValues    OwaspCrsExclusionEntryMatchVariable = "RequestArgValues"
+	OwaspCrsExclusionEntryMatchVariableRequestCookieKeys   OwaspCrsExclusionEntryMatchVariable = "RequestCookieKeys"
+	OwaspCrsExclusionEntryMatchVariableRequestCookieNames  OwaspCrsExclusionEntryMatchVariable = "RequestCookieNames"
+	OwaspCrsExclusionEntryMatchVariableRequestCookieValues OwaspCrsExclusionEntryMatchVariable = "RequestCookieValues"
+	OwaspCrsExclusionEntryMatchVariableRequestHeaderKeys   OwaspCrsExclusionEntryMatchVariable = "RequestHeaderKeys"
+	OwaspCrsExclusionEntryMatchVariableRequestHeaderNames  OwaspCrsExclusionEntryMatchVariable = "RequestHeaderNames"
+	OwaspCrsExclusionEntryMatchVariableRequestHeaderValues OwaspCrsExclusionEntryMatchVariable = "RequestHeaderValues"
+)
+
+func PossibleValuesForOwaspCrsExclusionEntryMatchVariable() []string {
+	return []string{
+		string(OwaspCrsExclusionEntryMatchVariableRequestArgKeys),
+		string(OwaspCrsExclusionEntryMatchVariableRequestArgNames),
+		string(OwaspCrsExclusionEntryMatchVariableRequestArgValues),
+		string(OwaspCrsExclusionEntryMatchVariableRequestCookieKeys),
+		string(OwaspCrsExclusionEntryMatchVariableRequestCookieNames),
+		string(OwaspCrsExclusionEntryMatchVariableRequestCookieValues),
+		string(OwaspCrsExclusionEntryMatchVariableRequestHeaderKeys),
+		string(OwaspCrsExclusionEntryMatchVariableRequestHeaderNames),
+		string(OwaspCrsExclusionEntryMatchVariableRequestHeaderValues),
+	}
+}
+
+func parseOwaspCrsExclusionEntryMatchVariable(input string) (*OwaspCrsExclusionEntryMatchVariable, error) {
+	vals := map[string]OwaspCrsExclusionEntryMatchVariable{
+		"requestargkeys":      OwaspCrsExclusionEntryMatchVariableRequestArgKeys,
+		"requestargnames":     OwaspCrsExclusionEntryMatchVariableRequestArgNames,
+		"requestargvalues":    OwaspCrsExclusionEntryMatchVariableRequestArgValues,
+		"requestcookiekeys":   OwaspCrsExclusionEntryMatchVariableRequestCookieKeys,
+		"requestcookienames":  OwaspCrsExclusionEntryMatchVariableRequestCookieNames,
+		"requestcookievalues": OwaspCrsExclusionEntryMatchVariableRequestCookieValues,
+		"requestheaderkeys":   OwaspCrsExclusionEntryMatchVariableRequestHeaderKeys,
+		"requestheadernames":  OwaspCrsExclusionEntryMatchVariableRequestHeaderNames,
+		"requestheadervalues": OwaspCrsExclusionEntryMatchVariableRequestHeaderValues,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := OwaspCrsExclusionEntryMatchVariable(input)
+	return &out, nil
+}
+
+type OwaspCrsExclusionEntrySelectorMatchOperator string
+
+const (
+	OwaspCrsExclusionEntrySelectorMatchOperatorContains   OwaspCrsExclusionEntrySelectorMatchOperator = "Contains"
+	OwaspCrsExclusionEntrySelectorMatchOperatorEndsWith   OwaspCrsExclusionEntrySelectorMatchOperator = "EndsWith"
+	OwaspCrsExclusionEntrySelectorMatchOperatorEquals     OwaspCrsExclusionEntrySelectorMatchOperator = "Equals"
+	OwaspCrsExclusionEntrySelectorMatchOperatorEqualsAny  OwaspCrsExclusionEntrySelectorMatchOperator = "EqualsAny"
+	OwaspCrsExclusionEntrySelectorMatchOperatorStartsWith OwaspCrsExclusionEntrySelectorMatchOperator = "StartsWith"
+)
+
+func PossibleValuesForOwaspCrsExclusionEntrySelectorMatchOperator() []string {
+	return []string{
+		string(OwaspCrsExclusionEntrySelectorMatchOperatorContains),
+		string(OwaspCrsExclusionEntrySelectorMatchOperatorEndsWith),
+		string(OwaspCrsExclusionEntrySelectorMatchOperatorEquals),
+		string(OwaspCrsExclusionEntrySelectorMatchOperatorEqualsAny),
+		string(OwaspCrsExclusionEntrySelectorMatchOperatorStartsWith),
+	}
+}
+
+func parseOwaspCrsExclusionEntrySelectorMatchOperator(input string) (*OwaspCrsExclusionEntrySelectorMatchOperator, error) {
+	vals := map[string]OwaspCrsExclusionEntrySelectorMatchOperator{
+		"contains":   OwaspCrsExclusionEntrySelectorMatchOperatorContains,
+		"endswith":   OwaspCrsExclusionEntrySelectorMatchOperatorEndsWith,
+		"equals":     OwaspCrsExclusionEntrySelectorMatchOperatorEquals,
+		"equalsany":  OwaspCrsExclusionEntrySelectorMatchOperatorEqualsAny,
+		"startswith": OwaspCrsExclusionEntrySelectorMatchOperatorStartsWith,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := OwaspCrsExclusionEntrySelectorMatchOperator(input)
+	return &out, nil
+}
+
+type ProvisioningState string
+
+const (
+	ProvisioningStateDeleting  ProvisioningState = "Deleting"
+	ProvisioningStateFailed    ProvisioningState = "Failed"
+	ProvisioningStateSucceeded ProvisioningState = "Succeeded"
+	ProvisioningStateUpdating  ProvisioningState = "Updating"
+)
+
+func PossibleValuesForProvisioningState() []string {
+	return []string{
+		string(ProvisioningStateDeleting),
+		string(ProvisioningStateFailed),
+		string(ProvisioningStateSucceeded),
+		string(ProvisioningStateUpdating),
+	}
+}
+
+func parseProvisioningState(input string) (*ProvisioningState, error) {
+	vals := map[string]ProvisioningState{
+		"deleting":  ProvisioningStateDeleting,
+		"failed":    ProvisioningStateFailed,
+		"succeeded": ProvisioningStateSucceeded,
+		"updating":  ProvisioningStateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ProvisioningState(input)
+	return &out, nil
+}
+
+type WebApplicationFirewallAction string
+
+const (
+	WebApplicationFirewallActionAllow WebApplicationFirewallAction = "Allow"
+	WebApplicationFirewallActionBlock WebApplicationFirewallAction = "Block"
+	WebApplicationFirewallActionLog   WebApplicationFirewallAction = "Log"
+)
+
+func PossibleValuesForWebApplicationFirewallAction() []string {
+	return []string{
+		string(WebApplicationFirewallActionAllow),
+		string(WebApplicationFirewallActionBlock),
+		string(WebApplicationFirewallActionLog),
+	}
+}
+
+func parseWebApplicationFirewallAction(input string) (*WebApplicationFirewallAction, error) {
+	vals := map[string]WebApplicationFirewallAction{
+		"allow": WebApplicationFirewallActionAllow,
+		"block": WebApplicationFirewallActionBlock,
+		"log":   WebApplicationFirewallActionLog,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := WebApplicationFirewallAction(input)
+	return &out, nil
+}
+
+type WebApplicationFirewallEnabledState string
+
+const (
+	WebApplicationFirewallEnabledStateDisabled WebApplicationFirewallEnabledState = "Disabled"
+	WebApplicationFirewallEnabledStateEnabled  WebApplicationFirewallEnabledState = "Enabled"
+)
+
+func PossibleValuesForWebApplicationFirewallEnabledState() []string {
+	return []string{
+		string(WebApplicationFirewallEnabledStateDisabled),
+		string(WebApplicationFirewallEnabledStateEnabled),
+	}
+}
+
+func parseWebApplicationFirewallEnabledState(input string) (*WebApplicationFirewallEnabledState, error) {
+	vals := map[string]WebApplicationFirewallEnabledState{
+		"disabled": WebApplicationFirewallEnabledStateDisabled,
+		"enabled":  WebApplicationFirewallEnabledStateEnabled,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := WebApplicationFirewallEnabledState(input)
+	return &out, nil
+}
+
+type WebApplicationFirewallMatchVariable string
+
+const (
+	WebApplicationFirewallMatchVariablePostArgs       WebApplicationFirewallMatchVariable = "PostArgs"
+	WebApplicationFirewallMatchVariableQueryString    WebApplicationFirewallMatchVariable = "QueryString"
+	WebApplicationFirewallMatchVariableRemoteAddr     WebApplicationFirewallMatchVariable = "RemoteAddr"
+	WebApplicationFirewallMatchVariableRequestBody    WebApplicationFirewallMatchVariable = "RequestBody"
+	WebApplicationFirewallMatchVariableRequestCookies WebApplicationFirewallMatchVariable = "RequestCookies"
+	WebApplicationFirewallMatchVariableRequestHeaders WebApplicationFirewallMatchVariable = "RequestHeaders"
+	WebApplicationFirewallMatchVariableRequestMethod  WebApplicationFirewallMatchVariable = "RequestMethod"
+	WebApplicationFirewallMatchVariableRequestUri     WebApplicationFirewallMatchVariable = "RequestUri"
+)
+
+func PossibleValuesForWebApplicationFirewallMatchVariable() []string {
+	return []string{
+		string(WebApplicationFirewallMatchVariablePostArgs),
+		string(WebApplicationFirewallMatchVariableQueryString),
+		string(WebApplicationFirewallMatchVariableRemoteAddr),
+		string(WebApplicationFirewallMatchVariableRequestBody),
+		string(WebApplicationFirewallMatchVariableRequestCookies),
+		string(WebApplicationFirewallMatchVariableRequestHeaders),
+		string(WebApplicationFirewallMatchVariableRequestMethod),
+		string(WebApplicationFirewallMatchVariableRequestUri),
+	}
+}
+
+func parseWebApplicationFirewallMatchVariable(input string) (*WebApplicationFirewallMatchVariable, error) {
+	vals := map[string]WebApplicationFirewallMatchVariable{
+		"postargs":       WebApplicationFirewallMatchVariablePostArgs,
+		"querystring":    WebApplicationFirewallMatchVariableQueryString,
+		"remoteaddr":     WebApplicationFirewallMatchVariableRemoteAddr,
+		"requestbody":    WebApplicationFirewallMatchVariableRequestBody,
+		"requestcookies": WebApplicationFirewallMatchVariableRequestCookies,
+		"requestheaders": WebApplicationFirewallMatchVariableRequestHeaders,
+		"requestmethod":  WebApplicationFirewallMatchVariableRequestMethod,
+		"requesturi":     WebApplicationFirewallMatchVariableRequestUri,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := WebApplicationFirewallMatchVariable(input)
+	return &out, nil
+}
+
+type WebApplicationFirewallMode string
+
+const (
+	WebApplicationFirewallModeDetection  WebApplicationFirewallMode = "Detection"
+	WebApplicationFirewallModePrevention WebApplicationFirewallMode = "Prevention"
+)
+
+func PossibleValuesForWebApplicationFirewallMode() []string {
+	return []string{
+		string(WebApplicationFirewallModeDetection),
+		string(WebApplicationFirewallModePrevention),
+	}
+}
+
+func parseWebApplicationFirewallMode(input string) (*WebApplicationFirewallMode, error) {
+	vals := map[string]WebApplicationFirewallMode{
+		"detection":  WebApplicationFirewallModeDetection,
+		"prevention": WebApplicationFirewallModePrevention,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := WebApplicationFirewallMode(input)
+	return &out, nil
+}
+
+type WebApplicationFirewallOperator string
+
+const (
+	WebApplicationFirewallOperatorAny                WebApplicationFirewallOperator = "Any"
+	WebApplicationFirewallOperatorBeginsWith         WebApplicationFirewallOperator = "BeginsWith"
+	WebApplicationFirewallOperatorContains           WebApplicationFirewallOperator = "Contains"
+	WebApplicationFirewallOperatorEndsWith           WebApplicationFirewallOperator = "EndsWith"
+	WebApplicationFirewallOperatorEqual              WebApplicationFirewallOperator = "Equal"
+	WebApplicationFirewallOperatorGeoMatch           WebApplicationFirewallOperator = "GeoMatch"
+	WebApplicationFirewallOperatorGreaterThan        WebApplicationFirewallOperator = "GreaterThan"
+	WebApplicationFirewallOperatorGreaterThanOrEqual WebApplicationFirewallOperator = "GreaterThanOrEqual"
+	WebApplicationFirewallOperatorIPMatch            WebApplicationFirewallOperator = "IPMatch"
+	WebApplicationFirewallOperatorLessThan           WebApplicationFirewallOperator = "LessThan"
+	WebApplicationFirewallOperatorLessThanOrEqual    WebApplicationFirewallOperator = "LessThanOrEqual"
+	WebApplicationFirewallOperatorRegex              WebApplicationFirewallOperator = "Regex"
+)
+
+func PossibleValuesForWebApplicationFirewallOperator() []string {
+	return []string{
+		string(WebApplicationFirewallOperatorAny),
+		string(WebApplicationFirewallOperatorBeginsWith),
+		string(WebApplicationFirewallOperatorContains),
+		string(WebApplicationFirewallOperatorEndsWith),
+		string(WebApplicationFirewallOperatorEqual),
+		string(WebApplicationFirewallOperatorGeoMatch),
+		string(WebApplicationFirewallOperatorGreaterThan),
+		string(WebApplicationFirewallOperatorGreaterThanOrEqual),
+		string(WebApplicationFirewallOperatorIPMatch),
+		string(WebApplicationFirewallOperatorLessThan),
+		string(WebApplicationFirewallOperatorLessThanOrEqual),
+		string(WebApplicationFirewallOperatorRegex),
+	}
+}
+
+func parseWebApplicationFirewallOperator(input string) (*WebApplicationFirewallOperator, error) {
+	vals := map[string]WebApplicationFirewallOperator{
+		"any":                WebApplicationFirewallOperatorAny,
+		"beginswith":         WebApplicationFirewallOperatorBeginsWith,
+		"contains":           WebApplicationFirewallOperatorContains,
+		"endswith":           WebApplicationFirewallOperatorEndsWith,
+		"equal":              WebApplicationFirewallOperatorEqual,
+		"geomatch":           WebApplicationFirewallOperatorGeoMatch,
+		"greaterthan":        WebApplicationFirewallOperatorGreaterThan,
+		"greaterthanorequal": WebApplicationFirewallOperatorGreaterThanOrEqual,
+		"ipmatch":            WebApplicationFirewallOperatorIPMatch,
+		"lessthan":           WebApplicationFirewallOperatorLessThan,
+		"lessthanorequal":    WebApplicationFirewallOperatorLessThanOrEqual,
+		"regex":              WebApplicationFirewallOperatorRegex,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := WebApplicationFirewallOperator(input)
+	return &out, nil
+}
+
+type WebApplicationFirewallRuleType string
+
+const (
+	WebApplicationFirewallRuleTypeInvalid   WebApplicationFirewallRuleType = "Invalid"
+	WebApplicationFirewallRuleTypeMatchRule WebApplicationFirewallRuleType = "MatchRule"
+)
+
+func PossibleValuesForWebApplicationFirewallRuleType() []string {
+	return []string{
+		string(WebApplicationFirewallRuleTypeInvalid),
+		string(WebApplicationFirewallRuleTypeMatchRule),
+	}
+}
+
+func parseWebApplicationFirewallRuleType(input string) (*WebApplicationFirewallRuleType, error) {
+	vals := map[string]WebApplicationFirewallRuleType{
+		"invalid":   WebApplicationFirewallRuleTypeInvalid,
+		"matchrule": WebApplicationFirewallRuleTypeMatchRule,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := WebApplicationFirewallRuleType(input)
+	return &out, nil
+}
+
+type WebApplicationFirewallTransform string
+
+const (
+	WebApplicationFirewallTransformHtmlEntityDecode WebApplicationFirewallTransform = "HtmlEntityDecode"
+	WebApplicationFirewallTransformLowercase        WebApplicationFirewallTransform = "Lowercase"
+	WebApplicationFirewallTransformRemoveNulls      WebApplicationFirewallTransform = "RemoveNulls"
+	WebApplicationFirewallTransformTrim             WebApplicationFirewallTransform = "Trim"
+	WebApplicationFirewallTransformUrlDecode        WebApplicationFirewallTransform = "UrlDecode"
+	WebApplicationFirewallTransformUrlEncode        WebApplicationFirewallTransform = "UrlEncode"
+)
+
+func PossibleValuesForWebApplicationFirewallTransform() []string {
+	return []string{
+		string(WebApplicationFirewallTransformHtmlEntityDecode),
+		string(WebApplicationFirewallTransformLowercase),
+		string(WebApplicationFirewallTransformRemoveNulls),
+		string(WebApplicationFirewallTransformTrim),
+		string(WebApplicationFirewallTransformUrlDecode),
+		string(WebApplicationFirewallTransformUrlEncode),
+	}
+}
+
+func parseWebApplicationFirewallTransform(input string) (*WebApplicationFirewallTransform, error) {
+	vals := map[string]WebApplicationFirewallTransform{
+		"htmlentitydecode": WebApplicationFirewallTransformHtmlEntityDecode,
+		"lowercase":        WebApplicationFirewallTransformLowercase,
+		"removenulls":      WebApplicationFirewallTransformRemoveNulls,
+		"trim":             WebApplicationFirewallTransformTrim,
+		"urldecode":        WebApplicationFirewallTransformUrlDecode,
+		"urlencode":        WebApplicationFirewallTransformUrlEncode,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := WebApplicationFirewallTransform(input)
+	return &out, nil
+}
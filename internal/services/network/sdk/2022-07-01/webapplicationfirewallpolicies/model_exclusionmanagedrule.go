@@ -0,0 +1,5 @@
+package webapplicationfirewallpolicies
+
+type ExclusionManagedRule struct {
+	RuleId string `json:"ruleId"`
+}
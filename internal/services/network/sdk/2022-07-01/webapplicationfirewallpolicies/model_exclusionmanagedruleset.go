@@ -0,0 +1,7 @@
+package webapplicationfirewallpolicies
+
+type ExclusionManagedRuleSet struct {
+	RuleGroups     *[]ExclusionManagedRuleGroup `json:"ruleGroups,omitempty"`
+	RuleSetType    string                       `json:"ruleSetType"`
+	RuleSetVersion string                       `json:"ruleSetVersion"`
+}
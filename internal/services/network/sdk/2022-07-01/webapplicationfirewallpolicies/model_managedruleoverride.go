@@ -0,0 +1,7 @@
+package webapplicationfirewallpolicies
+
+type ManagedRuleOverride struct {
+	Action *ActionType              `json:"action,omitempty"`
+	RuleId string                   `json:"ruleId"`
+	State  *ManagedRuleEnabledState `json:"state,omitempty"`
+}
@@ -0,0 +1,11 @@
+package webapplicationfirewallpolicies
+
+type WebApplicationFirewallPolicyPropertiesFormat struct {
+	CustomRules       *[]WebApplicationFirewallCustomRule `json:"customRules,omitempty"`
+	HttpListeners     *[]SubResource                      `json:"httpListeners,omitempty"`
+	ManagedRules      ManagedRulesDefinition              `json:"managedRules"`
+	PathBasedRules    *[]SubResource                      `json:"pathBasedRules,omitempty"`
+	PolicySettings    *PolicySettings                     `json:"policySettings,omitempty"`
+	ProvisioningState *ProvisioningState                  `json:"provisioningState,omitempty"`
+	ResourceState     *string                             `json:"resourceState,omitempty"`
+}
@@ -93,6 +93,7 @@ func dataSourceSubnetRead(d *pluginsdk.ResourceData, meta interface{}) error {
 		return fmt.Errorf("retrieving %s: %+v", id, err)
 	}
 
+	id.ResourceGroup = azure.NormalizeResourceGroupName(id.ResourceGroup, resp.ID)
 	d.SetId(id.ID())
 	d.Set("name", id.Name)
 	d.Set("virtual_network_name", id.VirtualNetworkName)
@@ -0,0 +1,210 @@
+package network
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// Azure reserves the first four addresses (and the last address) within each Subnet
+const subnetReservedLeadingAddresses = 4
+
+func dataSourceSubnetIPAvailability() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceSubnetIPAvailabilityRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"virtual_network_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupNameForDataSource(),
+
+			"ip_address": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsIPv4Address,
+			},
+
+			"ip_address_available": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+
+			"available_ip_addresses": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"available_ip_address_count": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+
+			"used_ip_address_count": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+
+			"total_ip_address_count": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceSubnetIPAvailabilityRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	subnetsClient := meta.(*clients.Client).Network.SubnetsClient
+	vnetClient := meta.(*clients.Client).Network.VnetClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewSubnetID(subscriptionId, d.Get("resource_group_name").(string), d.Get("virtual_network_name").(string), d.Get("name").(string))
+	subnet, err := subnetsClient.Get(ctx, id.ResourceGroup, id.VirtualNetworkName, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(subnet.Response) {
+			return fmt.Errorf("Error: %s was not found", id)
+		}
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	ipAddress := d.Get("ip_address").(string)
+	if ipAddress == "" {
+		ipAddress, err = subnetFirstUsableIPv4Address(subnet.SubnetPropertiesFormat)
+		if err != nil {
+			return fmt.Errorf("determining the first usable IP Address for %s: %+v", id, err)
+		}
+	}
+
+	availability, err := vnetClient.CheckIPAddressAvailability(ctx, id.ResourceGroup, id.VirtualNetworkName, ipAddress)
+	if err != nil {
+		return fmt.Errorf("checking the availability of IP Address %q within %s: %+v", ipAddress, id, err)
+	}
+
+	ipAddressAvailable := availability.Available != nil && *availability.Available
+	availableIPAddresses := make([]string, 0)
+	if ipAddressAvailable {
+		availableIPAddresses = append(availableIPAddresses, ipAddress)
+	}
+	if availability.AvailableIPAddresses != nil {
+		for _, v := range *availability.AvailableIPAddresses {
+			if !utils.SliceContainsValue(availableIPAddresses, v) {
+				availableIPAddresses = append(availableIPAddresses, v)
+			}
+		}
+	}
+
+	usage, err := subnetIPUsage(ctx, vnetClient, id)
+	if err != nil {
+		return err
+	}
+
+	id.ResourceGroup = azure.NormalizeResourceGroupName(id.ResourceGroup, subnet.ID)
+	d.SetId(id.ID())
+	d.Set("name", id.Name)
+	d.Set("virtual_network_name", id.VirtualNetworkName)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("ip_address", ipAddress)
+	d.Set("ip_address_available", ipAddressAvailable)
+	if err := d.Set("available_ip_addresses", availableIPAddresses); err != nil {
+		return fmt.Errorf("setting `available_ip_addresses`: %+v", err)
+	}
+
+	total, used := 0, 0
+	if usage != nil {
+		if usage.Limit != nil {
+			total = int(*usage.Limit)
+		}
+		if usage.CurrentValue != nil {
+			used = int(*usage.CurrentValue)
+		}
+	}
+	d.Set("total_ip_address_count", total)
+	d.Set("used_ip_address_count", used)
+	d.Set("available_ip_address_count", total-used)
+
+	return nil
+}
+
+// subnetIPUsage returns the IP usage for the Subnet, which is only exposed when listing the usages of the Virtual Network
+func subnetIPUsage(ctx context.Context, client *network.VirtualNetworksClient, id parse.SubnetId) (*network.VirtualNetworkUsage, error) {
+	iterator, err := client.ListUsageComplete(ctx, id.ResourceGroup, id.VirtualNetworkName)
+	if err != nil {
+		return nil, fmt.Errorf("listing usages for Virtual Network %q (Resource Group %q): %+v", id.VirtualNetworkName, id.ResourceGroup, err)
+	}
+
+	for iterator.NotDone() {
+		usage := iterator.Value()
+		if usage.ID != nil && strings.EqualFold(*usage.ID, id.ID()) {
+			return &usage, nil
+		}
+
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return nil, fmt.Errorf("listing usages for Virtual Network %q (Resource Group %q): %+v", id.VirtualNetworkName, id.ResourceGroup, err)
+		}
+	}
+
+	return nil, nil
+}
+
+func subnetFirstUsableIPv4Address(props *network.SubnetPropertiesFormat) (string, error) {
+	if props == nil {
+		return "", fmt.Errorf("`properties` was nil")
+	}
+
+	prefixes := make([]string, 0)
+	if props.AddressPrefix != nil && *props.AddressPrefix != "" {
+		prefixes = append(prefixes, *props.AddressPrefix)
+	}
+	if props.AddressPrefixes != nil {
+		prefixes = append(prefixes, *props.AddressPrefixes...)
+	}
+
+	for _, prefix := range prefixes {
+		ip, ipNet, err := net.ParseCIDR(prefix)
+		if err != nil || ip.To4() == nil {
+			continue
+		}
+
+		networkAddress := binary.BigEndian.Uint32(ipNet.IP.To4())
+		output := make(net.IP, net.IPv4len)
+		binary.BigEndian.PutUint32(output, networkAddress+subnetReservedLeadingAddresses)
+		if networkAddress+subnetReservedLeadingAddresses < networkAddress || !ipNet.Contains(output) {
+			continue
+		}
+
+		return output.String(), nil
+	}
+
+	return "", fmt.Errorf("no IPv4 Address Prefix with a usable address was found")
+}
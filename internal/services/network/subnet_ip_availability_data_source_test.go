@@ -0,0 +1,113 @@
+package network_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type SubnetIPAvailabilityDataSource struct{}
+
+func TestAccDataSourceSubnetIPAvailability_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_subnet_ip_availability", "test")
+	r := SubnetIPAvailabilityDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("ip_address").HasValue("10.0.0.4"),
+				check.That(data.ResourceName).Key("ip_address_available").HasValue("true"),
+				check.That(data.ResourceName).Key("available_ip_addresses.0").HasValue("10.0.0.4"),
+				check.That(data.ResourceName).Key("total_ip_address_count").HasValue("251"),
+				check.That(data.ResourceName).Key("used_ip_address_count").HasValue("0"),
+				check.That(data.ResourceName).Key("available_ip_address_count").HasValue("251"),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceSubnetIPAvailability_ipAddress(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_subnet_ip_availability", "test")
+	r := SubnetIPAvailabilityDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.ipAddress(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("ip_address").HasValue("10.0.0.4"),
+				check.That(data.ResourceName).Key("ip_address_available").HasValue("false"),
+				check.That(data.ResourceName).Key("available_ip_addresses.#").Exists(),
+				check.That(data.ResourceName).Key("used_ip_address_count").HasValue("1"),
+				check.That(data.ResourceName).Key("available_ip_address_count").HasValue("250"),
+			),
+		},
+	})
+}
+
+func (SubnetIPAvailabilityDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_subnet_ip_availability" "test" {
+  name                 = azurerm_subnet.test.name
+  virtual_network_name = azurerm_subnet.test.virtual_network_name
+  resource_group_name  = azurerm_subnet.test.resource_group_name
+}
+`, SubnetIPAvailabilityDataSource{}.template(data))
+}
+
+func (SubnetIPAvailabilityDataSource) ipAddress(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_interface" "test" {
+  name                = "acctestni-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  ip_configuration {
+    name                          = "primary"
+    subnet_id                     = azurerm_subnet.test.id
+    private_ip_address_allocation = "Static"
+    private_ip_address            = "10.0.0.4"
+  }
+}
+
+data "azurerm_subnet_ip_availability" "test" {
+  name                 = azurerm_subnet.test.name
+  virtual_network_name = azurerm_subnet.test.virtual_network_name
+  resource_group_name  = azurerm_subnet.test.resource_group_name
+  ip_address           = azurerm_network_interface.test.private_ip_address
+}
+`, SubnetIPAvailabilityDataSource{}.template(data), data.RandomInteger)
+}
+
+func (SubnetIPAvailabilityDataSource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctest%d-vn"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  address_space       = ["10.0.0.0/16"]
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "internal"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.0.0.0/24"]
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
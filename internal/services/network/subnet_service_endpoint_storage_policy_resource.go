@@ -178,7 +178,8 @@ func resourceSubnetServiceEndpointStoragePolicyDelete(d *pluginsdk.ResourceData,
 		return err
 	}
 
-	if _, err := client.Delete(ctx, id.ResourceGroup, id.ServiceEndpointPolicyName); err != nil {
+	future, err := client.Delete(ctx, id.ResourceGroup, id.ServiceEndpointPolicyName)
+	if err = utils.WaitForDeleteIgnore404(ctx, client.Client, future.FutureAPI, err); err != nil {
 		return fmt.Errorf("deleting Subnet Service Endpoint Storage Policy %q (Resource Group %q): %+v", id.ServiceEndpointPolicyName, id.ResourceGroup, err)
 	}
 
@@ -15,7 +15,10 @@ var ValidateWebApplicationFirewallPolicyRuleGroupName = validation.StringInSlice
 	"crs_41_xss_attacks",
 	"crs_42_tight_security",
 	"crs_45_trojans",
+	"BadBots",
 	"General",
+	"GoodBots",
+	"KnownBadBots",
 	"REQUEST-911-METHOD-ENFORCEMENT",
 	"REQUEST-913-SCANNER-DETECTION",
 	"REQUEST-920-PROTOCOL-ENFORCEMENT",
@@ -28,11 +31,13 @@ var ValidateWebApplicationFirewallPolicyRuleGroupName = validation.StringInSlice
 	"REQUEST-942-APPLICATION-ATTACK-SQLI",
 	"REQUEST-943-APPLICATION-ATTACK-SESSION-FIXATION",
 	"REQUEST-944-APPLICATION-ATTACK-JAVA",
+	"UnknownBots",
 }, false)
 
 var ValidateWebApplicationFirewallPolicyRuleSetVersion = validation.StringInSlice([]string{
 	"0.1",
 	"1.0",
+	"1.1",
 	"2.2.9",
 	"3.0",
 	"3.1",
@@ -43,3 +48,11 @@ var ValidateWebApplicationFirewallPolicyRuleSetType = validation.StringInSlice([
 	"OWASP",
 	"Microsoft_BotManagerRuleSet",
 }, false)
+
+// WebApplicationFirewallPolicyRuleSetVersions returns the versions which are available for each Managed Rule Set type
+func WebApplicationFirewallPolicyRuleSetVersions() map[string][]string {
+	return map[string][]string{
+		"OWASP":                       {"2.2.9", "3.0", "3.1", "3.2"},
+		"Microsoft_BotManagerRuleSet": {"0.1", "1.0", "1.1"},
+	}
+}
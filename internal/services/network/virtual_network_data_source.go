@@ -89,7 +89,9 @@ func dataSourceVnetRead(d *pluginsdk.ResourceData, meta interface{}) error {
 		return fmt.Errorf("retrieving %s: %+v", id, err)
 	}
 
+	id.ResourceGroup = azure.NormalizeResourceGroupName(id.ResourceGroup, resp.ID)
 	d.SetId(id.ID())
+	d.Set("resource_group_name", id.ResourceGroup)
 
 	if location := resp.Location; location != nil {
 		d.Set("location", azure.NormalizeLocation(*location))
@@ -7,7 +7,6 @@ import (
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network"
-	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	commonValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
@@ -388,24 +387,11 @@ func resourceVPNGatewayDelete(d *pluginsdk.ResourceData, meta interface{}) error
 		return err
 	}
 
-	deleteFuture, err := client.Delete(ctx, id.ResourceGroup, id.Name)
-	if err != nil {
-		if response.WasNotFound(deleteFuture.Response()) {
-			return nil
-		}
-
+	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
+	if err = utils.WaitForDeleteIgnore404(ctx, client.Client, future.FutureAPI, err); err != nil {
 		return fmt.Errorf("deleting %s: %+v", *id, err)
 	}
 
-	err = deleteFuture.WaitForCompletionRef(ctx, client.Client)
-	if err != nil {
-		if response.WasNotFound(deleteFuture.Response()) {
-			return nil
-		}
-
-		return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
-	}
-
 	return nil
 }
 
@@ -0,0 +1,252 @@
+package network
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-07-01/configurationpolicygroups"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+const vpnServerConfigurationResourceName = "azurerm_vpn_server_configuration"
+
+func resourceVPNServerConfigurationPolicyGroup() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceVPNServerConfigurationPolicyGroupCreateUpdate,
+		Read:   resourceVPNServerConfigurationPolicyGroupRead,
+		Update: resourceVPNServerConfigurationPolicyGroupCreateUpdate,
+		Delete: resourceVPNServerConfigurationPolicyGroupDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := configurationpolicygroups.ParseConfigurationPolicyGroupID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"vpn_server_configuration_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: configurationpolicygroups.ValidateVpnServerConfigurationID,
+			},
+
+			"policy": {
+				Type:     pluginsdk.TypeSet,
+				Required: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"type": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(configurationpolicygroups.VpnPolicyMemberAttributeTypeAADGroupId),
+								string(configurationpolicygroups.VpnPolicyMemberAttributeTypeCertificateGroupId),
+								string(configurationpolicygroups.VpnPolicyMemberAttributeTypeRadiusAzureGroupId),
+							}, false),
+						},
+
+						"value": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
+			"is_default": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"priority": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+		},
+	}
+}
+
+func resourceVPNServerConfigurationPolicyGroupCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ConfigurationPolicyGroupsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	vpnServerConfigurationId, err := configurationpolicygroups.ParseVpnServerConfigurationID(d.Get("vpn_server_configuration_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := configurationpolicygroups.NewConfigurationPolicyGroupID(vpnServerConfigurationId.SubscriptionId, vpnServerConfigurationId.ResourceGroupName, vpnServerConfigurationId.VpnServerConfigurationName, d.Get("name").(string))
+
+	// the Configuration Policy Groups within a VPN Server Configuration can't be modified concurrently
+	locks.ByName(id.VpnServerConfigurationName, vpnServerConfigurationResourceName)
+	defer locks.UnlockByName(id.VpnServerConfigurationName, vpnServerConfigurationResourceName)
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_vpn_server_configuration_policy_group", id.ID())
+		}
+	}
+
+	parameters := configurationpolicygroups.VpnServerConfigurationPolicyGroup{
+		Name: utils.String(id.ConfigurationPolicyGroupName),
+		Properties: &configurationpolicygroups.VpnServerConfigurationPolicyGroupProperties{
+			IsDefault:     utils.Bool(d.Get("is_default").(bool)),
+			PolicyMembers: expandVPNServerConfigurationPolicyGroupPolicyMembers(d.Get("policy").(*pluginsdk.Set).List()),
+			Priority:      utils.Int64(int64(d.Get("priority").(int))),
+		},
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceVPNServerConfigurationPolicyGroupRead(d, meta)
+}
+
+func resourceVPNServerConfigurationPolicyGroupRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ConfigurationPolicyGroupsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := configurationpolicygroups.ParseConfigurationPolicyGroupID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[INFO] %s does not exist - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.ConfigurationPolicyGroupName)
+	d.Set("vpn_server_configuration_id", configurationpolicygroups.NewVpnServerConfigurationID(id.SubscriptionId, id.ResourceGroupName, id.VpnServerConfigurationName).ID())
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			isDefault := false
+			if props.IsDefault != nil {
+				isDefault = *props.IsDefault
+			}
+			d.Set("is_default", isDefault)
+
+			priority := 0
+			if props.Priority != nil {
+				priority = int(*props.Priority)
+			}
+			d.Set("priority", priority)
+
+			if err := d.Set("policy", flattenVPNServerConfigurationPolicyGroupPolicyMembers(props.PolicyMembers)); err != nil {
+				return fmt.Errorf("setting `policy`: %+v", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceVPNServerConfigurationPolicyGroupDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ConfigurationPolicyGroupsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := configurationpolicygroups.ParseConfigurationPolicyGroupID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.VpnServerConfigurationName, vpnServerConfigurationResourceName)
+	defer locks.UnlockByName(id.VpnServerConfigurationName, vpnServerConfigurationResourceName)
+
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandVPNServerConfigurationPolicyGroupPolicyMembers(input []interface{}) *[]configurationpolicygroups.VpnServerConfigurationPolicyGroupMember {
+	results := make([]configurationpolicygroups.VpnServerConfigurationPolicyGroupMember, 0)
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+		attributeType := configurationpolicygroups.VpnPolicyMemberAttributeType(v["type"].(string))
+
+		results = append(results, configurationpolicygroups.VpnServerConfigurationPolicyGroupMember{
+			Name:           utils.String(v["name"].(string)),
+			AttributeType:  &attributeType,
+			AttributeValue: utils.String(v["value"].(string)),
+		})
+	}
+
+	return &results
+}
+
+func flattenVPNServerConfigurationPolicyGroupPolicyMembers(input *[]configurationpolicygroups.VpnServerConfigurationPolicyGroupMember) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		attributeType := ""
+		if item.AttributeType != nil {
+			attributeType = string(*item.AttributeType)
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":  utils.NormalizeNilableString(item.Name),
+			"type":  attributeType,
+			"value": utils.NormalizeNilableString(item.AttributeValue),
+		})
+	}
+
+	return results
+}
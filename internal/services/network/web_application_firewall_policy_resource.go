@@ -1,15 +1,19 @@
 package network
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-07-01/webapplicationfirewallpolicies"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -57,9 +61,9 @@ func resourceWebApplicationFirewallPolicy() *pluginsdk.Resource {
 							Type:     pluginsdk.TypeString,
 							Required: true,
 							ValidateFunc: validation.StringInSlice([]string{
-								string(network.WebApplicationFirewallActionAllow),
-								string(network.WebApplicationFirewallActionBlock),
-								string(network.WebApplicationFirewallActionLog),
+								string(webapplicationfirewallpolicies.WebApplicationFirewallActionAllow),
+								string(webapplicationfirewallpolicies.WebApplicationFirewallActionBlock),
+								string(webapplicationfirewallpolicies.WebApplicationFirewallActionLog),
 							}, false),
 						},
 						"match_conditions": {
@@ -83,14 +87,14 @@ func resourceWebApplicationFirewallPolicy() *pluginsdk.Resource {
 													Type:     pluginsdk.TypeString,
 													Required: true,
 													ValidateFunc: validation.StringInSlice([]string{
-														string(network.WebApplicationFirewallMatchVariableRemoteAddr),
-														string(network.WebApplicationFirewallMatchVariableRequestMethod),
-														string(network.WebApplicationFirewallMatchVariableQueryString),
-														string(network.WebApplicationFirewallMatchVariablePostArgs),
-														string(network.WebApplicationFirewallMatchVariableRequestURI),
-														string(network.WebApplicationFirewallMatchVariableRequestHeaders),
-														string(network.WebApplicationFirewallMatchVariableRequestBody),
-														string(network.WebApplicationFirewallMatchVariableRequestCookies),
+														string(webapplicationfirewallpolicies.WebApplicationFirewallMatchVariableRemoteAddr),
+														string(webapplicationfirewallpolicies.WebApplicationFirewallMatchVariableRequestMethod),
+														string(webapplicationfirewallpolicies.WebApplicationFirewallMatchVariableQueryString),
+														string(webapplicationfirewallpolicies.WebApplicationFirewallMatchVariablePostArgs),
+														string(webapplicationfirewallpolicies.WebApplicationFirewallMatchVariableRequestUri),
+														string(webapplicationfirewallpolicies.WebApplicationFirewallMatchVariableRequestHeaders),
+														string(webapplicationfirewallpolicies.WebApplicationFirewallMatchVariableRequestBody),
+														string(webapplicationfirewallpolicies.WebApplicationFirewallMatchVariableRequestCookies),
 													}, false),
 												},
 												"selector": {
@@ -104,17 +108,17 @@ func resourceWebApplicationFirewallPolicy() *pluginsdk.Resource {
 										Type:     pluginsdk.TypeString,
 										Required: true,
 										ValidateFunc: validation.StringInSlice([]string{
-											string(network.WebApplicationFirewallOperatorIPMatch),
-											string(network.WebApplicationFirewallOperatorGeoMatch),
-											string(network.WebApplicationFirewallOperatorEqual),
-											string(network.WebApplicationFirewallOperatorContains),
-											string(network.WebApplicationFirewallOperatorLessThan),
-											string(network.WebApplicationFirewallOperatorGreaterThan),
-											string(network.WebApplicationFirewallOperatorLessThanOrEqual),
-											string(network.WebApplicationFirewallOperatorGreaterThanOrEqual),
-											string(network.WebApplicationFirewallOperatorBeginsWith),
-											string(network.WebApplicationFirewallOperatorEndsWith),
-											string(network.WebApplicationFirewallOperatorRegex),
+											string(webapplicationfirewallpolicies.WebApplicationFirewallOperatorIPMatch),
+											string(webapplicationfirewallpolicies.WebApplicationFirewallOperatorGeoMatch),
+											string(webapplicationfirewallpolicies.WebApplicationFirewallOperatorEqual),
+											string(webapplicationfirewallpolicies.WebApplicationFirewallOperatorContains),
+											string(webapplicationfirewallpolicies.WebApplicationFirewallOperatorLessThan),
+											string(webapplicationfirewallpolicies.WebApplicationFirewallOperatorGreaterThan),
+											string(webapplicationfirewallpolicies.WebApplicationFirewallOperatorLessThanOrEqual),
+											string(webapplicationfirewallpolicies.WebApplicationFirewallOperatorGreaterThanOrEqual),
+											string(webapplicationfirewallpolicies.WebApplicationFirewallOperatorBeginsWith),
+											string(webapplicationfirewallpolicies.WebApplicationFirewallOperatorEndsWith),
+											string(webapplicationfirewallpolicies.WebApplicationFirewallOperatorRegex),
 										}, false),
 									},
 									"negation_condition": {
@@ -127,12 +131,12 @@ func resourceWebApplicationFirewallPolicy() *pluginsdk.Resource {
 										Elem: &pluginsdk.Schema{
 											Type: pluginsdk.TypeString,
 											ValidateFunc: validation.StringInSlice([]string{
-												string(network.WebApplicationFirewallTransformHTMLEntityDecode),
-												string(network.WebApplicationFirewallTransformLowercase),
-												string(network.WebApplicationFirewallTransformRemoveNulls),
-												string(network.WebApplicationFirewallTransformTrim),
-												string(network.WebApplicationFirewallTransformURLDecode),
-												string(network.WebApplicationFirewallTransformURLEncode),
+												string(webapplicationfirewallpolicies.WebApplicationFirewallTransformHtmlEntityDecode),
+												string(webapplicationfirewallpolicies.WebApplicationFirewallTransformLowercase),
+												string(webapplicationfirewallpolicies.WebApplicationFirewallTransformRemoveNulls),
+												string(webapplicationfirewallpolicies.WebApplicationFirewallTransformTrim),
+												string(webapplicationfirewallpolicies.WebApplicationFirewallTransformUrlDecode),
+												string(webapplicationfirewallpolicies.WebApplicationFirewallTransformUrlEncode),
 											}, false),
 										},
 									},
@@ -147,8 +151,8 @@ func resourceWebApplicationFirewallPolicy() *pluginsdk.Resource {
 							Type:     pluginsdk.TypeString,
 							Required: true,
 							ValidateFunc: validation.StringInSlice([]string{
-								string(network.WebApplicationFirewallRuleTypeMatchRule),
-								string(network.WebApplicationFirewallRuleTypeInvalid),
+								string(webapplicationfirewallpolicies.WebApplicationFirewallRuleTypeMatchRule),
+								string(webapplicationfirewallpolicies.WebApplicationFirewallRuleTypeInvalid),
 							}, false),
 						},
 						"name": {
@@ -171,13 +175,9 @@ func resourceWebApplicationFirewallPolicy() *pluginsdk.Resource {
 							Elem: &pluginsdk.Resource{
 								Schema: map[string]*pluginsdk.Schema{
 									"match_variable": {
-										Type:     pluginsdk.TypeString,
-										Required: true,
-										ValidateFunc: validation.StringInSlice([]string{
-											string(network.OwaspCrsExclusionEntryMatchVariableRequestArgNames),
-											string(network.OwaspCrsExclusionEntryMatchVariableRequestCookieNames),
-											string(network.OwaspCrsExclusionEntryMatchVariableRequestHeaderNames),
-										}, false),
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(webapplicationfirewallpolicies.PossibleValuesForOwaspCrsExclusionEntryMatchVariable(), false),
 									},
 									"selector": {
 										Type:         pluginsdk.TypeString,
@@ -188,13 +188,55 @@ func resourceWebApplicationFirewallPolicy() *pluginsdk.Resource {
 										Type:     pluginsdk.TypeString,
 										Required: true,
 										ValidateFunc: validation.StringInSlice([]string{
-											string(network.OwaspCrsExclusionEntrySelectorMatchOperatorContains),
-											string(network.OwaspCrsExclusionEntrySelectorMatchOperatorEndsWith),
-											string(network.OwaspCrsExclusionEntrySelectorMatchOperatorEquals),
-											string(network.OwaspCrsExclusionEntrySelectorMatchOperatorEqualsAny),
-											string(network.OwaspCrsExclusionEntrySelectorMatchOperatorStartsWith),
+											string(webapplicationfirewallpolicies.OwaspCrsExclusionEntrySelectorMatchOperatorContains),
+											string(webapplicationfirewallpolicies.OwaspCrsExclusionEntrySelectorMatchOperatorEndsWith),
+											string(webapplicationfirewallpolicies.OwaspCrsExclusionEntrySelectorMatchOperatorEquals),
+											string(webapplicationfirewallpolicies.OwaspCrsExclusionEntrySelectorMatchOperatorEqualsAny),
+											string(webapplicationfirewallpolicies.OwaspCrsExclusionEntrySelectorMatchOperatorStartsWith),
 										}, false),
 									},
+									"excluded_rule_set": {
+										Type:     pluginsdk.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &pluginsdk.Resource{
+											Schema: map[string]*pluginsdk.Schema{
+												"type": {
+													Type:         pluginsdk.TypeString,
+													Optional:     true,
+													Default:      "OWASP",
+													ValidateFunc: validate.ValidateWebApplicationFirewallPolicyRuleSetType,
+												},
+												"version": {
+													Type:         pluginsdk.TypeString,
+													Optional:     true,
+													Default:      "3.2",
+													ValidateFunc: validate.ValidateWebApplicationFirewallPolicyRuleSetVersion,
+												},
+												"rule_group": {
+													Type:     pluginsdk.TypeList,
+													Optional: true,
+													Elem: &pluginsdk.Resource{
+														Schema: map[string]*pluginsdk.Schema{
+															"rule_group_name": {
+																Type:         pluginsdk.TypeString,
+																Required:     true,
+																ValidateFunc: validate.ValidateWebApplicationFirewallPolicyRuleGroupName,
+															},
+															"excluded_rules": {
+																Type:     pluginsdk.TypeList,
+																Optional: true,
+																Elem: &pluginsdk.Schema{
+																	Type:         pluginsdk.TypeString,
+																	ValidateFunc: validation.StringIsNotEmpty,
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
 								},
 							},
 						},
@@ -226,11 +268,34 @@ func resourceWebApplicationFirewallPolicy() *pluginsdk.Resource {
 												},
 												"disabled_rules": {
 													Type:     pluginsdk.TypeList,
-													Required: true,
+													Optional: true,
 													Elem: &pluginsdk.Schema{
 														Type: pluginsdk.TypeString,
 													},
 												},
+												"rule": {
+													Type:     pluginsdk.TypeList,
+													Optional: true,
+													Elem: &pluginsdk.Resource{
+														Schema: map[string]*pluginsdk.Schema{
+															"id": {
+																Type:         pluginsdk.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringIsNotEmpty,
+															},
+															"action": {
+																Type:         pluginsdk.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringInSlice(webapplicationfirewallpolicies.PossibleValuesForActionType(), false),
+															},
+															"enabled": {
+																Type:     pluginsdk.TypeBool,
+																Optional: true,
+																Default:  false,
+															},
+														},
+													},
+												},
 											},
 										},
 									},
@@ -256,10 +321,10 @@ func resourceWebApplicationFirewallPolicy() *pluginsdk.Resource {
 							Type:     pluginsdk.TypeString,
 							Optional: true,
 							ValidateFunc: validation.StringInSlice([]string{
-								string(network.WebApplicationFirewallModePrevention),
-								string(network.WebApplicationFirewallModeDetection),
+								string(webapplicationfirewallpolicies.WebApplicationFirewallModePrevention),
+								string(webapplicationfirewallpolicies.WebApplicationFirewallModeDetection),
 							}, false),
-							Default: string(network.WebApplicationFirewallModePrevention),
+							Default: string(webapplicationfirewallpolicies.WebApplicationFirewallModePrevention),
 						},
 						"request_body_check": {
 							Type:     pluginsdk.TypeBool,
@@ -296,7 +361,64 @@ func resourceWebApplicationFirewallPolicy() *pluginsdk.Resource {
 
 			"tags": tags.Schema(),
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(webApplicationFirewallPolicyCustomizeDiff),
+	}
+}
+
+func webApplicationFirewallPolicyCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	managedRules := d.Get("managed_rules").([]interface{})
+	if len(managedRules) == 0 || managedRules[0] == nil {
+		return nil
+	}
+	v := managedRules[0].(map[string]interface{})
+
+	for _, item := range v["managed_rule_set"].([]interface{}) {
+		if item == nil {
+			continue
+		}
+		ruleSet := item.(map[string]interface{})
+		if err := validateWebApplicationFirewallPolicyRuleSetVersion("managed_rule_set", ruleSet["type"].(string), ruleSet["version"].(string)); err != nil {
+			return err
+		}
+	}
+
+	for _, item := range v["exclusion"].([]interface{}) {
+		if item == nil {
+			continue
+		}
+		exclusion := item.(map[string]interface{})
+		for _, raw := range exclusion["excluded_rule_set"].([]interface{}) {
+			if raw == nil {
+				continue
+			}
+			ruleSet := raw.(map[string]interface{})
+			if err := validateWebApplicationFirewallPolicyRuleSetVersion("excluded_rule_set", ruleSet["type"].(string), ruleSet["version"].(string)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateWebApplicationFirewallPolicyRuleSetVersion(block, ruleSetType, ruleSetVersion string) error {
+	// the version may not be known until apply time when it's interpolated
+	if ruleSetType == "" || ruleSetVersion == "" {
+		return nil
+	}
+
+	versions, ok := validate.WebApplicationFirewallPolicyRuleSetVersions()[ruleSetType]
+	if !ok {
+		return nil
+	}
+	for _, version := range versions {
+		if version == ruleSetVersion {
+			return nil
+		}
 	}
+
+	return fmt.Errorf("`version` within the `%s` block must be one of [%s] when `type` is %q but got %q", block, strings.Join(versions, ", "), ruleSetType, ruleSetVersion)
 }
 
 func resourceWebApplicationFirewallPolicyCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -305,17 +427,17 @@ func resourceWebApplicationFirewallPolicyCreateUpdate(d *pluginsdk.ResourceData,
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id := parse.NewApplicationGatewayWebApplicationFirewallPolicyID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+	id := webapplicationfirewallpolicies.NewApplicationGatewayWebApplicationFirewallPolicyID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
 
 	if d.IsNewResource() {
-		resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+		existing, err := client.Get(ctx, id)
 		if err != nil {
-			if !utils.ResponseWasNotFound(resp.Response) {
-				return fmt.Errorf("checking for present of existing %s: %+v", id, err)
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
 			}
 		}
-		if !utils.ResponseWasNotFound(resp.Response) {
-			return tf.ImportAsExistsError("azurerm_web_application_firewall_policy", *resp.ID)
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_web_application_firewall_policy", id.ID())
 		}
 	}
 
@@ -325,21 +447,21 @@ func resourceWebApplicationFirewallPolicyCreateUpdate(d *pluginsdk.ResourceData,
 	managedRules := d.Get("managed_rules").([]interface{})
 	t := d.Get("tags").(map[string]interface{})
 
-	parameters := network.WebApplicationFirewallPolicy{
+	parameters := webapplicationfirewallpolicies.WebApplicationFirewallPolicy{
 		Location: utils.String(location),
-		WebApplicationFirewallPolicyPropertiesFormat: &network.WebApplicationFirewallPolicyPropertiesFormat{
+		Properties: &webapplicationfirewallpolicies.WebApplicationFirewallPolicyPropertiesFormat{
 			CustomRules:    expandWebApplicationFirewallPolicyWebApplicationFirewallCustomRule(customRules),
 			PolicySettings: expandWebApplicationFirewallPolicyPolicySettings(policySettings),
 			ManagedRules:   expandWebApplicationFirewallPolicyManagedRulesDefinition(managedRules),
 		},
-		Tags: tags.Expand(t),
+		Tags: expandWebApplicationFirewallPolicyTags(t),
 	}
 
-	if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, parameters); err != nil {
+	if _, err := client.CreateOrUpdate(ctx, id, parameters); err != nil {
 		return fmt.Errorf("creating %s: %+v", id, err)
 	}
 
-	d.SetId(id.ID())
+	d.SetId(parse.NewApplicationGatewayWebApplicationFirewallPolicyID(id.SubscriptionId, id.ResourceGroupName, id.ApplicationGatewayWebApplicationFirewallPolicyName).ID())
 
 	return resourceWebApplicationFirewallPolicyRead(d, meta)
 }
@@ -354,9 +476,9 @@ func resourceWebApplicationFirewallPolicyRead(d *pluginsdk.ResourceData, meta in
 		return err
 	}
 
-	resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	resp, err := client.Get(ctx, webapplicationfirewallpolicies.NewApplicationGatewayWebApplicationFirewallPolicyID(id.SubscriptionId, id.ResourceGroup, id.Name))
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if response.WasNotFound(resp.HttpResponse) {
 			log.Printf("[INFO] Web Application Firewall Policy %q does not exist - removing from state", d.Id())
 			d.SetId("")
 			return nil
@@ -366,28 +488,34 @@ func resourceWebApplicationFirewallPolicyRead(d *pluginsdk.ResourceData, meta in
 
 	d.Set("name", id.Name)
 	d.Set("resource_group_name", id.ResourceGroup)
-	if location := resp.Location; location != nil {
-		d.Set("location", azure.NormalizeLocation(*location))
-	}
-	if webApplicationFirewallPolicyPropertiesFormat := resp.WebApplicationFirewallPolicyPropertiesFormat; webApplicationFirewallPolicyPropertiesFormat != nil {
-		if err := d.Set("custom_rules", flattenWebApplicationFirewallPolicyWebApplicationFirewallCustomRule(webApplicationFirewallPolicyPropertiesFormat.CustomRules)); err != nil {
-			return fmt.Errorf("setting `custom_rules`: %+v", err)
-		}
-		if err := d.Set("policy_settings", flattenWebApplicationFirewallPolicyPolicySettings(webApplicationFirewallPolicyPropertiesFormat.PolicySettings)); err != nil {
-			return fmt.Errorf("setting `policy_settings`: %+v", err)
-		}
-		if err := d.Set("managed_rules", flattenWebApplicationFirewallPolicyManagedRulesDefinition(webApplicationFirewallPolicyPropertiesFormat.ManagedRules)); err != nil {
-			return fmt.Errorf("setting `managed_rules`: %+v", err)
-		}
-		if err := d.Set("http_listener_ids", flattenSubResourcesToIDs(webApplicationFirewallPolicyPropertiesFormat.HTTPListeners)); err != nil {
-			return fmt.Errorf("setting `http_listeners`: %+v", err)
+
+	if model := resp.Model; model != nil {
+		if location := model.Location; location != nil {
+			d.Set("location", azure.NormalizeLocation(*location))
 		}
-		if err := d.Set("path_based_rule_ids", flattenSubResourcesToIDs(webApplicationFirewallPolicyPropertiesFormat.PathBasedRules)); err != nil {
-			return fmt.Errorf("setting `path_based_rules`: %+v", err)
+
+		if props := model.Properties; props != nil {
+			if err := d.Set("custom_rules", flattenWebApplicationFirewallPolicyWebApplicationFirewallCustomRule(props.CustomRules)); err != nil {
+				return fmt.Errorf("setting `custom_rules`: %+v", err)
+			}
+			if err := d.Set("policy_settings", flattenWebApplicationFirewallPolicyPolicySettings(props.PolicySettings)); err != nil {
+				return fmt.Errorf("setting `policy_settings`: %+v", err)
+			}
+			if err := d.Set("managed_rules", flattenWebApplicationFirewallPolicyManagedRulesDefinition(props.ManagedRules)); err != nil {
+				return fmt.Errorf("setting `managed_rules`: %+v", err)
+			}
+			if err := d.Set("http_listener_ids", flattenWebApplicationFirewallPolicySubResourcesToIDs(props.HttpListeners)); err != nil {
+				return fmt.Errorf("setting `http_listeners`: %+v", err)
+			}
+			if err := d.Set("path_based_rule_ids", flattenWebApplicationFirewallPolicySubResourcesToIDs(props.PathBasedRules)); err != nil {
+				return fmt.Errorf("setting `path_based_rules`: %+v", err)
+			}
 		}
+
+		return tags.FlattenAndSet(d, flattenWebApplicationFirewallPolicyTags(model.Tags))
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return nil
 }
 
 func resourceWebApplicationFirewallPolicyDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -400,20 +528,27 @@ func resourceWebApplicationFirewallPolicyDelete(d *pluginsdk.ResourceData, meta
 		return err
 	}
 
-	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
-	if err != nil {
+	if err := client.DeleteThenPoll(ctx, webapplicationfirewallpolicies.NewApplicationGatewayWebApplicationFirewallPolicyID(id.SubscriptionId, id.ResourceGroup, id.Name)); err != nil {
 		return fmt.Errorf("deleting %s: %+v", *id, err)
 	}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for the deletion of %s: %+v", *id, err)
-	}
-
 	return nil
 }
 
-func expandWebApplicationFirewallPolicyWebApplicationFirewallCustomRule(input []interface{}) *[]network.WebApplicationFirewallCustomRule {
-	results := make([]network.WebApplicationFirewallCustomRule, 0)
+func expandWebApplicationFirewallPolicyTags(input map[string]interface{}) *map[string]string {
+	output := tags.ToTypedObject(tags.Expand(input))
+	return &output
+}
+
+func flattenWebApplicationFirewallPolicyTags(input *map[string]string) map[string]*string {
+	if input == nil {
+		return map[string]*string{}
+	}
+	return tags.FromTypedObject(*input)
+}
+
+func expandWebApplicationFirewallPolicyWebApplicationFirewallCustomRule(input []interface{}) *[]webapplicationfirewallpolicies.WebApplicationFirewallCustomRule {
+	results := make([]webapplicationfirewallpolicies.WebApplicationFirewallCustomRule, 0)
 	for _, item := range input {
 		v := item.(map[string]interface{})
 		name := v["name"].(string)
@@ -422,12 +557,12 @@ func expandWebApplicationFirewallPolicyWebApplicationFirewallCustomRule(input []
 		matchConditions := v["match_conditions"].([]interface{})
 		action := v["action"].(string)
 
-		result := network.WebApplicationFirewallCustomRule{
-			Action:          network.WebApplicationFirewallAction(action),
+		result := webapplicationfirewallpolicies.WebApplicationFirewallCustomRule{
+			Action:          webapplicationfirewallpolicies.WebApplicationFirewallAction(action),
 			MatchConditions: expandWebApplicationFirewallPolicyMatchCondition(matchConditions),
 			Name:            utils.String(name),
-			Priority:        utils.Int32(int32(priority)),
-			RuleType:        network.WebApplicationFirewallRuleType(ruleType),
+			Priority:        int64(priority),
+			RuleType:        webapplicationfirewallpolicies.WebApplicationFirewallRuleType(ruleType),
 		}
 
 		results = append(results, result)
@@ -435,59 +570,61 @@ func expandWebApplicationFirewallPolicyWebApplicationFirewallCustomRule(input []
 	return &results
 }
 
-func expandWebApplicationFirewallPolicyPolicySettings(input []interface{}) *network.PolicySettings {
+func expandWebApplicationFirewallPolicyPolicySettings(input []interface{}) *webapplicationfirewallpolicies.PolicySettings {
 	if len(input) == 0 {
 		return nil
 	}
 	v := input[0].(map[string]interface{})
 
-	enabled := network.WebApplicationFirewallEnabledStateDisabled
+	enabled := webapplicationfirewallpolicies.WebApplicationFirewallEnabledStateDisabled
 	if value, ok := v["enabled"].(bool); ok && value {
-		enabled = network.WebApplicationFirewallEnabledStateEnabled
+		enabled = webapplicationfirewallpolicies.WebApplicationFirewallEnabledStateEnabled
 	}
-	mode := v["mode"].(string)
+	mode := webapplicationfirewallpolicies.WebApplicationFirewallMode(v["mode"].(string))
 	requestBodyCheck := v["request_body_check"].(bool)
 	maxRequestBodySizeInKb := v["max_request_body_size_in_kb"].(int)
 	fileUploadLimitInMb := v["file_upload_limit_in_mb"].(int)
 
-	result := network.PolicySettings{
-		State:                  enabled,
-		Mode:                   network.WebApplicationFirewallMode(mode),
+	result := webapplicationfirewallpolicies.PolicySettings{
+		State:                  &enabled,
+		Mode:                   &mode,
 		RequestBodyCheck:       utils.Bool(requestBodyCheck),
-		MaxRequestBodySizeInKb: utils.Int32(int32(maxRequestBodySizeInKb)),
-		FileUploadLimitInMb:    utils.Int32(int32(fileUploadLimitInMb)),
+		MaxRequestBodySizeInKb: utils.Int64(int64(maxRequestBodySizeInKb)),
+		FileUploadLimitInMb:    utils.Int64(int64(fileUploadLimitInMb)),
 	}
 	return &result
 }
 
-func expandWebApplicationFirewallPolicyManagedRulesDefinition(input []interface{}) *network.ManagedRulesDefinition {
+func expandWebApplicationFirewallPolicyManagedRulesDefinition(input []interface{}) webapplicationfirewallpolicies.ManagedRulesDefinition {
 	if len(input) == 0 {
-		return nil
+		return webapplicationfirewallpolicies.ManagedRulesDefinition{}
 	}
 	v := input[0].(map[string]interface{})
 
 	exclusions := v["exclusion"].([]interface{})
 	managedRuleSets := v["managed_rule_set"].([]interface{})
 
-	return &network.ManagedRulesDefinition{
+	return webapplicationfirewallpolicies.ManagedRulesDefinition{
 		Exclusions:      expandWebApplicationFirewallPolicyExclusions(exclusions),
 		ManagedRuleSets: expandWebApplicationFirewallPolicyManagedRuleSet(managedRuleSets),
 	}
 }
 
-func expandWebApplicationFirewallPolicyExclusions(input []interface{}) *[]network.OwaspCrsExclusionEntry {
-	results := make([]network.OwaspCrsExclusionEntry, 0)
+func expandWebApplicationFirewallPolicyExclusions(input []interface{}) *[]webapplicationfirewallpolicies.OwaspCrsExclusionEntry {
+	results := make([]webapplicationfirewallpolicies.OwaspCrsExclusionEntry, 0)
 	for _, item := range input {
 		v := item.(map[string]interface{})
 
 		matchVariable := v["match_variable"].(string)
 		selectorMatchOperator := v["selector_match_operator"].(string)
 		selector := v["selector"].(string)
+		excludedRuleSets := v["excluded_rule_set"].([]interface{})
 
-		result := network.OwaspCrsExclusionEntry{
-			MatchVariable:         network.OwaspCrsExclusionEntryMatchVariable(matchVariable),
-			SelectorMatchOperator: network.OwaspCrsExclusionEntrySelectorMatchOperator(selectorMatchOperator),
-			Selector:              utils.String(selector),
+		result := webapplicationfirewallpolicies.OwaspCrsExclusionEntry{
+			MatchVariable:            webapplicationfirewallpolicies.OwaspCrsExclusionEntryMatchVariable(matchVariable),
+			SelectorMatchOperator:    webapplicationfirewallpolicies.OwaspCrsExclusionEntrySelectorMatchOperator(selectorMatchOperator),
+			Selector:                 selector,
+			ExclusionManagedRuleSets: expandWebApplicationFirewallPolicyExclusionManagedRuleSets(excludedRuleSets),
 		}
 
 		results = append(results, result)
@@ -495,8 +632,43 @@ func expandWebApplicationFirewallPolicyExclusions(input []interface{}) *[]networ
 	return &results
 }
 
-func expandWebApplicationFirewallPolicyManagedRuleSet(input []interface{}) *[]network.ManagedRuleSet {
-	results := make([]network.ManagedRuleSet, 0)
+func expandWebApplicationFirewallPolicyExclusionManagedRuleSets(input []interface{}) *[]webapplicationfirewallpolicies.ExclusionManagedRuleSet {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	results := make([]webapplicationfirewallpolicies.ExclusionManagedRuleSet, 0)
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		ruleGroups := make([]webapplicationfirewallpolicies.ExclusionManagedRuleGroup, 0)
+		for _, raw := range v["rule_group"].([]interface{}) {
+			group := raw.(map[string]interface{})
+
+			rules := make([]webapplicationfirewallpolicies.ExclusionManagedRule, 0)
+			for _, ruleId := range group["excluded_rules"].([]interface{}) {
+				rules = append(rules, webapplicationfirewallpolicies.ExclusionManagedRule{
+					RuleId: ruleId.(string),
+				})
+			}
+
+			ruleGroups = append(ruleGroups, webapplicationfirewallpolicies.ExclusionManagedRuleGroup{
+				RuleGroupName: group["rule_group_name"].(string),
+				Rules:         &rules,
+			})
+		}
+
+		results = append(results, webapplicationfirewallpolicies.ExclusionManagedRuleSet{
+			RuleSetType:    v["type"].(string),
+			RuleSetVersion: v["version"].(string),
+			RuleGroups:     &ruleGroups,
+		})
+	}
+	return &results
+}
+
+func expandWebApplicationFirewallPolicyManagedRuleSet(input []interface{}) []webapplicationfirewallpolicies.ManagedRuleSet {
+	results := make([]webapplicationfirewallpolicies.ManagedRuleSet, 0)
 	for _, item := range input {
 		v := item.(map[string]interface{})
 
@@ -506,28 +678,29 @@ func expandWebApplicationFirewallPolicyManagedRuleSet(input []interface{}) *[]ne
 		if value, exists := v["rule_group_override"]; exists {
 			ruleGroupOverrides = value.([]interface{})
 		}
-		result := network.ManagedRuleSet{
-			RuleSetType:        utils.String(ruleSetType),
-			RuleSetVersion:     utils.String(ruleSetVersion),
+		result := webapplicationfirewallpolicies.ManagedRuleSet{
+			RuleSetType:        ruleSetType,
+			RuleSetVersion:     ruleSetVersion,
 			RuleGroupOverrides: expandWebApplicationFirewallPolicyRuleGroupOverrides(ruleGroupOverrides),
 		}
 
 		results = append(results, result)
 	}
-	return &results
+	return results
 }
 
-func expandWebApplicationFirewallPolicyRuleGroupOverrides(input []interface{}) *[]network.ManagedRuleGroupOverride {
-	results := make([]network.ManagedRuleGroupOverride, 0)
+func expandWebApplicationFirewallPolicyRuleGroupOverrides(input []interface{}) *[]webapplicationfirewallpolicies.ManagedRuleGroupOverride {
+	results := make([]webapplicationfirewallpolicies.ManagedRuleGroupOverride, 0)
 	for _, item := range input {
 		v := item.(map[string]interface{})
 
 		ruleGroupName := v["rule_group_name"].(string)
 		disabledRules := v["disabled_rules"].([]interface{})
+		rules := v["rule"].([]interface{})
 
-		result := network.ManagedRuleGroupOverride{
-			RuleGroupName: utils.String(ruleGroupName),
-			Rules:         expandWebApplicationFirewallPolicyRules(disabledRules),
+		result := webapplicationfirewallpolicies.ManagedRuleGroupOverride{
+			RuleGroupName: ruleGroupName,
+			Rules:         expandWebApplicationFirewallPolicyRules(disabledRules, rules),
 		}
 
 		results = append(results, result)
@@ -535,14 +708,32 @@ func expandWebApplicationFirewallPolicyRuleGroupOverrides(input []interface{}) *
 	return &results
 }
 
-func expandWebApplicationFirewallPolicyRules(input []interface{}) *[]network.ManagedRuleOverride {
-	results := make([]network.ManagedRuleOverride, 0)
-	for _, item := range input {
-		ruleID := item.(string)
+func expandWebApplicationFirewallPolicyRules(disabledRules []interface{}, rules []interface{}) *[]webapplicationfirewallpolicies.ManagedRuleOverride {
+	results := make([]webapplicationfirewallpolicies.ManagedRuleOverride, 0)
+	for _, item := range disabledRules {
+		state := webapplicationfirewallpolicies.ManagedRuleEnabledStateDisabled
+
+		result := webapplicationfirewallpolicies.ManagedRuleOverride{
+			RuleId: item.(string),
+			State:  &state,
+		}
+
+		results = append(results, result)
+	}
+
+	for _, item := range rules {
+		v := item.(map[string]interface{})
+
+		state := webapplicationfirewallpolicies.ManagedRuleEnabledStateDisabled
+		if v["enabled"].(bool) {
+			state = webapplicationfirewallpolicies.ManagedRuleEnabledStateEnabled
+		}
+		action := webapplicationfirewallpolicies.ActionType(v["action"].(string))
 
-		result := network.ManagedRuleOverride{
-			RuleID: utils.String(ruleID),
-			State:  network.ManagedRuleEnabledStateDisabled,
+		result := webapplicationfirewallpolicies.ManagedRuleOverride{
+			RuleId: v["id"].(string),
+			State:  &state,
+			Action: &action,
 		}
 
 		results = append(results, result)
@@ -550,8 +741,8 @@ func expandWebApplicationFirewallPolicyRules(input []interface{}) *[]network.Man
 	return &results
 }
 
-func expandWebApplicationFirewallPolicyMatchCondition(input []interface{}) *[]network.MatchCondition {
-	results := make([]network.MatchCondition, 0)
+func expandWebApplicationFirewallPolicyMatchCondition(input []interface{}) []webapplicationfirewallpolicies.MatchCondition {
+	results := make([]webapplicationfirewallpolicies.MatchCondition, 0)
 	for _, item := range input {
 		v := item.(map[string]interface{})
 		matchVariables := v["match_variables"].([]interface{})
@@ -560,41 +751,41 @@ func expandWebApplicationFirewallPolicyMatchCondition(input []interface{}) *[]ne
 		matchValues := v["match_values"].([]interface{})
 		transformsRaw := v["transforms"].(*pluginsdk.Set).List()
 
-		var transforms []network.WebApplicationFirewallTransform
+		transforms := make([]webapplicationfirewallpolicies.WebApplicationFirewallTransform, 0)
 		for _, trans := range transformsRaw {
-			transforms = append(transforms, network.WebApplicationFirewallTransform(trans.(string)))
+			transforms = append(transforms, webapplicationfirewallpolicies.WebApplicationFirewallTransform(trans.(string)))
 		}
-		result := network.MatchCondition{
-			MatchValues:      utils.ExpandStringSlice(matchValues),
+		result := webapplicationfirewallpolicies.MatchCondition{
+			MatchValues:      *utils.ExpandStringSlice(matchValues),
 			MatchVariables:   expandWebApplicationFirewallPolicyMatchVariable(matchVariables),
 			NegationConditon: utils.Bool(negationCondition),
-			Operator:         network.WebApplicationFirewallOperator(operator),
+			Operator:         webapplicationfirewallpolicies.WebApplicationFirewallOperator(operator),
 			Transforms:       &transforms,
 		}
 
 		results = append(results, result)
 	}
-	return &results
+	return results
 }
 
-func expandWebApplicationFirewallPolicyMatchVariable(input []interface{}) *[]network.MatchVariable {
-	results := make([]network.MatchVariable, 0)
+func expandWebApplicationFirewallPolicyMatchVariable(input []interface{}) []webapplicationfirewallpolicies.MatchVariable {
+	results := make([]webapplicationfirewallpolicies.MatchVariable, 0)
 	for _, item := range input {
 		v := item.(map[string]interface{})
 		variableName := v["variable_name"].(string)
 		selector := v["selector"].(string)
 
-		result := network.MatchVariable{
+		result := webapplicationfirewallpolicies.MatchVariable{
 			Selector:     utils.String(selector),
-			VariableName: network.WebApplicationFirewallMatchVariable(variableName),
+			VariableName: webapplicationfirewallpolicies.WebApplicationFirewallMatchVariable(variableName),
 		}
 
 		results = append(results, result)
 	}
-	return &results
+	return results
 }
 
-func flattenWebApplicationFirewallPolicyWebApplicationFirewallCustomRule(input *[]network.WebApplicationFirewallCustomRule) []interface{} {
+func flattenWebApplicationFirewallPolicyWebApplicationFirewallCustomRule(input *[]webapplicationfirewallpolicies.WebApplicationFirewallCustomRule) []interface{} {
 	results := make([]interface{}, 0)
 	if input == nil {
 		return results
@@ -608,9 +799,7 @@ func flattenWebApplicationFirewallPolicyWebApplicationFirewallCustomRule(input *
 		}
 		v["action"] = string(item.Action)
 		v["match_conditions"] = flattenWebApplicationFirewallPolicyMatchCondition(item.MatchConditions)
-		if priority := item.Priority; priority != nil {
-			v["priority"] = int(*priority)
-		}
+		v["priority"] = int(item.Priority)
 		v["rule_type"] = string(item.RuleType)
 
 		results = append(results, v)
@@ -619,39 +808,40 @@ func flattenWebApplicationFirewallPolicyWebApplicationFirewallCustomRule(input *
 	return results
 }
 
-func flattenWebApplicationFirewallPolicyPolicySettings(input *network.PolicySettings) []interface{} {
+func flattenWebApplicationFirewallPolicyPolicySettings(input *webapplicationfirewallpolicies.PolicySettings) []interface{} {
 	if input == nil {
 		return make([]interface{}, 0)
 	}
 
 	result := make(map[string]interface{})
 
-	result["enabled"] = input.State == network.WebApplicationFirewallEnabledStateEnabled
-	result["mode"] = string(input.Mode)
-	result["request_body_check"] = input.RequestBodyCheck
-	result["max_request_body_size_in_kb"] = int(*input.MaxRequestBodySizeInKb)
-	result["file_upload_limit_in_mb"] = int(*input.FileUploadLimitInMb)
+	result["enabled"] = input.State != nil && *input.State == webapplicationfirewallpolicies.WebApplicationFirewallEnabledStateEnabled
+	mode := ""
+	if input.Mode != nil {
+		mode = string(*input.Mode)
+	}
+	result["mode"] = mode
+	result["request_body_check"] = input.RequestBodyCheck != nil && *input.RequestBodyCheck
+	if input.MaxRequestBodySizeInKb != nil {
+		result["max_request_body_size_in_kb"] = int(*input.MaxRequestBodySizeInKb)
+	}
+	if input.FileUploadLimitInMb != nil {
+		result["file_upload_limit_in_mb"] = int(*input.FileUploadLimitInMb)
+	}
 
 	return []interface{}{result}
 }
 
-func flattenWebApplicationFirewallPolicyManagedRulesDefinition(input *network.ManagedRulesDefinition) []interface{} {
-	results := make([]interface{}, 0)
-	if input == nil {
-		return results
-	}
-
+func flattenWebApplicationFirewallPolicyManagedRulesDefinition(input webapplicationfirewallpolicies.ManagedRulesDefinition) []interface{} {
 	v := make(map[string]interface{})
 
 	v["exclusion"] = flattenWebApplicationFirewallPolicyExclusions(input.Exclusions)
 	v["managed_rule_set"] = flattenWebApplicationFirewallPolicyManagedRuleSets(input.ManagedRuleSets)
 
-	results = append(results, v)
-
-	return results
+	return []interface{}{v}
 }
 
-func flattenWebApplicationFirewallPolicyExclusions(input *[]network.OwaspCrsExclusionEntry) []interface{} {
+func flattenWebApplicationFirewallPolicyExclusions(input *[]webapplicationfirewallpolicies.OwaspCrsExclusionEntry) []interface{} {
 	results := make([]interface{}, 0)
 	if input == nil {
 		return results
@@ -660,26 +850,53 @@ func flattenWebApplicationFirewallPolicyExclusions(input *[]network.OwaspCrsExcl
 	for _, item := range *input {
 		v := make(map[string]interface{})
 
-		selector := item.Selector
-
 		v["match_variable"] = string(item.MatchVariable)
-		if selector != nil {
-			v["selector"] = *selector
-		}
+		v["selector"] = item.Selector
 		v["selector_match_operator"] = string(item.SelectorMatchOperator)
+		v["excluded_rule_set"] = flattenWebApplicationFirewallPolicyExclusionManagedRuleSets(item.ExclusionManagedRuleSets)
 
 		results = append(results, v)
 	}
 	return results
 }
 
-func flattenWebApplicationFirewallPolicyManagedRuleSets(input *[]network.ManagedRuleSet) []interface{} {
+func flattenWebApplicationFirewallPolicyExclusionManagedRuleSets(input *[]webapplicationfirewallpolicies.ExclusionManagedRuleSet) []interface{} {
 	results := make([]interface{}, 0)
 	if input == nil {
 		return results
 	}
 
 	for _, item := range *input {
+		ruleGroups := make([]interface{}, 0)
+		if item.RuleGroups != nil {
+			for _, group := range *item.RuleGroups {
+				excludedRules := make([]interface{}, 0)
+				if group.Rules != nil {
+					for _, rule := range *group.Rules {
+						excludedRules = append(excludedRules, rule.RuleId)
+					}
+				}
+
+				ruleGroups = append(ruleGroups, map[string]interface{}{
+					"rule_group_name": group.RuleGroupName,
+					"excluded_rules":  excludedRules,
+				})
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"type":       item.RuleSetType,
+			"version":    item.RuleSetVersion,
+			"rule_group": ruleGroups,
+		})
+	}
+	return results
+}
+
+func flattenWebApplicationFirewallPolicyManagedRuleSets(input []webapplicationfirewallpolicies.ManagedRuleSet) []interface{} {
+	results := make([]interface{}, 0)
+
+	for _, item := range input {
 		v := make(map[string]interface{})
 
 		v["type"] = item.RuleSetType
@@ -691,7 +908,7 @@ func flattenWebApplicationFirewallPolicyManagedRuleSets(input *[]network.Managed
 	return results
 }
 
-func flattenWebApplicationFirewallPolicyRuleGroupOverrides(input *[]network.ManagedRuleGroupOverride) []interface{} {
+func flattenWebApplicationFirewallPolicyRuleGroupOverrides(input *[]webapplicationfirewallpolicies.ManagedRuleGroupOverride) []interface{} {
 	results := make([]interface{}, 0)
 	if input == nil {
 		return results
@@ -700,38 +917,49 @@ func flattenWebApplicationFirewallPolicyRuleGroupOverrides(input *[]network.Mana
 	for _, item := range *input {
 		v := make(map[string]interface{})
 
+		disabledRules, rules := flattenWebApplicationFirewallPolicyManagedRuleOverrides(item.Rules)
 		v["rule_group_name"] = item.RuleGroupName
-		v["disabled_rules"] = flattenWebApplicationFirewallPolicyManagedRuleOverrides(item.Rules)
+		v["disabled_rules"] = disabledRules
+		v["rule"] = rules
 
 		results = append(results, v)
 	}
 	return results
 }
 
-func flattenWebApplicationFirewallPolicyManagedRuleOverrides(input *[]network.ManagedRuleOverride) []string {
-	results := make([]string, 0)
+// flattenWebApplicationFirewallPolicyManagedRuleOverrides splits the overrides into the IDs of the rules which are
+// only disabled (`disabled_rules`) and the rules which override the action taken (`rule`)
+func flattenWebApplicationFirewallPolicyManagedRuleOverrides(input *[]webapplicationfirewallpolicies.ManagedRuleOverride) ([]string, []interface{}) {
+	disabledRules := make([]string, 0)
+	rules := make([]interface{}, 0)
 	if input == nil {
-		return results
+		return disabledRules, rules
 	}
 
 	for _, item := range *input {
-		if item.State == "" || item.State == network.ManagedRuleEnabledStateDisabled {
-			v := *item.RuleID
+		enabled := item.State != nil && *item.State == webapplicationfirewallpolicies.ManagedRuleEnabledStateEnabled
 
-			results = append(results, v)
+		if item.Action == nil {
+			if !enabled {
+				disabledRules = append(disabledRules, item.RuleId)
+			}
+			continue
 		}
+
+		rules = append(rules, map[string]interface{}{
+			"id":      item.RuleId,
+			"action":  string(*item.Action),
+			"enabled": enabled,
+		})
 	}
 
-	return results
+	return disabledRules, rules
 }
 
-func flattenWebApplicationFirewallPolicyMatchCondition(input *[]network.MatchCondition) []interface{} {
+func flattenWebApplicationFirewallPolicyMatchCondition(input []webapplicationfirewallpolicies.MatchCondition) []interface{} {
 	results := make([]interface{}, 0)
-	if input == nil {
-		return results
-	}
 
-	for _, item := range *input {
+	for _, item := range input {
 		v := make(map[string]interface{})
 
 		var transforms []interface{}
@@ -740,7 +968,7 @@ func flattenWebApplicationFirewallPolicyMatchCondition(input *[]network.MatchCon
 				transforms = append(transforms, string(trans))
 			}
 		}
-		v["match_values"] = utils.FlattenStringSlice(item.MatchValues)
+		v["match_values"] = utils.FlattenStringSlice(&item.MatchValues)
 		v["match_variables"] = flattenWebApplicationFirewallPolicyMatchVariable(item.MatchVariables)
 		if negationCondition := item.NegationConditon; negationCondition != nil {
 			v["negation_condition"] = *negationCondition
@@ -754,13 +982,10 @@ func flattenWebApplicationFirewallPolicyMatchCondition(input *[]network.MatchCon
 	return results
 }
 
-func flattenWebApplicationFirewallPolicyMatchVariable(input *[]network.MatchVariable) []interface{} {
+func flattenWebApplicationFirewallPolicyMatchVariable(input []webapplicationfirewallpolicies.MatchVariable) []interface{} {
 	results := make([]interface{}, 0)
-	if input == nil {
-		return results
-	}
 
-	for _, item := range *input {
+	for _, item := range input {
 		v := make(map[string]interface{})
 
 		if selector := item.Selector; selector != nil {
@@ -773,3 +998,20 @@ func flattenWebApplicationFirewallPolicyMatchVariable(input *[]network.MatchVari
 
 	return results
 }
+
+func flattenWebApplicationFirewallPolicySubResourcesToIDs(input *[]webapplicationfirewallpolicies.SubResource) []interface{} {
+	ids := make([]interface{}, 0)
+	if input == nil {
+		return ids
+	}
+
+	for _, v := range *input {
+		if v.Id == nil {
+			continue
+		}
+
+		ids = append(ids, *v.Id)
+	}
+
+	return ids
+}
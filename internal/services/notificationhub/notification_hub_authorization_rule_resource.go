@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceid"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/notificationhub/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/notificationhub/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -23,9 +24,8 @@ func resourceNotificationHubAuthorizationRule() *pluginsdk.Resource {
 		Read:   resourceNotificationHubAuthorizationRuleRead,
 		Update: resourceNotificationHubAuthorizationRuleCreateUpdate,
 		Delete: resourceNotificationHubAuthorizationRuleDelete,
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.NotificationHubAuthorizationRuleID(id)
-			return err
+		Importer: pluginsdk.ImporterNormalizingResourceId(func(id string) (resourceid.Formatter, error) {
+			return parse.NotificationHubAuthorizationRuleIDInsensitively(id)
 		}),
 		// TODO: customizeDiff for send+listen when manage selected
 
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceid"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/notificationhub/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/notificationhub/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
@@ -31,9 +32,8 @@ func resourceNotificationHubNamespace() *pluginsdk.Resource {
 		Read:   resourceNotificationHubNamespaceRead,
 		Update: resourceNotificationHubNamespaceCreateUpdate,
 		Delete: resourceNotificationHubNamespaceDelete,
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.NamespaceID(id)
-			return err
+		Importer: pluginsdk.ImporterNormalizingResourceId(func(id string) (resourceid.Formatter, error) {
+			return parse.NamespaceIDInsensitively(id)
 		}),
 
 		Timeouts: &pluginsdk.ResourceTimeout{
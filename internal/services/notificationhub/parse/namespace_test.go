@@ -199,6 +199,16 @@ func TestNamespaceIDInsensitively(t *testing.T) {
 				Name:           "namespace1",
 			},
 		},
+
+		{
+			// upper-cased root segment names
+			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/Microsoft.NotificationHubs/namespaces/namespace1",
+			Expected: &NamespaceId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "resGroup1",
+				Name:           "namespace1",
+			},
+		},
 	}
 
 	for _, v := range testData {
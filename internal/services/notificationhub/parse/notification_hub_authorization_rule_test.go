@@ -263,6 +263,18 @@ func TestNotificationHubAuthorizationRuleIDInsensitively(t *testing.T) {
 				AuthorizationRuleName: "authorizationRule1",
 			},
 		},
+
+		{
+			// upper-cased root segment names
+			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/Microsoft.NotificationHubs/namespaces/namespace1/notificationHubs/hub1/authorizationRules/authorizationRule1",
+			Expected: &NotificationHubAuthorizationRuleId{
+				SubscriptionId:        "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:         "resGroup1",
+				NamespaceName:         "namespace1",
+				NotificationHubName:   "hub1",
+				AuthorizationRuleName: "authorizationRule1",
+			},
+		},
 	}
 
 	for _, v := range testData {
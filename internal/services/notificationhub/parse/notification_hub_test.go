@@ -231,6 +231,17 @@ func TestNotificationHubIDInsensitively(t *testing.T) {
 				Name:           "hub1",
 			},
 		},
+
+		{
+			// upper-cased root segment names
+			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/Microsoft.NotificationHubs/namespaces/namespace1/notificationHubs/hub1",
+			Expected: &NotificationHubId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "resGroup1",
+				NamespaceName:  "namespace1",
+				Name:           "hub1",
+			},
+		},
 	}
 
 	for _, v := range testData {
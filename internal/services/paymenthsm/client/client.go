@@ -0,0 +1,19 @@
+package client
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/paymenthsm/sdk/2021-11-30/dedicatedhsms"
+)
+
+type Client struct {
+	DedicatedHsmsClient *dedicatedhsms.DedicatedHsmsClient
+}
+
+func NewClient(o *common.ClientOptions) *Client {
+	dedicatedHsmsClient := dedicatedhsms.NewDedicatedHsmsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&dedicatedHsmsClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		DedicatedHsmsClient: &dedicatedHsmsClient,
+	}
+}
@@ -0,0 +1,326 @@
+package paymenthsm
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	azValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	hsmValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/hsm/validate"
+	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/paymenthsm/sdk/2021-11-30/dedicatedhsms"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.ResourceWithUpdate = PaymentHsmResource{}
+
+type PaymentHsmResource struct{}
+
+type PaymentHsmResourceModel struct {
+	Name                     string              `tfschema:"name"`
+	ResourceGroupName        string              `tfschema:"resource_group_name"`
+	Location                 string              `tfschema:"location"`
+	SkuName                  string              `tfschema:"sku_name"`
+	StampId                  string              `tfschema:"stamp_id"`
+	NetworkProfile           []PaymentHsmNetwork `tfschema:"network_profile"`
+	ManagementNetworkProfile []PaymentHsmNetwork `tfschema:"management_network_profile"`
+	Tags                     map[string]string   `tfschema:"tags"`
+}
+
+type PaymentHsmNetwork struct {
+	SubnetId                           string   `tfschema:"subnet_id"`
+	NetworkInterfacePrivateIpAddresses []string `tfschema:"network_interface_private_ip_addresses"`
+}
+
+func (r PaymentHsmResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: hsmValidate.DedicatedHardwareSecurityModuleName,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": location.Schema(),
+
+		"sku_name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(dedicatedhsms.SkuNamePayShieldOneZeroKLMKOneCPSSixZero),
+				string(dedicatedhsms.SkuNamePayShieldOneZeroKLMKOneCPSTwoFiveZero),
+				string(dedicatedhsms.SkuNamePayShieldOneZeroKLMKOneCPSTwoFiveZeroZero),
+				string(dedicatedhsms.SkuNamePayShieldOneZeroKLMKTwoCPSSixZero),
+				string(dedicatedhsms.SkuNamePayShieldOneZeroKLMKTwoCPSTwoFiveZero),
+				string(dedicatedhsms.SkuNamePayShieldOneZeroKLMKTwoCPSTwoFiveZeroZero),
+			}, false),
+		},
+
+		"stamp_id": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				"stamp1",
+				"stamp2",
+			}, false),
+		},
+
+		"network_profile": r.networkProfileSchema(true),
+
+		"management_network_profile": r.networkProfileSchema(false),
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r PaymentHsmResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r PaymentHsmResource) ModelObject() interface{} {
+	return &PaymentHsmResourceModel{}
+}
+
+func (r PaymentHsmResource) ResourceType() string {
+	return "azurerm_payment_hsm"
+}
+
+func (r PaymentHsmResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return dedicatedhsms.ValidateDedicatedHSMID
+}
+
+func (r PaymentHsmResource) networkProfileSchema(required bool) *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Required: required,
+		Optional: !required,
+		ForceNew: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				// the Subnet must be delegated to `Microsoft.HardwareSecurityModules/dedicatedHSMs`
+				"subnet_id": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: networkValidate.SubnetID,
+				},
+
+				"network_interface_private_ip_addresses": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Computed: true,
+					ForceNew: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: azValidate.IPv4Address,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r PaymentHsmResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PaymentHSM.DedicatedHsmsClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model PaymentHsmResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := dedicatedhsms.NewDedicatedHSMID(subscriptionId, model.ResourceGroupName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			skuName := dedicatedhsms.SkuName(model.SkuName)
+			payload := dedicatedhsms.DedicatedHsm{
+				Location: location.Normalize(model.Location),
+				Properties: dedicatedhsms.DedicatedHsmProperties{
+					NetworkProfile:           expandPaymentHsmNetworkProfile(model.NetworkProfile),
+					ManagementNetworkProfile: expandPaymentHsmNetworkProfile(model.ManagementNetworkProfile),
+					StampId:                  utils.String(model.StampId),
+				},
+				Sku: dedicatedhsms.Sku{
+					Name: &skuName,
+				},
+				Tags: &model.Tags,
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r PaymentHsmResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PaymentHSM.DedicatedHsmsClient
+
+			id, err := dedicatedhsms.ParseDedicatedHSMID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := PaymentHsmResourceModel{
+				Name:              id.DedicatedHSMName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+
+				if model.Sku.Name != nil {
+					state.SkuName = string(*model.Sku.Name)
+				}
+
+				props := model.Properties
+				state.StampId = utils.NormalizeNilableString(props.StampId)
+				state.NetworkProfile = flattenPaymentHsmNetworkProfile(props.NetworkProfile)
+				state.ManagementNetworkProfile = flattenPaymentHsmNetworkProfile(props.ManagementNetworkProfile)
+
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r PaymentHsmResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PaymentHSM.DedicatedHsmsClient
+
+			id, err := dedicatedhsms.ParseDedicatedHSMID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model PaymentHsmResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			payload := dedicatedhsms.DedicatedHsmPatchParameters{}
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = &model.Tags
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r PaymentHsmResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PaymentHSM.DedicatedHsmsClient
+
+			id, err := dedicatedhsms.ParseDedicatedHSMID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandPaymentHsmNetworkProfile(input []PaymentHsmNetwork) *dedicatedhsms.NetworkProfile {
+	if len(input) == 0 {
+		return nil
+	}
+
+	v := input[0]
+	result := dedicatedhsms.NetworkProfile{
+		Subnet: &dedicatedhsms.ApiEntityReference{
+			Id: utils.String(v.SubnetId),
+		},
+	}
+
+	// when omitted the private IP Addresses are allocated from the Subnet by the service
+	if len(v.NetworkInterfacePrivateIpAddresses) > 0 {
+		networkInterfaces := make([]dedicatedhsms.NetworkInterface, 0)
+		for _, ipAddress := range v.NetworkInterfacePrivateIpAddresses {
+			networkInterfaces = append(networkInterfaces, dedicatedhsms.NetworkInterface{
+				PrivateIPAddress: utils.String(ipAddress),
+			})
+		}
+		result.NetworkInterfaces = &networkInterfaces
+	}
+
+	return &result
+}
+
+func flattenPaymentHsmNetworkProfile(input *dedicatedhsms.NetworkProfile) []PaymentHsmNetwork {
+	if input == nil {
+		return []PaymentHsmNetwork{}
+	}
+
+	subnetId := ""
+	if input.Subnet != nil && input.Subnet.Id != nil {
+		subnetId = *input.Subnet.Id
+	}
+
+	ipAddresses := make([]string, 0)
+	if input.NetworkInterfaces != nil {
+		for _, item := range *input.NetworkInterfaces {
+			if item.PrivateIPAddress != nil {
+				ipAddresses = append(ipAddresses, *item.PrivateIPAddress)
+			}
+		}
+	}
+
+	return []PaymentHsmNetwork{
+		{
+			SubnetId:                           subnetId,
+			NetworkInterfacePrivateIpAddresses: ipAddresses,
+		},
+	}
+}
@@ -0,0 +1,212 @@
+package paymenthsm_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/paymenthsm/sdk/2021-11-30/dedicatedhsms"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type PaymentHsmResource struct{}
+
+func TestAccPaymentHsm_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_payment_hsm", "test")
+	r := PaymentHsmResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("network_profile.0.network_interface_private_ip_addresses.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccPaymentHsm_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_payment_hsm", "test")
+	r := PaymentHsmResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccPaymentHsm_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_payment_hsm", "test")
+	r := PaymentHsmResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data, "Production"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data, "Test"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("tags.environment").HasValue("Test"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r PaymentHsmResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := dedicatedhsms.ParseDedicatedHSMID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.PaymentHSM.DedicatedHsmsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r PaymentHsmResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_payment_hsm" "test" {
+  name                = "acctest-phsm-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku_name            = "payShield10K_LMK1_CPS60"
+  stamp_id            = "stamp1"
+
+  network_profile {
+    subnet_id = azurerm_subnet.test.id
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r PaymentHsmResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_payment_hsm" "import" {
+  name                = azurerm_payment_hsm.test.name
+  resource_group_name = azurerm_payment_hsm.test.resource_group_name
+  location            = azurerm_payment_hsm.test.location
+  sku_name            = azurerm_payment_hsm.test.sku_name
+  stamp_id            = azurerm_payment_hsm.test.stamp_id
+
+  network_profile {
+    subnet_id = azurerm_subnet.test.id
+  }
+}
+`, r.basic(data))
+}
+
+func (r PaymentHsmResource) complete(data acceptance.TestData, environment string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_subnet" "management" {
+  name                 = "acctest-mgmt-%[2]d"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.2.1.0/24"]
+
+  delegation {
+    name = "first"
+
+    service_delegation {
+      name = "Microsoft.HardwareSecurityModules/dedicatedHSMs"
+
+      actions = [
+        "Microsoft.Network/networkinterfaces/*",
+        "Microsoft.Network/virtualNetworks/subnets/join/action",
+      ]
+    }
+  }
+}
+
+resource "azurerm_payment_hsm" "test" {
+  name                = "acctest-phsm-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku_name            = "payShield10K_LMK1_CPS60"
+  stamp_id            = "stamp1"
+
+  network_profile {
+    subnet_id                              = azurerm_subnet.test.id
+    network_interface_private_ip_addresses = ["10.2.0.5"]
+  }
+
+  management_network_profile {
+    subnet_id                              = azurerm_subnet.management.id
+    network_interface_private_ip_addresses = ["10.2.1.5"]
+  }
+
+  tags = {
+    environment = "%[3]s"
+  }
+}
+`, r.template(data), data.RandomInteger, environment)
+}
+
+func (PaymentHsmResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-phsm-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctest-vnet-%[1]d"
+  address_space       = ["10.2.0.0/16"]
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "acctest-hsm-%[1]d"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.2.0.0/24"]
+
+  delegation {
+    name = "first"
+
+    service_delegation {
+      name = "Microsoft.HardwareSecurityModules/dedicatedHSMs"
+
+      actions = [
+        "Microsoft.Network/networkinterfaces/*",
+        "Microsoft.Network/virtualNetworks/subnets/join/action",
+      ]
+    }
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
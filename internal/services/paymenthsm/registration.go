@@ -0,0 +1,29 @@
+package paymenthsm
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+var _ sdk.TypedServiceRegistration = Registration{}
+
+type Registration struct{}
+
+func (r Registration) Name() string {
+	return "Payment HSM"
+}
+
+func (r Registration) WebsiteCategories() []string {
+	return []string{
+		"Payment HSM",
+	}
+}
+
+func (r Registration) DataSources() []sdk.DataSource {
+	return []sdk.DataSource{}
+}
+
+func (r Registration) Resources() []sdk.Resource {
+	return []sdk.Resource{
+		PaymentHsmResource{},
+	}
+}
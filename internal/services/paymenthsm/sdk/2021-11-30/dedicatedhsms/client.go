@@ -0,0 +1,15 @@
+package dedicatedhsms
+
+import "github.com/Azure/go-autorest/autorest"
+
+type DedicatedHsmsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewDedicatedHsmsClientWithBaseURI(endpoint string) DedicatedHsmsClient {
+	return DedicatedHsmsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
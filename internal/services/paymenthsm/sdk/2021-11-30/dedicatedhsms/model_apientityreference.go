@@ -0,0 +1,5 @@
+package dedicatedhsms
+
+type ApiEntityReference struct {
+	Id *string `json:"id,omitempty"`
+}
@@ -0,0 +1,13 @@
+package dedicatedhsms
+
+type DedicatedHsm struct {
+	Id         *string                `json:"id,omitempty"`
+	Location   string                 `json:"location"`
+	Name       *string                `json:"name,omitempty"`
+	Properties DedicatedHsmProperties `json:"properties"`
+	Sku        Sku                    `json:"sku"`
+	SystemData *SystemData            `json:"systemData,omitempty"`
+	Tags       *map[string]string     `json:"tags,omitempty"`
+	Type       *string                `json:"type,omitempty"`
+	Zones      *[]string              `json:"zones,omitempty"`
+}
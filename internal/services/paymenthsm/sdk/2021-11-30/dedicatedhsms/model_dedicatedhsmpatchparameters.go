@@ -0,0 +1,5 @@
+package dedicatedhsms
+
+type DedicatedHsmPatchParameters struct {
+	Tags *map[string]string `json:"tags,omitempty"`
+}
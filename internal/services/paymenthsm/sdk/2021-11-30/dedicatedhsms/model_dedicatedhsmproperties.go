@@ -0,0 +1,9 @@
+package dedicatedhsms
+
+type DedicatedHsmProperties struct {
+	ManagementNetworkProfile *NetworkProfile `json:"managementNetworkProfile,omitempty"`
+	NetworkProfile           *NetworkProfile `json:"networkProfile,omitempty"`
+	ProvisioningState        *JsonWebKeyType `json:"provisioningState,omitempty"`
+	StampId                  *string         `json:"stampId,omitempty"`
+	StatusMessage            *string         `json:"statusMessage,omitempty"`
+}
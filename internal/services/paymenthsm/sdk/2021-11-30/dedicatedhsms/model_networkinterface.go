@@ -0,0 +1,6 @@
+package dedicatedhsms
+
+type NetworkInterface struct {
+	Id               *string `json:"id,omitempty"`
+	PrivateIPAddress *string `json:"privateIpAddress,omitempty"`
+}
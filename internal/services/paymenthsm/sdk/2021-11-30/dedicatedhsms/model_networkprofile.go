@@ -0,0 +1,6 @@
+package dedicatedhsms
+
+type NetworkProfile struct {
+	NetworkInterfaces *[]NetworkInterface `json:"networkInterfaces,omitempty"`
+	Subnet            *ApiEntityReference `json:"subnet,omitempty"`
+}
@@ -0,0 +1,5 @@
+package dedicatedhsms
+
+type Sku struct {
+	Name *SkuName `json:"name,omitempty"`
+}
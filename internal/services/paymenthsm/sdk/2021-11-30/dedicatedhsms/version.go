@@ -0,0 +1,9 @@
+package dedicatedhsms
+
+import "fmt"
+
+const defaultApiVersion = "2021-11-30"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/dedicatedhsms/%s", defaultApiVersion)
+}
@@ -0,0 +1,200 @@
+package azuresdkhacks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/policyinsights/mgmt/2019-10-01-preview/policyinsights"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// The version of the Azure SDK for Go in use (API Version 2019-10-01-preview) doesn't expose the Resource ID filter,
+// the number of Parallel Deployments, the Resource Count or the Failure Threshold for a Remediation - as such these
+// functions send the requests using a newer API Version and patch the missing fields into (and out of) the payloads.
+// This can be removed once the Policy Insights SDK has been updated.
+
+const remediationAPIVersion = "2024-10-01"
+
+// RemediationExtendedProperties contains the fields missing from policyinsights.Remediation
+type RemediationExtendedProperties struct {
+	// ResourceIds is sent as `properties.filters.resourceIds`
+	ResourceIds *[]string
+
+	ParallelDeployments *int32
+	ResourceCount       *int32
+
+	// FailurePercentage is sent as `properties.failureThreshold.percentage`
+	FailurePercentage *float64
+}
+
+type remediationExtendedResponse struct {
+	Properties *struct {
+		Filters *struct {
+			ResourceIds *[]string `json:"resourceIds,omitempty"`
+		} `json:"filters,omitempty"`
+		ParallelDeployments *int32 `json:"parallelDeployments,omitempty"`
+		ResourceCount       *int32 `json:"resourceCount,omitempty"`
+		FailureThreshold    *struct {
+			Percentage *float64 `json:"percentage,omitempty"`
+		} `json:"failureThreshold,omitempty"`
+	} `json:"properties,omitempty"`
+}
+
+// CreateOrUpdateRemediation creates or updates the Remediation at the specified Scope including the extended properties
+func CreateOrUpdateRemediation(ctx context.Context, client *policyinsights.RemediationsClient, scopeId string, remediationName string, parameters policyinsights.Remediation, extended RemediationExtendedProperties) (result policyinsights.Remediation, err error) {
+	req, err := remediationPreparer(ctx, client, scopeId, remediationName, autorest.AsPut(), withExtendedRemediationJSON(parameters, extended))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "policyinsights.RemediationsClient", "CreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.CreateOrUpdateAtResourceSender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "policyinsights.RemediationsClient", "CreateOrUpdate", resp, "Failure sending request")
+		return
+	}
+
+	result, err = client.CreateOrUpdateAtResourceResponder(resp)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "policyinsights.RemediationsClient", "CreateOrUpdate", resp, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// GetRemediation retrieves the Remediation at the specified Scope along with the extended properties
+func GetRemediation(ctx context.Context, client *policyinsights.RemediationsClient, scopeId string, remediationName string) (result policyinsights.Remediation, extended RemediationExtendedProperties, err error) {
+	req, err := remediationPreparer(ctx, client, scopeId, remediationName, autorest.AsGet())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "policyinsights.RemediationsClient", "Get", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.GetAtResourceSender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "policyinsights.RemediationsClient", "Get", resp, "Failure sending request")
+		return
+	}
+
+	var body bytes.Buffer
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByCopying(&body),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "policyinsights.RemediationsClient", "Get", resp, "Failure responding to request")
+		return
+	}
+
+	var raw remediationExtendedResponse
+	if err = json.Unmarshal(body.Bytes(), &raw); err != nil {
+		err = autorest.NewErrorWithError(err, "policyinsights.RemediationsClient", "Get", resp, "Failure unmarshalling extended properties")
+		return
+	}
+
+	if props := raw.Properties; props != nil {
+		if props.Filters != nil {
+			extended.ResourceIds = props.Filters.ResourceIds
+		}
+		extended.ParallelDeployments = props.ParallelDeployments
+		extended.ResourceCount = props.ResourceCount
+		if props.FailureThreshold != nil {
+			extended.FailurePercentage = props.FailureThreshold.Percentage
+		}
+	}
+
+	return
+}
+
+func remediationPreparer(ctx context.Context, client *policyinsights.RemediationsClient, scopeId string, remediationName string, method autorest.PrepareDecorator, decorators ...autorest.PrepareDecorator) (*http.Request, error) {
+	// each of the Scopes (Management Group, Subscription, Resource Group and Resource) share the same path format
+	pathParameters := map[string]interface{}{
+		"remediationName": autorest.Encode("path", remediationName),
+		"scope":           strings.TrimPrefix(scopeId, "/"),
+	}
+
+	queryParameters := map[string]interface{}{
+		"api-version": remediationAPIVersion,
+	}
+
+	decorators = append([]autorest.PrepareDecorator{
+		autorest.AsContentType("application/json; charset=utf-8"),
+		method,
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters("/{scope}/providers/Microsoft.PolicyInsights/remediations/{remediationName}", pathParameters),
+		autorest.WithQueryParameters(queryParameters),
+	}, decorators...)
+
+	preparer := autorest.CreatePreparer(decorators...)
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+func withExtendedRemediationJSON(parameters policyinsights.Remediation, extended RemediationExtendedProperties) autorest.PrepareDecorator {
+	return func(p autorest.Preparer) autorest.Preparer {
+		return autorest.PreparerFunc(func(r *http.Request) (*http.Request, error) {
+			r, err := p.Prepare(r)
+			if err != nil {
+				return r, err
+			}
+
+			b, err := json.Marshal(parameters)
+			if err != nil {
+				return r, err
+			}
+
+			var out map[string]interface{}
+			if err := json.Unmarshal(b, &out); err != nil {
+				return r, err
+			}
+
+			props, ok := out["properties"].(map[string]interface{})
+			if !ok {
+				props = make(map[string]interface{})
+				out["properties"] = props
+			}
+
+			if extended.ResourceIds != nil {
+				filters, ok := props["filters"].(map[string]interface{})
+				if !ok {
+					filters = make(map[string]interface{})
+					props["filters"] = filters
+				}
+				filters["resourceIds"] = *extended.ResourceIds
+			}
+
+			if extended.ParallelDeployments != nil {
+				props["parallelDeployments"] = *extended.ParallelDeployments
+			}
+
+			if extended.ResourceCount != nil {
+				props["resourceCount"] = *extended.ResourceCount
+			}
+
+			if extended.FailurePercentage != nil {
+				props["failureThreshold"] = map[string]interface{}{
+					"percentage": *extended.FailurePercentage,
+				}
+			}
+
+			b, err = json.Marshal(out)
+			if err != nil {
+				return r, err
+			}
+
+			r.ContentLength = int64(len(b))
+			r.Body = io.NopCloser(bytes.NewReader(b))
+			return r, nil
+		})
+	}
+}
@@ -231,6 +231,17 @@ func TestVirtualMachineConfigurationAssignmentIDInsensitively(t *testing.T) {
 				GuestConfigurationAssignmentName: "assignment1",
 			},
 		},
+
+		{
+			// upper-cased root segment names
+			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/Microsoft.Compute/virtualMachines/vm1/PROVIDERS/Microsoft.GuestConfiguration/guestConfigurationAssignments/assignment1",
+			Expected: &VirtualMachineConfigurationAssignmentId{
+				SubscriptionId:                   "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:                    "resGroup1",
+				VirtualMachineName:               "vm1",
+				GuestConfigurationAssignmentName: "assignment1",
+			},
+		},
 	}
 
 	for _, v := range testData {
@@ -7,8 +7,10 @@ import (
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/policyinsights/mgmt/2019-10-01-preview/policyinsights"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/azuresdkhacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -71,6 +73,35 @@ func resourceArmPolicyRemediation() *pluginsdk.Resource {
 				},
 			},
 
+			"resource_ids": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: azure.ValidateResourceID,
+				},
+			},
+
+			"failure_percentage": {
+				Type:         pluginsdk.TypeFloat,
+				Optional:     true,
+				ValidateFunc: validation.FloatBetween(0, 1),
+			},
+
+			"parallel_deployments": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 30),
+			},
+
+			"resource_count": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 50000),
+			},
+
 			"policy_definition_reference_id": {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
@@ -125,18 +156,21 @@ func resourceArmPolicyRemediationCreateUpdate(d *pluginsdk.ResourceData, meta in
 		},
 	}
 
-	switch scope := scope.(type) {
-	case parse.ScopeAtSubscription:
-		_, err = client.CreateOrUpdateAtSubscription(ctx, scope.SubscriptionId, name, parameters)
-	case parse.ScopeAtResourceGroup:
-		_, err = client.CreateOrUpdateAtResourceGroup(ctx, scope.SubscriptionId, scope.ResourceGroup, name, parameters)
-	case parse.ScopeAtResource:
-		_, err = client.CreateOrUpdateAtResource(ctx, scope.ScopeId(), name, parameters)
-	case parse.ScopeAtManagementGroup:
-		_, err = client.CreateOrUpdateAtManagementGroup(ctx, scope.ManagementGroupName, name, parameters)
-	default:
-		return fmt.Errorf("creating/updating Policy Remediation %q: invalid scope type", name)
+	extendedProps := azuresdkhacks.RemediationExtendedProperties{}
+	if v, ok := d.GetOk("resource_ids"); ok {
+		extendedProps.ResourceIds = utils.ExpandStringSlice(v.([]interface{}))
+	}
+	if v, ok := d.GetOk("failure_percentage"); ok {
+		extendedProps.FailurePercentage = utils.Float(v.(float64))
+	}
+	if v, ok := d.GetOk("parallel_deployments"); ok {
+		extendedProps.ParallelDeployments = utils.Int32(int32(v.(int)))
 	}
+	if v, ok := d.GetOk("resource_count"); ok {
+		extendedProps.ResourceCount = utils.Int32(int32(v.(int)))
+	}
+
+	_, err = azuresdkhacks.CreateOrUpdateRemediation(ctx, client, scope.ScopeId(), name, parameters, extendedProps)
 	if err != nil {
 		return fmt.Errorf("creating/updating Policy Remediation %q (Scope %q): %+v", name, scope.ScopeId(), err)
 	}
@@ -164,7 +198,7 @@ func resourceArmPolicyRemediationRead(d *pluginsdk.ResourceData, meta interface{
 		return fmt.Errorf("reading Policy Remediation: %+v", err)
 	}
 
-	resp, err := RemediationGetAtScope(ctx, client, id.Name, id.PolicyScopeId)
+	resp, extendedProps, err := azuresdkhacks.GetRemediation(ctx, client, id.ScopeId(), id.Name)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			log.Printf("[INFO] Policy Remediation %q does not exist - removing from state", d.Id())
@@ -191,6 +225,28 @@ func resourceArmPolicyRemediationRead(d *pluginsdk.ResourceData, meta interface{
 		d.Set("resource_discovery_mode", string(props.ResourceDiscoveryMode))
 	}
 
+	if err := d.Set("resource_ids", utils.FlattenStringSlice(extendedProps.ResourceIds)); err != nil {
+		return fmt.Errorf("setting `resource_ids`: %+v", err)
+	}
+
+	failurePercentage := 0.0
+	if extendedProps.FailurePercentage != nil {
+		failurePercentage = *extendedProps.FailurePercentage
+	}
+	d.Set("failure_percentage", failurePercentage)
+
+	parallelDeployments := 0
+	if extendedProps.ParallelDeployments != nil {
+		parallelDeployments = int(*extendedProps.ParallelDeployments)
+	}
+	d.Set("parallel_deployments", parallelDeployments)
+
+	resourceCount := 0
+	if extendedProps.ResourceCount != nil {
+		resourceCount = int(*extendedProps.ResourceCount)
+	}
+	d.Set("resource_count", resourceCount)
+
 	return nil
 }
 
@@ -91,6 +91,23 @@ func TestAccAzureRMPolicyRemediation_atManagementGroup(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMPolicyRemediation_atManagementGroupComplete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_policy_remediation", "test")
+	r := PolicyRemediationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.atManagementGroupComplete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("parallel_deployments").HasValue("5"),
+				check.That(data.ResourceName).Key("resource_count").HasValue("100"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccAzureRMPolicyRemediation_atResource(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_policy_remediation", "test")
 	r := PolicyRemediationResource{}
@@ -620,6 +637,79 @@ resource "azurerm_policy_remediation" "test" {
 `, data.RandomString)
 }
 
+func (r PolicyRemediationResource) atManagementGroupComplete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_management_group" "test" {
+  display_name = "acctest-policy-%[1]s"
+}
+
+resource "azurerm_policy_definition" "test" {
+  name                = "acctestDef-%[1]s"
+  policy_type         = "Custom"
+  mode                = "All"
+  display_name        = "my-policy-definition"
+  management_group_id = azurerm_management_group.test.group_id
+
+  policy_rule = <<POLICY_RULE
+    {
+    "if": {
+      "not": {
+        "field": "location",
+        "in": "[parameters('allowedLocations')]"
+      }
+    },
+    "then": {
+      "effect": "audit"
+    }
+  }
+POLICY_RULE
+
+  parameters = <<PARAMETERS
+    {
+    "allowedLocations": {
+      "type": "Array",
+      "metadata": {
+        "description": "The list of allowed locations for resources.",
+        "displayName": "Allowed locations",
+        "strongType": "location"
+      }
+    }
+  }
+PARAMETERS
+}
+
+resource "azurerm_policy_assignment" "test" {
+  name                 = "acctestAssign-%[1]s"
+  scope                = azurerm_management_group.test.id
+  policy_definition_id = azurerm_policy_definition.test.id
+  description          = "Policy Assignment created via an Acceptance Test"
+  display_name         = "My Example Policy Assignment"
+
+  parameters = <<PARAMETERS
+{
+  "allowedLocations": {
+    "value": [ "West Europe" ]
+  }
+}
+PARAMETERS
+}
+
+resource "azurerm_policy_remediation" "test" {
+  name                 = "acctestremediation-%[1]s"
+  scope                = azurerm_policy_assignment.test.scope
+  policy_assignment_id = azurerm_policy_assignment.test.id
+  location_filters     = ["westeurope"]
+  failure_percentage   = 0.5
+  parallel_deployments = 5
+  resource_count       = 100
+}
+`, data.RandomString)
+}
+
 func (r PolicyRemediationResource) atResource(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
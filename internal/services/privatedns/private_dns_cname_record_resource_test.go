@@ -3,6 +3,7 @@ package privatedns_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/Azure/azure-sdk-for-go/services/privatedns/mgmt/2018-09-01/privatedns"
@@ -61,6 +62,31 @@ func TestAccPrivateDnsCNameRecord_subdomain(t *testing.T) {
 	})
 }
 
+func TestAccPrivateDnsCNameRecord_wildcard(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_private_dns_cname_record", "test")
+	r := PrivateDnsCNameRecordResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.wildcard(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccPrivateDnsCNameRecord_apex(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_private_dns_cname_record", "test")
+	r := PrivateDnsCNameRecordResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.apex(data),
+			ExpectError: regexp.MustCompile("cannot be the apex of the zone"),
+		},
+	})
+}
+
 func TestAccPrivateDnsCNameRecord_updateRecords(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_private_dns_cname_record", "test")
 	r := PrivateDnsCNameRecordResource{}
@@ -182,6 +208,58 @@ resource "azurerm_private_dns_cname_record" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
 }
 
+func (PrivateDnsCNameRecordResource) wildcard(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_private_dns_zone" "test" {
+  name                = "acctestzone%d.com"
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_private_dns_cname_record" "test" {
+  name                = "*.acctestcname%d"
+  resource_group_name = azurerm_resource_group.test.name
+  zone_name           = azurerm_private_dns_zone.test.name
+  ttl                 = 300
+  record              = "contoso.com"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
+}
+
+func (PrivateDnsCNameRecordResource) apex(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_private_dns_zone" "test" {
+  name                = "acctestzone%d.com"
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_private_dns_cname_record" "test" {
+  name                = "@"
+  resource_group_name = azurerm_resource_group.test.name
+  zone_name           = azurerm_private_dns_zone.test.name
+  ttl                 = 300
+  record              = "contoso.com"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
 func (PrivateDnsCNameRecordResource) updateRecords(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -0,0 +1,139 @@
+package privatedns
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/privatedns/mgmt/2018-09-01/privatedns"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func dataSourcePrivateDnsZoneVirtualNetworkLinks() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourcePrivateDnsZoneVirtualNetworkLinksRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"private_dns_zone_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupNameForDataSource(),
+
+			"virtual_network_links": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"virtual_network_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"registration_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Computed: true,
+						},
+
+						"tags": tags.SchemaDataSource(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePrivateDnsZoneVirtualNetworkLinksRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).PrivateDns.VirtualNetworkLinksClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewPrivateDnsZoneID(subscriptionId, d.Get("resource_group_name").(string), d.Get("private_dns_zone_name").(string))
+
+	iterator, err := client.ListComplete(ctx, id.ResourceGroup, id.Name, nil)
+	if err != nil {
+		if utils.ResponseWasNotFound(iterator.Response().Response) {
+			return fmt.Errorf("%s was not found", id)
+		}
+		return fmt.Errorf("listing Virtual Network Links for %s: %+v", id, err)
+	}
+
+	links := make([]privatedns.VirtualNetworkLink, 0)
+	for iterator.NotDone() {
+		links = append(links, iterator.Value())
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("listing Virtual Network Links for %s: %+v", id, err)
+		}
+	}
+
+	d.SetId(id.ID())
+
+	d.Set("private_dns_zone_name", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+
+	if err := d.Set("virtual_network_links", flattenPrivateDnsZoneVirtualNetworkLinks(links)); err != nil {
+		return fmt.Errorf("setting `virtual_network_links`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenPrivateDnsZoneVirtualNetworkLinks(input []privatedns.VirtualNetworkLink) []interface{} {
+	results := make([]interface{}, 0)
+
+	for _, item := range input {
+		id := ""
+		if item.ID != nil {
+			id = *item.ID
+		}
+
+		name := ""
+		if item.Name != nil {
+			name = *item.Name
+		}
+
+		virtualNetworkId := ""
+		registrationEnabled := false
+		if props := item.VirtualNetworkLinkProperties; props != nil {
+			if props.VirtualNetwork != nil && props.VirtualNetwork.ID != nil {
+				virtualNetworkId = *props.VirtualNetwork.ID
+			}
+			if props.RegistrationEnabled != nil {
+				registrationEnabled = *props.RegistrationEnabled
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"id":                   id,
+			"name":                 name,
+			"virtual_network_id":   virtualNetworkId,
+			"registration_enabled": registrationEnabled,
+			"tags":                 tags.Flatten(item.Tags),
+		})
+	}
+
+	return results
+}
@@ -0,0 +1,84 @@
+package privatedns_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type PrivateDnsZoneVirtualNetworkLinksDataSource struct {
+}
+
+func TestAccDataSourcePrivateDnsZoneVirtualNetworkLinks_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_private_dns_zone_virtual_network_links", "test")
+	r := PrivateDnsZoneVirtualNetworkLinksDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("virtual_network_links.#").HasValue("2"),
+				check.That(data.ResourceName).Key("virtual_network_links.0.virtual_network_id").Exists(),
+			),
+		},
+	})
+}
+
+func (PrivateDnsZoneVirtualNetworkLinksDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_network" "hub" {
+  name                = "acctestvnet-hub-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  address_space       = ["10.0.0.0/16"]
+}
+
+resource "azurerm_virtual_network" "spoke" {
+  name                = "acctestvnet-spoke-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  address_space       = ["10.1.0.0/16"]
+}
+
+resource "azurerm_private_dns_zone" "test" {
+  name                = "acctestzone%[1]d.com"
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_private_dns_zone_virtual_network_link" "hub" {
+  name                  = "acctestlink-hub-%[1]d"
+  private_dns_zone_name = azurerm_private_dns_zone.test.name
+  virtual_network_id    = azurerm_virtual_network.hub.id
+  resource_group_name   = azurerm_resource_group.test.name
+  registration_enabled  = true
+}
+
+resource "azurerm_private_dns_zone_virtual_network_link" "spoke" {
+  name                  = "acctestlink-spoke-%[1]d"
+  private_dns_zone_name = azurerm_private_dns_zone.test.name
+  virtual_network_id    = azurerm_virtual_network.spoke.id
+  resource_group_name   = azurerm_resource_group.test.name
+}
+
+data "azurerm_private_dns_zone_virtual_network_links" "test" {
+  private_dns_zone_name = azurerm_private_dns_zone.test.name
+  resource_group_name   = azurerm_resource_group.test.name
+
+  depends_on = [
+    azurerm_private_dns_zone_virtual_network_link.hub,
+    azurerm_private_dns_zone_virtual_network_link.spoke,
+  ]
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
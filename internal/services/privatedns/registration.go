@@ -21,7 +21,8 @@ func (r Registration) WebsiteCategories() []string {
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurerm_private_dns_zone": dataSourcePrivateDnsZone(),
+		"azurerm_private_dns_zone":                       dataSourcePrivateDnsZone(),
+		"azurerm_private_dns_zone_virtual_network_links": dataSourcePrivateDnsZoneVirtualNetworkLinks(),
 	}
 }
 
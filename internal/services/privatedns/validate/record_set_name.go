@@ -0,0 +1,70 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// recordSetNameLabelRegex matches a single label within the name of a Record Set - underscores are allowed since
+// these are used by SRV and TXT Records (for example `_sip._tcp` or `_dmarc`)
+var recordSetNameLabelRegex = regexp.MustCompile(`^[a-zA-Z\d_-]{1,63}$`)
+
+// RecordSetName validates the name of a Record Set, which can be either the apex of the zone (`@`), a relative
+// name (e.g. `www` or `_sip._tcp`) or a wildcard name (e.g. `*` or `*.internal`)
+func RecordSetName(v interface{}, k string) (warnings []string, errors []error) {
+	return validateRecordSetName(v, k, true)
+}
+
+// CNameRecordName validates the name of a CNAME Record, which can't be the apex of the zone (`@`) since a CNAME
+// Record can't coexist with the SOA Record at the apex
+func CNameRecordName(v interface{}, k string) (warnings []string, errors []error) {
+	return validateRecordSetName(v, k, false)
+}
+
+func validateRecordSetName(v interface{}, k string, allowApex bool) (warnings []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if value == "@" {
+		if !allowApex {
+			errors = append(errors, fmt.Errorf("%q cannot be the apex of the zone (`@`) for this record type", k))
+		}
+		return warnings, errors
+	}
+
+	if len(value) == 0 || len(value) > 253 {
+		errors = append(errors, fmt.Errorf("%q must be between 1 and 253 characters", k))
+		return warnings, errors
+	}
+
+	for i, label := range strings.Split(value, ".") {
+		if label == "*" {
+			if i != 0 {
+				errors = append(errors, fmt.Errorf("%q can only contain a wildcard (`*`) as the left-most label, for example `*` or `*.internal`", k))
+				return warnings, errors
+			}
+			continue
+		}
+
+		if label == "" {
+			errors = append(errors, fmt.Errorf("%q cannot start or end with a period, or contain consecutive periods", k))
+			return warnings, errors
+		}
+
+		if label == "@" || strings.Contains(label, "*") {
+			errors = append(errors, fmt.Errorf("%q can only contain `@` as the entire name and `*` as the entire left-most label", k))
+			return warnings, errors
+		}
+
+		if !recordSetNameLabelRegex.MatchString(label) {
+			errors = append(errors, fmt.Errorf("each label within %q must be between 1 and 63 characters and can only contain letters, numbers, underscores and hyphens", k))
+			return warnings, errors
+		}
+	}
+
+	return warnings, errors
+}
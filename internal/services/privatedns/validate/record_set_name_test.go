@@ -0,0 +1,86 @@
+package validate
+
+import "testing"
+
+func TestRecordSetName(t *testing.T) {
+	cases := []struct {
+		Value      string
+		Valid      bool
+		CNameValid bool
+	}{
+		{
+			Value: "",
+		},
+		{
+			Value:      "@",
+			Valid:      true,
+			CNameValid: false,
+		},
+		{
+			Value:      "www",
+			Valid:      true,
+			CNameValid: true,
+		},
+		{
+			Value:      "api.internal",
+			Valid:      true,
+			CNameValid: true,
+		},
+		{
+			Value:      "_sip._tcp",
+			Valid:      true,
+			CNameValid: true,
+		},
+		{
+			Value:      "*",
+			Valid:      true,
+			CNameValid: true,
+		},
+		{
+			Value:      "*.internal",
+			Valid:      true,
+			CNameValid: true,
+		},
+		{
+			Value: "internal.*",
+		},
+		{
+			Value: "a*b",
+		},
+		{
+			Value: "*.*",
+		},
+		{
+			Value: "@.internal",
+		},
+		{
+			Value: "www.",
+		},
+		{
+			Value: ".www",
+		},
+		{
+			Value: "a..b",
+		},
+		{
+			Value: "www!",
+		},
+		{
+			Value: "a234567890123456789012345678901234567890123456789012345678901234",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Value %q", tc.Value)
+
+		_, errors := RecordSetName(tc.Value, "name")
+		if valid := len(errors) == 0; valid != tc.Valid {
+			t.Fatalf("expected RecordSetName to return %t for %q but got %t", tc.Valid, tc.Value, valid)
+		}
+
+		_, errors = CNameRecordName(tc.Value, "name")
+		if valid := len(errors) == 0; valid != tc.CNameValid {
+			t.Fatalf("expected CNameRecordName to return %t for %q but got %t", tc.CNameValid, tc.Value, valid)
+		}
+	}
+}
@@ -41,6 +41,15 @@ func resourceRedisCache() *pluginsdk.Resource {
 			return err
 		}),
 
+		CustomizeDiff: pluginsdk.CustomDiffInSequence(
+			// a Redis Cache can only be scaled up one tier at a time in-place (Basic to Standard, or Standard to Premium
+			// alongside changing the `family` to `P`) - any other change to the `sku_name` recreates it
+			pluginsdk.ForceNewUnlessTransitionSupported("sku_name", map[string][]string{
+				string(redis.SkuNameBasic):    {string(redis.SkuNameStandard)},
+				string(redis.SkuNameStandard): {string(redis.SkuNamePremium)},
+			}),
+		),
+
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(90 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
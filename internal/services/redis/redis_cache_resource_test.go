@@ -84,6 +84,46 @@ func TestAccRedisCache_standard(t *testing.T) {
 	})
 }
 
+func TestAccRedisCache_skuUpgradedInPlaceAndDowngraded(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_redis_cache", "test")
+	r := RedisCacheResource{}
+
+	// the Resource ID is the same when the Redis Cache is recreated, so the creation time is compared instead
+	var createdTime string
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("sku_name").HasValue("Basic"),
+				data.CheckWithClient(r.createdTime(&createdTime, false)),
+			),
+		},
+		data.ImportStep(),
+		{
+			// upgrading from Basic to Standard is performed in-place
+			Config: r.standard(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("sku_name").HasValue("Standard"),
+				data.CheckWithClient(r.createdTime(&createdTime, false)),
+			),
+		},
+		data.ImportStep(),
+		{
+			// downgrading from Standard to Basic recreates the Redis Cache
+			Config: r.basic(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("sku_name").HasValue("Basic"),
+				data.CheckWithClient(r.createdTime(&createdTime, true)),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccRedisCache_premium(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_redis_cache", "test")
 	r := RedisCacheResource{}
@@ -488,6 +528,45 @@ func (t RedisCacheResource) Exists(ctx context.Context, clients *clients.Client,
 	return utils.Bool(resp.Properties != nil), nil
 }
 
+// createdTime returns a ClientCheckFunc which compares the creation time of the Redis Cache against the one recorded
+// by the previous step (when there is one) to determine whether it was recreated, then records the current value
+func (t RedisCacheResource) createdTime(previous *string, expectRecreated bool) acceptance.ClientCheckFunc {
+	return func(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) error {
+		id, err := parse.CacheID(state.ID)
+		if err != nil {
+			return err
+		}
+
+		filter := fmt.Sprintf("name eq '%s' and resourceType eq 'Microsoft.Cache/Redis'", id.RediName)
+		iterator, err := clients.Resource.ResourcesClient.ListByResourceGroupComplete(ctx, id.ResourceGroup, filter, "createdTime", nil)
+		if err != nil {
+			return fmt.Errorf("listing resources to find %s: %+v", *id, err)
+		}
+
+		current := ""
+		for iterator.NotDone() {
+			if v := iterator.Value(); v.CreatedTime != nil {
+				current = v.CreatedTime.String()
+			}
+			if err := iterator.NextWithContext(ctx); err != nil {
+				return fmt.Errorf("listing resources to find %s: %+v", *id, err)
+			}
+		}
+		if current == "" {
+			return fmt.Errorf("the creation time of %s was not found", *id)
+		}
+
+		if *previous != "" {
+			if recreated := current != *previous; recreated != expectRecreated {
+				return fmt.Errorf("expected %s to have been recreated: %t, but was: %t (created at %q, previously %q)", *id, expectRecreated, recreated, current, *previous)
+			}
+		}
+
+		*previous = current
+		return nil
+	}
+}
+
 func (RedisCacheResource) basic(data acceptance.TestData, requireSSL bool) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
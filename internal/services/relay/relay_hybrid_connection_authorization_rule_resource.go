@@ -1,6 +1,7 @@
 package relay
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -11,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/relay/sdk/2017-04-01/hybridconnections"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
@@ -55,10 +57,55 @@ func resourceRelayHybridConnectionAuthorizationRule() *pluginsdk.Resource {
 			},
 
 			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			// changing either of these values regenerates the associated key, which allows keys to be rotated
+			// using Terraform - for example by using the ID of a `time_rotating` resource
+			"primary_key_rotation_trigger": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"secondary_key_rotation_trigger": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
 		}),
 
-		CustomizeDiff: pluginsdk.CustomizeDiffShim(authorizationRuleCustomizeDiff),
+		CustomizeDiff: pluginsdk.CustomDiffInSequence(
+			authorizationRuleCustomizeDiff,
+			hybridConnectionAuthorizationRuleKeyRotationCustomizeDiff,
+		),
+	}
+}
+
+// hybridConnectionAuthorizationRuleKeyRotationCustomizeDiff marks the keys (and connection strings) which
+// are regenerated as a result of a rotation trigger changing as unknown, so that these are updated in the plan
+func hybridConnectionAuthorizationRuleKeyRotationCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	if d.HasChange("primary_key_rotation_trigger") {
+		if err := d.SetNewComputed("primary_key"); err != nil {
+			return err
+		}
+		if err := d.SetNewComputed("primary_connection_string"); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("secondary_key_rotation_trigger") {
+		if err := d.SetNewComputed("secondary_key"); err != nil {
+			return err
+		}
+		if err := d.SetNewComputed("secondary_connection_string"); err != nil {
+			return err
+		}
 	}
+
+	return nil
 }
 
 func resourceRelayHybridConnectionAuthorizationRuleCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -93,6 +140,21 @@ func resourceRelayHybridConnectionAuthorizationRuleCreateUpdate(d *pluginsdk.Res
 		return fmt.Errorf("creating/updating %s: %+v", resourceId, err)
 	}
 
+	// new keys are generated when the Authorization Rule is created, so these only need regenerating on update
+	if !d.IsNewResource() {
+		if d.HasChange("primary_key_rotation_trigger") {
+			if err := regenerateHybridConnectionAuthorizationRuleKey(ctx, client, resourceId, hybridconnections.KeyTypePrimaryKey); err != nil {
+				return err
+			}
+		}
+
+		if d.HasChange("secondary_key_rotation_trigger") {
+			if err := regenerateHybridConnectionAuthorizationRuleKey(ctx, client, resourceId, hybridconnections.KeyTypeSecondaryKey); err != nil {
+				return err
+			}
+		}
+	}
+
 	d.SetId(resourceId.ID())
 
 	return resourceRelayHybridConnectionAuthorizationRuleRead(d, meta)
@@ -158,3 +220,15 @@ func resourceRelayHybridConnectionAuthorizationRuleDelete(d *pluginsdk.ResourceD
 
 	return nil
 }
+
+func regenerateHybridConnectionAuthorizationRuleKey(ctx context.Context, client *hybridconnections.HybridConnectionsClient, id hybridconnections.HybridConnectionAuthorizationRuleId, keyType hybridconnections.KeyType) error {
+	log.Printf("[DEBUG] Regenerating the %s for %s..", keyType, id)
+	input := hybridconnections.RegenerateAccessKeyParameters{
+		KeyType: keyType,
+	}
+	if _, err := client.RegenerateKeys(ctx, id, input); err != nil {
+		return fmt.Errorf("regenerating the %s for %s: %+v", keyType, id, err)
+	}
+
+	return nil
+}
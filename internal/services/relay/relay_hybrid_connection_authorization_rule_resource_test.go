@@ -50,6 +50,37 @@ func TestAccRelayHybridConnectionAuthorizationRule_requiresImport(t *testing.T)
 	})
 }
 
+func TestAccRelayHybridConnectionAuthorizationRule_rotateKeys(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_relay_hybrid_connection_authorization_rule", "test")
+	r := RelayHybridConnectionAuthorizationRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.rotateKeys(data, "first", "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("primary_key").IsSet(),
+				check.That(data.ResourceName).Key("secondary_key").IsSet(),
+			),
+		},
+		data.ImportStep("primary_key_rotation_trigger", "secondary_key_rotation_trigger"),
+		{
+			Config: r.rotateKeys(data, "second", "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("primary_key_rotation_trigger", "secondary_key_rotation_trigger"),
+		{
+			Config: r.rotateKeys(data, "second", "second"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("primary_key_rotation_trigger", "secondary_key_rotation_trigger"),
+	})
+}
+
 func (t RelayHybridConnectionAuthorizationRuleResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := hybridconnections.ParseHybridConnectionAuthorizationRuleID(state.ID)
 	if err != nil {
@@ -121,3 +152,44 @@ resource "azurerm_relay_hybrid_connection_authorization_rule" "import" {
 }
 `, r.basic(data))
 }
+
+func (RelayHybridConnectionAuthorizationRuleResource) rotateKeys(data acceptance.TestData, primaryTrigger, secondaryTrigger string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_relay_namespace" "test" {
+  name                = "acctestrn-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  sku_name = "Standard"
+}
+
+resource "azurerm_relay_hybrid_connection" "test" {
+  name                 = "acctestrnhc-%d"
+  resource_group_name  = azurerm_resource_group.test.name
+  relay_namespace_name = azurerm_relay_namespace.test.name
+}
+
+resource "azurerm_relay_hybrid_connection_authorization_rule" "test" {
+  name                   = "acctestrnak-%d"
+  namespace_name         = azurerm_relay_namespace.test.name
+  hybrid_connection_name = azurerm_relay_hybrid_connection.test.name
+  resource_group_name    = azurerm_resource_group.test.name
+
+  listen = true
+  send   = true
+  manage = false
+
+  primary_key_rotation_trigger   = "%s"
+  secondary_key_rotation_trigger = "%s"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger, primaryTrigger, secondaryTrigger)
+}
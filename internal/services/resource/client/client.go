@@ -12,6 +12,7 @@ import (
 type Client struct {
 	DeploymentsClient           *resources.DeploymentsClient
 	FeaturesClient              *features.Client
+	GenericClient               *GenericClient
 	GroupsClient                *resources.GroupsClient
 	LocksClient                 *locks.ManagementLocksClient
 	ProvidersClient             *providers.ProvidersClient
@@ -30,6 +31,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	featuresClient := features.NewClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&featuresClient.Client, o.ResourceManagerAuthorizer)
 
+	genericClient := NewGenericClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&genericClient.Client, o.ResourceManagerAuthorizer)
+
 	groupsClient := resources.NewGroupsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&groupsClient.Client, o.ResourceManagerAuthorizer)
 
@@ -57,6 +61,7 @@ func NewClient(o *common.ClientOptions) *Client {
 		GroupsClient:                &groupsClient,
 		DeploymentsClient:           &deploymentsClient,
 		FeaturesClient:              &featuresClient,
+		GenericClient:               &genericClient,
 		LocksClient:                 &locksClient,
 		ProvidersClient:             &providersClient,
 		ResourceProvidersClient:     &resourceProvidersClient,
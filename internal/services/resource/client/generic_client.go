@@ -0,0 +1,146 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// GenericClient sends requests to arbitrary Resource Manager resources using the API Version
+// specified by the caller, with the request and response bodies left as raw JSON.
+type GenericClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+type GenericResponse struct {
+	HttpResponse *http.Response
+	Model        map[string]interface{}
+}
+
+func NewGenericClientWithBaseURI(endpoint string) GenericClient {
+	return GenericClient{
+		Client:  autorest.NewClientWithUserAgent(""),
+		baseUri: endpoint,
+	}
+}
+
+// Get retrieves the resource with the specified ID
+func (c GenericClient) Get(ctx context.Context, id string, apiVersion string) (result GenericResponse, err error) {
+	req, err := c.prepare(ctx, http.MethodGet, id, apiVersion, nil)
+	if err != nil {
+		return result, fmt.Errorf("preparing request: %+v", err)
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return result, fmt.Errorf("sending request: %+v", err)
+	}
+
+	err = autorest.Respond(
+		result.HttpResponse,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	if err != nil {
+		return result, fmt.Errorf("responding to request: %+v", err)
+	}
+
+	return result, nil
+}
+
+// CreateOrUpdateThenPoll creates or replaces the resource with the specified ID and waits
+// for any long-running operation to complete
+func (c GenericClient) CreateOrUpdateThenPoll(ctx context.Context, id string, apiVersion string, body interface{}) error {
+	return c.sendThenPoll(ctx, http.MethodPut, id, apiVersion, body)
+}
+
+// DeleteThenPoll deletes the resource with the specified ID and waits for any long-running
+// operation to complete
+func (c GenericClient) DeleteThenPoll(ctx context.Context, id string, apiVersion string) error {
+	return c.sendThenPoll(ctx, http.MethodDelete, id, apiVersion, nil)
+}
+
+// ActionThenPoll invokes the (POST) action with the specified name on the resource with the
+// specified ID, waits for any long-running operation to complete and then returns the result
+func (c GenericClient) ActionThenPoll(ctx context.Context, id string, action string, apiVersion string, body interface{}) (result GenericResponse, err error) {
+	req, err := c.prepare(ctx, http.MethodPost, fmt.Sprintf("%s/%s", id, action), apiVersion, body)
+	if err != nil {
+		return result, fmt.Errorf("preparing request: %+v", err)
+	}
+
+	resp, err := c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return result, fmt.Errorf("sending request: %+v", err)
+	}
+
+	future, err := azure.NewFutureFromResponse(resp)
+	if err != nil {
+		return result, err
+	}
+
+	if err := future.WaitForCompletionRef(ctx, c.Client); err != nil {
+		return result, fmt.Errorf("waiting for completion: %+v", err)
+	}
+
+	// actions may return their result either directly or at the end of the long-running operation
+	result.HttpResponse, err = future.GetResult(c.Client)
+	if err != nil {
+		return result, fmt.Errorf("retrieving result: %+v", err)
+	}
+
+	err = autorest.Respond(
+		result.HttpResponse,
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusCreated, http.StatusAccepted, http.StatusNoContent),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	if err != nil {
+		return result, fmt.Errorf("responding to request: %+v", err)
+	}
+
+	return result, nil
+}
+
+func (c GenericClient) sendThenPoll(ctx context.Context, method string, id string, apiVersion string, body interface{}) error {
+	req, err := c.prepare(ctx, method, id, apiVersion, body)
+	if err != nil {
+		return fmt.Errorf("preparing request: %+v", err)
+	}
+
+	resp, err := c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return fmt.Errorf("sending request: %+v", err)
+	}
+
+	// the future handles both synchronous and asynchronous (long-running) operations
+	future, err := azure.NewFutureFromResponse(resp)
+	if err != nil {
+		return err
+	}
+
+	if err := future.WaitForCompletionRef(ctx, c.Client); err != nil {
+		return fmt.Errorf("waiting for completion: %+v", err)
+	}
+
+	return nil
+}
+
+func (c GenericClient) prepare(ctx context.Context, method string, id string, apiVersion string, body interface{}) (*http.Request, error) {
+	decorators := []autorest.PrepareDecorator{
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.WithMethod(method),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"api-version": apiVersion,
+		}),
+	}
+	if body != nil {
+		decorators = append(decorators, autorest.WithJSON(body))
+	}
+
+	return autorest.CreatePreparer(decorators...).Prepare((&http.Request{}).WithContext(ctx))
+}
@@ -0,0 +1,383 @@
+package resource
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceGenericResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceGenericResourceCreate,
+		Read:   resourceGenericResourceRead,
+		Update: resourceGenericResourceUpdate,
+		Delete: resourceGenericResourceDelete,
+		Importer: pluginsdk.ImporterValidatingResourceIdThen(func(id string) error {
+			_, err := parse.GenericResourceID(id)
+			return err
+		}, importGenericResource),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"parent_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^/`), "`parent_id` must be a Resource Manager ID starting with `/`"),
+			},
+
+			"type": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.GenericResourceType,
+			},
+
+			"api_version": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.ApiVersion,
+			},
+
+			"body": {
+				Type:             pluginsdk.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				StateFunc:        utils.NormalizeJson,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+			},
+
+			"output": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceGenericResourceCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Resource.GenericClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewGenericResourceID(d.Get("parent_id").(string), d.Get("type").(string), d.Get("name").(string))
+	if err := validateGenericResourceParent(id); err != nil {
+		return err
+	}
+	apiVersion := d.Get("api_version").(string)
+
+	existing, err := client.Get(ctx, id.ID(), apiVersion)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_generic_resource", id.ID())
+	}
+
+	body, err := expandGenericResourceBody(d.Get("body").(string))
+	if err != nil {
+		return fmt.Errorf("expanding `body`: %+v", err)
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, id.ID(), apiVersion, body); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceGenericResourceRead(d, meta)
+}
+
+func resourceGenericResourceRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Resource.GenericClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.GenericResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ID(), d.Get("api_version").(string))
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("parent_id", id.ParentId)
+	d.Set("type", id.Type)
+
+	// only the fields which were specified in the configuration are tracked within `body`, since
+	// the API returns additional read-only fields (such as `provisioningState`) which would otherwise cause a diff
+	var body interface{}
+	if v := d.Get("body").(string); v != "" {
+		config, err := expandGenericResourceBody(v)
+		if err != nil {
+			return fmt.Errorf("expanding `body`: %+v", err)
+		}
+		body = normalizeGenericResourceBody(config, resp.Model)
+	} else {
+		// when importing there's no configuration to compare against, so take everything bar the read-only fields
+		body = filterOutGenericResourceReadOnlyFields(resp.Model)
+	}
+
+	flattenedBody, err := flattenGenericResourceBody(body)
+	if err != nil {
+		return fmt.Errorf("flattening `body`: %+v", err)
+	}
+	d.Set("body", flattenedBody)
+
+	output, err := flattenGenericResourceBody(resp.Model)
+	if err != nil {
+		return fmt.Errorf("flattening `output`: %+v", err)
+	}
+	d.Set("output", output)
+
+	return nil
+}
+
+func resourceGenericResourceUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Resource.GenericClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.GenericResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// the resource is replaced in its entirety, since the body is opaque to us
+	body, err := expandGenericResourceBody(d.Get("body").(string))
+	if err != nil {
+		return fmt.Errorf("expanding `body`: %+v", err)
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, id.ID(), d.Get("api_version").(string), body); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return resourceGenericResourceRead(d, meta)
+}
+
+func resourceGenericResourceDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Resource.GenericClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.GenericResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteThenPoll(ctx, id.ID(), d.Get("api_version").(string)); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func importGenericResource(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) ([]*pluginsdk.ResourceData, error) {
+	client := meta.(*clients.Client).Resource.ProvidersClient
+
+	id, err := parse.GenericResourceID(d.Id())
+	if err != nil {
+		return []*pluginsdk.ResourceData{d}, err
+	}
+
+	// the API Version isn't part of the Resource ID, so we default to the latest version
+	// which is available for this Resource Type - which can then be changed in the configuration
+	segments := strings.SplitN(id.Type, "/", 2)
+	resourceProviderName := segments[0]
+	resp, err := client.Get(ctx, resourceProviderName, "")
+	if err != nil {
+		return []*pluginsdk.ResourceData{d}, fmt.Errorf("retrieving Resource Provider MetaData for %q: %+v", resourceProviderName, err)
+	}
+	if resp.ResourceTypes == nil {
+		return []*pluginsdk.ResourceData{d}, fmt.Errorf("`resourceTypes` was nil for Resource Provider %q", resourceProviderName)
+	}
+
+	apiVersion := findApiVersionForResourceType(segments[1], *resp.ResourceTypes)
+	if apiVersion == nil {
+		return []*pluginsdk.ResourceData{d}, fmt.Errorf("unable to determine API version for Resource Type %q (Resource Provider %q)", segments[1], resourceProviderName)
+	}
+	d.Set("api_version", *apiVersion)
+
+	return []*pluginsdk.ResourceData{d}, nil
+}
+
+func validateGenericResourceParent(id parse.GenericResourceId) error {
+	if !id.IsChildResource() {
+		return nil
+	}
+
+	parentId, err := parse.GenericResourceID(id.ParentId)
+	if err != nil {
+		return fmt.Errorf("parsing `parent_id` for the child Resource Type %q: %+v", id.Type, err)
+	}
+
+	if !strings.EqualFold(parentId.Type, id.ParentType()) {
+		return fmt.Errorf("`parent_id` must be a resource of type %q for the child Resource Type %q but got %q", id.ParentType(), id.Type, parentId.Type)
+	}
+
+	return nil
+}
+
+func expandGenericResourceBody(input string) (map[string]interface{}, error) {
+	var output map[string]interface{}
+
+	if err := json.Unmarshal([]byte(input), &output); err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func flattenGenericResourceBody(input interface{}) (string, error) {
+	if input == nil {
+		return "{}", nil
+	}
+
+	bytes, err := json.Marshal(input)
+	if err != nil {
+		return "", fmt.Errorf("marshalling json: %+v", err)
+	}
+
+	return string(bytes), nil
+}
+
+// normalizeGenericResourceBody returns the values from `actual` which correspond to the fields
+// defined in `config` - retaining the configured values where the API doesn't return them (for
+// example secrets) or returns them in a different casing
+func normalizeGenericResourceBody(config interface{}, actual interface{}) interface{} {
+	switch configValue := config.(type) {
+	case map[string]interface{}:
+		actualValue, ok := actual.(map[string]interface{})
+		if !ok {
+			if actual == nil {
+				return config
+			}
+			return actual
+		}
+
+		output := make(map[string]interface{})
+		for key, value := range configValue {
+			v, ok := findGenericResourceBodyValue(actualValue, key)
+			if !ok {
+				output[key] = value
+				continue
+			}
+
+			if strings.EqualFold(key, "location") {
+				configLocation, configOk := value.(string)
+				actualLocation, actualOk := v.(string)
+				if configOk && actualOk && location.Normalize(configLocation) == location.Normalize(actualLocation) {
+					output[key] = value
+					continue
+				}
+			}
+
+			output[key] = normalizeGenericResourceBody(value, v)
+		}
+		return output
+
+	case []interface{}:
+		actualValue, ok := actual.([]interface{})
+		if !ok || len(actualValue) != len(configValue) {
+			if actual == nil {
+				return config
+			}
+			return actual
+		}
+
+		output := make([]interface{}, 0)
+		for i, value := range configValue {
+			output = append(output, normalizeGenericResourceBody(value, actualValue[i]))
+		}
+		return output
+
+	case string:
+		// values such as enums are treated case-insensitively by the API
+		if actualValue, ok := actual.(string); ok && strings.EqualFold(configValue, actualValue) {
+			return config
+		}
+		return actual
+	}
+
+	return actual
+}
+
+func findGenericResourceBodyValue(input map[string]interface{}, key string) (interface{}, bool) {
+	if v, ok := input[key]; ok {
+		return v, true
+	}
+
+	for k, v := range input {
+		if strings.EqualFold(k, key) {
+			return v, true
+		}
+	}
+
+	return nil, false
+}
+
+func filterOutGenericResourceReadOnlyFields(input map[string]interface{}) map[string]interface{} {
+	output := make(map[string]interface{})
+	for key, value := range input {
+		switch strings.ToLower(key) {
+		case "id", "name", "type", "etag", "systemdata":
+			continue
+		}
+
+		output[key] = value
+	}
+
+	if properties, ok := output["properties"].(map[string]interface{}); ok {
+		filteredProperties := make(map[string]interface{})
+		for key, value := range properties {
+			if strings.EqualFold(key, "provisioningState") {
+				continue
+			}
+			filteredProperties[key] = value
+		}
+		output["properties"] = filteredProperties
+	}
+
+	return output
+}
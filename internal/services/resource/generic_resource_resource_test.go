@@ -0,0 +1,196 @@
+package resource_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type GenericResourceResource struct{}
+
+func TestAccGenericResource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_generic_resource", "test")
+	r := GenericResourceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("output").IsSet(),
+			),
+		},
+		data.ImportStep("api_version", "body"),
+	})
+}
+
+func TestAccGenericResource_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_generic_resource", "test")
+	r := GenericResourceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccGenericResource_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_generic_resource", "test")
+	r := GenericResourceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("api_version", "body"),
+		{
+			Config: r.updated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("api_version", "body"),
+	})
+}
+
+func TestAccGenericResource_childResource(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_generic_resource", "test")
+	r := GenericResourceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.childResource(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That("azurerm_generic_resource.subnet").ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("api_version", "body"),
+	})
+}
+
+func (GenericResourceResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.GenericResourceID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Resource.GenericClient.Get(ctx, id.ID(), state.Attributes["api_version"])
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r GenericResourceResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_generic_resource" "test" {
+  name        = "acctestvnet-%d"
+  parent_id   = azurerm_resource_group.test.id
+  type        = "Microsoft.Network/virtualNetworks"
+  api_version = "2021-05-01"
+
+  body = jsonencode({
+    location = azurerm_resource_group.test.location
+    properties = {
+      addressSpace = {
+        addressPrefixes = ["10.0.0.0/16"]
+      }
+    }
+  })
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r GenericResourceResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_generic_resource" "import" {
+  name        = azurerm_generic_resource.test.name
+  parent_id   = azurerm_generic_resource.test.parent_id
+  type        = azurerm_generic_resource.test.type
+  api_version = azurerm_generic_resource.test.api_version
+  body        = azurerm_generic_resource.test.body
+}
+`, r.basic(data))
+}
+
+func (r GenericResourceResource) updated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_generic_resource" "test" {
+  name        = "acctestvnet-%d"
+  parent_id   = azurerm_resource_group.test.id
+  type        = "Microsoft.Network/virtualNetworks"
+  api_version = "2021-08-01"
+
+  body = jsonencode({
+    location = azurerm_resource_group.test.location
+    properties = {
+      addressSpace = {
+        addressPrefixes = ["10.0.0.0/16", "10.1.0.0/16"]
+      }
+    }
+    tags = {
+      environment = "Test"
+    }
+  })
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r GenericResourceResource) childResource(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_generic_resource" "subnet" {
+  name        = "acctestsubnet-%d"
+  parent_id   = azurerm_generic_resource.test.id
+  type        = "Microsoft.Network/virtualNetworks/subnets"
+  api_version = "2021-05-01"
+
+  body = jsonencode({
+    properties = {
+      addressPrefix = "10.0.2.0/24"
+    }
+  })
+}
+`, r.basic(data), data.RandomInteger)
+}
+
+func (GenericResourceResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-generic-%d"
+  location = "%s"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
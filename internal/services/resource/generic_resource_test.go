@@ -0,0 +1,74 @@
+package resource
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNormalizeGenericResourceBody(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Config   string
+		Actual   string
+		Expected string
+	}{
+		{
+			Name:     "read-only fields are ignored",
+			Config:   `{"properties": {"addressSpace": {"addressPrefixes": ["10.0.0.0/16"]}}}`,
+			Actual:   `{"id": "/some/id", "properties": {"provisioningState": "Succeeded", "addressSpace": {"addressPrefixes": ["10.0.0.0/16"]}}}`,
+			Expected: `{"properties": {"addressSpace": {"addressPrefixes": ["10.0.0.0/16"]}}}`,
+		},
+		{
+			Name:     "drift is detected",
+			Config:   `{"properties": {"addressSpace": {"addressPrefixes": ["10.0.0.0/16"]}}}`,
+			Actual:   `{"properties": {"addressSpace": {"addressPrefixes": ["10.1.0.0/16"]}}}`,
+			Expected: `{"properties": {"addressSpace": {"addressPrefixes": ["10.1.0.0/16"]}}}`,
+		},
+		{
+			Name:     "lists of a different length are taken from the API",
+			Config:   `{"properties": {"addressSpace": {"addressPrefixes": ["10.0.0.0/16"]}}}`,
+			Actual:   `{"properties": {"addressSpace": {"addressPrefixes": ["10.0.0.0/16", "10.1.0.0/16"]}}}`,
+			Expected: `{"properties": {"addressSpace": {"addressPrefixes": ["10.0.0.0/16", "10.1.0.0/16"]}}}`,
+		},
+		{
+			Name:     "fields which aren't returned are retained",
+			Config:   `{"properties": {"administratorPassword": "P@55w0rd1234!", "enabled": true}}`,
+			Actual:   `{"properties": {"enabled": false}}`,
+			Expected: `{"properties": {"administratorPassword": "P@55w0rd1234!", "enabled": false}}`,
+		},
+		{
+			Name:     "casing differences are ignored",
+			Config:   `{"location": "West Europe", "sku": {"name": "standard_lrs"}, "Kind": "StorageV2"}`,
+			Actual:   `{"location": "westeurope", "sku": {"name": "Standard_LRS"}, "kind": "StorageV2"}`,
+			Expected: `{"location": "West Europe", "sku": {"name": "standard_lrs"}, "Kind": "StorageV2"}`,
+		},
+		{
+			Name:     "location changes are detected",
+			Config:   `{"location": "West Europe"}`,
+			Actual:   `{"location": "northeurope"}`,
+			Expected: `{"location": "northeurope"}`,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		config, err := expandGenericResourceBody(v.Config)
+		if err != nil {
+			t.Fatalf("expanding config: %+v", err)
+		}
+		actual, err := expandGenericResourceBody(v.Actual)
+		if err != nil {
+			t.Fatalf("expanding actual: %+v", err)
+		}
+		expected, err := expandGenericResourceBody(v.Expected)
+		if err != nil {
+			t.Fatalf("expanding expected: %+v", err)
+		}
+
+		result := normalizeGenericResourceBody(config, actual)
+		if !reflect.DeepEqual(result, expected) {
+			t.Fatalf("Expected %+v but got %+v", expected, result)
+		}
+	}
+}
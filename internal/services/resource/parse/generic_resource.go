@@ -0,0 +1,105 @@
+package parse
+
+import (
+	"fmt"
+	"strings"
+)
+
+// GenericResourceId is the ID of an arbitrary ARM resource, identified by its Parent,
+// fully-qualified Type (e.g. `Microsoft.Network/virtualNetworks/subnets`) and Name
+type GenericResourceId struct {
+	ParentId string
+	Type     string
+	Name     string
+}
+
+func NewGenericResourceID(parentId, resourceType, name string) GenericResourceId {
+	return GenericResourceId{
+		ParentId: parentId,
+		Type:     resourceType,
+		Name:     name,
+	}
+}
+
+func (id GenericResourceId) String() string {
+	segments := []string{
+		fmt.Sprintf("Type %q", id.Type),
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Parent %q", id.ParentId),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Generic Resource", segmentsStr)
+}
+
+func (id GenericResourceId) ID() string {
+	parentId := strings.TrimSuffix(id.ParentId, "/")
+
+	// top-level (and extension) resources sit beneath a `providers` segment on the parent,
+	// whereas child resources are appended directly to the parent resource
+	segments := strings.Split(id.Type, "/")
+	if len(segments) <= 2 {
+		return fmt.Sprintf("%s/providers/%s/%s", parentId, id.Type, id.Name)
+	}
+
+	return fmt.Sprintf("%s/%s/%s", parentId, segments[len(segments)-1], id.Name)
+}
+
+// IsChildResource returns whether this is a child resource (e.g. a Subnet within a Virtual Network)
+// in which case the Parent ID must be a resource of the parent Type
+func (id GenericResourceId) IsChildResource() bool {
+	return len(strings.Split(id.Type, "/")) > 2
+}
+
+// ParentType returns the fully-qualified Type of the Parent resource for a child resource
+func (id GenericResourceId) ParentType() string {
+	segments := strings.Split(id.Type, "/")
+	return strings.Join(segments[:len(segments)-1], "/")
+}
+
+// GenericResourceID parses the ID of an arbitrary ARM resource into a GenericResourceId struct
+func GenericResourceID(input string) (*GenericResourceId, error) {
+	if !strings.HasPrefix(input, "/") {
+		return nil, fmt.Errorf("ID was expected to start with `/`")
+	}
+
+	segments := strings.Split(strings.TrimPrefix(input, "/"), "/")
+	for _, segment := range segments {
+		if segment == "" {
+			return nil, fmt.Errorf("ID contained an empty segment")
+		}
+	}
+
+	// the resource is defined by the last `providers` segment, since the parent can itself be a resource
+	providersIndex := -1
+	for i := len(segments) - 1; i >= 0; i-- {
+		if strings.EqualFold(segments[i], "providers") {
+			providersIndex = i
+			break
+		}
+	}
+	if providersIndex == -1 {
+		return nil, fmt.Errorf("ID was missing the 'providers' element")
+	}
+
+	// namespace followed by pairs of type/name
+	namespace := segments[providersIndex+1:]
+	if len(namespace) < 3 || len(namespace[1:])%2 != 0 {
+		return nil, fmt.Errorf("ID was expected to contain a Resource Provider Namespace followed by pairs of Resource Types and Names after the 'providers' element")
+	}
+
+	types := []string{namespace[0]}
+	for i := 1; i < len(namespace); i += 2 {
+		types = append(types, namespace[i])
+	}
+
+	parentSegments := segments[:providersIndex]
+	if len(namespace) > 3 {
+		parentSegments = segments[:len(segments)-2]
+	}
+
+	return &GenericResourceId{
+		ParentId: "/" + strings.Join(parentSegments, "/"),
+		Type:     strings.Join(types, "/"),
+		Name:     segments[len(segments)-1],
+	}, nil
+}
@@ -0,0 +1,175 @@
+package parse
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceid"
+)
+
+var _ resourceid.Formatter = GenericResourceId{}
+
+func TestGenericResourceIDFormatter(t *testing.T) {
+	testData := []struct {
+		Id       GenericResourceId
+		Expected string
+	}{
+		{
+			// top-level resource
+			Id:       NewGenericResourceID("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1", "Microsoft.Network/virtualNetworks", "network1"),
+			Expected: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualNetworks/network1",
+		},
+		{
+			// child resource
+			Id:       NewGenericResourceID("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualNetworks/network1", "Microsoft.Network/virtualNetworks/subnets", "subnet1"),
+			Expected: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1",
+		},
+		{
+			// extension resource
+			Id:       NewGenericResourceID("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualNetworks/network1", "Microsoft.Authorization/locks", "lock1"),
+			Expected: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualNetworks/network1/providers/Microsoft.Authorization/locks/lock1",
+		},
+		{
+			// tenant-level resource
+			Id:       NewGenericResourceID("/", "Microsoft.Management/managementGroups", "group1"),
+			Expected: "/providers/Microsoft.Management/managementGroups/group1",
+		},
+	}
+	for _, v := range testData {
+		actual := v.Id.ID()
+		if actual != v.Expected {
+			t.Fatalf("Expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+func TestGenericResourceID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *GenericResourceId
+	}{
+
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+
+		{
+			// not starting with a slash
+			Input: "subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualNetworks/network1",
+			Error: true,
+		},
+
+		{
+			// missing Providers
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1",
+			Error: true,
+		},
+
+		{
+			// missing Type and Name
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network",
+			Error: true,
+		},
+
+		{
+			// missing Name
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualNetworks",
+			Error: true,
+		},
+
+		{
+			// empty segment
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups//providers/Microsoft.Network/virtualNetworks/network1",
+			Error: true,
+		},
+
+		{
+			// missing Name for child resource
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualNetworks/network1/subnets",
+			Error: true,
+		},
+
+		{
+			// valid top-level resource
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualNetworks/network1",
+			Expected: &GenericResourceId{
+				ParentId: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1",
+				Type:     "Microsoft.Network/virtualNetworks",
+				Name:     "network1",
+			},
+		},
+
+		{
+			// valid child resource
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1",
+			Expected: &GenericResourceId{
+				ParentId: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualNetworks/network1",
+				Type:     "Microsoft.Network/virtualNetworks/subnets",
+				Name:     "subnet1",
+			},
+		},
+
+		{
+			// valid extension resource
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualNetworks/network1/providers/Microsoft.Authorization/locks/lock1",
+			Expected: &GenericResourceId{
+				ParentId: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualNetworks/network1",
+				Type:     "Microsoft.Authorization/locks",
+				Name:     "lock1",
+			},
+		},
+
+		{
+			// valid subscription-level resource
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Security/pricings/VirtualMachines",
+			Expected: &GenericResourceId{
+				ParentId: "/subscriptions/12345678-1234-9876-4563-123456789012",
+				Type:     "Microsoft.Security/pricings",
+				Name:     "VirtualMachines",
+			},
+		},
+
+		{
+			// valid tenant-level resource
+			Input: "/providers/Microsoft.Management/managementGroups/group1",
+			Expected: &GenericResourceId{
+				ParentId: "/",
+				Type:     "Microsoft.Management/managementGroups",
+				Name:     "group1",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := GenericResourceID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.ParentId != v.Expected.ParentId {
+			t.Fatalf("Expected %q but got %q for ParentId", v.Expected.ParentId, actual.ParentId)
+		}
+		if actual.Type != v.Expected.Type {
+			t.Fatalf("Expected %q but got %q for Type", v.Expected.Type, actual.Type)
+		}
+		if actual.Name != v.Expected.Name {
+			t.Fatalf("Expected %q but got %q for Name", v.Expected.Name, actual.Name)
+		}
+
+		// the ID should round-trip
+		if roundTripped := actual.ID(); roundTripped != v.Input {
+			t.Fatalf("Expected the ID to round-trip to %q but got %q", v.Input, roundTripped)
+		}
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceid"
 )
 
 type ResourceGroupId struct {
@@ -60,3 +61,35 @@ func ResourceGroupID(input string) (*ResourceGroupId, error) {
 
 	return &resourceId, nil
 }
+
+// ResourceGroupIDInsensitively parses an ResourceGroup ID into an ResourceGroupId struct, insensitively
+// This should only be used to parse an ID for rewriting, the ResourceGroupID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func ResourceGroupIDInsensitively(input string) (*ResourceGroupId, error) {
+	id, err := resourceid.ParseAzureResourceIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := ResourceGroupId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -199,6 +199,16 @@ func TestResourceGroupTemplateDeploymentIDInsensitively(t *testing.T) {
 				DeploymentName: "deploy1",
 			},
 		},
+
+		{
+			// upper-cased root segment names
+			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/group1/PROVIDERS/Microsoft.Resources/deployments/deploy1",
+			Expected: &ResourceGroupTemplateDeploymentId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "group1",
+				DeploymentName: "deploy1",
+			},
+		},
 	}
 
 	for _, v := range testData {
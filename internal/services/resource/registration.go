@@ -36,8 +36,10 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 // SupportedResources returns the supported Resources supported by this Service
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
+		"azurerm_generic_resource":                     resourceGenericResource(),
 		"azurerm_management_lock":                      resourceManagementLock(),
 		"azurerm_management_group_template_deployment": managementGroupTemplateDeploymentResource(),
+		"azurerm_resource_action":                      resourceResourceAction(),
 		"azurerm_resource_group":                       resourceResourceGroup(),
 		"azurerm_resource_group_template_deployment":   resourceGroupTemplateDeploymentResource(),
 		"azurerm_subscription_template_deployment":     subscriptionTemplateDeploymentResource(),
@@ -0,0 +1,159 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+const (
+	resourceActionWhenApply   = "apply"
+	resourceActionWhenDestroy = "destroy"
+)
+
+func resourceResourceAction() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceResourceActionCreate,
+		Read:   resourceResourceActionRead,
+		Delete: resourceResourceActionDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"resource_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^/`), "`resource_id` must be a Resource Manager ID starting with `/`"),
+			},
+
+			"action": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[^/]`), "`action` must be the name of an action and cannot start with `/`"),
+			},
+
+			"api_version": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ApiVersion,
+			},
+
+			"body": {
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				StateFunc:        utils.NormalizeJson,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+			},
+
+			"when": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  resourceActionWhenApply,
+				ValidateFunc: validation.StringInSlice([]string{
+					resourceActionWhenApply,
+					resourceActionWhenDestroy,
+				}, false),
+			},
+
+			// actions such as `listKeys` return secrets
+			"output": {
+				Type:      pluginsdk.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func resourceResourceActionCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	resourceId := d.Get("resource_id").(string)
+	action := d.Get("action").(string)
+	id := fmt.Sprintf("%s/%s", resourceId, action)
+
+	output := ""
+	if d.Get("when").(string) == resourceActionWhenApply {
+		result, err := invokeResourceAction(ctx, d, meta)
+		if err != nil {
+			return err
+		}
+		output = result
+	}
+
+	d.SetId(id)
+	d.Set("output", output)
+
+	return resourceResourceActionRead(d, meta)
+}
+
+func resourceResourceActionRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	// an action is invoked once and can't be retrieved from the API, so the values within the state are retained
+	return nil
+}
+
+func resourceResourceActionDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	if d.Get("when").(string) == resourceActionWhenDestroy {
+		if _, err := invokeResourceAction(ctx, d, meta); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func invokeResourceAction(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) (string, error) {
+	client := meta.(*clients.Client).Resource.GenericClient
+
+	resourceId := d.Get("resource_id").(string)
+	action := d.Get("action").(string)
+
+	var body interface{}
+	if v := d.Get("body").(string); v != "" {
+		expanded, err := expandGenericResourceBody(v)
+		if err != nil {
+			return "", fmt.Errorf("expanding `body`: %+v", err)
+		}
+		body = expanded
+	}
+
+	resp, err := client.ActionThenPoll(ctx, resourceId, action, d.Get("api_version").(string), body)
+	if err != nil {
+		return "", fmt.Errorf("invoking action %q on %q: %+v", action, resourceId, err)
+	}
+
+	var model interface{}
+	if resp.Model != nil {
+		model = resp.Model
+	}
+
+	output, err := flattenGenericResourceBody(model)
+	if err != nil {
+		return "", fmt.Errorf("flattening `output`: %+v", err)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,123 @@
+package resource_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type ResourceActionResource struct{}
+
+func TestAccResourceAction_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_resource_action", "test")
+	r := ResourceActionResource{}
+
+	data.ResourceTestSkipCheckDestroyed(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("output").IsSet(),
+			),
+		},
+	})
+}
+
+func TestAccResourceAction_withBody(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_resource_action", "test")
+	r := ResourceActionResource{}
+
+	data.ResourceTestSkipCheckDestroyed(t, []acceptance.TestStep{
+		{
+			Config: r.withBody(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("output").IsSet(),
+			),
+		},
+	})
+}
+
+func TestAccResourceAction_whenDestroy(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_resource_action", "test")
+	r := ResourceActionResource{}
+
+	data.ResourceTestSkipCheckDestroyed(t, []acceptance.TestStep{
+		{
+			Config: r.whenDestroy(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("output").IsEmpty(),
+			),
+		},
+		{
+			// removing the action invokes it
+			Config: r.template(data),
+		},
+	})
+}
+
+func (r ResourceActionResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_resource_action" "test" {
+  resource_id = azurerm_storage_account.test.id
+  action      = "listKeys"
+  api_version = "2021-09-01"
+}
+`, r.template(data))
+}
+
+func (r ResourceActionResource) withBody(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_resource_action" "test" {
+  resource_id = azurerm_storage_account.test.id
+  action      = "regenerateKey"
+  api_version = "2021-09-01"
+
+  body = jsonencode({
+    keyName = "key1"
+  })
+}
+`, r.template(data))
+}
+
+func (r ResourceActionResource) whenDestroy(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_resource_action" "test" {
+  resource_id = azurerm_storage_account.test.id
+  action      = "regenerateKey"
+  api_version = "2021-09-01"
+  when        = "destroy"
+
+  body = jsonencode({
+    keyName = "key2"
+  })
+}
+`, r.template(data))
+}
+
+func (ResourceActionResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-action-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
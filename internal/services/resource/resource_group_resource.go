@@ -1,6 +1,7 @@
 package resource
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"sort"
@@ -12,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceid"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -25,9 +27,8 @@ func resourceResourceGroup() *pluginsdk.Resource {
 		Read:   resourceResourceGroupRead,
 		Update: resourceResourceGroupCreateUpdate,
 		Delete: resourceResourceGroupDelete,
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.ResourceGroupID(id)
-			return err
+		Importer: pluginsdk.ImporterNormalizingResourceId(func(id string) (resourceid.Formatter, error) {
+			return parse.ResourceGroupIDInsensitively(id)
 		}),
 
 		Timeouts: &pluginsdk.ResourceTimeout{
@@ -130,19 +131,29 @@ func resourceResourceGroupDelete(d *pluginsdk.ResourceData, meta interface{}) er
 	// conditionally check for nested resources and error if they exist
 	if meta.(*clients.Client).Features.ResourceGroup.PreventDeletionIfContainsResources {
 		resourceClient := meta.(*clients.Client).Resource.ResourcesClient
-		results, err := resourceClient.ListByResourceGroupComplete(ctx, id.ResourceGroup, "", "", utils.Int32(500))
+		nestedResourceIds, err := listResourceGroupNestedResourceIds(ctx, resourceClient, *id)
 		if err != nil {
-			return fmt.Errorf("listing resources in %s: %v", *id, err)
+			return err
 		}
-		nestedResourceIds := make([]string, 0)
-		for results.NotDone() {
-			val := results.Value()
-			if val.ID != nil {
-				nestedResourceIds = append(nestedResourceIds, *val.ID)
+
+		// the Resources API is eventually consistent, meaning that Resources deleted immediately prior to the
+		// Resource Group (e.g. by Terraform in this same apply) can continue to be listed for a short period
+		for attempt := 1; len(nestedResourceIds) > 0 && attempt <= 3; attempt++ {
+			// there's no point waiting to re-check if the delete would time out first
+			if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < 30*time.Second {
+				break
+			}
+
+			log.Printf("[DEBUG] %d Resources are still listed within %s - re-checking in 30s (attempt %d/3)..", len(nestedResourceIds), *id, attempt)
+			select {
+			case <-ctx.Done():
+				return fmt.Errorf("waiting to re-check the Resources within %s: %+v", *id, ctx.Err())
+			case <-time.After(30 * time.Second):
 			}
 
-			if err := results.NextWithContext(ctx); err != nil {
-				return fmt.Errorf("retrieving next page of nested items for %s: %+v", id, err)
+			nestedResourceIds, err = listResourceGroupNestedResourceIds(ctx, resourceClient, *id)
+			if err != nil {
+				return err
 			}
 		}
 
@@ -164,6 +175,27 @@ func resourceResourceGroupDelete(d *pluginsdk.ResourceData, meta interface{}) er
 	return nil
 }
 
+func listResourceGroupNestedResourceIds(ctx context.Context, client *resources.Client, id parse.ResourceGroupId) ([]string, error) {
+	results, err := client.ListByResourceGroupComplete(ctx, id.ResourceGroup, "", "", utils.Int32(500))
+	if err != nil {
+		return nil, fmt.Errorf("listing resources in %s: %v", id, err)
+	}
+
+	nestedResourceIds := make([]string, 0)
+	for results.NotDone() {
+		val := results.Value()
+		if val.ID != nil {
+			nestedResourceIds = append(nestedResourceIds, *val.ID)
+		}
+
+		if err := results.NextWithContext(ctx); err != nil {
+			return nil, fmt.Errorf("retrieving next page of nested items for %s: %+v", id, err)
+		}
+	}
+
+	return nestedResourceIds, nil
+}
+
 func resourceGroupContainsItemsError(name string, nestedResourceIds []string) error {
 	formattedResourceUris := make([]string, 0)
 	for _, id := range nestedResourceIds {
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceid"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
@@ -25,9 +26,8 @@ func resourceGroupTemplateDeploymentResource() *pluginsdk.Resource {
 		Read:   resourceGroupTemplateDeploymentResourceRead,
 		Update: resourceGroupTemplateDeploymentResourceUpdate,
 		Delete: resourceGroupTemplateDeploymentResourceDelete,
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.ResourceGroupTemplateDeploymentID(id)
-			return err
+		Importer: pluginsdk.ImporterNormalizingResourceId(func(id string) (resourceid.Formatter, error) {
+			return parse.ResourceGroupTemplateDeploymentIDInsensitively(id)
 		}),
 
 		Timeouts: &pluginsdk.ResourceTimeout{
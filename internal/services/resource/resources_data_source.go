@@ -1,6 +1,7 @@
 package resource
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -104,23 +105,37 @@ func dataSourceResourcesRead(d *pluginsdk.ResourceData, meta interface{}) error
 		filter += v
 	}
 
-	// Use List instead of listComplete because of bug in SDK: https://github.com/Azure/azure-sdk-for-go/issues/9510
-	resources := make([]map[string]interface{}, 0)
-	resourcesResp, err := client.List(ctx, filter, "", nil)
-	if err != nil {
-		return fmt.Errorf("getting resources: %+v", err)
+	key := clients.ListCacheKey{
+		SubscriptionId: meta.(*clients.Client).Account.SubscriptionId,
+		API:            "Microsoft.Resources/resources/List",
+		Parameters:     []string{filter},
 	}
+	result, err := meta.(*clients.Client).ListCache.Get(ctx, key, func(ctx context.Context) (interface{}, error) {
+		// Use List instead of listComplete because of bug in SDK: https://github.com/Azure/azure-sdk-for-go/issues/9510
+		results := make([]resources.GenericResourceExpanded, 0)
+		resourcesResp, err := client.List(ctx, filter, "", nil)
+		if err != nil {
+			return nil, fmt.Errorf("getting resources: %+v", err)
+		}
 
-	resources = append(resources, filterResource(resourcesResp.Values(), requiredTags)...)
-	for resourcesResp.Response().NextLink != nil && *resourcesResp.Response().NextLink != "" {
-		if err := resourcesResp.NextWithContext(ctx); err != nil {
-			return fmt.Errorf("loading Resource List: %+v", err)
+		results = append(results, resourcesResp.Values()...)
+		for resourcesResp.Response().NextLink != nil && *resourcesResp.Response().NextLink != "" {
+			if err := resourcesResp.NextWithContext(ctx); err != nil {
+				return nil, fmt.Errorf("loading Resource List: %+v", err)
+			}
+			results = append(results, resourcesResp.Values()...)
 		}
-		resources = append(resources, filterResource(resourcesResp.Values(), requiredTags)...)
+
+		return results, nil
+	})
+	if err != nil {
+		return err
 	}
 
+	filtered := filterResource(result.([]resources.GenericResourceExpanded), requiredTags)
+
 	d.SetId("resource-" + uuid.New().String())
-	if err := d.Set("resources", resources); err != nil {
+	if err := d.Set("resources", filtered); err != nil {
 		return fmt.Errorf("setting `resources`: %+v", err)
 	}
 
@@ -0,0 +1,20 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// ApiVersion validates that the specified value is a Resource Manager API Version
+// such as `2021-02-01` or `2021-05-01-preview`
+func ApiVersion(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+	if !regexp.MustCompile(`^\d{4}-\d{2}-\d{2}(-[a-zA-Z0-9]+)?$`).MatchString(v) {
+		errors = append(errors, fmt.Errorf("%s must be an API Version in the format `YYYY-MM-DD`, optionally with a suffix such as `-preview`", key))
+	}
+	return
+}
@@ -0,0 +1,46 @@
+package validate
+
+import "testing"
+
+func TestApiVersion(t *testing.T) {
+	cases := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			// empty
+			Input: "",
+			Valid: false,
+		},
+		{
+			// missing day
+			Input: "2021-02",
+			Valid: false,
+		},
+		{
+			// empty suffix
+			Input: "2021-02-01-",
+			Valid: false,
+		},
+		{
+			// stable
+			Input: "2021-02-01",
+			Valid: true,
+		},
+		{
+			// preview
+			Input: "2021-05-01-preview",
+			Valid: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing value %s", tc.Input)
+		_, errors := ApiVersion(tc.Input, "test")
+		valid := len(errors) == 0
+
+		if tc.Valid != valid {
+			t.Fatalf("expected %t but got %t", tc.Valid, valid)
+		}
+	}
+}
@@ -0,0 +1,20 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// GenericResourceType validates that the specified value is a fully-qualified Resource Type
+// such as `Microsoft.Network/virtualNetworks` or `Microsoft.Network/virtualNetworks/subnets`
+func GenericResourceType(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+	if !regexp.MustCompile(`^[a-zA-Z0-9]+(\.[a-zA-Z0-9]+)*(/[a-zA-Z0-9_\-]+)+$`).MatchString(v) {
+		errors = append(errors, fmt.Errorf("%s must be a Resource Provider Namespace followed by one or more Resource Types, for example `Microsoft.Network/virtualNetworks`", key))
+	}
+	return
+}
@@ -0,0 +1,51 @@
+package validate
+
+import "testing"
+
+func TestGenericResourceType(t *testing.T) {
+	cases := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			// empty
+			Input: "",
+			Valid: false,
+		},
+		{
+			// namespace only
+			Input: "Microsoft.Network",
+			Valid: false,
+		},
+		{
+			// trailing slash
+			Input: "Microsoft.Network/virtualNetworks/",
+			Valid: false,
+		},
+		{
+			// top-level type
+			Input: "Microsoft.Network/virtualNetworks",
+			Valid: true,
+		},
+		{
+			// child type
+			Input: "Microsoft.Network/virtualNetworks/subnets",
+			Valid: true,
+		},
+		{
+			// invalid char
+			Input: "Microsoft.Network/virtual Networks",
+			Valid: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing value %s", tc.Input)
+		_, errors := GenericResourceType(tc.Input, "test")
+		valid := len(errors) == 0
+
+		if tc.Valid != valid {
+			t.Fatalf("expected %t but got %t", tc.Valid, valid)
+		}
+	}
+}
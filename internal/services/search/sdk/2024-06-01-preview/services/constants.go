@@ -0,0 +1,235 @@
+package services
+
+import "strings"
+
+type HostingMode string
+
+const (
+	HostingModeDefault     HostingMode = "default"
+	HostingModeHighDensity HostingMode = "highDensity"
+)
+
+func PossibleValuesForHostingMode() []string {
+	return []string{
+		string(HostingModeDefault),
+		string(HostingModeHighDensity),
+	}
+}
+
+func parseHostingMode(input string) (*HostingMode, error) {
+	vals := map[string]HostingMode{
+		"default":     HostingModeDefault,
+		"highdensity": HostingModeHighDensity,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := HostingMode(input)
+	return &out, nil
+}
+
+type ProvisioningState string
+
+const (
+	ProvisioningStateFailed       ProvisioningState = "failed"
+	ProvisioningStateProvisioning ProvisioningState = "provisioning"
+	ProvisioningStateSucceeded    ProvisioningState = "succeeded"
+)
+
+func PossibleValuesForProvisioningState() []string {
+	return []string{
+		string(ProvisioningStateFailed),
+		string(ProvisioningStateProvisioning),
+		string(ProvisioningStateSucceeded),
+	}
+}
+
+func parseProvisioningState(input string) (*ProvisioningState, error) {
+	vals := map[string]ProvisioningState{
+		"failed":       ProvisioningStateFailed,
+		"provisioning": ProvisioningStateProvisioning,
+		"succeeded":    ProvisioningStateSucceeded,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ProvisioningState(input)
+	return &out, nil
+}
+
+type PublicNetworkAccess string
+
+const (
+	PublicNetworkAccessDisabled PublicNetworkAccess = "disabled"
+	PublicNetworkAccessEnabled  PublicNetworkAccess = "enabled"
+)
+
+func PossibleValuesForPublicNetworkAccess() []string {
+	return []string{
+		string(PublicNetworkAccessDisabled),
+		string(PublicNetworkAccessEnabled),
+	}
+}
+
+func parsePublicNetworkAccess(input string) (*PublicNetworkAccess, error) {
+	vals := map[string]PublicNetworkAccess{
+		"disabled": PublicNetworkAccessDisabled,
+		"enabled":  PublicNetworkAccessEnabled,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := PublicNetworkAccess(input)
+	return &out, nil
+}
+
+type SearchBypass string
+
+const (
+	SearchBypassAzureServices SearchBypass = "AzureServices"
+	SearchBypassNone          SearchBypass = "None"
+)
+
+func PossibleValuesForSearchBypass() []string {
+	return []string{
+		string(SearchBypassAzureServices),
+		string(SearchBypassNone),
+	}
+}
+
+func parseSearchBypass(input string) (*SearchBypass, error) {
+	vals := map[string]SearchBypass{
+		"azureservices": SearchBypassAzureServices,
+		"none":          SearchBypassNone,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := SearchBypass(input)
+	return &out, nil
+}
+
+type SearchSemanticSearch string
+
+const (
+	SearchSemanticSearchDisabled SearchSemanticSearch = "disabled"
+	SearchSemanticSearchFree     SearchSemanticSearch = "free"
+	SearchSemanticSearchStandard SearchSemanticSearch = "standard"
+)
+
+func PossibleValuesForSearchSemanticSearch() []string {
+	return []string{
+		string(SearchSemanticSearchDisabled),
+		string(SearchSemanticSearchFree),
+		string(SearchSemanticSearchStandard),
+	}
+}
+
+func parseSearchSemanticSearch(input string) (*SearchSemanticSearch, error) {
+	vals := map[string]SearchSemanticSearch{
+		"disabled": SearchSemanticSearchDisabled,
+		"free":     SearchSemanticSearchFree,
+		"standard": SearchSemanticSearchStandard,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := SearchSemanticSearch(input)
+	return &out, nil
+}
+
+type SearchServiceStatus string
+
+const (
+	SearchServiceStatusDegraded     SearchServiceStatus = "degraded"
+	SearchServiceStatusDeleting     SearchServiceStatus = "deleting"
+	SearchServiceStatusDisabled     SearchServiceStatus = "disabled"
+	SearchServiceStatusError        SearchServiceStatus = "error"
+	SearchServiceStatusProvisioning SearchServiceStatus = "provisioning"
+	SearchServiceStatusRunning      SearchServiceStatus = "running"
+	SearchServiceStatusStopped      SearchServiceStatus = "stopped"
+)
+
+func PossibleValuesForSearchServiceStatus() []string {
+	return []string{
+		string(SearchServiceStatusDegraded),
+		string(SearchServiceStatusDeleting),
+		string(SearchServiceStatusDisabled),
+		string(SearchServiceStatusError),
+		string(SearchServiceStatusProvisioning),
+		string(SearchServiceStatusRunning),
+		string(SearchServiceStatusStopped),
+	}
+}
+
+func parseSearchServiceStatus(input string) (*SearchServiceStatus, error) {
+	vals := map[string]SearchServiceStatus{
+		"degraded":     SearchServiceStatusDegraded,
+		"deleting":     SearchServiceStatusDeleting,
+		"disabled":     SearchServiceStatusDisabled,
+		"error":        SearchServiceStatusError,
+		"provisioning": SearchServiceStatusProvisioning,
+		"running":      SearchServiceStatusRunning,
+		"stopped":      SearchServiceStatusStopped,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := SearchServiceStatus(input)
+	return &out, nil
+}
+
+type SkuName string
+
+const (
+	SkuNameBasic                SkuName = "basic"
+	SkuNameFree                 SkuName = "free"
+	SkuNameStandard             SkuName = "standard"
+	SkuNameStandardThree        SkuName = "standard3"
+	SkuNameStandardTwo          SkuName = "standard2"
+	SkuNameStorageOptimizedLOne SkuName = "storage_optimized_l1"
+	SkuNameStorageOptimizedLTwo SkuName = "storage_optimized_l2"
+)
+
+func PossibleValuesForSkuName() []string {
+	return []string{
+		string(SkuNameBasic),
+		string(SkuNameFree),
+		string(SkuNameStandard),
+		string(SkuNameStandardThree),
+		string(SkuNameStandardTwo),
+		string(SkuNameStorageOptimizedLOne),
+		string(SkuNameStorageOptimizedLTwo),
+	}
+}
+
+func parseSkuName(input string) (*SkuName, error) {
+	vals := map[string]SkuName{
+		"basic":                SkuNameBasic,
+		"free":                 SkuNameFree,
+		"standard":             SkuNameStandard,
+		"standard3":            SkuNameStandardThree,
+		"standard2":            SkuNameStandardTwo,
+		"storage_optimized_l1": SkuNameStorageOptimizedLOne,
+		"storage_optimized_l2": SkuNameStorageOptimizedLTwo,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := SkuName(input)
+	return &out, nil
+}
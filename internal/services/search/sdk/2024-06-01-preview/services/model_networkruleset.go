@@ -0,0 +1,6 @@
+package services
+
+type NetworkRuleSet struct {
+	Bypass  *SearchBypass `json:"bypass,omitempty"`
+	IPRules *[]IPRule     `json:"ipRules,omitempty"`
+}
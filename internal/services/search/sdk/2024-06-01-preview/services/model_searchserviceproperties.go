@@ -0,0 +1,13 @@
+package services
+
+type SearchServiceProperties struct {
+	HostingMode         *HostingMode          `json:"hostingMode,omitempty"`
+	NetworkRuleSet      *NetworkRuleSet       `json:"networkRuleSet,omitempty"`
+	PartitionCount      *int64                `json:"partitionCount,omitempty"`
+	ProvisioningState   *ProvisioningState    `json:"provisioningState,omitempty"`
+	PublicNetworkAccess *PublicNetworkAccess  `json:"publicNetworkAccess,omitempty"`
+	ReplicaCount        *int64                `json:"replicaCount,omitempty"`
+	SemanticSearch      *SearchSemanticSearch `json:"semanticSearch,omitempty"`
+	Status              *SearchServiceStatus  `json:"status,omitempty"`
+	StatusDetails       *string               `json:"statusDetails,omitempty"`
+}
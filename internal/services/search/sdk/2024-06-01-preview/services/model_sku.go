@@ -0,0 +1,5 @@
+package services
+
+type Sku struct {
+	Name *SkuName `json:"name,omitempty"`
+}
@@ -0,0 +1,9 @@
+package services
+
+import "fmt"
+
+const defaultApiVersion = "2024-06-01-preview"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/services/%s", defaultApiVersion)
+}
@@ -0,0 +1,8 @@
+package sharedprivatelinkresources
+
+type SharedPrivateLinkResource struct {
+	Id         *string                              `json:"id,omitempty"`
+	Name       *string                              `json:"name,omitempty"`
+	Properties *SharedPrivateLinkResourceProperties `json:"properties,omitempty"`
+	Type       *string                              `json:"type,omitempty"`
+}
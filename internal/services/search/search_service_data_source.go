@@ -2,14 +2,16 @@ package search
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
-	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/sdk/2024-06-01-preview/services"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
-	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
 func dataSourceSearchService() *pluginsdk.Resource {
@@ -105,47 +107,53 @@ func dataSourceSearchServiceRead(d *pluginsdk.ResourceData, meta interface{}) er
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id := parse.NewSearchServiceID(subscriptionID, d.Get("resource_group_name").(string), d.Get("name").(string))
+	id := services.NewSearchServiceID(subscriptionID, d.Get("resource_group_name").(string), d.Get("name").(string))
 
-	resp, err := client.Get(ctx, id.ResourceGroup, id.Name, nil)
+	resp, err := client.Get(ctx, id)
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
-			return fmt.Errorf("Search Service %q (Resource Group %q) was not found", id.Name, id.ResourceGroup)
+		if response.WasNotFound(resp.HttpResponse) {
+			return fmt.Errorf("%s was not found", id)
 		}
 
-		return fmt.Errorf("reading Search Service: %+v", err)
+		return fmt.Errorf("retrieving %s: %+v", id, err)
 	}
 
 	d.SetId(id.ID())
 
-	if props := resp.ServiceProperties; props != nil {
-		if count := props.PartitionCount; count != nil {
-			d.Set("partition_count", int(*count))
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			if count := props.PartitionCount; count != nil {
+				d.Set("partition_count", int(*count))
+			}
+
+			if count := props.ReplicaCount; count != nil {
+				d.Set("replica_count", int(*count))
+			}
+
+			publicNetworkAccess := true
+			if props.PublicNetworkAccess != nil {
+				publicNetworkAccess = strings.EqualFold(string(*props.PublicNetworkAccess), string(services.PublicNetworkAccessEnabled))
+			}
+			d.Set("public_network_access_enabled", publicNetworkAccess)
 		}
 
-		if count := props.ReplicaCount; count != nil {
-			d.Set("replica_count", int(*count))
+		if err := d.Set("identity", identity.FlattenSystemAssigned(model.Identity)); err != nil {
+			return fmt.Errorf("setting `identity`: %s", err)
 		}
-
-		d.Set("public_network_access_enabled", props.PublicNetworkAccess != "Disabled")
 	}
 
 	adminKeysClient := meta.(*clients.Client).Search.AdminKeysClient
-	adminKeysResp, err := adminKeysClient.Get(ctx, id.ResourceGroup, id.Name, nil)
+	adminKeysResp, err := adminKeysClient.Get(ctx, id.ResourceGroupName, id.SearchServiceName, nil)
 	if err == nil {
 		d.Set("primary_key", adminKeysResp.PrimaryKey)
 		d.Set("secondary_key", adminKeysResp.SecondaryKey)
 	}
 
 	queryKeysClient := meta.(*clients.Client).Search.QueryKeysClient
-	queryKeysResp, err := queryKeysClient.ListBySearchService(ctx, id.ResourceGroup, id.Name, nil)
+	queryKeysResp, err := queryKeysClient.ListBySearchService(ctx, id.ResourceGroupName, id.SearchServiceName, nil)
 	if err == nil {
 		d.Set("query_keys", flattenSearchQueryKeys(queryKeysResp.Values()))
 	}
 
-	if err := d.Set("identity", flattenSearchServiceIdentity(resp.Identity)); err != nil {
-		return fmt.Errorf("setting `identity`: %s", err)
-	}
-
 	return nil
 }
@@ -3,15 +3,19 @@ package search
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/search/mgmt/2020-03-13/search"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	tagsHelper "github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
-	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/sdk/2024-06-01-preview/services"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -34,7 +38,7 @@ func resourceSearchService() *pluginsdk.Resource {
 		},
 
 		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.SearchServiceID(id)
+			_, err := services.ParseSearchServiceID(id)
 			return err
 		}),
 
@@ -54,13 +58,33 @@ func resourceSearchService() *pluginsdk.Resource {
 				Required: true,
 				ForceNew: true,
 				ValidateFunc: validation.StringInSlice([]string{
-					string(search.Free),
-					string(search.Basic),
-					string(search.Standard),
-					string(search.Standard2),
-					string(search.Standard3),
-					string(search.StorageOptimizedL1),
-					string(search.StorageOptimizedL2),
+					string(services.SkuNameFree),
+					string(services.SkuNameBasic),
+					string(services.SkuNameStandard),
+					string(services.SkuNameStandardTwo),
+					string(services.SkuNameStandardThree),
+					string(services.SkuNameStorageOptimizedLOne),
+					string(services.SkuNameStorageOptimizedLTwo),
+				}, false),
+			},
+
+			"hosting_mode": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  string(services.HostingModeDefault),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(services.HostingModeDefault),
+					string(services.HostingModeHighDensity),
+				}, false),
+			},
+
+			"semantic_search_sku": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(services.SearchSemanticSearchFree),
+					string(services.SearchSemanticSearchStandard),
 				}, false),
 			},
 
@@ -111,6 +135,16 @@ func resourceSearchService() *pluginsdk.Resource {
 				Default:  true,
 			},
 
+			"network_rule_bypass_option": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  string(services.SearchBypassNone),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(services.SearchBypassAzureServices),
+					string(services.SearchBypassNone),
+				}, false),
+			},
+
 			"allowed_ips": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
@@ -133,7 +167,7 @@ func resourceSearchService() *pluginsdk.Resource {
 							Type:     pluginsdk.TypeString,
 							Required: true,
 							ValidateFunc: validation.StringInSlice([]string{
-								string(search.SystemAssigned),
+								string(identity.TypeSystemAssigned),
 							}, false),
 						},
 
@@ -161,64 +195,81 @@ func resourceSearchServiceCreateUpdate(d *pluginsdk.ResourceData, meta interface
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id := parse.NewSearchServiceID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+	id := services.NewSearchServiceID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
 	if d.IsNewResource() {
-		existing, err := client.Get(ctx, id.ResourceGroup, id.Name, nil)
+		existing, err := client.Get(ctx, id)
 		if err != nil {
-			if !utils.ResponseWasNotFound(existing.Response) {
+			if !response.WasNotFound(existing.HttpResponse) {
 				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
 			}
 		}
 
-		if !utils.ResponseWasNotFound(existing.Response) {
+		if !response.WasNotFound(existing.HttpResponse) {
 			return tf.ImportAsExistsError("azurerm_search_service", id.ID())
 		}
 	}
 
-	location := azure.NormalizeLocation(d.Get("location").(string))
-	skuName := d.Get("sku").(string)
+	skuName := services.SkuName(d.Get("sku").(string))
+
+	hostingMode := services.HostingMode(d.Get("hosting_mode").(string))
+	if hostingMode == services.HostingModeHighDensity && skuName != services.SkuNameStandardThree {
+		return fmt.Errorf("`hosting_mode` can only be set to %q when `sku` is %q", string(services.HostingModeHighDensity), string(services.SkuNameStandardThree))
+	}
+
+	semanticSearch := services.SearchSemanticSearchDisabled
+	if v := d.Get("semantic_search_sku").(string); v != "" {
+		if skuName == services.SkuNameFree {
+			return fmt.Errorf("`semantic_search_sku` cannot be set when `sku` is %q", string(services.SkuNameFree))
+		}
+		semanticSearch = services.SearchSemanticSearch(v)
+	}
 
-	publicNetworkAccess := search.Enabled
+	publicNetworkAccess := services.PublicNetworkAccessEnabled
 	if enabled := d.Get("public_network_access_enabled").(bool); !enabled {
-		publicNetworkAccess = search.Disabled
+		publicNetworkAccess = services.PublicNetworkAccessDisabled
+	}
+
+	expandedIdentity, err := identity.ExpandSystemAssigned(d.Get("identity").([]interface{}))
+	if err != nil {
+		return fmt.Errorf("expanding `identity`: %+v", err)
 	}
 
-	t := d.Get("tags").(map[string]interface{})
+	bypass := services.SearchBypass(d.Get("network_rule_bypass_option").(string))
 
-	properties := search.Service{
-		Location: utils.String(location),
-		Sku: &search.Sku{
-			Name: search.SkuName(skuName),
+	properties := services.SearchService{
+		Location: location.Normalize(d.Get("location").(string)),
+		Sku: &services.Sku{
+			Name: &skuName,
 		},
-		ServiceProperties: &search.ServiceProperties{
-			PublicNetworkAccess: publicNetworkAccess,
-			NetworkRuleSet: &search.NetworkRuleSet{
+		Properties: &services.SearchServiceProperties{
+			HostingMode:         &hostingMode,
+			PublicNetworkAccess: &publicNetworkAccess,
+			NetworkRuleSet: &services.NetworkRuleSet{
+				Bypass:  &bypass,
 				IPRules: expandSearchServiceIPRules(d.Get("allowed_ips").([]interface{})),
 			},
 		},
-		Identity: expandSearchServiceIdentity(d.Get("identity").([]interface{})),
-		Tags:     tags.Expand(t),
+		Identity: expandedIdentity,
+		Tags:     tagsHelper.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	// semantic search isn't supported on the free sku, where the API rejects even the `disabled` value
+	if skuName != services.SkuNameFree {
+		properties.Properties.SemanticSearch = &semanticSearch
 	}
 
 	if v, ok := d.GetOk("replica_count"); ok {
-		replicaCount := int32(v.(int))
-		properties.ServiceProperties.ReplicaCount = utils.Int32(replicaCount)
+		properties.Properties.ReplicaCount = utils.Int64(int64(v.(int)))
 	}
 
 	if v, ok := d.GetOk("partition_count"); ok {
-		partitionCount := int32(v.(int))
-		properties.ServiceProperties.PartitionCount = utils.Int32(partitionCount)
+		properties.Properties.PartitionCount = utils.Int64(int64(v.(int)))
 	}
 
-	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, properties, nil)
-	if err != nil {
+	if err := client.CreateOrUpdateThenPoll(ctx, id, properties); err != nil {
 		return fmt.Errorf("creating/updating %s: %+v", id, err)
 	}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for the creation/update of %s: %+v", id, err)
-	}
-
 	d.SetId(id.ID())
 	return resourceSearchServiceRead(d, meta)
 }
@@ -228,14 +279,14 @@ func resourceSearchServiceRead(d *pluginsdk.ResourceData, meta interface{}) erro
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := parse.SearchServiceID(d.Id())
+	id, err := services.ParseSearchServiceID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	resp, err := client.Get(ctx, id.ResourceGroup, id.Name, nil)
+	resp, err := client.Get(ctx, *id)
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if response.WasNotFound(resp.HttpResponse) {
 			log.Printf("[DEBUG] %s was not found - removing from state", *id)
 			d.SetId("")
 			return nil
@@ -244,46 +295,77 @@ func resourceSearchServiceRead(d *pluginsdk.ResourceData, meta interface{}) erro
 		return fmt.Errorf("retrieving %s: %+v", *id, err)
 	}
 
-	d.Set("name", id.Name)
-	d.Set("resource_group_name", id.ResourceGroup)
-	d.Set("location", location.NormalizeNilable(resp.Location))
+	d.Set("name", id.SearchServiceName)
+	d.Set("resource_group_name", id.ResourceGroupName)
 
-	if sku := resp.Sku; sku != nil {
-		d.Set("sku", string(sku.Name))
-	}
+	if model := resp.Model; model != nil {
+		d.Set("location", location.Normalize(model.Location))
 
-	if props := resp.ServiceProperties; props != nil {
-		if count := props.PartitionCount; count != nil {
-			d.Set("partition_count", int(*count))
+		skuName := ""
+		if sku := model.Sku; sku != nil && sku.Name != nil {
+			skuName = string(*sku.Name)
 		}
+		d.Set("sku", skuName)
 
-		if count := props.ReplicaCount; count != nil {
-			d.Set("replica_count", int(*count))
+		if props := model.Properties; props != nil {
+			if count := props.PartitionCount; count != nil {
+				d.Set("partition_count", int(*count))
+			}
+
+			if count := props.ReplicaCount; count != nil {
+				d.Set("replica_count", int(*count))
+			}
+
+			hostingMode := string(services.HostingModeDefault)
+			if props.HostingMode != nil {
+				hostingMode = string(*props.HostingMode)
+			}
+			d.Set("hosting_mode", hostingMode)
+
+			semanticSearchSku := ""
+			if props.SemanticSearch != nil && *props.SemanticSearch != services.SearchSemanticSearchDisabled {
+				semanticSearchSku = string(*props.SemanticSearch)
+			}
+			d.Set("semantic_search_sku", semanticSearchSku)
+
+			publicNetworkAccess := true
+			if props.PublicNetworkAccess != nil {
+				publicNetworkAccess = strings.EqualFold(string(*props.PublicNetworkAccess), string(services.PublicNetworkAccessEnabled))
+			}
+			d.Set("public_network_access_enabled", publicNetworkAccess)
+
+			bypass := string(services.SearchBypassNone)
+			if props.NetworkRuleSet != nil && props.NetworkRuleSet.Bypass != nil {
+				bypass = string(*props.NetworkRuleSet.Bypass)
+			}
+			d.Set("network_rule_bypass_option", bypass)
+
+			d.Set("allowed_ips", flattenSearchServiceIPRules(props.NetworkRuleSet))
 		}
 
-		d.Set("public_network_access_enabled", props.PublicNetworkAccess != "Disabled")
+		if err := d.Set("identity", identity.FlattenSystemAssigned(model.Identity)); err != nil {
+			return fmt.Errorf("setting `identity`: %s", err)
+		}
 
-		d.Set("allowed_ips", flattenSearchServiceIPRules(props.NetworkRuleSet))
+		if err := tags.FlattenAndSet(d, tagsHelper.Flatten(model.Tags)); err != nil {
+			return err
+		}
 	}
 
 	adminKeysClient := meta.(*clients.Client).Search.AdminKeysClient
-	adminKeysResp, err := adminKeysClient.Get(ctx, id.ResourceGroup, id.Name, nil)
+	adminKeysResp, err := adminKeysClient.Get(ctx, id.ResourceGroupName, id.SearchServiceName, nil)
 	if err == nil {
 		d.Set("primary_key", adminKeysResp.PrimaryKey)
 		d.Set("secondary_key", adminKeysResp.SecondaryKey)
 	}
 
 	queryKeysClient := meta.(*clients.Client).Search.QueryKeysClient
-	queryKeysResp, err := queryKeysClient.ListBySearchService(ctx, id.ResourceGroup, id.Name, nil)
+	queryKeysResp, err := queryKeysClient.ListBySearchService(ctx, id.ResourceGroupName, id.SearchServiceName, nil)
 	if err == nil {
 		d.Set("query_keys", flattenSearchQueryKeys(queryKeysResp.Values()))
 	}
 
-	if err := d.Set("identity", flattenSearchServiceIdentity(resp.Identity)); err != nil {
-		return fmt.Errorf("setting `identity`: %s", err)
-	}
-
-	return tags.FlattenAndSet(d, resp.Tags)
+	return nil
 }
 
 func resourceSearchServiceDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -291,14 +373,14 @@ func resourceSearchServiceDelete(d *pluginsdk.ResourceData, meta interface{}) er
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := parse.SearchServiceID(d.Id())
+	id, err := services.ParseSearchServiceID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	resp, err := client.Delete(ctx, id.ResourceGroup, id.Name, nil)
+	resp, err := client.Delete(ctx, *id)
 	if err != nil {
-		if utils.ResponseWasNotFound(resp) {
+		if response.WasNotFound(resp.HttpResponse) {
 			return nil
 		}
 
@@ -325,15 +407,15 @@ func flattenSearchQueryKeys(input []search.QueryKey) []interface{} {
 	return results
 }
 
-func expandSearchServiceIPRules(input []interface{}) *[]search.IPRule {
-	output := make([]search.IPRule, 0)
+func expandSearchServiceIPRules(input []interface{}) *[]services.IPRule {
+	output := make([]services.IPRule, 0)
 	if input == nil {
 		return &output
 	}
 
 	for _, rule := range input {
 		if rule != nil {
-			output = append(output, search.IPRule{
+			output = append(output, services.IPRule{
 				Value: utils.String(rule.(string)),
 			})
 		}
@@ -342,8 +424,8 @@ func expandSearchServiceIPRules(input []interface{}) *[]search.IPRule {
 	return &output
 }
 
-func flattenSearchServiceIPRules(input *search.NetworkRuleSet) []interface{} {
-	if input == nil || *input.IPRules == nil || len(*input.IPRules) == 0 {
+func flattenSearchServiceIPRules(input *services.NetworkRuleSet) []interface{} {
+	if input == nil || input.IPRules == nil || len(*input.IPRules) == 0 {
 		return nil
 	}
 	result := make([]interface{}, 0)
@@ -352,39 +434,3 @@ func flattenSearchServiceIPRules(input *search.NetworkRuleSet) []interface{} {
 	}
 	return result
 }
-
-func expandSearchServiceIdentity(input []interface{}) *search.Identity {
-	if len(input) == 0 || input[0] == nil {
-		return &search.Identity{
-			Type: search.None,
-		}
-	}
-	identity := input[0].(map[string]interface{})
-	return &search.Identity{
-		Type: search.IdentityType(identity["type"].(string)),
-	}
-}
-
-func flattenSearchServiceIdentity(identity *search.Identity) []interface{} {
-	if identity == nil || identity.Type == search.None {
-		return make([]interface{}, 0)
-	}
-
-	principalId := ""
-	if identity.PrincipalID != nil {
-		principalId = *identity.PrincipalID
-	}
-
-	tenantId := ""
-	if identity.TenantID != nil {
-		tenantId = *identity.TenantID
-	}
-
-	return []interface{}{
-		map[string]interface{}{
-			"principal_id": principalId,
-			"tenant_id":    tenantId,
-			"type":         string(identity.Type),
-		},
-	}
-}
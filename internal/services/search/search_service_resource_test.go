@@ -8,7 +8,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
-	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/sdk/2024-06-01-preview/services"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
@@ -62,6 +62,7 @@ func TestAccSearchService_complete(t *testing.T) {
 				check.That(data.ResourceName).Key("secondary_key").Exists(),
 				check.That(data.ResourceName).Key("query_keys.#").HasValue("1"),
 				check.That(data.ResourceName).Key("public_network_access_enabled").HasValue("false"),
+				check.That(data.ResourceName).Key("network_rule_bypass_option").HasValue("AzureServices"),
 			),
 		},
 		data.ImportStep(),
@@ -158,18 +159,66 @@ func TestAccSearchService_identity(t *testing.T) {
 	})
 }
 
+func TestAccSearchService_semanticSearch(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_search_service", "test")
+	r := SearchServiceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.semanticSearch(data, "free"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("semantic_search_sku").HasValue("free"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.semanticSearch(data, "standard"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("semantic_search_sku").HasValue("standard"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("semantic_search_sku").HasValue(""),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSearchService_hostingModeHighDensity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_search_service", "test")
+	r := SearchServiceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.hostingModeHighDensity(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("hosting_mode").HasValue("highDensity"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (t SearchServiceResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
-	id, err := parse.SearchServiceID(state.ID)
+	id, err := services.ParseSearchServiceID(state.ID)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := clients.Search.ServicesClient.Get(ctx, id.ResourceGroup, id.Name, nil)
+	resp, err := clients.Search.ServicesClient.Get(ctx, *id)
 	if err != nil {
-		return nil, fmt.Errorf("reading Search Service %q (resource group %q) was not found: %+v", id.Name, id.ResourceGroup, err)
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
 	}
 
-	return utils.Bool(resp.ServiceProperties != nil), nil
+	return utils.Bool(resp.Model != nil && resp.Model.Properties != nil), nil
 }
 
 func (SearchServiceResource) basic(data acceptance.TestData) string {
@@ -233,6 +282,7 @@ resource "azurerm_search_service" "test" {
   partition_count     = 3
 
   public_network_access_enabled = false
+  network_rule_bypass_option    = "AzureServices"
 
   tags = {
     environment = "Production"
@@ -295,3 +345,49 @@ resource "azurerm_search_service" "test" {
 }
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
 }
+
+func (SearchServiceResource) semanticSearch(data acceptance.TestData, semanticSearchSku string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_search_service" "test" {
+  name                = "acctestsearchservice%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku                 = "standard"
+  semantic_search_sku = "%s"
+
+  tags = {
+    environment = "staging"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, semanticSearchSku)
+}
+
+func (SearchServiceResource) hostingModeHighDensity(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_search_service" "test" {
+  name                = "acctestsearchservice%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku                 = "standard3"
+  hosting_mode        = "highDensity"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
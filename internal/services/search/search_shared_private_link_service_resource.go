@@ -0,0 +1,232 @@
+package search
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/sdk/2024-06-01-preview/sharedprivatelinkresources"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.ResourceWithUpdate = SharedPrivateLinkServiceResource{}
+
+type SharedPrivateLinkServiceResource struct{}
+
+type SharedPrivateLinkServiceModel struct {
+	Name             string `tfschema:"name"`
+	SearchServiceId  string `tfschema:"search_service_id"`
+	SubResourceName  string `tfschema:"subresource_name"`
+	TargetResourceId string `tfschema:"target_resource_id"`
+	RequestMessage   string `tfschema:"request_message"`
+	Status           string `tfschema:"status"`
+}
+
+func (r SharedPrivateLinkServiceResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"search_service_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: sharedprivatelinkresources.ValidateSearchServiceID,
+		},
+
+		"subresource_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"target_resource_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: azure.ValidateResourceID,
+		},
+
+		"request_message": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r SharedPrivateLinkServiceResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"status": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r SharedPrivateLinkServiceResource) ModelObject() interface{} {
+	return &SharedPrivateLinkServiceModel{}
+}
+
+func (r SharedPrivateLinkServiceResource) ResourceType() string {
+	return "azurerm_search_shared_private_link_service"
+}
+
+func (r SharedPrivateLinkServiceResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return sharedprivatelinkresources.ValidateSharedPrivateLinkResourceID
+}
+
+func (r SharedPrivateLinkServiceResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Search.SharedPrivateLinkResourcesClient
+
+			var model SharedPrivateLinkServiceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			searchServiceId, err := sharedprivatelinkresources.ParseSearchServiceID(model.SearchServiceId)
+			if err != nil {
+				return err
+			}
+
+			id := sharedprivatelinkresources.NewSharedPrivateLinkResourceID(searchServiceId.SubscriptionId, searchServiceId.ResourceGroupName, searchServiceId.SearchServiceName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := sharedprivatelinkresources.SharedPrivateLinkResource{
+				Properties: &sharedprivatelinkresources.SharedPrivateLinkResourceProperties{
+					GroupId:               utils.String(model.SubResourceName),
+					PrivateLinkResourceId: utils.String(model.TargetResourceId),
+				},
+			}
+
+			if model.RequestMessage != "" {
+				payload.Properties.RequestMessage = utils.String(model.RequestMessage)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r SharedPrivateLinkServiceResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Search.SharedPrivateLinkResourcesClient
+
+			id, err := sharedprivatelinkresources.ParseSharedPrivateLinkResourceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := SharedPrivateLinkServiceModel{
+				Name:            id.SharedPrivateLinkResourceName,
+				SearchServiceId: sharedprivatelinkresources.NewSearchServiceID(id.SubscriptionId, id.ResourceGroupName, id.SearchServiceName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.SubResourceName = utils.NormalizeNilableString(props.GroupId)
+					state.TargetResourceId = utils.NormalizeNilableString(props.PrivateLinkResourceId)
+					state.RequestMessage = utils.NormalizeNilableString(props.RequestMessage)
+
+					if props.Status != nil {
+						state.Status = string(*props.Status)
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r SharedPrivateLinkServiceResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Search.SharedPrivateLinkResourcesClient
+
+			id, err := sharedprivatelinkresources.ParseSharedPrivateLinkResourceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model SharedPrivateLinkServiceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil || existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+			}
+
+			payload := *existing.Model
+			if metadata.ResourceData.HasChange("request_message") {
+				payload.Properties.RequestMessage = utils.String(model.RequestMessage)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r SharedPrivateLinkServiceResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Search.SharedPrivateLinkResourcesClient
+
+			id, err := sharedprivatelinkresources.ParseSharedPrivateLinkResourceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,608 @@
+package servicebus
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/servicebus/mgmt/2021-06-01-preview/servicebus"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicebus/client"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicebus/parse"
+	azValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/servicebus/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+const (
+	serviceBusNamespaceEntitiesResourceName = "azurerm_servicebus_namespace_entities"
+
+	// these match the values returned by the API when the properties are omitted
+	serviceBusEntityDefaultMessageTTL     = "P10675199DT2H48M5.4775807S"
+	serviceBusEntityDefaultLockDuration   = "PT1M"
+	serviceBusEntityDefaultMaxSizeInMB    = 1024
+	serviceBusEntityDefaultMaxDeliveryCnt = 10
+)
+
+func resourceServiceBusNamespaceEntities() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceServiceBusNamespaceEntitiesCreate,
+		Read:   resourceServiceBusNamespaceEntitiesRead,
+		Update: resourceServiceBusNamespaceEntitiesUpdate,
+		Delete: resourceServiceBusNamespaceEntitiesDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceIdThen(func(id string) error {
+			_, err := parse.NamespaceID(id)
+			return err
+		}, importServiceBusNamespaceEntities),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"namespace_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azValidate.NamespaceID,
+			},
+
+			"queue": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: azValidate.QueueName(),
+						},
+
+						"dead_lettering_on_message_expiration": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"default_message_ttl": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Default:      serviceBusEntityDefaultMessageTTL,
+							ValidateFunc: validate.ISO8601Duration,
+						},
+
+						"enable_partitioning": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"lock_duration": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Default:      serviceBusEntityDefaultLockDuration,
+							ValidateFunc: validate.ISO8601Duration,
+						},
+
+						"max_delivery_count": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							Default:      serviceBusEntityDefaultMaxDeliveryCnt,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+
+						"max_size_in_megabytes": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							Default:      serviceBusEntityDefaultMaxSizeInMB,
+							ValidateFunc: azValidate.ServiceBusMaxSizeInMegabytes(),
+						},
+
+						"requires_session": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"status": serviceBusNamespaceEntityStatusSchema(),
+					},
+				},
+			},
+
+			"topic": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: azValidate.TopicName(),
+						},
+
+						"default_message_ttl": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Default:      serviceBusEntityDefaultMessageTTL,
+							ValidateFunc: validate.ISO8601Duration,
+						},
+
+						"enable_partitioning": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"max_size_in_megabytes": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							Default:      serviceBusEntityDefaultMaxSizeInMB,
+							ValidateFunc: azValidate.ServiceBusMaxSizeInMegabytes(),
+						},
+
+						"support_ordering": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"status": serviceBusNamespaceEntityStatusSchema(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func serviceBusNamespaceEntityStatusSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeString,
+		Optional: true,
+		Default:  string(servicebus.EntityStatusActive),
+		ValidateFunc: validation.StringInSlice([]string{
+			string(servicebus.EntityStatusActive),
+			string(servicebus.EntityStatusDisabled),
+			string(servicebus.EntityStatusReceiveDisabled),
+			string(servicebus.EntityStatusSendDisabled),
+		}, false),
+	}
+}
+
+func resourceServiceBusNamespaceEntitiesCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ServiceBus
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NamespaceID(d.Get("namespace_id").(string))
+	if err != nil {
+		return err
+	}
+
+	locks.ByID(id.ID())
+	defer locks.UnlockByID(id.ID())
+
+	existingQueues, err := listServiceBusNamespaceQueues(ctx, client.QueuesClient, *id)
+	if err != nil {
+		return err
+	}
+	existingTopics, err := listServiceBusNamespaceTopics(ctx, client.TopicsClient, *id)
+	if err != nil {
+		return err
+	}
+
+	queues := expandServiceBusNamespaceEntitiesQueues(d.Get("queue").(*pluginsdk.Set).List())
+	for name := range queues {
+		if _, ok := existingQueues[name]; ok {
+			return tf.ImportAsExistsError(serviceBusNamespaceEntitiesResourceName, id.ID())
+		}
+	}
+	topics := expandServiceBusNamespaceEntitiesTopics(d.Get("topic").(*pluginsdk.Set).List())
+	for name := range topics {
+		if _, ok := existingTopics[name]; ok {
+			return tf.ImportAsExistsError(serviceBusNamespaceEntitiesResourceName, id.ID())
+		}
+	}
+
+	if err := applyServiceBusNamespaceEntities(ctx, client, *id, map[string]servicebus.SBQueue{}, queues, map[string]servicebus.SBTopic{}, topics); err != nil {
+		return err
+	}
+
+	d.SetId(id.ID())
+	return resourceServiceBusNamespaceEntitiesRead(d, meta)
+}
+
+func resourceServiceBusNamespaceEntitiesRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ServiceBus
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NamespaceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	namespace, err := client.NamespacesClient.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(namespace.Response) {
+			log.Printf("[INFO] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	isPremium := namespace.Sku != nil && namespace.Sku.Name == servicebus.SkuNamePremium
+
+	// the entities are retrieved using the List APIs (a single paged request per entity type) rather than
+	// one request per entity - and only the entities managed by this resource are tracked in the state
+	existingQueues, err := listServiceBusNamespaceQueues(ctx, client.QueuesClient, *id)
+	if err != nil {
+		return err
+	}
+	existingTopics, err := listServiceBusNamespaceTopics(ctx, client.TopicsClient, *id)
+	if err != nil {
+		return err
+	}
+
+	d.Set("namespace_id", id.ID())
+
+	managedQueues := make([]servicebus.SBQueue, 0)
+	for _, raw := range d.Get("queue").(*pluginsdk.Set).List() {
+		name := raw.(map[string]interface{})["name"].(string)
+		if queue, ok := existingQueues[name]; ok {
+			managedQueues = append(managedQueues, queue)
+		}
+	}
+	if err := d.Set("queue", flattenServiceBusNamespaceEntitiesQueues(managedQueues, isPremium)); err != nil {
+		return fmt.Errorf("setting `queue`: %+v", err)
+	}
+
+	managedTopics := make([]servicebus.SBTopic, 0)
+	for _, raw := range d.Get("topic").(*pluginsdk.Set).List() {
+		name := raw.(map[string]interface{})["name"].(string)
+		if topic, ok := existingTopics[name]; ok {
+			managedTopics = append(managedTopics, topic)
+		}
+	}
+	if err := d.Set("topic", flattenServiceBusNamespaceEntitiesTopics(managedTopics, isPremium)); err != nil {
+		return fmt.Errorf("setting `topic`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceServiceBusNamespaceEntitiesUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ServiceBus
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NamespaceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByID(id.ID())
+	defer locks.UnlockByID(id.ID())
+
+	oldQueuesRaw, newQueuesRaw := d.GetChange("queue")
+	oldTopicsRaw, newTopicsRaw := d.GetChange("topic")
+
+	oldQueues := expandServiceBusNamespaceEntitiesQueues(oldQueuesRaw.(*pluginsdk.Set).List())
+	newQueues := expandServiceBusNamespaceEntitiesQueues(newQueuesRaw.(*pluginsdk.Set).List())
+	oldTopics := expandServiceBusNamespaceEntitiesTopics(oldTopicsRaw.(*pluginsdk.Set).List())
+	newTopics := expandServiceBusNamespaceEntitiesTopics(newTopicsRaw.(*pluginsdk.Set).List())
+
+	if err := applyServiceBusNamespaceEntities(ctx, client, *id, oldQueues, newQueues, oldTopics, newTopics); err != nil {
+		return err
+	}
+
+	return resourceServiceBusNamespaceEntitiesRead(d, meta)
+}
+
+func resourceServiceBusNamespaceEntitiesDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ServiceBus
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NamespaceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByID(id.ID())
+	defer locks.UnlockByID(id.ID())
+
+	queues := expandServiceBusNamespaceEntitiesQueues(d.Get("queue").(*pluginsdk.Set).List())
+	topics := expandServiceBusNamespaceEntitiesTopics(d.Get("topic").(*pluginsdk.Set).List())
+
+	return applyServiceBusNamespaceEntities(ctx, client, *id, queues, map[string]servicebus.SBQueue{}, topics, map[string]servicebus.SBTopic{})
+}
+
+func importServiceBusNamespaceEntities(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) ([]*pluginsdk.ResourceData, error) {
+	client := meta.(*clients.Client).ServiceBus
+
+	id, err := parse.NamespaceID(d.Id())
+	if err != nil {
+		return []*pluginsdk.ResourceData{}, err
+	}
+
+	// when importing all of the existing Queues and Topics within the Namespace are brought under management
+	queues, err := listServiceBusNamespaceQueues(ctx, client.QueuesClient, *id)
+	if err != nil {
+		return []*pluginsdk.ResourceData{}, err
+	}
+	queueNames := make([]interface{}, 0)
+	for name := range queues {
+		queueNames = append(queueNames, map[string]interface{}{
+			"name": name,
+		})
+	}
+
+	topics, err := listServiceBusNamespaceTopics(ctx, client.TopicsClient, *id)
+	if err != nil {
+		return []*pluginsdk.ResourceData{}, err
+	}
+	topicNames := make([]interface{}, 0)
+	for name := range topics {
+		topicNames = append(topicNames, map[string]interface{}{
+			"name": name,
+		})
+	}
+
+	if err := d.Set("queue", queueNames); err != nil {
+		return []*pluginsdk.ResourceData{}, fmt.Errorf("setting `queue`: %+v", err)
+	}
+	if err := d.Set("topic", topicNames); err != nil {
+		return []*pluginsdk.ResourceData{}, fmt.Errorf("setting `topic`: %+v", err)
+	}
+
+	return []*pluginsdk.ResourceData{d}, nil
+}
+
+// applyServiceBusNamespaceEntities only sends requests for the Queues and Topics which have been added, changed
+// or removed between the old and new configurations, which avoids one request per entity for unchanged entities.
+func applyServiceBusNamespaceEntities(ctx context.Context, client *client.Client, id parse.NamespaceId, oldQueues, newQueues map[string]servicebus.SBQueue, oldTopics, newTopics map[string]servicebus.SBTopic) error {
+	for name, oldQueue := range oldQueues {
+		newQueue, exists := newQueues[name]
+		// partitioning and sessions can only be configured when the Queue is created
+		if exists && !serviceBusQueueRequiresRecreation(oldQueue, newQueue) {
+			continue
+		}
+
+		log.Printf("[DEBUG] Deleting Queue %q within %s..", name, id)
+		if resp, err := client.QueuesClient.Delete(ctx, id.ResourceGroup, id.Name, name); err != nil && !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("deleting Queue %q within %s: %+v", name, id, err)
+		}
+		delete(oldQueues, name)
+	}
+
+	for name, newQueue := range newQueues {
+		if oldQueue, exists := oldQueues[name]; exists && serviceBusQueuesEqual(oldQueue, newQueue) {
+			continue
+		}
+
+		log.Printf("[DEBUG] Creating/Updating Queue %q within %s..", name, id)
+		if _, err := client.QueuesClient.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, name, newQueue); err != nil {
+			return fmt.Errorf("creating/updating Queue %q within %s: %+v", name, id, err)
+		}
+	}
+
+	for name, oldTopic := range oldTopics {
+		newTopic, exists := newTopics[name]
+		// partitioning can only be configured when the Topic is created
+		if exists && *oldTopic.EnablePartitioning == *newTopic.EnablePartitioning {
+			continue
+		}
+
+		log.Printf("[DEBUG] Deleting Topic %q within %s..", name, id)
+		if resp, err := client.TopicsClient.Delete(ctx, id.ResourceGroup, id.Name, name); err != nil && !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("deleting Topic %q within %s: %+v", name, id, err)
+		}
+		delete(oldTopics, name)
+	}
+
+	for name, newTopic := range newTopics {
+		if oldTopic, exists := oldTopics[name]; exists && serviceBusTopicsEqual(oldTopic, newTopic) {
+			continue
+		}
+
+		log.Printf("[DEBUG] Creating/Updating Topic %q within %s..", name, id)
+		if _, err := client.TopicsClient.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, name, newTopic); err != nil {
+			return fmt.Errorf("creating/updating Topic %q within %s: %+v", name, id, err)
+		}
+	}
+
+	return nil
+}
+
+func listServiceBusNamespaceQueues(ctx context.Context, client *servicebus.QueuesClient, id parse.NamespaceId) (map[string]servicebus.SBQueue, error) {
+	results := make(map[string]servicebus.SBQueue)
+
+	iterator, err := client.ListByNamespaceComplete(ctx, id.ResourceGroup, id.Name, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("listing Queues within %s: %+v", id, err)
+	}
+	for iterator.NotDone() {
+		queue := iterator.Value()
+		if queue.Name != nil {
+			results[*queue.Name] = queue
+		}
+
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return nil, fmt.Errorf("listing Queues within %s: %+v", id, err)
+		}
+	}
+
+	return results, nil
+}
+
+func listServiceBusNamespaceTopics(ctx context.Context, client *servicebus.TopicsClient, id parse.NamespaceId) (map[string]servicebus.SBTopic, error) {
+	results := make(map[string]servicebus.SBTopic)
+
+	iterator, err := client.ListByNamespaceComplete(ctx, id.ResourceGroup, id.Name, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("listing Topics within %s: %+v", id, err)
+	}
+	for iterator.NotDone() {
+		topic := iterator.Value()
+		if topic.Name != nil {
+			results[*topic.Name] = topic
+		}
+
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return nil, fmt.Errorf("listing Topics within %s: %+v", id, err)
+		}
+	}
+
+	return results, nil
+}
+
+func expandServiceBusNamespaceEntitiesQueues(input []interface{}) map[string]servicebus.SBQueue {
+	results := make(map[string]servicebus.SBQueue)
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+		name := v["name"].(string)
+
+		results[name] = servicebus.SBQueue{
+			Name: utils.String(name),
+			SBQueueProperties: &servicebus.SBQueueProperties{
+				DeadLetteringOnMessageExpiration: utils.Bool(v["dead_lettering_on_message_expiration"].(bool)),
+				DefaultMessageTimeToLive:         utils.String(v["default_message_ttl"].(string)),
+				EnablePartitioning:               utils.Bool(v["enable_partitioning"].(bool)),
+				LockDuration:                     utils.String(v["lock_duration"].(string)),
+				MaxDeliveryCount:                 utils.Int32(int32(v["max_delivery_count"].(int))),
+				MaxSizeInMegabytes:               utils.Int32(int32(v["max_size_in_megabytes"].(int))),
+				RequiresSession:                  utils.Bool(v["requires_session"].(bool)),
+				Status:                           servicebus.EntityStatus(v["status"].(string)),
+			},
+		}
+	}
+
+	return results
+}
+
+func flattenServiceBusNamespaceEntitiesQueues(input []servicebus.SBQueue, isPremium bool) []interface{} {
+	results := make([]interface{}, 0)
+
+	for _, item := range input {
+		props := item.SBQueueProperties
+		if item.Name == nil || props == nil {
+			continue
+		}
+
+		enablePartitioning := utils.NormaliseNilableBool(props.EnablePartitioning)
+
+		results = append(results, map[string]interface{}{
+			"name":                                 *item.Name,
+			"dead_lettering_on_message_expiration": utils.NormaliseNilableBool(props.DeadLetteringOnMessageExpiration),
+			"default_message_ttl":                  utils.NormalizeNilableString(props.DefaultMessageTimeToLive),
+			"enable_partitioning":                  enablePartitioning,
+			"lock_duration":                        utils.NormalizeNilableString(props.LockDuration),
+			"max_delivery_count":                   int(utils.NormaliseNilableInt32(props.MaxDeliveryCount)),
+			"max_size_in_megabytes":                flattenServiceBusNamespaceEntityMaxSize(props.MaxSizeInMegabytes, enablePartitioning, isPremium),
+			"requires_session":                     utils.NormaliseNilableBool(props.RequiresSession),
+			"status":                               string(props.Status),
+		})
+	}
+
+	return results
+}
+
+func expandServiceBusNamespaceEntitiesTopics(input []interface{}) map[string]servicebus.SBTopic {
+	results := make(map[string]servicebus.SBTopic)
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+		name := v["name"].(string)
+
+		results[name] = servicebus.SBTopic{
+			Name: utils.String(name),
+			SBTopicProperties: &servicebus.SBTopicProperties{
+				DefaultMessageTimeToLive: utils.String(v["default_message_ttl"].(string)),
+				EnablePartitioning:       utils.Bool(v["enable_partitioning"].(bool)),
+				MaxSizeInMegabytes:       utils.Int32(int32(v["max_size_in_megabytes"].(int))),
+				SupportOrdering:          utils.Bool(v["support_ordering"].(bool)),
+				Status:                   servicebus.EntityStatus(v["status"].(string)),
+			},
+		}
+	}
+
+	return results
+}
+
+func flattenServiceBusNamespaceEntitiesTopics(input []servicebus.SBTopic, isPremium bool) []interface{} {
+	results := make([]interface{}, 0)
+
+	for _, item := range input {
+		props := item.SBTopicProperties
+		if item.Name == nil || props == nil {
+			continue
+		}
+
+		enablePartitioning := utils.NormaliseNilableBool(props.EnablePartitioning)
+
+		results = append(results, map[string]interface{}{
+			"name":                  *item.Name,
+			"default_message_ttl":   utils.NormalizeNilableString(props.DefaultMessageTimeToLive),
+			"enable_partitioning":   enablePartitioning,
+			"max_size_in_megabytes": flattenServiceBusNamespaceEntityMaxSize(props.MaxSizeInMegabytes, enablePartitioning, isPremium),
+			"support_ordering":      utils.NormaliseNilableBool(props.SupportOrdering),
+			"status":                string(props.Status),
+		})
+	}
+
+	return results
+}
+
+func flattenServiceBusNamespaceEntityMaxSize(input *int32, enablePartitioning, isPremium bool) int {
+	if input == nil {
+		return 0
+	}
+
+	// when partitioning is enabled in a Basic or Standard Namespace the API returns 16 times the configured size
+	if enablePartitioning && !isPremium {
+		const partitionCount = 16
+		return int(*input / partitionCount)
+	}
+
+	return int(*input)
+}
+
+func serviceBusQueueRequiresRecreation(oldQueue, newQueue servicebus.SBQueue) bool {
+	o := oldQueue.SBQueueProperties
+	n := newQueue.SBQueueProperties
+	return *o.EnablePartitioning != *n.EnablePartitioning || *o.RequiresSession != *n.RequiresSession
+}
+
+func serviceBusQueuesEqual(oldQueue, newQueue servicebus.SBQueue) bool {
+	o := oldQueue.SBQueueProperties
+	n := newQueue.SBQueueProperties
+	return *o.DeadLetteringOnMessageExpiration == *n.DeadLetteringOnMessageExpiration &&
+		*o.DefaultMessageTimeToLive == *n.DefaultMessageTimeToLive &&
+		*o.EnablePartitioning == *n.EnablePartitioning &&
+		*o.LockDuration == *n.LockDuration &&
+		*o.MaxDeliveryCount == *n.MaxDeliveryCount &&
+		*o.MaxSizeInMegabytes == *n.MaxSizeInMegabytes &&
+		*o.RequiresSession == *n.RequiresSession &&
+		o.Status == n.Status
+}
+
+func serviceBusTopicsEqual(oldTopic, newTopic servicebus.SBTopic) bool {
+	o := oldTopic.SBTopicProperties
+	n := newTopic.SBTopicProperties
+	return *o.DefaultMessageTimeToLive == *n.DefaultMessageTimeToLive &&
+		*o.EnablePartitioning == *n.EnablePartitioning &&
+		*o.MaxSizeInMegabytes == *n.MaxSizeInMegabytes &&
+		*o.SupportOrdering == *n.SupportOrdering &&
+		o.Status == n.Status
+}
@@ -0,0 +1,195 @@
+package servicebus_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicebus/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ServiceBusNamespaceEntitiesResource struct{}
+
+func TestAccServiceBusNamespaceEntities_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_servicebus_namespace_entities", "test")
+	r := ServiceBusNamespaceEntitiesResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("queue.#").HasValue("2"),
+				check.That(data.ResourceName).Key("topic.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccServiceBusNamespaceEntities_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_servicebus_namespace_entities", "test")
+	r := ServiceBusNamespaceEntitiesResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccServiceBusNamespaceEntities_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_servicebus_namespace_entities", "test")
+	r := ServiceBusNamespaceEntitiesResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.updated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("queue.#").HasValue("2"),
+				check.That(data.ResourceName).Key("topic.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (t ServiceBusNamespaceEntitiesResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.NamespaceID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	for key, name := range state.Attributes {
+		if !strings.HasSuffix(key, ".name") {
+			continue
+		}
+
+		if strings.HasPrefix(key, "queue.") {
+			if _, err := clients.ServiceBus.QueuesClient.Get(ctx, id.ResourceGroup, id.Name, name); err != nil {
+				return nil, fmt.Errorf("retrieving Queue %q within %s: %+v", name, *id, err)
+			}
+		}
+
+		if strings.HasPrefix(key, "topic.") {
+			if _, err := clients.ServiceBus.TopicsClient.Get(ctx, id.ResourceGroup, id.Name, name); err != nil {
+				return nil, fmt.Errorf("retrieving Topic %q within %s: %+v", name, *id, err)
+			}
+		}
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (r ServiceBusNamespaceEntitiesResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_servicebus_namespace_entities" "test" {
+  namespace_id = azurerm_servicebus_namespace.test.id
+
+  queue {
+    name = "queue-one"
+  }
+
+  queue {
+    name                = "queue-two"
+    max_delivery_count  = 5
+    requires_session    = true
+    enable_partitioning = true
+  }
+
+  topic {
+    name = "topic-one"
+  }
+}
+`, r.template(data))
+}
+
+func (r ServiceBusNamespaceEntitiesResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_servicebus_namespace_entities" "import" {
+  namespace_id = azurerm_servicebus_namespace_entities.test.namespace_id
+
+  queue {
+    name = "queue-one"
+  }
+}
+`, r.basic(data))
+}
+
+func (r ServiceBusNamespaceEntitiesResource) updated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_servicebus_namespace_entities" "test" {
+  namespace_id = azurerm_servicebus_namespace.test.id
+
+  queue {
+    name                                 = "queue-one"
+    dead_lettering_on_message_expiration = true
+    lock_duration                        = "PT5M"
+    status                               = "Disabled"
+  }
+
+  queue {
+    name = "queue-three"
+  }
+
+  topic {
+    name                  = "topic-one"
+    max_size_in_megabytes = 2048
+    support_ordering      = true
+  }
+
+  topic {
+    name                = "topic-two"
+    default_message_ttl = "P7D"
+  }
+}
+`, r.template(data))
+}
+
+func (ServiceBusNamespaceEntitiesResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_servicebus_namespace" "test" {
+  name                = "acctestservicebusnamespace-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku                 = "Standard"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
@@ -47,6 +47,14 @@ func resourceServiceBusNamespace() *pluginsdk.Resource {
 			0: migration.NamespaceV0ToV1{},
 		}),
 
+		CustomizeDiff: pluginsdk.CustomDiffInSequence(
+			// a Namespace can be changed between the Basic and Standard tiers in-place, but not from or to Premium
+			pluginsdk.ForceNewUnlessTransitionSupported("sku", map[string][]string{
+				string(servicebus.SkuNameBasic):    {string(servicebus.SkuNameStandard)},
+				string(servicebus.SkuNameStandard): {string(servicebus.SkuNameBasic)},
+			}),
+		),
+
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
@@ -69,7 +77,6 @@ func resourceServiceBusNamespace() *pluginsdk.Resource {
 			"sku": {
 				Type:     pluginsdk.TypeString,
 				Required: true,
-				ForceNew: true,
 				ValidateFunc: validation.StringInSlice([]string{
 					string(servicebus.SkuNameBasic),
 					string(servicebus.SkuNameStandard),
@@ -99,6 +99,55 @@ func TestAccAzureRMServiceBusNamespace_NonStandardCasing(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMServiceBusNamespace_skuUpdated(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_servicebus_namespace", "test")
+	r := ServiceBusNamespaceResource{}
+
+	// the Resource ID is the same when the Namespace is recreated, so the creation time is compared instead
+	var createdAt string
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("sku").HasValue("basic"),
+				data.CheckWithClient(r.createdAt(&createdAt, false)),
+			),
+		},
+		data.ImportStep(),
+		{
+			// changing between Basic and Standard is performed in-place
+			Config: r.standard(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("sku").HasValue("standard"),
+				data.CheckWithClient(r.createdAt(&createdAt, false)),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("sku").HasValue("basic"),
+				data.CheckWithClient(r.createdAt(&createdAt, false)),
+			),
+		},
+		data.ImportStep(),
+		{
+			// changing to Premium recreates the Namespace
+			Config: r.premium(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("sku").HasValue("premium"),
+				data.CheckWithClient(r.createdAt(&createdAt, true)),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccAzureRMServiceBusNamespace_premium(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_servicebus_namespace", "test")
 	r := ServiceBusNamespaceResource{}
@@ -164,6 +213,35 @@ func (t ServiceBusNamespaceResource) Exists(ctx context.Context, clients *client
 	return utils.Bool(resp.ID != nil), nil
 }
 
+// createdAt returns a ClientCheckFunc which compares the creation time of the Namespace against the one recorded by
+// the previous step (when there is one) to determine whether it was recreated, then records the current value
+func (t ServiceBusNamespaceResource) createdAt(previous *string, expectRecreated bool) acceptance.ClientCheckFunc {
+	return func(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) error {
+		id, err := parse.NamespaceID(state.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := clients.ServiceBus.NamespacesClient.Get(ctx, id.ResourceGroup, id.Name)
+		if err != nil {
+			return fmt.Errorf("retrieving %s: %+v", *id, err)
+		}
+		if resp.SBNamespaceProperties == nil || resp.SBNamespaceProperties.CreatedAt == nil {
+			return fmt.Errorf("retrieving %s: `properties.createdAt` was nil", *id)
+		}
+
+		current := resp.SBNamespaceProperties.CreatedAt.String()
+		if *previous != "" {
+			if recreated := current != *previous; recreated != expectRecreated {
+				return fmt.Errorf("expected %s to have been recreated: %t, but was: %t (created at %q, previously %q)", *id, expectRecreated, recreated, current, *previous)
+			}
+		}
+
+		*previous = current
+		return nil
+	}
+}
+
 func (ServiceBusNamespaceResource) basic(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -184,6 +262,26 @@ resource "azurerm_servicebus_namespace" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
 }
 
+func (ServiceBusNamespaceResource) standard(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_servicebus_namespace" "test" {
+  name                = "acctestservicebusnamespace-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "standard"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
 func (ServiceBusNamespaceResource) complete(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -5,11 +5,13 @@ import (
 	msi "github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2018-06-01-preview/sql"
 	sqlv5 "github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v5.0/sql"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sql/sdk/2023-05-01-preview/distributedavailabilitygroups"
 )
 
 type Client struct {
 	DatabasesClient                                 *sql.DatabasesClient
 	DatabaseThreatDetectionPoliciesClient           *sql.DatabaseThreatDetectionPoliciesClient
+	DistributedAvailabilityGroupsClient             *distributedavailabilitygroups.DistributedAvailabilityGroupsClient
 	ElasticPoolsClient                              *sql.ElasticPoolsClient
 	DatabaseExtendedBlobAuditingPoliciesClient      *sql.ExtendedDatabaseBlobAuditingPoliciesClient
 	FirewallRulesClient                             *sql.FirewallRulesClient
@@ -39,6 +41,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	databaseThreatDetectionPoliciesClient := sql.NewDatabaseThreatDetectionPoliciesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&databaseThreatDetectionPoliciesClient.Client, o.ResourceManagerAuthorizer)
 
+	distributedAvailabilityGroupsClient := distributedavailabilitygroups.NewDistributedAvailabilityGroupsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&distributedAvailabilityGroupsClient.Client, o.ResourceManagerAuthorizer)
+
 	elasticPoolsClient := sql.NewElasticPoolsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&elasticPoolsClient.Client, o.ResourceManagerAuthorizer)
 
@@ -88,6 +93,7 @@ func NewClient(o *common.ClientOptions) *Client {
 		DatabasesClient: &databasesClient,
 		DatabaseExtendedBlobAuditingPoliciesClient:      &databaseExtendedBlobAuditingPoliciesClient,
 		DatabaseThreatDetectionPoliciesClient:           &databaseThreatDetectionPoliciesClient,
+		DistributedAvailabilityGroupsClient:             &distributedAvailabilityGroupsClient,
 		ElasticPoolsClient:                              &elasticPoolsClient,
 		FailoverGroupsClient:                            &failoverGroupsClient,
 		FirewallRulesClient:                             &firewallRulesClient,
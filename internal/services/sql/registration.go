@@ -38,6 +38,8 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_sql_managed_database":                                resourceArmSqlManagedDatabase(),
 		"azurerm_sql_managed_instance":                                resourceArmSqlMiServer(),
 		"azurerm_sql_managed_instance_failover_group":                 resourceSqlInstanceFailoverGroup(),
+		"azurerm_sql_managed_instance_link":                           resourceSqlManagedInstanceLink(),
+		"azurerm_sql_managed_instance_link_failover":                  resourceSqlManagedInstanceLinkFailover(),
 		"azurerm_sql_managed_instance_active_directory_administrator": resourceSqlManagedInstanceAdministrator(),
 		"azurerm_sql_server":                                          resourceSqlServer(),
 		"azurerm_sql_virtual_network_rule":                            resourceSqlVirtualNetworkRule(),
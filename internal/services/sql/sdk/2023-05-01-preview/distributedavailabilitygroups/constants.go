@@ -0,0 +1,143 @@
+package distributedavailabilitygroups
+
+import "strings"
+
+type FailoverModeType string
+
+const (
+	FailoverModeTypeManual FailoverModeType = "Manual"
+	FailoverModeTypeNone   FailoverModeType = "None"
+)
+
+func PossibleValuesForFailoverModeType() []string {
+	return []string{
+		string(FailoverModeTypeManual),
+		string(FailoverModeTypeNone),
+	}
+}
+
+func parseFailoverModeType(input string) (*FailoverModeType, error) {
+	vals := map[string]FailoverModeType{
+		"manual": FailoverModeTypeManual,
+		"none":   FailoverModeTypeNone,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := FailoverModeType(input)
+	return &out, nil
+}
+
+type FailoverType string
+
+const (
+	FailoverTypeForcedAllowDataLoss FailoverType = "ForcedAllowDataLoss"
+	FailoverTypePlanned             FailoverType = "Planned"
+)
+
+func PossibleValuesForFailoverType() []string {
+	return []string{
+		string(FailoverTypeForcedAllowDataLoss),
+		string(FailoverTypePlanned),
+	}
+}
+
+func parseFailoverType(input string) (*FailoverType, error) {
+	vals := map[string]FailoverType{
+		"forcedallowdataloss": FailoverTypeForcedAllowDataLoss,
+		"planned":             FailoverTypePlanned,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := FailoverType(input)
+	return &out, nil
+}
+
+type LinkRole string
+
+const (
+	LinkRolePrimary   LinkRole = "Primary"
+	LinkRoleSecondary LinkRole = "Secondary"
+)
+
+func PossibleValuesForLinkRole() []string {
+	return []string{
+		string(LinkRolePrimary),
+		string(LinkRoleSecondary),
+	}
+}
+
+func parseLinkRole(input string) (*LinkRole, error) {
+	vals := map[string]LinkRole{
+		"primary":   LinkRolePrimary,
+		"secondary": LinkRoleSecondary,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := LinkRole(input)
+	return &out, nil
+}
+
+type ReplicationModeType string
+
+const (
+	ReplicationModeTypeAsync ReplicationModeType = "Async"
+	ReplicationModeTypeSync  ReplicationModeType = "Sync"
+)
+
+func PossibleValuesForReplicationModeType() []string {
+	return []string{
+		string(ReplicationModeTypeAsync),
+		string(ReplicationModeTypeSync),
+	}
+}
+
+func parseReplicationModeType(input string) (*ReplicationModeType, error) {
+	vals := map[string]ReplicationModeType{
+		"async": ReplicationModeTypeAsync,
+		"sync":  ReplicationModeTypeSync,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ReplicationModeType(input)
+	return &out, nil
+}
+
+type SeedingModeType string
+
+const (
+	SeedingModeTypeAutomatic SeedingModeType = "Automatic"
+	SeedingModeTypeManual    SeedingModeType = "Manual"
+)
+
+func PossibleValuesForSeedingModeType() []string {
+	return []string{
+		string(SeedingModeTypeAutomatic),
+		string(SeedingModeTypeManual),
+	}
+}
+
+func parseSeedingModeType(input string) (*SeedingModeType, error) {
+	vals := map[string]SeedingModeType{
+		"automatic": SeedingModeTypeAutomatic,
+		"manual":    SeedingModeTypeManual,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := SeedingModeType(input)
+	return &out, nil
+}
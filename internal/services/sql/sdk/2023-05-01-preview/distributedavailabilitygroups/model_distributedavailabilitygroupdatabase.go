@@ -0,0 +1,12 @@
+package distributedavailabilitygroups
+
+type DistributedAvailabilityGroupDatabase struct {
+	ConnectedState        *string `json:"connectedState,omitempty"`
+	DatabaseName          *string `json:"databaseName,omitempty"`
+	InstanceReplicaId     *string `json:"instanceReplicaId,omitempty"`
+	LastHardenedLsn       *string `json:"lastHardenedLsn,omitempty"`
+	PartnerReplicaId      *string `json:"partnerReplicaId,omitempty"`
+	ReplicaState          *string `json:"replicaState,omitempty"`
+	SeedingProgress       *string `json:"seedingProgress,omitempty"`
+	SynchronizationHealth *string `json:"synchronizationHealth,omitempty"`
+}
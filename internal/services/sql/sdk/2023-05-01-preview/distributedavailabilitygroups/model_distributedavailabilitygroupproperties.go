@@ -0,0 +1,15 @@
+package distributedavailabilitygroups
+
+type DistributedAvailabilityGroupProperties struct {
+	Databases                        *[]DistributedAvailabilityGroupDatabase `json:"databases,omitempty"`
+	DistributedAvailabilityGroupId   *string                                 `json:"distributedAvailabilityGroupId,omitempty"`
+	DistributedAvailabilityGroupName *string                                 `json:"distributedAvailabilityGroupName,omitempty"`
+	FailoverMode                     *FailoverModeType                       `json:"failoverMode,omitempty"`
+	InstanceAvailabilityGroupName    *string                                 `json:"instanceAvailabilityGroupName,omitempty"`
+	InstanceLinkRole                 *LinkRole                               `json:"instanceLinkRole,omitempty"`
+	PartnerAvailabilityGroupName     *string                                 `json:"partnerAvailabilityGroupName,omitempty"`
+	PartnerEndpoint                  *string                                 `json:"partnerEndpoint,omitempty"`
+	PartnerLinkRole                  *LinkRole                               `json:"partnerLinkRole,omitempty"`
+	ReplicationMode                  *ReplicationModeType                    `json:"replicationMode,omitempty"`
+	SeedingMode                      *SeedingModeType                        `json:"seedingMode,omitempty"`
+}
@@ -0,0 +1,5 @@
+package distributedavailabilitygroups
+
+type DistributedAvailabilityGroupsFailoverRequest struct {
+	FailoverType FailoverType `json:"failoverType"`
+}
@@ -0,0 +1,9 @@
+package distributedavailabilitygroups
+
+import "fmt"
+
+const defaultApiVersion = "2023-05-01-preview"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/distributedavailabilitygroups/%s", defaultApiVersion)
+}
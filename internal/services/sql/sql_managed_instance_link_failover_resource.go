@@ -0,0 +1,110 @@
+package sql
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sql/sdk/2023-05-01-preview/distributedavailabilitygroups"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+// resourceSqlManagedInstanceLinkFailover fails over a Managed Instance Link when it's created - since a failover is
+// an action rather than something which exists in Azure, there's nothing to remove when this resource is destroyed.
+func resourceSqlManagedInstanceLinkFailover() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceSqlManagedInstanceLinkFailoverCreate,
+		Read:   resourceSqlManagedInstanceLinkFailoverRead,
+		Delete: resourceSqlManagedInstanceLinkFailoverDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"managed_instance_link_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: distributedavailabilitygroups.ValidateDistributedAvailabilityGroupID,
+			},
+
+			"failover_type": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(distributedavailabilitygroups.FailoverTypeForcedAllowDataLoss),
+					string(distributedavailabilitygroups.FailoverTypePlanned),
+				}, false),
+			},
+
+			"triggers": {
+				Type:     pluginsdk.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceSqlManagedInstanceLinkFailoverCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Sql.DistributedAvailabilityGroupsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := distributedavailabilitygroups.ParseDistributedAvailabilityGroupID(d.Get("managed_instance_link_id").(string))
+	if err != nil {
+		return err
+	}
+
+	payload := distributedavailabilitygroups.DistributedAvailabilityGroupsFailoverRequest{
+		FailoverType: distributedavailabilitygroups.FailoverType(d.Get("failover_type").(string)),
+	}
+	if err := client.FailoverThenPoll(ctx, *id, payload); err != nil {
+		return fmt.Errorf("failing over %s: %+v", *id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceSqlManagedInstanceLinkFailoverRead(d, meta)
+}
+
+func resourceSqlManagedInstanceLinkFailoverRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Sql.DistributedAvailabilityGroupsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := distributedavailabilitygroups.ParseDistributedAvailabilityGroupID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing Failover from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("managed_instance_link_id", id.ID())
+
+	return nil
+}
+
+func resourceSqlManagedInstanceLinkFailoverDelete(d *pluginsdk.ResourceData, _ interface{}) error {
+	log.Printf("[DEBUG] Failing over a Managed Instance Link can't be undone - removing %q from state only", d.Id())
+	return nil
+}
@@ -0,0 +1,47 @@
+package sql_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+)
+
+type SqlManagedInstanceLinkFailoverResource struct{}
+
+func TestAccSqlManagedInstanceLinkFailover_planned(t *testing.T) {
+	skipSqlManagedInstanceLinkTests(t)
+
+	data := acceptance.BuildTestData(t, "azurerm_sql_managed_instance_link_failover", "test")
+	r := SqlManagedInstanceLinkFailoverResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.planned(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+	})
+}
+
+func (r SqlManagedInstanceLinkFailoverResource) Exists(ctx context.Context, client *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	// the Failover itself isn't tracked in Azure, so this checks the Managed Instance Link exists
+	return SqlManagedInstanceLinkResource{}.Exists(ctx, client, state)
+}
+
+func (r SqlManagedInstanceLinkFailoverResource) planned(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sql_managed_instance_link_failover" "test" {
+  managed_instance_link_id = azurerm_sql_managed_instance_link.test.id
+  failover_type            = "Planned"
+}
+`, SqlManagedInstanceLinkResource{}.basic(data))
+}
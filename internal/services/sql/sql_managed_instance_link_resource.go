@@ -0,0 +1,385 @@
+package sql
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sql/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sql/sdk/2023-05-01-preview/distributedavailabilitygroups"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceSqlManagedInstanceLink() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceSqlManagedInstanceLinkCreate,
+		Read:   resourceSqlManagedInstanceLinkRead,
+		Update: resourceSqlManagedInstanceLinkUpdate,
+		Delete: resourceSqlManagedInstanceLinkDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := distributedavailabilitygroups.ParseDistributedAvailabilityGroupID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"managed_instance_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"database_names": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"instance_availability_group_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"partner_availability_group_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"partner_endpoint": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^(?i)tcp://.+:\d+$`), "`partner_endpoint` must be in the format `TCP://hostname:port`"),
+			},
+
+			"instance_link_role": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  string(distributedavailabilitygroups.LinkRoleSecondary),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(distributedavailabilitygroups.LinkRolePrimary),
+					string(distributedavailabilitygroups.LinkRoleSecondary),
+				}, false),
+			},
+
+			"failover_mode": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  string(distributedavailabilitygroups.FailoverModeTypeNone),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(distributedavailabilitygroups.FailoverModeTypeManual),
+					string(distributedavailabilitygroups.FailoverModeTypeNone),
+				}, false),
+			},
+
+			"seeding_mode": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  string(distributedavailabilitygroups.SeedingModeTypeAutomatic),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(distributedavailabilitygroups.SeedingModeTypeAutomatic),
+					string(distributedavailabilitygroups.SeedingModeTypeManual),
+				}, false),
+			},
+
+			"replication_mode": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  string(distributedavailabilitygroups.ReplicationModeTypeAsync),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(distributedavailabilitygroups.ReplicationModeTypeAsync),
+					string(distributedavailabilitygroups.ReplicationModeTypeSync),
+				}, false),
+			},
+
+			"distributed_availability_group_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"partner_link_role": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"database": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"connected_state": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"replica_state": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"synchronization_health": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceSqlManagedInstanceLinkCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Sql.DistributedAvailabilityGroupsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	instanceId, err := parse.ManagedInstanceID(d.Get("managed_instance_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := distributedavailabilitygroups.NewDistributedAvailabilityGroupID(instanceId.SubscriptionId, instanceId.ResourceGroup, instanceId.Name, d.Get("name").(string))
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_sql_managed_instance_link", id.ID())
+	}
+
+	databases := make([]distributedavailabilitygroups.DistributedAvailabilityGroupDatabase, 0)
+	for _, v := range d.Get("database_names").([]interface{}) {
+		databases = append(databases, distributedavailabilitygroups.DistributedAvailabilityGroupDatabase{
+			DatabaseName: utils.String(v.(string)),
+		})
+	}
+
+	failoverMode := distributedavailabilitygroups.FailoverModeType(d.Get("failover_mode").(string))
+	instanceLinkRole := distributedavailabilitygroups.LinkRole(d.Get("instance_link_role").(string))
+	replicationMode := distributedavailabilitygroups.ReplicationModeType(d.Get("replication_mode").(string))
+	seedingMode := distributedavailabilitygroups.SeedingModeType(d.Get("seeding_mode").(string))
+	payload := distributedavailabilitygroups.DistributedAvailabilityGroup{
+		Properties: &distributedavailabilitygroups.DistributedAvailabilityGroupProperties{
+			Databases:                     &databases,
+			FailoverMode:                  &failoverMode,
+			InstanceAvailabilityGroupName: utils.String(d.Get("instance_availability_group_name").(string)),
+			InstanceLinkRole:              &instanceLinkRole,
+			PartnerAvailabilityGroupName:  utils.String(d.Get("partner_availability_group_name").(string)),
+			PartnerEndpoint:               utils.String(d.Get("partner_endpoint").(string)),
+			ReplicationMode:               &replicationMode,
+			SeedingMode:                   &seedingMode,
+		},
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceSqlManagedInstanceLinkRead(d, meta)
+}
+
+func resourceSqlManagedInstanceLinkRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Sql.DistributedAvailabilityGroupsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := distributedavailabilitygroups.ParseDistributedAvailabilityGroupID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.DistributedAvailabilityGroupName)
+	d.Set("managed_instance_id", parse.NewManagedInstanceID(id.SubscriptionId, id.ResourceGroupName, id.ManagedInstanceName).ID())
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			databaseNames := make([]interface{}, 0)
+			if props.Databases != nil {
+				for _, v := range *props.Databases {
+					if v.DatabaseName != nil {
+						databaseNames = append(databaseNames, *v.DatabaseName)
+					}
+				}
+			}
+			if err := d.Set("database_names", databaseNames); err != nil {
+				return fmt.Errorf("setting `database_names`: %+v", err)
+			}
+			if err := d.Set("database", flattenSqlManagedInstanceLinkDatabases(props.Databases)); err != nil {
+				return fmt.Errorf("setting `database`: %+v", err)
+			}
+
+			d.Set("instance_availability_group_name", props.InstanceAvailabilityGroupName)
+			d.Set("partner_availability_group_name", props.PartnerAvailabilityGroupName)
+			d.Set("partner_endpoint", props.PartnerEndpoint)
+			d.Set("distributed_availability_group_id", props.DistributedAvailabilityGroupId)
+
+			failoverMode := string(distributedavailabilitygroups.FailoverModeTypeNone)
+			if props.FailoverMode != nil {
+				failoverMode = string(*props.FailoverMode)
+			}
+			d.Set("failover_mode", failoverMode)
+
+			// `instance_link_role` is the role the Managed Instance has when the link is created, which changes when
+			// the link is failed over - as such this is only set from the API when it's not already known (e.g. on import)
+			if _, ok := d.GetOk("instance_link_role"); !ok && props.InstanceLinkRole != nil {
+				d.Set("instance_link_role", string(*props.InstanceLinkRole))
+			}
+
+			partnerLinkRole := ""
+			if props.PartnerLinkRole != nil {
+				partnerLinkRole = string(*props.PartnerLinkRole)
+			}
+			d.Set("partner_link_role", partnerLinkRole)
+
+			replicationMode := string(distributedavailabilitygroups.ReplicationModeTypeAsync)
+			if props.ReplicationMode != nil {
+				replicationMode = string(*props.ReplicationMode)
+			}
+			d.Set("replication_mode", replicationMode)
+
+			seedingMode := string(distributedavailabilitygroups.SeedingModeTypeAutomatic)
+			if props.SeedingMode != nil {
+				seedingMode = string(*props.SeedingMode)
+			}
+			d.Set("seeding_mode", seedingMode)
+		}
+	}
+
+	return nil
+}
+
+func resourceSqlManagedInstanceLinkUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Sql.DistributedAvailabilityGroupsClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := distributedavailabilitygroups.ParseDistributedAvailabilityGroupID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// `replication_mode` is the only property which can be updated
+	if d.HasChange("replication_mode") {
+		replicationMode := distributedavailabilitygroups.ReplicationModeType(d.Get("replication_mode").(string))
+		payload := distributedavailabilitygroups.DistributedAvailabilityGroup{
+			Properties: &distributedavailabilitygroups.DistributedAvailabilityGroupProperties{
+				ReplicationMode: &replicationMode,
+			},
+		}
+
+		if err := client.UpdateThenPoll(ctx, *id, payload); err != nil {
+			return fmt.Errorf("updating %s: %+v", *id, err)
+		}
+	}
+
+	return resourceSqlManagedInstanceLinkRead(d, meta)
+}
+
+func resourceSqlManagedInstanceLinkDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Sql.DistributedAvailabilityGroupsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := distributedavailabilitygroups.ParseDistributedAvailabilityGroupID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func flattenSqlManagedInstanceLinkDatabases(input *[]distributedavailabilitygroups.DistributedAvailabilityGroupDatabase) []interface{} {
+	output := make([]interface{}, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		name := ""
+		if v.DatabaseName != nil {
+			name = *v.DatabaseName
+		}
+
+		connectedState := ""
+		if v.ConnectedState != nil {
+			connectedState = *v.ConnectedState
+		}
+
+		replicaState := ""
+		if v.ReplicaState != nil {
+			replicaState = *v.ReplicaState
+		}
+
+		synchronizationHealth := ""
+		if v.SynchronizationHealth != nil {
+			synchronizationHealth = *v.SynchronizationHealth
+		}
+
+		output = append(output, map[string]interface{}{
+			"name":                   name,
+			"connected_state":        connectedState,
+			"replica_state":          replicaState,
+			"synchronization_health": synchronizationHealth,
+		})
+	}
+
+	return output
+}
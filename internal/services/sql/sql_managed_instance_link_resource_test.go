@@ -0,0 +1,147 @@
+package sql_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sql/sdk/2023-05-01-preview/distributedavailabilitygroups"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type SqlManagedInstanceLinkResource struct{}
+
+// the Managed Instance Link requires a SQL Server with an Availability Group containing the database being linked,
+// which is reachable from the Managed Instance - as such these tests require this to be provisioned up front.
+func skipSqlManagedInstanceLinkTests(t *testing.T) {
+	if os.Getenv("ARM_TEST_SQL_MI_LINK_PARTNER_ENDPOINT") == "" || os.Getenv("ARM_TEST_SQL_MI_LINK_PARTNER_AG_NAME") == "" || os.Getenv("ARM_TEST_SQL_MI_LINK_DATABASE_NAME") == "" {
+		t.Skip("Skipping as `ARM_TEST_SQL_MI_LINK_PARTNER_ENDPOINT`, `ARM_TEST_SQL_MI_LINK_PARTNER_AG_NAME` and `ARM_TEST_SQL_MI_LINK_DATABASE_NAME` are not specified")
+	}
+}
+
+func TestAccSqlManagedInstanceLink_basic(t *testing.T) {
+	skipSqlManagedInstanceLinkTests(t)
+
+	data := acceptance.BuildTestData(t, "azurerm_sql_managed_instance_link", "test")
+	r := SqlManagedInstanceLinkResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("distributed_availability_group_id").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSqlManagedInstanceLink_requiresImport(t *testing.T) {
+	skipSqlManagedInstanceLinkTests(t)
+
+	data := acceptance.BuildTestData(t, "azurerm_sql_managed_instance_link", "test")
+	r := SqlManagedInstanceLinkResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccSqlManagedInstanceLink_update(t *testing.T) {
+	skipSqlManagedInstanceLinkTests(t)
+
+	data := acceptance.BuildTestData(t, "azurerm_sql_managed_instance_link", "test")
+	r := SqlManagedInstanceLinkResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.replicationMode(data, "Sync"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("replication_mode").HasValue("Sync"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.replicationMode(data, "Async"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("replication_mode").HasValue("Async"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r SqlManagedInstanceLinkResource) Exists(ctx context.Context, client *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	id, err := distributedavailabilitygroups.ParseDistributedAvailabilityGroupID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Sql.DistributedAvailabilityGroupsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (r SqlManagedInstanceLinkResource) basic(data acceptance.TestData) string {
+	return r.replicationMode(data, "Async")
+}
+
+func (r SqlManagedInstanceLinkResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sql_managed_instance_link" "import" {
+  name                             = azurerm_sql_managed_instance_link.test.name
+  managed_instance_id              = azurerm_sql_managed_instance_link.test.managed_instance_id
+  database_names                   = azurerm_sql_managed_instance_link.test.database_names
+  instance_availability_group_name = azurerm_sql_managed_instance_link.test.instance_availability_group_name
+  partner_availability_group_name  = azurerm_sql_managed_instance_link.test.partner_availability_group_name
+  partner_endpoint                 = azurerm_sql_managed_instance_link.test.partner_endpoint
+}
+`, r.basic(data))
+}
+
+func (r SqlManagedInstanceLinkResource) replicationMode(data acceptance.TestData, mode string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sql_managed_instance_link" "test" {
+  name                             = "acctest-link-%d"
+  managed_instance_id              = azurerm_sql_managed_instance.test.id
+  database_names                   = [%q]
+  instance_availability_group_name = "acctest-mi-ag-%d"
+  partner_availability_group_name  = %q
+  partner_endpoint                 = %q
+  replication_mode                 = %q
+}
+`, SqlManagedInstanceResource{}.basic(data), data.RandomInteger, os.Getenv("ARM_TEST_SQL_MI_LINK_DATABASE_NAME"), data.RandomInteger, os.Getenv("ARM_TEST_SQL_MI_LINK_PARTNER_AG_NAME"), os.Getenv("ARM_TEST_SQL_MI_LINK_PARTNER_ENDPOINT"), mode)
+}
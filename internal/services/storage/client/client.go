@@ -10,6 +10,8 @@ import (
 	"github.com/Azure/go-autorest/autorest"
 	az "github.com/Azure/go-autorest/autorest/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/sdk/2020-09-01/registeredservers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/sdk/2020-09-01/serverendpoints"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/shim"
 	"github.com/tombuildsstuff/giovanni/storage/2019-12-12/blob/accounts"
 	"github.com/tombuildsstuff/giovanni/storage/2019-12-12/blob/blobs"
@@ -36,6 +38,8 @@ type Client struct {
 	Environment                 az.Environment
 	FileServicesClient          *storage.FileServicesClient
 	ObjectReplicationClient     *storage.ObjectReplicationPoliciesClient
+	SyncRegisteredServersClient *registeredservers.RegisteredServersClient
+	SyncServerEndpointsClient   *serverendpoints.ServerEndpointsClient
 	SyncServiceClient           *storagesync.ServicesClient
 	SyncGroupsClient            *storagesync.SyncGroupsClient
 	SubscriptionId              string
@@ -75,6 +79,12 @@ func NewClient(options *common.ClientOptions) *Client {
 	objectReplicationPolicyClient := storage.NewObjectReplicationPoliciesClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
 	options.ConfigureClient(&objectReplicationPolicyClient.Client, options.ResourceManagerAuthorizer)
 
+	syncRegisteredServersClient := registeredservers.NewRegisteredServersClientWithBaseURI(options.ResourceManagerEndpoint)
+	options.ConfigureClient(&syncRegisteredServersClient.Client, options.ResourceManagerAuthorizer)
+
+	syncServerEndpointsClient := serverendpoints.NewServerEndpointsClientWithBaseURI(options.ResourceManagerEndpoint)
+	options.ConfigureClient(&syncServerEndpointsClient.Client, options.ResourceManagerAuthorizer)
+
 	syncServiceClient := storagesync.NewServicesClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
 	options.ConfigureClient(&syncServiceClient.Client, options.ResourceManagerAuthorizer)
 
@@ -96,6 +106,8 @@ func NewClient(options *common.ClientOptions) *Client {
 		FileServicesClient:          &fileServicesClient,
 		ObjectReplicationClient:     &objectReplicationPolicyClient,
 		SubscriptionId:              options.SubscriptionId,
+		SyncRegisteredServersClient: &syncRegisteredServersClient,
+		SyncServerEndpointsClient:   &syncServerEndpointsClient,
 		SyncServiceClient:           &syncServiceClient,
 		SyncGroupsClient:            &syncGroupsClient,
 
@@ -58,5 +58,7 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_storage_sync":                         resourceStorageSync(),
 		"azurerm_storage_sync_cloud_endpoint":          resourceStorageSyncCloudEndpoint(),
 		"azurerm_storage_sync_group":                   resourceStorageSyncGroup(),
+		"azurerm_storage_sync_registered_server":       resourceStorageSyncRegisteredServer(),
+		"azurerm_storage_sync_server_endpoint":         resourceStorageSyncServerEndpoint(),
 	}
 }
@@ -0,0 +1,15 @@
+package registeredservers
+
+import "github.com/Azure/go-autorest/autorest"
+
+type RegisteredServersClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewRegisteredServersClientWithBaseURI(endpoint string) RegisteredServersClient {
+	return RegisteredServersClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
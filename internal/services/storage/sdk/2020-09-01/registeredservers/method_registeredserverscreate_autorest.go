@@ -0,0 +1,75 @@
+package registeredservers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+type RegisteredServersCreateResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// RegisteredServersCreate ...
+func (c RegisteredServersClient) RegisteredServersCreate(ctx context.Context, id RegisteredServerId, input RegisteredServerCreateParameters) (result RegisteredServersCreateResponse, err error) {
+	req, err := c.preparerForRegisteredServersCreate(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "registeredservers.RegisteredServersClient", "RegisteredServersCreate", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForRegisteredServersCreate(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "registeredservers.RegisteredServersClient", "RegisteredServersCreate", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// RegisteredServersCreateThenPoll performs RegisteredServersCreate then polls until it's completed
+func (c RegisteredServersClient) RegisteredServersCreateThenPoll(ctx context.Context, id RegisteredServerId, input RegisteredServerCreateParameters) error {
+	result, err := c.RegisteredServersCreate(ctx, id, input)
+	if err != nil {
+		return fmt.Errorf("performing RegisteredServersCreate: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after RegisteredServersCreate: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForRegisteredServersCreate prepares the RegisteredServersCreate request.
+func (c RegisteredServersClient) preparerForRegisteredServersCreate(ctx context.Context, id RegisteredServerId, input RegisteredServerCreateParameters) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForRegisteredServersCreate sends the RegisteredServersCreate request. The method will close the
+// http.Response Body if it receives an error.
+func (c RegisteredServersClient) senderForRegisteredServersCreate(ctx context.Context, req *http.Request) (future RegisteredServersCreateResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
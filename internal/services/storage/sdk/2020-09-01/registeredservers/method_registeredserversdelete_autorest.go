@@ -0,0 +1,73 @@
+package registeredservers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+type RegisteredServersDeleteResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// RegisteredServersDelete ...
+func (c RegisteredServersClient) RegisteredServersDelete(ctx context.Context, id RegisteredServerId) (result RegisteredServersDeleteResponse, err error) {
+	req, err := c.preparerForRegisteredServersDelete(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "registeredservers.RegisteredServersClient", "RegisteredServersDelete", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForRegisteredServersDelete(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "registeredservers.RegisteredServersClient", "RegisteredServersDelete", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// RegisteredServersDeleteThenPoll performs RegisteredServersDelete then polls until it's completed
+func (c RegisteredServersClient) RegisteredServersDeleteThenPoll(ctx context.Context, id RegisteredServerId) error {
+	result, err := c.RegisteredServersDelete(ctx, id)
+	if err != nil {
+		return fmt.Errorf("performing RegisteredServersDelete: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after RegisteredServersDelete: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForRegisteredServersDelete prepares the RegisteredServersDelete request.
+func (c RegisteredServersClient) preparerForRegisteredServersDelete(ctx context.Context, id RegisteredServerId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsDelete(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForRegisteredServersDelete sends the RegisteredServersDelete request. The method will close the
+// http.Response Body if it receives an error.
+func (c RegisteredServersClient) senderForRegisteredServersDelete(ctx context.Context, req *http.Request) (future RegisteredServersDeleteResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
@@ -0,0 +1,183 @@
+package registeredservers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type RegisteredServersListByStorageSyncServiceResponse struct {
+	HttpResponse *http.Response
+	Model        *[]RegisteredServer
+
+	nextLink     *string
+	nextPageFunc func(ctx context.Context, nextLink string) (RegisteredServersListByStorageSyncServiceResponse, error)
+}
+
+type RegisteredServersListByStorageSyncServiceCompleteResult struct {
+	Items []RegisteredServer
+}
+
+func (r RegisteredServersListByStorageSyncServiceResponse) HasMore() bool {
+	return r.nextLink != nil
+}
+
+func (r RegisteredServersListByStorageSyncServiceResponse) LoadMore(ctx context.Context) (resp RegisteredServersListByStorageSyncServiceResponse, err error) {
+	if !r.HasMore() {
+		err = fmt.Errorf("no more pages returned")
+		return
+	}
+	return r.nextPageFunc(ctx, *r.nextLink)
+}
+
+// RegisteredServersListByStorageSyncService ...
+func (c RegisteredServersClient) RegisteredServersListByStorageSyncService(ctx context.Context, id StorageSyncServiceId) (resp RegisteredServersListByStorageSyncServiceResponse, err error) {
+	req, err := c.preparerForRegisteredServersListByStorageSyncService(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "registeredservers.RegisteredServersClient", "RegisteredServersListByStorageSyncService", nil, "Failure preparing request")
+		return
+	}
+
+	resp.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "registeredservers.RegisteredServersClient", "RegisteredServersListByStorageSyncService", resp.HttpResponse, "Failure sending request")
+		return
+	}
+
+	resp, err = c.responderForRegisteredServersListByStorageSyncService(resp.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "registeredservers.RegisteredServersClient", "RegisteredServersListByStorageSyncService", resp.HttpResponse, "Failure responding to request")
+		return
+	}
+	return
+}
+
+// RegisteredServersListByStorageSyncServiceComplete retrieves all of the results into a single object
+func (c RegisteredServersClient) RegisteredServersListByStorageSyncServiceComplete(ctx context.Context, id StorageSyncServiceId) (RegisteredServersListByStorageSyncServiceCompleteResult, error) {
+	return c.RegisteredServersListByStorageSyncServiceCompleteMatchingPredicate(ctx, id, RegisteredServerPredicate{})
+}
+
+// RegisteredServersListByStorageSyncServiceCompleteMatchingPredicate retrieves all of the results and then applied the predicate
+func (c RegisteredServersClient) RegisteredServersListByStorageSyncServiceCompleteMatchingPredicate(ctx context.Context, id StorageSyncServiceId, predicate RegisteredServerPredicate) (resp RegisteredServersListByStorageSyncServiceCompleteResult, err error) {
+	items := make([]RegisteredServer, 0)
+
+	page, err := c.RegisteredServersListByStorageSyncService(ctx, id)
+	if err != nil {
+		err = fmt.Errorf("loading the initial page: %+v", err)
+		return
+	}
+	if page.Model != nil {
+		for _, v := range *page.Model {
+			if predicate.Matches(v) {
+				items = append(items, v)
+			}
+		}
+	}
+
+	for page.HasMore() {
+		page, err = page.LoadMore(ctx)
+		if err != nil {
+			err = fmt.Errorf("loading the next page: %+v", err)
+			return
+		}
+
+		if page.Model != nil {
+			for _, v := range *page.Model {
+				if predicate.Matches(v) {
+					items = append(items, v)
+				}
+			}
+		}
+	}
+
+	out := RegisteredServersListByStorageSyncServiceCompleteResult{
+		Items: items,
+	}
+	return out, nil
+}
+
+// preparerForRegisteredServersListByStorageSyncService prepares the RegisteredServersListByStorageSyncService request.
+func (c RegisteredServersClient) preparerForRegisteredServersListByStorageSyncService(ctx context.Context, id StorageSyncServiceId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(fmt.Sprintf("%s/registeredServers", id.ID())),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// preparerForRegisteredServersListByStorageSyncServiceWithNextLink prepares the RegisteredServersListByStorageSyncService request with the given nextLink token.
+func (c RegisteredServersClient) preparerForRegisteredServersListByStorageSyncServiceWithNextLink(ctx context.Context, nextLink string) (*http.Request, error) {
+	uri, err := url.Parse(nextLink)
+	if err != nil {
+		return nil, fmt.Errorf("parsing nextLink %q: %+v", nextLink, err)
+	}
+	queryParameters := map[string]interface{}{}
+	for k, v := range uri.Query() {
+		if len(v) == 0 {
+			continue
+		}
+		val := v[0]
+		val = autorest.Encode("query", val)
+		queryParameters[k] = val
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(uri.Path),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForRegisteredServersListByStorageSyncService handles the response to the RegisteredServersListByStorageSyncService request. The method always
+// closes the http.Response Body.
+func (c RegisteredServersClient) responderForRegisteredServersListByStorageSyncService(resp *http.Response) (result RegisteredServersListByStorageSyncServiceResponse, err error) {
+	type page struct {
+		Values   []RegisteredServer `json:"value"`
+		NextLink *string            `json:"nextLink"`
+	}
+	var respObj page
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&respObj),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	result.Model = &respObj.Values
+	result.nextLink = respObj.NextLink
+	if respObj.NextLink != nil {
+		result.nextPageFunc = func(ctx context.Context, nextLink string) (result RegisteredServersListByStorageSyncServiceResponse, err error) {
+			req, err := c.preparerForRegisteredServersListByStorageSyncServiceWithNextLink(ctx, nextLink)
+			if err != nil {
+				err = autorest.NewErrorWithError(err, "registeredservers.RegisteredServersClient", "RegisteredServersListByStorageSyncService", nil, "Failure preparing request")
+				return
+			}
+
+			result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+			if err != nil {
+				err = autorest.NewErrorWithError(err, "registeredservers.RegisteredServersClient", "RegisteredServersListByStorageSyncService", result.HttpResponse, "Failure sending request")
+				return
+			}
+
+			result, err = c.responderForRegisteredServersListByStorageSyncService(result.HttpResponse)
+			if err != nil {
+				err = autorest.NewErrorWithError(err, "registeredservers.RegisteredServersClient", "RegisteredServersListByStorageSyncService", result.HttpResponse, "Failure responding to request")
+				return
+			}
+
+			return
+		}
+	}
+	return
+}
@@ -0,0 +1,8 @@
+package registeredservers
+
+type RegisteredServer struct {
+	Id         *string                     `json:"id,omitempty"`
+	Name       *string                     `json:"name,omitempty"`
+	Properties *RegisteredServerProperties `json:"properties,omitempty"`
+	Type       *string                     `json:"type,omitempty"`
+}
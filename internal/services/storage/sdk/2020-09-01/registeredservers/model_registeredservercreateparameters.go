@@ -0,0 +1,5 @@
+package registeredservers
+
+type RegisteredServerCreateParameters struct {
+	Properties *RegisteredServerCreateParametersProperties `json:"properties,omitempty"`
+}
@@ -0,0 +1,13 @@
+package registeredservers
+
+type RegisteredServerCreateParametersProperties struct {
+	AgentVersion      *string `json:"agentVersion,omitempty"`
+	ClusterId         *string `json:"clusterId,omitempty"`
+	ClusterName       *string `json:"clusterName,omitempty"`
+	FriendlyName      *string `json:"friendlyName,omitempty"`
+	LastHeartBeat     *string `json:"lastHeartBeat,omitempty"`
+	ServerCertificate *string `json:"serverCertificate,omitempty"`
+	ServerId          *string `json:"serverId,omitempty"`
+	ServerOSVersion   *string `json:"serverOSVersion,omitempty"`
+	ServerRole        *string `json:"serverRole,omitempty"`
+}
@@ -0,0 +1,23 @@
+package registeredservers
+
+type RegisteredServerProperties struct {
+	AgentVersion          *string `json:"agentVersion,omitempty"`
+	AgentVersionStatus    *string `json:"agentVersionStatus,omitempty"`
+	ClusterId             *string `json:"clusterId,omitempty"`
+	ClusterName           *string `json:"clusterName,omitempty"`
+	DiscoveryEndpointUri  *string `json:"discoveryEndpointUri,omitempty"`
+	FriendlyName          *string `json:"friendlyName,omitempty"`
+	LastHeartBeat         *string `json:"lastHeartBeat,omitempty"`
+	LastOperationName     *string `json:"lastOperationName,omitempty"`
+	LastWorkflowId        *string `json:"lastWorkflowId,omitempty"`
+	ManagementEndpointUri *string `json:"managementEndpointUri,omitempty"`
+	MonitoringEndpointUri *string `json:"monitoringEndpointUri,omitempty"`
+	ProvisioningState     *string `json:"provisioningState,omitempty"`
+	ResourceLocation      *string `json:"resourceLocation,omitempty"`
+	ServerCertificate     *string `json:"serverCertificate,omitempty"`
+	ServerId              *string `json:"serverId,omitempty"`
+	ServerOSVersion       *string `json:"serverOSVersion,omitempty"`
+	ServerRole            *string `json:"serverRole,omitempty"`
+	ServiceLocation       *string `json:"serviceLocation,omitempty"`
+	StorageSyncServiceUid *string `json:"storageSyncServiceUid,omitempty"`
+}
@@ -0,0 +1,9 @@
+package registeredservers
+
+import "fmt"
+
+const defaultApiVersion = "2020-09-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/registeredservers/%s", defaultApiVersion)
+}
@@ -0,0 +1,15 @@
+package serverendpoints
+
+import "github.com/Azure/go-autorest/autorest"
+
+type ServerEndpointsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewServerEndpointsClientWithBaseURI(endpoint string) ServerEndpointsClient {
+	return ServerEndpointsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
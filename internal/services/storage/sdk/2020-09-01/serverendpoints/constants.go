@@ -0,0 +1,118 @@
+package serverendpoints
+
+import "strings"
+
+type FeatureStatus string
+
+const (
+	FeatureStatusOff FeatureStatus = "off"
+	FeatureStatusOn  FeatureStatus = "on"
+)
+
+func PossibleValuesForFeatureStatus() []string {
+	return []string{
+		string(FeatureStatusOff),
+		string(FeatureStatusOn),
+	}
+}
+
+func parseFeatureStatus(input string) (*FeatureStatus, error) {
+	vals := map[string]FeatureStatus{
+		"off": FeatureStatusOff,
+		"on":  FeatureStatusOn,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := FeatureStatus(input)
+	return &out, nil
+}
+
+type InitialDownloadPolicy string
+
+const (
+	InitialDownloadPolicyAvoidTieredFiles           InitialDownloadPolicy = "AvoidTieredFiles"
+	InitialDownloadPolicyNamespaceOnly              InitialDownloadPolicy = "NamespaceOnly"
+	InitialDownloadPolicyNamespaceThenModifiedFiles InitialDownloadPolicy = "NamespaceThenModifiedFiles"
+)
+
+func PossibleValuesForInitialDownloadPolicy() []string {
+	return []string{
+		string(InitialDownloadPolicyAvoidTieredFiles),
+		string(InitialDownloadPolicyNamespaceOnly),
+		string(InitialDownloadPolicyNamespaceThenModifiedFiles),
+	}
+}
+
+func parseInitialDownloadPolicy(input string) (*InitialDownloadPolicy, error) {
+	vals := map[string]InitialDownloadPolicy{
+		"avoidtieredfiles":           InitialDownloadPolicyAvoidTieredFiles,
+		"namespaceonly":              InitialDownloadPolicyNamespaceOnly,
+		"namespacethenmodifiedfiles": InitialDownloadPolicyNamespaceThenModifiedFiles,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := InitialDownloadPolicy(input)
+	return &out, nil
+}
+
+type InitialUploadPolicy string
+
+const (
+	InitialUploadPolicyMerge               InitialUploadPolicy = "Merge"
+	InitialUploadPolicyServerAuthoritative InitialUploadPolicy = "ServerAuthoritative"
+)
+
+func PossibleValuesForInitialUploadPolicy() []string {
+	return []string{
+		string(InitialUploadPolicyMerge),
+		string(InitialUploadPolicyServerAuthoritative),
+	}
+}
+
+func parseInitialUploadPolicy(input string) (*InitialUploadPolicy, error) {
+	vals := map[string]InitialUploadPolicy{
+		"merge":               InitialUploadPolicyMerge,
+		"serverauthoritative": InitialUploadPolicyServerAuthoritative,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := InitialUploadPolicy(input)
+	return &out, nil
+}
+
+type LocalCacheMode string
+
+const (
+	LocalCacheModeDownloadNewAndModifiedFiles LocalCacheMode = "DownloadNewAndModifiedFiles"
+	LocalCacheModeUpdateLocallyCachedFiles    LocalCacheMode = "UpdateLocallyCachedFiles"
+)
+
+func PossibleValuesForLocalCacheMode() []string {
+	return []string{
+		string(LocalCacheModeDownloadNewAndModifiedFiles),
+		string(LocalCacheModeUpdateLocallyCachedFiles),
+	}
+}
+
+func parseLocalCacheMode(input string) (*LocalCacheMode, error) {
+	vals := map[string]LocalCacheMode{
+		"downloadnewandmodifiedfiles": LocalCacheModeDownloadNewAndModifiedFiles,
+		"updatelocallycachedfiles":    LocalCacheModeUpdateLocallyCachedFiles,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := LocalCacheMode(input)
+	return &out, nil
+}
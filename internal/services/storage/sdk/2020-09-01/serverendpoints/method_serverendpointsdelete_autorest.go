@@ -0,0 +1,73 @@
+package serverendpoints
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+type ServerEndpointsDeleteResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// ServerEndpointsDelete ...
+func (c ServerEndpointsClient) ServerEndpointsDelete(ctx context.Context, id ServerEndpointId) (result ServerEndpointsDeleteResponse, err error) {
+	req, err := c.preparerForServerEndpointsDelete(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "serverendpoints.ServerEndpointsClient", "ServerEndpointsDelete", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForServerEndpointsDelete(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "serverendpoints.ServerEndpointsClient", "ServerEndpointsDelete", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// ServerEndpointsDeleteThenPoll performs ServerEndpointsDelete then polls until it's completed
+func (c ServerEndpointsClient) ServerEndpointsDeleteThenPoll(ctx context.Context, id ServerEndpointId) error {
+	result, err := c.ServerEndpointsDelete(ctx, id)
+	if err != nil {
+		return fmt.Errorf("performing ServerEndpointsDelete: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after ServerEndpointsDelete: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForServerEndpointsDelete prepares the ServerEndpointsDelete request.
+func (c ServerEndpointsClient) preparerForServerEndpointsDelete(ctx context.Context, id ServerEndpointId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsDelete(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForServerEndpointsDelete sends the ServerEndpointsDelete request. The method will close the
+// http.Response Body if it receives an error.
+func (c ServerEndpointsClient) senderForServerEndpointsDelete(ctx context.Context, req *http.Request) (future ServerEndpointsDeleteResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
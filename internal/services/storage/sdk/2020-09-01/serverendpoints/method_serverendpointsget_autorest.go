@@ -0,0 +1,64 @@
+package serverendpoints
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type ServerEndpointsGetResponse struct {
+	HttpResponse *http.Response
+	Model        *ServerEndpoint
+}
+
+// ServerEndpointsGet ...
+func (c ServerEndpointsClient) ServerEndpointsGet(ctx context.Context, id ServerEndpointId) (result ServerEndpointsGetResponse, err error) {
+	req, err := c.preparerForServerEndpointsGet(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "serverendpoints.ServerEndpointsClient", "ServerEndpointsGet", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "serverendpoints.ServerEndpointsClient", "ServerEndpointsGet", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForServerEndpointsGet(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "serverendpoints.ServerEndpointsClient", "ServerEndpointsGet", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForServerEndpointsGet prepares the ServerEndpointsGet request.
+func (c ServerEndpointsClient) preparerForServerEndpointsGet(ctx context.Context, id ServerEndpointId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForServerEndpointsGet handles the response to the ServerEndpointsGet request. The method always
+// closes the http.Response Body.
+func (c ServerEndpointsClient) responderForServerEndpointsGet(resp *http.Response) (result ServerEndpointsGetResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
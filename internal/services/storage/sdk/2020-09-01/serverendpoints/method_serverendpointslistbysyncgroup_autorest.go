@@ -0,0 +1,183 @@
+package serverendpoints
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type ServerEndpointsListBySyncGroupResponse struct {
+	HttpResponse *http.Response
+	Model        *[]ServerEndpoint
+
+	nextLink     *string
+	nextPageFunc func(ctx context.Context, nextLink string) (ServerEndpointsListBySyncGroupResponse, error)
+}
+
+type ServerEndpointsListBySyncGroupCompleteResult struct {
+	Items []ServerEndpoint
+}
+
+func (r ServerEndpointsListBySyncGroupResponse) HasMore() bool {
+	return r.nextLink != nil
+}
+
+func (r ServerEndpointsListBySyncGroupResponse) LoadMore(ctx context.Context) (resp ServerEndpointsListBySyncGroupResponse, err error) {
+	if !r.HasMore() {
+		err = fmt.Errorf("no more pages returned")
+		return
+	}
+	return r.nextPageFunc(ctx, *r.nextLink)
+}
+
+// ServerEndpointsListBySyncGroup ...
+func (c ServerEndpointsClient) ServerEndpointsListBySyncGroup(ctx context.Context, id SyncGroupId) (resp ServerEndpointsListBySyncGroupResponse, err error) {
+	req, err := c.preparerForServerEndpointsListBySyncGroup(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "serverendpoints.ServerEndpointsClient", "ServerEndpointsListBySyncGroup", nil, "Failure preparing request")
+		return
+	}
+
+	resp.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "serverendpoints.ServerEndpointsClient", "ServerEndpointsListBySyncGroup", resp.HttpResponse, "Failure sending request")
+		return
+	}
+
+	resp, err = c.responderForServerEndpointsListBySyncGroup(resp.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "serverendpoints.ServerEndpointsClient", "ServerEndpointsListBySyncGroup", resp.HttpResponse, "Failure responding to request")
+		return
+	}
+	return
+}
+
+// ServerEndpointsListBySyncGroupComplete retrieves all of the results into a single object
+func (c ServerEndpointsClient) ServerEndpointsListBySyncGroupComplete(ctx context.Context, id SyncGroupId) (ServerEndpointsListBySyncGroupCompleteResult, error) {
+	return c.ServerEndpointsListBySyncGroupCompleteMatchingPredicate(ctx, id, ServerEndpointPredicate{})
+}
+
+// ServerEndpointsListBySyncGroupCompleteMatchingPredicate retrieves all of the results and then applied the predicate
+func (c ServerEndpointsClient) ServerEndpointsListBySyncGroupCompleteMatchingPredicate(ctx context.Context, id SyncGroupId, predicate ServerEndpointPredicate) (resp ServerEndpointsListBySyncGroupCompleteResult, err error) {
+	items := make([]ServerEndpoint, 0)
+
+	page, err := c.ServerEndpointsListBySyncGroup(ctx, id)
+	if err != nil {
+		err = fmt.Errorf("loading the initial page: %+v", err)
+		return
+	}
+	if page.Model != nil {
+		for _, v := range *page.Model {
+			if predicate.Matches(v) {
+				items = append(items, v)
+			}
+		}
+	}
+
+	for page.HasMore() {
+		page, err = page.LoadMore(ctx)
+		if err != nil {
+			err = fmt.Errorf("loading the next page: %+v", err)
+			return
+		}
+
+		if page.Model != nil {
+			for _, v := range *page.Model {
+				if predicate.Matches(v) {
+					items = append(items, v)
+				}
+			}
+		}
+	}
+
+	out := ServerEndpointsListBySyncGroupCompleteResult{
+		Items: items,
+	}
+	return out, nil
+}
+
+// preparerForServerEndpointsListBySyncGroup prepares the ServerEndpointsListBySyncGroup request.
+func (c ServerEndpointsClient) preparerForServerEndpointsListBySyncGroup(ctx context.Context, id SyncGroupId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(fmt.Sprintf("%s/serverEndpoints", id.ID())),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// preparerForServerEndpointsListBySyncGroupWithNextLink prepares the ServerEndpointsListBySyncGroup request with the given nextLink token.
+func (c ServerEndpointsClient) preparerForServerEndpointsListBySyncGroupWithNextLink(ctx context.Context, nextLink string) (*http.Request, error) {
+	uri, err := url.Parse(nextLink)
+	if err != nil {
+		return nil, fmt.Errorf("parsing nextLink %q: %+v", nextLink, err)
+	}
+	queryParameters := map[string]interface{}{}
+	for k, v := range uri.Query() {
+		if len(v) == 0 {
+			continue
+		}
+		val := v[0]
+		val = autorest.Encode("query", val)
+		queryParameters[k] = val
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(uri.Path),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForServerEndpointsListBySyncGroup handles the response to the ServerEndpointsListBySyncGroup request. The method always
+// closes the http.Response Body.
+func (c ServerEndpointsClient) responderForServerEndpointsListBySyncGroup(resp *http.Response) (result ServerEndpointsListBySyncGroupResponse, err error) {
+	type page struct {
+		Values   []ServerEndpoint `json:"value"`
+		NextLink *string          `json:"nextLink"`
+	}
+	var respObj page
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&respObj),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	result.Model = &respObj.Values
+	result.nextLink = respObj.NextLink
+	if respObj.NextLink != nil {
+		result.nextPageFunc = func(ctx context.Context, nextLink string) (result ServerEndpointsListBySyncGroupResponse, err error) {
+			req, err := c.preparerForServerEndpointsListBySyncGroupWithNextLink(ctx, nextLink)
+			if err != nil {
+				err = autorest.NewErrorWithError(err, "serverendpoints.ServerEndpointsClient", "ServerEndpointsListBySyncGroup", nil, "Failure preparing request")
+				return
+			}
+
+			result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+			if err != nil {
+				err = autorest.NewErrorWithError(err, "serverendpoints.ServerEndpointsClient", "ServerEndpointsListBySyncGroup", result.HttpResponse, "Failure sending request")
+				return
+			}
+
+			result, err = c.responderForServerEndpointsListBySyncGroup(result.HttpResponse)
+			if err != nil {
+				err = autorest.NewErrorWithError(err, "serverendpoints.ServerEndpointsClient", "ServerEndpointsListBySyncGroup", result.HttpResponse, "Failure responding to request")
+				return
+			}
+
+			return
+		}
+	}
+	return
+}
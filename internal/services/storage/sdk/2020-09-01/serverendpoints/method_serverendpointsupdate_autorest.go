@@ -0,0 +1,75 @@
+package serverendpoints
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+type ServerEndpointsUpdateResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// ServerEndpointsUpdate ...
+func (c ServerEndpointsClient) ServerEndpointsUpdate(ctx context.Context, id ServerEndpointId, input ServerEndpointUpdateParameters) (result ServerEndpointsUpdateResponse, err error) {
+	req, err := c.preparerForServerEndpointsUpdate(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "serverendpoints.ServerEndpointsClient", "ServerEndpointsUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForServerEndpointsUpdate(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "serverendpoints.ServerEndpointsClient", "ServerEndpointsUpdate", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// ServerEndpointsUpdateThenPoll performs ServerEndpointsUpdate then polls until it's completed
+func (c ServerEndpointsClient) ServerEndpointsUpdateThenPoll(ctx context.Context, id ServerEndpointId, input ServerEndpointUpdateParameters) error {
+	result, err := c.ServerEndpointsUpdate(ctx, id, input)
+	if err != nil {
+		return fmt.Errorf("performing ServerEndpointsUpdate: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after ServerEndpointsUpdate: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForServerEndpointsUpdate prepares the ServerEndpointsUpdate request.
+func (c ServerEndpointsClient) preparerForServerEndpointsUpdate(ctx context.Context, id ServerEndpointId, input ServerEndpointUpdateParameters) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPatch(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForServerEndpointsUpdate sends the ServerEndpointsUpdate request. The method will close the
+// http.Response Body if it receives an error.
+func (c ServerEndpointsClient) senderForServerEndpointsUpdate(ctx context.Context, req *http.Request) (future ServerEndpointsUpdateResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
@@ -0,0 +1,8 @@
+package serverendpoints
+
+type ServerEndpoint struct {
+	Id         *string                   `json:"id,omitempty"`
+	Name       *string                   `json:"name,omitempty"`
+	Properties *ServerEndpointProperties `json:"properties,omitempty"`
+	Type       *string                   `json:"type,omitempty"`
+}
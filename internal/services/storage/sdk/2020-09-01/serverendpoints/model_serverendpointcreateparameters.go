@@ -0,0 +1,5 @@
+package serverendpoints
+
+type ServerEndpointCreateParameters struct {
+	Properties *ServerEndpointCreateParametersProperties `json:"properties,omitempty"`
+}
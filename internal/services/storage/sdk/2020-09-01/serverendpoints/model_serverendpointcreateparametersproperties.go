@@ -0,0 +1,15 @@
+package serverendpoints
+
+type ServerEndpointCreateParametersProperties struct {
+	CloudTiering                 *FeatureStatus         `json:"cloudTiering,omitempty"`
+	FriendlyName                 *string                `json:"friendlyName,omitempty"`
+	InitialDownloadPolicy        *InitialDownloadPolicy `json:"initialDownloadPolicy,omitempty"`
+	InitialUploadPolicy          *InitialUploadPolicy   `json:"initialUploadPolicy,omitempty"`
+	LocalCacheMode               *LocalCacheMode        `json:"localCacheMode,omitempty"`
+	OfflineDataTransfer          *FeatureStatus         `json:"offlineDataTransfer,omitempty"`
+	OfflineDataTransferShareName *string                `json:"offlineDataTransferShareName,omitempty"`
+	ServerLocalPath              *string                `json:"serverLocalPath,omitempty"`
+	ServerResourceId             *string                `json:"serverResourceId,omitempty"`
+	TierFilesOlderThanDays       *int64                 `json:"tierFilesOlderThanDays,omitempty"`
+	VolumeFreeSpacePercent       *int64                 `json:"volumeFreeSpacePercent,omitempty"`
+}
@@ -0,0 +1,19 @@
+package serverendpoints
+
+type ServerEndpointProperties struct {
+	CloudTiering                 *FeatureStatus         `json:"cloudTiering,omitempty"`
+	FriendlyName                 *string                `json:"friendlyName,omitempty"`
+	InitialDownloadPolicy        *InitialDownloadPolicy `json:"initialDownloadPolicy,omitempty"`
+	InitialUploadPolicy          *InitialUploadPolicy   `json:"initialUploadPolicy,omitempty"`
+	LastOperationName            *string                `json:"lastOperationName,omitempty"`
+	LastWorkflowId               *string                `json:"lastWorkflowId,omitempty"`
+	LocalCacheMode               *LocalCacheMode        `json:"localCacheMode,omitempty"`
+	OfflineDataTransfer          *FeatureStatus         `json:"offlineDataTransfer,omitempty"`
+	OfflineDataTransferShareName *string                `json:"offlineDataTransferShareName,omitempty"`
+	ProvisioningState            *string                `json:"provisioningState,omitempty"`
+	ServerLocalPath              *string                `json:"serverLocalPath,omitempty"`
+	ServerName                   *string                `json:"serverName,omitempty"`
+	ServerResourceId             *string                `json:"serverResourceId,omitempty"`
+	TierFilesOlderThanDays       *int64                 `json:"tierFilesOlderThanDays,omitempty"`
+	VolumeFreeSpacePercent       *int64                 `json:"volumeFreeSpacePercent,omitempty"`
+}
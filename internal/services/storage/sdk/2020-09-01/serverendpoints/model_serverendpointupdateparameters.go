@@ -0,0 +1,5 @@
+package serverendpoints
+
+type ServerEndpointUpdateParameters struct {
+	Properties *ServerEndpointUpdateProperties `json:"properties,omitempty"`
+}
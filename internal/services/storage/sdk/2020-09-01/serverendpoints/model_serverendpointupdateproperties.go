@@ -0,0 +1,10 @@
+package serverendpoints
+
+type ServerEndpointUpdateProperties struct {
+	CloudTiering                 *FeatureStatus  `json:"cloudTiering,omitempty"`
+	LocalCacheMode               *LocalCacheMode `json:"localCacheMode,omitempty"`
+	OfflineDataTransfer          *FeatureStatus  `json:"offlineDataTransfer,omitempty"`
+	OfflineDataTransferShareName *string         `json:"offlineDataTransferShareName,omitempty"`
+	TierFilesOlderThanDays       *int64          `json:"tierFilesOlderThanDays,omitempty"`
+	VolumeFreeSpacePercent       *int64          `json:"volumeFreeSpacePercent,omitempty"`
+}
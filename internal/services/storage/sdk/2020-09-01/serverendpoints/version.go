@@ -0,0 +1,9 @@
+package serverendpoints
+
+import "fmt"
+
+const defaultApiVersion = "2020-09-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/serverendpoints/%s", defaultApiVersion)
+}
@@ -23,8 +23,7 @@ func (w DataPlaneStorageQueueWrapper) Create(ctx context.Context, _, accountName
 }
 
 func (w DataPlaneStorageQueueWrapper) Delete(ctx context.Context, _, accountName, queueName string) error {
-	_, err := w.client.Delete(ctx, accountName, queueName)
-	return err
+	return utils.DeleteIgnore404(w.client.Delete(ctx, accountName, queueName))
 }
 
 func (w DataPlaneStorageQueueWrapper) Exists(ctx context.Context, _, accountName, queueName string) (*bool, error) {
@@ -54,8 +54,7 @@ func (w DataPlaneStorageShareWrapper) Create(ctx context.Context, _, accountName
 
 func (w DataPlaneStorageShareWrapper) Delete(ctx context.Context, _, accountName, shareName string) error {
 	deleteSnapshots := true
-	_, err := w.client.Delete(ctx, accountName, shareName, deleteSnapshots)
-	return err
+	return utils.DeleteIgnore404(w.client.Delete(ctx, accountName, shareName, deleteSnapshots))
 }
 
 func (w DataPlaneStorageShareWrapper) Exists(ctx context.Context, _, accountName, shareName string) (*bool, error) {
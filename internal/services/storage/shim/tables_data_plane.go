@@ -23,8 +23,7 @@ func (w DataPlaneStorageTableWrapper) Create(ctx context.Context, _, accountName
 }
 
 func (w DataPlaneStorageTableWrapper) Delete(ctx context.Context, _, accountName, tableName string) error {
-	_, err := w.client.Delete(ctx, accountName, tableName)
-	return err
+	return utils.DeleteIgnore404(w.client.Delete(ctx, accountName, tableName))
 }
 
 func (w DataPlaneStorageTableWrapper) Exists(ctx context.Context, _, accountName, tableName string) (*bool, error) {
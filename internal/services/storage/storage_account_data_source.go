@@ -287,7 +287,9 @@ func dataSourceStorageAccountRead(d *pluginsdk.ResourceData, meta interface{}) e
 		return fmt.Errorf("retrieving %s: %+v", id, err)
 	}
 
+	id.ResourceGroup = azure.NormalizeResourceGroupName(id.ResourceGroup, resp.ID)
 	d.SetId(id.ID())
+	d.Set("resource_group_name", id.ResourceGroup)
 
 	// handle the user not having permissions to list the keys
 	d.Set("primary_connection_string", "")
@@ -11,13 +11,13 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-04-01/storage"
 	azautorest "github.com/Azure/go-autorest/autorest"
 	autorestAzure "github.com/Azure/go-autorest/autorest/azure"
-	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-getter/helper/url"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/networkrules"
 	msiparse "github.com/hashicorp/terraform-provider-azurerm/internal/services/msi/parse"
 	msiValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/msi/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network"
@@ -272,80 +272,7 @@ func resourceStorageAccount() *pluginsdk.Resource {
 				Default:  true,
 			},
 
-			"network_rules": {
-				Type:     pluginsdk.TypeList,
-				Optional: true,
-				Computed: true,
-				MaxItems: 1,
-				Elem: &pluginsdk.Resource{
-					Schema: map[string]*pluginsdk.Schema{
-						"bypass": {
-							Type:     pluginsdk.TypeSet,
-							Optional: true,
-							Computed: true,
-							Elem: &pluginsdk.Schema{
-								Type: pluginsdk.TypeString,
-								ValidateFunc: validation.StringInSlice([]string{
-									string(storage.BypassAzureServices),
-									string(storage.BypassLogging),
-									string(storage.BypassMetrics),
-									string(storage.BypassNone),
-								}, true),
-							},
-							Set: pluginsdk.HashString,
-						},
-
-						"ip_rules": {
-							Type:     pluginsdk.TypeSet,
-							Optional: true,
-							Computed: true,
-							Elem: &pluginsdk.Schema{
-								Type:         pluginsdk.TypeString,
-								ValidateFunc: validate.StorageAccountIpRule,
-							},
-							Set: pluginsdk.HashString,
-						},
-
-						"virtual_network_subnet_ids": {
-							Type:     pluginsdk.TypeSet,
-							Optional: true,
-							Computed: true,
-							Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
-							Set:      pluginsdk.HashString,
-						},
-
-						"default_action": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-							ValidateFunc: validation.StringInSlice([]string{
-								string(storage.DefaultActionAllow),
-								string(storage.DefaultActionDeny),
-							}, false),
-						},
-
-						"private_link_access": {
-							Type:     pluginsdk.TypeList,
-							Optional: true,
-							Elem: &pluginsdk.Resource{
-								Schema: map[string]*pluginsdk.Schema{
-									"endpoint_resource_id": {
-										Type:         pluginsdk.TypeString,
-										Required:     true,
-										ValidateFunc: azure.ValidateResourceID,
-									},
-
-									"endpoint_tenant_id": {
-										Type:         pluginsdk.TypeString,
-										Optional:     true,
-										Computed:     true,
-										ValidateFunc: validation.IsUUID,
-									},
-								},
-							},
-						},
-					},
-				},
-			},
+			"network_rules": storageAccountNetworkRulesSchema(),
 
 			"identity": {
 				Type:     pluginsdk.TypeList,
@@ -662,6 +589,12 @@ func resourceStorageAccount() *pluginsdk.Resource {
 											}, false),
 										},
 									},
+
+									"multichannel_enabled": {
+										Type:     pluginsdk.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
 								},
 							},
 						},
@@ -933,6 +866,105 @@ func resourceStorageAccount() *pluginsdk.Resource {
 	}
 }
 
+var storageAccountNetworkRules = networkrules.Options{
+	BypassValues: []string{
+		string(storage.BypassAzureServices),
+		string(storage.BypassLogging),
+		string(storage.BypassMetrics),
+		string(storage.BypassNone),
+	},
+	IPRuleValidateFunc: validate.StorageAccountIpRule,
+	AdditionalFields: map[string]*pluginsdk.Schema{
+		"private_link_access": storageAccountPrivateLinkAccessSchema(),
+	},
+}
+
+func storageAccountNetworkRulesSchema() *pluginsdk.Schema {
+	if features.ThreePointOh() {
+		return storageAccountNetworkRules.Schema()
+	}
+
+	// TODO: (v3.0) remove this in favour of the shared schema above
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"bypass": {
+					Type:     pluginsdk.TypeSet,
+					Optional: true,
+					Computed: true,
+					Elem: &pluginsdk.Schema{
+						Type: pluginsdk.TypeString,
+						ValidateFunc: validation.StringInSlice([]string{
+							string(storage.BypassAzureServices),
+							string(storage.BypassLogging),
+							string(storage.BypassMetrics),
+							string(storage.BypassNone),
+						}, true),
+					},
+					Set: pluginsdk.HashString,
+				},
+
+				"ip_rules": {
+					Type:     pluginsdk.TypeSet,
+					Optional: true,
+					Computed: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validate.StorageAccountIpRule,
+					},
+					Set: pluginsdk.HashString,
+				},
+
+				"virtual_network_subnet_ids": {
+					Type:     pluginsdk.TypeSet,
+					Optional: true,
+					Computed: true,
+					Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
+					Set:      pluginsdk.HashString,
+				},
+
+				"default_action": {
+					Type:     pluginsdk.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(storage.DefaultActionAllow),
+						string(storage.DefaultActionDeny),
+					}, false),
+				},
+
+				"private_link_access": storageAccountPrivateLinkAccessSchema(),
+			},
+		},
+	}
+}
+
+func storageAccountPrivateLinkAccessSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"endpoint_resource_id": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: azure.ValidateResourceID,
+				},
+
+				"endpoint_tenant_id": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.IsUUID,
+				},
+			},
+		},
+	}
+}
+
 func resourceStorageAccountCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	envName := meta.(*clients.Client).Account.Environment.Name
 	tenantId := meta.(*clients.Client).Account.TenantId
@@ -1175,7 +1207,12 @@ func resourceStorageAccountCreate(d *pluginsdk.ResourceData, meta interface{}) e
 		if accountKind == string(storage.KindFileStorage) || accountKind != string(storage.KindBlobStorage) && accountKind != string(storage.KindBlockBlobStorage) && accountTier != string(storage.SkuTierPremium) {
 			fileServiceClient := meta.(*clients.Client).Storage.FileServicesClient
 
-			if _, err = fileServiceClient.SetServiceProperties(ctx, id.ResourceGroup, id.Name, expandShareProperties(val.([]interface{}))); err != nil {
+			isFileStorage := accountKind == string(storage.KindFileStorage)
+			if !isFileStorage && d.Get("share_properties.0.smb.0.multichannel_enabled").(bool) {
+				return fmt.Errorf("`multichannel_enabled` is only supported for Premium FileStorage accounts")
+			}
+
+			if _, err = fileServiceClient.SetServiceProperties(ctx, id.ResourceGroup, id.Name, expandShareProperties(val.([]interface{}), isFileStorage)); err != nil {
 				return fmt.Errorf("updating Azure Storage Account `share_properties` %q: %+v", id.Name, err)
 			}
 		} else {
@@ -1546,7 +1583,12 @@ func resourceStorageAccountUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 		if accountKind == string(storage.KindFileStorage) || accountKind != string(storage.KindBlobStorage) && accountKind != string(storage.KindBlockBlobStorage) && accountTier != string(storage.SkuTierPremium) {
 			fileServiceClient := meta.(*clients.Client).Storage.FileServicesClient
 
-			if _, err = fileServiceClient.SetServiceProperties(ctx, id.ResourceGroup, id.Name, expandShareProperties(d.Get("share_properties").([]interface{}))); err != nil {
+			isFileStorage := accountKind == string(storage.KindFileStorage)
+			if !isFileStorage && d.Get("share_properties.0.smb.0.multichannel_enabled").(bool) {
+				return fmt.Errorf("`multichannel_enabled` is only supported for Premium FileStorage accounts")
+			}
+
+			if _, err = fileServiceClient.SetServiceProperties(ctx, id.ResourceGroup, id.Name, expandShareProperties(d.Get("share_properties").([]interface{}), isFileStorage)); err != nil {
 				return fmt.Errorf("updating Azure Storage Account `file share_properties` %q: %+v", id.Name, err)
 			}
 		} else {
@@ -1931,11 +1973,8 @@ func resourceStorageAccountDelete(d *pluginsdk.ResourceData, meta interface{}) e
 	locks.MultipleByName(&virtualNetworkNames, network.VirtualNetworkResourceName)
 	defer locks.UnlockMultipleByName(&virtualNetworkNames, network.VirtualNetworkResourceName)
 
-	resp, err := client.Delete(ctx, id.ResourceGroup, id.Name)
-	if err != nil {
-		if !response.WasNotFound(resp.Response) {
-			return fmt.Errorf("issuing delete request for %s: %+v", *id, err)
-		}
+	if err := utils.DeleteIgnore404(client.Delete(ctx, id.ResourceGroup, id.Name)); err != nil {
+		return fmt.Errorf("issuing delete request for %s: %+v", *id, err)
 	}
 
 	// remove this from the cache
@@ -2021,67 +2060,36 @@ func expandArmStorageAccountRouting(input []interface{}) *storage.RoutingPrefere
 
 func expandStorageAccountNetworkRules(d *pluginsdk.ResourceData, tenantId string) *storage.NetworkRuleSet {
 	networkRules := d.Get("network_rules").([]interface{})
-	if len(networkRules) == 0 {
+	rules := storageAccountNetworkRules.Expand(networkRules)
+	if rules == nil {
 		// Default access is enabled when no network rules are set.
 		return &storage.NetworkRuleSet{DefaultAction: storage.DefaultActionAllow}
 	}
 
-	networkRule := networkRules[0].(map[string]interface{})
-	networkRuleSet := &storage.NetworkRuleSet{
-		IPRules:             expandStorageAccountIPRules(networkRule),
-		VirtualNetworkRules: expandStorageAccountVirtualNetworks(networkRule),
-		Bypass:              expandStorageAccountBypass(networkRule),
-		ResourceAccessRules: expandStorageAccountPrivateLinkAccess(networkRule["private_link_access"].([]interface{}), tenantId),
-	}
-
-	if v := networkRule["default_action"]; v != nil {
-		networkRuleSet.DefaultAction = storage.DefaultAction(v.(string))
-	}
-
-	return networkRuleSet
-}
-
-func expandStorageAccountIPRules(networkRule map[string]interface{}) *[]storage.IPRule {
-	ipRulesInfo := networkRule["ip_rules"].(*pluginsdk.Set).List()
-	ipRules := make([]storage.IPRule, len(ipRulesInfo))
-
-	for i, ipRuleConfig := range ipRulesInfo {
-		attrs := ipRuleConfig.(string)
-		ipRule := storage.IPRule{
-			IPAddressOrRange: utils.String(attrs),
+	ipRules := make([]storage.IPRule, 0)
+	for _, v := range rules.IPRules {
+		ipRules = append(ipRules, storage.IPRule{
+			IPAddressOrRange: utils.String(v),
 			Action:           storage.ActionAllow,
-		}
-		ipRules[i] = ipRule
+		})
 	}
 
-	return &ipRules
-}
-
-func expandStorageAccountVirtualNetworks(networkRule map[string]interface{}) *[]storage.VirtualNetworkRule {
-	virtualNetworkInfo := networkRule["virtual_network_subnet_ids"].(*pluginsdk.Set).List()
-	virtualNetworks := make([]storage.VirtualNetworkRule, len(virtualNetworkInfo))
-
-	for i, virtualNetworkConfig := range virtualNetworkInfo {
-		attrs := virtualNetworkConfig.(string)
-		virtualNetwork := storage.VirtualNetworkRule{
-			VirtualNetworkResourceID: utils.String(attrs),
+	virtualNetworks := make([]storage.VirtualNetworkRule, 0)
+	for _, v := range rules.VirtualNetworkRules {
+		virtualNetworks = append(virtualNetworks, storage.VirtualNetworkRule{
+			VirtualNetworkResourceID: utils.String(v.SubnetId),
 			Action:                   storage.ActionAllow,
-		}
-		virtualNetworks[i] = virtualNetwork
+		})
 	}
 
-	return &virtualNetworks
-}
-
-func expandStorageAccountBypass(networkRule map[string]interface{}) storage.Bypass {
-	bypassInfo := networkRule["bypass"].(*pluginsdk.Set).List()
-
-	var bypassValues []string
-	for _, bypassConfig := range bypassInfo {
-		bypassValues = append(bypassValues, bypassConfig.(string))
+	networkRule := networkRules[0].(map[string]interface{})
+	return &storage.NetworkRuleSet{
+		IPRules:             &ipRules,
+		VirtualNetworkRules: &virtualNetworks,
+		Bypass:              storage.Bypass(strings.Join(rules.Bypass, ", ")),
+		DefaultAction:       storage.DefaultAction(rules.DefaultAction),
+		ResourceAccessRules: expandStorageAccountPrivateLinkAccess(networkRule["private_link_access"].([]interface{}), tenantId),
 	}
-
-	return storage.Bypass(strings.Join(bypassValues, ", "))
 }
 
 func expandStorageAccountPrivateLinkAccess(inputs []interface{}, tenantId string) *[]storage.ResourceAccessRule {
@@ -2195,7 +2203,9 @@ func expandBlobPropertiesCors(input []interface{}) *storage.CorsRules {
 	return &blobCorsRules
 }
 
-func expandShareProperties(input []interface{}) storage.FileServiceProperties {
+// expandShareProperties expands the `share_properties` block - SMB Multichannel is only sent when supported by
+// the account (Premium FileStorage), since the API rejects the setting for all other accounts
+func expandShareProperties(input []interface{}, supportsMultichannel bool) storage.FileServiceProperties {
 	props := storage.FileServiceProperties{
 		FileServicePropertiesProperties: &storage.FileServicePropertiesProperties{
 			Cors: &storage.CorsRules{
@@ -2208,6 +2218,15 @@ func expandShareProperties(input []interface{}) storage.FileServiceProperties {
 	}
 
 	if len(input) == 0 || input[0] == nil {
+		if supportsMultichannel {
+			props.ProtocolSettings = &storage.ProtocolSettings{
+				Smb: &storage.SmbSetting{
+					Multichannel: &storage.Multichannel{
+						Enabled: utils.Bool(false),
+					},
+				},
+			}
+		}
 		return props
 	}
 
@@ -2218,30 +2237,43 @@ func expandShareProperties(input []interface{}) storage.FileServiceProperties {
 	props.FileServicePropertiesProperties.Cors = expandBlobPropertiesCors(v["cors_rule"].([]interface{}))
 
 	props.ProtocolSettings = &storage.ProtocolSettings{
-		Smb: expandSharePropertiesSMB(v["smb"].([]interface{})),
+		Smb: expandSharePropertiesSMB(v["smb"].([]interface{}), supportsMultichannel),
 	}
 
 	return props
 }
 
-func expandSharePropertiesSMB(input []interface{}) *storage.SmbSetting {
+func expandSharePropertiesSMB(input []interface{}, supportsMultichannel bool) *storage.SmbSetting {
 	if len(input) == 0 || input[0] == nil {
-		return &storage.SmbSetting{
+		output := &storage.SmbSetting{
 			Versions:                 utils.String(""),
 			AuthenticationMethods:    utils.String(""),
 			KerberosTicketEncryption: utils.String(""),
 			ChannelEncryption:        utils.String(""),
 		}
+		if supportsMultichannel {
+			output.Multichannel = &storage.Multichannel{
+				Enabled: utils.Bool(false),
+			}
+		}
+		return output
 	}
 
 	v := input[0].(map[string]interface{})
 
-	return &storage.SmbSetting{
+	output := &storage.SmbSetting{
 		Versions:                 utils.ExpandStringSliceWithDelimiter(v["versions"].(*pluginsdk.Set).List(), ";"),
 		AuthenticationMethods:    utils.ExpandStringSliceWithDelimiter(v["authentication_types"].(*pluginsdk.Set).List(), ";"),
 		KerberosTicketEncryption: utils.ExpandStringSliceWithDelimiter(v["kerberos_ticket_encryption_type"].(*pluginsdk.Set).List(), ";"),
 		ChannelEncryption:        utils.ExpandStringSliceWithDelimiter(v["channel_encryption_type"].(*pluginsdk.Set).List(), ";"),
 	}
+	if supportsMultichannel {
+		output.Multichannel = &storage.Multichannel{
+			Enabled: utils.Bool(v["multichannel_enabled"].(bool)),
+		}
+	}
+
+	return output
 }
 
 func expandQueueProperties(input []interface{}) (queues.StorageServiceProperties, error) {
@@ -2473,15 +2505,27 @@ func flattenStorageAccountNetworkRules(input *storage.NetworkRuleSet) []interfac
 		return []interface{}{}
 	}
 
-	networkRules := make(map[string]interface{})
-
-	networkRules["ip_rules"] = pluginsdk.NewSet(pluginsdk.HashString, flattenStorageAccountIPRules(input.IPRules))
-	networkRules["virtual_network_subnet_ids"] = pluginsdk.NewSet(pluginsdk.HashString, flattenStorageAccountVirtualNetworks(input.VirtualNetworkRules))
-	networkRules["bypass"] = pluginsdk.NewSet(pluginsdk.HashString, flattenStorageAccountBypass(input.Bypass))
-	networkRules["default_action"] = string(input.DefaultAction)
-	networkRules["private_link_access"] = flattenStorageAccountPrivateLinkAccess(input.ResourceAccessRules)
+	rules := networkrules.NetworkRules{
+		DefaultAction:       string(input.DefaultAction),
+		Bypass:              make([]string, 0),
+		IPRules:             make([]string, 0),
+		VirtualNetworkRules: make([]networkrules.VirtualNetworkRule, 0),
+	}
+	for _, v := range flattenStorageAccountBypass(input.Bypass) {
+		rules.Bypass = append(rules.Bypass, v.(string))
+	}
+	for _, v := range flattenStorageAccountIPRules(input.IPRules) {
+		rules.IPRules = append(rules.IPRules, v.(string))
+	}
+	for _, v := range flattenStorageAccountVirtualNetworks(input.VirtualNetworkRules) {
+		rules.VirtualNetworkRules = append(rules.VirtualNetworkRules, networkrules.VirtualNetworkRule{
+			SubnetId: v.(string),
+		})
+	}
 
-	return []interface{}{networkRules}
+	output := storageAccountNetworkRules.Flatten(&rules)
+	output[0].(map[string]interface{})["private_link_access"] = flattenStorageAccountPrivateLinkAccess(input.ResourceAccessRules)
+	return output
 }
 
 func flattenStorageAccountIPRules(input *[]storage.IPRule) []interface{} {
@@ -2818,7 +2862,12 @@ func flattenedSharePropertiesSMB(input *storage.SmbSetting) []interface{} {
 		channelEncryption = utils.FlattenStringSliceWithDelimiter(input.ChannelEncryption, ";")
 	}
 
-	if len(versions) == 0 && len(authenticationMethods) == 0 && len(kerberosTicketEncryption) == 0 && len(channelEncryption) == 0 {
+	multichannelEnabled := false
+	if input.Multichannel != nil && input.Multichannel.Enabled != nil {
+		multichannelEnabled = *input.Multichannel.Enabled
+	}
+
+	if len(versions) == 0 && len(authenticationMethods) == 0 && len(kerberosTicketEncryption) == 0 && len(channelEncryption) == 0 && !multichannelEnabled {
 		return []interface{}{}
 	}
 
@@ -2828,6 +2877,7 @@ func flattenedSharePropertiesSMB(input *storage.SmbSetting) []interface{} {
 			"authentication_types":            authenticationMethods,
 			"kerberos_ticket_encryption_type": kerberosTicketEncryption,
 			"channel_encryption_type":         channelEncryption,
+			"multichannel_enabled":            multichannelEnabled,
 		},
 	}
 }
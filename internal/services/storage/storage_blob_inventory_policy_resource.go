@@ -197,7 +197,7 @@ func resourceStorageBlobInventoryPolicyDelete(d *pluginsdk.ResourceData, meta in
 		return err
 	}
 
-	if _, err := client.Delete(ctx, id.ResourceGroup, id.StorageAccountName); err != nil {
+	if err := utils.DeleteIgnore404(client.Delete(ctx, id.ResourceGroup, id.StorageAccountName)); err != nil {
 		return fmt.Errorf("deleting %q: %+v", id, err)
 	}
 	return nil
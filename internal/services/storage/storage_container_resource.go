@@ -251,7 +251,8 @@ func resourceStorageContainerDelete(d *pluginsdk.ResourceData, meta interface{})
 		return fmt.Errorf("retrieving Account %q for Container %q: %s", id.AccountName, id.Name, err)
 	}
 	if account == nil {
-		return fmt.Errorf("Unable to locate Storage Account %q!", id.AccountName)
+		log.Printf("[WARN] Unable to determine Resource Group for Storage Container %q (Account %s) - assuming removed", id.Name, id.AccountName)
+		return nil
 	}
 	client, err := storageClient.ContainersClient(ctx, *account)
 	if err != nil {
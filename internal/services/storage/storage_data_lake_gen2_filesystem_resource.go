@@ -332,11 +332,8 @@ func resourceStorageDataLakeGen2FileSystemDelete(d *pluginsdk.ResourceData, meta
 		return err
 	}
 
-	resp, err := client.Delete(ctx, id.AccountName, id.DirectoryName)
-	if err != nil {
-		if !utils.ResponseWasNotFound(resp) {
-			return fmt.Errorf("deleting File System %q in Storage Account %q: %+v", id.DirectoryName, id.AccountName, err)
-		}
+	if err := utils.DeleteIgnore404(client.Delete(ctx, id.AccountName, id.DirectoryName)); err != nil {
+		return fmt.Errorf("deleting File System %q in Storage Account %q: %+v", id.DirectoryName, id.AccountName, err)
 	}
 
 	return nil
@@ -349,11 +349,8 @@ func resourceStorageDataLakeGen2PathDelete(d *pluginsdk.ResourceData, meta inter
 		return err
 	}
 
-	resp, err := client.Delete(ctx, id.AccountName, id.FileSystemName, id.Path)
-	if err != nil {
-		if !utils.ResponseWasNotFound(resp) {
-			return fmt.Errorf("deleting Path %q in File System %q in Storage Account %q: %+v", id.Path, id.FileSystemName, id.AccountName, err)
-		}
+	if err := utils.DeleteIgnore404(client.Delete(ctx, id.AccountName, id.FileSystemName, id.Path)); err != nil {
+		return fmt.Errorf("deleting Path %q in File System %q in Storage Account %q: %+v", id.Path, id.FileSystemName, id.AccountName, err)
 	}
 
 	return nil
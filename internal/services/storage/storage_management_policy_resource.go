@@ -307,7 +307,7 @@ func resourceStorageManagementPolicyDelete(d *pluginsdk.ResourceData, meta inter
 		return err
 	}
 
-	if _, err := client.Delete(ctx, rid.ResourceGroup, rid.StorageAccountName); err != nil {
+	if err := utils.DeleteIgnore404(client.Delete(ctx, rid.ResourceGroup, rid.StorageAccountName)); err != nil {
 		return fmt.Errorf("deleting %s: %+v", rid, err)
 	}
 	return nil
@@ -259,11 +259,11 @@ func resourceStorageObjectReplicationDelete(d *pluginsdk.ResourceData, meta inte
 		return err
 	}
 
-	if _, err := client.Delete(ctx, id.DstResourceGroup, id.DstStorageAccountName, id.DstName); err != nil {
+	if err := utils.DeleteIgnore404(client.Delete(ctx, id.DstResourceGroup, id.DstStorageAccountName, id.DstName)); err != nil {
 		return fmt.Errorf("deleting %q: %+v", id, err)
 	}
 
-	if _, err := client.Delete(ctx, id.SrcResourceGroup, id.SrcStorageAccountName, id.SrcName); err != nil {
+	if err := utils.DeleteIgnore404(client.Delete(ctx, id.SrcResourceGroup, id.SrcStorageAccountName, id.SrcName)); err != nil {
 		return fmt.Errorf("deleting %q : %+v", id, err)
 	}
 	return nil
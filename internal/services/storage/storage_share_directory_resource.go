@@ -220,7 +220,7 @@ func resourceStorageShareDirectoryDelete(d *pluginsdk.ResourceData, meta interfa
 		return fmt.Errorf("building File Share Client for Storage Account %q (Resource Group %q): %s", id.AccountName, account.ResourceGroup, err)
 	}
 
-	if _, err := client.Delete(ctx, id.AccountName, id.ShareName, id.DirectoryName); err != nil {
+	if err := utils.DeleteIgnore404(client.Delete(ctx, id.AccountName, id.ShareName, id.DirectoryName)); err != nil {
 		return fmt.Errorf("deleting Storage Share %q (File Share %q / Account %q / Resource Group %q): %s", id.DirectoryName, id.ShareName, id.AccountName, account.ResourceGroup, err)
 	}
 
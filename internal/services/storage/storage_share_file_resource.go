@@ -330,7 +330,7 @@ func resourceStorageShareFileDelete(d *pluginsdk.ResourceData, meta interface{})
 		return fmt.Errorf("building File Share File Client for Storage Account %q (Resource Group %q): %s", id.AccountName, account.ResourceGroup, err)
 	}
 
-	if _, err := client.Delete(ctx, id.AccountName, id.ShareName, id.DirectoryName, id.FileName); err != nil {
+	if err := utils.DeleteIgnore404(client.Delete(ctx, id.AccountName, id.ShareName, id.DirectoryName, id.FileName)); err != nil {
 		return fmt.Errorf("deleting Storage Share File %q (File Share %q / Account %q / Resource Group %q): %s", id.FileName, id.ShareName, id.AccountName, account.ResourceGroup, err)
 	}
 
@@ -311,7 +311,8 @@ func resourceStorageShareDelete(d *pluginsdk.ResourceData, meta interface{}) err
 		return fmt.Errorf("retrieving Account %q for Share %q: %s", id.AccountName, id.Name, err)
 	}
 	if account == nil {
-		return fmt.Errorf("unable to locate Storage Account %q!", id.AccountName)
+		log.Printf("[WARN] Unable to determine Resource Group for Storage Share %q (Account %s) - assuming removed", id.Name, id.AccountName)
+		return nil
 	}
 
 	client, err := storageClient.FileSharesClient(ctx, *account)
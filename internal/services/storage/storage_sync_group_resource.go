@@ -119,7 +119,7 @@ func resourceStorageSyncGroupDelete(d *pluginsdk.ResourceData, meta interface{})
 		return err
 	}
 
-	if _, err := client.Delete(ctx, id.ResourceGroup, id.StorageSyncServiceName, id.SyncGroupName); err != nil {
+	if err := utils.DeleteIgnore404(client.Delete(ctx, id.ResourceGroup, id.StorageSyncServiceName, id.SyncGroupName)); err != nil {
 		return fmt.Errorf("deleting %s: %+v", *id, err)
 	}
 
@@ -0,0 +1,221 @@
+package storage
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/sdk/2020-09-01/registeredservers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceStorageSyncRegisteredServer() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceStorageSyncRegisteredServerCreate,
+		Read:   resourceStorageSyncRegisteredServerRead,
+		Delete: resourceStorageSyncRegisteredServerDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := registeredservers.ParseRegisteredServerID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"storage_sync_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.StorageSyncId,
+			},
+
+			"server_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"server_certificate": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"agent_version": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"server_os_version": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"friendly_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"server_role": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"cluster_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"cluster_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"agent_version_status": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"last_heart_beat": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceStorageSyncRegisteredServerCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.SyncRegisteredServersClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	serviceId, err := parse.StorageSyncServiceID(d.Get("storage_sync_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := registeredservers.NewRegisteredServerID(serviceId.SubscriptionId, serviceId.ResourceGroup, serviceId.Name, d.Get("server_id").(string))
+	existing, err := client.RegisteredServersGet(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_storage_sync_registered_server", id.ID())
+	}
+
+	parameters := registeredservers.RegisteredServerCreateParameters{
+		Properties: &registeredservers.RegisteredServerCreateParametersProperties{
+			AgentVersion:      utils.String(d.Get("agent_version").(string)),
+			FriendlyName:      utils.String(d.Get("friendly_name").(string)),
+			ServerCertificate: utils.String(d.Get("server_certificate").(string)),
+			ServerId:          utils.String(id.ServerId),
+			ServerOSVersion:   utils.String(d.Get("server_os_version").(string)),
+		},
+	}
+
+	if v, ok := d.GetOk("server_role"); ok {
+		parameters.Properties.ServerRole = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("cluster_id"); ok {
+		parameters.Properties.ClusterId = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("cluster_name"); ok {
+		parameters.Properties.ClusterName = utils.String(v.(string))
+	}
+
+	if err := client.RegisteredServersCreateThenPoll(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceStorageSyncRegisteredServerRead(d, meta)
+}
+
+func resourceStorageSyncRegisteredServerRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.SyncRegisteredServersClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := registeredservers.ParseRegisteredServerID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.RegisteredServersGet(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[INFO] %s does not exist - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("storage_sync_id", parse.NewStorageSyncServiceID(id.SubscriptionId, id.ResourceGroupName, id.StorageSyncServiceName).ID())
+	d.Set("server_id", id.ServerId)
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			d.Set("agent_version", props.AgentVersion)
+			d.Set("agent_version_status", props.AgentVersionStatus)
+			d.Set("cluster_id", props.ClusterId)
+			d.Set("cluster_name", props.ClusterName)
+			d.Set("friendly_name", props.FriendlyName)
+			d.Set("last_heart_beat", props.LastHeartBeat)
+			d.Set("server_certificate", props.ServerCertificate)
+			d.Set("server_os_version", props.ServerOSVersion)
+			d.Set("server_role", props.ServerRole)
+		}
+	}
+
+	return nil
+}
+
+func resourceStorageSyncRegisteredServerDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.SyncRegisteredServersClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := registeredservers.ParseRegisteredServerID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.RegisteredServersDeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,117 @@
+package storage_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/sdk/2020-09-01/registeredservers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type StorageSyncRegisteredServerResource struct{}
+
+// registering a Server requires the Server ID and Certificate generated by the Azure File Sync agent on that Server
+func (StorageSyncRegisteredServerResource) preCheck(t *testing.T) {
+	if os.Getenv("ARM_TEST_STORAGE_SYNC_SERVER_ID") == "" || os.Getenv("ARM_TEST_STORAGE_SYNC_SERVER_CERTIFICATE") == "" {
+		t.Skip("Skipping as ARM_TEST_STORAGE_SYNC_SERVER_ID and/or ARM_TEST_STORAGE_SYNC_SERVER_CERTIFICATE are not specified")
+	}
+}
+
+func TestAccStorageSyncRegisteredServer_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_sync_registered_server", "test")
+	r := StorageSyncRegisteredServerResource{}
+	r.preCheck(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStorageSyncRegisteredServer_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_sync_registered_server", "test")
+	r := StorageSyncRegisteredServerResource{}
+	r.preCheck(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (r StorageSyncRegisteredServerResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := registeredservers.ParseRegisteredServerID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Storage.SyncRegisteredServersClient.RegisteredServersGet(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r StorageSyncRegisteredServerResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-StorageSync-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_storage_sync" "test" {
+  name                = "acctest-StorageSync-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_storage_sync_registered_server" "test" {
+  storage_sync_id    = azurerm_storage_sync.test.id
+  server_id          = "%[3]s"
+  server_certificate = "%[4]s"
+  agent_version      = "16.0.0.0"
+  server_os_version  = "10.0.20348.0"
+  friendly_name      = "acctest-server-%[1]d"
+}
+`, data.RandomInteger, data.Locations.Primary, os.Getenv("ARM_TEST_STORAGE_SYNC_SERVER_ID"), os.Getenv("ARM_TEST_STORAGE_SYNC_SERVER_CERTIFICATE"))
+}
+
+func (r StorageSyncRegisteredServerResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_sync_registered_server" "import" {
+  storage_sync_id    = azurerm_storage_sync_registered_server.test.storage_sync_id
+  server_id          = azurerm_storage_sync_registered_server.test.server_id
+  server_certificate = azurerm_storage_sync_registered_server.test.server_certificate
+  agent_version      = azurerm_storage_sync_registered_server.test.agent_version
+  server_os_version  = azurerm_storage_sync_registered_server.test.server_os_version
+  friendly_name      = azurerm_storage_sync_registered_server.test.friendly_name
+}
+`, r.basic(data))
+}
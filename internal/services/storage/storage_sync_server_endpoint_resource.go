@@ -0,0 +1,297 @@
+package storage
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/sdk/2020-09-01/registeredservers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/sdk/2020-09-01/serverendpoints"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceStorageSyncServerEndpoint() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceStorageSyncServerEndpointCreate,
+		Read:   resourceStorageSyncServerEndpointRead,
+		Update: resourceStorageSyncServerEndpointUpdate,
+		Delete: resourceStorageSyncServerEndpointDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := serverendpoints.ParseServerEndpointID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(45 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(45 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(45 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.StorageSyncName,
+			},
+
+			"storage_sync_group_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.StorageSyncGroupID,
+			},
+
+			"registered_server_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: registeredservers.ValidateRegisteredServerID,
+			},
+
+			"server_local_path": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"cloud_tiering_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"volume_free_space_percent": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      20,
+				ValidateFunc: validation.IntBetween(1, 99),
+			},
+
+			"tier_files_older_than_days": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 2147483647),
+			},
+
+			"initial_download_policy": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  string(serverendpoints.InitialDownloadPolicyNamespaceThenModifiedFiles),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(serverendpoints.InitialDownloadPolicyAvoidTieredFiles),
+					string(serverendpoints.InitialDownloadPolicyNamespaceOnly),
+					string(serverendpoints.InitialDownloadPolicyNamespaceThenModifiedFiles),
+				}, false),
+			},
+
+			"initial_upload_policy": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  string(serverendpoints.InitialUploadPolicyMerge),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(serverendpoints.InitialUploadPolicyMerge),
+					string(serverendpoints.InitialUploadPolicyServerAuthoritative),
+				}, false),
+			},
+
+			"local_cache_mode": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  string(serverendpoints.LocalCacheModeUpdateLocallyCachedFiles),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(serverendpoints.LocalCacheModeDownloadNewAndModifiedFiles),
+					string(serverendpoints.LocalCacheModeUpdateLocallyCachedFiles),
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceStorageSyncServerEndpointCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.SyncServerEndpointsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	groupId, err := parse.StorageSyncGroupID(d.Get("storage_sync_group_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := serverendpoints.NewServerEndpointID(groupId.SubscriptionId, groupId.ResourceGroup, groupId.StorageSyncServiceName, groupId.SyncGroupName, d.Get("name").(string))
+	existing, err := client.ServerEndpointsGet(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_storage_sync_server_endpoint", id.ID())
+	}
+
+	initialDownloadPolicy := serverendpoints.InitialDownloadPolicy(d.Get("initial_download_policy").(string))
+	initialUploadPolicy := serverendpoints.InitialUploadPolicy(d.Get("initial_upload_policy").(string))
+	localCacheMode := serverendpoints.LocalCacheMode(d.Get("local_cache_mode").(string))
+
+	parameters := serverendpoints.ServerEndpointCreateParameters{
+		Properties: &serverendpoints.ServerEndpointCreateParametersProperties{
+			CloudTiering:           expandStorageSyncServerEndpointCloudTiering(d.Get("cloud_tiering_enabled").(bool)),
+			InitialDownloadPolicy:  &initialDownloadPolicy,
+			InitialUploadPolicy:    &initialUploadPolicy,
+			LocalCacheMode:         &localCacheMode,
+			ServerLocalPath:        utils.String(d.Get("server_local_path").(string)),
+			ServerResourceId:       utils.String(d.Get("registered_server_id").(string)),
+			VolumeFreeSpacePercent: utils.Int64(int64(d.Get("volume_free_space_percent").(int))),
+		},
+	}
+
+	if v, ok := d.GetOk("tier_files_older_than_days"); ok {
+		parameters.Properties.TierFilesOlderThanDays = utils.Int64(int64(v.(int)))
+	}
+
+	if err := client.ServerEndpointsCreateThenPoll(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceStorageSyncServerEndpointRead(d, meta)
+}
+
+func resourceStorageSyncServerEndpointRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.SyncServerEndpointsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := serverendpoints.ParseServerEndpointID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.ServerEndpointsGet(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[INFO] %s does not exist - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.ServerEndpointName)
+	d.Set("storage_sync_group_id", parse.NewStorageSyncGroupID(id.SubscriptionId, id.ResourceGroupName, id.StorageSyncServiceName, id.SyncGroupName).ID())
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			registeredServerId := ""
+			if props.ServerResourceId != nil {
+				parsed, err := registeredservers.ParseRegisteredServerIDInsensitively(*props.ServerResourceId)
+				if err != nil {
+					return err
+				}
+				registeredServerId = parsed.ID()
+			}
+			d.Set("registered_server_id", registeredServerId)
+			d.Set("server_local_path", props.ServerLocalPath)
+
+			d.Set("cloud_tiering_enabled", props.CloudTiering != nil && *props.CloudTiering == serverendpoints.FeatureStatusOn)
+			d.Set("volume_free_space_percent", props.VolumeFreeSpacePercent)
+			d.Set("tier_files_older_than_days", props.TierFilesOlderThanDays)
+
+			initialDownloadPolicy := ""
+			if props.InitialDownloadPolicy != nil {
+				initialDownloadPolicy = string(*props.InitialDownloadPolicy)
+			}
+			d.Set("initial_download_policy", initialDownloadPolicy)
+
+			initialUploadPolicy := ""
+			if props.InitialUploadPolicy != nil {
+				initialUploadPolicy = string(*props.InitialUploadPolicy)
+			}
+			d.Set("initial_upload_policy", initialUploadPolicy)
+
+			localCacheMode := ""
+			if props.LocalCacheMode != nil {
+				localCacheMode = string(*props.LocalCacheMode)
+			}
+			d.Set("local_cache_mode", localCacheMode)
+		}
+	}
+
+	return nil
+}
+
+func resourceStorageSyncServerEndpointUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.SyncServerEndpointsClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := serverendpoints.ParseServerEndpointID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	parameters := serverendpoints.ServerEndpointUpdateParameters{
+		Properties: &serverendpoints.ServerEndpointUpdateProperties{},
+	}
+
+	// the tiering policies are only used when Cloud Tiering is enabled, so these are sent together
+	if d.HasChanges("cloud_tiering_enabled", "volume_free_space_percent", "tier_files_older_than_days") {
+		parameters.Properties.CloudTiering = expandStorageSyncServerEndpointCloudTiering(d.Get("cloud_tiering_enabled").(bool))
+		parameters.Properties.VolumeFreeSpacePercent = utils.Int64(int64(d.Get("volume_free_space_percent").(int)))
+
+		if v, ok := d.GetOk("tier_files_older_than_days"); ok {
+			parameters.Properties.TierFilesOlderThanDays = utils.Int64(int64(v.(int)))
+		} else {
+			parameters.Properties.TierFilesOlderThanDays = utils.Int64(0)
+		}
+	}
+
+	if d.HasChange("local_cache_mode") {
+		localCacheMode := serverendpoints.LocalCacheMode(d.Get("local_cache_mode").(string))
+		parameters.Properties.LocalCacheMode = &localCacheMode
+	}
+
+	if err := client.ServerEndpointsUpdateThenPoll(ctx, *id, parameters); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return resourceStorageSyncServerEndpointRead(d, meta)
+}
+
+func resourceStorageSyncServerEndpointDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.SyncServerEndpointsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := serverendpoints.ParseServerEndpointID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.ServerEndpointsDeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandStorageSyncServerEndpointCloudTiering(enabled bool) *serverendpoints.FeatureStatus {
+	status := serverendpoints.FeatureStatusOff
+	if enabled {
+		status = serverendpoints.FeatureStatusOn
+	}
+	return &status
+}
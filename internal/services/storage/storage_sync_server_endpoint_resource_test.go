@@ -0,0 +1,201 @@
+package storage_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/sdk/2020-09-01/registeredservers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/sdk/2020-09-01/serverendpoints"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type StorageSyncServerEndpointResource struct{}
+
+// Server Endpoints require a Registered Server, which can only be provisioned by installing the Azure File Sync
+// agent on a Windows Server - as such these tests run against an existing Registered Server.
+func (StorageSyncServerEndpointResource) preCheck(t *testing.T) {
+	if os.Getenv("ARM_TEST_STORAGE_SYNC_REGISTERED_SERVER_ID") == "" {
+		t.Skip("Skipping as ARM_TEST_STORAGE_SYNC_REGISTERED_SERVER_ID is not specified")
+	}
+}
+
+func TestAccStorageSyncServerEndpoint_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_sync_server_endpoint", "test")
+	r := StorageSyncServerEndpointResource{}
+	r.preCheck(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStorageSyncServerEndpoint_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_sync_server_endpoint", "test")
+	r := StorageSyncServerEndpointResource{}
+	r.preCheck(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccStorageSyncServerEndpoint_cloudTiering(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_sync_server_endpoint", "test")
+	r := StorageSyncServerEndpointResource{}
+	r.preCheck(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.cloudTiering(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("cloud_tiering_enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("cloud_tiering_enabled").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r StorageSyncServerEndpointResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := serverendpoints.ParseServerEndpointID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Storage.SyncServerEndpointsClient.ServerEndpointsGet(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r StorageSyncServerEndpointResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_sync_server_endpoint" "test" {
+  name                  = "acctest-SEP-%d"
+  storage_sync_group_id = azurerm_storage_sync_group.test.id
+  registered_server_id  = "%s"
+  server_local_path     = "D:\\acctest-%d"
+
+  depends_on = [azurerm_storage_sync_cloud_endpoint.test]
+}
+`, r.template(data), data.RandomInteger, os.Getenv("ARM_TEST_STORAGE_SYNC_REGISTERED_SERVER_ID"), data.RandomInteger)
+}
+
+func (r StorageSyncServerEndpointResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_sync_server_endpoint" "import" {
+  name                  = azurerm_storage_sync_server_endpoint.test.name
+  storage_sync_group_id = azurerm_storage_sync_server_endpoint.test.storage_sync_group_id
+  registered_server_id  = azurerm_storage_sync_server_endpoint.test.registered_server_id
+  server_local_path     = azurerm_storage_sync_server_endpoint.test.server_local_path
+}
+`, r.basic(data))
+}
+
+func (r StorageSyncServerEndpointResource) cloudTiering(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_sync_server_endpoint" "test" {
+  name                       = "acctest-SEP-%d"
+  storage_sync_group_id      = azurerm_storage_sync_group.test.id
+  registered_server_id       = "%s"
+  server_local_path          = "D:\\acctest-%d"
+  cloud_tiering_enabled      = true
+  volume_free_space_percent  = 40
+  tier_files_older_than_days = 30
+  local_cache_mode           = "DownloadNewAndModifiedFiles"
+
+  depends_on = [azurerm_storage_sync_cloud_endpoint.test]
+}
+`, r.template(data), data.RandomInteger, os.Getenv("ARM_TEST_STORAGE_SYNC_REGISTERED_SERVER_ID"), data.RandomInteger)
+}
+
+func (r StorageSyncServerEndpointResource) template(data acceptance.TestData) string {
+	// the Sync Group must be within the same Storage Sync Service as the Registered Server
+	storageSyncId := ""
+	if serverId, err := registeredservers.ParseRegisteredServerID(os.Getenv("ARM_TEST_STORAGE_SYNC_REGISTERED_SERVER_ID")); err == nil {
+		storageSyncId = parse.NewStorageSyncServiceID(serverId.SubscriptionId, serverId.ResourceGroupName, serverId.StorageSyncServiceName).ID()
+	}
+
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-StorageSync-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_storage_sync_group" "test" {
+  name            = "acctest-StorageSyncGroup-%[1]d"
+  storage_sync_id = "%[4]s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "accstr%[3]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_share" "test" {
+  name                 = "acctest-share-%[1]d"
+  storage_account_name = azurerm_storage_account.test.name
+  quota                = 50
+}
+
+resource "azurerm_storage_sync_cloud_endpoint" "test" {
+  name                  = "acctest-CEP-%[1]d"
+  storage_sync_group_id = azurerm_storage_sync_group.test.id
+  storage_account_id    = azurerm_storage_account.test.id
+  file_share_name       = azurerm_storage_share.test.name
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, storageSyncId)
+}
@@ -203,7 +203,8 @@ func resourceStorageTableDelete(d *pluginsdk.ResourceData, meta interface{}) err
 		return fmt.Errorf("retrieving Account %q for Table %q: %s", id.AccountName, id.Name, err)
 	}
 	if account == nil {
-		return fmt.Errorf("Unable to locate Storage Account %q!", id.AccountName)
+		log.Printf("[WARN] Unable to determine Resource Group for Storage Table %q (Account %s) - assuming removed", id.Name, id.AccountName)
+		return nil
 	}
 
 	client, err := storageClient.TablesClient(ctx, *account)
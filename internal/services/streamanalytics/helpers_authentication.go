@@ -0,0 +1,54 @@
+package streamanalytics
+
+import (
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/streamanalytics/mgmt/2020-03-01-preview/streamanalytics"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func schemaStreamAnalyticsAuthenticationMode() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeString,
+		Optional: true,
+		Default:  string(streamanalytics.ConnectionString),
+		ValidateFunc: validation.StringInSlice([]string{
+			string(streamanalytics.ConnectionString),
+			string(streamanalytics.Msi),
+		}, false),
+	}
+}
+
+// flattenStreamAnalyticsAuthenticationMode returns the Authentication Mode of an Input or Output - which is
+// omitted by the API for resources created prior to the introduction of Managed Identity authentication
+func flattenStreamAnalyticsAuthenticationMode(input streamanalytics.AuthenticationMode) string {
+	if input == "" {
+		return string(streamanalytics.ConnectionString)
+	}
+
+	return string(input)
+}
+
+// expandStreamAnalyticsOptionalSecret returns nil for empty values, since credentials must be omitted
+// (rather than sent empty) when authenticating using a Managed Identity
+func expandStreamAnalyticsOptionalSecret(input string) *string {
+	if input == "" {
+		return nil
+	}
+
+	return utils.String(input)
+}
+
+func validateStreamAnalyticsSharedAccessPolicy(d *pluginsdk.ResourceData) error {
+	if d.Get("authentication_mode").(string) != string(streamanalytics.ConnectionString) {
+		return nil
+	}
+
+	if d.Get("shared_access_policy_key").(string) == "" || d.Get("shared_access_policy_name").(string) == "" {
+		return fmt.Errorf("`shared_access_policy_key` and `shared_access_policy_name` must be specified when `authentication_mode` is `%s`", streamanalytics.ConnectionString)
+	}
+
+	return nil
+}
@@ -66,7 +66,7 @@ func resourceStreamAnalyticsOutputBlob() *pluginsdk.Resource {
 
 			"storage_account_key": {
 				Type:         pluginsdk.TypeString,
-				Required:     true,
+				Optional:     true,
 				Sensitive:    true,
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
@@ -101,6 +101,8 @@ func resourceStreamAnalyticsOutputBlob() *pluginsdk.Resource {
 				Optional:     true,
 				ValidateFunc: validation.FloatBetween(0, 10000),
 			},
+
+			"authentication_mode": schemaStreamAnalyticsAuthenticationMode(),
 		},
 	}
 }
@@ -131,6 +133,11 @@ func resourceStreamAnalyticsOutputBlobCreateUpdate(d *pluginsdk.ResourceData, me
 	storageAccountKey := d.Get("storage_account_key").(string)
 	storageAccountName := d.Get("storage_account_name").(string)
 	timeFormat := d.Get("time_format").(string)
+	authenticationMode := d.Get("authentication_mode").(string)
+
+	if authenticationMode == string(streamanalytics.ConnectionString) && storageAccountKey == "" {
+		return fmt.Errorf("`storage_account_key` must be specified when `authentication_mode` is `%s`", streamanalytics.ConnectionString)
+	}
 
 	serializationRaw := d.Get("serialization").([]interface{})
 	serialization, err := expandStreamAnalyticsOutputSerialization(serializationRaw)
@@ -146,14 +153,15 @@ func resourceStreamAnalyticsOutputBlobCreateUpdate(d *pluginsdk.ResourceData, me
 				BlobOutputDataSourceProperties: &streamanalytics.BlobOutputDataSourceProperties{
 					StorageAccounts: &[]streamanalytics.StorageAccount{
 						{
-							AccountKey:  utils.String(storageAccountKey),
+							AccountKey:  expandStreamAnalyticsOptionalSecret(storageAccountKey),
 							AccountName: utils.String(storageAccountName),
 						},
 					},
-					Container:   utils.String(containerName),
-					DateFormat:  utils.String(dateFormat),
-					PathPattern: utils.String(pathPattern),
-					TimeFormat:  utils.String(timeFormat),
+					Container:          utils.String(containerName),
+					DateFormat:         utils.String(dateFormat),
+					PathPattern:        utils.String(pathPattern),
+					TimeFormat:         utils.String(timeFormat),
+					AuthenticationMode: streamanalytics.AuthenticationMode(authenticationMode),
 				},
 			},
 			Serialization: serialization,
@@ -222,6 +230,7 @@ func resourceStreamAnalyticsOutputBlobRead(d *pluginsdk.ResourceData, meta inter
 		d.Set("path_pattern", v.PathPattern)
 		d.Set("storage_container_name", v.Container)
 		d.Set("time_format", v.TimeFormat)
+		d.Set("authentication_mode", flattenStreamAnalyticsAuthenticationMode(v.AuthenticationMode))
 
 		if accounts := v.StorageAccounts; accounts != nil && len(*accounts) > 0 {
 			account := (*accounts)[0]
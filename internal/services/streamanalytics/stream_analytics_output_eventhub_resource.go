@@ -66,14 +66,14 @@ func resourceStreamAnalyticsOutputEventHub() *pluginsdk.Resource {
 
 			"shared_access_policy_key": {
 				Type:         pluginsdk.TypeString,
-				Required:     true,
+				Optional:     true,
 				Sensitive:    true,
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 
 			"shared_access_policy_name": {
 				Type:         pluginsdk.TypeString,
-				Required:     true,
+				Optional:     true,
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 
@@ -91,6 +91,8 @@ func resourceStreamAnalyticsOutputEventHub() *pluginsdk.Resource {
 				Optional: true,
 			},
 
+			"authentication_mode": schemaStreamAnalyticsAuthenticationMode(),
+
 			"serialization": schemaStreamAnalyticsOutputSerialization(),
 		},
 	}
@@ -123,6 +125,10 @@ func resourceStreamAnalyticsOutputEventHubCreateUpdate(d *pluginsdk.ResourceData
 	propertyColumns := d.Get("property_columns").([]interface{})
 	partitionKey := d.Get("partition_key").(string)
 
+	if err := validateStreamAnalyticsSharedAccessPolicy(d); err != nil {
+		return err
+	}
+
 	serializationRaw := d.Get("serialization").([]interface{})
 	serialization, err := expandStreamAnalyticsOutputSerialization(serializationRaw)
 	if err != nil {
@@ -137,8 +143,9 @@ func resourceStreamAnalyticsOutputEventHubCreateUpdate(d *pluginsdk.ResourceData
 				EventHubOutputDataSourceProperties: &streamanalytics.EventHubOutputDataSourceProperties{
 					EventHubName:           utils.String(eventHubName),
 					ServiceBusNamespace:    utils.String(serviceBusNamespace),
-					SharedAccessPolicyKey:  utils.String(sharedAccessPolicyKey),
-					SharedAccessPolicyName: utils.String(sharedAccessPolicyName),
+					SharedAccessPolicyKey:  expandStreamAnalyticsOptionalSecret(sharedAccessPolicyKey),
+					SharedAccessPolicyName: expandStreamAnalyticsOptionalSecret(sharedAccessPolicyName),
+					AuthenticationMode:     streamanalytics.AuthenticationMode(d.Get("authentication_mode").(string)),
 					PropertyColumns:        utils.ExpandStringSlice(propertyColumns),
 					PartitionKey:           utils.String(partitionKey),
 				},
@@ -194,6 +201,7 @@ func resourceStreamAnalyticsOutputEventHubRead(d *pluginsdk.ResourceData, meta i
 		d.Set("eventhub_name", v.EventHubName)
 		d.Set("servicebus_namespace", v.ServiceBusNamespace)
 		d.Set("shared_access_policy_name", v.SharedAccessPolicyName)
+		d.Set("authentication_mode", flattenStreamAnalyticsAuthenticationMode(v.AuthenticationMode))
 		d.Set("property_columns", v.PropertyColumns)
 		d.Set("partition_key", v.PartitionKey)
 
@@ -79,17 +79,19 @@ func resourceStreamAnalyticsOutputSql() *pluginsdk.Resource {
 
 			"user": {
 				Type:         pluginsdk.TypeString,
-				Required:     true,
+				Optional:     true,
 				ForceNew:     true,
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 
 			"password": {
 				Type:         pluginsdk.TypeString,
-				Required:     true,
+				Optional:     true,
 				Sensitive:    true,
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
+
+			"authentication_mode": schemaStreamAnalyticsAuthenticationMode(),
 		},
 	}
 }
@@ -117,6 +119,11 @@ func resourceStreamAnalyticsOutputSqlCreateUpdate(d *pluginsdk.ResourceData, met
 	tableName := d.Get("table").(string)
 	sqlUser := d.Get("user").(string)
 	sqlUserPassword := d.Get("password").(string)
+	authenticationMode := d.Get("authentication_mode").(string)
+
+	if authenticationMode == string(streamanalytics.ConnectionString) && (sqlUser == "" || sqlUserPassword == "") {
+		return fmt.Errorf("`user` and `password` must be specified when `authentication_mode` is `%s`", streamanalytics.ConnectionString)
+	}
 
 	props := streamanalytics.Output{
 		Name: utils.String(id.Name),
@@ -124,11 +131,12 @@ func resourceStreamAnalyticsOutputSqlCreateUpdate(d *pluginsdk.ResourceData, met
 			Datasource: &streamanalytics.AzureSQLDatabaseOutputDataSource{
 				Type: streamanalytics.TypeMicrosoftSQLServerDatabase,
 				AzureSQLDatabaseOutputDataSourceProperties: &streamanalytics.AzureSQLDatabaseOutputDataSourceProperties{
-					Server:   utils.String(server),
-					Database: utils.String(databaseName),
-					User:     utils.String(sqlUser),
-					Password: utils.String(sqlUserPassword),
-					Table:    utils.String(tableName),
+					Server:             utils.String(server),
+					Database:           utils.String(databaseName),
+					User:               expandStreamAnalyticsOptionalSecret(sqlUser),
+					Password:           expandStreamAnalyticsOptionalSecret(sqlUserPassword),
+					Table:              utils.String(tableName),
+					AuthenticationMode: streamanalytics.AuthenticationMode(authenticationMode),
 				},
 			},
 		},
@@ -182,6 +190,7 @@ func resourceStreamAnalyticsOutputSqlRead(d *pluginsdk.ResourceData, meta interf
 		d.Set("database", v.Database)
 		d.Set("table", v.Table)
 		d.Set("user", v.User)
+		d.Set("authentication_mode", flattenStreamAnalyticsAuthenticationMode(v.AuthenticationMode))
 	}
 
 	return nil
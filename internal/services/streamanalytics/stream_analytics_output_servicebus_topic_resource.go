@@ -66,14 +66,14 @@ func resourceStreamAnalyticsOutputServiceBusTopic() *pluginsdk.Resource {
 
 			"shared_access_policy_key": {
 				Type:         pluginsdk.TypeString,
-				Required:     true,
+				Optional:     true,
 				Sensitive:    true,
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 
 			"shared_access_policy_name": {
 				Type:         pluginsdk.TypeString,
-				Required:     true,
+				Optional:     true,
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 
@@ -86,6 +86,8 @@ func resourceStreamAnalyticsOutputServiceBusTopic() *pluginsdk.Resource {
 				},
 			},
 
+			"authentication_mode": schemaStreamAnalyticsAuthenticationMode(),
+
 			"serialization": schemaStreamAnalyticsOutputSerialization(),
 		},
 	}
@@ -113,6 +115,10 @@ func resourceStreamAnalyticsOutputServiceBusTopicCreateUpdate(d *pluginsdk.Resou
 		}
 	}
 
+	if err := validateStreamAnalyticsSharedAccessPolicy(d); err != nil {
+		return err
+	}
+
 	serializationRaw := d.Get("serialization").([]interface{})
 	serialization, err := expandStreamAnalyticsOutputSerialization(serializationRaw)
 	if err != nil {
@@ -127,8 +133,9 @@ func resourceStreamAnalyticsOutputServiceBusTopicCreateUpdate(d *pluginsdk.Resou
 				ServiceBusTopicOutputDataSourceProperties: &streamanalytics.ServiceBusTopicOutputDataSourceProperties{
 					TopicName:              utils.String(d.Get("topic_name").(string)),
 					ServiceBusNamespace:    utils.String(d.Get("servicebus_namespace").(string)),
-					SharedAccessPolicyKey:  utils.String(d.Get("shared_access_policy_key").(string)),
-					SharedAccessPolicyName: utils.String(d.Get("shared_access_policy_name").(string)),
+					SharedAccessPolicyKey:  expandStreamAnalyticsOptionalSecret(d.Get("shared_access_policy_key").(string)),
+					SharedAccessPolicyName: expandStreamAnalyticsOptionalSecret(d.Get("shared_access_policy_name").(string)),
+					AuthenticationMode:     streamanalytics.AuthenticationMode(d.Get("authentication_mode").(string)),
 					PropertyColumns:        utils.ExpandStringSlice(d.Get("property_columns").([]interface{})),
 				},
 			},
@@ -183,6 +190,7 @@ func resourceStreamAnalyticsOutputServiceBusTopicRead(d *pluginsdk.ResourceData,
 		d.Set("topic_name", v.TopicName)
 		d.Set("servicebus_namespace", v.ServiceBusNamespace)
 		d.Set("shared_access_policy_name", v.SharedAccessPolicyName)
+		d.Set("authentication_mode", flattenStreamAnalyticsAuthenticationMode(v.AuthenticationMode))
 		d.Set("property_columns", v.PropertyColumns)
 
 		if err := d.Set("serialization", flattenStreamAnalyticsOutputSerialization(props.Serialization)); err != nil {
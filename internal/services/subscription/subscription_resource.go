@@ -92,6 +92,19 @@ func resourceSubscription() *pluginsdk.Resource {
 				},
 			},
 
+			"reseller_id": {
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Description:   "The MPN ID of the Reseller, used when creating a Subscription for a Microsoft Partner Agreement billing scope.",
+				ValidateFunc:  validation.StringIsNotEmpty,
+				ConflictsWith: []string{"subscription_id"},
+				// the Reseller ID is not exposed in any way, so must be ignored if the resource is imported.
+				DiffSuppressFunc: func(k, old, new string, d *pluginsdk.ResourceData) bool {
+					return new == ""
+				},
+			},
+
 			"subscription_id": {
 				Type:        pluginsdk.TypeString,
 				Description: "The GUID of the Subscription.",
@@ -201,6 +214,9 @@ func resourceSubscriptionCreate(d *pluginsdk.ResourceData, meta interface{}) err
 		// If we're not assuming control of an existing Subscription, we need to know where to create it.
 		req.Properties.DisplayName = utils.String(d.Get("subscription_name").(string))
 		req.Properties.BillingScope = utils.String(d.Get("billing_scope_id").(string))
+		if resellerId := d.Get("reseller_id").(string); resellerId != "" {
+			req.Properties.ResellerID = utils.String(resellerId)
+		}
 	}
 
 	future, err := aliasClient.Create(ctx, aliasName, req)
@@ -475,11 +491,11 @@ func waitForSubscriptionStateToSettle(ctx context.Context, clients *clients.Clie
 func checkExistingAliases(ctx context.Context, client subscriptionAlias.AliasClient, subscriptionId string) (*string, int, error) {
 	aliasList, err := client.List(ctx)
 	if err != nil {
-		return nil, len(*aliasList.Value), fmt.Errorf("could not List existing Subscription Aliases")
+		return nil, 0, fmt.Errorf("could not List existing Subscription Aliases: %+v", err)
 	}
 
 	if aliasList.Value == nil {
-		return nil, len(*aliasList.Value), fmt.Errorf("failed reading Subscription Alias list")
+		return nil, 0, fmt.Errorf("failed reading Subscription Alias list")
 	}
 
 	for _, v := range *aliasList.Value {
@@ -93,6 +93,24 @@ func TestAccSubscriptionResource_devTest(t *testing.T) {
 	})
 }
 
+func TestAccSubscriptionResource_partnerAccountReseller(t *testing.T) {
+	if os.Getenv("ARM_BILLING_MPA_ACCOUNT") == "" || os.Getenv("ARM_BILLING_MPA_CUSTOMER") == "" || os.Getenv("ARM_BILLING_MPA_RESELLER_ID") == "" {
+		t.Skip("skipping tests - no Microsoft Partner Agreement billing account data provided")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_subscription", "test")
+	r := SubscriptionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.partnerAccountReseller(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r)),
+		},
+		data.ImportStep("reseller_id"),
+	})
+}
+
 func (SubscriptionResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.SubscriptionAliasID(state.ID)
 	if err != nil {
@@ -174,6 +192,29 @@ resource "azurerm_subscription" "test" {
 `, billingAccount, enrollmentAccount, data.RandomInteger)
 }
 
+func (SubscriptionResource) partnerAccountReseller(data acceptance.TestData) string {
+	billingAccount := os.Getenv("ARM_BILLING_MPA_ACCOUNT")
+	customer := os.Getenv("ARM_BILLING_MPA_CUSTOMER")
+	resellerId := os.Getenv("ARM_BILLING_MPA_RESELLER_ID")
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_billing_mpa_account_scope" "test" {
+  billing_account_name = "%s"
+  customer_name        = "%s"
+}
+
+resource "azurerm_subscription" "test" {
+  alias             = "testAcc-%[4]d"
+  subscription_name = "testAccSubscription %[4]d"
+  billing_scope_id  = data.azurerm_billing_mpa_account_scope.test.id
+  reseller_id       = "%[3]s"
+}
+`, billingAccount, customer, resellerId, data.RandomInteger)
+}
+
 func (r SubscriptionResource) requiresImport(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
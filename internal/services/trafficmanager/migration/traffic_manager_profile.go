@@ -0,0 +1,171 @@
+package migration
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/trafficmanager/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+var _ pluginsdk.StateUpgrade = TrafficManagerProfileV0ToV1{}
+
+type TrafficManagerProfileV0ToV1 struct{}
+
+func (TrafficManagerProfileV0ToV1) Schema() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+
+		"resource_group_name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+
+		"profile_status": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+
+		"traffic_routing_method": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+		},
+
+		"dns_config": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"relative_name": {
+						Type:     pluginsdk.TypeString,
+						ForceNew: true,
+						Required: true,
+					},
+					"ttl": {
+						Type:     pluginsdk.TypeInt,
+						Required: true,
+					},
+				},
+			},
+		},
+
+		"monitor_config": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"expected_status_code_ranges": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+
+					"custom_header": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"name": {
+									Type:     pluginsdk.TypeString,
+									Required: true,
+								},
+								"value": {
+									Type:     pluginsdk.TypeString,
+									Required: true,
+								},
+							},
+						},
+					},
+
+					"protocol": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+					},
+
+					"port": {
+						Type:     pluginsdk.TypeInt,
+						Required: true,
+					},
+
+					"path": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+					},
+
+					"interval_in_seconds": {
+						Type:     pluginsdk.TypeInt,
+						Optional: true,
+						Default:  30,
+					},
+
+					"timeout_in_seconds": {
+						Type:     pluginsdk.TypeInt,
+						Optional: true,
+						Default:  10,
+					},
+
+					"tolerated_number_of_failures": {
+						Type:     pluginsdk.TypeInt,
+						Optional: true,
+						Default:  3,
+					},
+				},
+			},
+		},
+
+		"fqdn": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"max_return": {
+			Type:     pluginsdk.TypeInt,
+			Optional: true,
+		},
+
+		"traffic_view_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+		},
+
+		"tags": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (TrafficManagerProfileV0ToV1) UpgradeFunc() pluginsdk.StateUpgraderFunc {
+	return func(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+		// Profiles imported prior to the importer validating the ID may have been stored using the casing returned
+		// by the Portal, which the newer API version (and the ID parser) don't accept:
+		// old:
+		// 	/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/microsoft.network/trafficmanagerprofiles/profile1
+		// new:
+		// 	/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Network/trafficManagerProfiles/profile1
+		oldId := rawState["id"].(string)
+		id, err := parse.TrafficManagerProfileIDInsensitively(oldId)
+		if err != nil {
+			return rawState, err
+		}
+
+		newId := id.ID()
+		log.Printf("[DEBUG] Updating ID from %q to %q", oldId, newId)
+		rawState["id"] = newId
+
+		return rawState, nil
+	}
+}
@@ -0,0 +1,75 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type HeatMapId struct {
+	SubscriptionId            string
+	ResourceGroup             string
+	TrafficManagerProfileName string
+	Name                      string
+}
+
+func NewHeatMapID(subscriptionId, resourceGroup, trafficManagerProfileName, name string) HeatMapId {
+	return HeatMapId{
+		SubscriptionId:            subscriptionId,
+		ResourceGroup:             resourceGroup,
+		TrafficManagerProfileName: trafficManagerProfileName,
+		Name:                      name,
+	}
+}
+
+func (id HeatMapId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Traffic Manager Profile Name %q", id.TrafficManagerProfileName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Heat Map", segmentsStr)
+}
+
+func (id HeatMapId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/trafficManagerProfiles/%s/heatMaps/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.TrafficManagerProfileName, id.Name)
+}
+
+// HeatMapID parses a HeatMap ID into an HeatMapId struct
+func HeatMapID(input string) (*HeatMapId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := HeatMapId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.TrafficManagerProfileName, err = id.PopSegment("trafficManagerProfiles"); err != nil {
+		return nil, err
+	}
+	if resourceId.Name, err = id.PopSegment("heatMaps"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
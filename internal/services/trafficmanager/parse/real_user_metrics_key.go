@@ -0,0 +1,61 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type RealUserMetricsKeyId struct {
+	SubscriptionId                   string
+	TrafficManagerUserMetricsKeyName string
+}
+
+func NewRealUserMetricsKeyID(subscriptionId, trafficManagerUserMetricsKeyName string) RealUserMetricsKeyId {
+	return RealUserMetricsKeyId{
+		SubscriptionId:                   subscriptionId,
+		TrafficManagerUserMetricsKeyName: trafficManagerUserMetricsKeyName,
+	}
+}
+
+func (id RealUserMetricsKeyId) String() string {
+	segments := []string{
+		fmt.Sprintf("Traffic Manager User Metrics Key Name %q", id.TrafficManagerUserMetricsKeyName),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Real User Metrics Key", segmentsStr)
+}
+
+func (id RealUserMetricsKeyId) ID() string {
+	fmtString := "/subscriptions/%s/providers/Microsoft.Network/trafficManagerUserMetricsKeys/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.TrafficManagerUserMetricsKeyName)
+}
+
+// RealUserMetricsKeyID parses a RealUserMetricsKey ID into an RealUserMetricsKeyId struct
+func RealUserMetricsKeyID(input string) (*RealUserMetricsKeyId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := RealUserMetricsKeyId{
+		SubscriptionId: id.SubscriptionID,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.TrafficManagerUserMetricsKeyName, err = id.PopSegment("trafficManagerUserMetricsKeys"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,96 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.Id = RealUserMetricsKeyId{}
+
+func TestRealUserMetricsKeyIDFormatter(t *testing.T) {
+	actual := NewRealUserMetricsKeyID("12345678-1234-9876-4563-123456789012", "default").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Network/trafficManagerUserMetricsKeys/default"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestRealUserMetricsKeyID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *RealUserMetricsKeyId
+	}{
+
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+
+		{
+			// missing SubscriptionId
+			Input: "/",
+			Error: true,
+		},
+
+		{
+			// missing value for SubscriptionId
+			Input: "/subscriptions/",
+			Error: true,
+		},
+
+		{
+			// missing TrafficManagerUserMetricsKeyName
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Network/",
+			Error: true,
+		},
+
+		{
+			// missing value for TrafficManagerUserMetricsKeyName
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Network/trafficManagerUserMetricsKeys/",
+			Error: true,
+		},
+
+		{
+			// valid
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Network/trafficManagerUserMetricsKeys/default",
+			Expected: &RealUserMetricsKeyId{
+				SubscriptionId:                   "12345678-1234-9876-4563-123456789012",
+				TrafficManagerUserMetricsKeyName: "default",
+			},
+		},
+
+		{
+			// upper-cased
+			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/PROVIDERS/MICROSOFT.NETWORK/TRAFFICMANAGERUSERMETRICSKEYS/DEFAULT",
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := RealUserMetricsKeyID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.SubscriptionId != v.Expected.SubscriptionId {
+			t.Fatalf("Expected %q but got %q for SubscriptionId", v.Expected.SubscriptionId, actual.SubscriptionId)
+		}
+		if actual.TrafficManagerUserMetricsKeyName != v.Expected.TrafficManagerUserMetricsKeyName {
+			t.Fatalf("Expected %q but got %q for TrafficManagerUserMetricsKeyName", v.Expected.TrafficManagerUserMetricsKeyName, actual.TrafficManagerUserMetricsKeyName)
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package parse
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// StatusCodeRange is a range of HTTP Status Codes, which can be specified either as a range (e.g. `200-299`)
+// or as a single Status Code (e.g. `200`), in which case both `Min` and `Max` are set to this value
+type StatusCodeRange struct {
+	Min int
+	Max int
+}
+
+func ParseStatusCodeRange(input string) (*StatusCodeRange, error) {
+	parts := strings.Split(input, "-")
+	if len(parts) > 2 {
+		return nil, fmt.Errorf("expected a single status code (e.g. `200`) or a range of status codes (e.g. `200-299`) but got %q", input)
+	}
+
+	min, err := parseStatusCode(parts[0])
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	max := min
+	if len(parts) == 2 {
+		max, err = parseStatusCode(parts[1])
+		if err != nil {
+			return nil, fmt.Errorf("parsing %q: %+v", input, err)
+		}
+	}
+
+	if min > max {
+		return nil, fmt.Errorf("expected the minimum status code (%d) to be less than or equal to the maximum status code (%d) in %q", min, max, input)
+	}
+
+	return &StatusCodeRange{
+		Min: min,
+		Max: max,
+	}, nil
+}
+
+func parseStatusCode(input string) (int, error) {
+	v, err := strconv.Atoi(input)
+	if err != nil {
+		return 0, fmt.Errorf("expected the status code %q to be an integer", input)
+	}
+
+	if v < 100 || v > 599 {
+		return 0, fmt.Errorf("expected the status code %d to be between 100 and 599", v)
+	}
+
+	return v, nil
+}
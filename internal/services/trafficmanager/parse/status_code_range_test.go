@@ -0,0 +1,101 @@
+package parse
+
+import "testing"
+
+func TestParseStatusCodeRange(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *StatusCodeRange
+	}{
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+		{
+			// single status code
+			Input: "200",
+			Expected: &StatusCodeRange{
+				Min: 200,
+				Max: 200,
+			},
+		},
+		{
+			// range
+			Input: "200-299",
+			Expected: &StatusCodeRange{
+				Min: 200,
+				Max: 299,
+			},
+		},
+		{
+			// range of a single status code
+			Input: "404-404",
+			Expected: &StatusCodeRange{
+				Min: 404,
+				Max: 404,
+			},
+		},
+		{
+			// missing the maximum
+			Input: "200-",
+			Error: true,
+		},
+		{
+			// missing the minimum
+			Input: "-299",
+			Error: true,
+		},
+		{
+			// multiple separators
+			Input: "200-299-399",
+			Error: true,
+		},
+		{
+			// not an integer
+			Input: "2xx",
+			Error: true,
+		},
+		{
+			// minimum greater than maximum
+			Input: "299-200",
+			Error: true,
+		},
+		{
+			// below the bounds
+			Input: "99-200",
+			Error: true,
+		},
+		{
+			// above the bounds
+			Input: "500-600",
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ParseStatusCodeRange(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expected a value but got an error: %s", err)
+		}
+
+		if v.Error {
+			t.Fatal("Expected an error but didn't get one")
+		}
+
+		if actual.Min != v.Expected.Min {
+			t.Fatalf("Expected %d but got %d for Min", v.Expected.Min, actual.Min)
+		}
+
+		if actual.Max != v.Expected.Max {
+			t.Fatalf("Expected %d but got %d for Max", v.Expected.Max, actual.Max)
+		}
+	}
+}
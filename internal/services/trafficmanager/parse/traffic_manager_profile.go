@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceid"
 )
 
 type TrafficManagerProfileId struct {
@@ -67,3 +68,47 @@ func TrafficManagerProfileID(input string) (*TrafficManagerProfileId, error) {
 
 	return &resourceId, nil
 }
+
+// TrafficManagerProfileIDInsensitively parses an TrafficManagerProfile ID into an TrafficManagerProfileId struct, insensitively
+// This should only be used to parse an ID for rewriting, the TrafficManagerProfileID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func TrafficManagerProfileIDInsensitively(input string) (*TrafficManagerProfileId, error) {
+	id, err := resourceid.ParseAzureResourceIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := TrafficManagerProfileId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	// find the correct casing for the 'trafficManagerProfiles' segment
+	trafficManagerProfilesKey := "trafficManagerProfiles"
+	for key := range id.Path {
+		if strings.EqualFold(key, trafficManagerProfilesKey) {
+			trafficManagerProfilesKey = key
+			break
+		}
+	}
+	if resourceId.Name, err = id.PopSegment(trafficManagerProfilesKey); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -1,6 +1,7 @@
 package trafficmanager
 
 import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
@@ -21,15 +22,27 @@ func (r Registration) WebsiteCategories() []string {
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
+		"azurerm_traffic_manager_dns_name_availability": dataSourceArmTrafficManagerDnsNameAvailability(),
 		"azurerm_traffic_manager_geographical_location": dataSourceArmTrafficManagerGeographicalLocation(),
+		"azurerm_traffic_manager_heat_map":              dataSourceArmTrafficManagerHeatMap(),
 		"azurerm_traffic_manager_profile":               dataSourceArmTrafficManagerProfile(),
+		"azurerm_traffic_manager_real_user_metrics_key": dataSourceArmTrafficManagerRealUserMetricsKey(),
 	}
 }
 
 // SupportedResources returns the supported Resources supported by this Service
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
-	return map[string]*pluginsdk.Resource{
-		"azurerm_traffic_manager_endpoint": resourceArmTrafficManagerEndpoint(),
-		"azurerm_traffic_manager_profile":  resourceArmTrafficManagerProfile(),
+	resources := map[string]*pluginsdk.Resource{
+		"azurerm_traffic_manager_azure_endpoint":        resourceAzureEndpoint(),
+		"azurerm_traffic_manager_external_endpoint":     resourceExternalEndpoint(),
+		"azurerm_traffic_manager_nested_endpoint":       resourceNestedEndpoint(),
+		"azurerm_traffic_manager_profile":               resourceArmTrafficManagerProfile(),
+		"azurerm_traffic_manager_real_user_metrics_key": resourceArmTrafficManagerRealUserMetricsKey(),
+	}
+
+	if !features.ThreePointOh() {
+		resources["azurerm_traffic_manager_endpoint"] = resourceArmTrafficManagerEndpoint()
 	}
+
+	return resources
 }
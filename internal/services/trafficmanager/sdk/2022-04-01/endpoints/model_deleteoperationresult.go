@@ -0,0 +1,5 @@
+package endpoints
+
+type DeleteOperationResult struct {
+	Boolean *bool `json:"boolean,omitempty"`
+}
@@ -0,0 +1,5 @@
+package geographichierarchies
+
+type GeographicHierarchyProperties struct {
+	GeographicHierarchy *Region `json:"geographicHierarchy,omitempty"`
+}
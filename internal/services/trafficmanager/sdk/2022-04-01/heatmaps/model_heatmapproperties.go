@@ -0,0 +1,8 @@
+package heatmaps
+
+type HeatMapProperties struct {
+	EndTime      *string            `json:"endTime,omitempty"`
+	Endpoints    *[]HeatMapEndpoint `json:"endpoints,omitempty"`
+	StartTime    *string            `json:"startTime,omitempty"`
+	TrafficFlows *[]TrafficFlow     `json:"trafficFlows,omitempty"`
+}
@@ -0,0 +1,7 @@
+package heatmaps
+
+type QueryExperience struct {
+	EndpointId int64    `json:"endpointId"`
+	Latency    *float64 `json:"latency,omitempty"`
+	QueryCount int64    `json:"queryCount"`
+}
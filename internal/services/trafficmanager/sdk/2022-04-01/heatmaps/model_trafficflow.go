@@ -0,0 +1,8 @@
+package heatmaps
+
+type TrafficFlow struct {
+	Latitude         *float64           `json:"latitude,omitempty"`
+	Longitude        *float64           `json:"longitude,omitempty"`
+	QueryExperiences *[]QueryExperience `json:"queryExperiences,omitempty"`
+	SourceIP         *string            `json:"sourceIp,omitempty"`
+}
@@ -0,0 +1,6 @@
+package profiles
+
+type CheckTrafficManagerRelativeDnsNameAvailabilityParameters struct {
+	Name *string `json:"name,omitempty"`
+	Type *string `json:"type,omitempty"`
+}
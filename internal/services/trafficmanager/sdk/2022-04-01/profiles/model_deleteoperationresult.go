@@ -0,0 +1,5 @@
+package profiles
+
+type DeleteOperationResult struct {
+	Boolean *bool `json:"boolean,omitempty"`
+}
@@ -0,0 +1,6 @@
+package profiles
+
+type EndpointPropertiesCustomHeadersInlined struct {
+	Name  *string `json:"name,omitempty"`
+	Value *string `json:"value,omitempty"`
+}
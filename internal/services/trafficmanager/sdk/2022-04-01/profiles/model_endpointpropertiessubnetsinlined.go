@@ -0,0 +1,7 @@
+package profiles
+
+type EndpointPropertiesSubnetsInlined struct {
+	First *string `json:"first,omitempty"`
+	Last  *string `json:"last,omitempty"`
+	Scope *int64  `json:"scope,omitempty"`
+}
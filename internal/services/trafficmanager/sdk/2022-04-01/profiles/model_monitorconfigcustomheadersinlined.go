@@ -0,0 +1,6 @@
+package profiles
+
+type MonitorConfigCustomHeadersInlined struct {
+	Name  *string `json:"name,omitempty"`
+	Value *string `json:"value,omitempty"`
+}
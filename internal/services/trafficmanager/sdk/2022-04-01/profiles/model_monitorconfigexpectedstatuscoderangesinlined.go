@@ -0,0 +1,6 @@
+package profiles
+
+type MonitorConfigExpectedStatusCodeRangesInlined struct {
+	Max *int64 `json:"max,omitempty"`
+	Min *int64 `json:"min,omitempty"`
+}
@@ -0,0 +1,9 @@
+package profiles
+
+import "fmt"
+
+const defaultApiVersion = "2022-04-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/profiles/%s", defaultApiVersion)
+}
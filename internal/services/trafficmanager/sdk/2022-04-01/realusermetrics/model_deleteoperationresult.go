@@ -0,0 +1,5 @@
+package realusermetrics
+
+type DeleteOperationResult struct {
+	Boolean *bool `json:"boolean,omitempty"`
+}
@@ -0,0 +1,5 @@
+package realusermetrics
+
+type UserMetricsProperties struct {
+	Key *string `json:"key,omitempty"`
+}
@@ -0,0 +1,9 @@
+package realusermetrics
+
+import "fmt"
+
+const defaultApiVersion = "2022-04-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/realusermetrics/%s", defaultApiVersion)
+}
@@ -0,0 +1,387 @@
+package trafficmanager
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	azValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/trafficmanager/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/trafficmanager/sdk/2022-04-01/endpoints"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/trafficmanager/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+const azureEndpointResourceType = "azureEndpoints"
+
+func resourceAzureEndpoint() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceAzureEndpointCreate,
+		Read:   resourceAzureEndpointRead,
+		Update: resourceAzureEndpointUpdate,
+		Delete: resourceAzureEndpointDelete,
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.AzureEndpointID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"profile_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.TrafficManagerProfileID,
+			},
+
+			"target_resource_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"always_serve_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"weight": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 1000),
+			},
+
+			"priority": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 1000),
+			},
+
+			"geo_mappings": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"custom_header": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"value": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
+			"subnet": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"first": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: azValidate.IPv4Address,
+						},
+
+						"last": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: azValidate.IPv4Address,
+						},
+
+						"scope": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 32),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAzureEndpointCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).TrafficManager.EndpointsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	profileId, err := parse.TrafficManagerProfileID(d.Get("profile_id").(string))
+	if err != nil {
+		return fmt.Errorf("parsing `profile_id`: %+v", err)
+	}
+
+	id := parse.NewAzureEndpointID(profileId.SubscriptionId, profileId.ResourceGroup, profileId.Name, d.Get("name").(string))
+	existing, err := client.Get(ctx, endpoints.NewEndpointTypeID(id.SubscriptionId, id.ResourceGroup, id.TrafficManagerProfileName, azureEndpointResourceType, id.Name))
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_traffic_manager_azure_endpoint", id.ID())
+	}
+
+	params := endpoints.Endpoint{
+		Name:       utils.String(id.Name),
+		Type:       utils.String(fmt.Sprintf("Microsoft.Network/trafficManagerProfiles/%s", azureEndpointResourceType)),
+		Properties: expandAzureEndpointProperties(d),
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, endpoints.NewEndpointTypeID(id.SubscriptionId, id.ResourceGroup, id.TrafficManagerProfileName, azureEndpointResourceType, id.Name), params); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceAzureEndpointRead(d, meta)
+}
+
+func resourceAzureEndpointRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).TrafficManager.EndpointsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.AzureEndpointID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, endpoints.NewEndpointTypeID(id.SubscriptionId, id.ResourceGroup, id.TrafficManagerProfileName, azureEndpointResourceType, id.Name))
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("profile_id", parse.NewTrafficManagerProfileID(id.SubscriptionId, id.ResourceGroup, id.TrafficManagerProfileName).ID())
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			d.Set("enabled", props.EndpointStatus != nil && *props.EndpointStatus == endpoints.EndpointStatusEnabled)
+			d.Set("always_serve_enabled", props.AlwaysServe != nil && *props.AlwaysServe == endpoints.AlwaysServeEnabled)
+			d.Set("target_resource_id", props.TargetResourceId)
+			d.Set("weight", props.Weight)
+			d.Set("priority", props.Priority)
+			d.Set("geo_mappings", utils.FlattenStringSlice(props.GeoMapping))
+
+			subnets := orderTrafficManagerListLikeExisting(d.Get("subnet").([]interface{}), flattenTrafficManagerEndpointSubnets(props.Subnets), trafficManagerSubnetKey)
+			if err := d.Set("subnet", subnets); err != nil {
+				return fmt.Errorf("setting `subnet`: %+v", err)
+			}
+
+			customHeaders := orderTrafficManagerListLikeExisting(d.Get("custom_header").([]interface{}), flattenTrafficManagerEndpointCustomHeaders(props.CustomHeaders), trafficManagerCustomHeaderKey)
+			if err := d.Set("custom_header", customHeaders); err != nil {
+				return fmt.Errorf("setting `custom_header`: %+v", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceAzureEndpointUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).TrafficManager.EndpointsClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.AzureEndpointID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	params := endpoints.Endpoint{
+		Name:       utils.String(id.Name),
+		Type:       utils.String(fmt.Sprintf("Microsoft.Network/trafficManagerProfiles/%s", azureEndpointResourceType)),
+		Properties: expandAzureEndpointProperties(d),
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, endpoints.NewEndpointTypeID(id.SubscriptionId, id.ResourceGroup, id.TrafficManagerProfileName, azureEndpointResourceType, id.Name), params); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return resourceAzureEndpointRead(d, meta)
+}
+
+func resourceAzureEndpointDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).TrafficManager.EndpointsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.AzureEndpointID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Delete(ctx, endpoints.NewEndpointTypeID(id.SubscriptionId, id.ResourceGroup, id.TrafficManagerProfileName, azureEndpointResourceType, id.Name))
+	if err != nil {
+		if !response.WasNotFound(resp.HttpResponse) {
+			return fmt.Errorf("deleting %s: %+v", *id, err)
+		}
+	}
+
+	return nil
+}
+
+func expandAzureEndpointProperties(d *pluginsdk.ResourceData) *endpoints.EndpointProperties {
+	props := endpoints.EndpointProperties{
+		TargetResourceId: utils.String(d.Get("target_resource_id").(string)),
+		EndpointStatus:   expandTrafficManagerEndpointStatus(d.Get("enabled").(bool)),
+		AlwaysServe:      expandTrafficManagerEndpointAlwaysServe(d.Get("always_serve_enabled").(bool)),
+	}
+
+	if weight := d.Get("weight").(int); weight != 0 {
+		props.Weight = utils.Int64(int64(weight))
+	}
+
+	if priority := d.Get("priority").(int); priority != 0 {
+		props.Priority = utils.Int64(int64(priority))
+	}
+
+	if geoMappings := utils.ExpandStringSlice(d.Get("geo_mappings").([]interface{})); len(*geoMappings) > 0 {
+		props.GeoMapping = geoMappings
+	}
+
+	if subnets := expandTrafficManagerEndpointSubnets(d.Get("subnet").([]interface{})); len(*subnets) > 0 {
+		props.Subnets = subnets
+	}
+
+	if customHeaders := expandTrafficManagerEndpointCustomHeaders(d.Get("custom_header").([]interface{})); len(*customHeaders) > 0 {
+		props.CustomHeaders = customHeaders
+	}
+
+	return &props
+}
+
+func expandTrafficManagerEndpointStatus(enabled bool) *endpoints.EndpointStatus {
+	status := endpoints.EndpointStatusDisabled
+	if enabled {
+		status = endpoints.EndpointStatusEnabled
+	}
+	return &status
+}
+
+func expandTrafficManagerEndpointAlwaysServe(enabled bool) *endpoints.AlwaysServe {
+	alwaysServe := endpoints.AlwaysServeDisabled
+	if enabled {
+		alwaysServe = endpoints.AlwaysServeEnabled
+	}
+	return &alwaysServe
+}
+
+func expandTrafficManagerEndpointSubnets(input []interface{}) *[]endpoints.EndpointPropertiesSubnetsInlined {
+	results := make([]endpoints.EndpointPropertiesSubnetsInlined, 0)
+	for _, item := range input {
+		v := item.(map[string]interface{})
+		// a range is specified using `first` and `last`, whereas a CIDR block is specified using `first` and `scope`
+		if v["scope"].(int) == 0 && v["first"].(string) != "0.0.0.0" {
+			results = append(results, endpoints.EndpointPropertiesSubnetsInlined{
+				First: utils.String(v["first"].(string)),
+				Last:  utils.String(v["last"].(string)),
+			})
+		} else {
+			results = append(results, endpoints.EndpointPropertiesSubnetsInlined{
+				First: utils.String(v["first"].(string)),
+				Scope: utils.Int64(int64(v["scope"].(int))),
+			})
+		}
+	}
+	return &results
+}
+
+func expandTrafficManagerEndpointCustomHeaders(input []interface{}) *[]endpoints.EndpointPropertiesCustomHeadersInlined {
+	results := make([]endpoints.EndpointPropertiesCustomHeadersInlined, 0)
+	for _, item := range input {
+		v := item.(map[string]interface{})
+		results = append(results, endpoints.EndpointPropertiesCustomHeadersInlined{
+			Name:  utils.String(v["name"].(string)),
+			Value: utils.String(v["value"].(string)),
+		})
+	}
+	return &results
+}
+
+func flattenTrafficManagerEndpointSubnets(input *[]endpoints.EndpointPropertiesSubnetsInlined) []interface{} {
+	result := make([]interface{}, 0)
+	if input == nil {
+		return result
+	}
+	for _, subnet := range *input {
+		flatSubnet := make(map[string]interface{}, 3)
+		if subnet.First != nil {
+			flatSubnet["first"] = *subnet.First
+		}
+		if subnet.Last != nil {
+			flatSubnet["last"] = *subnet.Last
+		}
+		if subnet.Scope != nil {
+			flatSubnet["scope"] = int(*subnet.Scope)
+		}
+		result = append(result, flatSubnet)
+	}
+	return sortTrafficManagerFlattenedList(result, trafficManagerSubnetKey)
+}
+
+func flattenTrafficManagerEndpointCustomHeaders(input *[]endpoints.EndpointPropertiesCustomHeadersInlined) []interface{} {
+	result := make([]interface{}, 0)
+	if input == nil {
+		return result
+	}
+	for _, header := range *input {
+		flatHeader := make(map[string]interface{}, 2)
+		if header.Name != nil {
+			flatHeader["name"] = *header.Name
+		}
+		if header.Value != nil {
+			flatHeader["value"] = *header.Value
+		}
+		result = append(result, flatHeader)
+	}
+	return sortTrafficManagerFlattenedList(result, trafficManagerCustomHeaderKey)
+}
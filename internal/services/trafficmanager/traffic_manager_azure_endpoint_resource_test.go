@@ -0,0 +1,285 @@
+package trafficmanager_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/trafficmanager/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/trafficmanager/sdk/2022-04-01/endpoints"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type AzureEndpointResource struct{}
+
+func TestAccAzureEndpoint_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_traffic_manager_azure_endpoint", "test")
+	r := AzureEndpointResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAzureEndpoint_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_traffic_manager_azure_endpoint", "test")
+	r := AzureEndpointResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccAzureEndpoint_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_traffic_manager_azure_endpoint", "test")
+	r := AzureEndpointResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.updated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("enabled").HasValue("false"),
+				check.That(data.ResourceName).Key("always_serve_enabled").HasValue("true"),
+				check.That(data.ResourceName).Key("weight").HasValue("5"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAzureEndpoint_geoMappings(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_traffic_manager_azure_endpoint", "test")
+	r := AzureEndpointResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.geoMappings(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("geo_mappings.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAzureEndpoint_customHeadersAndSubnets(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_traffic_manager_azure_endpoint", "test")
+	r := AzureEndpointResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.customHeadersAndSubnets(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("custom_header.#").HasValue("1"),
+				check.That(data.ResourceName).Key("subnet.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.customHeadersAndSubnetsUpdated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("custom_header.#").HasValue("2"),
+				check.That(data.ResourceName).Key("subnet.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (AzureEndpointResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.AzureEndpointID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.TrafficManager.EndpointsClient.Get(ctx, endpoints.NewEndpointTypeID(id.SubscriptionId, id.ResourceGroup, id.TrafficManagerProfileName, "azureEndpoints", id.Name))
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil && resp.Model.Properties != nil), nil
+}
+
+func (AzureEndpointResource) template(data acceptance.TestData, routingMethod string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-traffic-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_traffic_manager_profile" "test" {
+  name                   = "acctest-TMP-%[1]d"
+  resource_group_name    = azurerm_resource_group.test.name
+  traffic_routing_method = "%[3]s"
+
+  dns_config {
+    relative_name = "acctest-tmp-%[1]d"
+    ttl           = 30
+  }
+
+  monitor_config {
+    protocol = "https"
+    port     = 443
+    path     = "/"
+  }
+}
+
+resource "azurerm_public_ip" "test" {
+  name                = "acctestpublicip-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  allocation_method   = "Static"
+  domain_name_label   = "acctestpublicip-%[1]d"
+}
+`, data.RandomInteger, data.Locations.Primary, routingMethod)
+}
+
+func (r AzureEndpointResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_traffic_manager_azure_endpoint" "test" {
+  name               = "acctestend-azure%d"
+  profile_id         = azurerm_traffic_manager_profile.test.id
+  target_resource_id = azurerm_public_ip.test.id
+  weight             = 3
+}
+`, r.template(data, "Weighted"), data.RandomInteger)
+}
+
+func (r AzureEndpointResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_traffic_manager_azure_endpoint" "import" {
+  name               = azurerm_traffic_manager_azure_endpoint.test.name
+  profile_id         = azurerm_traffic_manager_azure_endpoint.test.profile_id
+  target_resource_id = azurerm_traffic_manager_azure_endpoint.test.target_resource_id
+  weight             = azurerm_traffic_manager_azure_endpoint.test.weight
+}
+`, r.basic(data))
+}
+
+func (r AzureEndpointResource) updated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_traffic_manager_azure_endpoint" "test" {
+  name                 = "acctestend-azure%d"
+  profile_id           = azurerm_traffic_manager_profile.test.id
+  target_resource_id   = azurerm_public_ip.test.id
+  weight               = 5
+  enabled              = false
+  always_serve_enabled = true
+}
+`, r.template(data, "Weighted"), data.RandomInteger)
+}
+
+func (r AzureEndpointResource) geoMappings(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_traffic_manager_azure_endpoint" "test" {
+  name               = "acctestend-azure%d"
+  profile_id         = azurerm_traffic_manager_profile.test.id
+  target_resource_id = azurerm_public_ip.test.id
+  geo_mappings       = ["GB", "FR"]
+}
+`, r.template(data, "Geographic"), data.RandomInteger)
+}
+
+func (r AzureEndpointResource) customHeadersAndSubnets(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_traffic_manager_azure_endpoint" "test" {
+  name               = "acctestend-azure%d"
+  profile_id         = azurerm_traffic_manager_profile.test.id
+  target_resource_id = azurerm_public_ip.test.id
+
+  custom_header {
+    name  = "host"
+    value = "www.example.com"
+  }
+
+  subnet {
+    first = "1.2.3.0"
+    scope = 24
+  }
+
+  subnet {
+    first = "11.12.13.14"
+    last  = "11.12.13.20"
+  }
+}
+`, r.template(data, "Subnet"), data.RandomInteger)
+}
+
+func (r AzureEndpointResource) customHeadersAndSubnetsUpdated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_traffic_manager_azure_endpoint" "test" {
+  name               = "acctestend-azure%d"
+  profile_id         = azurerm_traffic_manager_profile.test.id
+  target_resource_id = azurerm_public_ip.test.id
+
+  custom_header {
+    name  = "host"
+    value = "www.example.org"
+  }
+
+  custom_header {
+    name  = "user-agent"
+    value = "terraform"
+  }
+
+  subnet {
+    first = "0.0.0.0"
+    scope = 0
+  }
+}
+`, r.template(data, "Subnet"), data.RandomInteger)
+}
@@ -0,0 +1,73 @@
+package trafficmanager
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func dataSourceArmTrafficManagerDnsNameAvailability() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceArmTrafficManagerDnsNameAvailabilityRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"relative_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"available": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+
+			"fqdn": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"reason": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"message": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceArmTrafficManagerDnsNameAvailabilityRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).TrafficManager.ProfilesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	relativeName := d.Get("relative_name").(string)
+	availability, err := checkTrafficManagerRelativeDnsNameAvailability(ctx, client, relativeName)
+	if err != nil {
+		return err
+	}
+
+	// this data source doesn't represent an Azure resource, so the FQDN being checked is used as the ID
+	fqdn := fmt.Sprintf("%s.trafficmanager.net", relativeName)
+	d.SetId(fqdn)
+
+	d.Set("available", availability.NameAvailable != nil && *availability.NameAvailable)
+	d.Set("fqdn", fqdn)
+	d.Set("reason", utils.NormalizeNilableString(availability.Reason))
+	d.Set("message", utils.NormalizeNilableString(availability.Message))
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package trafficmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type TrafficManagerDnsNameAvailabilityDataSource struct{}
+
+func TestAccTrafficManagerDnsNameAvailabilityDataSource_available(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_traffic_manager_dns_name_availability", "test")
+	r := TrafficManagerDnsNameAvailabilityDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.available(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("available").HasValue("true"),
+				check.That(data.ResourceName).Key("fqdn").HasValue(fmt.Sprintf("acctest-tmp-%d.trafficmanager.net", data.RandomInteger)),
+			),
+		},
+	})
+}
+
+func TestAccTrafficManagerDnsNameAvailabilityDataSource_taken(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_traffic_manager_dns_name_availability", "test")
+	r := TrafficManagerDnsNameAvailabilityDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.taken(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("available").HasValue("false"),
+				check.That(data.ResourceName).Key("reason").Exists(),
+			),
+		},
+	})
+}
+
+func (TrafficManagerDnsNameAvailabilityDataSource) available(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_traffic_manager_dns_name_availability" "test" {
+  relative_name = "acctest-tmp-%d"
+}
+`, data.RandomInteger)
+}
+
+func (TrafficManagerDnsNameAvailabilityDataSource) taken(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-traffic-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_traffic_manager_profile" "test" {
+  name                   = "acctest-TMP-%[1]d"
+  resource_group_name    = azurerm_resource_group.test.name
+  traffic_routing_method = "Performance"
+
+  dns_config {
+    relative_name = "acctest-tmp-%[1]d"
+    ttl           = 30
+  }
+
+  monitor_config {
+    protocol = "HTTPS"
+    port     = 443
+    path     = "/"
+  }
+}
+
+data "azurerm_traffic_manager_dns_name_availability" "test" {
+  relative_name = azurerm_traffic_manager_profile.test.dns_config.0.relative_name
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
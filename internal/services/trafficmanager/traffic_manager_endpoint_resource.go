@@ -3,15 +3,17 @@ package trafficmanager
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/trafficmanager/mgmt/2018-08-01/trafficmanager"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/trafficmanager/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/trafficmanager/sdk/2022-04-01/endpoints"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -19,7 +21,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
-// TODO: split and deprecate this resource prior to 3.0
+// TODO: remove this resource in 3.0 in favour of the `azurerm_traffic_manager_{azure|external|nested}_endpoint` resources
 
 func resourceArmTrafficManagerEndpoint() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
@@ -30,6 +32,18 @@ func resourceArmTrafficManagerEndpoint() *pluginsdk.Resource {
 		// TODO: replace this with an importer which validates the ID during import
 		Importer: pluginsdk.DefaultImporter(),
 
+		DeprecationMessage: func() string {
+			msg := `The 'azurerm_traffic_manager_endpoint' resource is deprecated in favour of the:
+
+- 'azurerm_traffic_manager_azure_endpoint'
+- 'azurerm_traffic_manager_external_endpoint'
+- 'azurerm_traffic_manager_nested_endpoint'
+
+resources and will be removed in version 3.0 of the Azure Provider.
+`
+			return strings.ReplaceAll(msg, "'", "`")
+		}(),
+
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
@@ -82,8 +96,8 @@ func resourceArmTrafficManagerEndpoint() *pluginsdk.Resource {
 				Optional: true,
 				Computed: true,
 				ValidateFunc: validation.StringInSlice([]string{
-					string(trafficmanager.EndpointStatusDisabled),
-					string(trafficmanager.EndpointStatusEnabled),
+					string(endpoints.EndpointStatusDisabled),
+					string(endpoints.EndpointStatusEnabled),
 				}, true),
 				DiffSuppressFunc: suppress.CaseDifference,
 			},
@@ -206,26 +220,27 @@ func resourceArmTrafficManagerEndpointCreateUpdate(d *pluginsdk.ResourceData, me
 		return err
 	}
 
+	endpointId := endpoints.NewEndpointTypeID(subscriptionId, resourceGroup, profileName, endpointType, name)
 	if d.IsNewResource() {
-		existing, err := client.Get(ctx, resourceGroup, profileName, endpointType, name)
+		existing, err := client.Get(ctx, endpointId)
 		if err != nil {
-			if !utils.ResponseWasNotFound(existing.Response) {
+			if !response.WasNotFound(existing.HttpResponse) {
 				return fmt.Errorf("checking for presence of existing Traffic Manager Endpoint %q (Resource Group %q): %v", name, resourceGroup, err)
 			}
 		}
 
-		if !utils.ResponseWasNotFound(existing.Response) {
+		if !response.WasNotFound(existing.HttpResponse) {
 			return tf.ImportAsExistsError("azurerm_traffic_manager_endpoint", resourceId.ID())
 		}
 	}
 
-	params := trafficmanager.Endpoint{
-		Name:               &name,
-		Type:               &fullEndpointType,
-		EndpointProperties: getArmTrafficManagerEndpointProperties(d),
+	params := endpoints.Endpoint{
+		Name:       &name,
+		Type:       &fullEndpointType,
+		Properties: getArmTrafficManagerEndpointProperties(d),
 	}
 
-	if _, err := client.CreateOrUpdate(ctx, resourceGroup, profileName, endpointType, name, params); err != nil {
+	if _, err := client.CreateOrUpdate(ctx, endpointId, params); err != nil {
 		return fmt.Errorf("creating/updating %s Endpoint %q (Traffic Manager Profile %q / Resource Group %q): %+v", resourceId.EndpointType(), resourceId.Name, resourceId.TrafficManagerProfileName, resourceId.ResourceGroup, err)
 	}
 
@@ -243,9 +258,9 @@ func resourceArmTrafficManagerEndpointRead(d *pluginsdk.ResourceData, meta inter
 		return err
 	}
 
-	resp, err := client.Get(ctx, id.ResourceGroup, id.TrafficManagerProfileName, id.EndpointType(), id.Name)
+	resp, err := client.Get(ctx, endpoints.NewEndpointTypeID(id.SubscriptionId, id.ResourceGroup, id.TrafficManagerProfileName, id.EndpointType(), id.Name))
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if response.WasNotFound(resp.HttpResponse) {
 			d.SetId("")
 			return nil
 		}
@@ -257,24 +272,37 @@ func resourceArmTrafficManagerEndpointRead(d *pluginsdk.ResourceData, meta inter
 	d.Set("type", id.EndpointType())
 	d.Set("profile_name", id.TrafficManagerProfileName)
 
-	if props := resp.EndpointProperties; props != nil {
-		d.Set("endpoint_status", string(props.EndpointStatus))
-		d.Set("target_resource_id", props.TargetResourceID)
-		d.Set("target", props.Target)
-		d.Set("weight", props.Weight)
-		d.Set("priority", props.Priority)
-		d.Set("endpoint_location", props.EndpointLocation)
-		d.Set("endpoint_monitor_status", props.EndpointMonitorStatus)
-
-		d.Set("min_child_endpoints", props.MinChildEndpoints)
-		d.Set("minimum_required_child_endpoints_ipv4", props.MinChildEndpointsIPv4)
-		d.Set("minimum_required_child_endpoints_ipv6", props.MinChildEndpointsIPv6)
-		d.Set("geo_mappings", props.GeoMapping)
-		if err := d.Set("subnet", flattenAzureRMTrafficManagerEndpointSubnetConfig(props.Subnets)); err != nil {
-			return fmt.Errorf("setting `subnet`: %s", err)
-		}
-		if err := d.Set("custom_header", flattenAzureRMTrafficManagerEndpointCustomHeaderConfig(props.CustomHeaders)); err != nil {
-			return fmt.Errorf("setting `custom_header`: %s", err)
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			endpointStatus := ""
+			if props.EndpointStatus != nil {
+				endpointStatus = string(*props.EndpointStatus)
+			}
+			d.Set("endpoint_status", endpointStatus)
+			d.Set("target_resource_id", props.TargetResourceId)
+			d.Set("target", props.Target)
+			d.Set("weight", props.Weight)
+			d.Set("priority", props.Priority)
+			d.Set("endpoint_location", props.EndpointLocation)
+
+			endpointMonitorStatus := ""
+			if props.EndpointMonitorStatus != nil {
+				endpointMonitorStatus = string(*props.EndpointMonitorStatus)
+			}
+			d.Set("endpoint_monitor_status", endpointMonitorStatus)
+
+			d.Set("min_child_endpoints", props.MinChildEndpoints)
+			d.Set("minimum_required_child_endpoints_ipv4", props.MinChildEndpointsIPv4)
+			d.Set("minimum_required_child_endpoints_ipv6", props.MinChildEndpointsIPv6)
+			d.Set("geo_mappings", props.GeoMapping)
+			subnets := orderTrafficManagerListLikeExisting(d.Get("subnet").([]interface{}), flattenTrafficManagerEndpointSubnets(props.Subnets), trafficManagerSubnetKey)
+			if err := d.Set("subnet", subnets); err != nil {
+				return fmt.Errorf("setting `subnet`: %s", err)
+			}
+			customHeaders := orderTrafficManagerListLikeExisting(d.Get("custom_header").([]interface{}), flattenTrafficManagerEndpointCustomHeaders(props.CustomHeaders), trafficManagerCustomHeaderKey)
+			if err := d.Set("custom_header", customHeaders); err != nil {
+				return fmt.Errorf("setting `custom_header`: %s", err)
+			}
 		}
 	}
 	return nil
@@ -290,9 +318,9 @@ func resourceArmTrafficManagerEndpointDelete(d *pluginsdk.ResourceData, meta int
 		return err
 	}
 
-	resp, err := client.Delete(ctx, id.ResourceGroup, id.TrafficManagerProfileName, id.EndpointType(), id.Name)
+	resp, err := client.Delete(ctx, endpoints.NewEndpointTypeID(id.SubscriptionId, id.ResourceGroup, id.TrafficManagerProfileName, id.EndpointType(), id.Name))
 	if err != nil {
-		if !utils.ResponseWasNotFound(resp.Response) {
+		if !response.WasNotFound(resp.HttpResponse) {
 			return fmt.Errorf("deleting Endpoint %q (Traffic Manager Profile %q / Resource Group %q): %+v", id.Name, id.TrafficManagerProfileName, id.ResourceGroup, err)
 		}
 	}
@@ -300,17 +328,17 @@ func resourceArmTrafficManagerEndpointDelete(d *pluginsdk.ResourceData, meta int
 	return nil
 }
 
-func getArmTrafficManagerEndpointProperties(d *pluginsdk.ResourceData) *trafficmanager.EndpointProperties {
+func getArmTrafficManagerEndpointProperties(d *pluginsdk.ResourceData) *endpoints.EndpointProperties {
 	target := d.Get("target").(string)
-	status := d.Get("endpoint_status").(string)
+	status := endpoints.EndpointStatus(d.Get("endpoint_status").(string))
 
-	endpointProps := trafficmanager.EndpointProperties{
+	endpointProps := endpoints.EndpointProperties{
 		Target:         &target,
-		EndpointStatus: trafficmanager.EndpointStatus(status),
+		EndpointStatus: &status,
 	}
 
 	if resourceId := d.Get("target_resource_id").(string); resourceId != "" {
-		endpointProps.TargetResourceID = utils.String(resourceId)
+		endpointProps.TargetResourceId = utils.String(resourceId)
 		// NOTE: Workaround for upstream behaviour: if the target is blank instead of nil, the REST API will throw a 500 error
 		if target == "" {
 			endpointProps.Target = nil
@@ -353,75 +381,13 @@ func getArmTrafficManagerEndpointProperties(d *pluginsdk.ResourceData) *trafficm
 		endpointProps.MinChildEndpointsIPv6 = utils.Int64(int64(minChildEndpointsIPv6))
 	}
 
-	subnetSlice := make([]trafficmanager.EndpointPropertiesSubnetsItem, 0)
-	for _, subnet := range d.Get("subnet").([]interface{}) {
-		subnetBlock := subnet.(map[string]interface{})
-		if subnetBlock["scope"].(int) == 0 && subnetBlock["first"].(string) != "0.0.0.0" {
-			subnetSlice = append(subnetSlice, trafficmanager.EndpointPropertiesSubnetsItem{
-				First: utils.String(subnetBlock["first"].(string)),
-				Last:  utils.String(subnetBlock["last"].(string)),
-			})
-		} else {
-			subnetSlice = append(subnetSlice, trafficmanager.EndpointPropertiesSubnetsItem{
-				First: utils.String(subnetBlock["first"].(string)),
-				Scope: utils.Int32(int32(subnetBlock["scope"].(int))),
-			})
-		}
-	}
-	if len(subnetSlice) > 0 {
-		endpointProps.Subnets = &subnetSlice
+	if subnets := expandTrafficManagerEndpointSubnets(d.Get("subnet").([]interface{})); len(*subnets) > 0 {
+		endpointProps.Subnets = subnets
 	}
 
-	headerSlice := make([]trafficmanager.EndpointPropertiesCustomHeadersItem, 0)
-	for _, header := range d.Get("custom_header").([]interface{}) {
-		headerBlock := header.(map[string]interface{})
-		headerSlice = append(headerSlice, trafficmanager.EndpointPropertiesCustomHeadersItem{
-			Name:  utils.String(headerBlock["name"].(string)),
-			Value: utils.String(headerBlock["value"].(string)),
-		})
-	}
-	if len(headerSlice) > 0 {
-		endpointProps.CustomHeaders = &headerSlice
+	if customHeaders := expandTrafficManagerEndpointCustomHeaders(d.Get("custom_header").([]interface{})); len(*customHeaders) > 0 {
+		endpointProps.CustomHeaders = customHeaders
 	}
 
 	return &endpointProps
 }
-
-func flattenAzureRMTrafficManagerEndpointSubnetConfig(input *[]trafficmanager.EndpointPropertiesSubnetsItem) []interface{} {
-	result := make([]interface{}, 0)
-	if input == nil {
-		return result
-	}
-	for _, subnet := range *input {
-		flatSubnet := make(map[string]interface{}, 3)
-		if subnet.First != nil {
-			flatSubnet["first"] = *subnet.First
-		}
-		if subnet.Last != nil {
-			flatSubnet["last"] = *subnet.Last
-		}
-		if subnet.Scope != nil {
-			flatSubnet["scope"] = int(*subnet.Scope)
-		}
-		result = append(result, flatSubnet)
-	}
-	return result
-}
-
-func flattenAzureRMTrafficManagerEndpointCustomHeaderConfig(input *[]trafficmanager.EndpointPropertiesCustomHeadersItem) []interface{} {
-	result := make([]interface{}, 0)
-	if input == nil {
-		return result
-	}
-	for _, header := range *input {
-		flatHeader := make(map[string]interface{}, 2)
-		if header.Name != nil {
-			flatHeader["name"] = *header.Name
-		}
-		if header.Value != nil {
-			flatHeader["value"] = *header.Value
-		}
-		result = append(result, flatHeader)
-	}
-	return result
-}
@@ -6,9 +6,11 @@ import (
 	"path"
 	"testing"
 
+	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/trafficmanager/sdk/2022-04-01/endpoints"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
@@ -295,9 +297,9 @@ func (r TrafficManagerEndpointResource) Exists(ctx context.Context, client *clie
 	profileName := state.Attributes["profile_name"]
 	resourceGroup := state.Attributes["resource_group_name"]
 
-	resp, err := client.TrafficManager.EndpointsClient.Get(ctx, resourceGroup, profileName, path.Base(endpointType), name)
+	resp, err := client.TrafficManager.EndpointsClient.Get(ctx, endpoints.NewEndpointTypeID(client.Account.SubscriptionId, resourceGroup, profileName, path.Base(endpointType), name))
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if response.WasNotFound(resp.HttpResponse) {
 			return utils.Bool(false), nil
 		}
 		return nil, fmt.Errorf("retrieving Traffic Manager Endpoint %q (Resource Group %q): %+v", name, resourceGroup, err)
@@ -311,7 +313,7 @@ func (r TrafficManagerEndpointResource) Destroy(ctx context.Context, client *cli
 	profileName := state.Attributes["profile_name"]
 	resourceGroup := state.Attributes["resource_group_name"]
 
-	if _, err := client.TrafficManager.EndpointsClient.Delete(ctx, resourceGroup, profileName, path.Base(endpointType), name); err != nil {
+	if _, err := client.TrafficManager.EndpointsClient.Delete(ctx, endpoints.NewEndpointTypeID(client.Account.SubscriptionId, resourceGroup, profileName, path.Base(endpointType), name)); err != nil {
 		return nil, fmt.Errorf("deleting Traffic Manager Endpoint %q (Resource Group %q): %+v", name, resourceGroup, err)
 	}
 	return utils.Bool(true), nil
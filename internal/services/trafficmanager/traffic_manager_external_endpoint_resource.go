@@ -0,0 +1,314 @@
+package trafficmanager
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	azValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/trafficmanager/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/trafficmanager/sdk/2022-04-01/endpoints"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/trafficmanager/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+const externalEndpointResourceType = "externalEndpoints"
+
+func resourceExternalEndpoint() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceExternalEndpointCreate,
+		Read:   resourceExternalEndpointRead,
+		Update: resourceExternalEndpointUpdate,
+		Delete: resourceExternalEndpointDelete,
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.ExternalEndpointID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"profile_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.TrafficManagerProfileID,
+			},
+
+			"target": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"always_serve_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"weight": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 1000),
+			},
+
+			"priority": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 1000),
+			},
+
+			"endpoint_location": {
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     location.EnhancedValidate,
+				StateFunc:        location.StateFunc,
+				DiffSuppressFunc: location.DiffSuppressFunc,
+			},
+
+			"geo_mappings": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"custom_header": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"value": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
+			"subnet": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"first": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: azValidate.IPv4Address,
+						},
+
+						"last": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: azValidate.IPv4Address,
+						},
+
+						"scope": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 32),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceExternalEndpointCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).TrafficManager.EndpointsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	profileId, err := parse.TrafficManagerProfileID(d.Get("profile_id").(string))
+	if err != nil {
+		return fmt.Errorf("parsing `profile_id`: %+v", err)
+	}
+
+	id := parse.NewExternalEndpointID(profileId.SubscriptionId, profileId.ResourceGroup, profileId.Name, d.Get("name").(string))
+	existing, err := client.Get(ctx, endpoints.NewEndpointTypeID(id.SubscriptionId, id.ResourceGroup, id.TrafficManagerProfileName, externalEndpointResourceType, id.Name))
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_traffic_manager_external_endpoint", id.ID())
+	}
+
+	params := endpoints.Endpoint{
+		Name:       utils.String(id.Name),
+		Type:       utils.String(fmt.Sprintf("Microsoft.Network/trafficManagerProfiles/%s", externalEndpointResourceType)),
+		Properties: expandExternalEndpointProperties(d),
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, endpoints.NewEndpointTypeID(id.SubscriptionId, id.ResourceGroup, id.TrafficManagerProfileName, externalEndpointResourceType, id.Name), params); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceExternalEndpointRead(d, meta)
+}
+
+func resourceExternalEndpointRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).TrafficManager.EndpointsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ExternalEndpointID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, endpoints.NewEndpointTypeID(id.SubscriptionId, id.ResourceGroup, id.TrafficManagerProfileName, externalEndpointResourceType, id.Name))
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("profile_id", parse.NewTrafficManagerProfileID(id.SubscriptionId, id.ResourceGroup, id.TrafficManagerProfileName).ID())
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			d.Set("enabled", props.EndpointStatus != nil && *props.EndpointStatus == endpoints.EndpointStatusEnabled)
+			d.Set("always_serve_enabled", props.AlwaysServe != nil && *props.AlwaysServe == endpoints.AlwaysServeEnabled)
+			d.Set("target", props.Target)
+			d.Set("endpoint_location", location.NormalizeNilable(props.EndpointLocation))
+			d.Set("weight", props.Weight)
+			d.Set("priority", props.Priority)
+			d.Set("geo_mappings", utils.FlattenStringSlice(props.GeoMapping))
+
+			subnets := orderTrafficManagerListLikeExisting(d.Get("subnet").([]interface{}), flattenTrafficManagerEndpointSubnets(props.Subnets), trafficManagerSubnetKey)
+			if err := d.Set("subnet", subnets); err != nil {
+				return fmt.Errorf("setting `subnet`: %+v", err)
+			}
+
+			customHeaders := orderTrafficManagerListLikeExisting(d.Get("custom_header").([]interface{}), flattenTrafficManagerEndpointCustomHeaders(props.CustomHeaders), trafficManagerCustomHeaderKey)
+			if err := d.Set("custom_header", customHeaders); err != nil {
+				return fmt.Errorf("setting `custom_header`: %+v", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceExternalEndpointUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).TrafficManager.EndpointsClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ExternalEndpointID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	params := endpoints.Endpoint{
+		Name:       utils.String(id.Name),
+		Type:       utils.String(fmt.Sprintf("Microsoft.Network/trafficManagerProfiles/%s", externalEndpointResourceType)),
+		Properties: expandExternalEndpointProperties(d),
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, endpoints.NewEndpointTypeID(id.SubscriptionId, id.ResourceGroup, id.TrafficManagerProfileName, externalEndpointResourceType, id.Name), params); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return resourceExternalEndpointRead(d, meta)
+}
+
+func resourceExternalEndpointDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).TrafficManager.EndpointsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ExternalEndpointID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Delete(ctx, endpoints.NewEndpointTypeID(id.SubscriptionId, id.ResourceGroup, id.TrafficManagerProfileName, externalEndpointResourceType, id.Name))
+	if err != nil {
+		if !response.WasNotFound(resp.HttpResponse) {
+			return fmt.Errorf("deleting %s: %+v", *id, err)
+		}
+	}
+
+	return nil
+}
+
+func expandExternalEndpointProperties(d *pluginsdk.ResourceData) *endpoints.EndpointProperties {
+	props := endpoints.EndpointProperties{
+		Target:         utils.String(d.Get("target").(string)),
+		EndpointStatus: expandTrafficManagerEndpointStatus(d.Get("enabled").(bool)),
+		AlwaysServe:    expandTrafficManagerEndpointAlwaysServe(d.Get("always_serve_enabled").(bool)),
+	}
+
+	if endpointLocation := d.Get("endpoint_location").(string); endpointLocation != "" {
+		props.EndpointLocation = utils.String(location.Normalize(endpointLocation))
+	}
+
+	if weight := d.Get("weight").(int); weight != 0 {
+		props.Weight = utils.Int64(int64(weight))
+	}
+
+	if priority := d.Get("priority").(int); priority != 0 {
+		props.Priority = utils.Int64(int64(priority))
+	}
+
+	if geoMappings := utils.ExpandStringSlice(d.Get("geo_mappings").([]interface{})); len(*geoMappings) > 0 {
+		props.GeoMapping = geoMappings
+	}
+
+	if subnets := expandTrafficManagerEndpointSubnets(d.Get("subnet").([]interface{})); len(*subnets) > 0 {
+		props.Subnets = subnets
+	}
+
+	if customHeaders := expandTrafficManagerEndpointCustomHeaders(d.Get("custom_header").([]interface{})); len(*customHeaders) > 0 {
+		props.CustomHeaders = customHeaders
+	}
+
+	return &props
+}
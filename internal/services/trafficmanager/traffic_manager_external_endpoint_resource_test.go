@@ -0,0 +1,249 @@
+package trafficmanager_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/trafficmanager/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/trafficmanager/sdk/2022-04-01/endpoints"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ExternalEndpointResource struct{}
+
+func TestAccExternalEndpoint_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_traffic_manager_external_endpoint", "test")
+	r := ExternalEndpointResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccExternalEndpoint_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_traffic_manager_external_endpoint", "test")
+	r := ExternalEndpointResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccExternalEndpoint_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_traffic_manager_external_endpoint", "test")
+	r := ExternalEndpointResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.updated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("enabled").HasValue("false"),
+				check.That(data.ResourceName).Key("always_serve_enabled").HasValue("true"),
+				check.That(data.ResourceName).Key("target").HasValue("www.example.org"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccExternalEndpoint_customHeadersAndSubnets(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_traffic_manager_external_endpoint", "test")
+	r := ExternalEndpointResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.customHeadersAndSubnets(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("custom_header.#").HasValue("1"),
+				check.That(data.ResourceName).Key("subnet.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.customHeadersAndSubnetsUpdated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("custom_header.#").HasValue("2"),
+				check.That(data.ResourceName).Key("subnet.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (ExternalEndpointResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ExternalEndpointID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.TrafficManager.EndpointsClient.Get(ctx, endpoints.NewEndpointTypeID(id.SubscriptionId, id.ResourceGroup, id.TrafficManagerProfileName, "externalEndpoints", id.Name))
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil && resp.Model.Properties != nil), nil
+}
+
+func (ExternalEndpointResource) template(data acceptance.TestData, routingMethod string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-traffic-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_traffic_manager_profile" "test" {
+  name                   = "acctest-TMP-%[1]d"
+  resource_group_name    = azurerm_resource_group.test.name
+  traffic_routing_method = "%[3]s"
+
+  dns_config {
+    relative_name = "acctest-tmp-%[1]d"
+    ttl           = 30
+  }
+
+  monitor_config {
+    protocol = "https"
+    port     = 443
+    path     = "/"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, routingMethod)
+}
+
+func (r ExternalEndpointResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_traffic_manager_external_endpoint" "test" {
+  name       = "acctestend-external%d"
+  profile_id = azurerm_traffic_manager_profile.test.id
+  target     = "www.example.com"
+  weight     = 3
+}
+`, r.template(data, "Weighted"), data.RandomInteger)
+}
+
+func (r ExternalEndpointResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_traffic_manager_external_endpoint" "import" {
+  name       = azurerm_traffic_manager_external_endpoint.test.name
+  profile_id = azurerm_traffic_manager_external_endpoint.test.profile_id
+  target     = azurerm_traffic_manager_external_endpoint.test.target
+  weight     = azurerm_traffic_manager_external_endpoint.test.weight
+}
+`, r.basic(data))
+}
+
+func (r ExternalEndpointResource) updated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_traffic_manager_external_endpoint" "test" {
+  name                 = "acctestend-external%d"
+  profile_id           = azurerm_traffic_manager_profile.test.id
+  target               = "www.example.org"
+  weight               = 5
+  endpoint_location    = "%s"
+  enabled              = false
+  always_serve_enabled = true
+}
+`, r.template(data, "Weighted"), data.RandomInteger, data.Locations.Secondary)
+}
+
+func (r ExternalEndpointResource) customHeadersAndSubnets(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_traffic_manager_external_endpoint" "test" {
+  name       = "acctestend-external%d"
+  profile_id = azurerm_traffic_manager_profile.test.id
+  target     = "www.example.com"
+
+  custom_header {
+    name  = "host"
+    value = "www.example.com"
+  }
+
+  subnet {
+    first = "1.2.3.0"
+    scope = 24
+  }
+
+  subnet {
+    first = "11.12.13.14"
+    last  = "11.12.13.20"
+  }
+}
+`, r.template(data, "Subnet"), data.RandomInteger)
+}
+
+func (r ExternalEndpointResource) customHeadersAndSubnetsUpdated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_traffic_manager_external_endpoint" "test" {
+  name       = "acctestend-external%d"
+  profile_id = azurerm_traffic_manager_profile.test.id
+  target     = "www.example.com"
+
+  custom_header {
+    name  = "host"
+    value = "www.example.org"
+  }
+
+  custom_header {
+    name  = "user-agent"
+    value = "terraform"
+  }
+
+  subnet {
+    first = "0.0.0.0"
+    scope = 0
+  }
+}
+`, r.template(data, "Subnet"), data.RandomInteger)
+}
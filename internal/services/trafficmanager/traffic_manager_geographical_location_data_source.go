@@ -5,8 +5,8 @@ import (
 	"strings"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/trafficmanager/mgmt/2018-08-01/trafficmanager"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/trafficmanager/sdk/2022-04-01/geographichierarchies"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
@@ -42,9 +42,9 @@ func dataSourceArmTrafficManagerGeographicalLocationRead(d *pluginsdk.ResourceDa
 
 	name := d.Get("name").(string)
 
-	var result *trafficmanager.Region
-	if props := results.GeographicHierarchyProperties; props != nil {
-		if topLevelRegion := props.GeographicHierarchy; topLevelRegion != nil {
+	var result *geographichierarchies.Region
+	if model := results.Model; model != nil && model.Properties != nil {
+		if topLevelRegion := model.Properties.GeographicHierarchy; topLevelRegion != nil {
 			result = topLevelRegion
 			if !geographicalRegionIsMatch(topLevelRegion, name) {
 				result = filterGeographicalRegions(topLevelRegion.Regions, name)
@@ -62,7 +62,7 @@ func dataSourceArmTrafficManagerGeographicalLocationRead(d *pluginsdk.ResourceDa
 	return nil
 }
 
-func filterGeographicalRegions(input *[]trafficmanager.Region, name string) *trafficmanager.Region {
+func filterGeographicalRegions(input *[]geographichierarchies.Region, name string) *geographichierarchies.Region {
 	if regions := input; regions != nil {
 		for _, region := range *regions {
 			if geographicalRegionIsMatch(&region, name) {
@@ -79,6 +79,6 @@ func filterGeographicalRegions(input *[]trafficmanager.Region, name string) *tra
 	return nil
 }
 
-func geographicalRegionIsMatch(input *trafficmanager.Region, name string) bool {
+func geographicalRegionIsMatch(input *geographichierarchies.Region, name string) bool {
 	return strings.EqualFold(*input.Name, name)
 }
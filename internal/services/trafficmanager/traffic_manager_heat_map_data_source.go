@@ -0,0 +1,291 @@
+package trafficmanager
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/trafficmanager/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/trafficmanager/sdk/2022-04-01/heatmaps"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/trafficmanager/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+// the Heat Map API only supports a single Heat Map per Profile
+const trafficManagerHeatMapName = "default"
+
+func dataSourceArmTrafficManagerHeatMap() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceArmTrafficManagerHeatMapRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"profile_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.TrafficManagerProfileID,
+			},
+
+			"top_left": {
+				Type:         pluginsdk.TypeList,
+				Optional:     true,
+				MinItems:     2,
+				MaxItems:     2,
+				RequiredWith: []string{"bottom_right"},
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeFloat,
+					ValidateFunc: validation.FloatBetween(-180, 180),
+				},
+			},
+
+			"bottom_right": {
+				Type:         pluginsdk.TypeList,
+				Optional:     true,
+				MinItems:     2,
+				MaxItems:     2,
+				RequiredWith: []string{"top_left"},
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeFloat,
+					ValidateFunc: validation.FloatBetween(-180, 180),
+				},
+			},
+
+			"start_time": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"end_time": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"endpoint": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"endpoint_id": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"resource_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"traffic_flow": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"source_ip": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"latitude": {
+							Type:     pluginsdk.TypeFloat,
+							Computed: true,
+						},
+
+						"longitude": {
+							Type:     pluginsdk.TypeFloat,
+							Computed: true,
+						},
+
+						"query_experience": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"endpoint_id": {
+										Type:     pluginsdk.TypeInt,
+										Computed: true,
+									},
+
+									"query_count": {
+										Type:     pluginsdk.TypeInt,
+										Computed: true,
+									},
+
+									"latency": {
+										Type:     pluginsdk.TypeFloat,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceArmTrafficManagerHeatMapRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).TrafficManager.HeatMapClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	profileId, err := parse.TrafficManagerProfileID(d.Get("profile_id").(string))
+	if err != nil {
+		return fmt.Errorf("parsing `profile_id`: %+v", err)
+	}
+
+	id := parse.NewHeatMapID(profileId.SubscriptionId, profileId.ResourceGroup, profileId.Name, trafficManagerHeatMapName)
+
+	options := heatmaps.DefaultHeatMapGetOperationOptions()
+	if topLeft := expandTrafficManagerHeatMapCoordinates(d.Get("top_left").([]interface{})); topLeft != nil {
+		options.TopLeft = &topLeft
+	}
+	if bottomRight := expandTrafficManagerHeatMapCoordinates(d.Get("bottom_right").([]interface{})); bottomRight != nil {
+		options.BotRight = &bottomRight
+	}
+
+	resp, err := client.HeatMapGet(ctx, heatmaps.NewTrafficManagerProfileID(id.SubscriptionId, id.ResourceGroup, id.TrafficManagerProfileName), options)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	d.Set("profile_id", profileId.ID())
+
+	startTime := ""
+	endTime := ""
+	endpoints := make([]interface{}, 0)
+	trafficFlows := make([]interface{}, 0)
+	if model := resp.Model; model != nil && model.Properties != nil {
+		props := model.Properties
+		startTime = formatTrafficManagerHeatMapTime(props.StartTime)
+		endTime = formatTrafficManagerHeatMapTime(props.EndTime)
+		endpoints = flattenTrafficManagerHeatMapEndpoints(props.Endpoints)
+		trafficFlows = flattenTrafficManagerHeatMapTrafficFlows(props.TrafficFlows)
+	}
+
+	d.Set("start_time", startTime)
+	d.Set("end_time", endTime)
+
+	if err := d.Set("endpoint", endpoints); err != nil {
+		return fmt.Errorf("setting `endpoint`: %+v", err)
+	}
+
+	if err := d.Set("traffic_flow", trafficFlows); err != nil {
+		return fmt.Errorf("setting `traffic_flow`: %+v", err)
+	}
+
+	return nil
+}
+
+// formatTrafficManagerHeatMapTime normalizes the timestamps returned by the API into RFC3339 format
+func formatTrafficManagerHeatMapTime(input *string) string {
+	if input == nil {
+		return ""
+	}
+
+	if v, err := time.Parse(time.RFC3339, *input); err == nil {
+		return v.Format(time.RFC3339)
+	}
+
+	return *input
+}
+
+func expandTrafficManagerHeatMapCoordinates(input []interface{}) []float64 {
+	if len(input) == 0 {
+		return nil
+	}
+
+	output := make([]float64, 0)
+	for _, v := range input {
+		output = append(output, v.(float64))
+	}
+
+	return output
+}
+
+func flattenTrafficManagerHeatMapEndpoints(input *[]heatmaps.HeatMapEndpoint) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, v := range *input {
+		endpointId := 0
+		if v.EndpointId != nil {
+			endpointId = int(*v.EndpointId)
+		}
+
+		resourceId := ""
+		if v.ResourceId != nil {
+			resourceId = *v.ResourceId
+		}
+
+		results = append(results, map[string]interface{}{
+			"endpoint_id": endpointId,
+			"resource_id": resourceId,
+		})
+	}
+
+	return results
+}
+
+func flattenTrafficManagerHeatMapTrafficFlows(input *[]heatmaps.TrafficFlow) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, v := range *input {
+		sourceIp := ""
+		if v.SourceIP != nil {
+			sourceIp = *v.SourceIP
+		}
+
+		latitude := 0.0
+		if v.Latitude != nil {
+			latitude = *v.Latitude
+		}
+
+		longitude := 0.0
+		if v.Longitude != nil {
+			longitude = *v.Longitude
+		}
+
+		queryExperiences := make([]interface{}, 0)
+		if v.QueryExperiences != nil {
+			for _, experience := range *v.QueryExperiences {
+				endpointId := int(experience.EndpointId)
+				queryCount := int(experience.QueryCount)
+
+				latency := 0.0
+				if experience.Latency != nil {
+					latency = *experience.Latency
+				}
+
+				queryExperiences = append(queryExperiences, map[string]interface{}{
+					"endpoint_id": endpointId,
+					"query_count": queryCount,
+					"latency":     latency,
+				})
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"source_ip":        sourceIp,
+			"latitude":         latitude,
+			"longitude":        longitude,
+			"query_experience": queryExperiences,
+		})
+	}
+
+	return results
+}
@@ -0,0 +1,64 @@
+package trafficmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type TrafficManagerHeatMapDataSource struct{}
+
+func TestAccAzureRMDataSourceTrafficManagerHeatMap_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_traffic_manager_heat_map", "test")
+	r := TrafficManagerHeatMapDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("profile_id").Exists(),
+				check.That(data.ResourceName).Key("start_time").Exists(),
+				check.That(data.ResourceName).Key("end_time").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccAzureRMDataSourceTrafficManagerHeatMap_boundingBox(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_traffic_manager_heat_map", "test")
+	r := TrafficManagerHeatMapDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.boundingBox(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("top_left.#").HasValue("2"),
+				check.That(data.ResourceName).Key("bottom_right.#").HasValue("2"),
+			),
+		},
+	})
+}
+
+func (d TrafficManagerHeatMapDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_traffic_manager_heat_map" "test" {
+  profile_id = azurerm_traffic_manager_profile.test.id
+}
+`, TrafficManagerProfileResource{}.withTrafficView(data, true))
+}
+
+func (d TrafficManagerHeatMapDataSource) boundingBox(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_traffic_manager_heat_map" "test" {
+  profile_id   = azurerm_traffic_manager_profile.test.id
+  top_left     = [10.5, -20.5]
+  bottom_right = [-10.5, 20.5]
+}
+`, TrafficManagerProfileResource{}.withTrafficView(data, true))
+}
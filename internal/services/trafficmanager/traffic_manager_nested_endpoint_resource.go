@@ -0,0 +1,370 @@
+package trafficmanager
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	azValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/trafficmanager/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/trafficmanager/sdk/2022-04-01/endpoints"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/trafficmanager/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+const nestedEndpointResourceType = "nestedEndpoints"
+
+func resourceNestedEndpoint() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceNestedEndpointCreate,
+		Read:   resourceNestedEndpointRead,
+		Update: resourceNestedEndpointUpdate,
+		Delete: resourceNestedEndpointDelete,
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.NestedEndpointID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"profile_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.TrafficManagerProfileID,
+			},
+
+			"target_resource_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.TrafficManagerProfileID,
+			},
+
+			"minimum_child_endpoints": {
+				Type:         pluginsdk.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"minimum_required_child_endpoints_ipv4": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"minimum_required_child_endpoints_ipv6": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"always_serve_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"weight": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 1000),
+			},
+
+			"priority": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 1000),
+			},
+
+			"endpoint_location": {
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     location.EnhancedValidate,
+				StateFunc:        location.StateFunc,
+				DiffSuppressFunc: location.DiffSuppressFunc,
+			},
+
+			"geo_mappings": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"custom_header": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"value": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
+			"subnet": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"first": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: azValidate.IPv4Address,
+						},
+
+						"last": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: azValidate.IPv4Address,
+						},
+
+						"scope": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 32),
+						},
+					},
+				},
+			},
+		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, d *pluginsdk.ResourceDiff, v interface{}) error {
+			return validateNestedEndpointMinimumChildEndpoints(d)
+		}),
+	}
+}
+
+func resourceNestedEndpointCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).TrafficManager.EndpointsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	profileId, err := parse.TrafficManagerProfileID(d.Get("profile_id").(string))
+	if err != nil {
+		return fmt.Errorf("parsing `profile_id`: %+v", err)
+	}
+
+	id := parse.NewNestedEndpointID(profileId.SubscriptionId, profileId.ResourceGroup, profileId.Name, d.Get("name").(string))
+	existing, err := client.Get(ctx, endpoints.NewEndpointTypeID(id.SubscriptionId, id.ResourceGroup, id.TrafficManagerProfileName, nestedEndpointResourceType, id.Name))
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_traffic_manager_nested_endpoint", id.ID())
+	}
+
+	params := endpoints.Endpoint{
+		Name:       utils.String(id.Name),
+		Type:       utils.String(fmt.Sprintf("Microsoft.Network/trafficManagerProfiles/%s", nestedEndpointResourceType)),
+		Properties: expandNestedEndpointProperties(d),
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, endpoints.NewEndpointTypeID(id.SubscriptionId, id.ResourceGroup, id.TrafficManagerProfileName, nestedEndpointResourceType, id.Name), params); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceNestedEndpointRead(d, meta)
+}
+
+func resourceNestedEndpointRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).TrafficManager.EndpointsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NestedEndpointID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, endpoints.NewEndpointTypeID(id.SubscriptionId, id.ResourceGroup, id.TrafficManagerProfileName, nestedEndpointResourceType, id.Name))
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("profile_id", parse.NewTrafficManagerProfileID(id.SubscriptionId, id.ResourceGroup, id.TrafficManagerProfileName).ID())
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			d.Set("enabled", props.EndpointStatus != nil && *props.EndpointStatus == endpoints.EndpointStatusEnabled)
+			d.Set("always_serve_enabled", props.AlwaysServe != nil && *props.AlwaysServe == endpoints.AlwaysServeEnabled)
+			d.Set("target_resource_id", props.TargetResourceId)
+			d.Set("minimum_child_endpoints", props.MinChildEndpoints)
+			d.Set("minimum_required_child_endpoints_ipv4", props.MinChildEndpointsIPv4)
+			d.Set("minimum_required_child_endpoints_ipv6", props.MinChildEndpointsIPv6)
+			d.Set("endpoint_location", location.NormalizeNilable(props.EndpointLocation))
+			d.Set("weight", props.Weight)
+			d.Set("priority", props.Priority)
+			d.Set("geo_mappings", utils.FlattenStringSlice(props.GeoMapping))
+
+			subnets := orderTrafficManagerListLikeExisting(d.Get("subnet").([]interface{}), flattenTrafficManagerEndpointSubnets(props.Subnets), trafficManagerSubnetKey)
+			if err := d.Set("subnet", subnets); err != nil {
+				return fmt.Errorf("setting `subnet`: %+v", err)
+			}
+
+			customHeaders := orderTrafficManagerListLikeExisting(d.Get("custom_header").([]interface{}), flattenTrafficManagerEndpointCustomHeaders(props.CustomHeaders), trafficManagerCustomHeaderKey)
+			if err := d.Set("custom_header", customHeaders); err != nil {
+				return fmt.Errorf("setting `custom_header`: %+v", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceNestedEndpointUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).TrafficManager.EndpointsClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NestedEndpointID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	params := endpoints.Endpoint{
+		Name:       utils.String(id.Name),
+		Type:       utils.String(fmt.Sprintf("Microsoft.Network/trafficManagerProfiles/%s", nestedEndpointResourceType)),
+		Properties: expandNestedEndpointProperties(d),
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, endpoints.NewEndpointTypeID(id.SubscriptionId, id.ResourceGroup, id.TrafficManagerProfileName, nestedEndpointResourceType, id.Name), params); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return resourceNestedEndpointRead(d, meta)
+}
+
+func resourceNestedEndpointDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).TrafficManager.EndpointsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NestedEndpointID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Delete(ctx, endpoints.NewEndpointTypeID(id.SubscriptionId, id.ResourceGroup, id.TrafficManagerProfileName, nestedEndpointResourceType, id.Name))
+	if err != nil {
+		if !response.WasNotFound(resp.HttpResponse) {
+			return fmt.Errorf("deleting %s: %+v", *id, err)
+		}
+	}
+
+	return nil
+}
+
+func expandNestedEndpointProperties(d *pluginsdk.ResourceData) *endpoints.EndpointProperties {
+	props := endpoints.EndpointProperties{
+		TargetResourceId:  utils.String(d.Get("target_resource_id").(string)),
+		EndpointStatus:    expandTrafficManagerEndpointStatus(d.Get("enabled").(bool)),
+		AlwaysServe:       expandTrafficManagerEndpointAlwaysServe(d.Get("always_serve_enabled").(bool)),
+		MinChildEndpoints: utils.Int64(int64(d.Get("minimum_child_endpoints").(int))),
+	}
+
+	if minChildEndpointsIPv4 := d.Get("minimum_required_child_endpoints_ipv4").(int); minChildEndpointsIPv4 != 0 {
+		props.MinChildEndpointsIPv4 = utils.Int64(int64(minChildEndpointsIPv4))
+	}
+
+	if minChildEndpointsIPv6 := d.Get("minimum_required_child_endpoints_ipv6").(int); minChildEndpointsIPv6 != 0 {
+		props.MinChildEndpointsIPv6 = utils.Int64(int64(minChildEndpointsIPv6))
+	}
+
+	if endpointLocation := d.Get("endpoint_location").(string); endpointLocation != "" {
+		props.EndpointLocation = utils.String(location.Normalize(endpointLocation))
+	}
+
+	if weight := d.Get("weight").(int); weight != 0 {
+		props.Weight = utils.Int64(int64(weight))
+	}
+
+	if priority := d.Get("priority").(int); priority != 0 {
+		props.Priority = utils.Int64(int64(priority))
+	}
+
+	if geoMappings := utils.ExpandStringSlice(d.Get("geo_mappings").([]interface{})); len(*geoMappings) > 0 {
+		props.GeoMapping = geoMappings
+	}
+
+	if subnets := expandTrafficManagerEndpointSubnets(d.Get("subnet").([]interface{})); len(*subnets) > 0 {
+		props.Subnets = subnets
+	}
+
+	if customHeaders := expandTrafficManagerEndpointCustomHeaders(d.Get("custom_header").([]interface{})); len(*customHeaders) > 0 {
+		props.CustomHeaders = customHeaders
+	}
+
+	return &props
+}
+
+// validateNestedEndpointMinimumChildEndpoints ensures the IPv4/IPv6 specific minimums don't exceed the total number
+// of child endpoints which must be available, since the child profile could otherwise never be considered healthy
+func validateNestedEndpointMinimumChildEndpoints(d *pluginsdk.ResourceDiff) error {
+	if !d.NewValueKnown("minimum_child_endpoints") {
+		return nil
+	}
+	minChildEndpoints := d.Get("minimum_child_endpoints").(int)
+
+	for _, key := range []string{"minimum_required_child_endpoints_ipv4", "minimum_required_child_endpoints_ipv6"} {
+		if !d.NewValueKnown(key) {
+			continue
+		}
+
+		if v := d.Get(key).(int); v > minChildEndpoints {
+			return fmt.Errorf("`%s` (%d) must be less than or equal to `minimum_child_endpoints` (%d)", key, v, minChildEndpoints)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,335 @@
+package trafficmanager_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/trafficmanager/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/trafficmanager/sdk/2022-04-01/endpoints"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type NestedEndpointResource struct{}
+
+func TestAccNestedEndpoint_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_traffic_manager_nested_endpoint", "test")
+	r := NestedEndpointResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccNestedEndpoint_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_traffic_manager_nested_endpoint", "test")
+	r := NestedEndpointResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccNestedEndpoint_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_traffic_manager_nested_endpoint", "test")
+	r := NestedEndpointResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.updated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("enabled").HasValue("false"),
+				check.That(data.ResourceName).Key("minimum_child_endpoints").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccNestedEndpoint_customHeadersAndSubnets(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_traffic_manager_nested_endpoint", "test")
+	r := NestedEndpointResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.customHeadersAndSubnets(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("custom_header.#").HasValue("1"),
+				check.That(data.ResourceName).Key("subnet.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.customHeadersAndSubnetsUpdated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("custom_header.#").HasValue("2"),
+				check.That(data.ResourceName).Key("subnet.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccNestedEndpoint_minimumRequiredChildEndpoints(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_traffic_manager_nested_endpoint", "test")
+	r := NestedEndpointResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.minimumRequiredChildEndpoints(data, 1, 2, 1),
+			ExpectError: regexp.MustCompile("`minimum_required_child_endpoints_ipv4` \\(2\\) must be less than or equal to `minimum_child_endpoints` \\(1\\)"),
+		},
+		{
+			Config: r.minimumRequiredChildEndpoints(data, 2, 2, 1),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("minimum_required_child_endpoints_ipv4").HasValue("2"),
+				check.That(data.ResourceName).Key("minimum_required_child_endpoints_ipv6").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.minimumRequiredChildEndpoints(data, 2, 1, 2),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("minimum_required_child_endpoints_ipv4").HasValue("1"),
+				check.That(data.ResourceName).Key("minimum_required_child_endpoints_ipv6").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (NestedEndpointResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.NestedEndpointID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.TrafficManager.EndpointsClient.Get(ctx, endpoints.NewEndpointTypeID(id.SubscriptionId, id.ResourceGroup, id.TrafficManagerProfileName, "nestedEndpoints", id.Name))
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil && resp.Model.Properties != nil), nil
+}
+
+func (NestedEndpointResource) template(data acceptance.TestData, routingMethod string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-traffic-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_traffic_manager_profile" "parent" {
+  name                   = "acctest-TMP-parent-%[1]d"
+  resource_group_name    = azurerm_resource_group.test.name
+  traffic_routing_method = "%[3]s"
+
+  dns_config {
+    relative_name = "acctest-tmp-parent-%[1]d"
+    ttl           = 30
+  }
+
+  monitor_config {
+    protocol = "https"
+    port     = 443
+    path     = "/"
+  }
+}
+
+resource "azurerm_traffic_manager_profile" "child" {
+  name                   = "acctest-TMP-child-%[1]d"
+  resource_group_name    = azurerm_resource_group.test.name
+  traffic_routing_method = "Priority"
+
+  dns_config {
+    relative_name = "acctest-tmp-child-%[1]d"
+    ttl           = 30
+  }
+
+  monitor_config {
+    protocol = "https"
+    port     = 443
+    path     = "/"
+  }
+}
+
+resource "azurerm_traffic_manager_external_endpoint" "first" {
+  name       = "acctestend-first%[1]d"
+  profile_id = azurerm_traffic_manager_profile.child.id
+  target     = "www.example.com"
+  priority   = 1
+}
+
+resource "azurerm_traffic_manager_external_endpoint" "second" {
+  name       = "acctestend-second%[1]d"
+  profile_id = azurerm_traffic_manager_profile.child.id
+  target     = "www.example.org"
+  priority   = 2
+}
+`, data.RandomInteger, data.Locations.Primary, routingMethod)
+}
+
+func (r NestedEndpointResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_traffic_manager_nested_endpoint" "test" {
+  name                    = "acctestend-nested%d"
+  profile_id              = azurerm_traffic_manager_profile.parent.id
+  target_resource_id      = azurerm_traffic_manager_profile.child.id
+  minimum_child_endpoints = 1
+  priority                = 1
+  endpoint_location       = azurerm_resource_group.test.location
+}
+`, r.template(data, "Priority"), data.RandomInteger)
+}
+
+func (r NestedEndpointResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_traffic_manager_nested_endpoint" "import" {
+  name                    = azurerm_traffic_manager_nested_endpoint.test.name
+  profile_id              = azurerm_traffic_manager_nested_endpoint.test.profile_id
+  target_resource_id      = azurerm_traffic_manager_nested_endpoint.test.target_resource_id
+  minimum_child_endpoints = azurerm_traffic_manager_nested_endpoint.test.minimum_child_endpoints
+  priority                = azurerm_traffic_manager_nested_endpoint.test.priority
+  endpoint_location       = azurerm_traffic_manager_nested_endpoint.test.endpoint_location
+}
+`, r.basic(data))
+}
+
+func (r NestedEndpointResource) updated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_traffic_manager_nested_endpoint" "test" {
+  name                    = "acctestend-nested%d"
+  profile_id              = azurerm_traffic_manager_profile.parent.id
+  target_resource_id      = azurerm_traffic_manager_profile.child.id
+  minimum_child_endpoints = 2
+  priority                = 2
+  endpoint_location       = azurerm_resource_group.test.location
+  enabled                 = false
+}
+`, r.template(data, "Priority"), data.RandomInteger)
+}
+
+func (r NestedEndpointResource) minimumRequiredChildEndpoints(data acceptance.TestData, minimum, minimumIPv4, minimumIPv6 int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_traffic_manager_nested_endpoint" "test" {
+  name                                  = "acctestend-nested%d"
+  profile_id                            = azurerm_traffic_manager_profile.parent.id
+  target_resource_id                    = azurerm_traffic_manager_profile.child.id
+  minimum_child_endpoints               = %d
+  minimum_required_child_endpoints_ipv4 = %d
+  minimum_required_child_endpoints_ipv6 = %d
+  priority                              = 1
+  endpoint_location                     = azurerm_resource_group.test.location
+}
+`, r.template(data, "Priority"), data.RandomInteger, minimum, minimumIPv4, minimumIPv6)
+}
+
+func (r NestedEndpointResource) customHeadersAndSubnets(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_traffic_manager_nested_endpoint" "test" {
+  name                    = "acctestend-nested%d"
+  profile_id              = azurerm_traffic_manager_profile.parent.id
+  target_resource_id      = azurerm_traffic_manager_profile.child.id
+  minimum_child_endpoints = 1
+  endpoint_location       = azurerm_resource_group.test.location
+
+  custom_header {
+    name  = "host"
+    value = "www.example.com"
+  }
+
+  subnet {
+    first = "1.2.3.0"
+    scope = 24
+  }
+
+  subnet {
+    first = "11.12.13.14"
+    last  = "11.12.13.20"
+  }
+}
+`, r.template(data, "Subnet"), data.RandomInteger)
+}
+
+func (r NestedEndpointResource) customHeadersAndSubnetsUpdated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_traffic_manager_nested_endpoint" "test" {
+  name                    = "acctestend-nested%d"
+  profile_id              = azurerm_traffic_manager_profile.parent.id
+  target_resource_id      = azurerm_traffic_manager_profile.child.id
+  minimum_child_endpoints = 1
+  endpoint_location       = azurerm_resource_group.test.location
+
+  custom_header {
+    name  = "host"
+    value = "www.example.org"
+  }
+
+  custom_header {
+    name  = "user-agent"
+    value = "terraform"
+  }
+
+  subnet {
+    first = "0.0.0.0"
+    scope = 0
+  }
+}
+`, r.template(data, "Subnet"), data.RandomInteger)
+}
@@ -0,0 +1,80 @@
+package trafficmanager
+
+import (
+	"fmt"
+	"sort"
+)
+
+// the Traffic Manager API doesn't guarantee the order in which the custom headers, subnets and expected status
+// code ranges are returned - as such these are sorted when flattening so that the values exposed (and in particular
+// those exposed by the Data Sources) are stable across reads
+
+func sortTrafficManagerFlattenedList(input []interface{}, key func(interface{}) string) []interface{} {
+	sort.SliceStable(input, func(i, j int) bool {
+		return key(input[i]) < key(input[j])
+	})
+	return input
+}
+
+// orderTrafficManagerListLikeExisting returns the flattened items in the same order as the existing items when both
+// contain the same elements, so that a Resource whose configuration isn't sorted doesn't show a diff
+func orderTrafficManagerListLikeExisting(existing []interface{}, flattened []interface{}, key func(interface{}) string) []interface{} {
+	if len(existing) != len(flattened) {
+		return flattened
+	}
+
+	available := make(map[string][]interface{}, len(flattened))
+	for _, v := range flattened {
+		k := key(v)
+		available[k] = append(available[k], v)
+	}
+
+	output := make([]interface{}, 0, len(flattened))
+	for _, v := range existing {
+		k := key(v)
+		items := available[k]
+		if len(items) == 0 {
+			return flattened
+		}
+		// the existing item is retained, since equivalent items (such as the status code ranges `200` and
+		// `200-200`) share the same key
+		output = append(output, v)
+		available[k] = items[1:]
+	}
+
+	return output
+}
+
+func trafficManagerCustomHeaderKey(input interface{}) string {
+	raw, ok := input.(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	return fmt.Sprintf("%v\x00%v", raw["name"], raw["value"])
+}
+
+func trafficManagerSubnetKey(input interface{}) string {
+	raw, ok := input.(map[string]interface{})
+	if !ok {
+		return ""
+	}
+
+	first, _ := raw["first"].(string)
+	last, _ := raw["last"].(string)
+	scope, _ := raw["scope"].(int)
+	return fmt.Sprintf("%s\x00%s\x00%03d", first, last, scope)
+}
+
+// trafficManagerStatusCodeRangeKey returns the same key for equivalent ranges, such as `200` and `200-200`
+func trafficManagerStatusCodeRangeKey(input interface{}) string {
+	value := fmt.Sprintf("%v", input)
+
+	var min, max int
+	if _, err := fmt.Sscanf(value, "%d-%d", &min, &max); err == nil {
+		return fmt.Sprintf("%03d-%03d", min, max)
+	}
+	if _, err := fmt.Sscanf(value, "%d", &min); err == nil {
+		return fmt.Sprintf("%03d-%03d", min, min)
+	}
+	return value
+}
@@ -0,0 +1,118 @@
+package trafficmanager
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/trafficmanager/sdk/2022-04-01/endpoints"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/trafficmanager/sdk/2022-04-01/profiles"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func TestFlattenAzureRMTrafficManagerEndpointCustomHeaderConfigIsSorted(t *testing.T) {
+	input := []endpoints.EndpointPropertiesCustomHeadersInlined{
+		{Name: utils.String("x-header"), Value: utils.String("b")},
+		{Name: utils.String("host"), Value: utils.String("www.example.com")},
+		{Name: utils.String("x-header"), Value: utils.String("a")},
+	}
+	expected := []interface{}{
+		map[string]interface{}{"name": "host", "value": "www.example.com"},
+		map[string]interface{}{"name": "x-header", "value": "a"},
+		map[string]interface{}{"name": "x-header", "value": "b"},
+	}
+
+	actual := flattenTrafficManagerEndpointCustomHeaders(&input)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
+func TestFlattenAzureRMTrafficManagerEndpointSubnetConfigIsSorted(t *testing.T) {
+	input := []endpoints.EndpointPropertiesSubnetsInlined{
+		{First: utils.String("10.1.0.0"), Scope: utils.Int64(24)},
+		{First: utils.String("10.0.0.0"), Last: utils.String("10.0.0.255")},
+		{First: utils.String("10.0.0.0"), Scope: utils.Int64(16)},
+	}
+	expected := []interface{}{
+		map[string]interface{}{"first": "10.0.0.0", "scope": 16},
+		map[string]interface{}{"first": "10.0.0.0", "last": "10.0.0.255"},
+		map[string]interface{}{"first": "10.1.0.0", "scope": 24},
+	}
+
+	actual := flattenTrafficManagerEndpointSubnets(&input)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
+func TestFlattenAzureRMTrafficManagerProfileMonitorConfigIsSorted(t *testing.T) {
+	protocol := profiles.MonitorProtocolHTTPS
+	input := profiles.MonitorConfig{
+		Protocol:                  &protocol,
+		Port:                      utils.Int64(443),
+		IntervalInSeconds:         utils.Int64(30),
+		TimeoutInSeconds:          utils.Int64(10),
+		ToleratedNumberOfFailures: utils.Int64(3),
+		CustomHeaders: &[]profiles.MonitorConfigCustomHeadersInlined{
+			{Name: utils.String("x-second"), Value: utils.String("2")},
+			{Name: utils.String("x-first"), Value: utils.String("1")},
+		},
+		ExpectedStatusCodeRanges: &[]profiles.MonitorConfigExpectedStatusCodeRangesInlined{
+			{Min: utils.Int64(301), Max: utils.Int64(308)},
+			{Min: utils.Int64(200), Max: utils.Int64(202)},
+			{Min: utils.Int64(90), Max: utils.Int64(99)},
+		},
+	}
+
+	actual := flattenAzureRMTrafficManagerProfileMonitorConfig(&input)[0].(map[string]interface{})
+
+	expectedHeaders := []interface{}{
+		map[string]interface{}{"name": "x-first", "value": "1"},
+		map[string]interface{}{"name": "x-second", "value": "2"},
+	}
+	if !reflect.DeepEqual(expectedHeaders, actual["custom_header"]) {
+		t.Fatalf("Expected %+v but got %+v", expectedHeaders, actual["custom_header"])
+	}
+
+	expectedRanges := []interface{}{"90-99", "200-202", "301-308"}
+	if !reflect.DeepEqual(expectedRanges, actual["expected_status_code_ranges"]) {
+		t.Fatalf("Expected %+v but got %+v", expectedRanges, actual["expected_status_code_ranges"])
+	}
+}
+
+func TestOrderTrafficManagerListLikeExisting(t *testing.T) {
+	flattened := []interface{}{"200-202", "301-308", "404-404"}
+
+	cases := []struct {
+		existing []interface{}
+		expected []interface{}
+	}{
+		{
+			// same elements in a different order retain the existing order
+			existing: []interface{}{"404-404", "200-202", "301-308"},
+			expected: []interface{}{"404-404", "200-202", "301-308"},
+		},
+		{
+			// equivalent elements retain the existing values
+			existing: []interface{}{"404", "200-202", "301-308"},
+			expected: []interface{}{"404", "200-202", "301-308"},
+		},
+		{
+			// a changed element returns the sorted values
+			existing: []interface{}{"404-404", "200-299", "301-308"},
+			expected: flattened,
+		},
+		{
+			// nothing existing (e.g. during import) returns the sorted values
+			existing: []interface{}{},
+			expected: flattened,
+		},
+	}
+
+	for _, v := range cases {
+		actual := orderTrafficManagerListLikeExisting(v.existing, flattened, trafficManagerStatusCodeRangeKey)
+		if !reflect.DeepEqual(v.expected, actual) {
+			t.Fatalf("Expected %+v but got %+v", v.expected, actual)
+		}
+	}
+}
@@ -4,15 +4,16 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/trafficmanager/mgmt/2018-08-01/trafficmanager"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	tagsHelper "github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/trafficmanager/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/trafficmanager/sdk/2022-04-01/profiles"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
-	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
 func dataSourceArmTrafficManagerProfile() *pluginsdk.Resource {
@@ -127,12 +128,17 @@ func dataSourceArmTrafficManagerProfile() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"max_return": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+
 			"traffic_view_enabled": {
 				Type:     pluginsdk.TypeBool,
-				Optional: true,
+				Computed: true,
 			},
 
-			"tags": tags.Schema(),
+			"tags": tags.SchemaDataSource(),
 		},
 	}
 }
@@ -145,28 +151,43 @@ func dataSourceArmTrafficManagerProfileRead(d *pluginsdk.ResourceData, meta inte
 
 	id := parse.NewTrafficManagerProfileID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
 
-	resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	resp, err := client.Get(ctx, profiles.NewTrafficManagerProfileID(id.SubscriptionId, id.ResourceGroup, id.Name))
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if response.WasNotFound(resp.HttpResponse) {
 			return fmt.Errorf("Traffic Manager Profile %q was not found in Resource Group %q", id.Name, id.ResourceGroup)
 		}
-
 		return fmt.Errorf("retrieving Traffic Manager Profile %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
 	}
-	d.SetId(id.ID())
-
-	if profile := resp.ProfileProperties; profile != nil {
-		d.Set("profile_status", profile.ProfileStatus)
-		d.Set("traffic_routing_method", profile.TrafficRoutingMethod)
 
-		d.Set("dns_config", flattenAzureRMTrafficManagerProfileDNSConfig(profile.DNSConfig))
-		d.Set("monitor_config", flattenAzureRMTrafficManagerProfileMonitorConfig(profile.MonitorConfig))
-		d.Set("traffic_view_enabled", profile.TrafficViewEnrollmentStatus == trafficmanager.TrafficViewEnrollmentStatusEnabled)
+	d.SetId(id.ID())
 
-		// fqdn is actually inside DNSConfig, inlined for simpler reference
-		if dns := profile.DNSConfig; dns != nil {
-			d.Set("fqdn", dns.Fqdn)
+	if model := resp.Model; model != nil {
+		if profile := model.Properties; profile != nil {
+			profileStatus := ""
+			if profile.ProfileStatus != nil {
+				profileStatus = string(*profile.ProfileStatus)
+			}
+			d.Set("profile_status", profileStatus)
+
+			trafficRoutingMethod := ""
+			if profile.TrafficRoutingMethod != nil {
+				trafficRoutingMethod = string(*profile.TrafficRoutingMethod)
+			}
+			d.Set("traffic_routing_method", trafficRoutingMethod)
+			d.Set("max_return", profile.MaxReturn)
+
+			d.Set("dns_config", flattenAzureRMTrafficManagerProfileDNSConfig(profile.DnsConfig))
+			d.Set("monitor_config", flattenAzureRMTrafficManagerProfileMonitorConfig(profile.MonitorConfig))
+			d.Set("traffic_view_enabled", profile.TrafficViewEnrollmentStatus != nil && *profile.TrafficViewEnrollmentStatus == profiles.TrafficViewEnrollmentStatusEnabled)
+
+			// fqdn is actually inside DNSConfig, inlined for simpler reference
+			if dns := profile.DnsConfig; dns != nil {
+				d.Set("fqdn", dns.Fqdn)
+			}
 		}
+
+		return tags.FlattenAndSet(d, tagsHelper.Flatten(model.Tags))
 	}
-	return tags.FlattenAndSet(d, resp.Tags)
+
+	return nil
 }
@@ -18,6 +18,11 @@ func TestAccAzureRMDataSourceTrafficManagerProfile(t *testing.T) {
 			Config: TrafficManagerProfileDataSource{}.template(data),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).Key("traffic_routing_method").HasValue("Performance"),
+				check.That(data.ResourceName).Key("fqdn").Exists(),
+				check.That(data.ResourceName).Key("profile_status").HasValue("Enabled"),
+				check.That(data.ResourceName).Key("traffic_view_enabled").HasValue("false"),
+				check.That(data.ResourceName).Key("dns_config.#").HasValue("1"),
+				check.That(data.ResourceName).Key("monitor_config.#").HasValue("1"),
 			),
 		},
 	})
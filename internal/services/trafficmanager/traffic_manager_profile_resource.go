@@ -1,17 +1,21 @@
 package trafficmanager
 
 import (
+	"context"
 	"fmt"
 	"log"
-	"strconv"
 	"strings"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/trafficmanager/mgmt/2018-08-01/trafficmanager"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	tagsHelper "github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/trafficmanager/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/trafficmanager/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/trafficmanager/sdk/2022-04-01/profiles"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/trafficmanager/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -23,12 +27,16 @@ import (
 
 func resourceArmTrafficManagerProfile() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
-		Create: resourceArmTrafficManagerProfileCreate,
-		Read:   resourceArmTrafficManagerProfileRead,
-		Update: resourceArmTrafficManagerProfileUpdate,
-		Delete: resourceArmTrafficManagerProfileDelete,
-		// TODO: replace this with an importer which validates the ID during import
-		Importer: pluginsdk.DefaultImporter(),
+		Create:   resourceArmTrafficManagerProfileCreate,
+		Read:     resourceArmTrafficManagerProfileRead,
+		Update:   resourceArmTrafficManagerProfileUpdate,
+		Delete:   resourceArmTrafficManagerProfileDelete,
+		Importer: pluginsdk.ImporterValidatingResourceIdThen(validateTrafficManagerProfileImportId, importTrafficManagerProfile),
+
+		SchemaVersion: 1,
+		StateUpgraders: pluginsdk.StateUpgrades(map[int]pluginsdk.StateUpgrade{
+			0: migration.TrafficManagerProfileV0ToV1{},
+		}),
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -52,8 +60,8 @@ func resourceArmTrafficManagerProfile() *pluginsdk.Resource {
 				Optional: true,
 				Computed: true,
 				ValidateFunc: validation.StringInSlice([]string{
-					string(trafficmanager.ProfileStatusEnabled),
-					string(trafficmanager.ProfileStatusDisabled),
+					string(profiles.ProfileStatusEnabled),
+					string(profiles.ProfileStatusDisabled),
 				}, true),
 				DiffSuppressFunc: suppress.CaseDifference,
 			},
@@ -62,12 +70,12 @@ func resourceArmTrafficManagerProfile() *pluginsdk.Resource {
 				Type:     pluginsdk.TypeString,
 				Required: true,
 				ValidateFunc: validation.StringInSlice([]string{
-					string(trafficmanager.TrafficRoutingMethodGeographic),
-					string(trafficmanager.TrafficRoutingMethodWeighted),
-					string(trafficmanager.TrafficRoutingMethodPerformance),
-					string(trafficmanager.TrafficRoutingMethodPriority),
-					string(trafficmanager.TrafficRoutingMethodSubnet),
-					string(trafficmanager.TrafficRoutingMethodMultiValue),
+					string(profiles.TrafficRoutingMethodGeographic),
+					string(profiles.TrafficRoutingMethodWeighted),
+					string(profiles.TrafficRoutingMethodPerformance),
+					string(profiles.TrafficRoutingMethodPriority),
+					string(profiles.TrafficRoutingMethodSubnet),
+					string(profiles.TrafficRoutingMethodMultiValue),
 				}, false),
 			},
 
@@ -128,9 +136,9 @@ func resourceArmTrafficManagerProfile() *pluginsdk.Resource {
 							Type:     pluginsdk.TypeString,
 							Required: true,
 							ValidateFunc: validation.StringInSlice([]string{
-								string(trafficmanager.MonitorProtocolHTTP),
-								string(trafficmanager.MonitorProtocolHTTPS),
-								string(trafficmanager.MonitorProtocolTCP),
+								string(profiles.MonitorProtocolHTTP),
+								string(profiles.MonitorProtocolHTTPS),
+								string(profiles.MonitorProtocolTCP),
 							}, true),
 							DiffSuppressFunc: suppress.CaseDifference,
 						},
@@ -188,9 +196,69 @@ func resourceArmTrafficManagerProfile() *pluginsdk.Resource {
 
 			"tags": tags.Schema(),
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, d *pluginsdk.ResourceDiff, v interface{}) error {
+			if err := validateTrafficManagerProfileMaxReturn(d); err != nil {
+				return err
+			}
+
+			return validateTrafficManagerProfileMonitorConfig(d)
+		}),
 	}
 }
 
+func validateTrafficManagerProfileMaxReturn(d *pluginsdk.ResourceDiff) error {
+	if !d.NewValueKnown("traffic_routing_method") || !d.NewValueKnown("max_return") {
+		return nil
+	}
+
+	if d.Get("traffic_routing_method").(string) == string(profiles.TrafficRoutingMethodMultiValue) && d.Get("max_return").(int) == 0 {
+		return fmt.Errorf("`max_return` must be specified when `traffic_routing_method` is set to `MultiValue`")
+	}
+
+	return nil
+}
+
+func validateTrafficManagerProfileMonitorConfig(d *pluginsdk.ResourceDiff) error {
+	if !d.NewValueKnown("monitor_config.0.interval_in_seconds") || !d.NewValueKnown("monitor_config.0.timeout_in_seconds") {
+		return nil
+	}
+
+	if d.Get("monitor_config.0.interval_in_seconds").(int) == 10 && d.Get("monitor_config.0.timeout_in_seconds").(int) == 10 {
+		return fmt.Errorf("`timeout_in_seconds` must be between `5` and `9` when `interval_in_seconds` is set to `10`")
+	}
+
+	return nil
+}
+
+// validateTrafficManagerProfileImportId validates the ID being imported - insensitively, since IDs copied from the
+// Portal use the casing `microsoft.network/trafficmanagerprofiles` rather than the casing the API expects
+func validateTrafficManagerProfileImportId(input string) error {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return err
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return fmt.Errorf("expected the Resource Provider to be `Microsoft.Network` but got %q", id.Provider)
+	}
+
+	_, err = parse.TrafficManagerProfileIDInsensitively(input)
+	return err
+}
+
+// importTrafficManagerProfile rewrites the ID being imported into the casing used by the Provider, so that
+// the imported ID matches the ID of Traffic Manager Profiles created through Terraform
+func importTrafficManagerProfile(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) ([]*pluginsdk.ResourceData, error) {
+	id, err := parse.TrafficManagerProfileIDInsensitively(d.Id())
+	if err != nil {
+		return []*pluginsdk.ResourceData{d}, err
+	}
+
+	d.SetId(id.ID())
+	return []*pluginsdk.ResourceData{d}, nil
+}
+
 func resourceArmTrafficManagerProfileCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).TrafficManager.ProfilesClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
@@ -200,52 +268,62 @@ func resourceArmTrafficManagerProfileCreate(d *pluginsdk.ResourceData, meta inte
 	log.Printf("[INFO] preparing arguments for Traffic Manager Profile creation.")
 
 	resourceId := parse.NewTrafficManagerProfileID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
-	existing, err := client.Get(ctx, resourceId.ResourceGroup, resourceId.Name)
+	profileId := profiles.NewTrafficManagerProfileID(resourceId.SubscriptionId, resourceId.ResourceGroup, resourceId.Name)
+	existing, err := client.Get(ctx, profileId)
 	if err != nil {
-		if !utils.ResponseWasNotFound(existing.Response) {
+		if !response.WasNotFound(existing.HttpResponse) {
 			return fmt.Errorf("checking for presence of existing Traffic Manager Profile %q (Resource Group %q)", resourceId.Name, resourceId.ResourceGroup)
 		}
 	}
 
-	if !utils.ResponseWasNotFound(existing.Response) {
+	if !response.WasNotFound(existing.HttpResponse) {
 		return tf.ImportAsExistsError("azurerm_traffic_manager_profile", resourceId.ID())
 	}
 
+	// the relative name is globally unique within `trafficmanager.net`, so check it's available up-front rather
+	// than failing part-way through the apply
+	relativeName := d.Get("dns_config.0.relative_name").(string)
+	availability, err := checkTrafficManagerRelativeDnsNameAvailability(ctx, client, relativeName)
+	if err != nil {
+		return err
+	}
+	if availability.NameAvailable != nil && !*availability.NameAvailable {
+		return fmt.Errorf("the DNS Relative Name %q for Traffic Manager Profile %q (Resource Group %q) is not available: %s", relativeName, resourceId.Name, resourceId.ResourceGroup, utils.NormalizeNilableString(availability.Message))
+	}
+
+	trafficRoutingMethod := profiles.TrafficRoutingMethod(d.Get("traffic_routing_method").(string))
+
+	monitorConfig, err := expandArmTrafficManagerMonitorConfig(d)
+	if err != nil {
+		return fmt.Errorf("expanding `monitor_config`: %+v", err)
+	}
+
 	// No existing profile - start from a new struct.
-	profile := trafficmanager.Profile{
+	profile := profiles.Profile{
 		Name:     utils.String(resourceId.Name),
 		Location: utils.String("global"), // must be provided in request
-		ProfileProperties: &trafficmanager.ProfileProperties{
-			TrafficRoutingMethod: trafficmanager.TrafficRoutingMethod(d.Get("traffic_routing_method").(string)),
-			DNSConfig:            expandArmTrafficManagerDNSConfig(d),
-			MonitorConfig:        expandArmTrafficManagerMonitorConfig(d),
+		Properties: &profiles.ProfileProperties{
+			TrafficRoutingMethod: &trafficRoutingMethod,
+			DnsConfig:            expandArmTrafficManagerDNSConfig(d),
+			MonitorConfig:        monitorConfig,
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: tagsHelper.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
 	if maxReturn, ok := d.GetOk("max_return"); ok {
-		profile.MaxReturn = utils.Int64(int64(maxReturn.(int)))
+		profile.Properties.MaxReturn = utils.Int64(int64(maxReturn.(int)))
 	}
 
 	if status, ok := d.GetOk("profile_status"); ok {
-		profile.ProfileStatus = trafficmanager.ProfileStatus(status.(string))
+		profileStatus := profiles.ProfileStatus(status.(string))
+		profile.Properties.ProfileStatus = &profileStatus
 	}
 
 	if trafficViewStatus, ok := d.GetOk("traffic_view_enabled"); ok {
-		profile.TrafficViewEnrollmentStatus = expandArmTrafficManagerTrafficView(trafficViewStatus.(bool))
-	}
-
-	if profile.ProfileProperties.TrafficRoutingMethod == trafficmanager.TrafficRoutingMethodMultiValue &&
-		profile.ProfileProperties.MaxReturn == nil {
-		return fmt.Errorf("`max_return` must be specified when `traffic_routing_method` is set to `MultiValue`")
+		profile.Properties.TrafficViewEnrollmentStatus = expandArmTrafficManagerTrafficView(trafficViewStatus.(bool))
 	}
 
-	if *profile.ProfileProperties.MonitorConfig.IntervalInSeconds == int64(10) &&
-		*profile.ProfileProperties.MonitorConfig.TimeoutInSeconds == int64(10) {
-		return fmt.Errorf("`timeout_in_seconds` must be between `5` and `9` when `interval_in_seconds` is set to `10`")
-	}
-
-	if _, err := client.CreateOrUpdate(ctx, resourceId.ResourceGroup, resourceId.Name, profile); err != nil {
+	if _, err := client.CreateOrUpdate(ctx, profileId, profile); err != nil {
 		return fmt.Errorf("creating Traffic Manager Profile %q (Resource Group %q): %+v", resourceId.Name, resourceId.ResourceGroup, err)
 	}
 
@@ -253,6 +331,22 @@ func resourceArmTrafficManagerProfileCreate(d *pluginsdk.ResourceData, meta inte
 	return resourceArmTrafficManagerProfileRead(d, meta)
 }
 
+func checkTrafficManagerRelativeDnsNameAvailability(ctx context.Context, client *profiles.ProfilesClient, relativeName string) (*profiles.TrafficManagerNameAvailability, error) {
+	input := profiles.CheckTrafficManagerRelativeDnsNameAvailabilityParameters{
+		Name: utils.String(relativeName),
+		Type: utils.String("Microsoft.Network/trafficManagerProfiles"),
+	}
+	resp, err := client.CheckTrafficManagerRelativeDnsNameAvailability(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("checking the availability of the Traffic Manager DNS Relative Name %q: %+v", relativeName, err)
+	}
+	if resp.Model == nil {
+		return nil, fmt.Errorf("checking the availability of the Traffic Manager DNS Relative Name %q: model was nil", relativeName)
+	}
+
+	return resp.Model, nil
+}
+
 func resourceArmTrafficManagerProfileRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).TrafficManager.ProfilesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
@@ -263,9 +357,9 @@ func resourceArmTrafficManagerProfileRead(d *pluginsdk.ResourceData, meta interf
 		return err
 	}
 
-	resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	resp, err := client.Get(ctx, profiles.NewTrafficManagerProfileID(id.SubscriptionId, id.ResourceGroup, id.Name))
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if response.WasNotFound(resp.HttpResponse) {
 			d.SetId("")
 			return nil
 		}
@@ -275,21 +369,45 @@ func resourceArmTrafficManagerProfileRead(d *pluginsdk.ResourceData, meta interf
 	d.Set("name", id.Name)
 	d.Set("resource_group_name", id.ResourceGroup)
 
-	if profile := resp.ProfileProperties; profile != nil {
-		d.Set("profile_status", profile.ProfileStatus)
-		d.Set("traffic_routing_method", profile.TrafficRoutingMethod)
-		d.Set("max_return", profile.MaxReturn)
+	if model := resp.Model; model != nil {
+		if profile := model.Properties; profile != nil {
+			profileStatus := ""
+			if profile.ProfileStatus != nil {
+				profileStatus = string(*profile.ProfileStatus)
+			}
+			d.Set("profile_status", profileStatus)
 
-		d.Set("dns_config", flattenAzureRMTrafficManagerProfileDNSConfig(profile.DNSConfig))
-		d.Set("monitor_config", flattenAzureRMTrafficManagerProfileMonitorConfig(profile.MonitorConfig))
-		d.Set("traffic_view_enabled", profile.TrafficViewEnrollmentStatus == trafficmanager.TrafficViewEnrollmentStatusEnabled)
+			trafficRoutingMethod := ""
+			if profile.TrafficRoutingMethod != nil {
+				trafficRoutingMethod = string(*profile.TrafficRoutingMethod)
+			}
+			d.Set("traffic_routing_method", trafficRoutingMethod)
+			d.Set("max_return", profile.MaxReturn)
+
+			d.Set("dns_config", flattenAzureRMTrafficManagerProfileDNSConfig(profile.DnsConfig))
+			monitorConfig := flattenAzureRMTrafficManagerProfileMonitorConfig(profile.MonitorConfig)
+			// retain the configured ordering of the custom headers and status code ranges when only the order differs
+			if existing := d.Get("monitor_config").([]interface{}); len(existing) > 0 && existing[0] != nil {
+				existingConfig := existing[0].(map[string]interface{})
+				flattenedConfig := monitorConfig[0].(map[string]interface{})
+				flattenedConfig["custom_header"] = orderTrafficManagerListLikeExisting(existingConfig["custom_header"].([]interface{}), flattenedConfig["custom_header"].([]interface{}), trafficManagerCustomHeaderKey)
+				if ranges, ok := flattenedConfig["expected_status_code_ranges"].([]interface{}); ok {
+					flattenedConfig["expected_status_code_ranges"] = orderTrafficManagerListLikeExisting(existingConfig["expected_status_code_ranges"].([]interface{}), ranges, trafficManagerStatusCodeRangeKey)
+				}
+			}
+			d.Set("monitor_config", monitorConfig)
+			d.Set("traffic_view_enabled", profile.TrafficViewEnrollmentStatus != nil && *profile.TrafficViewEnrollmentStatus == profiles.TrafficViewEnrollmentStatusEnabled)
 
-		// fqdn is actually inside DNSConfig, inlined for simpler reference
-		if dns := profile.DNSConfig; dns != nil {
-			d.Set("fqdn", dns.Fqdn)
+			// fqdn is actually inside DNSConfig, inlined for simpler reference
+			if dns := profile.DnsConfig; dns != nil {
+				d.Set("fqdn", dns.Fqdn)
+			}
 		}
+
+		return tags.FlattenAndSet(d, tagsHelper.Flatten(model.Tags))
 	}
-	return tags.FlattenAndSet(d, resp.Tags)
+
+	return nil
 }
 
 func resourceArmTrafficManagerProfileUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -302,42 +420,48 @@ func resourceArmTrafficManagerProfileUpdate(d *pluginsdk.ResourceData, meta inte
 		return err
 	}
 
-	update := trafficmanager.Profile{
-		ProfileProperties: &trafficmanager.ProfileProperties{},
+	update := profiles.Profile{
+		Properties: &profiles.ProfileProperties{},
 	}
 	if d.HasChange("tags") {
-		update.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
+		update.Tags = tagsHelper.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
 	if d.HasChange("profile_status") {
-		update.ProfileProperties.ProfileStatus = trafficmanager.ProfileStatus(d.Get("profile_status").(string))
+		profileStatus := profiles.ProfileStatus(d.Get("profile_status").(string))
+		update.Properties.ProfileStatus = &profileStatus
 	}
 
 	if d.HasChange("traffic_routing_method") {
-		update.ProfileProperties.TrafficRoutingMethod = trafficmanager.TrafficRoutingMethod(d.Get("traffic_routing_method").(string))
+		trafficRoutingMethod := profiles.TrafficRoutingMethod(d.Get("traffic_routing_method").(string))
+		update.Properties.TrafficRoutingMethod = &trafficRoutingMethod
 	}
 
 	if d.HasChange("max_return") {
 		if maxReturn, ok := d.GetOk("max_return"); ok {
-			update.MaxReturn = utils.Int64(int64(maxReturn.(int)))
+			update.Properties.MaxReturn = utils.Int64(int64(maxReturn.(int)))
 		}
 	}
 
 	if d.HasChange("dns_config") {
-		update.ProfileProperties.DNSConfig = expandArmTrafficManagerDNSConfig(d)
+		update.Properties.DnsConfig = expandArmTrafficManagerDNSConfig(d)
 	}
 
 	if d.HasChange("monitor_config") {
-		update.ProfileProperties.MonitorConfig = expandArmTrafficManagerMonitorConfig(d)
+		monitorConfig, err := expandArmTrafficManagerMonitorConfig(d)
+		if err != nil {
+			return fmt.Errorf("expanding `monitor_config`: %+v", err)
+		}
+		update.Properties.MonitorConfig = monitorConfig
 	}
 
 	if d.HasChange("traffic_view_enabled") {
 		if trafficViewStatus, ok := d.GetOk("traffic_view_enabled"); ok {
-			update.ProfileProperties.TrafficViewEnrollmentStatus = expandArmTrafficManagerTrafficView(trafficViewStatus.(bool))
+			update.Properties.TrafficViewEnrollmentStatus = expandArmTrafficManagerTrafficView(trafficViewStatus.(bool))
 		}
 	}
 
-	if _, err := client.Update(ctx, id.ResourceGroup, id.Name, update); err != nil {
+	if _, err := client.Update(ctx, profiles.NewTrafficManagerProfileID(id.SubscriptionId, id.ResourceGroup, id.Name), update); err != nil {
 		return fmt.Errorf("updating Traffic Manager Profile %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
 	}
 
@@ -354,9 +478,9 @@ func resourceArmTrafficManagerProfileDelete(d *pluginsdk.ResourceData, meta inte
 		return err
 	}
 
-	resp, err := client.Delete(ctx, id.ResourceGroup, id.Name)
+	resp, err := client.Delete(ctx, profiles.NewTrafficManagerProfileID(id.SubscriptionId, id.ResourceGroup, id.Name))
 	if err != nil {
-		if !utils.ResponseWasNotFound(resp.Response) {
+		if !response.WasNotFound(resp.HttpResponse) {
 			return err
 		}
 	}
@@ -364,14 +488,15 @@ func resourceArmTrafficManagerProfileDelete(d *pluginsdk.ResourceData, meta inte
 	return nil
 }
 
-func expandArmTrafficManagerMonitorConfig(d *pluginsdk.ResourceData) *trafficmanager.MonitorConfig {
+func expandArmTrafficManagerMonitorConfig(d *pluginsdk.ResourceData) (*profiles.MonitorConfig, error) {
 	monitorSets := d.Get("monitor_config").([]interface{})
 	monitor := monitorSets[0].(map[string]interface{})
 
 	customHeaders := expandArmTrafficManagerCustomHeadersConfig(monitor["custom_header"].([]interface{}))
+	protocol := profiles.MonitorProtocol(monitor["protocol"].(string))
 
-	cfg := trafficmanager.MonitorConfig{
-		Protocol:                  trafficmanager.MonitorProtocol(monitor["protocol"].(string)),
+	cfg := profiles.MonitorConfig{
+		Protocol:                  &protocol,
 		CustomHeaders:             customHeaders,
 		Port:                      utils.Int64(int64(monitor["port"].(int))),
 		Path:                      utils.String(monitor["path"].(string)),
@@ -381,32 +506,34 @@ func expandArmTrafficManagerMonitorConfig(d *pluginsdk.ResourceData) *trafficman
 	}
 
 	if v, ok := monitor["expected_status_code_ranges"].([]interface{}); ok {
-		ranges := make([]trafficmanager.MonitorConfigExpectedStatusCodeRangesItem, 0)
+		ranges := make([]profiles.MonitorConfigExpectedStatusCodeRangesInlined, 0)
 		for _, r := range v {
-			parts := strings.Split(r.(string), "-")
-			min, _ := strconv.Atoi(parts[0])
-			max, _ := strconv.Atoi(parts[1])
-			ranges = append(ranges, trafficmanager.MonitorConfigExpectedStatusCodeRangesItem{
-				Min: utils.Int32(int32(min)),
-				Max: utils.Int32(int32(max)),
+			statusCodeRange, err := parse.ParseStatusCodeRange(r.(string))
+			if err != nil {
+				return nil, fmt.Errorf("parsing `expected_status_code_ranges`: %+v", err)
+			}
+
+			ranges = append(ranges, profiles.MonitorConfigExpectedStatusCodeRangesInlined{
+				Min: utils.Int64(int64(statusCodeRange.Min)),
+				Max: utils.Int64(int64(statusCodeRange.Max)),
 			})
 		}
 		cfg.ExpectedStatusCodeRanges = &ranges
 	}
 
-	return &cfg
+	return &cfg, nil
 }
 
-func expandArmTrafficManagerCustomHeadersConfig(d []interface{}) *[]trafficmanager.MonitorConfigCustomHeadersItem {
+func expandArmTrafficManagerCustomHeadersConfig(d []interface{}) *[]profiles.MonitorConfigCustomHeadersInlined {
 	if len(d) == 0 || d[0] == nil {
 		return nil
 	}
 
-	customHeaders := make([]trafficmanager.MonitorConfigCustomHeadersItem, len(d))
+	customHeaders := make([]profiles.MonitorConfigCustomHeadersInlined, len(d))
 
 	for i, v := range d {
 		ch := v.(map[string]interface{})
-		customHeaders[i] = trafficmanager.MonitorConfigCustomHeadersItem{
+		customHeaders[i] = profiles.MonitorConfigCustomHeadersInlined{
 			Name:  utils.String(ch["name"].(string)),
 			Value: utils.String(ch["value"].(string)),
 		}
@@ -415,7 +542,7 @@ func expandArmTrafficManagerCustomHeadersConfig(d []interface{}) *[]trafficmanag
 	return &customHeaders
 }
 
-func flattenArmTrafficManagerCustomHeadersConfig(input *[]trafficmanager.MonitorConfigCustomHeadersItem) []interface{} {
+func flattenArmTrafficManagerCustomHeadersConfig(input *[]profiles.MonitorConfigCustomHeadersInlined) []interface{} {
 	result := make([]interface{}, 0)
 	if input == nil {
 		return result
@@ -427,61 +554,91 @@ func flattenArmTrafficManagerCustomHeadersConfig(input *[]trafficmanager.Monitor
 	}
 
 	for _, v := range headers {
-		header := make(map[string]string, 2)
-		header["name"] = *v.Name
-		header["value"] = *v.Value
+		header := make(map[string]interface{}, 2)
+		if v.Name != nil {
+			header["name"] = *v.Name
+		}
+		if v.Value != nil {
+			header["value"] = *v.Value
+		}
 		result = append(result, header)
 	}
 
-	return result
+	return sortTrafficManagerFlattenedList(result, trafficManagerCustomHeaderKey)
 }
 
-func expandArmTrafficManagerDNSConfig(d *pluginsdk.ResourceData) *trafficmanager.DNSConfig {
+func expandArmTrafficManagerDNSConfig(d *pluginsdk.ResourceData) *profiles.DnsConfig {
 	dnsSets := d.Get("dns_config").([]interface{})
 	dns := dnsSets[0].(map[string]interface{})
 
 	name := dns["relative_name"].(string)
 	ttl := int64(dns["ttl"].(int))
 
-	return &trafficmanager.DNSConfig{
+	return &profiles.DnsConfig{
 		RelativeName: &name,
-		TTL:          &ttl,
+		Ttl:          &ttl,
 	}
 }
 
-func expandArmTrafficManagerTrafficView(s bool) trafficmanager.TrafficViewEnrollmentStatus {
+func expandArmTrafficManagerTrafficView(s bool) *profiles.TrafficViewEnrollmentStatus {
+	status := profiles.TrafficViewEnrollmentStatusDisabled
 	if s {
-		return trafficmanager.TrafficViewEnrollmentStatusEnabled
+		status = profiles.TrafficViewEnrollmentStatusEnabled
 	}
-	return trafficmanager.TrafficViewEnrollmentStatusDisabled
+	return &status
 }
 
-func flattenAzureRMTrafficManagerProfileDNSConfig(dns *trafficmanager.DNSConfig) []interface{} {
+func flattenAzureRMTrafficManagerProfileDNSConfig(dns *profiles.DnsConfig) []interface{} {
+	if dns == nil {
+		return []interface{}{}
+	}
+
 	result := make(map[string]interface{})
 
-	result["relative_name"] = *dns.RelativeName
-	result["ttl"] = int(*dns.TTL)
+	if dns.RelativeName != nil {
+		result["relative_name"] = *dns.RelativeName
+	}
+	if dns.Ttl != nil {
+		result["ttl"] = int(*dns.Ttl)
+	}
 
 	return []interface{}{result}
 }
 
-func flattenAzureRMTrafficManagerProfileMonitorConfig(cfg *trafficmanager.MonitorConfig) []interface{} {
+func flattenAzureRMTrafficManagerProfileMonitorConfig(cfg *profiles.MonitorConfig) []interface{} {
+	if cfg == nil {
+		return []interface{}{}
+	}
+
 	result := make(map[string]interface{})
 
-	result["protocol"] = string(cfg.Protocol)
-	result["port"] = int(*cfg.Port)
+	protocol := ""
+	if cfg.Protocol != nil {
+		protocol = string(*cfg.Protocol)
+	}
+	result["protocol"] = protocol
 	result["custom_header"] = flattenArmTrafficManagerCustomHeadersConfig(cfg.CustomHeaders)
 
+	if cfg.Port != nil {
+		result["port"] = int(*cfg.Port)
+	}
+
 	if cfg.Path != nil {
 		result["path"] = *cfg.Path
 	}
 
-	result["interval_in_seconds"] = int(*cfg.IntervalInSeconds)
-	result["timeout_in_seconds"] = int(*cfg.TimeoutInSeconds)
-	result["tolerated_number_of_failures"] = int(*cfg.ToleratedNumberOfFailures)
+	if cfg.IntervalInSeconds != nil {
+		result["interval_in_seconds"] = int(*cfg.IntervalInSeconds)
+	}
+	if cfg.TimeoutInSeconds != nil {
+		result["timeout_in_seconds"] = int(*cfg.TimeoutInSeconds)
+	}
+	if cfg.ToleratedNumberOfFailures != nil {
+		result["tolerated_number_of_failures"] = int(*cfg.ToleratedNumberOfFailures)
+	}
 
 	if v := cfg.ExpectedStatusCodeRanges; v != nil {
-		ranges := make([]string, 0)
+		ranges := make([]interface{}, 0)
 		for _, r := range *v {
 			if r.Min == nil || r.Max == nil {
 				continue
@@ -489,7 +646,7 @@ func flattenAzureRMTrafficManagerProfileMonitorConfig(cfg *trafficmanager.Monito
 
 			ranges = append(ranges, fmt.Sprintf("%d-%d", *r.Min, *r.Max))
 		}
-		result["expected_status_code_ranges"] = ranges
+		result["expected_status_code_ranges"] = sortTrafficManagerFlattenedList(ranges, trafficManagerStatusCodeRangeKey)
 	}
 
 	return []interface{}{result}
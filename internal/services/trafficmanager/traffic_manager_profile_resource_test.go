@@ -4,11 +4,14 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/trafficmanager/sdk/2022-04-01/profiles"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
@@ -32,6 +35,34 @@ func TestAccAzureRMTrafficManagerProfile_basic(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMTrafficManagerProfile_importInsensitively(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_traffic_manager_profile", "test")
+	r := TrafficManagerProfileResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "Geographic"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			ResourceName:      data.ResourceName,
+			ImportState:       true,
+			ImportStateVerify: true,
+			ImportStateIdFunc: func(state *acceptance.State) (string, error) {
+				rs, ok := state.RootModule().Resources[data.ResourceName]
+				if !ok {
+					return "", fmt.Errorf("%q was not found in the state", data.ResourceName)
+				}
+
+				// IDs copied from the Portal use a lower-cased Resource Provider and Resource Type
+				return strings.Replace(rs.Primary.ID, "/providers/Microsoft.Network/trafficManagerProfiles/", "/providers/microsoft.network/trafficmanagerprofiles/", 1), nil
+			},
+		},
+	})
+}
+
 func TestAccAzureRMTrafficManagerProfile_complete(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_traffic_manager_profile", "test")
 	r := TrafficManagerProfileResource{}
@@ -242,9 +273,9 @@ func (r TrafficManagerProfileResource) Exists(ctx context.Context, client *clien
 	name := state.Attributes["name"]
 	resourceGroup := state.Attributes["resource_group_name"]
 
-	resp, err := client.TrafficManager.ProfilesClient.Get(ctx, resourceGroup, name)
+	resp, err := client.TrafficManager.ProfilesClient.Get(ctx, profiles.NewTrafficManagerProfileID(client.Account.SubscriptionId, resourceGroup, name))
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if response.WasNotFound(resp.HttpResponse) {
 			return utils.Bool(false), nil
 		}
 		return nil, fmt.Errorf("retrieving Traffic Manager Profile %q (Resource Group %q): %+v", name, resourceGroup, err)
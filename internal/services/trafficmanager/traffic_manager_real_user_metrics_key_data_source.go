@@ -0,0 +1,53 @@
+package trafficmanager
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/trafficmanager/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/trafficmanager/sdk/2022-04-01/realusermetrics"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func dataSourceArmTrafficManagerRealUserMetricsKey() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceArmTrafficManagerRealUserMetricsKeyRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"key": {
+				Type:      pluginsdk.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func dataSourceArmTrafficManagerRealUserMetricsKeyRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).TrafficManager.UserMetricsKeysClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewRealUserMetricsKeyID(subscriptionId, trafficManagerRealUserMetricsKeyName)
+
+	resp, err := client.TrafficManagerUserMetricsKeysGet(ctx, realusermetrics.NewSubscriptionID(id.SubscriptionId))
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	if !trafficManagerRealUserMetricsKeyExists(resp.Model) {
+		return fmt.Errorf("%s was not found - Real User Metrics must be enabled for the Subscription, for example using the `azurerm_traffic_manager_real_user_metrics_key` resource", id)
+	}
+
+	d.SetId(id.ID())
+	d.Set("key", resp.Model.Properties.Key)
+
+	return nil
+}
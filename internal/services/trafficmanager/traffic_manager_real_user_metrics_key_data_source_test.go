@@ -0,0 +1,35 @@
+package trafficmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type TrafficManagerRealUserMetricsKeyDataSource struct{}
+
+// this test is run in sequence from TestAccAzureRMTrafficManagerRealUserMetricsKey
+func testAccAzureRMDataSourceTrafficManagerRealUserMetricsKey_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_traffic_manager_real_user_metrics_key", "test")
+
+	data.DataSourceTestInSequence(t, []acceptance.TestStep{
+		{
+			Config: TrafficManagerRealUserMetricsKeyDataSource{}.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("key").Exists(),
+			),
+		},
+	})
+}
+
+func (d TrafficManagerRealUserMetricsKeyDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_traffic_manager_real_user_metrics_key" "test" {
+  depends_on = [azurerm_traffic_manager_real_user_metrics_key.test]
+}
+`, TrafficManagerRealUserMetricsKeyResource{}.basic(data))
+}
@@ -0,0 +1,158 @@
+package trafficmanager
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/trafficmanager/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/trafficmanager/sdk/2022-04-01/realusermetrics"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+// there's a single Real User Metrics Key per Subscription, which is always named `default`
+const trafficManagerRealUserMetricsKeyName = "default"
+
+func resourceArmTrafficManagerRealUserMetricsKey() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceArmTrafficManagerRealUserMetricsKeyCreate,
+		Read:   resourceArmTrafficManagerRealUserMetricsKeyRead,
+		Update: resourceArmTrafficManagerRealUserMetricsKeyUpdate,
+		Delete: resourceArmTrafficManagerRealUserMetricsKeyDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.RealUserMetricsKeyID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"triggers": {
+				Type:     pluginsdk.TypeMap,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"key": {
+				Type:      pluginsdk.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func resourceArmTrafficManagerRealUserMetricsKeyCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).TrafficManager.UserMetricsKeysClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewRealUserMetricsKeyID(subscriptionId, trafficManagerRealUserMetricsKeyName)
+
+	subscription := realusermetrics.NewSubscriptionID(id.SubscriptionId)
+	existing, err := client.TrafficManagerUserMetricsKeysGet(ctx, subscription)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if trafficManagerRealUserMetricsKeyExists(existing.Model) {
+		return tf.ImportAsExistsError("azurerm_traffic_manager_real_user_metrics_key", id.ID())
+	}
+
+	if _, err := client.TrafficManagerUserMetricsKeysCreateOrUpdate(ctx, subscription); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceArmTrafficManagerRealUserMetricsKeyRead(d, meta)
+}
+
+func resourceArmTrafficManagerRealUserMetricsKeyRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).TrafficManager.UserMetricsKeysClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.RealUserMetricsKeyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.TrafficManagerUserMetricsKeysGet(ctx, realusermetrics.NewSubscriptionID(id.SubscriptionId))
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state!", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	if !trafficManagerRealUserMetricsKeyExists(resp.Model) {
+		log.Printf("[DEBUG] %s was not found - removing from state!", *id)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("key", resp.Model.Properties.Key)
+
+	return nil
+}
+
+func resourceArmTrafficManagerRealUserMetricsKeyUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).TrafficManager.UserMetricsKeysClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.RealUserMetricsKeyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// `triggers` is the only updatable field - PUT-ing the key again issues a new one, which rotates it in-place
+	if d.HasChange("triggers") {
+		if _, err := client.TrafficManagerUserMetricsKeysCreateOrUpdate(ctx, realusermetrics.NewSubscriptionID(id.SubscriptionId)); err != nil {
+			return fmt.Errorf("rotating %s: %+v", *id, err)
+		}
+	}
+
+	return resourceArmTrafficManagerRealUserMetricsKeyRead(d, meta)
+}
+
+func resourceArmTrafficManagerRealUserMetricsKeyDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).TrafficManager.UserMetricsKeysClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.RealUserMetricsKeyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// deleting the key disables Real User Metrics for the Subscription
+	if _, err := client.TrafficManagerUserMetricsKeysDelete(ctx, realusermetrics.NewSubscriptionID(id.SubscriptionId)); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+// trafficManagerRealUserMetricsKeyExists returns whether Real User Metrics are enabled, since the API returns
+// an empty key rather than a 404 when they're not
+func trafficManagerRealUserMetricsKeyExists(input *realusermetrics.UserMetricsModel) bool {
+	return input != nil && input.Properties != nil && input.Properties.Key != nil && *input.Properties.Key != ""
+}
@@ -0,0 +1,131 @@
+package trafficmanager_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/trafficmanager/sdk/2022-04-01/realusermetrics"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type TrafficManagerRealUserMetricsKeyResource struct{}
+
+func TestAccAzureRMTrafficManagerRealUserMetricsKey(t *testing.T) {
+	// there's a single Real User Metrics Key per Subscription, so these tests can't be run in parallel
+	acceptance.RunTestsInSequence(t, map[string]map[string]func(t *testing.T){
+		"resource": {
+			"basic":          testAccAzureRMTrafficManagerRealUserMetricsKey_basic,
+			"requiresImport": testAccAzureRMTrafficManagerRealUserMetricsKey_requiresImport,
+			"rotate":         testAccAzureRMTrafficManagerRealUserMetricsKey_rotate,
+		},
+		"dataSource": {
+			"basic": testAccAzureRMDataSourceTrafficManagerRealUserMetricsKey_basic,
+		},
+	})
+}
+
+func testAccAzureRMTrafficManagerRealUserMetricsKey_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_traffic_manager_real_user_metrics_key", "test")
+	r := TrafficManagerRealUserMetricsKeyResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("key").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func testAccAzureRMTrafficManagerRealUserMetricsKey_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_traffic_manager_real_user_metrics_key", "test")
+	r := TrafficManagerRealUserMetricsKeyResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func testAccAzureRMTrafficManagerRealUserMetricsKey_rotate(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_traffic_manager_real_user_metrics_key", "test")
+	r := TrafficManagerRealUserMetricsKeyResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.triggers(data, "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("key").Exists(),
+			),
+		},
+		data.ImportStep("triggers"),
+		{
+			Config: r.triggers(data, "second"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("key").Exists(),
+			),
+		},
+		data.ImportStep("triggers"),
+	})
+}
+
+func (r TrafficManagerRealUserMetricsKeyResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	resp, err := client.TrafficManager.UserMetricsKeysClient.TrafficManagerUserMetricsKeysGet(ctx, realusermetrics.NewSubscriptionID(client.Account.SubscriptionId))
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving Traffic Manager Real User Metrics Key: %+v", err)
+	}
+
+	exists := resp.Model != nil && resp.Model.Properties != nil && resp.Model.Properties.Key != nil && *resp.Model.Properties.Key != ""
+	return utils.Bool(exists), nil
+}
+
+func (r TrafficManagerRealUserMetricsKeyResource) basic(_ acceptance.TestData) string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_traffic_manager_real_user_metrics_key" "test" {}
+`
+}
+
+func (r TrafficManagerRealUserMetricsKeyResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_traffic_manager_real_user_metrics_key" "import" {}
+`, r.basic(data))
+}
+
+func (r TrafficManagerRealUserMetricsKeyResource) triggers(_ acceptance.TestData, rotation string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_traffic_manager_real_user_metrics_key" "test" {
+  triggers = {
+    rotation = %q
+  }
+}
+`, rotation)
+}
@@ -2,8 +2,8 @@ package validate
 
 import (
 	"fmt"
-	"strconv"
-	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/trafficmanager/parse"
 )
 
 func StatusCodeRange(i interface{}, k string) (warnings []string, errors []error) {
@@ -13,20 +13,8 @@ func StatusCodeRange(i interface{}, k string) (warnings []string, errors []error
 		return warnings, errors
 	}
 
-	parts := strings.Split(v, "-")
-	if len(parts) != 2 {
-		errors = append(errors, fmt.Errorf("expected %s to contain a single '-', got %v", k, i))
-		return warnings, errors
-	}
-
-	if _, err := strconv.Atoi(parts[0]); err != nil {
-		errors = append(errors, fmt.Errorf("expected %s on the left of - to be an integer, got %v: %v", k, i, err))
-		return warnings, errors
-	}
-
-	if _, err := strconv.Atoi(parts[1]); err != nil {
-		errors = append(errors, fmt.Errorf("expected %s on the right of - to be an integer, got %v: %v", k, i, err))
-		return warnings, errors
+	if _, err := parse.ParseStatusCodeRange(v); err != nil {
+		errors = append(errors, fmt.Errorf("%s: %+v", k, err))
 	}
 
 	return warnings, errors
@@ -0,0 +1,53 @@
+package validate
+
+import "testing"
+
+func TestStatusCodeRange(t *testing.T) {
+	cases := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			Input: "",
+			Valid: false,
+		},
+		{
+			Input: "200",
+			Valid: true,
+		},
+		{
+			Input: "200-299",
+			Valid: true,
+		},
+		{
+			Input: "200-",
+			Valid: false,
+		},
+		{
+			Input: "abc-299",
+			Valid: false,
+		},
+		{
+			Input: "299-200",
+			Valid: false,
+		},
+		{
+			Input: "0-99",
+			Valid: false,
+		},
+		{
+			Input: "500-600",
+			Valid: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Value %s", tc.Input)
+		_, errors := StatusCodeRange(tc.Input, "test")
+		valid := len(errors) == 0
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t", tc.Valid, valid)
+		}
+	}
+}
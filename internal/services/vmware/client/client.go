@@ -4,28 +4,46 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/vmware/sdk/2020-03-20/authorizations"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/vmware/sdk/2020-03-20/clusters"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/vmware/sdk/2020-03-20/hcxenterprisesites"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/vmware/sdk/2020-03-20/privateclouds"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/vmware/sdk/2021-12-01/addons"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/vmware/sdk/2021-12-01/placementpolicies"
 )
 
 type Client struct {
-	AuthorizationClient *authorizations.AuthorizationsClient
-	ClusterClient       *clusters.ClustersClient
-	PrivateCloudClient  *privateclouds.PrivateCloudsClient
+	AddonClient             *addons.AddonsClient
+	AuthorizationClient     *authorizations.AuthorizationsClient
+	ClusterClient           *clusters.ClustersClient
+	HcxEnterpriseSiteClient *hcxenterprisesites.HcxEnterpriseSitesClient
+	PlacementPolicyClient   *placementpolicies.PlacementPoliciesClient
+	PrivateCloudClient      *privateclouds.PrivateCloudsClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
+	addonClient := addons.NewAddonsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&addonClient.Client, o.ResourceManagerAuthorizer)
+
 	authorizationClient := authorizations.NewAuthorizationsClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&authorizationClient.Client, o.ResourceManagerAuthorizer)
 
 	clusterClient := clusters.NewClustersClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&clusterClient.Client, o.ResourceManagerAuthorizer)
 
+	hcxEnterpriseSiteClient := hcxenterprisesites.NewHcxEnterpriseSitesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&hcxEnterpriseSiteClient.Client, o.ResourceManagerAuthorizer)
+
+	placementPolicyClient := placementpolicies.NewPlacementPoliciesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&placementPolicyClient.Client, o.ResourceManagerAuthorizer)
+
 	privateCloudClient := privateclouds.NewPrivateCloudsClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&privateCloudClient.Client, o.ResourceManagerAuthorizer)
 
 	return &Client{
-		AuthorizationClient: &authorizationClient,
-		ClusterClient:       &clusterClient,
-		PrivateCloudClient:  &privateCloudClient,
+		AddonClient:             &addonClient,
+		AuthorizationClient:     &authorizationClient,
+		ClusterClient:           &clusterClient,
+		HcxEnterpriseSiteClient: &hcxEnterpriseSiteClient,
+		PlacementPolicyClient:   &placementPolicyClient,
+		PrivateCloudClient:      &privateCloudClient,
 	}
 }
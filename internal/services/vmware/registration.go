@@ -29,5 +29,10 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_vmware_private_cloud":               resourceVmwarePrivateCloud(),
 		"azurerm_vmware_cluster":                     resourceVmwareCluster(),
 		"azurerm_vmware_express_route_authorization": resourceVmwareExpressRouteAuthorization(),
+		"azurerm_vmware_hcx_addon":                   resourceVmwareHcxAddon(),
+		"azurerm_vmware_hcx_enterprise_site":         resourceVmwareHcxEnterpriseSite(),
+		"azurerm_vmware_placement_policy":            resourceVmwarePlacementPolicy(),
+		"azurerm_vmware_srm_addon":                   resourceVmwareSrmAddon(),
+		"azurerm_vmware_vr_addon":                    resourceVmwareVrAddon(),
 	}
 }
@@ -0,0 +1,8 @@
+package addons
+
+type Addon struct {
+	Id         *string          `json:"id,omitempty"`
+	Name       *string          `json:"name,omitempty"`
+	Properties *AddonProperties `json:"properties,omitempty"`
+	Type       *string          `json:"type,omitempty"`
+}
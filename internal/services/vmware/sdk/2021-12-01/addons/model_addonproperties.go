@@ -0,0 +1,9 @@
+package addons
+
+type AddonProperties struct {
+	AddonType         AddonType               `json:"addonType"`
+	LicenseKey        *string                 `json:"licenseKey,omitempty"`
+	Offer             *string                 `json:"offer,omitempty"`
+	ProvisioningState *AddonProvisioningState `json:"provisioningState,omitempty"`
+	VrsCount          *int64                  `json:"vrsCount,omitempty"`
+}
@@ -0,0 +1,9 @@
+package addons
+
+import "fmt"
+
+const defaultApiVersion = "2021-12-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/addons/%s", defaultApiVersion)
+}
@@ -0,0 +1,15 @@
+package placementpolicies
+
+import "github.com/Azure/go-autorest/autorest"
+
+type PlacementPoliciesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewPlacementPoliciesClientWithBaseURI(endpoint string) PlacementPoliciesClient {
+	return PlacementPoliciesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,8 @@
+package placementpolicies
+
+type PlacementPolicy struct {
+	Id         *string                    `json:"id,omitempty"`
+	Name       *string                    `json:"name,omitempty"`
+	Properties *PlacementPolicyProperties `json:"properties,omitempty"`
+	Type       *string                    `json:"type,omitempty"`
+}
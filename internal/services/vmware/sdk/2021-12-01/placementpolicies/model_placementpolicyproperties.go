@@ -0,0 +1,11 @@
+package placementpolicies
+
+type PlacementPolicyProperties struct {
+	AffinityType      *AffinityType                     `json:"affinityType,omitempty"`
+	DisplayName       *string                           `json:"displayName,omitempty"`
+	HostMembers       *[]string                         `json:"hostMembers,omitempty"`
+	ProvisioningState *PlacementPolicyProvisioningState `json:"provisioningState,omitempty"`
+	State             *PlacementPolicyState             `json:"state,omitempty"`
+	Type              PlacementPolicyType               `json:"type"`
+	VmMembers         *[]string                         `json:"vmMembers,omitempty"`
+}
@@ -0,0 +1,5 @@
+package placementpolicies
+
+type PlacementPolicyUpdate struct {
+	Properties *PlacementPolicyUpdateProperties `json:"properties,omitempty"`
+}
@@ -0,0 +1,7 @@
+package placementpolicies
+
+type PlacementPolicyUpdateProperties struct {
+	HostMembers *[]string             `json:"hostMembers,omitempty"`
+	State       *PlacementPolicyState `json:"state,omitempty"`
+	VmMembers   *[]string             `json:"vmMembers,omitempty"`
+}
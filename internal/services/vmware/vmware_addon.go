@@ -0,0 +1,27 @@
+package vmware
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/vmware/sdk/2021-12-01/addons"
+)
+
+// each type of Addon can only be installed once within a Private Cloud, under a fixed name
+const (
+	vmwareAddonNameHcx = "hcx"
+	vmwareAddonNameSrm = "srm"
+	vmwareAddonNameVr  = "vr"
+)
+
+func validateVmwareAddonID(input, expectedName string) error {
+	id, err := addons.ParseAddonID(input)
+	if err != nil {
+		return err
+	}
+
+	if id.AddonName != expectedName {
+		return fmt.Errorf("expected the name of the Addon to be %q but got %q", expectedName, id.AddonName)
+	}
+
+	return nil
+}
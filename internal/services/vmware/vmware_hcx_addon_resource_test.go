@@ -0,0 +1,83 @@
+package vmware_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/vmware/sdk/2021-12-01/addons"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type VmwareHcxAddonResource struct {
+}
+
+func TestAccVmwareHcxAddon_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_vmware_hcx_addon", "test")
+	r := VmwareHcxAddonResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccVmwareHcxAddon_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_vmware_hcx_addon", "test")
+	r := VmwareHcxAddonResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (VmwareHcxAddonResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := addons.ParseAddonID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Vmware.AddonClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r VmwareHcxAddonResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vmware_hcx_addon" "test" {
+  private_cloud_id = azurerm_vmware_private_cloud.test.id
+  offer            = "VMware MaaS Cloud Provider"
+}
+`, VmwarePrivateCloudResource{}.basic(data))
+}
+
+func (r VmwareHcxAddonResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vmware_hcx_addon" "import" {
+  private_cloud_id = azurerm_vmware_hcx_addon.test.private_cloud_id
+  offer            = "VMware MaaS Cloud Provider"
+}
+`, r.basic(data))
+}
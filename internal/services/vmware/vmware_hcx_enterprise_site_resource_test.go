@@ -0,0 +1,85 @@
+package vmware_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/vmware/sdk/2020-03-20/hcxenterprisesites"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type VmwareHcxEnterpriseSiteResource struct {
+}
+
+func TestAccVmwareHcxEnterpriseSite_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_vmware_hcx_enterprise_site", "test")
+	r := VmwareHcxEnterpriseSiteResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("activation_key").Exists(),
+				check.That(data.ResourceName).Key("status").HasValue("Available"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccVmwareHcxEnterpriseSite_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_vmware_hcx_enterprise_site", "test")
+	r := VmwareHcxEnterpriseSiteResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (VmwareHcxEnterpriseSiteResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := hcxenterprisesites.ParseHcxEnterpriseSiteID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Vmware.HcxEnterpriseSiteClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r VmwareHcxEnterpriseSiteResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vmware_hcx_enterprise_site" "test" {
+  name             = "acctest-HcxSite-%d"
+  private_cloud_id = azurerm_vmware_hcx_addon.test.private_cloud_id
+}
+`, VmwareHcxAddonResource{}.basic(data), data.RandomInteger)
+}
+
+func (r VmwareHcxEnterpriseSiteResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vmware_hcx_enterprise_site" "import" {
+  name             = azurerm_vmware_hcx_enterprise_site.test.name
+  private_cloud_id = azurerm_vmware_hcx_enterprise_site.test.private_cloud_id
+}
+`, r.basic(data))
+}
@@ -0,0 +1,275 @@
+package vmware
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/vmware/sdk/2020-03-20/clusters"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/vmware/sdk/2021-12-01/placementpolicies"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/vmware/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceVmwarePlacementPolicy() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceVmwarePlacementPolicyCreate,
+		Read:   resourceVmwarePlacementPolicyRead,
+		Update: resourceVmwarePlacementPolicyUpdate,
+		Delete: resourceVmwarePlacementPolicyDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := placementpolicies.ParsePlacementPolicyID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"cluster_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ClusterID,
+			},
+
+			"type": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(placementpolicies.PlacementPolicyTypeVmHost),
+					string(placementpolicies.PlacementPolicyTypeVmVm),
+				}, false),
+			},
+
+			"affinity_type": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(placementpolicies.AffinityTypeAffinity),
+					string(placementpolicies.AffinityTypeAntiAffinity),
+				}, false),
+			},
+
+			"virtual_machine_ids": {
+				Type:     pluginsdk.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"host_names": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"display_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func resourceVmwarePlacementPolicyCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	client := meta.(*clients.Client).Vmware.PlacementPolicyClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	clusterId, err := clusters.ParseClusterID(d.Get("cluster_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := placementpolicies.NewPlacementPolicyID(subscriptionId, clusterId.ResourceGroupName, clusterId.PrivateCloudName, clusterId.ClusterName, d.Get("name").(string))
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_vmware_placement_policy", id.ID())
+	}
+
+	policyType := placementpolicies.PlacementPolicyType(d.Get("type").(string))
+	hostNames := d.Get("host_names").(*pluginsdk.Set).List()
+	if policyType == placementpolicies.PlacementPolicyTypeVmHost && len(hostNames) == 0 {
+		return fmt.Errorf("`host_names` must be specified when `type` is %q", string(placementpolicies.PlacementPolicyTypeVmHost))
+	}
+	if policyType == placementpolicies.PlacementPolicyTypeVmVm && len(hostNames) > 0 {
+		return fmt.Errorf("`host_names` cannot be specified when `type` is %q", string(placementpolicies.PlacementPolicyTypeVmVm))
+	}
+
+	affinityType := placementpolicies.AffinityType(d.Get("affinity_type").(string))
+	state := expandVmwarePlacementPolicyState(d.Get("enabled").(bool))
+	policy := placementpolicies.PlacementPolicy{
+		Properties: &placementpolicies.PlacementPolicyProperties{
+			AffinityType: &affinityType,
+			State:        &state,
+			Type:         policyType,
+			VmMembers:    utils.ExpandStringSlice(d.Get("virtual_machine_ids").(*pluginsdk.Set).List()),
+		},
+	}
+
+	if policyType == placementpolicies.PlacementPolicyTypeVmHost {
+		policy.Properties.HostMembers = utils.ExpandStringSlice(hostNames)
+	}
+
+	if v, ok := d.GetOk("display_name"); ok {
+		policy.Properties.DisplayName = utils.String(v.(string))
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, id, policy); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceVmwarePlacementPolicyRead(d, meta)
+}
+
+func resourceVmwarePlacementPolicyRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Vmware.PlacementPolicyClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := placementpolicies.ParsePlacementPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[INFO] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.PlacementPolicyName)
+	d.Set("cluster_id", clusters.NewClusterID(id.SubscriptionId, id.ResourceGroupName, id.PrivateCloudName, id.ClusterName).ID())
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			d.Set("type", string(props.Type))
+			d.Set("display_name", props.DisplayName)
+
+			affinityType := ""
+			if props.AffinityType != nil {
+				affinityType = string(*props.AffinityType)
+			}
+			d.Set("affinity_type", affinityType)
+			d.Set("enabled", props.State != nil && *props.State == placementpolicies.PlacementPolicyStateEnabled)
+
+			if err := d.Set("virtual_machine_ids", utils.FlattenStringSlice(props.VmMembers)); err != nil {
+				return fmt.Errorf("setting `virtual_machine_ids`: %+v", err)
+			}
+			if err := d.Set("host_names", utils.FlattenStringSlice(props.HostMembers)); err != nil {
+				return fmt.Errorf("setting `host_names`: %+v", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceVmwarePlacementPolicyUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Vmware.PlacementPolicyClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := placementpolicies.ParsePlacementPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	update := placementpolicies.PlacementPolicyUpdate{
+		Properties: &placementpolicies.PlacementPolicyUpdateProperties{},
+	}
+
+	if d.HasChange("enabled") {
+		state := expandVmwarePlacementPolicyState(d.Get("enabled").(bool))
+		update.Properties.State = &state
+	}
+
+	if d.HasChange("virtual_machine_ids") {
+		update.Properties.VmMembers = utils.ExpandStringSlice(d.Get("virtual_machine_ids").(*pluginsdk.Set).List())
+	}
+
+	if d.HasChange("host_names") {
+		hostNames := d.Get("host_names").(*pluginsdk.Set).List()
+		if d.Get("type").(string) == string(placementpolicies.PlacementPolicyTypeVmHost) && len(hostNames) == 0 {
+			return fmt.Errorf("`host_names` must be specified when `type` is %q", string(placementpolicies.PlacementPolicyTypeVmHost))
+		}
+		update.Properties.HostMembers = utils.ExpandStringSlice(hostNames)
+	}
+
+	if err := client.UpdateThenPoll(ctx, *id, update); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return resourceVmwarePlacementPolicyRead(d, meta)
+}
+
+func resourceVmwarePlacementPolicyDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Vmware.PlacementPolicyClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := placementpolicies.ParsePlacementPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandVmwarePlacementPolicyState(enabled bool) placementpolicies.PlacementPolicyState {
+	if enabled {
+		return placementpolicies.PlacementPolicyStateEnabled
+	}
+	return placementpolicies.PlacementPolicyStateDisabled
+}
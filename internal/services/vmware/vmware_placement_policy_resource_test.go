@@ -0,0 +1,133 @@
+package vmware_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/vmware/sdk/2021-12-01/placementpolicies"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type VmwarePlacementPolicyResource struct {
+}
+
+// the Virtual Machines within a Placement Policy are provisioned within vCenter, rather than through the
+// Azure API - as such these tests require an existing Cluster containing (at least) two Virtual Machines
+func skipVmwarePlacementPolicyTests(t *testing.T) {
+	if os.Getenv("ARM_TEST_VMWARE_CLUSTER_ID") == "" || os.Getenv("ARM_TEST_VMWARE_VIRTUAL_MACHINE_IDS") == "" {
+		t.Skip("Skipping as `ARM_TEST_VMWARE_CLUSTER_ID` and `ARM_TEST_VMWARE_VIRTUAL_MACHINE_IDS` are not specified")
+	}
+}
+
+func TestAccVmwarePlacementPolicy_basic(t *testing.T) {
+	skipVmwarePlacementPolicyTests(t)
+	data := acceptance.BuildTestData(t, "azurerm_vmware_placement_policy", "test")
+	r := VmwarePlacementPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccVmwarePlacementPolicy_update(t *testing.T) {
+	skipVmwarePlacementPolicyTests(t)
+	data := acceptance.BuildTestData(t, "azurerm_vmware_placement_policy", "test")
+	r := VmwarePlacementPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("enabled").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccVmwarePlacementPolicy_requiresImport(t *testing.T) {
+	skipVmwarePlacementPolicyTests(t)
+	data := acceptance.BuildTestData(t, "azurerm_vmware_placement_policy", "test")
+	r := VmwarePlacementPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (VmwarePlacementPolicyResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := placementpolicies.ParsePlacementPolicyID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Vmware.PlacementPolicyClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (VmwarePlacementPolicyResource) basic(data acceptance.TestData, enabled bool) string {
+	virtualMachineIds := make([]string, 0)
+	for _, v := range strings.Split(os.Getenv("ARM_TEST_VMWARE_VIRTUAL_MACHINE_IDS"), ",") {
+		virtualMachineIds = append(virtualMachineIds, fmt.Sprintf("%q", strings.TrimSpace(v)))
+	}
+
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_vmware_placement_policy" "test" {
+  name                = "acctest-policy-%d"
+  cluster_id          = %q
+  type                = "VmVm"
+  affinity_type       = "AntiAffinity"
+  virtual_machine_ids = [%s]
+  enabled             = %t
+}
+`, data.RandomInteger, os.Getenv("ARM_TEST_VMWARE_CLUSTER_ID"), strings.Join(virtualMachineIds, ", "), enabled)
+}
+
+func (r VmwarePlacementPolicyResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vmware_placement_policy" "import" {
+  name                = azurerm_vmware_placement_policy.test.name
+  cluster_id          = azurerm_vmware_placement_policy.test.cluster_id
+  type                = azurerm_vmware_placement_policy.test.type
+  affinity_type       = azurerm_vmware_placement_policy.test.affinity_type
+  virtual_machine_ids = azurerm_vmware_placement_policy.test.virtual_machine_ids
+}
+`, r.basic(data, true))
+}
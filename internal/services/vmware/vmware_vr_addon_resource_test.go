@@ -0,0 +1,127 @@
+package vmware_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/vmware/sdk/2021-12-01/addons"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type VmwareVrAddonResource struct {
+}
+
+func TestAccVmwareVrAddon_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_vmware_vr_addon", "test")
+	r := VmwareVrAddonResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccVmwareVrAddon_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_vmware_vr_addon", "test")
+	r := VmwareVrAddonResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.update(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("replication_server_count").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccVmwareVrAddon_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_vmware_vr_addon", "test")
+	r := VmwareVrAddonResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (VmwareVrAddonResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := addons.ParseAddonID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Vmware.AddonClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r VmwareVrAddonResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vmware_srm_addon" "test" {
+  private_cloud_id = azurerm_vmware_private_cloud.test.id
+}
+`, VmwarePrivateCloudResource{}.basic(data))
+}
+
+func (r VmwareVrAddonResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vmware_vr_addon" "test" {
+  private_cloud_id         = azurerm_vmware_srm_addon.test.private_cloud_id
+  replication_server_count = 1
+}
+`, r.template(data))
+}
+
+func (r VmwareVrAddonResource) update(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vmware_vr_addon" "test" {
+  private_cloud_id         = azurerm_vmware_srm_addon.test.private_cloud_id
+  replication_server_count = 2
+}
+`, r.template(data))
+}
+
+func (r VmwareVrAddonResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vmware_vr_addon" "import" {
+  private_cloud_id         = azurerm_vmware_vr_addon.test.private_cloud_id
+  replication_server_count = azurerm_vmware_vr_addon.test.replication_server_count
+}
+`, r.basic(data))
+}
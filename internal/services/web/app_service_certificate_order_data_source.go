@@ -94,6 +94,29 @@ func dataSourceAppServiceCertificateOrder() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"domain_verification_record": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"type": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"value": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
 			"status": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
@@ -104,6 +127,11 @@ func dataSourceAppServiceCertificateOrder() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"next_auto_renewal_time": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
 			"is_private_key_external": {
 				Type:     pluginsdk.TypeBool,
 				Computed: true,
@@ -170,6 +198,7 @@ func dataSourceAppServiceCertificateOrderRead(d *pluginsdk.ResourceData, meta in
 		d.Set("key_size", props.KeySize)
 		d.Set("validity_in_years", props.ValidityInYears)
 		d.Set("domain_verification_token", props.DomainVerificationToken)
+		d.Set("domain_verification_record", flattenArmCertificateOrderDomainVerificationRecord(props.DomainVerificationToken))
 		d.Set("status", string(props.Status))
 		d.Set("is_private_key_external", props.IsPrivateKeyExternal)
 		d.Set("certificates", flattenArmCertificateOrderCertificate(props.Certificates))
@@ -185,6 +214,10 @@ func dataSourceAppServiceCertificateOrderRead(d *pluginsdk.ResourceData, meta in
 			d.Set("expiration_time", expirationTime.Format(time.RFC3339))
 		}
 
+		if nextAutoRenewalTime := props.NextAutoRenewalTimeStamp; nextAutoRenewalTime != nil {
+			d.Set("next_auto_renewal_time", nextAutoRenewalTime.Format(time.RFC3339))
+		}
+
 		if signedCertificate := props.SignedCertificate; signedCertificate != nil {
 			d.Set("signed_certificate_thumbprint", signedCertificate.Thumbprint)
 		}
@@ -20,9 +20,9 @@ import (
 
 func resourceAppServiceCertificateOrder() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
-		Create: resourceAppServiceCertificateOrderCreateUpdate,
+		Create: resourceAppServiceCertificateOrderCreate,
 		Read:   resourceAppServiceCertificateOrderRead,
-		Update: resourceAppServiceCertificateOrderCreateUpdate,
+		Update: resourceAppServiceCertificateOrderUpdate,
 		Delete: resourceAppServiceCertificateOrderDelete,
 		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
 			_, err := parse.CertificateOrderID(id)
@@ -121,11 +121,43 @@ func resourceAppServiceCertificateOrder() *pluginsdk.Resource {
 				ValidateFunc: validation.IntBetween(1, 3),
 			},
 
+			// changing any value within this map re-keys the certificate using the current `key_size` and `csr`
+			"rekey_triggers": {
+				Type:     pluginsdk.TypeMap,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
 			"domain_verification_token": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
 			},
 
+			"domain_verification_record": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"type": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"value": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
 			"status": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
@@ -136,6 +168,11 @@ func resourceAppServiceCertificateOrder() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"next_auto_renewal_time": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
 			"is_private_key_external": {
 				Type:     pluginsdk.TypeBool,
 				Computed: true,
@@ -169,9 +206,9 @@ func resourceAppServiceCertificateOrder() *pluginsdk.Resource {
 	}
 }
 
-func resourceAppServiceCertificateOrderCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+func resourceAppServiceCertificateOrderCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Web.CertificatesOrderClient
-	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for App Service Certificate creation.")
@@ -179,57 +216,29 @@ func resourceAppServiceCertificateOrderCreateUpdate(d *pluginsdk.ResourceData, m
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
 
-	if d.IsNewResource() {
-		existing, err := client.Get(ctx, resourceGroup, name)
-		if err != nil {
-			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("checking for presence of existing App Service Certificate Order %q (Resource Group %q): %s", name, resourceGroup, err)
-			}
-		}
-
-		if existing.ID != nil && *existing.ID != "" {
-			return tf.ImportAsExistsError("azurerm_app_service_certificate_order", *existing.ID)
+	existing, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing App Service Certificate Order %q (Resource Group %q): %s", name, resourceGroup, err)
 		}
 	}
 
-	location := azure.NormalizeLocation(d.Get("location").(string))
-	t := d.Get("tags").(map[string]interface{})
-	distinguishedName := d.Get("distinguished_name").(string)
-	csr := d.Get("csr").(string)
-	keySize := d.Get("key_size").(int)
-	autoRenew := d.Get("auto_renew").(bool)
-	validityInYears := d.Get("validity_in_years").(int)
-
-	properties := web.AppServiceCertificateOrderProperties{
-		DistinguishedName: utils.String(distinguishedName),
-		Csr:               utils.String(csr),
-		KeySize:           utils.Int32(int32(keySize)),
-		AutoRenew:         utils.Bool(autoRenew),
-		ValidityInYears:   utils.Int32(int32(validityInYears)),
-	}
-
-	switch d.Get("product_type").(string) {
-	case "Standard":
-		properties.ProductType = web.CertificateProductTypeStandardDomainValidatedSsl
-	case "WildCard":
-		properties.ProductType = web.CertificateProductTypeStandardDomainValidatedWildCardSsl
-	default:
-		return fmt.Errorf("setting `product_type` for App Service Certificate Order %q (Resource Group %q), either `Standard` or `WildCard`.", name, resourceGroup)
+	if existing.ID != nil && *existing.ID != "" {
+		return tf.ImportAsExistsError("azurerm_app_service_certificate_order", *existing.ID)
 	}
 
-	certificateOrder := web.AppServiceCertificateOrder{
-		AppServiceCertificateOrderProperties: &properties,
-		Location:                             utils.String(location),
-		Tags:                                 tags.Expand(t),
+	certificateOrder, err := expandAppServiceCertificateOrder(d)
+	if err != nil {
+		return fmt.Errorf("expanding App Service Certificate Order %q (Resource Group %q): %+v", name, resourceGroup, err)
 	}
 
 	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, certificateOrder)
 	if err != nil {
-		return fmt.Errorf("creating/updating App Service Certificate Order %q (Resource Group %q): %s", name, resourceGroup, err)
+		return fmt.Errorf("creating App Service Certificate Order %q (Resource Group %q): %s", name, resourceGroup, err)
 	}
 
 	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for creating/updating of App Service Certificate Order %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("waiting for creation of App Service Certificate Order %q (Resource Group %q): %+v", name, resourceGroup, err)
 	}
 
 	read, err := client.Get(ctx, resourceGroup, name)
@@ -246,6 +255,64 @@ func resourceAppServiceCertificateOrderCreateUpdate(d *pluginsdk.ResourceData, m
 	return resourceAppServiceCertificateOrderRead(d, meta)
 }
 
+func resourceAppServiceCertificateOrderUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Web.CertificatesOrderClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.CertificateOrderID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.HasChanges("csr", "distinguished_name", "key_size", "product_type", "validity_in_years", "tags") {
+		certificateOrder, err := expandAppServiceCertificateOrder(d)
+		if err != nil {
+			return fmt.Errorf("expanding App Service Certificate Order %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+		}
+
+		future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, certificateOrder)
+		if err != nil {
+			return fmt.Errorf("updating App Service Certificate Order %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+		}
+
+		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for update of App Service Certificate Order %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+		}
+	} else if d.HasChange("auto_renew") {
+		// toggling auto renewal on an issued order doesn't require the whole order to be re-submitted
+		patch := web.AppServiceCertificateOrderPatchResource{
+			AppServiceCertificateOrderPatchResourceProperties: &web.AppServiceCertificateOrderPatchResourceProperties{
+				AutoRenew: utils.Bool(d.Get("auto_renew").(bool)),
+			},
+		}
+
+		if _, err := client.Update(ctx, id.ResourceGroup, id.Name, patch); err != nil {
+			return fmt.Errorf("updating `auto_renew` for App Service Certificate Order %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+		}
+	}
+
+	if d.HasChange("rekey_triggers") {
+		log.Printf("[DEBUG] Re-keying App Service Certificate Order %q (Resource Group %q)", id.Name, id.ResourceGroup)
+
+		props := web.ReissueCertificateOrderRequestProperties{
+			KeySize: utils.Int32(int32(d.Get("key_size").(int))),
+		}
+		if csr := d.Get("csr").(string); csr != "" && d.Get("distinguished_name").(string) == "" {
+			props.Csr = utils.String(csr)
+		}
+
+		reissue := web.ReissueCertificateOrderRequest{
+			ReissueCertificateOrderRequestProperties: &props,
+		}
+		if _, err := client.Reissue(ctx, id.ResourceGroup, id.Name, reissue); err != nil {
+			return fmt.Errorf("re-keying App Service Certificate Order %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+		}
+	}
+
+	return resourceAppServiceCertificateOrderRead(d, meta)
+}
+
 func resourceAppServiceCertificateOrderRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Web.CertificatesOrderClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
@@ -281,6 +348,7 @@ func resourceAppServiceCertificateOrderRead(d *pluginsdk.ResourceData, meta inte
 		d.Set("key_size", props.KeySize)
 		d.Set("validity_in_years", props.ValidityInYears)
 		d.Set("domain_verification_token", props.DomainVerificationToken)
+		d.Set("domain_verification_record", flattenArmCertificateOrderDomainVerificationRecord(props.DomainVerificationToken))
 		d.Set("status", string(props.Status))
 		d.Set("is_private_key_external", props.IsPrivateKeyExternal)
 		d.Set("certificates", flattenArmCertificateOrderCertificate(props.Certificates))
@@ -296,6 +364,12 @@ func resourceAppServiceCertificateOrderRead(d *pluginsdk.ResourceData, meta inte
 			d.Set("expiration_time", expirationTime.Format(time.RFC3339))
 		}
 
+		nextAutoRenewalTime := ""
+		if props.NextAutoRenewalTimeStamp != nil {
+			nextAutoRenewalTime = props.NextAutoRenewalTimeStamp.Format(time.RFC3339)
+		}
+		d.Set("next_auto_renewal_time", nextAutoRenewalTime)
+
 		if signedCertificate := props.SignedCertificate; signedCertificate != nil {
 			d.Set("signed_certificate_thumbprint", signedCertificate.Thumbprint)
 		}
@@ -334,6 +408,55 @@ func resourceAppServiceCertificateOrderDelete(d *pluginsdk.ResourceData, meta in
 	return nil
 }
 
+func expandAppServiceCertificateOrder(d *pluginsdk.ResourceData) (web.AppServiceCertificateOrder, error) {
+	location := azure.NormalizeLocation(d.Get("location").(string))
+	t := d.Get("tags").(map[string]interface{})
+	distinguishedName := d.Get("distinguished_name").(string)
+	csr := d.Get("csr").(string)
+	keySize := d.Get("key_size").(int)
+	autoRenew := d.Get("auto_renew").(bool)
+	validityInYears := d.Get("validity_in_years").(int)
+
+	properties := web.AppServiceCertificateOrderProperties{
+		DistinguishedName: utils.String(distinguishedName),
+		Csr:               utils.String(csr),
+		KeySize:           utils.Int32(int32(keySize)),
+		AutoRenew:         utils.Bool(autoRenew),
+		ValidityInYears:   utils.Int32(int32(validityInYears)),
+	}
+
+	switch d.Get("product_type").(string) {
+	case "Standard":
+		properties.ProductType = web.CertificateProductTypeStandardDomainValidatedSsl
+	case "WildCard":
+		properties.ProductType = web.CertificateProductTypeStandardDomainValidatedWildCardSsl
+	default:
+		return web.AppServiceCertificateOrder{}, fmt.Errorf("setting `product_type`, either `Standard` or `WildCard`")
+	}
+
+	return web.AppServiceCertificateOrder{
+		AppServiceCertificateOrderProperties: &properties,
+		Location:                             utils.String(location),
+		Tags:                                 tags.Expand(t),
+	}, nil
+}
+
+// flattenArmCertificateOrderDomainVerificationRecord returns the TXT record which has to be created
+// at the apex of the DNS Zone for the domain to verify ownership of it
+func flattenArmCertificateOrderDomainVerificationRecord(token *string) []interface{} {
+	if token == nil || *token == "" {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"name":  "@",
+			"type":  "TXT",
+			"value": *token,
+		},
+	}
+}
+
 func flattenArmCertificateOrderCertificate(input map[string]*web.AppServiceCertificate) []interface{} {
 	results := make([]interface{}, 0)
 
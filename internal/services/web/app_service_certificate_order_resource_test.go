@@ -31,6 +31,7 @@ func TestAccAppServiceCertificateOrder_basic(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 				check.That(data.ResourceName).Key("csr").Exists(),
 				check.That(data.ResourceName).Key("domain_verification_token").Exists(),
+				check.That(data.ResourceName).Key("domain_verification_record.0.type").HasValue("TXT"),
 				check.That(data.ResourceName).Key("distinguished_name").HasValue("CN=example.com"),
 				check.That(data.ResourceName).Key("product_type").HasValue("Standard"),
 			),
@@ -152,6 +153,34 @@ func TestAccAppServiceCertificateOrder_update(t *testing.T) {
 	})
 }
 
+func TestAccAppServiceCertificateOrder_rekey(t *testing.T) {
+	if os.Getenv("ARM_RUN_TEST_APP_SERVICE_CERTIFICATE") == "" {
+		t.Skip("Skipping as ARM_RUN_TEST_APP_SERVICE_CERTIFICATE is not specified")
+		return
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_app_service_certificate_order", "test")
+	r := AppServiceCertificateOrderResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.rekey(data, "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("rekey_triggers"),
+		{
+			Config: r.rekey(data, "second"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("rekey_triggers.rotation").HasValue("second"),
+			),
+		},
+		data.ImportStep("rekey_triggers"),
+	})
+}
+
 func (r AppServiceCertificateOrderResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.CertificateOrderID(state.ID)
 	if err != nil {
@@ -248,3 +277,28 @@ resource "azurerm_app_service_certificate_order" "test" {
 }
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, keySize)
 }
+
+func (r AppServiceCertificateOrderResource) rekey(data acceptance.TestData, rotation string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_app_service_certificate_order" "test" {
+  name                = "acctestASCO-%d"
+  location            = "global"
+  resource_group_name = azurerm_resource_group.test.name
+  distinguished_name  = "CN=example.com"
+  product_type        = "Standard"
+
+  rekey_triggers = {
+    rotation = "%s"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, rotation)
+}
@@ -0,0 +1,208 @@
+package web
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-02-01/web"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/web/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/web/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type AppServiceEnvironmentCapacityDataSource struct{}
+
+var _ sdk.DataSource = AppServiceEnvironmentCapacityDataSource{}
+
+type AppServiceEnvironmentCapacityModel struct {
+	AppServiceEnvironmentId string                               `tfschema:"app_service_environment_id"`
+	FrontEndInstanceCount   int                                  `tfschema:"front_end_instance_count"`
+	FrontEndScaleFactor     int                                  `tfschema:"front_end_scale_factor"`
+	FrontEndSize            string                               `tfschema:"front_end_size"`
+	MaximumNumberOfMachines int                                  `tfschema:"maximum_number_of_machines"`
+	Capacity                []AppServiceEnvironmentCapacityEntry `tfschema:"capacity"`
+}
+
+type AppServiceEnvironmentCapacityEntry struct {
+	Name                          string `tfschema:"name"`
+	AvailableCapacity             int    `tfschema:"available_capacity"`
+	TotalCapacity                 int    `tfschema:"total_capacity"`
+	Unit                          string `tfschema:"unit"`
+	ComputeMode                   string `tfschema:"compute_mode"`
+	WorkerSize                    string `tfschema:"worker_size"`
+	WorkerSizeId                  int    `tfschema:"worker_size_id"`
+	ExcludeFromCapacityAllocation bool   `tfschema:"exclude_from_capacity_allocation"`
+	Linux                         bool   `tfschema:"linux"`
+}
+
+func (r AppServiceEnvironmentCapacityDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"app_service_environment_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validate.AppServiceEnvironmentID,
+		},
+	}
+}
+
+func (r AppServiceEnvironmentCapacityDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"front_end_instance_count": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"front_end_scale_factor": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"front_end_size": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"maximum_number_of_machines": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"capacity": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"available_capacity": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"total_capacity": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"unit": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"compute_mode": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"worker_size": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"worker_size_id": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"exclude_from_capacity_allocation": {
+						Type:     pluginsdk.TypeBool,
+						Computed: true,
+					},
+
+					"linux": {
+						Type:     pluginsdk.TypeBool,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r AppServiceEnvironmentCapacityDataSource) ModelObject() interface{} {
+	return &AppServiceEnvironmentCapacityModel{}
+}
+
+func (r AppServiceEnvironmentCapacityDataSource) ResourceType() string {
+	return "azurerm_app_service_environment_capacity"
+}
+
+func (r AppServiceEnvironmentCapacityDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Web.AppServiceEnvironmentsClient
+
+			var state AppServiceEnvironmentCapacityModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := parse.AppServiceEnvironmentID(state.AppServiceEnvironmentId)
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.Get(ctx, id.ResourceGroup, id.HostingEnvironmentName)
+			if err != nil {
+				if utils.ResponseWasNotFound(existing.Response) {
+					return fmt.Errorf("%s was not found", id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			if props := existing.AppServiceEnvironment; props != nil {
+				state.FrontEndInstanceCount = int(utils.NormaliseNilableInt32(props.MultiRoleCount))
+				state.FrontEndScaleFactor = int(utils.NormaliseNilableInt32(props.FrontEndScaleFactor))
+				state.FrontEndSize = utils.NormalizeNilableString(props.MultiSize)
+				state.MaximumNumberOfMachines = int(utils.NormaliseNilableInt32(props.MaximumNumberOfMachines))
+			}
+
+			capacities, err := listAppServiceEnvironmentCapacities(ctx, client, *id)
+			if err != nil {
+				return err
+			}
+			state.Capacity = capacities
+
+			metadata.SetID(id)
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func listAppServiceEnvironmentCapacities(ctx context.Context, client *web.AppServiceEnvironmentsClient, id parse.AppServiceEnvironmentId) ([]AppServiceEnvironmentCapacityEntry, error) {
+	output := make([]AppServiceEnvironmentCapacityEntry, 0)
+
+	iterator, err := client.ListCapacitiesComplete(ctx, id.ResourceGroup, id.HostingEnvironmentName)
+	if err != nil {
+		return nil, fmt.Errorf("listing capacities for %s: %+v", id, err)
+	}
+
+	for iterator.NotDone() {
+		item := iterator.Value()
+		output = append(output, AppServiceEnvironmentCapacityEntry{
+			Name:                          utils.NormalizeNilableString(item.Name),
+			AvailableCapacity:             int(utils.NormaliseNilableInt64(item.AvailableCapacity)),
+			TotalCapacity:                 int(utils.NormaliseNilableInt64(item.TotalCapacity)),
+			Unit:                          utils.NormalizeNilableString(item.Unit),
+			ComputeMode:                   string(item.ComputeMode),
+			WorkerSize:                    string(item.WorkerSize),
+			WorkerSizeId:                  int(utils.NormaliseNilableInt32(item.WorkerSizeID)),
+			ExcludeFromCapacityAllocation: utils.NormaliseNilableBool(item.ExcludeFromCapacityAllocation),
+			Linux:                         utils.NormaliseNilableBool(item.IsLinux),
+		})
+
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return nil, fmt.Errorf("listing capacities for %s: %+v", id, err)
+		}
+	}
+
+	return output, nil
+}
@@ -0,0 +1,36 @@
+package web_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type AppServiceEnvironmentCapacityDataSource struct{}
+
+func TestAccAppServiceEnvironmentCapacityDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_app_service_environment_capacity", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: AppServiceEnvironmentCapacityDataSource{}.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("maximum_number_of_machines").Exists(),
+				check.That(data.ResourceName).Key("capacity.#").Exists(),
+				check.That(data.ResourceName).Key("capacity.0.name").Exists(),
+			),
+		},
+	})
+}
+
+func (AppServiceEnvironmentCapacityDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_app_service_environment_capacity" "test" {
+  app_service_environment_id = azurerm_app_service_environment_v3.test.id
+}
+`, AppServiceEnvironmentV3Resource{}.basic(data))
+}
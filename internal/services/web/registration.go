@@ -60,6 +60,7 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 
 func (r Registration) DataSources() []sdk.DataSource {
 	return []sdk.DataSource{
+		AppServiceEnvironmentCapacityDataSource{},
 		AppServiceEnvironmentV3DataSource{},
 	}
 }
@@ -0,0 +1,19 @@
+package tags
+
+// MergeDefaults returns the Tags which should be assigned to a Resource - which are the Default Tags
+// configured in the Provider block, overridden by any Tags with the same key specified on the Resource
+func MergeDefaults(defaultTags map[string]string, resourceTags map[string]interface{}) map[string]interface{} {
+	output := make(map[string]interface{}, len(defaultTags)+len(resourceTags))
+
+	for k, v := range defaultTags {
+		output[k] = v
+	}
+
+	for k, v := range resourceTags {
+		// Validate should have ignored this error already
+		value, _ := TagValueToString(v)
+		output[k] = value
+	}
+
+	return output
+}
@@ -0,0 +1,74 @@
+package tags
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMergeDefaults(t *testing.T) {
+	testData := []struct {
+		name         string
+		defaultTags  map[string]string
+		resourceTags map[string]interface{}
+		expected     map[string]interface{}
+	}{
+		{
+			name:     "none",
+			expected: map[string]interface{}{},
+		},
+		{
+			name: "default tags only",
+			defaultTags: map[string]string{
+				"environment": "Production",
+			},
+			expected: map[string]interface{}{
+				"environment": "Production",
+			},
+		},
+		{
+			name: "resource tags only",
+			resourceTags: map[string]interface{}{
+				"cost_center": "MSFT",
+			},
+			expected: map[string]interface{}{
+				"cost_center": "MSFT",
+			},
+		},
+		{
+			name: "merged",
+			defaultTags: map[string]string{
+				"environment": "Production",
+			},
+			resourceTags: map[string]interface{}{
+				"cost_center": "MSFT",
+			},
+			expected: map[string]interface{}{
+				"cost_center": "MSFT",
+				"environment": "Production",
+			},
+		},
+		{
+			name: "resource tags take precedence",
+			defaultTags: map[string]string{
+				"environment": "Production",
+				"owner":       "platform",
+			},
+			resourceTags: map[string]interface{}{
+				"environment": "Staging",
+			},
+			expected: map[string]interface{}{
+				"environment": "Staging",
+				"owner":       "platform",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.name)
+
+		actual := MergeDefaults(v.defaultTags, v.resourceTags)
+		if !reflect.DeepEqual(actual, v.expected) {
+			t.Fatalf("Expected %+v but got %+v", v.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,46 @@
+package tags
+
+import "strings"
+
+// IgnoreConfig defines the Tags (by key or by key prefix) which are managed outside of Terraform
+// and should therefore be omitted when the Tags returned from the API are set into the State
+type IgnoreConfig struct {
+	Keys        []string
+	KeyPrefixes []string
+}
+
+// IsEmpty returns whether no Tags are ignored
+func (c IgnoreConfig) IsEmpty() bool {
+	return len(c.Keys) == 0 && len(c.KeyPrefixes) == 0
+}
+
+// Ignored returns whether the Tag with the specified key should be ignored - Tag keys in Azure are
+// case-insensitive, as such the comparison is too
+func (c IgnoreConfig) Ignored(key string) bool {
+	for _, v := range c.Keys {
+		if strings.EqualFold(key, v) {
+			return true
+		}
+	}
+
+	for _, v := range c.KeyPrefixes {
+		if v != "" && strings.HasPrefix(strings.ToLower(key), strings.ToLower(v)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// RemoveIgnored returns a copy of the flattened Tags with any ignored Tags removed
+func (c IgnoreConfig) RemoveIgnored(input map[string]interface{}) map[string]interface{} {
+	output := make(map[string]interface{}, len(input))
+	for k, v := range input {
+		if c.Ignored(k) {
+			continue
+		}
+		output[k] = v
+	}
+
+	return output
+}
@@ -0,0 +1,87 @@
+package tags
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestIgnoreConfigRemoveIgnored(t *testing.T) {
+	testData := []struct {
+		name     string
+		config   IgnoreConfig
+		input    map[string]interface{}
+		expected map[string]interface{}
+	}{
+		{
+			name: "none ignored",
+			input: map[string]interface{}{
+				"environment": "Production",
+			},
+			expected: map[string]interface{}{
+				"environment": "Production",
+			},
+		},
+		{
+			name: "ignored key",
+			config: IgnoreConfig{
+				Keys: []string{"CreatedBy"},
+			},
+			input: map[string]interface{}{
+				"environment": "Production",
+				"createdby":   "policy",
+			},
+			expected: map[string]interface{}{
+				"environment": "Production",
+			},
+		},
+		{
+			name: "ignored key prefix",
+			config: IgnoreConfig{
+				KeyPrefixes: []string{"hidden-"},
+			},
+			input: map[string]interface{}{
+				"environment":         "Production",
+				"hidden-link":         "/subscriptions/00000000-0000-0000-0000-000000000000",
+				"Hidden-Related":      "/subscriptions/00000000-0000-0000-0000-000000000000",
+				"not-hidden-anything": "value",
+			},
+			expected: map[string]interface{}{
+				"environment":         "Production",
+				"not-hidden-anything": "value",
+			},
+		},
+		{
+			name: "empty key prefix ignores nothing",
+			config: IgnoreConfig{
+				KeyPrefixes: []string{""},
+			},
+			input: map[string]interface{}{
+				"environment": "Production",
+			},
+			expected: map[string]interface{}{
+				"environment": "Production",
+			},
+		},
+		{
+			name: "all ignored",
+			config: IgnoreConfig{
+				Keys:        []string{"environment"},
+				KeyPrefixes: []string{"cost"},
+			},
+			input: map[string]interface{}{
+				"environment": "Production",
+				"cost_center": "MSFT",
+			},
+			expected: map[string]interface{}{},
+		},
+	}
+
+	for _, v := range testData {
+		t.Run(v.name, func(t *testing.T) {
+			actual := v.config.RemoveIgnored(v.input)
+			if !reflect.DeepEqual(actual, v.expected) {
+				t.Fatalf("expected %+v but got %+v", v.expected, actual)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package pluginsdk
 
 import (
 	"context"
+	"strings"
 
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -68,3 +69,68 @@ func ForceNewIfChange(key string, f ValueChangeConditionFunc) CustomizeDiffFunc
 		return nil
 	}
 }
+
+// ForceNewIfDowngrade returns a CustomizeDiffFunc that flags the given key as
+// requiring a new resource when the value is changed to one which appears
+// earlier within orderedValues than the existing value.
+//
+// This allows upgrades (e.g. from a `Basic` to a `Standard` SKU) to be performed
+// in-place, whilst downgrades - which the API doesn't support - recreate the
+// resource. Values are compared case-insensitively and values which aren't
+// present within orderedValues are never treated as a downgrade.
+func ForceNewIfDowngrade(key string, orderedValues []string) CustomizeDiffFunc {
+	return ForceNewIfChange(key, func(ctx context.Context, old, new, meta interface{}) bool {
+		return isDowngrade(old.(string), new.(string), orderedValues)
+	})
+}
+
+// ForceNewUnlessTransitionSupported returns a CustomizeDiffFunc that flags the
+// given key as requiring a new resource unless the change from the existing value
+// to the new value is listed within supportedTransitions, which is a map of the
+// existing value to the values it can be changed to in-place.
+//
+// Values are compared case-insensitively and changes where the existing value is
+// empty (e.g. when the resource is being created) are never flagged.
+func ForceNewUnlessTransitionSupported(key string, supportedTransitions map[string][]string) CustomizeDiffFunc {
+	return ForceNewIfChange(key, func(ctx context.Context, old, new, meta interface{}) bool {
+		return !isSupportedTransition(old.(string), new.(string), supportedTransitions)
+	})
+}
+
+func isDowngrade(old, new string, orderedValues []string) bool {
+	oldIndex := indexOfCaseInsensitive(orderedValues, old)
+	newIndex := indexOfCaseInsensitive(orderedValues, new)
+	if oldIndex == -1 || newIndex == -1 {
+		return false
+	}
+
+	return newIndex < oldIndex
+}
+
+func isSupportedTransition(old, new string, supportedTransitions map[string][]string) bool {
+	if old == "" || strings.EqualFold(old, new) {
+		return true
+	}
+
+	for from, to := range supportedTransitions {
+		if !strings.EqualFold(from, old) {
+			continue
+		}
+
+		if indexOfCaseInsensitive(to, new) != -1 {
+			return true
+		}
+	}
+
+	return false
+}
+
+func indexOfCaseInsensitive(input []string, value string) int {
+	for i, v := range input {
+		if strings.EqualFold(v, value) {
+			return i
+		}
+	}
+
+	return -1
+}
@@ -0,0 +1,60 @@
+package pluginsdk
+
+import "testing"
+
+func TestIsDowngrade(t *testing.T) {
+	orderedValues := []string{"Basic", "Standard", "Premium"}
+
+	testData := []struct {
+		old      string
+		new      string
+		expected bool
+	}{
+		{old: "", new: "Basic", expected: false},
+		{old: "Basic", new: "Basic", expected: false},
+		{old: "Basic", new: "Standard", expected: false},
+		{old: "Basic", new: "Premium", expected: false},
+		{old: "Standard", new: "Basic", expected: true},
+		{old: "Premium", new: "Standard", expected: true},
+		{old: "premium", new: "BASIC", expected: true},
+		{old: "standard", new: "Standard", expected: false},
+		{old: "Premium", new: "Unknown", expected: false},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q -> %q", v.old, v.new)
+
+		if actual := isDowngrade(v.old, v.new, orderedValues); actual != v.expected {
+			t.Fatalf("expected %t but got %t", v.expected, actual)
+		}
+	}
+}
+
+func TestIsSupportedTransition(t *testing.T) {
+	supportedTransitions := map[string][]string{
+		"Basic":    {"Standard"},
+		"Standard": {"Basic"},
+	}
+
+	testData := []struct {
+		old      string
+		new      string
+		expected bool
+	}{
+		{old: "", new: "Premium", expected: true},
+		{old: "Basic", new: "Basic", expected: true},
+		{old: "Basic", new: "Standard", expected: true},
+		{old: "standard", new: "BASIC", expected: true},
+		{old: "Basic", new: "Premium", expected: false},
+		{old: "Premium", new: "Standard", expected: false},
+		{old: "Premium", new: "premium", expected: true},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q -> %q", v.old, v.new)
+
+		if actual := isSupportedTransition(v.old, v.new, supportedTransitions); actual != v.expected {
+			t.Fatalf("expected %t but got %t", v.expected, actual)
+		}
+	}
+}
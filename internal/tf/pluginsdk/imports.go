@@ -6,10 +6,15 @@ import (
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceid"
 )
 
 type IDValidationFunc func(id string) error
 
+// IDInsensitiveParseFunc parses the Resource ID case-insensitively, returning a Resource ID which can
+// be formatted into its canonical casing
+type IDInsensitiveParseFunc func(id string) (resourceid.Formatter, error)
+
 type ImporterFunc = func(ctx context.Context, d *ResourceData, meta interface{}) ([]*ResourceData, error)
 
 // DefaultImporter is a wrapper around the default importer within the Plugin SDK
@@ -47,3 +52,35 @@ func ImporterValidatingResourceIdThen(validateFunc IDValidationFunc, thenFunc Im
 		},
 	}
 }
+
+// ImporterNormalizingResourceId parses the ID provided at import time case-insensitively using the
+// parseFunc and then rewrites it into its canonical casing - meaning that Resource ID's copied from the
+// Azure Portal/Azure CLI (which can differ in casing) can be imported, rather than being rejected.
+func ImporterNormalizingResourceId(parseFunc IDInsensitiveParseFunc) *schema.ResourceImporter {
+	thenFunc := func(ctx context.Context, d *ResourceData, meta interface{}) ([]*ResourceData, error) {
+		return []*ResourceData{d}, nil
+	}
+	return ImporterNormalizingResourceIdThen(parseFunc, thenFunc)
+}
+
+// ImporterNormalizingResourceIdThen parses the ID provided at import time case-insensitively using the
+// parseFunc, rewrites it into its canonical casing and then runs the 'thenFunc', allowing the import to be customised.
+func ImporterNormalizingResourceIdThen(parseFunc IDInsensitiveParseFunc, thenFunc ImporterFunc) *schema.ResourceImporter {
+	return &schema.ResourceImporter{
+		StateContext: func(ctx context.Context, d *ResourceData, meta interface{}) ([]*ResourceData, error) {
+			log.Printf("[DEBUG] Importing Resource - parsing %q", d.Id())
+
+			id, err := parseFunc(d.Id())
+			if err != nil {
+				return []*ResourceData{d}, fmt.Errorf("parsing Resource ID %q: %+v", d.Id(), err)
+			}
+
+			if normalized := id.ID(); normalized != d.Id() {
+				log.Printf("[DEBUG] Importing Resource - normalizing the Resource ID %q to %q", d.Id(), normalized)
+				d.SetId(normalized)
+			}
+
+			return thenFunc(ctx, d, meta)
+		},
+	}
+}
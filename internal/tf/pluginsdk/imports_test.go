@@ -0,0 +1,78 @@
+package pluginsdk
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceid"
+)
+
+type testImportId struct {
+	Name string
+}
+
+func (id testImportId) ID() string {
+	return fmt.Sprintf("/things/%s", id.Name)
+}
+
+func parseTestImportIdInsensitively(input string) (resourceid.Formatter, error) {
+	segments := strings.Split(strings.TrimPrefix(input, "/"), "/")
+	if len(segments) != 2 || !strings.EqualFold(segments[0], "things") || segments[1] == "" {
+		return nil, fmt.Errorf("expected an ID in the format `/things/{name}` but got %q", input)
+	}
+
+	return testImportId{Name: segments[1]}, nil
+}
+
+func TestImporterNormalizingResourceId(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected string
+	}{
+		{
+			// invalid
+			Input: "/other/thing1",
+			Error: true,
+		},
+		{
+			// canonical
+			Input:    "/things/thing1",
+			Expected: "/things/thing1",
+		},
+		{
+			// upper-cased segment names are normalized, values are left as-is
+			Input:    "/THINGS/Thing1",
+			Expected: "/things/Thing1",
+		},
+	}
+
+	importer := ImporterNormalizingResourceId(parseTestImportIdInsensitively)
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		d := (&Resource{Schema: map[string]*Schema{}}).TestResourceData()
+		d.SetId(v.Input)
+
+		results, err := importer.StateContext(context.TODO(), d, nil)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if len(results) != 1 {
+			t.Fatalf("Expected 1 result but got %d", len(results))
+		}
+		if actual := results[0].Id(); actual != v.Expected {
+			t.Fatalf("Expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
@@ -93,6 +93,11 @@ func IsIPv4Address(i interface{}, k string) ([]string, []error) {
 	return validation.IsIPv4Address(i, k)
 }
 
+// IsMACAddress is a SchemaValidateFunc which tests if the provided value is of type string and a valid MAC address
+func IsMACAddress(i interface{}, k string) ([]string, []error) {
+	return validation.IsMACAddress(i, k)
+}
+
 // IsIPv4Range is a SchemaValidateFunc which tests if the provided value is of type string, and in valid IP range
 func IsIPv4Range(i interface{}, k string) ([]string, []error) {
 	return validation.IsIPv4Range(i, k)
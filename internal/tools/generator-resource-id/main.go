@@ -331,6 +331,13 @@ type ResourceIdGenerator struct {
 }
 
 func (id ResourceIdGenerator) Code() string {
+	imports := []string{
+		`"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"`,
+	}
+	if id.ShouldRewrite {
+		imports = append(imports, `"github.com/hashicorp/terraform-provider-azurerm/internal/resourceid"`)
+	}
+
 	return fmt.Sprintf(`
 package parse
 
@@ -340,7 +347,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	%s
 )
 
 %s
@@ -349,7 +356,7 @@ import (
 %s
 %s
 %s
-`, id.codeForType(), id.codeForConstructor(), id.codeForDescription(), id.codeForFormatter(), id.codeForParser(), id.codeForParserInsensitive())
+`, strings.Join(imports, "\n\t"), id.codeForType(), id.codeForConstructor(), id.codeForDescription(), id.codeForFormatter(), id.codeForParser(), id.codeForParserInsensitive())
 }
 
 func (id ResourceIdGenerator) codeForType() string {
@@ -544,7 +551,7 @@ func (id ResourceIdGenerator) codeForParserInsensitive() string {
 // Whilst this may seem strange, this enables Terraform have consistent casing
 // which works around issues in Core, whilst handling broken API responses.
 func %[1]sIDInsensitively(input string) (*%[1]sId, error) {
-	id, err := resourceids.ParseAzureResourceID(input)
+	id, err := resourceid.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -836,6 +843,24 @@ func (id ResourceIdGenerator) testCodeForParserInsensitive() string {
 		return string(out)
 	}))
 
+	// the root segments are normalized by the parser, so these should be parsed regardless of casing too
+	resourceIdWithUpperCasedRootSegments := id.IDRaw
+	for _, key := range []string{"subscriptions", "resourceGroups", "providers"} {
+		resourceIdWithUpperCasedRootSegments = strings.ReplaceAll(resourceIdWithUpperCasedRootSegments, fmt.Sprintf("/%s/", key), fmt.Sprintf("/%s/", strings.ToUpper(key)))
+	}
+	rootSegmentsTypeName := fmt.Sprintf("%sId", id.TypeName)
+	if id.TestPackageSuffix != "" {
+		rootSegmentsTypeName = fmt.Sprintf("parse.%s", rootSegmentsTypeName)
+	}
+	testCases = append(testCases, fmt.Sprintf(`
+		{
+			// upper-cased root segment names
+			Input: "%[1]s",
+			Expected: &%[2]s{
+%[3]s
+			},
+		},`, resourceIdWithUpperCasedRootSegments, rootSegmentsTypeName, strings.Join(expectAssignments, "\n")))
+
 	testCasesStr := strings.Join(testCases, "\n")
 	assignmentCheckStr := strings.Join(assignmentChecks, "\n")
 
@@ -0,0 +1,340 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
+)
+
+// NOTE: since we're using `go run` for these tools all of the code needs to live within the main.go
+
+func main() {
+	f := flag.NewFlagSet("sensitive-audit", flag.ExitOnError)
+
+	statePath := f.String("state", "", "The path to the JSON representation of a State or Plan (from `terraform show -json`) which should be audited")
+	resourceName := f.String("name", "", "Only audit the specified Data Source/Resource (e.g. `azurerm_storage_account`)")
+	onlyGaps := f.Bool("only-gaps", false, "Only output the attributes which look like secrets but aren't marked as Sensitive")
+	strict := f.Bool("strict", false, "Exit with a non-zero exit code when any attributes look like secrets but aren't marked as Sensitive")
+
+	_ = f.Parse(os.Args[1:])
+
+	p := provider.AzureProvider()
+	schemas := map[string]map[string]*schema.Schema{}
+	for name, resource := range p.DataSourcesMap {
+		schemas[fmt.Sprintf("data.%s", name)] = resource.Schema
+	}
+	for name, resource := range p.ResourcesMap {
+		schemas[name] = resource.Schema
+	}
+
+	var findings []finding
+	if statePath != nil && *statePath != "" {
+		contents, err := os.ReadFile(*statePath)
+		if err != nil {
+			log.Fatalf("reading %q: %+v", *statePath, err)
+		}
+
+		findings, err = auditState(contents, schemas)
+		if err != nil {
+			log.Fatalf("auditing %q: %+v", *statePath, err)
+		}
+	} else {
+		for name, s := range schemas {
+			if *resourceName != "" && strings.TrimPrefix(name, "data.") != *resourceName {
+				continue
+			}
+			findings = append(findings, auditSchema(name, s)...)
+		}
+	}
+
+	gaps := output(os.Stdout, findings, *onlyGaps)
+	if *strict && gaps > 0 {
+		os.Exit(1)
+	}
+}
+
+// finding is an attribute which is either marked as Sensitive or looks like it contains a secret
+type finding struct {
+	// Address is the name of the Data Source/Resource - or the address of the instance within the State/Plan
+	Address string
+
+	// Path is the path to the attribute within the Data Source/Resource e.g. `site_config.auth_key`
+	Path string
+
+	Sensitive bool
+}
+
+func (f finding) String() string {
+	if f.Sensitive {
+		return fmt.Sprintf("%s: `%s` is marked as Sensitive", f.Address, f.Path)
+	}
+
+	return fmt.Sprintf("%s: `%s` looks like a secret but isn't marked as Sensitive", f.Address, f.Path)
+}
+
+func output(w io.Writer, findings []finding, onlyGaps bool) int {
+	sort.Slice(findings, func(i, j int) bool {
+		if findings[i].Address != findings[j].Address {
+			return findings[i].Address < findings[j].Address
+		}
+		return findings[i].Path < findings[j].Path
+	})
+
+	sensitive := 0
+	gaps := 0
+	for _, v := range findings {
+		if v.Sensitive {
+			sensitive++
+			if onlyGaps {
+				continue
+			}
+		} else {
+			gaps++
+		}
+
+		fmt.Fprintln(w, v.String())
+	}
+
+	fmt.Fprintf(w, "\n%d attributes are marked as Sensitive, %d attributes look like secrets but aren't marked as Sensitive\n", sensitive, gaps)
+	return gaps
+}
+
+// auditSchema returns the attributes within the Schema which are either marked as Sensitive or look like secrets
+func auditSchema(address string, input map[string]*schema.Schema) []finding {
+	findings := make([]finding, 0)
+
+	var walk func(prefix string, s map[string]*schema.Schema)
+	walk = func(prefix string, s map[string]*schema.Schema) {
+		for key, v := range s {
+			path := key
+			if prefix != "" {
+				path = fmt.Sprintf("%s.%s", prefix, key)
+			}
+
+			if nested, ok := v.Elem.(*schema.Resource); ok {
+				walk(path, nested.Schema)
+				continue
+			}
+
+			// only strings can contain secrets, e.g. `disable_password_authentication` is a bool
+			isString := v.Type == schema.TypeString
+			if v.Type == schema.TypeList || v.Type == schema.TypeSet || v.Type == schema.TypeMap {
+				if elem, ok := v.Elem.(*schema.Schema); ok {
+					isString = elem.Type == schema.TypeString
+				} else {
+					isString = v.Type == schema.TypeMap && v.Elem == nil
+				}
+			}
+
+			if v.Sensitive || (isString && looksLikeSecret(key)) {
+				findings = append(findings, finding{
+					Address:   address,
+					Path:      path,
+					Sensitive: v.Sensitive,
+				})
+			}
+		}
+	}
+	walk("", input)
+
+	return findings
+}
+
+type stateModule struct {
+	Resources    []stateResource `json:"resources"`
+	ChildModules []stateModule   `json:"child_modules"`
+}
+
+type stateResource struct {
+	Address string                 `json:"address"`
+	Mode    string                 `json:"mode"`
+	Type    string                 `json:"type"`
+	Values  map[string]interface{} `json:"values"`
+}
+
+type stateValues struct {
+	RootModule *stateModule `json:"root_module"`
+}
+
+// stateOrPlan is the subset of the JSON output from `terraform show -json` which is needed
+// for the audit - which is either a State (`values`) or a Plan (`planned_values`)
+type stateOrPlan struct {
+	Values        *stateValues `json:"values"`
+	PlannedValues *stateValues `json:"planned_values"`
+}
+
+// auditState returns the attributes set within the State/Plan which are either marked as Sensitive or
+// look like secrets - unlike auditSchema this only includes attributes which have a value
+func auditState(contents []byte, schemas map[string]map[string]*schema.Schema) ([]finding, error) {
+	var input stateOrPlan
+	if err := json.Unmarshal(contents, &input); err != nil {
+		return nil, fmt.Errorf("parsing JSON: %+v", err)
+	}
+
+	values := input.Values
+	if values == nil {
+		values = input.PlannedValues
+	}
+	if values == nil || values.RootModule == nil {
+		return nil, fmt.Errorf("neither `values` nor `planned_values` were found - this should be the output of `terraform show -json`")
+	}
+
+	findings := make([]finding, 0)
+
+	var walkModule func(module stateModule)
+	walkModule = func(module stateModule) {
+		for _, resource := range module.Resources {
+			name := resource.Type
+			if resource.Mode == "data" {
+				name = fmt.Sprintf("data.%s", resource.Type)
+			}
+
+			s, ok := schemas[name]
+			if !ok {
+				// other providers
+				continue
+			}
+
+			for _, v := range auditSchema(resource.Address, s) {
+				if hasValue(resource.Values, strings.Split(v.Path, ".")) {
+					findings = append(findings, v)
+				}
+			}
+		}
+
+		for _, child := range module.ChildModules {
+			walkModule(child)
+		}
+	}
+	walkModule(*values.RootModule)
+
+	return findings, nil
+}
+
+// hasValue returns whether a non-empty value is set for the specified path within the State/Plan values
+func hasValue(input interface{}, path []string) bool {
+	switch v := input.(type) {
+	case nil:
+		return false
+
+	case []interface{}:
+		for _, item := range v {
+			if hasValue(item, path) {
+				return true
+			}
+		}
+		return false
+
+	case map[string]interface{}:
+		if len(path) == 0 {
+			return len(v) > 0
+		}
+		return hasValue(v[path[0]], path[1:])
+
+	case string:
+		return len(path) == 0 && v != ""
+
+	default:
+		return len(path) == 0
+	}
+}
+
+// secretSegments are segments of an attribute name which indicate it contains a secret
+var secretSegments = map[string]struct{}{
+	"credential":  {},
+	"credentials": {},
+	"passphrase":  {},
+	"password":    {},
+	"pat":         {},
+	"sas":         {},
+	"secret":      {},
+	"token":       {},
+}
+
+// nonSecretSuffixes are the final segments of an attribute name which indicate it refers to, or
+// describes, a secret rather than containing one - for example `key_vault_secret_id` or `password_enabled`
+var nonSecretSuffixes = map[string]struct{}{
+	"enabled":     {},
+	"endpoint":    {},
+	"expiration":  {},
+	"expiry":      {},
+	"id":          {},
+	"ids":         {},
+	"interval":    {},
+	"length":      {},
+	"methods":     {},
+	"name":        {},
+	"names":       {},
+	"path":        {},
+	"permissions": {},
+	"policy":      {},
+	"reference":   {},
+	"source":      {},
+	"template":    {},
+	"thumbprint":  {},
+	"ttl":         {},
+	"type":        {},
+	"uri":         {},
+	"url":         {},
+	"version":     {},
+}
+
+// nonSecretKeyPrefixes are segments which indicate that an attribute ending in `key` isn't a secret,
+// for example `partition_key` (Cosmos DB) or `ssh_keys` (which are public keys)
+var nonSecretKeyPrefixes = map[string]struct{}{
+	"partition": {},
+	"row":       {},
+	"shard":     {},
+	"sort":      {},
+	"ssh":       {},
+}
+
+// looksLikeSecret returns whether the name of an attribute suggests that it contains a secret - this is
+// a heuristic, so the output is intended to be reviewed rather than acted on automatically
+func looksLikeSecret(name string) bool {
+	segments := strings.Split(strings.ToLower(name), "_")
+	last := segments[len(segments)-1]
+
+	// SAS URLs contain the signature, so these are checked before the suffixes
+	if strings.Contains(name, "connection_string") || strings.Contains(name, "sas_url") {
+		return true
+	}
+
+	if _, ok := nonSecretSuffixes[last]; ok {
+		return false
+	}
+
+	if strings.Contains(name, "non_secret") {
+		return false
+	}
+
+	for _, segment := range segments {
+		// public keys/certificates aren't secret
+		if segment == "public" {
+			return false
+		}
+	}
+
+	// `primary_access_key`, `shared_key` etc - but not `key_vault_id`, `key_type` or the `key` of a tag/header etc
+	if (last == "key" || last == "keys") && len(segments) > 1 {
+		if _, ok := nonSecretKeyPrefixes[segments[len(segments)-2]]; !ok {
+			return true
+		}
+	}
+
+	for _, segment := range segments {
+		if _, ok := secretSegments[segment]; ok {
+			return true
+		}
+	}
+
+	return false
+}
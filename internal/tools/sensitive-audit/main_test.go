@@ -0,0 +1,160 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestLooksLikeSecret(t *testing.T) {
+	testData := map[string]bool{
+		"admin_password":            true,
+		"client_secret":             true,
+		"primary_access_key":        true,
+		"primary_connection_string": true,
+		"sas_url":                   true,
+		"shared_key":                true,
+		"personal_access_token":     true,
+		"key_vault_secret_id":       false,
+		"key_vault_id":              false,
+		"key_type":                  false,
+		"password_enabled":          false,
+		"public_key":                false,
+		"name":                      false,
+		"secret_name":               false,
+		"certificate_thumbprint":    false,
+	}
+
+	for name, expected := range testData {
+		if actual := looksLikeSecret(name); actual != expected {
+			t.Fatalf("expected `looksLikeSecret(%q)` to be %t but got %t", name, expected, actual)
+		}
+	}
+}
+
+func testSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"primary_key": {
+			Type:      schema.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+		"connection_string": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"disable_password_authentication": {
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+		"site_config": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"auth_token": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestAuditSchema(t *testing.T) {
+	findings := auditSchema("azurerm_example", testSchema())
+
+	var buf bytes.Buffer
+	gaps := output(&buf, findings, false)
+	if gaps != 2 {
+		t.Fatalf("expected 2 gaps but got %d", gaps)
+	}
+
+	expected := strings.TrimSpace(`
+azurerm_example: ` + "`connection_string`" + ` looks like a secret but isn't marked as Sensitive
+azurerm_example: ` + "`primary_key`" + ` is marked as Sensitive
+azurerm_example: ` + "`site_config.auth_token`" + ` looks like a secret but isn't marked as Sensitive
+
+1 attributes are marked as Sensitive, 2 attributes look like secrets but aren't marked as Sensitive`)
+	if actual := strings.TrimSpace(buf.String()); actual != expected {
+		t.Fatalf("expected:\n%s\n\ngot:\n%s", expected, actual)
+	}
+}
+
+func TestAuditState(t *testing.T) {
+	state := `{
+  "values": {
+    "root_module": {
+      "resources": [
+        {
+          "address": "azurerm_example.test",
+          "mode": "managed",
+          "type": "azurerm_example",
+          "values": {
+            "name": "example",
+            "primary_key": "abc123",
+            "connection_string": "",
+            "site_config": [{"auth_token": "def456"}]
+          }
+        },
+        {
+          "address": "random_password.test",
+          "mode": "managed",
+          "type": "random_password",
+          "values": {
+            "result": "hunter2"
+          }
+        }
+      ],
+      "child_modules": [
+        {
+          "resources": [
+            {
+              "address": "module.example.azurerm_example.test",
+              "mode": "managed",
+              "type": "azurerm_example",
+              "values": {
+                "connection_string": "Endpoint=sb://example"
+              }
+            }
+          ]
+        }
+      ]
+    }
+  }
+}`
+	findings, err := auditState([]byte(state), map[string]map[string]*schema.Schema{
+		"azurerm_example": testSchema(),
+	})
+	if err != nil {
+		t.Fatalf("auditing state: %+v", err)
+	}
+
+	var buf bytes.Buffer
+	gaps := output(&buf, findings, true)
+	if gaps != 2 {
+		t.Fatalf("expected 2 gaps but got %d", gaps)
+	}
+
+	expected := strings.TrimSpace(`
+azurerm_example.test: ` + "`site_config.auth_token`" + ` looks like a secret but isn't marked as Sensitive
+module.example.azurerm_example.test: ` + "`connection_string`" + ` looks like a secret but isn't marked as Sensitive
+
+1 attributes are marked as Sensitive, 2 attributes look like secrets but aren't marked as Sensitive`)
+	if actual := strings.TrimSpace(buf.String()); actual != expected {
+		t.Fatalf("expected:\n%s\n\ngot:\n%s", expected, actual)
+	}
+}
+
+func TestAuditStateInvalid(t *testing.T) {
+	if _, err := auditState([]byte(`{"format_version": "1.0"}`), nil); err == nil {
+		t.Fatalf("expected an error when neither `values` nor `planned_values` are present")
+	}
+}
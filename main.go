@@ -6,6 +6,7 @@ import (
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/operationmetrics"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
 )
 
@@ -31,4 +32,7 @@ func main() {
 			ProviderFunc: provider.AzureProvider,
 		})
 	}
+
+	// the Operation Metrics summary is written out periodically, so ensure the latest summary is written before exiting
+	operationmetrics.FlushAll()
 }
@@ -0,0 +1,42 @@
+package utils
+
+import (
+	"context"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// DeleteIgnore404 wraps the response of a synchronous Delete call, treating a 404 as a
+// successful deletion so that destroying a resource which was removed outside of Terraform
+// doesn't fail. It's intended to be called inline: `DeleteIgnore404(client.Delete(ctx, ...))`
+func DeleteIgnore404(resp autorest.Response, err error) error {
+	if err != nil && !ResponseWasNotFound(resp) {
+		return err
+	}
+
+	return nil
+}
+
+// WaitForDeleteIgnore404 waits for the long-running Delete tracked by future to complete,
+// treating a 404 returned either by the initial request or whilst polling as the resource
+// already being gone. err is the error returned when sending the initial Delete request.
+func WaitForDeleteIgnore404(ctx context.Context, client autorest.Client, future azure.FutureAPI, err error) error {
+	if err != nil {
+		if future != nil && ResponseWasNotFound(autorest.Response{Response: future.Response()}) {
+			return nil
+		}
+
+		return err
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client); err != nil {
+		if ResponseWasNotFound(autorest.Response{Response: future.Response()}) {
+			return nil
+		}
+
+		return err
+	}
+
+	return nil
+}
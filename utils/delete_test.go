@@ -0,0 +1,110 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type fakeDeleteFuture struct {
+	azure.FutureAPI
+
+	response *http.Response
+	pollErr  error
+}
+
+func (f fakeDeleteFuture) Response() *http.Response {
+	return f.response
+}
+
+func (f fakeDeleteFuture) WaitForCompletionRef(_ context.Context, _ autorest.Client) error {
+	return f.pollErr
+}
+
+func TestDeleteIgnore404(t *testing.T) {
+	testCases := []struct {
+		statusCode  int
+		err         error
+		expectError bool
+	}{
+		{http.StatusOK, nil, false},
+		{http.StatusNoContent, nil, false},
+		{http.StatusNotFound, fmt.Errorf("not found"), false},
+		{http.StatusInternalServerError, fmt.Errorf("boom"), true},
+		{0, fmt.Errorf("dropped connection"), true},
+	}
+
+	for _, test := range testCases {
+		resp := autorest.Response{}
+		if test.statusCode != 0 {
+			resp.Response = &http.Response{StatusCode: test.statusCode}
+		}
+
+		err := DeleteIgnore404(resp, test.err)
+		if test.expectError != (err != nil) {
+			t.Fatalf("expected error to be %t for status code %d but got %+v", test.expectError, test.statusCode, err)
+		}
+	}
+}
+
+func TestWaitForDeleteIgnore404(t *testing.T) {
+	testCases := []struct {
+		name        string
+		future      azure.FutureAPI
+		err         error
+		expectError bool
+	}{
+		{
+			name:        "sending failed without a response",
+			future:      nil,
+			err:         fmt.Errorf("dropped connection"),
+			expectError: true,
+		},
+		{
+			name:        "sending returned a 404",
+			future:      fakeDeleteFuture{response: &http.Response{StatusCode: http.StatusNotFound}},
+			err:         fmt.Errorf("not found"),
+			expectError: false,
+		},
+		{
+			name:        "sending returned a 500",
+			future:      fakeDeleteFuture{response: &http.Response{StatusCode: http.StatusInternalServerError}},
+			err:         fmt.Errorf("boom"),
+			expectError: true,
+		},
+		{
+			name:        "polling completed",
+			future:      fakeDeleteFuture{response: &http.Response{StatusCode: http.StatusOK}},
+			expectError: false,
+		},
+		{
+			name: "polling returned a 404",
+			future: fakeDeleteFuture{
+				response: &http.Response{StatusCode: http.StatusNotFound},
+				pollErr:  fmt.Errorf("not found"),
+			},
+			expectError: false,
+		},
+		{
+			name: "polling failed",
+			future: fakeDeleteFuture{
+				response: &http.Response{StatusCode: http.StatusBadRequest},
+				pollErr:  fmt.Errorf("bad request"),
+			},
+			expectError: true,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Logf("[DEBUG] Testing %q", test.name)
+
+		err := WaitForDeleteIgnore404(context.TODO(), autorest.Client{}, test.future, test.err)
+		if test.expectError != (err != nil) {
+			t.Fatalf("expected error to be %t but got %+v", test.expectError, err)
+		}
+	}
+}